@@ -0,0 +1,119 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/backup"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/storage"
+)
+
+// runBackup writes a backup of the database, and of the attachment files with
+// -attachments, to the file given by -out.
+//
+//	journey backup -out journey.tar.zst [-attachments]
+func runBackup(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("backup", flag.ContinueOnError)
+	out := flags.String("out", "", "file to write the backup to, as .tar.zst, .tar.gz or .tar")
+	withFiles := flags.Bool("attachments", false, "back up the attachment files too")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *out == "" {
+		return errors.New("backup: -out is required")
+	}
+
+	pool, err := newPool(ctx)
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+
+	files, err := backupStorage(*withFiles)
+	if err != nil {
+		return err
+	}
+
+	w, err := backup.Create(*out)
+	if err != nil {
+		return err
+	}
+
+	m, err := backup.Backup(ctx, pool, w, files, printProgress)
+	if errClose := w.Close(); err == nil {
+		err = errClose
+	}
+	if err != nil {
+		_ = os.Remove(*out)
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "backed up %d tables and %d files at schema version %d to %s\n", len(m.Tables), m.Files, m.SchemaVersion, *out)
+	return nil
+}
+
+// runRestore loads a backup made by runBackup into a database migrated to
+// the same version, putting back the attachment files with -attachments.
+//
+//	journey restore [-clean] [-attachments] journey.tar.zst
+func runRestore(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("restore", flag.ContinueOnError)
+	clean := flags.Bool("clean", false, "delete the data in the database first")
+	withFiles := flags.Bool("attachments", false, "restore the attachment files too")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if flags.NArg() != 1 {
+		return errors.New("restore: the backup file is required")
+	}
+	in := flags.Arg(0)
+
+	pool, err := newPool(ctx)
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+
+	files, err := backupStorage(*withFiles)
+	if err != nil {
+		return err
+	}
+
+	r, err := backup.Open(in)
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+
+	m, err := backup.Restore(ctx, pool, r, files, *clean, printProgress)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(os.Stderr, "restored %d tables from %s, made %s\n", len(m.Tables), in, m.CreatedAt.Format("2006-01-02 15:04"))
+	return nil
+}
+
+// backupStorage is the storage attachment files are backed up from and
+// restored to, or nil when they are left out.
+func backupStorage(withFiles bool) (storage.Provider, error) {
+	if !withFiles {
+		return nil, nil
+	}
+
+	files, err := newStorage()
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := files.(storage.None); ok {
+		return nil, errors.New("no storage configured for -attachments, set JOURNEY_STORAGE_PROVIDER")
+	}
+	return files, nil
+}
+
+func printProgress(done, total int, step string) {
+	fmt.Fprintf(os.Stderr, "[%d/%d] %s\n", done, total, step)
+}
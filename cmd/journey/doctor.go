@@ -0,0 +1,226 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/export"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/logging"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/mailer/mailpit"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/storage"
+)
+
+// doctorTimeout bounds each check, so an unreachable service fails it rather
+// than hanging the report.
+const doctorTimeout = 5 * time.Second
+
+// errSkipped is returned by the checks of what is not configured.
+var errSkipped = errors.New("not configured")
+
+// doctorCheck is one line of the report: what it says on success, or why it
+// failed.
+type doctorCheck struct {
+	name string
+	run  func(ctx context.Context) (string, error)
+}
+
+// runDoctor checks the API could start and work as configured: the settings
+// are complete and valid, the database is reachable and fully migrated, and
+// so are the SMTP server and the storage, and the export templates render.
+// It prints a report and fails when any check does.
+//
+//	journey doctor
+func runDoctor(ctx context.Context, args []string) error {
+	flags := flag.NewFlagSet("doctor", flag.ContinueOnError)
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	var pool *pgxpool.Pool
+	defer func() {
+		if pool != nil {
+			pool.Close()
+		}
+	}()
+
+	checks := []doctorCheck{
+		{"config", checkConfig},
+		{"database", func(ctx context.Context) (string, error) {
+			var err error
+			pool, err = newPool(ctx)
+			if err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("connected to %s on %s", os.Getenv("JOURNEY_DATABASE_NAME"), os.Getenv("JOURNEY_DATABASE_HOST")), nil
+		}},
+		{"migrations", func(ctx context.Context) (string, error) {
+			if pool == nil {
+				return "", errors.New("database unreachable")
+			}
+			return checkMigrations(ctx, pool)
+		}},
+		{"smtp", func(ctx context.Context) (string, error) {
+			if err := mailpit.Ping(ctx); err != nil {
+				return "", err
+			}
+			return "server answered", nil
+		}},
+		{"storage", checkStorage},
+		{"templates", checkTemplates},
+	}
+
+	failed := 0
+	for _, c := range checks {
+		checkCtx, cancel := context.WithTimeout(ctx, doctorTimeout)
+		detail, err := c.run(checkCtx)
+		cancel()
+
+		switch {
+		case errors.Is(err, errSkipped):
+			fmt.Printf("skip %s: %v\n", c.name, err)
+		case err != nil:
+			failed++
+			fmt.Printf("FAIL %s: %v\n", c.name, err)
+		default:
+			fmt.Printf("ok   %s: %s\n", c.name, detail)
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("doctor: %d of %d checks failed", failed, len(checks))
+	}
+	return nil
+}
+
+// checkConfig reads every setting the way run does, reporting all that are
+// missing or invalid at once.
+func checkConfig(context.Context) (string, error) {
+	var errs []error
+	for _, name := range []string{
+		"JOURNEY_DATABASE_USER",
+		"JOURNEY_DATABASE_PASSWORD",
+		"JOURNEY_DATABASE_HOST",
+		"JOURNEY_DATABASE_PORT",
+		"JOURNEY_DATABASE_NAME",
+	} {
+		if os.Getenv(name) == "" {
+			errs = append(errs, fmt.Errorf("%s is not set", name))
+		}
+	}
+
+	if _, err := logging.ParseMode(os.Getenv("JOURNEY_LOG_PII")); err != nil {
+		errs = append(errs, err)
+	}
+	if _, err := newLinks(); err != nil {
+		errs = append(errs, err)
+	}
+	if _, err := newMailConfig(); err != nil {
+		errs = append(errs, err)
+	}
+	if _, err := newSheets(); err != nil {
+		errs = append(errs, err)
+	}
+	if _, err := newDrafter(); err != nil {
+		errs = append(errs, err)
+	}
+	if _, err := newInstance(); err != nil {
+		errs = append(errs, err)
+	}
+	if _, err := chaosConfig(); err != nil {
+		errs = append(errs, err)
+	}
+
+	switch sink := os.Getenv("JOURNEY_ANALYTICS_SINK"); sink {
+	case "", "log", "none", "prometheus", "posthog":
+	default:
+		errs = append(errs, fmt.Errorf("invalid JOURNEY_ANALYTICS_SINK: %q", sink))
+	}
+	if os.Getenv("JOURNEY_SCANNER_PROVIDER") == "clamav" && os.Getenv("JOURNEY_CLAMAV_ADDR") == "" {
+		errs = append(errs, errors.New("JOURNEY_CLAMAV_ADDR is not set"))
+	}
+	if days := os.Getenv("JOURNEY_ARCHIVE_AFTER_DAYS"); days != "" {
+		if n, err := strconv.Atoi(days); err != nil || n < 0 {
+			errs = append(errs, fmt.Errorf("invalid JOURNEY_ARCHIVE_AFTER_DAYS: %q", days))
+		}
+	}
+
+	if len(errs) > 0 {
+		msgs := make([]string, len(errs))
+		for i, err := range errs {
+			msgs[i] = err.Error()
+		}
+		return "", errors.New(strings.Join(msgs, "; "))
+	}
+	return "complete", nil
+}
+
+// checkMigrations compares the version of the database with the last
+// migration shipped with the API.
+func checkMigrations(ctx context.Context, pool *pgxpool.Pool) (string, error) {
+	latest, err := pgstore.LatestMigration()
+	if err != nil {
+		return "", err
+	}
+
+	var version int32
+	if err := pool.QueryRow(ctx, "SELECT version FROM schema_version").Scan(&version); err != nil {
+		return "", fmt.Errorf("failed to read schema version: %w", err)
+	}
+
+	switch {
+	case version < latest:
+		return "", fmt.Errorf("database at version %d, %d migrations pending", version, latest-version)
+	case version > latest:
+		return "", fmt.Errorf("database at version %d, ahead of the last migration %d", version, latest)
+	}
+	return fmt.Sprintf("at version %d", version), nil
+}
+
+// checkStorage looks up an object that is never there: the credentials are
+// valid when the storage says it is not found.
+func checkStorage(ctx context.Context) (string, error) {
+	files, err := newStorage()
+	if err != nil {
+		return "", err
+	}
+	if _, ok := files.(storage.None); ok {
+		return "", errSkipped
+	}
+
+	if _, err := files.Stat(ctx, "doctor/ping"); err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return "", err
+	}
+	return "credentials accepted", nil
+}
+
+// checkTemplates renders a sample itinerary in every export format.
+func checkTemplates(context.Context) (string, error) {
+	now := time.Now()
+	it := export.New("Florianópolis", now, now.AddDate(0, 0, 2))
+	it.Add(export.Item{At: now.Add(time.Hour), Duration: time.Hour, Title: "Passeio de barco", Notes: []string{"Levar protetor"}})
+
+	if _, err := export.HTML(it); err != nil {
+		return "", fmt.Errorf("html: %w", err)
+	}
+	if _, err := export.JSONLD(it, "http://localhost:8080"); err != nil {
+		return "", fmt.Errorf("json-ld: %w", err)
+	}
+	if _, err := export.Atom(it, "urn:journey:doctor", "http://localhost:8080/feed", "http://localhost:8080", nil, now); err != nil {
+		return "", fmt.Errorf("atom: %w", err)
+	}
+	if strings.TrimSpace(export.Markdown(it)) == "" {
+		return "", errors.New("markdown: rendered empty")
+	}
+	if !strings.Contains(export.ICS(it, "localhost", now), "BEGIN:VCALENDAR") {
+		return "", errors.New("ics: no calendar rendered")
+	}
+	return "html, markdown, ics, atom and json-ld render", nil
+}
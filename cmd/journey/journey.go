@@ -3,10 +3,13 @@ package main
 import (
 	"context"
 	"errors"
+	"expvar"
 	"fmt"
 	"net/http"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
 	"time"
 
@@ -14,9 +17,34 @@ import (
 	"github.com/go-chi/chi/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/phenpessoa/gutils/netutils/httputils"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/analytics"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/analytics/posthog"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/analytics/prometheus"
 	"github.com/xtuser777/nlw-journey-trilha-go/internal/api"
 	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/chaos"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/drafting"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/drafting/openai"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/federation"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/lifecycle"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/linkmeta"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/linkmeta/webpage"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/links"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/logging"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/mailer/dkim"
 	"github.com/xtuser777/nlw-journey-trilha-go/internal/mailer/mailpit"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/ocr"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/ocr/tesseract"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/routing"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/scanner"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/scanner/clamav"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/scheduler"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/sheets"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/sheets/google"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/storage"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/storage/s3"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/weather/openmeteo"
 	"go.uber.org/zap"
 	"go.uber.org/zap/zapcore"
 )
@@ -26,7 +54,18 @@ func main() {
 	ctx, cancel := signal.NotifyContext(ctx, os.Interrupt, os.Kill, syscall.SIGTERM, syscall.SIGKILL)
 	defer cancel()
 
-	if err := run(ctx); err != nil {
+	var err error
+	switch {
+	case len(os.Args) > 1 && os.Args[1] == "backup":
+		err = runBackup(ctx, os.Args[2:])
+	case len(os.Args) > 1 && os.Args[1] == "restore":
+		err = runRestore(ctx, os.Args[2:])
+	case len(os.Args) > 1 && os.Args[1] == "doctor":
+		err = runDoctor(ctx, os.Args[2:])
+	default:
+		err = run(ctx)
+	}
+	if err != nil {
 		fmt.Fprintln(os.Stderr, err.Error())
 		os.Exit(1)
 	}
@@ -37,7 +76,16 @@ func run(ctx context.Context) error {
 	cfg := zap.NewDevelopmentConfig()
 	cfg.EncoderConfig.EncodeLevel = zapcore.CapitalColorLevelEncoder
 
-	logger, err := cfg.Build()
+	// Emails and names of travelers are kept out of the logs, redacted unless
+	// JOURNEY_LOG_PII asks for them hashed, or shown when debugging.
+	piiMode, err := logging.ParseMode(os.Getenv("JOURNEY_LOG_PII"))
+	if err != nil {
+		return err
+	}
+
+	logger, err := cfg.Build(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		return logging.Sanitize(core, piiMode)
+	}))
 	if err != nil {
 		return err
 	}
@@ -45,32 +93,184 @@ func run(ctx context.Context) error {
 	logger = logger.Named("journey_app")
 	defer func() { _ = logger.Sync() }()
 
-	pool, err := pgxpool.New(ctx, fmt.Sprintf("user=%s password=%s host=%s port=%s dbname=%s",
-		os.Getenv("JOURNEY_DATABASE_USER"),
-		os.Getenv("JOURNEY_DATABASE_PASSWORD"),
-		os.Getenv("JOURNEY_DATABASE_HOST"),
-		os.Getenv("JOURNEY_DATABASE_PORT"),
-		os.Getenv("JOURNEY_DATABASE_NAME"),
-	))
+	pool, err := newPool(ctx)
 	if err != nil {
 		return err
 	}
 	defer pool.Close()
 
-	if err := pool.Ping(ctx); err != nil {
+	var receiptReader ocr.Provider = ocr.None{}
+	if os.Getenv("JOURNEY_OCR_PROVIDER") == "tesseract" {
+		receiptReader = tesseract.NewTesseract("tesseract", "por+eng")
+	}
+
+	files, err := newStorage()
+	if err != nil {
 		return err
 	}
 
+	var fileScanner scanner.Scanner = scanner.None{}
+	if os.Getenv("JOURNEY_SCANNER_PROVIDER") == "clamav" {
+		fileScanner = clamav.NewClamAV(os.Getenv("JOURNEY_CLAMAV_ADDR"))
+	}
+
+	var linkTitles linkmeta.Provider = linkmeta.None{}
+	if os.Getenv("JOURNEY_LINK_TITLES_PROVIDER") == "webpage" {
+		linkTitles = webpage.NewWebpage(&http.Client{Timeout: 5 * time.Second})
+	}
+
+	tripSheets, err := newSheets()
+	if err != nil {
+		return err
+	}
+
+	drafter, err := newDrafter()
+	if err != nil {
+		return err
+	}
+
+	instance, err := newInstance()
+	if err != nil {
+		return err
+	}
+
+	// Faults are injected only when JOURNEY_CHAOS_* asks for them, to test
+	// how the API copes with slow requests, failing queries and a flaky SMTP
+	// server. Never set them in production.
+	chaosCfg, err := chaosConfig()
+	if err != nil {
+		return err
+	}
+	var faults *chaos.Injector
+	if chaosCfg.Enabled() {
+		faults = chaos.New(chaosCfg)
+		logger.Warn("injecting faults",
+			zap.Float64("latency_rate", chaosCfg.LatencyRate),
+			zap.Duration("max_latency", chaosCfg.MaxLatency),
+			zap.Float64("smtp_failure_rate", chaosCfg.SMTPFailureRate),
+			zap.Float64("db_failure_rate", chaosCfg.DBFailureRate),
+		)
+	}
+
+	urls, err := newLinks()
+	if err != nil {
+		return err
+	}
+
+	mailCfg, err := newMailConfig()
+	if err != nil {
+		return err
+	}
+	mailCfg.Chaos = faults
+	mailCfg.Links = urls
+
+	mailer, err := mailpit.NewMailPit(pool, mailCfg)
+	if err != nil {
+		return err
+	}
+
+	validateRequest, err := api.RequestValidator()
+	if err != nil {
+		return err
+	}
+
+	// The admin routes and /debug/vars are only for operators, who send
+	// JOURNEY_ADMIN_TOKEN as a bearer token. Without it they are closed.
+	adminToken := os.Getenv("JOURNEY_ADMIN_TOKEN")
+	if adminToken == "" {
+		logger.Warn("JOURNEY_ADMIN_TOKEN is not set, admin routes are closed")
+	}
+
 	r := chi.NewMux()
-	r.Use(middleware.RequestID, middleware.Recoverer, httputils.ChiLogger(logger))
+	r.Use(middleware.RequestID, middleware.Recoverer, httputils.ChiLogger(logger), api.Localize, api.AdminOnly(adminToken), api.TokenGuard(logger), validateRequest)
+	if faults != nil {
+		r.Use(faults.Middleware)
+	}
+
+	// Analytics only ever count what happens, with nothing about who did it.
+	// They go to the log unless JOURNEY_ANALYTICS_SINK says otherwise, and
+	// "none" turns them off.
+	var events analytics.Sink = analytics.NewLog(logger)
+	switch sink := os.Getenv("JOURNEY_ANALYTICS_SINK"); sink {
+	case "", "log":
+	case "none":
+		events = analytics.None{}
+	case "prometheus":
+		metrics := prometheus.NewPrometheus()
+		r.Handle("/metrics", metrics)
+		events = metrics
+	case "posthog":
+		events = posthog.NewPostHog(
+			&http.Client{Timeout: 10 * time.Second},
+			os.Getenv("JOURNEY_POSTHOG_HOST"),
+			os.Getenv("JOURNEY_POSTHOG_API_KEY"),
+			logger,
+		)
+	default:
+		return fmt.Errorf("invalid JOURNEY_ANALYTICS_SINK: %q", sink)
+	}
 
+	// Trips can not be created with an owner email from these domains, nor
+	// their subdomains.
+	var blockedDomains []string
+	if domains := os.Getenv("JOURNEY_BLOCKED_EMAIL_DOMAINS"); domains != "" {
+		blockedDomains = strings.Split(domains, ",")
+	}
+
+	meteo := openmeteo.NewOpenMeteo(&http.Client{Timeout: 10 * time.Second})
 	si := api.NewApi(
 		pool,
 		logger,
-		mailpit.NewMailPit(pool),
+		mailer,
+		meteo,
+		receiptReader,
+		meteo,
+		routing.Haversine{},
+		files,
+		fileScanner,
+		tripSheets,
+		drafter,
+		linkTitles,
+		instance,
+		events,
+		urls,
+		blockedDomains,
 	)
 
-	r.Mount("/", spec.Handler(&si))
+	archiveAfter := scheduler.DefaultArchiveAfter
+	if days := os.Getenv("JOURNEY_ARCHIVE_AFTER_DAYS"); days != "" {
+		n, err := strconv.Atoi(days)
+		if err != nil || n < 0 {
+			return fmt.Errorf("invalid JOURNEY_ARCHIVE_AFTER_DAYS: %q", days)
+		}
+		archiveAfter = time.Duration(n) * 24 * time.Hour
+	}
+
+	var jobDB pgstore.DBTX = pool
+	if faults != nil {
+		si.InjectFaults(faults)
+		jobDB = faults.DB(pool)
+	}
+
+	jobStore := pgstore.NewStore(jobDB)
+	scheduler.New(logger, jobStore,
+		scheduler.OwnerSummaries(jobStore, mailer, logger),
+		scheduler.DailyDigests(jobStore, mailer, logger),
+		scheduler.PlanningDigests(jobStore, mailer, logger),
+		scheduler.TripReminders(jobStore, mailer, logger),
+		scheduler.OverdueTasks(jobStore, mailer, logger),
+		scheduler.ExpiredAttachments(jobStore, files, logger),
+		scheduler.ArchivedTrips(jobStore, mailer, archiveAfter, logger),
+		scheduler.TripSheets(jobStore, tripSheets, logger),
+		scheduler.TripSurveys(jobStore, mailer, logger),
+		scheduler.TrashPurge(jobStore),
+		scheduler.TripLifecycle(jobStore, lifecycle.New(jobStore, mailer, events, logger), logger),
+	).Start(ctx)
+
+	r.NotFound(api.NotFound)
+	r.MethodNotAllowed(api.MethodNotAllowed)
+	r.Handle("/debug/vars", expvar.Handler())
+	r.Mount("/", si.LoadTrip(spec.Handler(&si)))
 
 	srv := &http.Server{
 		Addr:         ":8080",
@@ -110,3 +310,189 @@ func run(ctx context.Context) error {
 
 	return nil
 }
+
+// newPool connects to the database given by the JOURNEY_DATABASE_* variables.
+func newPool(ctx context.Context) (*pgxpool.Pool, error) {
+	pool, err := pgxpool.New(ctx, fmt.Sprintf("user=%s password=%s host=%s port=%s dbname=%s",
+		os.Getenv("JOURNEY_DATABASE_USER"),
+		os.Getenv("JOURNEY_DATABASE_PASSWORD"),
+		os.Getenv("JOURNEY_DATABASE_HOST"),
+		os.Getenv("JOURNEY_DATABASE_PORT"),
+		os.Getenv("JOURNEY_DATABASE_NAME"),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := pool.Ping(ctx); err != nil {
+		pool.Close()
+		return nil, err
+	}
+
+	return pool, nil
+}
+
+// chaosConfig reads the faults to inject from JOURNEY_CHAOS_LATENCY_RATE,
+// JOURNEY_CHAOS_MAX_LATENCY, JOURNEY_CHAOS_SMTP_FAILURE_RATE and
+// JOURNEY_CHAOS_DB_FAILURE_RATE. Rates go from 0 to 1 and unset ones are 0.
+func chaosConfig() (chaos.Config, error) {
+	var cfg chaos.Config
+	for name, rate := range map[string]*float64{
+		"JOURNEY_CHAOS_LATENCY_RATE":      &cfg.LatencyRate,
+		"JOURNEY_CHAOS_SMTP_FAILURE_RATE": &cfg.SMTPFailureRate,
+		"JOURNEY_CHAOS_DB_FAILURE_RATE":   &cfg.DBFailureRate,
+	} {
+		if v := os.Getenv(name); v != "" {
+			var err error
+			if *rate, err = strconv.ParseFloat(v, 64); err != nil {
+				return chaos.Config{}, fmt.Errorf("invalid %s: %w", name, err)
+			}
+		}
+	}
+	if v := os.Getenv("JOURNEY_CHAOS_MAX_LATENCY"); v != "" {
+		var err error
+		if cfg.MaxLatency, err = time.ParseDuration(v); err != nil {
+			return chaos.Config{}, fmt.Errorf("invalid JOURNEY_CHAOS_MAX_LATENCY: %w", err)
+		}
+	}
+	return cfg, cfg.Validate()
+}
+
+// newStorage sets up the storage given by JOURNEY_STORAGE_PROVIDER, or none.
+func newStorage() (storage.Provider, error) {
+	if os.Getenv("JOURNEY_STORAGE_PROVIDER") != "s3" {
+		return storage.None{}, nil
+	}
+	files, err := s3.NewS3(&http.Client{Timeout: 10 * time.Second}, s3.Config{
+		Endpoint:        os.Getenv("JOURNEY_S3_ENDPOINT"),
+		Region:          os.Getenv("JOURNEY_S3_REGION"),
+		Bucket:          os.Getenv("JOURNEY_S3_BUCKET"),
+		AccessKeyID:     os.Getenv("JOURNEY_S3_ACCESS_KEY_ID"),
+		SecretAccessKey: os.Getenv("JOURNEY_S3_SECRET_ACCESS_KEY"),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+// newLinks builds the links sent outside the app from JOURNEY_APP_URL, where
+// the frontend is, and JOURNEY_API_URL, both defaulting to this server.
+func newLinks() (links.Builder, error) {
+	appURL, apiURL := os.Getenv("JOURNEY_APP_URL"), os.Getenv("JOURNEY_API_URL")
+	if appURL == "" {
+		appURL = "http://localhost:8080"
+	}
+	if apiURL == "" {
+		apiURL = "http://localhost:8080"
+	}
+	return links.New(appURL, apiURL)
+}
+
+// newSheets sets up the spreadsheets given by JOURNEY_SHEETS_PROVIDER, or
+// none.
+func newSheets() (sheets.Provider, error) {
+	if os.Getenv("JOURNEY_SHEETS_PROVIDER") != "google" {
+		return sheets.None{}, nil
+	}
+	tripSheets, err := google.NewGoogle(&http.Client{Timeout: 10 * time.Second}, google.Config{
+		ClientID:     os.Getenv("JOURNEY_GOOGLE_CLIENT_ID"),
+		ClientSecret: os.Getenv("JOURNEY_GOOGLE_CLIENT_SECRET"),
+		RedirectURL:  os.Getenv("JOURNEY_GOOGLE_REDIRECT_URL"),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return tripSheets, nil
+}
+
+// newDrafter sets up the itinerary drafts given by JOURNEY_DRAFT_PROVIDER, or
+// none. Drafts are paid for by the call, so they are capped a day, and each
+// answer is bounded by JOURNEY_DRAFT_MAX_TOKENS.
+func newDrafter() (drafting.Provider, error) {
+	if os.Getenv("JOURNEY_DRAFT_PROVIDER") != "openai" {
+		return drafting.None{}, nil
+	}
+
+	var err error
+	draftCfg := openai.Config{
+		URL:    os.Getenv("JOURNEY_DRAFT_API_URL"),
+		APIKey: os.Getenv("JOURNEY_DRAFT_API_KEY"),
+		Model:  os.Getenv("JOURNEY_DRAFT_MODEL"),
+	}
+	if maxTokens := os.Getenv("JOURNEY_DRAFT_MAX_TOKENS"); maxTokens != "" {
+		draftCfg.MaxTokens, err = strconv.Atoi(maxTokens)
+		if err != nil {
+			return nil, fmt.Errorf("invalid JOURNEY_DRAFT_MAX_TOKENS: %w", err)
+		}
+	}
+
+	perDay := 50
+	if limit := os.Getenv("JOURNEY_DRAFT_DAILY_LIMIT"); limit != "" {
+		perDay, err = strconv.Atoi(limit)
+		if err != nil || perDay < 0 {
+			return nil, fmt.Errorf("invalid JOURNEY_DRAFT_DAILY_LIMIT: %q", limit)
+		}
+	}
+
+	provider, err := openai.NewOpenAI(&http.Client{Timeout: 30 * time.Second}, draftCfg)
+	if err != nil {
+		return nil, err
+	}
+	return drafting.NewLimited(provider, perDay), nil
+}
+
+// newInstance reads the instance key from JOURNEY_INSTANCE_KEY_FILE. Trip
+// bundles are signed with it, and imported only when signed by this instance
+// or one of JOURNEY_TRUSTED_INSTANCE_KEYS. Without a key file the instance is
+// the zero value.
+func newInstance() (federation.Instance, error) {
+	keyFile := os.Getenv("JOURNEY_INSTANCE_KEY_FILE")
+	if keyFile == "" {
+		return federation.Instance{}, nil
+	}
+
+	key, err := os.ReadFile(keyFile)
+	if err != nil {
+		return federation.Instance{}, fmt.Errorf("failed to read instance key: %w", err)
+	}
+	return federation.NewInstance(os.Getenv("JOURNEY_INSTANCE_NAME"), key, strings.Split(os.Getenv("JOURNEY_TRUSTED_INSTANCE_KEYS"), ","))
+}
+
+// newMailConfig reads how emails are sent from the JOURNEY_MAIL_* and
+// JOURNEY_DKIM_* variables.
+func newMailConfig() (mailpit.Config, error) {
+	var err error
+	mailCfg := mailpit.Config{
+		From:       "mailpit@journey.com",
+		ReplyTo:    os.Getenv("JOURNEY_MAIL_REPLY_TO"),
+		RedirectTo: os.Getenv("JOURNEY_MAIL_REDIRECT_TO"),
+	}
+	if workers := os.Getenv("JOURNEY_MAIL_WORKERS"); workers != "" {
+		mailCfg.Workers, err = strconv.Atoi(workers)
+		if err != nil {
+			return mailpit.Config{}, fmt.Errorf("invalid JOURNEY_MAIL_WORKERS: %w", err)
+		}
+	}
+	if queueSize := os.Getenv("JOURNEY_MAIL_QUEUE_SIZE"); queueSize != "" {
+		mailCfg.QueueSize, err = strconv.Atoi(queueSize)
+		if err != nil {
+			return mailpit.Config{}, fmt.Errorf("invalid JOURNEY_MAIL_QUEUE_SIZE: %w", err)
+		}
+	}
+	if from := os.Getenv("JOURNEY_MAIL_FROM"); from != "" {
+		mailCfg.From = from
+	}
+	if keyFile := os.Getenv("JOURNEY_DKIM_PRIVATE_KEY_FILE"); keyFile != "" {
+		key, err := os.ReadFile(keyFile)
+		if err != nil {
+			return mailpit.Config{}, fmt.Errorf("failed to read DKIM private key: %w", err)
+		}
+
+		mailCfg.Signer, err = dkim.NewSigner(os.Getenv("JOURNEY_DKIM_DOMAIN"), os.Getenv("JOURNEY_DKIM_SELECTOR"), key)
+		if err != nil {
+			return mailpit.Config{}, err
+		}
+	}
+	return mailCfg, nil
+}
@@ -0,0 +1,44 @@
+package analytics
+
+import "go.uber.org/zap"
+
+// Event is something done in the app that is counted to understand how it is
+// used.
+type Event string
+
+const (
+	TripCreated   Event = "trip_created"
+	InviteSent    Event = "invite_sent"
+	ActivityAdded Event = "activity_added"
+	TripConfirmed Event = "trip_confirmed"
+	TripCancelled Event = "trip_cancelled"
+)
+
+// Events are every event counted, so sinks can report the ones that did not
+// happen yet as zero.
+var Events = []Event{TripCreated, InviteSent, ActivityAdded, TripConfirmed, TripCancelled}
+
+// Sink receives the usage counters. Events carry nothing about the trip or
+// the people in it, only that something happened and how many times.
+// Implementations must not block the request counting them.
+type Sink interface {
+	Count(event Event, n int)
+}
+
+// None is the sink used when analytics are turned off.
+type None struct{}
+
+func (None) Count(Event, int) {}
+
+// Log writes the events to the application log, at debug level.
+type Log struct {
+	logger *zap.Logger
+}
+
+func NewLog(logger *zap.Logger) Log {
+	return Log{logger}
+}
+
+func (l Log) Count(event Event, n int) {
+	l.logger.Debug("analytics event", zap.String("event", string(event)), zap.Int("count", n))
+}
@@ -0,0 +1,108 @@
+package posthog
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/analytics"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultHost = "https://us.i.posthog.com"
+
+	// queueSize is how many events wait to be sent. Events counted while
+	// the queue is full are dropped rather than slowing requests down.
+	queueSize = 256
+)
+
+type captureEvent struct {
+	APIKey     string         `json:"api_key"`
+	Event      string         `json:"event"`
+	DistinctID string         `json:"distinct_id"`
+	Properties map[string]any `json:"properties"`
+	Timestamp  time.Time      `json:"timestamp"`
+}
+
+// PostHog sends the events to PostHog in the background. Every event is sent
+// under an ID made up when the server starts, with person profiles turned
+// off, so they can not be tied to anyone.
+type PostHog struct {
+	client     *http.Client
+	url        string
+	apiKey     string
+	distinctID string
+	events     chan captureEvent
+	logger     *zap.Logger
+}
+
+// NewPostHog starts sending events to the PostHog instance at host, or to
+// PostHog cloud when host is empty.
+func NewPostHog(client *http.Client, host, apiKey string, logger *zap.Logger) *PostHog {
+	if host == "" {
+		host = defaultHost
+	}
+
+	ph := &PostHog{
+		client:     client,
+		url:        strings.TrimSuffix(host, "/") + "/capture/",
+		apiKey:     apiKey,
+		distinctID: uuid.NewString(),
+		events:     make(chan captureEvent, queueSize),
+		logger:     logger,
+	}
+	go ph.work()
+	return ph
+}
+
+func (ph *PostHog) Count(event analytics.Event, n int) {
+	select {
+	case ph.events <- captureEvent{
+		APIKey:     ph.apiKey,
+		Event:      string(event),
+		DistinctID: ph.distinctID,
+		Properties: map[string]any{"count": n, "$process_person_profile": false},
+		Timestamp:  time.Now(),
+	}:
+	default:
+		ph.logger.Warn("posthog queue is full, dropping event", zap.String("event", string(event)))
+	}
+}
+
+func (ph *PostHog) work() {
+	for event := range ph.events {
+		if err := ph.capture(event); err != nil {
+			ph.logger.Warn("failed to send analytics event", zap.Error(err), zap.String("event", event.Event))
+		}
+	}
+}
+
+func (ph *PostHog) capture(event captureEvent) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(context.Background(), http.MethodPost, ph.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := ph.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return nil
+}
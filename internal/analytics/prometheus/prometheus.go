@@ -0,0 +1,42 @@
+package prometheus
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/analytics"
+)
+
+// Prometheus keeps the event counters in memory and serves them in the
+// Prometheus text format, to be scraped from the handler it is mounted on.
+type Prometheus struct {
+	mu     sync.Mutex
+	counts map[analytics.Event]int64
+}
+
+func NewPrometheus() *Prometheus {
+	counts := make(map[analytics.Event]int64, len(analytics.Events))
+	for _, event := range analytics.Events {
+		counts[event] = 0
+	}
+	return &Prometheus{counts: counts}
+}
+
+func (p *Prometheus) Count(event analytics.Event, n int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.counts[event] += int64(n)
+}
+
+func (p *Prometheus) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	fmt.Fprintln(w, "# HELP journey_events_total Product usage events, with no data about who did them.")
+	fmt.Fprintln(w, "# TYPE journey_events_total counter")
+	for _, event := range analytics.Events {
+		fmt.Fprintf(w, "journey_events_total{event=%q} %d\n", event, p.counts[event])
+	}
+}
@@ -0,0 +1,76 @@
+package api
+
+import (
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// tripCreationLimit is how many trips can be created from one address
+	// within tripCreationWindow.
+	tripCreationLimit  = 10
+	tripCreationWindow = time.Hour
+)
+
+// creationLimiter counts the trips created from each address, in memory, so
+// each instance of the API counts its own.
+type creationLimiter struct {
+	mu      sync.Mutex
+	created map[string][]time.Time
+}
+
+// allow records a creation from the address, telling whether it is within
+// the limit.
+func (l *creationLimiter) allow(ip string, now time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.created == nil {
+		l.created = make(map[string][]time.Time)
+	}
+
+	recent := l.created[ip][:0]
+	for _, at := range l.created[ip] {
+		if now.Sub(at) < tripCreationWindow {
+			recent = append(recent, at)
+		}
+	}
+
+	if len(recent) >= tripCreationLimit {
+		l.created[ip] = recent
+		return false
+	}
+
+	if len(recent) == 0 && len(l.created) >= guardMaxEntries {
+		l.forget(now)
+	}
+	l.created[ip] = append(recent, now)
+	return true
+}
+
+// forget drops the addresses with no creation within the window.
+func (l *creationLimiter) forget(now time.Time) {
+	for ip, times := range l.created {
+		if len(times) == 0 || now.Sub(times[len(times)-1]) >= tripCreationWindow {
+			delete(l.created, ip)
+		}
+	}
+}
+
+// blockedDomain tells whether the domain of the email, or one it is under, is
+// in the blocklist.
+func (api *API) blockedDomain(email string) bool {
+	_, domain, ok := strings.Cut(strings.ToLower(email), "@")
+	if !ok {
+		return false
+	}
+
+	for domain != "" {
+		if api.blockedDomains[domain] {
+			return true
+		}
+		_, domain, _ = strings.Cut(domain, ".")
+	}
+	return false
+}
@@ -0,0 +1,148 @@
+package api
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/discord-gophers/goapi-gen/types"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"go.uber.org/zap"
+)
+
+const (
+	// statsTTL is how long the instance statistics are reused before the
+	// aggregate queries run again.
+	statsTTL = time.Minute
+
+	// statsDays is how many days back the trips created per day go.
+	statsDays = 30
+)
+
+// adminRoutes are the path prefixes of what only operators can see: the
+// admin routes and the expvar internals.
+var adminRoutes = []string{"/admin/", "/debug/"}
+
+// AdminOnly returns a middleware answering the admin routes only for requests
+// carrying the operator token as a bearer token, with a 401 otherwise. With no
+// token set they are answered to nobody.
+func AdminOnly(token string) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !isAdminRoute(r.URL.Path) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			given, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+			if !ok || token == "" || subtle.ConstantTimeCompare([]byte(given), []byte(token)) != 1 {
+				w.Header().Set("WWW-Authenticate", "Bearer")
+				writeError(w, http.StatusUnauthorized, "unauthorized", "invalid admin token")
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+func isAdminRoute(path string) bool {
+	for _, prefix := range adminRoutes {
+		if strings.HasPrefix(path, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// statsCache keeps the last computed instance statistics, so operators
+// refreshing a dashboard do not scan the whole database every time.
+type statsCache struct {
+	mu      sync.Mutex
+	stats   spec.AdminStatsResponse
+	expires time.Time
+}
+
+// Get instance statistics.
+// (GET /admin/stats)
+func (api *API) GetAdminStats(w http.ResponseWriter, r *http.Request) *spec.Response {
+	api.stats.mu.Lock()
+	defer api.stats.mu.Unlock()
+
+	if time.Now().Before(api.stats.expires) {
+		return spec.GetAdminStatsJSON200Response(api.stats.stats)
+	}
+
+	stats, err := api.instanceStats(r.Context())
+	if err != nil {
+		api.logger.Error("failed to get instance stats", zap.Error(err))
+		return spec.GetAdminStatsJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	api.stats.stats = stats
+	api.stats.expires = stats.GeneratedAt.Add(statsTTL)
+
+	return spec.GetAdminStatsJSON200Response(stats)
+}
+
+func (api *API) instanceStats(ctx context.Context) (spec.AdminStatsResponse, error) {
+	now := time.Now()
+
+	totals, err := api.store.GetInstanceTotals(ctx)
+	if err != nil {
+		return spec.AdminStatsResponse{}, fmt.Errorf("failed to get totals: %w", err)
+	}
+
+	since := now.AddDate(0, 0, -statsDays)
+	perDay, err := api.store.CountTripsCreatedPerDay(ctx, pgtype.Timestamp{Valid: true, Time: since})
+	if err != nil {
+		return spec.AdminStatsResponse{}, fmt.Errorf("failed to count trips per day: %w", err)
+	}
+
+	tables, err := api.store.GetTableSizes(ctx)
+	if err != nil {
+		return spec.AdminStatsResponse{}, fmt.Errorf("failed to get table sizes: %w", err)
+	}
+
+	sent, failed := api.mailer.SendCounts()
+
+	stats := spec.AdminStatsResponse{
+		GeneratedAt:  now,
+		Participants: totals.Participants,
+		Trips: spec.AdminStatsTrips{
+			Total:         totals.Trips,
+			Active:        totals.ActiveTrips,
+			CreatedPerDay: make([]spec.AdminStatsDay, 0, len(perDay)),
+		},
+		Emails: spec.AdminStatsEmails{Sent: sent, Failed: failed},
+		Database: spec.AdminStatsDatabase{
+			SizeBytes: totals.DatabaseBytes,
+			Tables:    make([]spec.AdminStatsTable, 0, len(tables)),
+		},
+	}
+
+	for _, day := range perDay {
+		stats.Trips.CreatedPerDay = append(stats.Trips.CreatedPerDay, spec.AdminStatsDay{
+			Day:   types.Date{Time: day.Day.Time},
+			Count: day.Trips,
+		})
+	}
+
+	for _, table := range tables {
+		stats.Database.Tables = append(stats.Database.Tables, spec.AdminStatsTable{
+			Name:         table.Name,
+			RowsEstimate: table.RowsEstimate,
+			SizeBytes:    table.Bytes,
+		})
+	}
+
+	return stats, nil
+}
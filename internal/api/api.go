@@ -5,16 +5,33 @@ import (
 	"encoding/json"
 	"errors"
 	"net/http"
+	"strings"
 	"time"
 
-	"github.com/discord-gophers/goapi-gen/types"
 	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/analytics"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/serializer"
 	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/chaos"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/domain"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/drafting"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/export"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/federation"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/lifecycle"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/linkmeta"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/links"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/ocr"
 	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/planning"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/routing"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/scanner"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/sheets"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/storage"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/weather"
 
 	"go.uber.org/zap"
 )
@@ -22,20 +39,195 @@ import (
 type mailer interface {
 	SendConfirmTripEmailToTripOwner(uuid.UUID) error
 	SendEmailInvitations(trupID uuid.UUID) error
+	SendParticipantInvitation(participantID uuid.UUID) error
+	SendOwnershipTransferRequest(token string) error
+	SendOwnerEmailChangeRequest(tripID uuid.UUID) error
+	SendCounts() (sent, failed int64)
+	SendWaitlistPromotion(participantID uuid.UUID) error
+	SendDatePollInvitations(tripID uuid.UUID) error
+	SendBudgetApprovalRequest(tripID uuid.UUID, plan string) error
+	SendActivityInvite(activityID uuid.UUID) error
+	SendAttachmentQuarantined(attachmentID uuid.UUID) error
+	SendRideFull(rideID uuid.UUID) error
+	SendRideCanceled(ride pgstore.Ride, passengerIDs []uuid.UUID) error
+	SendTripDatesImpact(tripID uuid.UUID, impact planning.Impact) error
+	SendTripCancelled(tripID uuid.UUID) error
 }
 
 type store interface {
 	GetParticipant(context.Context, uuid.UUID) (pgstore.Participant, error)
+	MarkParticipantsEmailInvalid(ctx context.Context, email string) ([]uuid.UUID, error)
+	CorrectParticipantEmail(ctx context.Context, arg pgstore.CorrectParticipantEmailParams) error
+	CreateOwnershipTransfer(ctx context.Context, arg pgstore.CreateOwnershipTransferParams) error
+	GetOwnershipTransfer(ctx context.Context, token string) (pgstore.OwnershipTransfer, error)
+	TransferOwnership(ctx context.Context, pool *pgxpool.Pool, transfer pgstore.OwnershipTransfer) error
+	CreateOwnerEmailChange(ctx context.Context, arg pgstore.CreateOwnerEmailChangeParams) error
+	GetOwnerEmailChangeByToken(ctx context.Context, token string) (pgstore.OwnerEmailChange, error)
+	ConfirmOwnerEmail(ctx context.Context, pool *pgxpool.Pool, token string) (pgstore.OwnerEmailChange, error)
+	MergeTrips(ctx context.Context, pool *pgxpool.Pool, targetID, sourceID uuid.UUID) (pgstore.TripMerge, error)
+	SetParticipantRole(ctx context.Context, arg pgstore.SetParticipantRoleParams) error
+	SetActivityOrganizer(ctx context.Context, arg pgstore.SetActivityOrganizerParams) error
+	CreateTask(ctx context.Context, arg pgstore.CreateTaskParams) (uuid.UUID, error)
+	GetTask(ctx context.Context, id uuid.UUID) (pgstore.Task, error)
+	GetTripTasks(ctx context.Context, tripID uuid.UUID) ([]pgstore.Task, error)
+	UpdateTask(ctx context.Context, arg pgstore.UpdateTaskParams) error
+	DeleteTask(ctx context.Context, id uuid.UUID) error
+	CreateParticipantGroup(ctx context.Context, pool *pgxpool.Pool, params pgstore.InsertParticipantGroupParams, members []uuid.UUID) (uuid.UUID, error)
+	GetParticipantGroup(ctx context.Context, id uuid.UUID) (pgstore.ParticipantGroup, error)
+	GetTripParticipantGroups(ctx context.Context, tripID uuid.UUID) ([]pgstore.ParticipantGroup, error)
+	UpdateParticipantGroup(ctx context.Context, pool *pgxpool.Pool, group pgstore.ParticipantGroup, name string, members []uuid.UUID) error
+	DeleteParticipantGroup(ctx context.Context, id uuid.UUID) error
+	RemoveOwner(ctx context.Context, pool *pgxpool.Pool, trip pgstore.Trip, participant pgstore.Participant) error
 	ConfirmParticipant(context.Context, uuid.UUID) error
-	CreateTrip(context.Context, *pgxpool.Pool, spec.CreateTripRequest) (uuid.UUID, error)
+	CreateTrip(context.Context, *pgxpool.Pool, spec.CreateTripRequest, bool) (uuid.UUID, error)
 	GetTrip(ctx context.Context, id uuid.UUID) (pgstore.Trip, error)
 	UpdateTrip(ctx context.Context, arg pgstore.UpdateTripParams) error
+	UpdateTripShiftingActivities(ctx context.Context, pool *pgxpool.Pool, params pgstore.UpdateTripParams, days int32) error
+	UpdateTripSettings(ctx context.Context, arg pgstore.UpdateTripSettingsParams) error
 	GetTripActivities(ctx context.Context, tripID uuid.UUID) ([]pgstore.Activity, error)
-	CreateActivity(ctx context.Context, arg pgstore.CreateActivityParams) (uuid.UUID, error)
-	WithTx(tx pgx.Tx) *pgstore.Queries
+	AddActivity(ctx context.Context, pool *pgxpool.Pool, trip pgstore.Trip, params pgstore.CreateActivityParams) (uuid.UUID, string, error)
+	AddDraftActivities(ctx context.Context, pool *pgxpool.Pool, activities []pgstore.CreateActivityParams) ([]uuid.UUID, error)
+	GetActivity(ctx context.Context, id uuid.UUID) (pgstore.Activity, error)
+	ApproveActivity(ctx context.Context, id uuid.UUID) error
+	DeleteActivity(ctx context.Context, id uuid.UUID) error
+	TrashActivity(ctx context.Context, arg pgstore.TrashActivityParams) (pgstore.TrashActivityRow, error)
+	TrashLink(ctx context.Context, arg pgstore.TrashLinkParams) (pgstore.TrashLinkRow, error)
+	RestoreActivity(ctx context.Context, arg pgstore.RestoreActivityParams) (pgstore.RestoreActivityRow, error)
+	RestoreLink(ctx context.Context, arg pgstore.RestoreLinkParams) (pgstore.RestoreLinkRow, error)
+	GetTripTrashedActivities(ctx context.Context, tripID uuid.UUID) ([]pgstore.GetTripTrashedActivitiesRow, error)
+	GetTripTrashedLinks(ctx context.Context, tripID uuid.UUID) ([]pgstore.GetTripTrashedLinksRow, error)
+	RescheduleActivities(ctx context.Context, pool *pgxpool.Pool, params []pgstore.UpdateActivityOccursAtParams) error
+	Tx(tx pgx.Tx) *pgstore.Queries
 	GetTripLinks(ctx context.Context, tripID uuid.UUID) ([]pgstore.Link, error)
+	ListTripLinks(ctx context.Context, arg pgstore.ListTripLinksParams) ([]pgstore.Link, error)
+	ListTripActivities(ctx context.Context, arg pgstore.ListTripActivitiesParams) ([]pgstore.Activity, error)
+	ListTripParticipants(ctx context.Context, arg pgstore.ListTripParticipantsParams) ([]pgstore.Participant, error)
+	GetTripConfirmationSummary(ctx context.Context, tripID uuid.UUID) (pgstore.GetTripConfirmationSummaryRow, error)
+	GetTripInviteSummary(ctx context.Context, id uuid.UUID) (pgstore.GetTripInviteSummaryRow, error)
+	ConfirmParticipantsByOwner(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID, ids []uuid.UUID, remoteAddr string) ([]uuid.UUID, error)
+	CountRecentAuditEvents(ctx context.Context, arg pgstore.CountRecentAuditEventsParams) (pgstore.CountRecentAuditEventsRow, error)
+	InsertAuditEvent(ctx context.Context, arg pgstore.InsertAuditEventParams) error
+	ListTripFeedEvents(ctx context.Context, arg pgstore.ListTripFeedEventsParams) ([]pgstore.AuditEvent, error)
+	GetInstanceTotals(ctx context.Context) (pgstore.GetInstanceTotalsRow, error)
+	CountTripsCreatedPerDay(ctx context.Context, since pgtype.Timestamp) ([]pgstore.CountTripsCreatedPerDayRow, error)
+	GetTableSizes(ctx context.Context) ([]pgstore.GetTableSizesRow, error)
 	CreateTripLink(ctx context.Context, arg pgstore.CreateTripLinkParams) (uuid.UUID, error)
+	CreateTripLinks(ctx context.Context, pool *pgxpool.Pool, links []pgstore.CreateTripLinkParams) ([]uuid.UUID, error)
 	GetParticipants(ctx context.Context, tripID uuid.UUID) ([]pgstore.Participant, error)
+	InviteParticipantsToTrip(ctx context.Context, arg []pgstore.InviteParticipantsToTripParams) (int64, error)
+	CountActiveParticipants(ctx context.Context, tripID uuid.UUID) (int64, error)
+	DeclineParticipant(ctx context.Context, pool *pgxpool.Pool, participant pgstore.Participant, trip pgstore.Trip) ([]uuid.UUID, error)
+	CreateCompanion(ctx context.Context, arg pgstore.CreateCompanionParams) (uuid.UUID, error)
+	GetCompanion(ctx context.Context, id uuid.UUID) (pgstore.Companion, error)
+	GetTripCompanions(ctx context.Context, tripID uuid.UUID) ([]pgstore.Companion, error)
+	RemoveCompanion(ctx context.Context, pool *pgxpool.Pool, companion pgstore.Companion, trip pgstore.Trip) ([]uuid.UUID, error)
+	CreateChecklistItem(ctx context.Context, arg pgstore.CreateChecklistItemParams) (uuid.UUID, error)
+	InsertChecklistItems(ctx context.Context, arg []pgstore.InsertChecklistItemsParams) (int64, error)
+	GetChecklistItem(ctx context.Context, id uuid.UUID) (pgstore.ChecklistItem, error)
+	GetTripChecklistItems(ctx context.Context, tripID uuid.UUID) ([]pgstore.ChecklistItem, error)
+	UpdateChecklistItem(ctx context.Context, arg pgstore.UpdateChecklistItemParams) error
+	DeleteChecklistItem(ctx context.Context, id uuid.UUID) error
+	CreateExpense(ctx context.Context, pool *pgxpool.Pool, params pgstore.InsertExpenseParams, splits []pgstore.InsertExpenseSplitsParams) (uuid.UUID, error)
+	ListTripExpenses(ctx context.Context, arg pgstore.ListTripExpensesParams) ([]pgstore.Expense, error)
+	GetTripExpensesByCategory(ctx context.Context, tripID uuid.UUID) ([]pgstore.GetTripExpensesByCategoryRow, error)
+	GetTripExpensesByDay(ctx context.Context, tripID uuid.UUID) ([]pgstore.GetTripExpensesByDayRow, error)
+	GetTripExpensesByParticipant(ctx context.Context, tripID uuid.UUID) ([]pgstore.GetTripExpensesByParticipantRow, error)
+	CreateReceipt(ctx context.Context, arg pgstore.CreateReceiptParams) (uuid.UUID, error)
+	GetReceipt(ctx context.Context, id uuid.UUID) (pgstore.Receipt, error)
+	GetExpenseReceipt(ctx context.Context, expenseID pgtype.UUID) (pgstore.Receipt, error)
+	GetTripExpenseReceipts(ctx context.Context, tripID uuid.UUID) ([]pgstore.GetTripExpenseReceiptsRow, error)
+	GetReceiptChunk(ctx context.Context, arg pgstore.GetReceiptChunkParams) ([]byte, error)
+	CreateAttachment(ctx context.Context, arg pgstore.CreateAttachmentParams) (uuid.UUID, error)
+	GetAttachment(ctx context.Context, id uuid.UUID) (pgstore.Attachment, error)
+	GetTripAttachmentBytes(ctx context.Context, tripID uuid.UUID) (int64, error)
+	GetTripSheet(ctx context.Context, tripID uuid.UUID) (pgstore.TripSheet, error)
+	GetTripSheetByState(ctx context.Context, state pgtype.Text) (pgstore.TripSheet, error)
+	StartTripSheetConnection(ctx context.Context, arg pgstore.StartTripSheetConnectionParams) error
+	ConnectTripSheet(ctx context.Context, arg pgstore.ConnectTripSheetParams) (pgstore.TripSheet, error)
+	DeleteTripSheet(ctx context.Context, tripID uuid.UUID) (int64, error)
+	ListNewParticipants(ctx context.Context, arg pgstore.ListNewParticipantsParams) ([]pgstore.ListNewParticipantsRow, error)
+	ListNewActivities(ctx context.Context, arg pgstore.ListNewActivitiesParams) ([]pgstore.ListNewActivitiesRow, error)
+	ShareTrip(ctx context.Context, arg pgstore.ShareTripParams) error
+	GetSharedTripID(ctx context.Context, token string) (uuid.UUID, error)
+	UnshareTrip(ctx context.Context, tripID uuid.UUID) (int64, error)
+	HoldTripShare(ctx context.Context, arg pgstore.HoldTripShareParams) (int64, error)
+	InsertContentReport(ctx context.Context, arg pgstore.InsertContentReportParams) (int64, error)
+	CountOpenContentReports(ctx context.Context, tripID uuid.UUID) (int64, error)
+	GetModerationQueue(ctx context.Context) ([]pgstore.GetModerationQueueRow, error)
+	GetOpenContentReports(ctx context.Context) ([]pgstore.ContentReport, error)
+	ApproveSharedTrip(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID, digest string) error
+	RejectSharedTrip(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID) error
+	CompleteAttachment(ctx context.Context, arg pgstore.CompleteAttachmentParams) (pgstore.Attachment, error)
+	SetAttachmentScanResult(ctx context.Context, arg pgstore.SetAttachmentScanResultParams) error
+	CreateExpenseFromReceipt(ctx context.Context, pool *pgxpool.Pool, receiptID uuid.UUID, params pgstore.InsertExpenseParams, splits []pgstore.InsertExpenseSplitsParams) (uuid.UUID, error)
+	GetTripBalances(ctx context.Context, tripID uuid.UUID) ([]pgstore.GetTripBalancesRow, error)
+	UpsertParticipantNeeds(ctx context.Context, arg pgstore.UpsertParticipantNeedsParams) error
+	GetParticipantNeeds(ctx context.Context, participantID uuid.UUID) (pgstore.ParticipantNeed, error)
+	GetTripParticipantNeeds(ctx context.Context, tripID uuid.UUID) ([]pgstore.GetTripParticipantNeedsRow, error)
+	AddLodging(ctx context.Context, pool *pgxpool.Pool, trip pgstore.Trip, params pgstore.CreateLodgingParams) (uuid.UUID, string, error)
+	GetLodging(ctx context.Context, id uuid.UUID) (pgstore.Lodging, error)
+	ApproveLodging(ctx context.Context, id uuid.UUID) error
+	DeleteLodging(ctx context.Context, id uuid.UUID) error
+	GetTripLodgings(ctx context.Context, tripID uuid.UUID) ([]pgstore.Lodging, error)
+	CreateLodgingRoom(ctx context.Context, arg pgstore.CreateLodgingRoomParams) (uuid.UUID, error)
+	GetLodgingRoom(ctx context.Context, id uuid.UUID) (pgstore.LodgingRoom, error)
+	GetLodgingRooms(ctx context.Context, lodgingID uuid.UUID) ([]pgstore.LodgingRoom, error)
+	DeleteLodgingRoom(ctx context.Context, id uuid.UUID) error
+	GetLodgingRoomAssignments(ctx context.Context, lodgingID uuid.UUID) ([]pgstore.RoomAssignment, error)
+	AssignRoom(ctx context.Context, pool *pgxpool.Pool, room pgstore.LodgingRoom, participantIDs []uuid.UUID) error
+	CreateRide(ctx context.Context, arg pgstore.CreateRideParams) (uuid.UUID, error)
+	GetRide(ctx context.Context, id uuid.UUID) (pgstore.Ride, error)
+	GetTripRides(ctx context.Context, tripID uuid.UUID) ([]pgstore.Ride, error)
+	DeleteRide(ctx context.Context, id uuid.UUID) error
+	GetRidePassengers(ctx context.Context, rideID uuid.UUID) ([]pgstore.RidePassenger, error)
+	GetTripRidePassengers(ctx context.Context, tripID uuid.UUID) ([]pgstore.RidePassenger, error)
+	DeleteRidePassenger(ctx context.Context, arg pgstore.DeleteRidePassengerParams) (int64, error)
+	ClaimRideSeats(ctx context.Context, pool *pgxpool.Pool, rideID, participantID uuid.UUID, seats int32) (bool, error)
+	CreateShoppingItem(ctx context.Context, arg pgstore.CreateShoppingItemParams) (uuid.UUID, error)
+	GetShoppingItem(ctx context.Context, id uuid.UUID) (pgstore.ShoppingItem, error)
+	GetTripShoppingItems(ctx context.Context, tripID uuid.UUID) ([]pgstore.ShoppingItem, error)
+	DeleteShoppingItem(ctx context.Context, id uuid.UUID) error
+	ClaimShoppingItem(ctx context.Context, arg pgstore.ClaimShoppingItemParams) (int64, error)
+	UnclaimShoppingItem(ctx context.Context, id uuid.UUID) (int64, error)
+	PurchaseShoppingItem(ctx context.Context, pool *pgxpool.Pool, itemID uuid.UUID, params pgstore.InsertExpenseParams, splits []pgstore.InsertExpenseSplitsParams) (uuid.UUID, error)
+	PayActivity(ctx context.Context, pool *pgxpool.Pool, activityID uuid.UUID, params pgstore.InsertExpenseParams, splits []pgstore.InsertExpenseSplitsParams) (uuid.UUID, error)
+	GetTripActivityEstimates(ctx context.Context, tripID uuid.UUID) ([]pgstore.GetTripActivityEstimatesRow, error)
+	GetTripBudgetTotals(ctx context.Context, tripID uuid.UUID) (pgstore.GetTripBudgetTotalsRow, error)
+	GetTripSurvey(ctx context.Context, tripID uuid.UUID) (pgstore.TripSurvey, error)
+	GetSurveyQuestions(ctx context.Context, tripID uuid.UUID) ([]pgstore.SurveyQuestion, error)
+	ReplaceSurveyQuestions(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID, questions []pgstore.InsertSurveyQuestionsParams) error
+	GetTripTags(ctx context.Context, tripID uuid.UUID) ([]string, error)
+	ReplaceTripTags(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID, tags []string) error
+	GetSurveyToken(ctx context.Context, token string) (pgstore.SurveyToken, error)
+	GetTripSurveyTokens(ctx context.Context, tripID uuid.UUID) ([]pgstore.GetTripSurveyTokensRow, error)
+	UpsertSurveyAnswer(ctx context.Context, arg pgstore.UpsertSurveyAnswerParams) error
+	GetParticipantSurveyAnswers(ctx context.Context, participantID uuid.UUID) ([]pgstore.SurveyAnswer, error)
+	GetTripSurveyAnswers(ctx context.Context, tripID uuid.UUID) ([]pgstore.SurveyAnswer, error)
+	CreateTransport(ctx context.Context, arg pgstore.CreateTransportParams) (uuid.UUID, error)
+	GetTripTransports(ctx context.Context, tripID uuid.UUID) ([]pgstore.Transport, error)
+	CreateDatePoll(ctx context.Context, pool *pgxpool.Pool, options []pgstore.InsertDatePollOptionsParams, participants []uuid.UUID) error
+	GetDatePollOption(ctx context.Context, id uuid.UUID) (pgstore.DatePollOption, error)
+	GetTripDatePollOptions(ctx context.Context, tripID uuid.UUID) ([]pgstore.DatePollOption, error)
+	GetDatePollResults(ctx context.Context, tripID uuid.UUID) ([]pgstore.GetDatePollResultsRow, error)
+	PickDatePollOption(ctx context.Context, pool *pgxpool.Pool, trip pgstore.Trip, option pgstore.DatePollOption) error
+	GetDatePollToken(ctx context.Context, token string) (pgstore.DatePollToken, error)
+	UpsertDatePollVote(ctx context.Context, arg pgstore.UpsertDatePollVoteParams) error
+	GetParticipantDatePollVotes(ctx context.Context, participantID uuid.UUID) ([]pgstore.DatePollVote, error)
+	GetTripExpenseSplits(ctx context.Context, tripID uuid.UUID) ([]pgstore.GetTripExpenseSplitsRow, error)
+	GetTripAuditEvents(ctx context.Context, tripID uuid.UUID) ([]pgstore.AuditEvent, error)
+	GetTripAttachments(ctx context.Context, tripID uuid.UUID) ([]pgstore.Attachment, error)
+	ImportTrip(ctx context.Context, pool *pgxpool.Pool, snapshot pgstore.TripSnapshot, instance string, sourceID uuid.UUID, remoteAddr string) error
+	TransitionTrip(ctx context.Context, arg pgstore.TransitionTripParams) (int64, error)
+	export.Source
+	planning.Source
+}
+
+type forecaster interface {
+	Forecast(ctx context.Context, destination string, from, to time.Time) ([]weather.Day, error)
+}
+
+type geocoder interface {
+	CountryCode(ctx context.Context, destination string) (string, error)
 }
 
 type API struct {
@@ -44,51 +236,124 @@ type API struct {
 	validator *validator.Validate
 	pool      *pgxpool.Pool
 	mailer    mailer
+	weather   forecaster
+	ocr       ocr.Provider
+	geocoder  geocoder
+	routing   routing.Provider
+	files     storage.Provider
+	scanner   scanner.Scanner
+	sheets    sheets.Provider
+	drafter   drafting.Provider
+	linkmeta  linkmeta.Provider
+	instance  federation.Instance
+	stats     *statsCache
+	events    analytics.Sink
+	urls      links.Builder
+
+	blockedDomains map[string]bool
+	creations      *creationLimiter
 }
 
-func NewApi(pool *pgxpool.Pool, logger *zap.Logger, mailer mailer) API {
-	validator := validator.New(validator.WithRequiredStructEnabled())
+func NewApi(pool *pgxpool.Pool, logger *zap.Logger, mailer mailer, weather forecaster, ocr ocr.Provider, geocoder geocoder, routing routing.Provider, files storage.Provider, scanner scanner.Scanner, sheets sheets.Provider, drafter drafting.Provider, linkmeta linkmeta.Provider, instance federation.Instance, events analytics.Sink, urls links.Builder, blockedDomains []string) API {
+	validator := newValidator()
+
+	blocked := make(map[string]bool, len(blockedDomains))
+	for _, domain := range blockedDomains {
+		blocked[strings.ToLower(strings.TrimSpace(domain))] = true
+	}
+
 	return API{
-		pgstore.New(pool),
+		pgstore.NewStore(pool),
 		logger,
 		validator,
 		pool,
 		mailer,
+		weather,
+		ocr,
+		geocoder,
+		routing,
+		files,
+		scanner,
+		sheets,
+		drafter,
+		linkmeta,
+		instance,
+		&statsCache{},
+		events,
+		urls,
+		blocked,
+		&creationLimiter{},
+	}
+}
+
+// lifecycle moves trips through their statuses with the store the API is
+// using, faulty or not.
+func (api *API) lifecycle() lifecycle.Lifecycle {
+	return lifecycle.New(api.store, api.mailer, api.events, api.logger)
+}
+
+// InjectFaults runs the queries of the API through injector, to test how it
+// copes with a failing database. Transactions still run on the pool as is.
+func (api *API) InjectFaults(injector *chaos.Injector) {
+	api.store = pgstore.NewStore(injector.DB(api.pool))
+}
+
+// extendWriteDeadline gives the handler d from now to write its response, for
+// the routes that can take longer than the server write timeout.
+func (api *API) extendWriteDeadline(w http.ResponseWriter, d time.Duration) {
+	err := http.NewResponseController(w).SetWriteDeadline(time.Now().Add(d))
+	if err != nil && !errors.Is(err, http.ErrNotSupported) {
+		api.logger.Warn("failed to extend the write deadline", zap.Error(err))
 	}
 }
 
 // Confirms a participant on a trip.
 // (PATCH /participants/{participantId}/confirm)
 func (api *API) PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request, participantID string) *spec.Response {
-	id, err := uuid.Parse(participantID)
-	if err != nil {
-		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{
-			Message: "invalid uuid",
-		})
+	id, errID := pathID(r.Context(), "participantId", participantID)
+	if errID != nil {
+		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(errID.Error)
 	}
 
 	participant, err := api.store.GetParticipant(r.Context(), id)
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{
+		if errors.Is(err, pgstore.ErrNotFound) {
+			return spec.PatchParticipantsParticipantIDConfirmJSON404Response(spec.Error{
+				Code:    "participant_not_found",
 				Message: "participant not found",
 			})
 		}
 		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", participantID))
 		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{
+			Code:    internalError,
 			Message: "something went wrong, try again",
 		})
 	}
 
 	if participant.IsConfirmed {
 		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{
+			Code:    "participant_already_confirmed",
 			Message: "participant already confirmed",
 		})
 	}
 
+	switch participant.Status {
+	case pgstore.ParticipantWaitlisted:
+		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{
+			Code:    "participant_waitlisted",
+			Message: "participant is on the waitlist",
+		})
+	case pgstore.ParticipantDeclined:
+		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{
+			Code:    "participant_declined",
+			Message: "participant declined the invitation",
+		})
+	}
+
 	if err := api.store.ConfirmParticipant(r.Context(), id); err != nil {
 		api.logger.Error("failed to confim participant", zap.Error(err), zap.String("participant_id", participantID))
 		return spec.PatchParticipantsParticipantIDConfirmJSON400Response(spec.Error{
+			Code:    internalError,
 			Message: "something went wrong, try again",
 		})
 	}
@@ -96,23 +361,152 @@ func (api *API) PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *
 	return spec.PatchParticipantsParticipantIDConfirmJSON204Response(nil)
 }
 
+// Declines a participant invitation.
+// (PATCH /participants/{participantId}/decline)
+func (api *API) PatchParticipantsParticipantIDDecline(w http.ResponseWriter, r *http.Request, participantID string) *spec.Response {
+	id, errID := pathID(r.Context(), "participantId", participantID)
+	if errID != nil {
+		return spec.PatchParticipantsParticipantIDDeclineJSON400Response(errID.Error)
+	}
+
+	participant, err := api.store.GetParticipant(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgstore.ErrNotFound) {
+			return spec.PatchParticipantsParticipantIDDeclineJSON404Response(spec.Error{
+				Code:    "participant_not_found",
+				Message: "participant not found",
+			})
+		}
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.PatchParticipantsParticipantIDDeclineJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	if participant.Status == pgstore.ParticipantDeclined {
+		return spec.PatchParticipantsParticipantIDDeclineJSON400Response(spec.Error{
+			Code:    "participant_already_declined",
+			Message: "participant already declined",
+		})
+	}
+
+	if participant.Role == pgstore.RoleOwner {
+		return spec.PatchParticipantsParticipantIDDeclineJSON400Response(spec.Error{
+			Code:    "owner_cannot_decline",
+			Message: "owners must be removed from the owners before declining",
+		})
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), participant.TripID)
+	if err != nil {
+		api.logger.Error("failed to get trip", zap.Error(err), zap.String("trip_id", participant.TripID.String()))
+		return spec.PatchParticipantsParticipantIDDeclineJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	promoted, err := api.store.DeclineParticipant(r.Context(), api.pool, participant, trip)
+	if err != nil {
+		api.logger.Error("failed to decline participant", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.PatchParticipantsParticipantIDDeclineJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	api.sendWaitlistPromotions(promoted, "PatchParticipantsParticipantIDDecline")
+
+	return spec.PatchParticipantsParticipantIDDeclineJSON204Response(nil)
+}
+
+// sendActivityInvites emails the calendar invites of the activities in the
+// background.
+func (api *API) sendActivityInvites(activityIDs []uuid.UUID, handler string) {
+	for _, activityID := range activityIDs {
+		go func() {
+			if err := api.mailer.SendActivityInvite(activityID); err != nil {
+				api.logger.Error(
+					"failed to send email on "+handler,
+					zap.Error(err),
+					zap.String("activity_id", activityID.String()),
+				)
+			}
+		}()
+	}
+}
+
+// sendWaitlistPromotions lets participants taken off the waitlist know in
+// the background.
+func (api *API) sendWaitlistPromotions(promoted []uuid.UUID, handler string) {
+	for _, participantID := range promoted {
+		go func() {
+			if err := api.mailer.SendWaitlistPromotion(participantID); err != nil {
+				api.logger.Error(
+					"failed to send email on "+handler,
+					zap.Error(err),
+					zap.String("participant_id", participantID.String()),
+				)
+			}
+		}()
+	}
+}
+
 // Create a new trip
 // (POST /trips)
-func (api *API) PostTrips(w http.ResponseWriter, r *http.Request) *spec.Response {
+func (api *API) PostTrips(w http.ResponseWriter, r *http.Request, params spec.PostTripsParams) *spec.Response {
 	var body spec.CreateTripRequest
 
 	err := json.NewDecoder(r.Body).Decode(&body)
 	if err != nil {
-		spec.PostTripsJSON400Response(spec.Error{Message: "invalid json: " + err.Error()})
+		spec.PostTripsJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + err.Error()})
 	}
 
 	if err := api.validator.Struct(body); err != nil {
-		return spec.PostTripsJSON400Response(spec.Error{Message: "invalid input: " + err.Error()})
+		return spec.PostTripsJSON422Response(invalidBody(err))
+	}
+
+	// Only bots fill the honeypot, they are not told they were caught.
+	if body.Website != nil && *body.Website != "" {
+		api.logger.Warn("ignoring trip creation filling the honeypot", zap.String("ip", clientIP(r)))
+		return spec.PostTripsJSON201Response(spec.CreateTripResponse{TripID: uuid.NewString()})
+	}
+
+	if api.blockedDomain(string(body.OwnerEmail)) {
+		api.logger.Warn("refusing trip creation from a blocked email domain", zap.String("ip", clientIP(r)))
+		return spec.PostTripsJSON400Response(spec.Error{Code: "email_not_accepted", Message: "owner email is not accepted, use another one"})
 	}
 
-	tripID, err := api.store.CreateTrip(r.Context(), api.pool, body)
+	if !api.creations.allow(clientIP(r), time.Now()) {
+		api.logger.Warn("refusing trip creation over the limit", zap.String("ip", clientIP(r)))
+		return spec.PostTripsJSON429Response(spec.Error{Code: "rate_limited", Message: "too many trips created, try again later"})
+	}
+
+	if body.Currency != nil {
+		code := strings.ToUpper(*body.Currency)
+		body.Currency = &code
+	} else {
+		body.Currency = api.destinationCurrency(r.Context(), body.Destination)
+	}
+
+	force := params.Force != nil && *params.Force
+	tripID, err := api.store.CreateTrip(r.Context(), api.pool, body, force)
 	if err != nil {
-		return spec.PostTripsJSON400Response(spec.Error{Message: "failed to create trip, try again"})
+		var duplicate *pgstore.DuplicateTripError
+		if errors.As(err, &duplicate) {
+			return spec.PostTripsJSON409Response(spec.DuplicateTripResponse{
+				Message: "an identical trip was just created, set force to create it again",
+				TripID:  duplicate.TripID.String(),
+			})
+		}
+		api.logger.Error("failed to create trip", zap.Error(err))
+		return spec.PostTripsJSON400Response(spec.Error{Code: internalError, Message: "failed to create trip, try again"})
+	}
+
+	api.events.Count(analytics.TripCreated, 1)
+	if len(body.EmailsToInvite) > 0 {
+		api.events.Count(analytics.InviteSent, len(body.EmailsToInvite))
 	}
 
 	go func() {
@@ -130,82 +524,104 @@ func (api *API) PostTrips(w http.ResponseWriter, r *http.Request) *spec.Response
 
 // Get a trip details.
 // (GET /trips/{tripId})
-func (api *API) GetTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
-	id, err := uuid.Parse(tripID)
-	if err != nil {
-		return spec.GetTripsTripIDJSON400Response(spec.Error{
-			Message: "invalid uuid",
-		})
+func (api *API) GetTripsTripID(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDParams) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.GetTripsTripIDJSON400Response(errID.Error)
 	}
 
-	trip, err := api.store.GetTrip(r.Context(), id)
+	fields, err := parseFields(params.Fields, spec.GetTripDetailsResponseTripObj{})
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.GetTripsTripIDJSON400Response(spec.Error{
-				Message: "trip not found",
-			})
-		}
-		api.logger.Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
-		return spec.GetTripsTripIDJSON400Response(spec.Error{
-			Message: "something went wrong, try again",
-		})
+		return spec.GetTripsTripIDJSON400Response(spec.Error{Code: "invalid_fields", Message: "invalid fields: " + err.Error()})
 	}
 
-	responseTrip := spec.GetTripDetailsResponseTripObj{
-		ID:          trip.ID.String(),
-		Destination: trip.Destination,
-		StartsAt:    trip.StartsAt.Time,
-		EndsAt:      trip.EndsAt.Time,
+	trip, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDJSON400Response, spec.GetTripsTripIDJSON404Response)
 	}
 
-	return spec.GetTripsTripIDJSON200Response(spec.GetTripDetailsResponse{Trip: responseTrip})
+	resp := spec.GetTripsTripIDJSON200Response(spec.GetTripDetailsResponse{Trip: serializer.Trip(serializer.OwnerView, trip.Domain())})
+	return api.sparseResponse(w, resp, fields, "trip")
 }
 
 // Update a trip.
 // (PUT /trips/{tripId})
-func (api *API) PutTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
-	id, err := uuid.Parse(tripID)
-	if err != nil {
-		return spec.PutTripsTripIDJSON400Response(spec.Error{
-			Message: "invalid uuid",
-		})
+func (api *API) PutTripsTripID(w http.ResponseWriter, r *http.Request, tripID string, params spec.PutTripsTripIDParams) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.PutTripsTripIDJSON400Response(errID.Error)
 	}
 
-	trip, err := api.store.GetTrip(r.Context(), id)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.PutTripsTripIDJSON400Response(spec.Error{
-				Message: "trip not found",
-			})
-		}
-		api.logger.Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
-		return spec.PutTripsTripIDJSON400Response(spec.Error{
-			Message: "something went wrong, try again",
-		})
+	trip, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PutTripsTripIDJSON400Response, spec.PutTripsTripIDJSON404Response)
 	}
 
 	var body spec.PutTripsTripIDJSONRequestBody
 
 	errJson := json.NewDecoder(r.Body).Decode(&body)
 	if errJson != nil {
-		spec.PutTripsTripIDJSON400Response(spec.Error{Message: "invalid json: " + errJson.Error()})
+		spec.PutTripsTripIDJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + errJson.Error()})
 	}
 
 	if err := api.validator.Struct(body); err != nil {
-		return spec.PutTripsTripIDJSON400Response(spec.Error{Message: "invalid input: " + err.Error()})
+		return spec.PutTripsTripIDJSON422Response(invalidBody(err))
+	}
+
+	datesChanged := !trip.StartsAt.Time.Equal(body.StartsAt) || !trip.EndsAt.Time.Equal(body.EndsAt)
+	dryRun := params.DryRun != nil && *params.DryRun
+
+	var shiftDays int
+	if params.ShiftActivities != nil && *params.ShiftActivities {
+		shiftDays = planning.ShiftDays(trip.StartsAt.Time, body.StartsAt)
+	}
+
+	var impact planning.Impact
+	if datesChanged || dryRun {
+		var err error
+		impact, err = planning.LoadImpact(r.Context(), api.store, trip.Domain(), body.StartsAt, body.EndsAt, shiftDays)
+		if err != nil {
+			api.logger.Error("failed to compute dates impact", zap.Error(err), zap.String("trip_id", tripID))
+			return spec.PutTripsTripIDJSON400Response(spec.Error{Code: internalError, Message: "failed to update trip, try again"})
+		}
 	}
 
-	params := pgstore.UpdateTripParams{
+	if dryRun {
+		return spec.PutTripsTripIDJSON200Response(dateImpactResponse(body.StartsAt, body.EndsAt, shiftDays, impact, true))
+	}
+
+	update := pgstore.UpdateTripParams{
 		ID:          trip.ID,
 		Destination: body.Destination,
-		IsConfirmed: trip.IsConfirmed,
 		StartsAt:    pgtype.Timestamp{Valid: true, Time: body.StartsAt},
 		EndsAt:      pgtype.Timestamp{Valid: true, Time: body.EndsAt},
 	}
+	if body.MaxParticipants != nil {
+		update.MaxParticipants = pgtype.Int4{Valid: true, Int32: int32(*body.MaxParticipants)}
+	}
+	if body.BudgetPerPersonCents != nil {
+		update.BudgetPerPersonCents = pgtype.Int8{Valid: true, Int64: *body.BudgetPerPersonCents}
+	}
 
-	errExec := api.store.UpdateTrip(r.Context(), params)
+	var errExec error
+	if shiftDays != 0 {
+		errExec = api.store.UpdateTripShiftingActivities(r.Context(), api.pool, update, int32(shiftDays))
+	} else {
+		errExec = api.store.UpdateTrip(r.Context(), update)
+	}
 	if errExec != nil {
-		return spec.PutTripsTripIDJSON400Response(spec.Error{Message: "failed to update trip, try again"})
+		api.logger.Error("failed to update trip", zap.Error(errExec), zap.String("trip_id", tripID))
+		return spec.PutTripsTripIDJSON400Response(spec.Error{Code: internalError, Message: "failed to update trip, try again"})
+	}
+
+	if datesChanged {
+		api.recordItineraryChange(r, trip.ID, pgstore.AuditTripDatesChanged, pgstore.ItineraryChange{StartsAt: &body.StartsAt, EndsAt: &body.EndsAt})
+
+		if params.Notify != nil && *params.Notify && !impact.Empty() {
+			api.sendTripDatesImpact(trip.ID, impact)
+		}
+
+		return spec.PutTripsTripIDJSON200Response(dateImpactResponse(body.StartsAt, body.EndsAt, shiftDays, impact, false))
 	}
 
 	return spec.PutTripsTripIDJSON204Response(body)
@@ -213,149 +629,193 @@ func (api *API) PutTripsTripID(w http.ResponseWriter, r *http.Request, tripID st
 
 // Get a trip activities.
 // (GET /trips/{tripId}/activities)
-func (api *API) GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
-	id, err := uuid.Parse(tripID)
+func (api *API) GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDActivitiesParams) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.GetTripsTripIDActivitiesJSON400Response(errID.Error)
+	}
+
+	var organizerID uuid.UUID
+	if params.OrganizerID != nil {
+		var err error
+		organizerID, err = uuid.Parse(*params.OrganizerID)
+		if err != nil {
+			return spec.GetTripsTripIDActivitiesJSON400Response(spec.Error{
+				Code:    "invalid_uuid",
+				Message: "invalid uuid",
+			})
+		}
+	}
+
+	fields, err := parseFields(params.Fields, spec.GetTripActivitiesResponseInnerArray{})
 	if err != nil {
-		return spec.GetTripsTripIDActivitiesJSON400Response(spec.Error{
-			Message: "invalid uuid",
-		})
+		return spec.GetTripsTripIDActivitiesJSON400Response(spec.Error{Code: "invalid_fields", Message: "invalid fields: " + err.Error()})
+	}
+
+	var sort string
+	if params.Sort != nil {
+		sort = *params.Sort
+	}
+	if err := pgstore.CheckSort(sort, pgstore.ActivitySortFields); err != nil {
+		return spec.GetTripsTripIDActivitiesJSON400Response(spec.Error{Code: "invalid_sort", Message: "invalid sort: " + err.Error()})
 	}
 
-	acts, err := api.store.GetTripActivities(r.Context(), id)
+	acts, err := api.store.ListTripActivities(r.Context(), pgstore.ListTripActivitiesParams{TripID: id, Sort: sort})
 	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.GetTripsTripIDActivitiesJSON400Response(spec.Error{
+		if errors.Is(err, pgstore.ErrNotFound) {
+			return spec.GetTripsTripIDActivitiesJSON404Response(spec.Error{
+				Code:    "trip_not_found",
 				Message: "trip not found",
 			})
 		}
 		api.logger.Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
 		return spec.GetTripsTripIDActivitiesJSON400Response(spec.Error{
+			Code:    internalError,
 			Message: "something went wrong, try again",
 		})
 	}
 
-	var responseActs []spec.GetTripActivitiesResponseInnerArray
+	var domainActs []domain.Activity
+	for _, act := range acts {
+		if params.OrganizerID != nil && act.OrganizerID.Bytes != organizerID {
+			continue
+		}
+		domainActs = append(domainActs, act.Domain())
+	}
+
+	resp := spec.GetTripsTripIDActivitiesJSON200Response(spec.GetTripActivitiesResponse{
+		Activities: serializer.ActivityDays(serializer.OwnerView, domainActs),
+	})
+	return api.sparseResponse(w, resp, fields, "activities", "activities")
+}
 
-	for i := 0; i < len(acts); i++ {
-		responseActs = append(responseActs, spec.GetTripActivitiesResponseInnerArray{
-			ID:       acts[i].ID.String(),
-			Title:    acts[i].Title,
-			OccursAt: acts[i].OccursAt.Time,
-		})
+// Create a trip activity.
+// (POST /trips/{tripId}/activities)
+func (api *API) PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	tripUUID, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.PostTripsTripIDActivitiesJSON400Response(errID.Error)
 	}
 
-	var responseActsDates []time.Time
+	trip, errResp := api.getTrip(r.Context(), tripUUID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDActivitiesJSON400Response, spec.PostTripsTripIDActivitiesJSON404Response)
+	}
 
-	for j := 0; j < len(responseActs); j++ {
-		if j == 0 {
-			responseActsDates = append(responseActsDates, responseActs[j].OccursAt)
-		} else {
-			if responseActsDates[j-1] != responseActs[j].OccursAt {
-				responseActsDates = append(responseActsDates, responseActs[j].OccursAt)
-			}
-		}
+	var body spec.CreateActivityRequest
+	if errJson := json.NewDecoder(r.Body).Decode(&body); errJson != nil {
+		return spec.PostTripsTripIDActivitiesJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + errJson.Error()})
 	}
 
-	var responseActsFinal []spec.GetTripActivitiesResponseOuterArray
+	if errVal := api.validator.Struct(body); errVal != nil {
+		return spec.PostTripsTripIDActivitiesJSON422Response(invalidBody(errVal))
+	}
 
-	if len(responseActsDates) == 0 {
-		responseActsFinal = []spec.GetTripActivitiesResponseOuterArray{}
+	tags := make([]string, len(body.Tags))
+	for i, tag := range body.Tags {
+		tags[i] = strings.ToLower(strings.TrimSpace(tag))
 	}
 
-	for x := 0; x < len(responseActsDates); x++ {
-		var actsInner []spec.GetTripActivitiesResponseInnerArray
-		for y := 0; y < len(responseActs); y++ {
-			if responseActs[y].OccursAt == responseActsDates[x] {
-				actsInner = append(actsInner, responseActs[y])
-			}
-		}
-		responseActsFinal = append(
-			responseActsFinal,
-			spec.GetTripActivitiesResponseOuterArray{
-				Activities: actsInner,
-				Date:       responseActsDates[x],
-			},
-		)
+	var duration pgtype.Int4
+	if body.DurationMinutes != nil {
+		duration = pgtype.Int4{Valid: true, Int32: int32(*body.DurationMinutes)}
 	}
 
-	return spec.GetTripsTripIDActivitiesJSON200Response(spec.GetTripActivitiesResponse{responseActsFinal})
-}
+	var cost int64
+	if body.CostCents != nil {
+		cost = *body.CostCents
+	}
 
-// Create a trip activity.
-// (POST /trips/{tripId}/activities)
-func (api *API) PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
-	tripUUID, errUUID := uuid.Parse(tripID)
-	if errUUID != nil {
-		return spec.PostTripsTripIDActivitiesJSON400Response(spec.Error{
-			Message: "invalid uuid",
-		})
+	var currency pgtype.Text
+	if body.Currency != nil {
+		currency = pgtype.Text{Valid: true, String: strings.ToUpper(*body.Currency)}
 	}
 
-	_, err := api.store.GetTrip(r.Context(), tripUUID)
-	if err != nil {
-		if errors.Is(err, pgx.ErrNoRows) {
-			return spec.PostTripsTripIDActivitiesJSON400Response(spec.Error{
-				Message: "trip not found",
-			})
-		}
-		api.logger.Error("failed to get trip", zap.Error(err), zap.String("trip_id", tripID))
-		return spec.PostTripsTripIDActivitiesJSON400Response(spec.Error{
-			Message: "something went wrong, try again",
-		})
+	// Activities with invites start at sequence zero, bumped on every send.
+	var inviteSequence pgtype.Int4
+	if body.SendInvite != nil && *body.SendInvite {
+		inviteSequence = pgtype.Int4{Valid: true}
 	}
 
-	var body spec.CreateActivityRequest
-	id, err := api.store.CreateActivity(r.Context(), pgstore.CreateActivityParams{
-		TripID:   tripUUID,
-		Title:    body.Title,
-		OccursAt: pgtype.Timestamp{Valid: true, Time: body.OccursAt},
+	var latitude, longitude pgtype.Float8
+	if body.Latitude != nil && body.Longitude != nil {
+		latitude = pgtype.Float8{Valid: true, Float64: *body.Latitude}
+		longitude = pgtype.Float8{Valid: true, Float64: *body.Longitude}
+	}
+
+	id, status, err := api.store.AddActivity(r.Context(), api.pool, trip, pgstore.CreateActivityParams{
+		TripID:          tripUUID,
+		Title:           body.Title,
+		OccursAt:        pgtype.Timestamp{Valid: true, Time: body.OccursAt},
+		Tags:            distinct(tags),
+		DurationMinutes: duration,
+		CostCents:       cost,
+		Currency:        currency,
+		Latitude:        latitude,
+		Longitude:       longitude,
+		InviteSequence:  inviteSequence,
 	})
 	if err != nil {
-		return spec.PostTripsTripIDActivitiesJSON400Response(spec.Error{Message: "failed to create activity, try again"})
+		api.logger.Error("failed to add activity", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDActivitiesJSON400Response(spec.Error{Code: internalError, Message: "failed to create activity, try again"})
+	}
+
+	if status == pgstore.PlanPending {
+		api.sendBudgetApprovalRequest(tripUUID, body.Title, "PostTripsTripIDActivities")
+	} else {
+		api.recordItineraryChange(r, tripUUID, pgstore.AuditActivityAdded, pgstore.ItineraryChange{Title: body.Title, OccursAt: &body.OccursAt})
+		if inviteSequence.Valid {
+			api.sendActivityInvites([]uuid.UUID{id}, "PostTripsTripIDActivities")
+		}
 	}
 
-	return spec.PostTripsTripIDActivitiesJSON201Response(spec.CreateActivityResponse{ActivityID: id.String()})
+	api.events.Count(analytics.ActivityAdded, 1)
+
+	return spec.PostTripsTripIDActivitiesJSON201Response(spec.CreateActivityResponse{ActivityID: id.String(), Status: status})
 }
 
 // Confirm a trip and send e-mail invitations.
 // (GET /trips/{tripId}/confirm)
 func (api *API) GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
-	tripUUID, errUUID := uuid.Parse(tripID)
-	if errUUID != nil {
-		return spec.GetTripsTripIDConfirmJSON400Response(spec.Error{
-			Message: "invalid uuid",
-		})
+	tripUUID, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.GetTripsTripIDConfirmJSON400Response(errID.Error)
 	}
 
-	_, errTrip := api.store.GetTrip(r.Context(), tripUUID)
-	if errTrip != nil {
-		if errors.Is(errTrip, pgx.ErrNoRows) {
-			return spec.GetTripsTripIDConfirmJSON400Response(spec.Error{
-				Message: "trip not found",
-			})
-		}
-		api.logger.Error("failed to get trip", zap.Error(errTrip), zap.String("trip_id", tripID))
-		return spec.GetTripsTripIDConfirmJSON400Response(spec.Error{
-			Message: "something went wrong, try again",
-		})
+	trip, errResp := api.getTrip(r.Context(), tripUUID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDConfirmJSON400Response, spec.GetTripsTripIDConfirmJSON404Response)
 	}
 
-	err := api.store.ConfirmParticipant(r.Context(), tripUUID)
-	if err != nil {
-		return spec.GetTripsTripIDConfirmJSON400Response(spec.Error{
-			Message: "failed to confirm participant, try again",
-		})
+	// Confirming again invites the participants added since, the invitations
+	// already sent are not sent twice.
+	status := domain.TripStatus(trip.Status)
+	if status.Confirmed() {
+		go func() {
+			if err := api.mailer.SendEmailInvitations(tripUUID); err != nil {
+				api.logger.Error(
+					"failed to send email on GetTripsTripIDConfirm",
+					zap.Error(err),
+					zap.String("trip_id", tripUUID.String()),
+				)
+			}
+		}()
+		return spec.GetTripsTripIDConfirmJSON204Response(nil)
 	}
 
-	go func() {
-		if err := api.mailer.SendEmailInvitations(tripUUID); err != nil {
-			api.logger.Error(
-				"failed to send email on GetTripsTripIDConfirm",
-				zap.Error(err),
-				zap.String("trip_id", tripUUID.String()),
-			)
+	if err := api.lifecycle().Transition(r.Context(), trip.ID, status, domain.TripConfirmed, r.RemoteAddr); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidTransition):
+			return spec.GetTripsTripIDConfirmJSON400Response(spec.Error{Code: "trip_cancelled", Message: "trip was cancelled"})
+		case errors.Is(err, lifecycle.ErrConflict):
+			return spec.GetTripsTripIDConfirmJSON400Response(spec.Error{Code: "trip_status_changed", Message: "trip status changed meanwhile, try again"})
 		}
-	}()
+		api.logger.Error("failed to confirm trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDConfirmJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to confirm trip, try again",
+		})
+	}
 
 	return spec.GetTripsTripIDConfirmJSON204Response(nil)
 }
@@ -363,110 +823,109 @@ func (api *API) GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request, tr
 // Invite someone to the trip.
 // (POST /trips/{tripId}/invites)
 func (api *API) PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
-	id, errUUID := uuid.Parse(tripID)
-	if errUUID != nil {
-		return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{
-			Message: "invalid uuid",
-		})
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.PostTripsTripIDInvitesJSON400Response(errID.Error)
 	}
 
-	_, errTrip := api.store.GetTrip(r.Context(), id)
-	if errTrip != nil {
-		if errors.Is(errTrip, pgx.ErrNoRows) {
-			return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{
-				Message: "trip not found",
-			})
-		}
-		api.logger.Error("failed to get trip", zap.Error(errTrip), zap.String("trip_id", tripID))
-		return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{
-			Message: "something went wrong, try again",
-		})
+	trip, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDInvitesJSON400Response, spec.PostTripsTripIDInvitesJSON404Response)
 	}
 
 	var body spec.PostTripsTripIDInvitesJSONBody
 
 	errJson := json.NewDecoder(r.Body).Decode(&body)
 	if errJson != nil {
-		spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Message: "invalid json: " + errJson.Error()})
+		return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + errJson.Error()})
 	}
 
 	if errVal := api.validator.Struct(body); errVal != nil {
-		return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{Message: "invalid input: " + errVal.Error()})
+		return spec.PostTripsTripIDInvitesJSON422Response(invalidBody(errVal))
 	}
 
 	tx, errTx := api.pool.Begin(r.Context())
 	if errTx != nil {
+		api.logger.Error("failed to begin tx for invites", zap.Error(errTx), zap.String("trip_id", tripID))
 		return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{
-			Message: "pgstore: failed to begin tx for PostTripsTripIDInvites",
+			Code:    internalError,
+			Message: "failed to invite participant, try again",
 		})
 	}
 	defer func() { _ = tx.Rollback(r.Context()) }()
 
-	qtx := api.store.WithTx(tx)
+	qtx := api.store.Tx(tx)
+
+	active, errCount := qtx.CountActiveParticipants(r.Context(), id)
+	if errCount != nil {
+		api.logger.Error("failed to count participants for invites", zap.Error(errCount), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to invite participant, try again",
+		})
+	}
 
 	participants := make([]pgstore.InviteParticipantsToTripParams, 1)
 	participants[0] = pgstore.InviteParticipantsToTripParams{
 		TripID: id,
 		Email:  string(body.Email),
+		Status: pgstore.InviteStatuses(trip.MaxParticipants, active, 1)[0],
 	}
 
 	if _, errExe := qtx.InviteParticipantsToTrip(r.Context(), participants); errExe != nil {
+		api.logger.Error("failed to insert invited participants", zap.Error(errExe), zap.String("trip_id", tripID))
 		return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{
-			Message: "pgstore: failed to insert participants for PostTripsTripIDInvites",
+			Code:    internalError,
+			Message: "failed to invite participant, try again",
 		})
 	}
 
 	if errCom := tx.Commit(r.Context()); errCom != nil {
+		api.logger.Error("failed to commit tx for invites", zap.Error(errCom), zap.String("trip_id", tripID))
 		return spec.PostTripsTripIDInvitesJSON400Response(spec.Error{
-			Message: "pgstore: failed to commit tx for PostTripsTripIDInvites",
+			Code:    internalError,
+			Message: "failed to invite participant, try again",
 		})
 	}
 
+	api.events.Count(analytics.InviteSent, 1)
+
 	return spec.PostTripsTripIDInvitesJSON201Response(nil)
 }
 
 // Get a trip links.
 // (GET /trips/{tripId}/links)
-func (api *API) GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
-	id, errUUID := uuid.Parse(tripID)
-	if errUUID != nil {
-		return spec.GetTripsTripIDLinksJSON400Response(spec.Error{
-			Message: "invalid uuid",
-		})
+func (api *API) GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDLinksParams) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.GetTripsTripIDLinksJSON400Response(errID.Error)
 	}
 
-	_, errTrip := api.store.GetTrip(r.Context(), id)
-	if errTrip != nil {
-		if errors.Is(errTrip, pgx.ErrNoRows) {
-			return spec.GetTripsTripIDLinksJSON400Response(spec.Error{
-				Message: "trip not found",
-			})
-		}
-		api.logger.Error("failed to get trip", zap.Error(errTrip), zap.String("trip_id", tripID))
-		return spec.GetTripsTripIDLinksJSON400Response(spec.Error{
-			Message: "something went wrong, try again",
-		})
+	var sort string
+	if params.Sort != nil {
+		sort = *params.Sort
+	}
+	if err := pgstore.CheckSort(sort, pgstore.LinkSortFields); err != nil {
+		return spec.GetTripsTripIDLinksJSON400Response(spec.Error{Code: "invalid_sort", Message: "invalid sort: " + err.Error()})
 	}
 
-	links, errExec := api.store.GetTripLinks(r.Context(), id)
+	_, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDLinksJSON400Response, spec.GetTripsTripIDLinksJSON404Response)
+	}
+
+	links, errExec := api.store.ListTripLinks(r.Context(), pgstore.ListTripLinksParams{TripID: id, Sort: sort})
 	if errExec != nil {
+		api.logger.Error("failed to list trip links", zap.Error(errExec), zap.String("trip_id", tripID))
 		return spec.GetTripsTripIDLinksJSON400Response(spec.Error{
-			Message: "links for found",
+			Code:    internalError,
+			Message: "fail to get trip links",
 		})
 	}
 
-	var responseLinks []spec.GetLinksResponseArray
-
-	if len(links) == 0 {
-		responseLinks = []spec.GetLinksResponseArray{}
-	}
-
+	responseLinks := make([]spec.GetLinksResponseArray, 0, len(links))
 	for _, link := range links {
-		responseLinks = append(responseLinks, spec.GetLinksResponseArray{
-			ID:    link.ID.String(),
-			Title: link.Title,
-			URL:   link.Url,
-		})
+		responseLinks = append(responseLinks, serializer.Link(link.Domain()))
 	}
 
 	return spec.GetTripsTripIDLinksJSON200Response(spec.GetLinksResponse{Links: responseLinks})
@@ -475,34 +934,24 @@ func (api *API) GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request, trip
 // Create a trip link.
 // (POST /trips/{tripId}/links)
 func (api *API) PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
-	id, errUUID := uuid.Parse(tripID)
-	if errUUID != nil {
-		return spec.PostTripsTripIDLinksJSON400Response(spec.Error{
-			Message: "invalid uuid",
-		})
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.PostTripsTripIDLinksJSON400Response(errID.Error)
 	}
 
-	_, errTrip := api.store.GetTrip(r.Context(), id)
-	if errTrip != nil {
-		if errors.Is(errTrip, pgx.ErrNoRows) {
-			return spec.PostTripsTripIDLinksJSON400Response(spec.Error{
-				Message: "trip not found",
-			})
-		}
-		api.logger.Error("failed to get trip", zap.Error(errTrip), zap.String("trip_id", tripID))
-		return spec.PostTripsTripIDLinksJSON400Response(spec.Error{
-			Message: "something went wrong, try again",
-		})
+	_, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDLinksJSON400Response, spec.PostTripsTripIDLinksJSON404Response)
 	}
 
 	var body spec.PostTripsTripIDLinksJSONBody
 	errJson := json.NewDecoder(r.Body).Decode(&body)
 	if errJson != nil {
-		return spec.PostTripsTripIDLinksJSON400Response(spec.Error{Message: "invalid json: " + errJson.Error()})
+		return spec.PostTripsTripIDLinksJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + errJson.Error()})
 	}
 
 	if errVal := api.validator.Struct(body); errVal != nil {
-		return spec.PostTripsTripIDLinksJSON400Response(spec.Error{Message: "invalid input: " + errVal.Error()})
+		return spec.PostTripsTripIDLinksJSON422Response(invalidBody(errVal))
 	}
 
 	uuid, err := api.store.CreateTripLink(r.Context(), pgstore.CreateTripLinkParams{
@@ -512,54 +961,89 @@ func (api *API) PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tri
 	})
 	if err != nil {
 		return spec.PostTripsTripIDLinksJSON400Response(spec.Error{
+			Code:    internalError,
 			Message: "fail to insert trip link",
 		})
 	}
 
+	api.recordItineraryChange(r, id, pgstore.AuditLinkAdded, pgstore.ItineraryChange{Title: body.Title, URL: body.URL})
+
 	return spec.PostTripsTripIDLinksJSON201Response(spec.CreateLinkResponse{LinkID: uuid.String()})
 }
 
 // Get a trip participants.
 // (GET /trips/{tripId}/participants)
-func (api *API) GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
-	id, errUUID := uuid.Parse(tripID)
-	if errUUID != nil {
-		return spec.GetTripsTripIDParticipantsJSON400Response(spec.Error{
-			Message: "invalid uuid",
-		})
+func (api *API) GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDParticipantsParams) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.GetTripsTripIDParticipantsJSON400Response(errID.Error)
+	}
+
+	fields, err := parseFields(params.Fields, spec.GetTripParticipantsResponseArray{})
+	if err != nil {
+		return spec.GetTripsTripIDParticipantsJSON400Response(spec.Error{Code: "invalid_fields", Message: "invalid fields: " + err.Error()})
+	}
+
+	var sort string
+	if params.Sort != nil {
+		sort = *params.Sort
+	}
+	if err := pgstore.CheckSort(sort, pgstore.ParticipantSortFields); err != nil {
+		return spec.GetTripsTripIDParticipantsJSON400Response(spec.Error{Code: "invalid_sort", Message: "invalid sort: " + err.Error()})
 	}
 
-	_, errTrip := api.store.GetTrip(r.Context(), id)
-	if errTrip != nil {
-		if errors.Is(errTrip, pgx.ErrNoRows) {
+	_, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDParticipantsJSON400Response, spec.GetTripsTripIDParticipantsJSON404Response)
+	}
+
+	var groupID uuid.UUID
+	if params.GroupID != nil {
+		groupID, err = uuid.Parse(*params.GroupID)
+		if err != nil {
 			return spec.GetTripsTripIDParticipantsJSON400Response(spec.Error{
-				Message: "trip not found",
+				Code:    "invalid_uuid",
+				Message: "invalid uuid",
 			})
 		}
-		api.logger.Error("failed to get trip", zap.Error(errTrip), zap.String("trip_id", tripID))
+	}
+
+	parts, err := api.store.ListTripParticipants(r.Context(), pgstore.ListTripParticipantsParams{TripID: id, Sort: sort})
+	if err != nil {
 		return spec.GetTripsTripIDParticipantsJSON400Response(spec.Error{
-			Message: "something went wrong, try again",
+			Code:    internalError,
+			Message: "fail to get trip participants",
 		})
 	}
 
-	parts, err := api.store.GetParticipants(r.Context(), id)
+	companions, err := api.store.GetTripCompanions(r.Context(), id)
 	if err != nil {
+		api.logger.Error("failed to get companions", zap.Error(err), zap.String("trip_id", tripID))
 		return spec.GetTripsTripIDParticipantsJSON400Response(spec.Error{
+			Code:    internalError,
 			Message: "fail to get trip participants",
 		})
 	}
 
+	companionsOf := make(map[uuid.UUID][]spec.GetTripParticipantsResponseCompanionArray)
+	for _, companion := range companions {
+		companionsOf[companion.ParticipantID] = append(companionsOf[companion.ParticipantID], spec.GetTripParticipantsResponseCompanionArray{
+			ID:   companion.ID.String(),
+			Name: companion.Name,
+		})
+	}
+
 	var responseParts []spec.GetTripParticipantsResponseArray
 	for _, part := range parts {
-		responseParts = append(responseParts, spec.GetTripParticipantsResponseArray{
-			ID:          part.ID.String(),
-			Email:       types.Email(part.Email),
-			IsConfirmed: part.IsConfirmed,
-			Name:        &part.Email,
-		})
+		if params.GroupID != nil && part.GroupID.Bytes != groupID {
+			continue
+		}
+
+		responseParts = append(responseParts, serializer.Participant(serializer.OwnerView, part.Domain(), companionsOf[part.ID]))
 	}
 
-	return spec.GetTripsTripIDParticipantsJSON200Response(spec.GetTripParticipantsResponse{
+	resp := spec.GetTripsTripIDParticipantsJSON200Response(spec.GetTripParticipantsResponse{
 		Participants: responseParts,
 	})
+	return api.sparseResponse(w, resp, fields, "participants")
 }
@@ -0,0 +1,415 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/images"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/scanner"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/storage"
+	"go.uber.org/zap"
+)
+
+const (
+	maxAttachmentSize = 25 << 20
+
+	// attachmentQuota is how much storage the attachments of a trip can take,
+	// leaving out infected files, which are deleted.
+	attachmentQuota = 500 << 20
+
+	// attachmentUploadExpiry is how long clients have to start uploading
+	// once they were given where to.
+	attachmentUploadExpiry = 15 * time.Minute
+
+	// attachmentScanTimeout bounds downloading and scanning an attachment.
+	attachmentScanTimeout = 5 * time.Minute
+)
+
+var attachmentContentTypes = map[string]bool{
+	"image/jpeg":      true,
+	"image/png":       true,
+	"image/heic":      true,
+	"application/pdf": true,
+}
+
+// Start uploading a trip attachment.
+// (POST /trips/{tripId}/attachments/presign)
+func (api *API) PostTripsTripIDAttachmentsPresign(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.PostTripsTripIDAttachmentsPresignJSON400Response(errID.Error)
+	}
+
+	_, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDAttachmentsPresignJSON400Response, spec.PostTripsTripIDAttachmentsPresignJSON404Response)
+	}
+
+	var body spec.PostTripsTripIDAttachmentsPresignJSONBody
+	if errJson := json.NewDecoder(r.Body).Decode(&body); errJson != nil {
+		return spec.PostTripsTripIDAttachmentsPresignJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + errJson.Error()})
+	}
+
+	if errVal := api.validator.Struct(body); errVal != nil {
+		return spec.PostTripsTripIDAttachmentsPresignJSON422Response(invalidBody(errVal))
+	}
+
+	if !attachmentContentTypes[body.ContentType] {
+		return spec.PostTripsTripIDAttachmentsPresignJSON400Response(spec.Error{
+			Code:    "unsupported_file",
+			Message: "attachment must be a jpeg, png or heic image or a pdf",
+		})
+	}
+
+	if body.SizeBytes > maxAttachmentSize {
+		return spec.PostTripsTripIDAttachmentsPresignJSON400Response(spec.Error{
+			Code:    "file_too_large",
+			Message: "attachment must be at most " + strconv.Itoa(maxAttachmentSize>>20) + "MB",
+		})
+	}
+
+	if errResp := api.checkAttachmentQuota(r.Context(), id, body.SizeBytes); errResp != nil {
+		return spec.PostTripsTripIDAttachmentsPresignJSON400Response(errResp.Error)
+	}
+
+	var uploadedBy pgtype.UUID
+	if body.ParticipantID != nil {
+		participantID, err := uuid.Parse(*body.ParticipantID)
+		if err != nil {
+			return spec.PostTripsTripIDAttachmentsPresignJSON400Response(spec.Error{Code: "invalid_uuid", Message: "invalid uuid"})
+		}
+
+		participant, err := api.store.GetParticipant(r.Context(), participantID)
+		if err != nil || participant.TripID != id {
+			if err != nil && !errors.Is(err, pgstore.ErrNotFound) {
+				api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", *body.ParticipantID))
+				return spec.PostTripsTripIDAttachmentsPresignJSON400Response(spec.Error{
+					Code:    internalError,
+					Message: "something went wrong, try again",
+				})
+			}
+			return spec.PostTripsTripIDAttachmentsPresignJSON404Response(spec.Error{
+				Code:    "participant_not_found",
+				Message: "participant not found",
+			})
+		}
+		uploadedBy = pgtype.UUID{Valid: true, Bytes: participant.ID}
+	}
+
+	attachmentID, err := api.store.CreateAttachment(r.Context(), pgstore.CreateAttachmentParams{
+		TripID:      id,
+		Filename:    body.Filename,
+		ContentType: body.ContentType,
+		UploadedBy:  uploadedBy,
+	})
+	if err != nil {
+		api.logger.Error("failed to insert attachment", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDAttachmentsPresignJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "fail to insert attachment",
+		})
+	}
+
+	upload, err := api.files.PresignUpload(r.Context(), pgstore.AttachmentKey(id, attachmentID), body.ContentType, attachmentUploadExpiry)
+	if err != nil {
+		if errors.Is(err, storage.ErrDisabled) {
+			return spec.PostTripsTripIDAttachmentsPresignJSON400Response(spec.Error{
+				Code:    "feature_disabled",
+				Message: "attachments are not enabled",
+			})
+		}
+		api.logger.Error("failed to presign attachment upload", zap.Error(err), zap.String("attachment_id", attachmentID.String()))
+		return spec.PostTripsTripIDAttachmentsPresignJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	response := spec.PresignAttachmentResponse{
+		AttachmentID: attachmentID.String(),
+		Method:       upload.Method,
+		UploadURL:    upload.URL,
+		ExpiresAt:    upload.ExpiresAt,
+	}
+	for name := range upload.Header {
+		response.Headers.Set(name, upload.Header.Get(name))
+	}
+
+	return spec.PostTripsTripIDAttachmentsPresignJSON201Response(response)
+}
+
+// Complete a trip attachment upload.
+// (POST /trips/{tripId}/attachments/{attachmentId}/complete)
+func (api *API) PostTripsTripIDAttachmentsAttachmentIDComplete(w http.ResponseWriter, r *http.Request, tripID string, attachmentID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.PostTripsTripIDAttachmentsAttachmentIDCompleteJSON400Response(errID.Error)
+	}
+
+	attachmentUUID, errID := pathID(r.Context(), "attachmentId", attachmentID)
+	if errID != nil {
+		return spec.PostTripsTripIDAttachmentsAttachmentIDCompleteJSON400Response(errID.Error)
+	}
+
+	attachment, err := api.store.GetAttachment(r.Context(), attachmentUUID)
+	if err != nil || attachment.TripID != id {
+		if err != nil && !errors.Is(err, pgstore.ErrNotFound) {
+			api.logger.Error("failed to get attachment", zap.Error(err), zap.String("attachment_id", attachmentID))
+			return spec.PostTripsTripIDAttachmentsAttachmentIDCompleteJSON400Response(spec.Error{
+				Code:    internalError,
+				Message: "something went wrong, try again",
+			})
+		}
+		return spec.PostTripsTripIDAttachmentsAttachmentIDCompleteJSON404Response(spec.Error{
+			Code:    "attachment_not_found",
+			Message: "attachment not found",
+		})
+	}
+
+	if attachment.UploadedAt.Valid {
+		return spec.PostTripsTripIDAttachmentsAttachmentIDCompleteJSON200Response(attachmentResponse(attachment))
+	}
+
+	key := pgstore.AttachmentKey(id, attachment.ID)
+	object, err := api.files.Stat(r.Context(), key)
+	if err != nil {
+		switch {
+		case errors.Is(err, storage.ErrNotFound):
+			return spec.PostTripsTripIDAttachmentsAttachmentIDCompleteJSON400Response(spec.Error{
+				Code:    "attachment_not_uploaded",
+				Message: "attachment was not uploaded",
+			})
+		case errors.Is(err, storage.ErrDisabled):
+			return spec.PostTripsTripIDAttachmentsAttachmentIDCompleteJSON400Response(spec.Error{
+				Code:    "feature_disabled",
+				Message: "attachments are not enabled",
+			})
+		}
+		api.logger.Error("failed to get uploaded attachment", zap.Error(err), zap.String("attachment_id", attachmentID))
+		return spec.PostTripsTripIDAttachmentsAttachmentIDCompleteJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	// The size announced when the upload started can not be enforced by the
+	// storage, so files are checked once they are there.
+	if object.Size > maxAttachmentSize {
+		if err := api.files.Delete(r.Context(), key); err != nil {
+			api.logger.Error("failed to delete oversized attachment", zap.Error(err), zap.String("attachment_id", attachmentID))
+		}
+		return spec.PostTripsTripIDAttachmentsAttachmentIDCompleteJSON400Response(spec.Error{
+			Code:    "file_too_large",
+			Message: "attachment must be at most " + strconv.Itoa(maxAttachmentSize>>20) + "MB",
+		})
+	}
+
+	// Other uploads may have completed since this one started.
+	if errResp := api.checkAttachmentQuota(r.Context(), id, object.Size); errResp != nil {
+		if err := api.files.Delete(r.Context(), key); err != nil {
+			api.logger.Error("failed to delete attachment over quota", zap.Error(err), zap.String("attachment_id", attachmentID))
+		}
+		return spec.PostTripsTripIDAttachmentsAttachmentIDCompleteJSON400Response(errResp.Error)
+	}
+
+	trip, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDAttachmentsAttachmentIDCompleteJSON400Response, spec.PostTripsTripIDAttachmentsAttachmentIDCompleteJSON404Response)
+	}
+
+	if errResp := api.checkAttachmentFile(r.Context(), attachment, trip.Settings); errResp != nil {
+		return spec.PostTripsTripIDAttachmentsAttachmentIDCompleteJSON400Response(errResp.Error)
+	}
+
+	completed, err := api.store.CompleteAttachment(r.Context(), pgstore.CompleteAttachmentParams{
+		SizeBytes: pgtype.Int8{Valid: true, Int64: object.Size},
+		ID:        attachment.ID,
+	})
+	if err != nil {
+		api.logger.Error("failed to complete attachment", zap.Error(err), zap.String("attachment_id", attachmentID))
+		return spec.PostTripsTripIDAttachmentsAttachmentIDCompleteJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to complete attachment, try again",
+		})
+	}
+
+	api.scanAttachment(completed)
+
+	return spec.PostTripsTripIDAttachmentsAttachmentIDCompleteJSON200Response(attachmentResponse(completed))
+}
+
+// Get a trip attachment.
+// (GET /trips/{tripId}/attachments/{attachmentId})
+func (api *API) GetTripsTripIDAttachmentsAttachmentID(w http.ResponseWriter, r *http.Request, tripID string, attachmentID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.GetTripsTripIDAttachmentsAttachmentIDJSON400Response(errID.Error)
+	}
+
+	attachmentUUID, errID := pathID(r.Context(), "attachmentId", attachmentID)
+	if errID != nil {
+		return spec.GetTripsTripIDAttachmentsAttachmentIDJSON400Response(errID.Error)
+	}
+
+	attachment, err := api.store.GetAttachment(r.Context(), attachmentUUID)
+	if err != nil || attachment.TripID != id {
+		if err != nil && !errors.Is(err, pgstore.ErrNotFound) {
+			api.logger.Error("failed to get attachment", zap.Error(err), zap.String("attachment_id", attachmentID))
+			return spec.GetTripsTripIDAttachmentsAttachmentIDJSON400Response(spec.Error{
+				Code:    internalError,
+				Message: "something went wrong, try again",
+			})
+		}
+		return spec.GetTripsTripIDAttachmentsAttachmentIDJSON404Response(spec.Error{
+			Code:    "attachment_not_found",
+			Message: "attachment not found",
+		})
+	}
+
+	return spec.GetTripsTripIDAttachmentsAttachmentIDJSON200Response(attachmentResponse(attachment))
+}
+
+// scanAttachment scans the file of an uploaded attachment in the background.
+// Infected files are moved to the quarantine and whoever uploaded them, or
+// the trip owner, is told by email.
+func (api *API) scanAttachment(attachment pgstore.Attachment) {
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), attachmentScanTimeout)
+		defer cancel()
+
+		logger := api.logger.With(zap.String("attachment_id", attachment.ID.String()))
+		key := pgstore.AttachmentKey(attachment.TripID, attachment.ID)
+
+		result := pgstore.SetAttachmentScanResultParams{ID: attachment.ID, ScanStatus: pgstore.ScanClean}
+		found, err := api.scanFile(ctx, key)
+		switch {
+		case errors.Is(err, scanner.ErrDisabled):
+			result.ScanStatus = pgstore.ScanUnscanned
+		case err != nil:
+			logger.Error("failed to scan attachment", zap.Error(err))
+			result.ScanStatus = pgstore.ScanFailed
+		case found.Infected:
+			logger.Warn("attachment is infected", zap.String("signature", found.Signature))
+			result.ScanStatus = pgstore.ScanInfected
+			result.ScanSignature = pgtype.Text{Valid: true, String: found.Signature}
+
+			if err := api.files.Copy(ctx, key, pgstore.QuarantineKey(attachment.TripID, attachment.ID)); err != nil {
+				// The file is deleted all the same, it must not stay reachable.
+				logger.Error("failed to quarantine attachment", zap.Error(err))
+			}
+			if err := api.files.Delete(ctx, key); err != nil {
+				logger.Error("failed to delete infected attachment", zap.Error(err))
+			}
+		}
+
+		if err := api.store.SetAttachmentScanResult(ctx, result); err != nil {
+			logger.Error("failed to save attachment scan result", zap.Error(err))
+			return
+		}
+
+		if result.ScanStatus == pgstore.ScanInfected {
+			if err := api.mailer.SendAttachmentQuarantined(attachment.ID); err != nil {
+				logger.Error("failed to send email on scanAttachment", zap.Error(err))
+			}
+		}
+	}()
+}
+
+func (api *API) scanFile(ctx context.Context, key string) (scanner.Result, error) {
+	file, err := api.files.Open(ctx, key)
+	if err != nil {
+		return scanner.Result{}, err
+	}
+	defer file.Close()
+
+	return api.scanner.Scan(ctx, file)
+}
+
+// checkAttachmentQuota makes sure adding size bytes to the attachments of the
+// trip keeps it within its quota.
+func (api *API) checkAttachmentQuota(ctx context.Context, tripID uuid.UUID, size int64) *apiError {
+	used, err := api.store.GetTripAttachmentBytes(ctx, tripID)
+	if err != nil {
+		api.logger.Error("failed to get trip attachment usage", zap.Error(err), zap.String("trip_id", tripID.String()))
+		return badRequest(internalError, "something went wrong, try again")
+	}
+
+	if used+size > attachmentQuota {
+		return badRequest("quota_exceeded", "trip attachments can take at most "+strconv.Itoa(attachmentQuota>>20)+"MB")
+	}
+	return nil
+}
+
+// checkAttachmentFile makes sure the uploaded file is what the attachment says
+// it is, discarding it otherwise, and removes the location from photos unless
+// the trip keeps it.
+func (api *API) checkAttachmentFile(ctx context.Context, attachment pgstore.Attachment, settings pgstore.TripSettings) *apiError {
+	logger := api.logger.With(zap.String("attachment_id", attachment.ID.String()))
+	key := pgstore.AttachmentKey(attachment.TripID, attachment.ID)
+
+	file, err := api.files.Open(ctx, key)
+	if err != nil {
+		logger.Error("failed to open uploaded attachment", zap.Error(err))
+		return badRequest(internalError, "something went wrong, try again")
+	}
+	defer file.Close()
+
+	data, err := io.ReadAll(io.LimitReader(file, maxAttachmentSize))
+	if err != nil {
+		logger.Error("failed to read uploaded attachment", zap.Error(err))
+		return badRequest(internalError, "something went wrong, try again")
+	}
+
+	reject := func(code, message string) *apiError {
+		if err := api.files.Delete(ctx, key); err != nil {
+			logger.Error("failed to delete rejected attachment", zap.Error(err))
+		}
+		return badRequest(code, message)
+	}
+
+	// The type was only announced by the client, the content has to match.
+	if images.Sniff(data) != attachment.ContentType {
+		return reject("unsupported_file", "attachment content is not "+attachment.ContentType)
+	}
+
+	if settings.KeepPhotoLocation {
+		return nil
+	}
+
+	stripped, err := images.StripGPS(data)
+	if err != nil {
+		return reject("invalid_file", "attachment has invalid EXIF data")
+	}
+	if stripped {
+		if err := api.files.Put(ctx, key, attachment.ContentType, data); err != nil {
+			logger.Error("failed to replace attachment without location", zap.Error(err))
+			return reject(internalError, "something went wrong, try again")
+		}
+	}
+
+	return nil
+}
+
+func attachmentResponse(attachment pgstore.Attachment) spec.AttachmentResponse {
+	response := spec.AttachmentResponse{
+		ID:          attachment.ID.String(),
+		Filename:    attachment.Filename,
+		ContentType: attachment.ContentType,
+		SizeBytes:   attachment.SizeBytes.Int64,
+		UploadedAt:  attachment.UploadedAt.Time,
+		ScanStatus:  attachment.ScanStatus,
+	}
+	if attachment.ScanSignature.Valid {
+		response.ScanSignature = &attachment.ScanSignature.String
+	}
+	return response
+}
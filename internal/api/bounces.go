@@ -0,0 +1,119 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+// Report a bounced email.
+// (POST /mail/bounces)
+func (api *API) PostMailBounces(w http.ResponseWriter, r *http.Request) *spec.Response {
+	var body spec.MailBounceRequest
+	if errJson := json.NewDecoder(r.Body).Decode(&body); errJson != nil {
+		return spec.PostMailBouncesJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + errJson.Error()})
+	}
+
+	if errVal := api.validator.Struct(body); errVal != nil {
+		return spec.PostMailBouncesJSON422Response(invalidBody(errVal))
+	}
+
+	// Soft bounces are retried by the provider and may still be delivered.
+	if body.Type != "hard" {
+		return spec.PostMailBouncesJSON204Response(nil)
+	}
+
+	ids, err := api.store.MarkParticipantsEmailInvalid(r.Context(), string(body.Email))
+	if err != nil {
+		api.logger.Error("failed to mark participants email invalid", zap.Error(err))
+		return spec.PostMailBouncesJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	for _, id := range ids {
+		api.logger.Info("participant invite bounced", zap.String("participant_id", id.String()))
+	}
+
+	return spec.PostMailBouncesJSON204Response(nil)
+}
+
+// Correct a participant email.
+// (PATCH /trips/{tripId}/participants/{participantId}/email)
+func (api *API) PatchTripsTripIDParticipantsParticipantIDEmail(w http.ResponseWriter, r *http.Request, tripID string, participantID string) *spec.Response {
+	tripUUID, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.PatchTripsTripIDParticipantsParticipantIDEmailJSON400Response(errID.Error)
+	}
+
+	id, errID := pathID(r.Context(), "participantId", participantID)
+	if errID != nil {
+		return spec.PatchTripsTripIDParticipantsParticipantIDEmailJSON400Response(errID.Error)
+	}
+
+	participant, err := api.store.GetParticipant(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgstore.ErrNotFound) {
+			return spec.PatchTripsTripIDParticipantsParticipantIDEmailJSON404Response(spec.Error{
+				Code:    "participant_not_found",
+				Message: "participant not found",
+			})
+		}
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.PatchTripsTripIDParticipantsParticipantIDEmailJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	if participant.TripID != tripUUID {
+		return spec.PatchTripsTripIDParticipantsParticipantIDEmailJSON404Response(spec.Error{
+			Code:    "participant_not_found",
+			Message: "participant not found",
+		})
+	}
+
+	if participant.Status != pgstore.ParticipantEmailInvalid {
+		return spec.PatchTripsTripIDParticipantsParticipantIDEmailJSON400Response(spec.Error{
+			Code:    "email_not_bounced",
+			Message: "participant email did not bounce",
+		})
+	}
+
+	var body spec.CorrectParticipantEmailRequest
+	if errJson := json.NewDecoder(r.Body).Decode(&body); errJson != nil {
+		return spec.PatchTripsTripIDParticipantsParticipantIDEmailJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + errJson.Error()})
+	}
+
+	if errVal := api.validator.Struct(body); errVal != nil {
+		return spec.PatchTripsTripIDParticipantsParticipantIDEmailJSON422Response(invalidBody(errVal))
+	}
+
+	if err := api.store.CorrectParticipantEmail(r.Context(), pgstore.CorrectParticipantEmailParams{
+		ID:    id,
+		Email: string(body.Email),
+	}); err != nil {
+		api.logger.Error("failed to correct participant email", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.PatchTripsTripIDParticipantsParticipantIDEmailJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to update participant, try again",
+		})
+	}
+
+	go func() {
+		if err := api.mailer.SendParticipantInvitation(id); err != nil {
+			api.logger.Error(
+				"failed to send email on PatchTripsTripIDParticipantsParticipantIDEmail",
+				zap.Error(err),
+				zap.String("participant_id", participantID),
+			)
+		}
+	}()
+
+	return spec.PatchTripsTripIDParticipantsParticipantIDEmailJSON204Response(nil)
+}
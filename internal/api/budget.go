@@ -0,0 +1,349 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+// Approve an activity over budget.
+// (PATCH /trips/{tripId}/activities/{activityId}/approve)
+func (api *API) PatchTripsTripIDActivitiesActivityIDApprove(w http.ResponseWriter, r *http.Request, tripID string, activityID string) *spec.Response {
+	activity, errResp := api.getPendingActivity(r.Context(), tripID, activityID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PatchTripsTripIDActivitiesActivityIDApproveJSON400Response, spec.PatchTripsTripIDActivitiesActivityIDApproveJSON404Response)
+	}
+
+	if err := api.store.ApproveActivity(r.Context(), activity.ID); err != nil {
+		api.logger.Error("failed to approve activity", zap.Error(err), zap.String("activity_id", activityID))
+		return spec.PatchTripsTripIDActivitiesActivityIDApproveJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to approve activity, try again",
+		})
+	}
+
+	api.recordItineraryChange(r, activity.TripID, pgstore.AuditActivityAdded, pgstore.ItineraryChange{Title: activity.Title, OccursAt: &activity.OccursAt.Time})
+
+	if activity.InviteSequence.Valid {
+		api.sendActivityInvites([]uuid.UUID{activity.ID}, "PatchTripsTripIDActivitiesActivityIDApprove")
+	}
+
+	return spec.PatchTripsTripIDActivitiesActivityIDApproveJSON204Response(nil)
+}
+
+// Reject an activity over budget.
+// (PATCH /trips/{tripId}/activities/{activityId}/reject)
+func (api *API) PatchTripsTripIDActivitiesActivityIDReject(w http.ResponseWriter, r *http.Request, tripID string, activityID string) *spec.Response {
+	activity, errResp := api.getPendingActivity(r.Context(), tripID, activityID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PatchTripsTripIDActivitiesActivityIDRejectJSON400Response, spec.PatchTripsTripIDActivitiesActivityIDRejectJSON404Response)
+	}
+
+	if err := api.store.DeleteActivity(r.Context(), activity.ID); err != nil {
+		api.logger.Error("failed to delete activity", zap.Error(err), zap.String("activity_id", activityID))
+		return spec.PatchTripsTripIDActivitiesActivityIDRejectJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to reject activity, try again",
+		})
+	}
+
+	return spec.PatchTripsTripIDActivitiesActivityIDRejectJSON204Response(nil)
+}
+
+// Approve a lodging over budget.
+// (PATCH /trips/{tripId}/lodgings/{lodgingId}/approve)
+func (api *API) PatchTripsTripIDLodgingsLodgingIDApprove(w http.ResponseWriter, r *http.Request, tripID string, lodgingID string) *spec.Response {
+	lodging, errResp := api.getPendingLodging(r.Context(), tripID, lodgingID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PatchTripsTripIDLodgingsLodgingIDApproveJSON400Response, spec.PatchTripsTripIDLodgingsLodgingIDApproveJSON404Response)
+	}
+
+	if err := api.store.ApproveLodging(r.Context(), lodging.ID); err != nil {
+		api.logger.Error("failed to approve lodging", zap.Error(err), zap.String("lodging_id", lodgingID))
+		return spec.PatchTripsTripIDLodgingsLodgingIDApproveJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to approve lodging, try again",
+		})
+	}
+
+	return spec.PatchTripsTripIDLodgingsLodgingIDApproveJSON204Response(nil)
+}
+
+// Reject a lodging over budget.
+// (PATCH /trips/{tripId}/lodgings/{lodgingId}/reject)
+func (api *API) PatchTripsTripIDLodgingsLodgingIDReject(w http.ResponseWriter, r *http.Request, tripID string, lodgingID string) *spec.Response {
+	lodging, errResp := api.getPendingLodging(r.Context(), tripID, lodgingID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PatchTripsTripIDLodgingsLodgingIDRejectJSON400Response, spec.PatchTripsTripIDLodgingsLodgingIDRejectJSON404Response)
+	}
+
+	if err := api.store.DeleteLodging(r.Context(), lodging.ID); err != nil {
+		api.logger.Error("failed to delete lodging", zap.Error(err), zap.String("lodging_id", lodgingID))
+		return spec.PatchTripsTripIDLodgingsLodgingIDRejectJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to reject lodging, try again",
+		})
+	}
+
+	return spec.PatchTripsTripIDLodgingsLodgingIDRejectJSON204Response(nil)
+}
+
+// getPendingActivity loads an activity of the given trip that is waiting for
+// the owner approval, returning the error to be sent to the client otherwise.
+func (api *API) getPendingActivity(ctx context.Context, tripID, activityID string) (pgstore.Activity, *apiError) {
+	activity, errResp := api.getTripActivity(ctx, tripID, activityID)
+	if errResp != nil {
+		return pgstore.Activity{}, errResp
+	}
+
+	if activity.Status != pgstore.PlanPending {
+		return pgstore.Activity{}, badRequest("not_pending_approval", "activity is not pending approval")
+	}
+
+	return activity, nil
+}
+
+// getTripActivity loads an activity making sure it belongs to the given
+// trip, returning the error to be sent to the client otherwise.
+func (api *API) getTripActivity(ctx context.Context, tripID, activityID string) (pgstore.Activity, *apiError) {
+	tripUUID, errID := pathID(ctx, "tripId", tripID)
+	if errID != nil {
+		return pgstore.Activity{}, errID
+	}
+
+	activityUUID, errID := pathID(ctx, "activityId", activityID)
+	if errID != nil {
+		return pgstore.Activity{}, errID
+	}
+
+	activity, err := api.store.GetActivity(ctx, activityUUID)
+	if err != nil {
+		if errors.Is(err, pgstore.ErrNotFound) {
+			return pgstore.Activity{}, notFound("activity_not_found", "activity not found")
+		}
+		api.logger.Error("failed to get activity", zap.Error(err), zap.String("activity_id", activityID))
+		return pgstore.Activity{}, badRequest(internalError, "something went wrong, try again")
+	}
+
+	if activity.TripID != tripUUID {
+		return pgstore.Activity{}, notFound("activity_not_found", "activity not found")
+	}
+
+	return activity, nil
+}
+
+// getPendingLodging loads a lodging of the given trip that is waiting for the
+// owner approval, returning the error to be sent to the client otherwise.
+func (api *API) getPendingLodging(ctx context.Context, tripID, lodgingID string) (pgstore.Lodging, *apiError) {
+	lodging, errResp := api.getTripLodging(ctx, tripID, lodgingID)
+	if errResp != nil {
+		return pgstore.Lodging{}, errResp
+	}
+
+	if lodging.Status != pgstore.PlanPending {
+		return pgstore.Lodging{}, badRequest("not_pending_approval", "lodging is not pending approval")
+	}
+
+	return lodging, nil
+}
+
+// getTripLodging loads a lodging making sure it belongs to the given trip,
+// returning the error to be sent to the client otherwise.
+func (api *API) getTripLodging(ctx context.Context, tripID, lodgingID string) (pgstore.Lodging, *apiError) {
+	tripUUID, errID := pathID(ctx, "tripId", tripID)
+	if errID != nil {
+		return pgstore.Lodging{}, errID
+	}
+
+	lodgingUUID, errID := pathID(ctx, "lodgingId", lodgingID)
+	if errID != nil {
+		return pgstore.Lodging{}, errID
+	}
+
+	lodging, err := api.store.GetLodging(ctx, lodgingUUID)
+	if err != nil {
+		if errors.Is(err, pgstore.ErrNotFound) {
+			return pgstore.Lodging{}, notFound("lodging_not_found", "lodging not found")
+		}
+		api.logger.Error("failed to get lodging", zap.Error(err), zap.String("lodging_id", lodgingID))
+		return pgstore.Lodging{}, badRequest(internalError, "something went wrong, try again")
+	}
+
+	if lodging.TripID != tripUUID {
+		return pgstore.Lodging{}, notFound("lodging_not_found", "lodging not found")
+	}
+
+	return lodging, nil
+}
+
+// sendBudgetApprovalRequest lets the trip owner know, in the background, that
+// a plan went over the budget and is waiting for them.
+func (api *API) sendBudgetApprovalRequest(tripID uuid.UUID, plan string, handler string) {
+	go func() {
+		if err := api.mailer.SendBudgetApprovalRequest(tripID, plan); err != nil {
+			api.logger.Error(
+				"failed to send email on "+handler,
+				zap.Error(err),
+				zap.String("trip_id", tripID.String()),
+			)
+		}
+	}()
+}
+
+// activityCategory is the expense category of a paid activity when none is
+// given.
+const activityCategory = "activities"
+
+// Pay for an activity.
+// (POST /trips/{tripId}/activities/{activityId}/pay)
+func (api *API) PostTripsTripIDActivitiesActivityIDPay(w http.ResponseWriter, r *http.Request, tripID string, activityID string) *spec.Response {
+	activity, errResp := api.getTripActivity(r.Context(), tripID, activityID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDActivitiesActivityIDPayJSON400Response, spec.PostTripsTripIDActivitiesActivityIDPayJSON404Response)
+	}
+
+	if activity.ExpenseID.Valid {
+		return spec.PostTripsTripIDActivitiesActivityIDPayJSON400Response(spec.Error{Code: "activity_already_paid", Message: "activity already paid"})
+	}
+
+	trip, errResp := api.getTrip(r.Context(), activity.TripID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDActivitiesActivityIDPayJSON400Response, spec.PostTripsTripIDActivitiesActivityIDPayJSON404Response)
+	}
+
+	var body spec.PayActivityRequest
+	if errJson := json.NewDecoder(r.Body).Decode(&body); errJson != nil {
+		return spec.PostTripsTripIDActivitiesActivityIDPayJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + errJson.Error()})
+	}
+
+	if errVal := api.validator.Struct(body); errVal != nil {
+		return spec.PostTripsTripIDActivitiesActivityIDPayJSON422Response(invalidBody(errVal))
+	}
+
+	amount := activity.CostCents
+	switch {
+	case body.AmountCents != nil:
+		amount = *body.AmountCents
+	case trip.Settings.ForeignCurrency(activity.Currency):
+		return spec.PostTripsTripIDActivitiesActivityIDPayJSON400Response(spec.Error{Code: "amount_required", Message: "activity cost is in another currency, give the amount paid"})
+	case amount == 0:
+		return spec.PostTripsTripIDActivitiesActivityIDPayJSON400Response(spec.Error{Code: "amount_required", Message: "activity has no cost, give the amount paid"})
+	}
+
+	payer, err := api.store.GetParticipant(r.Context(), uuid.MustParse(body.PaidBy))
+	if err != nil || payer.TripID != trip.ID {
+		if err != nil && !errors.Is(err, pgstore.ErrNotFound) {
+			api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", body.PaidBy))
+		}
+		return spec.PostTripsTripIDActivitiesActivityIDPayJSON404Response(spec.Error{
+			Code:    "participant_not_found",
+			Message: "participant not found",
+		})
+	}
+
+	splits, errResp := api.expenseSplits(r.Context(), trip.ID, amount, body.Split)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDActivitiesActivityIDPayJSON400Response, spec.PostTripsTripIDActivitiesActivityIDPayJSON404Response)
+	}
+
+	params := pgstore.InsertExpenseParams{
+		TripID:      trip.ID,
+		PaidBy:      payer.ID,
+		Description: activity.Title,
+		Category:    activityCategory,
+		AmountCents: amount,
+		SpentAt:     activity.OccursAt,
+	}
+	if body.Category != nil {
+		params.Category = *body.Category
+	}
+	if body.SpentAt != nil {
+		params.SpentAt = pgtype.Timestamp{Valid: true, Time: *body.SpentAt}
+	}
+
+	expenseID, err := api.store.PayActivity(r.Context(), api.pool, activity.ID, params, splits)
+	if err != nil {
+		switch {
+		case errors.Is(err, pgstore.ErrActivityPaid):
+			return spec.PostTripsTripIDActivitiesActivityIDPayJSON400Response(spec.Error{Code: "activity_already_paid", Message: "activity already paid"})
+		case errors.Is(err, pgstore.ErrForeignKey):
+			return spec.PostTripsTripIDActivitiesActivityIDPayJSON422Response(missingReference("participant_removed", "participant is no longer on the trip"))
+		}
+		api.logger.Error("failed to pay activity", zap.Error(err), zap.String("activity_id", activityID))
+		return spec.PostTripsTripIDActivitiesActivityIDPayJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to create expense, try again",
+		})
+	}
+
+	return spec.PostTripsTripIDActivitiesActivityIDPayJSON201Response(spec.CreateExpenseResponse{ExpenseID: expenseID.String()})
+}
+
+// Get a trip budget.
+// (GET /trips/{tripId}/budget)
+func (api *API) GetTripsTripIDBudget(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.GetTripsTripIDBudgetJSON400Response(errID.Error)
+	}
+
+	trip, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDBudgetJSON400Response, spec.GetTripsTripIDBudgetJSON404Response)
+	}
+
+	totals, err := api.store.GetTripBudgetTotals(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get trip budget totals", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDBudgetJSON400Response(spec.Error{Code: internalError, Message: "fail to get trip budget"})
+	}
+
+	estimates, err := api.store.GetTripActivityEstimates(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get trip activity estimates", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDBudgetJSON400Response(spec.Error{Code: internalError, Message: "fail to get trip budget"})
+	}
+
+	people, err := api.store.CountActiveParticipants(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to count participants", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDBudgetJSON400Response(spec.Error{Code: internalError, Message: "fail to get trip budget"})
+	}
+
+	response := spec.TripBudgetResponse{
+		People:          int(max(people, 1)),
+		EstimatedCents:  totals.LodgingCents,
+		SpentCents:      totals.SpentCents,
+		OtherCurrencies: []spec.TripBudgetResponseCurrencyArray{},
+	}
+	if trip.Settings.Currency != "" {
+		response.Currency = &trip.Settings.Currency
+	}
+
+	for _, estimate := range estimates {
+		if trip.Settings.ForeignCurrency(pgtype.Text{Valid: estimate.Currency != "", String: estimate.Currency}) {
+			response.OtherCurrencies = append(response.OtherCurrencies, spec.TripBudgetResponseCurrencyArray{
+				Currency:       estimate.Currency,
+				EstimatedCents: estimate.EstimatedCents,
+			})
+			continue
+		}
+		response.EstimatedCents += estimate.EstimatedCents
+	}
+
+	response.ProjectedCents = response.EstimatedCents + response.SpentCents
+	if trip.BudgetPerPersonCents.Valid {
+		budget := trip.BudgetPerPersonCents.Int64 * int64(response.People)
+		remaining := budget - response.ProjectedCents
+		response.BudgetCents, response.RemainingCents = &budget, &remaining
+	}
+
+	return spec.GetTripsTripIDBudgetJSON200Response(response)
+}
@@ -0,0 +1,202 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/linkmeta"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+const (
+	maxBulkLinksSize = 64 << 10
+	maxBulkLinks     = 50
+
+	// Titles are fetched a few at a time, each given up on after a while so
+	// a slow site does not hold the rest. The whole batch is given up on
+	// well before the server write timeout, the links left go by their host.
+	bulkLinkFetches       = 4
+	bulkLinkTitleTimeout  = 2 * time.Second
+	bulkLinkTitlesTimeout = 3 * time.Second
+)
+
+// Statuses of the links in a bulk import.
+const (
+	bulkLinkCreated   = "created"
+	bulkLinkDuplicate = "duplicate"
+	bulkLinkInvalid   = "invalid"
+)
+
+// Add many trip links at once.
+// (POST /trips/{tripId}/links/bulk)
+func (api *API) PostTripsTripIDLinksBulk(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.PostTripsTripIDLinksBulkJSON400Response(errID.Error)
+	}
+
+	_, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDLinksBulkJSON400Response, spec.PostTripsTripIDLinksBulkJSON404Response)
+	}
+
+	urls, err := readBulkLinks(http.MaxBytesReader(w, r.Body, maxBulkLinksSize), r.Header.Get("Content-Type"))
+	if err != nil {
+		return spec.PostTripsTripIDLinksBulkJSON400Response(spec.Error{Code: "invalid_links", Message: "invalid links: " + err.Error()})
+	}
+	if len(urls) > maxBulkLinks {
+		return spec.PostTripsTripIDLinksBulkJSON400Response(spec.Error{
+			Code:    "too_many_links",
+			Message: fmt.Sprintf("invalid links: at most %d links can be added at once", maxBulkLinks),
+		})
+	}
+
+	existing, err := api.store.GetTripLinks(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get trip links", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDLinksBulkJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	added := make(map[string]bool, len(existing)+len(urls))
+	for _, link := range existing {
+		added[linkKey(link.Url)] = true
+	}
+
+	results := make([]spec.BulkLinksResponseArray, len(urls))
+	var pending []int
+	for i, u := range urls {
+		results[i] = spec.BulkLinksResponseArray{URL: u}
+		if err := api.validator.Var(u, "required,http_url"); err != nil {
+			reason := "not an http or https URL"
+			results[i].Status, results[i].Reason = bulkLinkInvalid, &reason
+			continue
+		}
+
+		key := linkKey(u)
+		if added[key] {
+			results[i].Status = bulkLinkDuplicate
+			continue
+		}
+		added[key] = true
+		pending = append(pending, i)
+	}
+
+	if len(pending) > 0 {
+		titles := api.linkTitles(r.Context(), urls, pending)
+		links := make([]pgstore.CreateTripLinkParams, len(pending))
+		for j, i := range pending {
+			links[j] = pgstore.CreateTripLinkParams{TripID: id, Title: titles[j], Url: urls[i]}
+		}
+
+		ids, err := api.store.CreateTripLinks(r.Context(), api.pool, links)
+		if err != nil {
+			api.logger.Error("failed to insert trip links", zap.Error(err), zap.String("trip_id", tripID))
+			return spec.PostTripsTripIDLinksBulkJSON400Response(spec.Error{
+				Code:    internalError,
+				Message: "failed to insert trip links, try again",
+			})
+		}
+
+		for j, i := range pending {
+			linkID := ids[j].String()
+			results[i].Status, results[i].LinkID, results[i].Title = bulkLinkCreated, &linkID, &links[j].Title
+			api.recordItineraryChange(r, id, pgstore.AuditLinkAdded, pgstore.ItineraryChange{Title: links[j].Title, URL: links[j].Url})
+		}
+	}
+
+	return spec.PostTripsTripIDLinksBulkJSON200Response(spec.BulkLinksResponse{Links: results})
+}
+
+// readBulkLinks reads the URLs of a bulk import, a JSON array of strings or,
+// for any other content type, text with a URL a line. Blank lines are skipped.
+func readBulkLinks(body io.Reader, contentType string) ([]string, error) {
+	var urls []string
+	if mediaType, _, _ := mime.ParseMediaType(contentType); mediaType == "application/json" {
+		if err := json.NewDecoder(body).Decode(&urls); err != nil {
+			return nil, err
+		}
+		for i := range urls {
+			urls[i] = strings.TrimSpace(urls[i])
+		}
+		return urls, nil
+	}
+
+	text, err := io.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+	for _, line := range strings.Split(string(text), "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			urls = append(urls, line)
+		}
+	}
+	return urls, nil
+}
+
+// linkKey is what tells links apart: their scheme and host in any case, and
+// their path with or without a trailing slash, ignoring the fragment.
+func linkKey(link string) string {
+	u, err := url.Parse(link)
+	if err != nil {
+		return link
+	}
+	u.Scheme, u.Host = strings.ToLower(u.Scheme), strings.ToLower(u.Host)
+	u.Path, u.RawPath = strings.TrimSuffix(u.Path, "/"), ""
+	u.Fragment, u.RawFragment = "", ""
+	return u.String()
+}
+
+// linkTitles fetches the titles of the pages at the given indexes of urls,
+// returning them in the same order. Pages without a title, that could not be
+// fetched, or not in time for the batch, go by their host.
+func (api *API) linkTitles(ctx context.Context, urls []string, indexes []int) []string {
+	ctx, cancel := context.WithTimeout(ctx, bulkLinkTitlesTimeout)
+	defer cancel()
+
+	titles := make([]string, len(indexes))
+	for j, i := range indexes {
+		titles[j] = linkmeta.Fallback(urls[i])
+	}
+
+	slots := make(chan struct{}, bulkLinkFetches)
+	var wg sync.WaitGroup
+fetches:
+	for j, i := range indexes {
+		select {
+		case slots <- struct{}{}:
+		case <-ctx.Done():
+			break fetches
+		}
+
+		wg.Add(1)
+		go func() {
+			defer func() { <-slots; wg.Done() }()
+
+			ctx, cancel := context.WithTimeout(ctx, bulkLinkTitleTimeout)
+			defer cancel()
+
+			title, err := api.linkmeta.Title(ctx, urls[i])
+			if err != nil {
+				api.logger.Warn("failed to fetch link title", zap.Error(err), zap.String("url", urls[i]))
+			}
+			if title != "" {
+				titles[j] = title
+			}
+		}()
+	}
+	wg.Wait()
+	return titles
+}
@@ -0,0 +1,139 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/analytics"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/federation"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+// Export a trip bundle.
+// (GET /trips/{tripId}/bundle)
+func (api *API) GetTripsTripIDBundle(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.GetTripsTripIDBundleJSON400Response(errID.Error)
+	}
+
+	trip, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDBundleJSON400Response, spec.GetTripsTripIDBundleJSON404Response)
+	}
+
+	snapshot, err := federation.Snapshot(r.Context(), api.store, trip)
+	if err != nil {
+		api.logger.Error("failed to take trip snapshot", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDBundleJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to export trip, try again",
+		})
+	}
+
+	bundle, err := api.instance.Sign(snapshot, time.Now())
+	if err != nil {
+		if errors.Is(err, federation.ErrDisabled) {
+			return spec.GetTripsTripIDBundleJSON400Response(spec.Error{
+				Code:    "feature_disabled",
+				Message: "trip bundles are not enabled",
+			})
+		}
+		api.logger.Error("failed to sign trip bundle", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDBundleJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to export trip, try again",
+		})
+	}
+
+	// The bundle is written as signed, as encoding the payload again could
+	// change it from what the digest is of.
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Content-Disposition", `attachment; filename="trip-`+tripID+`.json"`)
+	if err := json.NewEncoder(w).Encode(bundle); err != nil {
+		api.logger.Error("failed to write trip bundle", zap.Error(err), zap.String("trip_id", tripID))
+	}
+
+	return nil
+}
+
+// Import a trip bundle.
+// (POST /trips/import)
+func (api *API) PostTripsImport(w http.ResponseWriter, r *http.Request) *spec.Response {
+	// Decoded as it came, as the signature is of the payload bytes.
+	var bundle federation.Bundle
+	if errJson := json.NewDecoder(r.Body).Decode(&bundle); errJson != nil {
+		return spec.PostTripsImportJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + errJson.Error()})
+	}
+
+	snapshot, err := api.instance.Verify(bundle)
+	if err != nil {
+		switch {
+		case errors.Is(err, federation.ErrVersion):
+			return spec.PostTripsImportJSON400Response(spec.Error{Code: "bundle_version_unsupported", Message: "bundle version is not supported"})
+		case errors.Is(err, federation.ErrUntrusted):
+			return spec.PostTripsImportJSON400Response(spec.Error{Code: "bundle_untrusted", Message: "bundle is not from a trusted instance"})
+		case errors.Is(err, federation.ErrBadSignature):
+			return spec.PostTripsImportJSON400Response(spec.Error{Code: "bundle_bad_signature", Message: "bundle signature is not valid"})
+		}
+		return spec.PostTripsImportJSON400Response(spec.Error{Code: "invalid_bundle", Message: "invalid bundle: " + err.Error()})
+	}
+
+	if api.blockedDomain(snapshot.Trip.OwnerEmail) {
+		api.logger.Warn("refusing trip import from a blocked email domain", zap.String("ip", clientIP(r)))
+		return spec.PostTripsImportJSON400Response(spec.Error{Code: "email_not_accepted", Message: "owner email is not accepted, use another one"})
+	}
+
+	if !api.creations.allow(clientIP(r), time.Now()) {
+		api.logger.Warn("refusing trip import over the limit", zap.String("ip", clientIP(r)))
+		return spec.PostTripsImportJSON429Response(spec.Error{Code: "rate_limited", Message: "too many trips created, try again later"})
+	}
+
+	sourceAttachments := snapshot.Attachments
+	imported, ids, err := federation.Remap(snapshot)
+	if err != nil {
+		return spec.PostTripsImportJSON400Response(spec.Error{Code: "invalid_bundle", Message: "invalid bundle: " + err.Error()})
+	}
+
+	sourceID := bundle.Manifest.TripID
+	if err := api.store.ImportTrip(r.Context(), api.pool, imported, bundle.Manifest.Instance, sourceID, r.RemoteAddr); err != nil {
+		api.logger.Error("failed to import trip", zap.Error(err), zap.String("source_trip_id", sourceID.String()))
+		return spec.PostTripsImportJSON400Response(spec.Error{Code: internalError, Message: "failed to import trip, try again"})
+	}
+
+	api.events.Count(analytics.TripCreated, 1)
+
+	response := spec.ImportTripResponse{
+		TripID:      imported.Trip.ID.String(),
+		Attachments: make([]spec.ImportTripResponseAttachmentArray, 0, len(sourceAttachments)),
+	}
+	for _, attachment := range sourceAttachments {
+		newID := ids[attachment.ID]
+		response.Attachments = append(response.Attachments, spec.ImportTripResponseAttachmentArray{
+			SourceID:     attachment.ID.String(),
+			AttachmentID: newID.String(),
+			SourceKey:    pgstore.AttachmentKey(attachment.TripID, attachment.ID),
+			Key:          pgstore.AttachmentKey(imported.Trip.ID, newID),
+		})
+	}
+
+	return spec.PostTripsImportJSON201Response(response)
+}
+
+// Get the instance key.
+// (GET /federation/key)
+func (api *API) GetFederationKey(w http.ResponseWriter, r *http.Request) *spec.Response {
+	key := api.instance.PublicKey()
+	if key == "" {
+		return spec.GetFederationKeyJSON400Response(spec.Error{Code: "feature_disabled", Message: "trip bundles are not enabled"})
+	}
+
+	return spec.GetFederationKeyJSON200Response(spec.InstanceKeyResponse{
+		Instance:  api.instance.Name(),
+		PublicKey: key,
+	})
+}
@@ -0,0 +1,283 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/packing"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/weather"
+	"go.uber.org/zap"
+)
+
+// forecastTimeout bounds how long checklist generation waits for the weather
+// integration before falling back to a climate-agnostic list.
+const forecastTimeout = 3 * time.Second
+
+// Get a trip checklist.
+// (GET /trips/{tripId}/checklist)
+func (api *API) GetTripsTripIDChecklist(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.GetTripsTripIDChecklistJSON400Response(errID.Error)
+	}
+
+	_, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDChecklistJSON400Response, spec.GetTripsTripIDChecklistJSON404Response)
+	}
+
+	items, err := api.store.GetTripChecklistItems(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get checklist", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDChecklistJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "fail to get trip checklist",
+		})
+	}
+
+	return spec.GetTripsTripIDChecklistJSON200Response(spec.GetChecklistResponse{
+		Items: checklistResponseItems(items),
+	})
+}
+
+// Create a trip checklist item.
+// (POST /trips/{tripId}/checklist)
+func (api *API) PostTripsTripIDChecklist(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.PostTripsTripIDChecklistJSON400Response(errID.Error)
+	}
+
+	_, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDChecklistJSON400Response, spec.PostTripsTripIDChecklistJSON404Response)
+	}
+
+	var body spec.PostTripsTripIDChecklistJSONBody
+	if errJson := json.NewDecoder(r.Body).Decode(&body); errJson != nil {
+		return spec.PostTripsTripIDChecklistJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + errJson.Error()})
+	}
+
+	if errVal := api.validator.Struct(body); errVal != nil {
+		return spec.PostTripsTripIDChecklistJSON422Response(invalidBody(errVal))
+	}
+
+	itemID, err := api.store.CreateChecklistItem(r.Context(), pgstore.CreateChecklistItemParams{
+		TripID:   id,
+		Title:    body.Title,
+		Category: body.Category,
+	})
+	if err != nil {
+		return spec.PostTripsTripIDChecklistJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "fail to insert checklist item",
+		})
+	}
+
+	return spec.PostTripsTripIDChecklistJSON201Response(spec.CreateChecklistItemResponse{ItemID: itemID.String()})
+}
+
+// Generate a starter packing checklist.
+// (POST /trips/{tripId}/checklist/generate)
+func (api *API) PostTripsTripIDChecklistGenerate(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.PostTripsTripIDChecklistGenerateJSON400Response(errID.Error)
+	}
+
+	trip, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDChecklistGenerateJSON400Response, spec.PostTripsTripIDChecklistGenerateJSON404Response)
+	}
+
+	var climate weather.Climate
+	ctx, cancel := context.WithTimeout(r.Context(), forecastTimeout)
+	defer cancel()
+
+	days, err := api.weather.Forecast(ctx, trip.Destination, trip.StartsAt.Time, trip.EndsAt.Time)
+	if err != nil {
+		api.logger.Warn("failed to get destination weather, generating checklist without it", zap.Error(err), zap.String("trip_id", tripID))
+	} else {
+		climate = weather.Summarize(days)
+	}
+
+	existing, err := api.store.GetTripChecklistItems(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get checklist", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDChecklistGenerateJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "fail to get trip checklist",
+		})
+	}
+
+	// Items generated before are found by their template, so the ones
+	// counting what to pack are updated when the trip length changed. Those
+	// from before templates were kept are found by their title.
+	byKey := make(map[string]pgstore.ChecklistItem, len(existing))
+	titles := make(map[string]bool, len(existing))
+	for _, item := range existing {
+		if item.TemplateKey.Valid {
+			byKey[item.TemplateKey.String] = item
+		}
+		titles[item.Title] = true
+	}
+
+	tripDays := int(trip.EndsAt.Time.Sub(trip.StartsAt.Time).Hours()/24) + 1
+
+	var newItems []pgstore.InsertChecklistItemsParams
+	for _, item := range packing.Generate(tripDays, climate) {
+		if generated, ok := byKey[item.Key]; ok {
+			if generated.Title == item.Title {
+				continue
+			}
+			if err := api.store.UpdateChecklistItem(r.Context(), pgstore.UpdateChecklistItemParams{
+				ID:        generated.ID,
+				Title:     item.Title,
+				IsChecked: generated.IsChecked,
+			}); err != nil {
+				api.logger.Error("failed to update checklist item", zap.Error(err), zap.String("trip_id", tripID))
+				return spec.PostTripsTripIDChecklistGenerateJSON400Response(spec.Error{
+					Code:    internalError,
+					Message: "failed to update checklist item, try again",
+				})
+			}
+			continue
+		}
+		if titles[item.Title] {
+			continue
+		}
+		newItems = append(newItems, pgstore.InsertChecklistItemsParams{
+			TripID:      id,
+			Title:       item.Title,
+			Category:    item.Category,
+			TemplateKey: pgtype.Text{Valid: true, String: item.Key},
+		})
+	}
+
+	if len(newItems) > 0 {
+		if _, err := api.store.InsertChecklistItems(r.Context(), newItems); err != nil {
+			api.logger.Error("failed to insert checklist items", zap.Error(err), zap.String("trip_id", tripID))
+			return spec.PostTripsTripIDChecklistGenerateJSON400Response(spec.Error{
+				Code:    internalError,
+				Message: "fail to insert checklist items",
+			})
+		}
+	}
+
+	items, err := api.store.GetTripChecklistItems(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get checklist", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDChecklistGenerateJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "fail to get trip checklist",
+		})
+	}
+
+	response := spec.GenerateChecklistResponse{Items: checklistResponseItems(items)}
+	if climate.Days > 0 {
+		response.Climate = &spec.GenerateChecklistResponseClimateObj{
+			TempMinC:  float32(climate.TempMinC),
+			TempMaxC:  float32(climate.TempMaxC),
+			RainyDays: climate.RainyDays,
+		}
+	}
+
+	return spec.PostTripsTripIDChecklistGenerateJSON201Response(response)
+}
+
+// Update a trip checklist item.
+// (PUT /trips/{tripId}/checklist/{itemId})
+func (api *API) PutTripsTripIDChecklistItemID(w http.ResponseWriter, r *http.Request, tripID string, itemID string) *spec.Response {
+	item, errResp := api.getTripChecklistItem(r.Context(), tripID, itemID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PutTripsTripIDChecklistItemIDJSON400Response, spec.PutTripsTripIDChecklistItemIDJSON404Response)
+	}
+
+	var body spec.PutTripsTripIDChecklistItemIDJSONBody
+	if errJson := json.NewDecoder(r.Body).Decode(&body); errJson != nil {
+		return spec.PutTripsTripIDChecklistItemIDJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + errJson.Error()})
+	}
+
+	if errVal := api.validator.Struct(body); errVal != nil {
+		return spec.PutTripsTripIDChecklistItemIDJSON422Response(invalidBody(errVal))
+	}
+
+	if err := api.store.UpdateChecklistItem(r.Context(), pgstore.UpdateChecklistItemParams{
+		ID:        item.ID,
+		Title:     body.Title,
+		IsChecked: body.IsChecked,
+	}); err != nil {
+		return spec.PutTripsTripIDChecklistItemIDJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to update checklist item, try again",
+		})
+	}
+
+	return spec.PutTripsTripIDChecklistItemIDJSON204Response(nil)
+}
+
+// Delete a trip checklist item.
+// (DELETE /trips/{tripId}/checklist/{itemId})
+func (api *API) DeleteTripsTripIDChecklistItemID(w http.ResponseWriter, r *http.Request, tripID string, itemID string) *spec.Response {
+	item, errResp := api.getTripChecklistItem(r.Context(), tripID, itemID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.DeleteTripsTripIDChecklistItemIDJSON400Response, spec.DeleteTripsTripIDChecklistItemIDJSON404Response)
+	}
+
+	if err := api.store.DeleteChecklistItem(r.Context(), item.ID); err != nil {
+		return spec.DeleteTripsTripIDChecklistItemIDJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to delete checklist item, try again",
+		})
+	}
+
+	return spec.DeleteTripsTripIDChecklistItemIDJSON204Response(nil)
+}
+
+// getTripChecklistItem loads a checklist item making sure it belongs to the
+// given trip, returning the error to be sent to the client otherwise.
+func (api *API) getTripChecklistItem(ctx context.Context, tripID, itemID string) (pgstore.ChecklistItem, *apiError) {
+	tripUUID, errID := pathID(ctx, "tripId", tripID)
+	if errID != nil {
+		return pgstore.ChecklistItem{}, errID
+	}
+
+	itemUUID, errID := pathID(ctx, "itemId", itemID)
+	if errID != nil {
+		return pgstore.ChecklistItem{}, errID
+	}
+
+	item, err := api.store.GetChecklistItem(ctx, itemUUID)
+	if err != nil {
+		if errors.Is(err, pgstore.ErrNotFound) {
+			return pgstore.ChecklistItem{}, notFound("checklist_item_not_found", "checklist item not found")
+		}
+		api.logger.Error("failed to get checklist item", zap.Error(err), zap.String("item_id", itemID))
+		return pgstore.ChecklistItem{}, badRequest(internalError, "something went wrong, try again")
+	}
+
+	if item.TripID != tripUUID {
+		return pgstore.ChecklistItem{}, notFound("checklist_item_not_found", "checklist item not found")
+	}
+
+	return item, nil
+}
+
+func checklistResponseItems(items []pgstore.ChecklistItem) []spec.GetChecklistResponseArray {
+	responseItems := make([]spec.GetChecklistResponseArray, 0, len(items))
+	for _, item := range items {
+		responseItems = append(responseItems, spec.GetChecklistResponseArray{
+			ID:        item.ID.String(),
+			Title:     item.Title,
+			Category:  item.Category,
+			IsChecked: item.IsChecked,
+		})
+	}
+	return responseItems
+}
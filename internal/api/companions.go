@@ -0,0 +1,151 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+// Add a companion to a participant.
+// (POST /participants/{participantId}/companions)
+func (api *API) PostParticipantsParticipantIDCompanions(w http.ResponseWriter, r *http.Request, participantID string) *spec.Response {
+	id, errID := pathID(r.Context(), "participantId", participantID)
+	if errID != nil {
+		return spec.PostParticipantsParticipantIDCompanionsJSON400Response(errID.Error)
+	}
+
+	participant, err := api.store.GetParticipant(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgstore.ErrNotFound) {
+			return spec.PostParticipantsParticipantIDCompanionsJSON404Response(spec.Error{
+				Code:    "participant_not_found",
+				Message: "participant not found",
+			})
+		}
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.PostParticipantsParticipantIDCompanionsJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	if !participant.IsConfirmed || participant.Status != pgstore.ParticipantInvited {
+		return spec.PostParticipantsParticipantIDCompanionsJSON400Response(spec.Error{
+			Code:    "participant_not_confirmed",
+			Message: "participant not confirmed",
+		})
+	}
+
+	var body spec.PostParticipantsParticipantIDCompanionsJSONBody
+	if errJson := json.NewDecoder(r.Body).Decode(&body); errJson != nil {
+		return spec.PostParticipantsParticipantIDCompanionsJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + errJson.Error()})
+	}
+
+	if errVal := api.validator.Struct(body); errVal != nil {
+		return spec.PostParticipantsParticipantIDCompanionsJSON422Response(invalidBody(errVal))
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), participant.TripID)
+	if err != nil {
+		api.logger.Error("failed to get trip", zap.Error(err), zap.String("trip_id", participant.TripID.String()))
+		return spec.PostParticipantsParticipantIDCompanionsJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	if trip.MaxParticipants.Valid {
+		active, err := api.store.CountActiveParticipants(r.Context(), trip.ID)
+		if err != nil {
+			api.logger.Error("failed to count participants", zap.Error(err), zap.String("trip_id", trip.ID.String()))
+			return spec.PostParticipantsParticipantIDCompanionsJSON400Response(spec.Error{
+				Code:    internalError,
+				Message: "something went wrong, try again",
+			})
+		}
+		if active >= int64(trip.MaxParticipants.Int32) {
+			return spec.PostParticipantsParticipantIDCompanionsJSON400Response(spec.Error{
+				Code:    "trip_full",
+				Message: "trip is full",
+			})
+		}
+	}
+
+	companionID, err := api.store.CreateCompanion(r.Context(), pgstore.CreateCompanionParams{
+		ParticipantID: participant.ID,
+		Name:          body.Name,
+	})
+	if err != nil {
+		api.logger.Error("failed to create companion", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.PostParticipantsParticipantIDCompanionsJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to add companion, try again",
+		})
+	}
+
+	return spec.PostParticipantsParticipantIDCompanionsJSON201Response(spec.CreateCompanionResponse{CompanionID: companionID.String()})
+}
+
+// Remove a companion from a participant.
+// (DELETE /participants/{participantId}/companions/{companionId})
+func (api *API) DeleteParticipantsParticipantIDCompanionsCompanionID(w http.ResponseWriter, r *http.Request, participantID string, companionID string) *spec.Response {
+	id, errID := pathID(r.Context(), "participantId", participantID)
+	if errID != nil {
+		return spec.DeleteParticipantsParticipantIDCompanionsCompanionIDJSON400Response(errID.Error)
+	}
+
+	companionUUID, errID := pathID(r.Context(), "companionId", companionID)
+	if errID != nil {
+		return spec.DeleteParticipantsParticipantIDCompanionsCompanionIDJSON400Response(errID.Error)
+	}
+
+	companion, err := api.store.GetCompanion(r.Context(), companionUUID)
+	if err != nil || companion.ParticipantID != id {
+		if err != nil && !errors.Is(err, pgstore.ErrNotFound) {
+			api.logger.Error("failed to get companion", zap.Error(err), zap.String("companion_id", companionID))
+			return spec.DeleteParticipantsParticipantIDCompanionsCompanionIDJSON400Response(spec.Error{
+				Code:    internalError,
+				Message: "something went wrong, try again",
+			})
+		}
+		return spec.DeleteParticipantsParticipantIDCompanionsCompanionIDJSON404Response(spec.Error{
+			Code:    "companion_not_found",
+			Message: "companion not found",
+		})
+	}
+
+	participant, err := api.store.GetParticipant(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.DeleteParticipantsParticipantIDCompanionsCompanionIDJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), participant.TripID)
+	if err != nil {
+		api.logger.Error("failed to get trip", zap.Error(err), zap.String("trip_id", participant.TripID.String()))
+		return spec.DeleteParticipantsParticipantIDCompanionsCompanionIDJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	promoted, err := api.store.RemoveCompanion(r.Context(), api.pool, companion, trip)
+	if err != nil {
+		api.logger.Error("failed to remove companion", zap.Error(err), zap.String("companion_id", companionID))
+		return spec.DeleteParticipantsParticipantIDCompanionsCompanionIDJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to remove companion, try again",
+		})
+	}
+
+	api.sendWaitlistPromotions(promoted, "DeleteParticipantsParticipantIDCompanionsCompanionID")
+
+	return spec.DeleteParticipantsParticipantIDCompanionsCompanionIDJSON204Response(nil)
+}
@@ -0,0 +1,194 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+const (
+	// bulkConfirmLimit is how many times the participants of a trip can be
+	// confirmed by the owner within bulkConfirmWindow.
+	bulkConfirmLimit  = 5
+	bulkConfirmWindow = time.Hour
+)
+
+// Get a summary of the trip confirmations.
+// (GET /trips/{tripId}/confirmations/summary)
+func (api *API) GetTripsTripIDConfirmationsSummary(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.GetTripsTripIDConfirmationsSummaryJSON400Response(errID.Error)
+	}
+
+	summary, err := api.store.GetTripConfirmationSummary(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgstore.ErrNotFound) {
+			return spec.GetTripsTripIDConfirmationsSummaryJSON404Response(spec.Error{
+				Code:    "trip_not_found",
+				Message: "trip not found",
+			})
+		}
+		api.logger.Error("failed to get confirmation summary", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDConfirmationsSummaryJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	// Clients polling the summary revalidate every time and get a 304 while
+	// nothing changed.
+	etag := confirmationSummaryETag(summary)
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "no-cache")
+	if noneMatch(r, etag) {
+		return spec.GetTripsTripIDConfirmationsSummaryJSON304Response(nil)
+	}
+
+	response := spec.ConfirmationSummaryResponse{
+		Confirmed: int(summary.Confirmed),
+		Pending:   int(summary.Pending),
+	}
+	if summary.LastConfirmedAt.Valid {
+		response.LastConfirmedAt = &summary.LastConfirmedAt.Time
+	}
+
+	return spec.GetTripsTripIDConfirmationsSummaryJSON200Response(response)
+}
+
+// Confirm trip participants on their behalf.
+// (POST /trips/{tripId}/participants/confirm-bulk)
+func (api *API) PostTripsTripIDParticipantsConfirmBulk(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.PostTripsTripIDParticipantsConfirmBulkJSON400Response(errID.Error)
+	}
+
+	_, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDParticipantsConfirmBulkJSON400Response, spec.PostTripsTripIDParticipantsConfirmBulkJSON404Response)
+	}
+
+	var body spec.ConfirmParticipantsRequest
+	if errJson := json.NewDecoder(r.Body).Decode(&body); errJson != nil {
+		return spec.PostTripsTripIDParticipantsConfirmBulkJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + errJson.Error()})
+	}
+
+	if errVal := api.validator.Struct(body); errVal != nil {
+		return spec.PostTripsTripIDParticipantsConfirmBulkJSON422Response(invalidBody(errVal))
+	}
+
+	recent, err := api.store.CountRecentAuditEvents(r.Context(), pgstore.CountRecentAuditEventsParams{
+		TripID: id,
+		Action: pgstore.AuditParticipantsConfirmed,
+		Since:  pgtype.Timestamp{Valid: true, Time: time.Now().Add(-bulkConfirmWindow)},
+	})
+	if err != nil {
+		api.logger.Error("failed to count recent confirmations", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDParticipantsConfirmBulkJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	if recent.Count >= bulkConfirmLimit {
+		retryAfter := time.Until(recent.Oldest.Time.Add(bulkConfirmWindow))
+		w.Header().Set("Retry-After", strconv.Itoa(int(retryAfter.Seconds())+1))
+		return spec.PostTripsTripIDParticipantsConfirmBulkJSON429Response(spec.Error{
+			Code:    "rate_limited",
+			Message: "too many confirmations, try again later",
+		})
+	}
+
+	participants, err := api.store.GetParticipants(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get participants", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDParticipantsConfirmBulkJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	tripParticipants := make(map[uuid.UUID]pgstore.Participant, len(participants))
+	for _, participant := range participants {
+		tripParticipants[participant.ID] = participant
+	}
+
+	ids := make([]uuid.UUID, 0, len(body.ParticipantIds))
+	for _, participantID := range body.ParticipantIds {
+		participant, ok := tripParticipants[uuid.MustParse(participantID)]
+		if !ok {
+			return spec.PostTripsTripIDParticipantsConfirmBulkJSON404Response(spec.Error{
+				Code:    "participant_not_found",
+				Message: "participant not found: " + participantID,
+			})
+		}
+
+		switch participant.Status {
+		case pgstore.ParticipantWaitlisted:
+			return spec.PostTripsTripIDParticipantsConfirmBulkJSON400Response(spec.Error{
+				Code:    "participant_waitlisted",
+				Message: "participant is on the waitlist: " + participantID,
+			})
+		case pgstore.ParticipantDeclined:
+			return spec.PostTripsTripIDParticipantsConfirmBulkJSON400Response(spec.Error{
+				Code:    "participant_declined",
+				Message: "participant declined the invitation: " + participantID,
+			})
+		}
+
+		ids = append(ids, participant.ID)
+	}
+
+	confirmed, err := api.store.ConfirmParticipantsByOwner(r.Context(), api.pool, id, ids, r.RemoteAddr)
+	if err != nil {
+		api.logger.Error("failed to confirm participants", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDParticipantsConfirmBulkJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	response := spec.ConfirmParticipantsResponse{Confirmed: make([]string, 0, len(confirmed))}
+	for _, participantID := range confirmed {
+		response.Confirmed = append(response.Confirmed, participantID.String())
+	}
+
+	return spec.PostTripsTripIDParticipantsConfirmBulkJSON200Response(response)
+}
+
+// confirmationSummaryETag identifies a summary by its values, so it changes
+// whenever someone confirms, declines or is invited.
+func confirmationSummaryETag(summary pgstore.GetTripConfirmationSummaryRow) string {
+	var last int64
+	if summary.LastConfirmedAt.Valid {
+		last = summary.LastConfirmedAt.Time.UnixMicro()
+	}
+	return fmt.Sprintf(`"%d-%d-%d"`, summary.Confirmed, summary.Pending, last)
+}
+
+// noneMatch reports whether the If-None-Match header of the request matches
+// etag, so the client already has the response. The header can list several
+// tags, weak or not, which are compared weakly as RFC 9110 says, or be *.
+func noneMatch(r *http.Request, etag string) bool {
+	etag = strings.TrimPrefix(etag, "W/")
+	for _, header := range r.Header.Values("If-None-Match") {
+		for _, tag := range strings.Split(header, ",") {
+			tag = strings.TrimSpace(tag)
+			if tag == "*" || strings.TrimPrefix(tag, "W/") == etag {
+				return true
+			}
+		}
+	}
+	return false
+}
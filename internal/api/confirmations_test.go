@@ -0,0 +1,36 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNoneMatch(t *testing.T) {
+	const etag = `"3-1-1700000000"`
+	tests := []struct {
+		name    string
+		headers []string
+		want    bool
+	}{
+		{"no header", nil, false},
+		{"same tag", []string{etag}, true},
+		{"weak tag", []string{"W/" + etag}, true},
+		{"other tag", []string{`"2-2-1700000000"`}, false},
+		{"tag in a list", []string{`"2-2-1700000000", W/` + etag}, true},
+		{"tag in another header", []string{`"2-2-1700000000"`, etag}, true},
+		{"any tag", []string{"*"}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, "/", nil)
+			for _, header := range tt.headers {
+				r.Header.Add("If-None-Match", header)
+			}
+
+			if got := noneMatch(r, etag); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
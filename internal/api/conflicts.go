@@ -0,0 +1,92 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/schedule"
+	"go.uber.org/zap"
+)
+
+// Get a trip schedule conflicts.
+// (GET /trips/{tripId}/conflicts)
+func (api *API) GetTripsTripIDConflicts(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.GetTripsTripIDConflictsJSON400Response(errID.Error)
+	}
+
+	_, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDConflictsJSON400Response, spec.GetTripsTripIDConflictsJSON404Response)
+	}
+
+	acts, err := api.store.GetTripActivities(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get activities", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDConflictsJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "fail to get trip conflicts",
+		})
+	}
+
+	lodgings, err := api.store.GetTripLodgings(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get lodgings", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDConflictsJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "fail to get trip conflicts",
+		})
+	}
+
+	transports, err := api.store.GetTripTransports(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get transports", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDConflictsJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "fail to get trip conflicts",
+		})
+	}
+
+	stays := make([]schedule.Stay, len(lodgings))
+	for i, lodging := range lodgings {
+		stays[i] = schedule.Stay{CheckIn: lodging.CheckIn.Time, CheckOut: lodging.CheckOut.Time}
+	}
+
+	legs := make([]schedule.Leg, len(transports))
+	for i, transport := range transports {
+		legs[i] = schedule.Leg{DepartsAt: transport.DepartsAt.Time, ArrivesAt: transport.ArrivesAt.Time}
+	}
+
+	conflicts := schedule.Conflicts(scheduleActivities(acts), stays, legs)
+
+	responseConflicts := make([]spec.GetConflictsResponseArray, 0, len(conflicts))
+	for _, conflict := range conflicts {
+		ids := make([]string, len(conflict.ActivityIDs))
+		for i, activityID := range conflict.ActivityIDs {
+			ids[i] = activityID.String()
+		}
+		responseConflicts = append(responseConflicts, spec.GetConflictsResponseArray{
+			Kind:        conflict.Kind,
+			ActivityIds: ids,
+			Message:     conflict.Message,
+		})
+	}
+
+	return spec.GetTripsTripIDConflictsJSON200Response(spec.GetConflictsResponse{Conflicts: responseConflicts})
+}
+
+func scheduleActivities(acts []pgstore.Activity) []schedule.Activity {
+	scheduled := make([]schedule.Activity, len(acts))
+	for i, act := range acts {
+		scheduled[i] = schedule.Activity{
+			ID:       act.ID,
+			Title:    act.Title,
+			StartsAt: act.OccursAt.Time,
+			Duration: time.Duration(act.DurationMinutes.Int32) * time.Minute,
+		}
+	}
+	return scheduled
+}
@@ -0,0 +1,35 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/currency"
+	"go.uber.org/zap"
+)
+
+// geocodeTimeout bounds how long trip creation waits to find out the
+// destination country before leaving the trip without a currency.
+const geocodeTimeout = 3 * time.Second
+
+// destinationCurrency infers the currency used at the destination from the
+// country it is in. Trips are still created when the lookup fails, only
+// without a default currency.
+func (api *API) destinationCurrency(ctx context.Context, destination string) *string {
+	ctx, cancel := context.WithTimeout(ctx, geocodeTimeout)
+	defer cancel()
+
+	country, err := api.geocoder.CountryCode(ctx, destination)
+	if err != nil {
+		api.logger.Warn("failed to find destination country, creating trip without currency", zap.Error(err), zap.String("destination", destination))
+		return nil
+	}
+
+	code, ok := currency.ForCountry(country)
+	if !ok {
+		api.logger.Warn("unknown currency for destination country", zap.String("country", country))
+		return nil
+	}
+
+	return &code
+}
@@ -0,0 +1,320 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/serializer"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+// Get the date poll results.
+// (GET /trips/{tripId}/date-poll)
+func (api *API) GetTripsTripIDDatePoll(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.GetTripsTripIDDatePollJSON400Response(errID.Error)
+	}
+
+	_, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDDatePollJSON400Response, spec.GetTripsTripIDDatePollJSON404Response)
+	}
+
+	results, err := api.store.GetDatePollResults(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get date poll results", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDDatePollJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "fail to get date poll results",
+		})
+	}
+
+	options := make([]spec.GetDatePollResultsResponseOptionArray, 0, len(results))
+	for _, result := range results {
+		options = append(options, spec.GetDatePollResultsResponseOptionArray{
+			ID:          result.ID.String(),
+			StartsAt:    result.StartsAt.Time,
+			EndsAt:      result.EndsAt.Time,
+			Available:   int(result.AvailableCount),
+			Unavailable: int(result.UnavailableCount),
+		})
+	}
+
+	return spec.GetTripsTripIDDatePollJSON200Response(spec.GetDatePollResultsResponse{Options: options})
+}
+
+// Propose dates for a trip.
+// (POST /trips/{tripId}/date-poll)
+func (api *API) PostTripsTripIDDatePoll(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.PostTripsTripIDDatePollJSON400Response(errID.Error)
+	}
+
+	_, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDDatePollJSON400Response, spec.PostTripsTripIDDatePollJSON404Response)
+	}
+
+	var body spec.PostTripsTripIDDatePollJSONBody
+	if errJson := json.NewDecoder(r.Body).Decode(&body); errJson != nil {
+		return spec.PostTripsTripIDDatePollJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + errJson.Error()})
+	}
+
+	if errVal := api.validator.Struct(body); errVal != nil {
+		return spec.PostTripsTripIDDatePollJSON422Response(invalidBody(errVal))
+	}
+
+	participants, err := api.store.GetParticipants(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get participants", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDDatePollJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	var invitees []uuid.UUID
+	for _, participant := range participants {
+		if participant.Status == pgstore.ParticipantInvited {
+			invitees = append(invitees, participant.ID)
+		}
+	}
+
+	options := make([]pgstore.InsertDatePollOptionsParams, len(body.Options))
+	for i, option := range body.Options {
+		options[i] = pgstore.InsertDatePollOptionsParams{
+			TripID:   id,
+			StartsAt: pgtype.Timestamp{Valid: true, Time: option.StartsAt},
+			EndsAt:   pgtype.Timestamp{Valid: true, Time: option.EndsAt},
+		}
+	}
+
+	if err := api.store.CreateDatePoll(r.Context(), api.pool, options, invitees); err != nil {
+		api.logger.Error("failed to create date poll", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDDatePollJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to create date poll, try again",
+		})
+	}
+
+	go func() {
+		if err := api.mailer.SendDatePollInvitations(id); err != nil {
+			api.logger.Error(
+				"failed to send email on PostTripsTripIDDatePoll",
+				zap.Error(err),
+				zap.String("trip_id", tripID),
+			)
+		}
+	}()
+
+	return spec.PostTripsTripIDDatePollJSON204Response(nil)
+}
+
+// Pick the winning dates of a trip.
+// (POST /trips/{tripId}/date-poll/{optionId}/pick)
+func (api *API) PostTripsTripIDDatePollOptionIDPick(w http.ResponseWriter, r *http.Request, tripID string, optionID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.PostTripsTripIDDatePollOptionIDPickJSON400Response(errID.Error)
+	}
+
+	optionUUID, errID := pathID(r.Context(), "optionId", optionID)
+	if errID != nil {
+		return spec.PostTripsTripIDDatePollOptionIDPickJSON400Response(errID.Error)
+	}
+
+	trip, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDDatePollOptionIDPickJSON400Response, spec.PostTripsTripIDDatePollOptionIDPickJSON404Response)
+	}
+
+	option, err := api.store.GetDatePollOption(r.Context(), optionUUID)
+	if err != nil {
+		if errors.Is(err, pgstore.ErrNotFound) {
+			return spec.PostTripsTripIDDatePollOptionIDPickJSON404Response(spec.Error{
+				Code:    "date_poll_option_not_found",
+				Message: "date poll option not found",
+			})
+		}
+		api.logger.Error("failed to get date poll option", zap.Error(err), zap.String("option_id", optionID))
+		return spec.PostTripsTripIDDatePollOptionIDPickJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	if option.TripID != trip.ID {
+		return spec.PostTripsTripIDDatePollOptionIDPickJSON404Response(spec.Error{
+			Code:    "date_poll_option_not_found",
+			Message: "date poll option not found",
+		})
+	}
+
+	if err := api.store.PickDatePollOption(r.Context(), api.pool, trip, option); err != nil {
+		api.logger.Error("failed to pick date poll option", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDDatePollOptionIDPickJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to pick date poll option, try again",
+		})
+	}
+
+	if !trip.StartsAt.Time.Equal(option.StartsAt.Time) || !trip.EndsAt.Time.Equal(option.EndsAt.Time) {
+		api.recordItineraryChange(r, trip.ID, pgstore.AuditTripDatesChanged, pgstore.ItineraryChange{StartsAt: &option.StartsAt.Time, EndsAt: &option.EndsAt.Time})
+	}
+
+	return spec.PostTripsTripIDDatePollOptionIDPickJSON204Response(nil)
+}
+
+// Get a date poll to answer.
+// (GET /date-poll/{token})
+func (api *API) GetDatePollToken(w http.ResponseWriter, r *http.Request, token string) *spec.Response {
+	participant, errResp := api.getDatePollParticipant(r, token)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetDatePollTokenJSON400Response, spec.GetDatePollTokenJSON404Response)
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), participant.TripID)
+	if err != nil {
+		api.logger.Error("failed to get trip", zap.Error(err), zap.String("trip_id", participant.TripID.String()))
+		return spec.GetDatePollTokenJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	options, err := api.store.GetTripDatePollOptions(r.Context(), trip.ID)
+	if err != nil {
+		api.logger.Error("failed to get date poll options", zap.Error(err), zap.String("trip_id", trip.ID.String()))
+		return spec.GetDatePollTokenJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	votes, err := api.store.GetParticipantDatePollVotes(r.Context(), participant.ID)
+	if err != nil {
+		api.logger.Error("failed to get date poll votes", zap.Error(err), zap.String("participant_id", participant.ID.String()))
+		return spec.GetDatePollTokenJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	answers := make(map[uuid.UUID]bool, len(votes))
+	for _, vote := range votes {
+		answers[vote.OptionID] = vote.IsAvailable
+	}
+
+	response := spec.GetDatePollResponse{
+		Destination: serializer.ParticipantView.Text(trip.Destination),
+		Options:     make([]spec.GetDatePollResponseOptionArray, 0, len(options)),
+	}
+	for _, option := range options {
+		responseOption := spec.GetDatePollResponseOptionArray{
+			ID:       option.ID.String(),
+			StartsAt: option.StartsAt.Time,
+			EndsAt:   option.EndsAt.Time,
+		}
+		if available, ok := answers[option.ID]; ok {
+			responseOption.Available = &available
+		}
+		response.Options = append(response.Options, responseOption)
+	}
+
+	return spec.GetDatePollTokenJSON200Response(response)
+}
+
+// Answer a date poll.
+// (PUT /date-poll/{token})
+func (api *API) PutDatePollToken(w http.ResponseWriter, r *http.Request, token string) *spec.Response {
+	participant, errResp := api.getDatePollParticipant(r, token)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PutDatePollTokenJSON400Response, spec.PutDatePollTokenJSON404Response)
+	}
+
+	var body spec.PutDatePollTokenJSONBody
+	if errJson := json.NewDecoder(r.Body).Decode(&body); errJson != nil {
+		return spec.PutDatePollTokenJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + errJson.Error()})
+	}
+
+	if errVal := api.validator.Struct(body); errVal != nil {
+		return spec.PutDatePollTokenJSON422Response(invalidBody(errVal))
+	}
+
+	options, err := api.store.GetTripDatePollOptions(r.Context(), participant.TripID)
+	if err != nil {
+		api.logger.Error("failed to get date poll options", zap.Error(err), zap.String("trip_id", participant.TripID.String()))
+		return spec.PutDatePollTokenJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	tripOptions := make(map[uuid.UUID]bool, len(options))
+	for _, option := range options {
+		tripOptions[option.ID] = true
+	}
+
+	votes := make([]pgstore.UpsertDatePollVoteParams, len(body.Votes))
+	for i, vote := range body.Votes {
+		optionID := uuid.MustParse(vote.OptionID)
+		if !tripOptions[optionID] {
+			return spec.PutDatePollTokenJSON400Response(spec.Error{
+				Code:    "date_poll_option_not_found",
+				Message: "date poll option not found: " + vote.OptionID,
+			})
+		}
+		votes[i] = pgstore.UpsertDatePollVoteParams{
+			OptionID:      optionID,
+			ParticipantID: participant.ID,
+			IsAvailable:   vote.Available,
+		}
+	}
+
+	for _, vote := range votes {
+		if err := api.store.UpsertDatePollVote(r.Context(), vote); err != nil {
+			if errors.Is(err, pgstore.ErrForeignKey) {
+				return spec.PutDatePollTokenJSON422Response(missingReference("date_poll_option_removed", "date poll option was removed: "+vote.OptionID.String()))
+			}
+			api.logger.Error("failed to save date poll vote", zap.Error(err), zap.String("participant_id", participant.ID.String()))
+			return spec.PutDatePollTokenJSON400Response(spec.Error{
+				Code:    internalError,
+				Message: "failed to save date poll answer, try again",
+			})
+		}
+	}
+
+	return spec.PutDatePollTokenJSON204Response(nil)
+}
+
+// getDatePollParticipant resolves a date poll token to the participant it was
+// issued to, returning the error to be sent to the client otherwise.
+func (api *API) getDatePollParticipant(r *http.Request, token string) (pgstore.Participant, *apiError) {
+	pollToken, err := api.store.GetDatePollToken(r.Context(), token)
+	if err != nil {
+		if errors.Is(err, pgstore.ErrNotFound) {
+			return pgstore.Participant{}, notFound("date_poll_not_found", "date poll not found")
+		}
+		api.logger.Error("failed to get date poll token", zap.Error(err))
+		return pgstore.Participant{}, badRequest(internalError, "something went wrong, try again")
+	}
+
+	participant, err := api.store.GetParticipant(r.Context(), pollToken.ParticipantID)
+	if err != nil {
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", pollToken.ParticipantID.String()))
+		return pgstore.Participant{}, badRequest(internalError, "something went wrong, try again")
+	}
+
+	if participant.Status == pgstore.ParticipantDeclined {
+		return pgstore.Participant{}, badRequest("participant_declined", "participant declined the trip")
+	}
+
+	return participant, nil
+}
@@ -0,0 +1,163 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/analytics"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/drafting"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+const (
+	// draftTimeout bounds how long the client waits for the draft provider.
+	draftTimeout = 30 * time.Second
+
+	// draftWriteTimeout is how long the draft route has to respond, past
+	// the server write timeout, so the drafted activities saved are not
+	// lost to the client, and added twice when it tries again.
+	draftWriteTimeout = draftTimeout + 10*time.Second
+
+	// maxDraftDays is the longest trip a draft is made for, as longer ones
+	// make for answers too long, and costly, to ask for.
+	maxDraftDays = 14
+
+	// draftActivitiesPerDay is how many activities a draft has, at most, for
+	// each day of the trip.
+	draftActivitiesPerDay = 4
+)
+
+// Draft the trip itinerary.
+// (POST /trips/{tripId}/activities/draft)
+func (api *API) PostTripsTripIDActivitiesDraft(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	api.extendWriteDeadline(w, draftWriteTimeout)
+
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.PostTripsTripIDActivitiesDraftJSON400Response(errID.Error)
+	}
+
+	trip, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDActivitiesDraftJSON400Response, spec.PostTripsTripIDActivitiesDraftJSON404Response)
+	}
+
+	var body spec.DraftActivitiesRequest
+	if errJson := json.NewDecoder(r.Body).Decode(&body); errJson != nil {
+		return spec.PostTripsTripIDActivitiesDraftJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + errJson.Error()})
+	}
+
+	if errVal := api.validator.Struct(body); errVal != nil {
+		return spec.PostTripsTripIDActivitiesDraftJSON422Response(invalidBody(errVal))
+	}
+
+	days := int(trip.EndsAt.Time.Sub(trip.StartsAt.Time).Hours()/24) + 1
+	if days > maxDraftDays {
+		return spec.PostTripsTripIDActivitiesDraftJSON400Response(spec.Error{
+			Code:    "trip_too_long",
+			Message: "drafts are only made for trips of up to " + strconv.Itoa(maxDraftDays) + " days",
+		})
+	}
+
+	req := drafting.Request{
+		Destination:   trip.Destination,
+		StartsAt:      trip.StartsAt.Time,
+		EndsAt:        trip.EndsAt.Time,
+		MaxActivities: days * draftActivitiesPerDay,
+	}
+	if body.Preferences != nil {
+		req.Preferences = strings.TrimSpace(*body.Preferences)
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), draftTimeout)
+	defer cancel()
+
+	proposed, err := api.drafter.Draft(ctx, req)
+	if err != nil {
+		switch {
+		case errors.Is(err, drafting.ErrDisabled):
+			return spec.PostTripsTripIDActivitiesDraftJSON400Response(spec.Error{
+				Code:    "feature_disabled",
+				Message: "itinerary drafts are not enabled",
+			})
+		case errors.Is(err, drafting.ErrLimited):
+			return spec.PostTripsTripIDActivitiesDraftJSON429Response(spec.Error{
+				Code:    "daily_limit_reached",
+				Message: "too many itinerary drafts today, try again tomorrow",
+			})
+		}
+		api.logger.Error("failed to draft itinerary", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDActivitiesDraftJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to draft itinerary, try again",
+		})
+	}
+
+	activities := drafting.Clean(req, proposed)
+	if len(activities) == 0 {
+		return spec.PostTripsTripIDActivitiesDraftJSON400Response(spec.Error{
+			Code:    "empty_draft",
+			Message: "no activities could be drafted, try again",
+		})
+	}
+
+	params := make([]pgstore.CreateActivityParams, len(activities))
+	for i, a := range activities {
+		tags := make([]string, 0, len(a.Tags))
+		for _, tag := range a.Tags {
+			if tag = strings.ToLower(strings.TrimSpace(tag)); tag != "" {
+				tags = append(tags, tag)
+			}
+		}
+
+		params[i] = pgstore.CreateActivityParams{
+			TripID:    trip.ID,
+			Title:     a.Title,
+			OccursAt:  pgtype.Timestamp{Valid: true, Time: a.OccursAt},
+			Tags:      distinct(tags),
+			CostCents: a.CostCents,
+		}
+		if a.DurationMinutes > 0 {
+			params[i].DurationMinutes = pgtype.Int4{Valid: true, Int32: int32(a.DurationMinutes)}
+		}
+	}
+
+	ids, err := api.store.AddDraftActivities(r.Context(), api.pool, params)
+	if err != nil {
+		api.logger.Error("failed to add draft activities", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDActivitiesDraftJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to create activities, try again",
+		})
+	}
+
+	api.events.Count(analytics.ActivityAdded, len(ids))
+
+	response := spec.DraftActivitiesResponse{
+		Activities: make([]spec.DraftActivitiesResponseActivityArray, 0, len(ids)),
+	}
+	for i, id := range ids {
+		responseActivity := spec.DraftActivitiesResponseActivityArray{
+			ID:        id.String(),
+			Title:     params[i].Title,
+			OccursAt:  params[i].OccursAt.Time,
+			CostCents: params[i].CostCents,
+			Tags:      params[i].Tags,
+		}
+		if params[i].DurationMinutes.Valid {
+			duration := int(params[i].DurationMinutes.Int32)
+			responseActivity.DurationMinutes = &duration
+		}
+		response.Activities = append(response.Activities, responseActivity)
+	}
+
+	return spec.PostTripsTripIDActivitiesDraftJSON201Response(response)
+}
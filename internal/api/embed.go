@@ -0,0 +1,267 @@
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"html"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/serializer"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/export"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+const (
+	// embedMaxAge is how long shared itineraries can be cached, by browsers
+	// and anything in between.
+	embedMaxAge = 300
+
+	// The size widgets are embedded with, unless asked for smaller.
+	widgetWidth  = 600
+	widgetHeight = 800
+)
+
+// Share a trip itinerary.
+// (POST /trips/{tripId}/share)
+func (api *API) PostTripsTripIDShare(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.PostTripsTripIDShareJSON400Response(errID.Error)
+	}
+
+	trip, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDShareJSON400Response, spec.PostTripsTripIDShareJSON404Response)
+	}
+
+	token, err := pgstore.NewTripShareToken()
+	if err != nil {
+		api.logger.Error("failed to generate trip share token", zap.Error(err))
+		return spec.PostTripsTripIDShareJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	if err := api.store.ShareTrip(r.Context(), pgstore.ShareTripParams{TripID: trip.ID, Token: token}); err != nil {
+		api.logger.Error("failed to share trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDShareJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to share trip, try again",
+		})
+	}
+
+	return spec.PostTripsTripIDShareJSON201Response(spec.TripShareResponse{
+		ShareToken: token,
+		EmbedURL:   api.urls.API("embed", "trips", token),
+	})
+}
+
+// Stop sharing a trip itinerary.
+// (DELETE /trips/{tripId}/share)
+func (api *API) DeleteTripsTripIDShare(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.DeleteTripsTripIDShareJSON400Response(errID.Error)
+	}
+
+	rows, err := api.store.UnshareTrip(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to unshare trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.DeleteTripsTripIDShareJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to stop sharing trip, try again",
+		})
+	}
+	if rows == 0 {
+		return spec.DeleteTripsTripIDShareJSON404Response(spec.Error{
+			Code:    "trip_not_shared",
+			Message: "trip is not shared",
+		})
+	}
+
+	return spec.DeleteTripsTripIDShareJSON204Response(nil)
+}
+
+// Get a shared trip itinerary.
+// (GET /embed/trips/{shareToken})
+func (api *API) GetEmbedTripsShareToken(w http.ResponseWriter, r *http.Request, shareToken string) *spec.Response {
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+
+	_, itinerary, errResp := api.getSharedItinerary(r.Context(), shareToken)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetEmbedTripsShareTokenJSON400Response, spec.GetEmbedTripsShareTokenJSON404Response)
+	}
+
+	response := serializer.EmbedTrip(serializer.PublicView, itinerary)
+	body, err := json.Marshal(response)
+	if err != nil {
+		api.logger.Error("failed to encode shared itinerary", zap.Error(err))
+		return spec.GetEmbedTripsShareTokenJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	sum := sha256.Sum256(body)
+	etag := `"` + hex.EncodeToString(sum[:16]) + `"`
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Cache-Control", "public, max-age="+strconv.Itoa(embedMaxAge))
+	if noneMatch(r, etag) {
+		return spec.GetEmbedTripsShareTokenJSON304Response(nil)
+	}
+
+	return spec.GetEmbedTripsShareTokenJSON200Response(response)
+}
+
+// Get a shared trip itinerary widget.
+// (GET /embed/trips/{shareToken}/widget)
+func (api *API) GetEmbedTripsShareTokenWidget(w http.ResponseWriter, r *http.Request, shareToken string) *spec.Response {
+	_, itinerary, errResp := api.getSharedItinerary(r.Context(), shareToken)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetEmbedTripsShareTokenWidgetJSON400Response, spec.GetEmbedTripsShareTokenWidgetJSON404Response)
+	}
+
+	page, err := export.HTML(itinerary)
+	if err != nil {
+		api.logger.Error("failed to render shared itinerary", zap.Error(err))
+		return spec.GetEmbedTripsShareTokenWidgetJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Content-Length", strconv.Itoa(len(page)))
+	w.Header().Set("Cache-Control", "public, max-age="+strconv.Itoa(embedMaxAge))
+	if _, err := w.Write(page); err != nil {
+		api.logger.Error("failed to write shared itinerary", zap.Error(err))
+	}
+
+	return nil
+}
+
+// Get the schema.org markup of a shared trip.
+// (GET /shared/{shareToken}/jsonld)
+func (api *API) GetSharedShareTokenJsonld(w http.ResponseWriter, r *http.Request, shareToken string) *spec.Response {
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+
+	_, itinerary, errResp := api.getSharedItinerary(r.Context(), shareToken)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetSharedShareTokenJsonldJSON400Response, spec.GetSharedShareTokenJsonldJSON404Response)
+	}
+
+	markup, err := export.JSONLD(itinerary, api.urls.API("embed", "trips", shareToken, "widget"))
+	if err != nil {
+		api.logger.Error("failed to render shared itinerary markup", zap.Error(err))
+		return spec.GetSharedShareTokenJsonldJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/ld+json")
+	w.Header().Set("Content-Length", strconv.Itoa(len(markup)))
+	w.Header().Set("Cache-Control", "public, max-age="+strconv.Itoa(embedMaxAge))
+	if _, err := w.Write(markup); err != nil {
+		api.logger.Error("failed to write shared itinerary markup", zap.Error(err))
+	}
+
+	return nil
+}
+
+// Get the oEmbed of a shared trip.
+// (GET /oembed)
+func (api *API) GetOembed(w http.ResponseWriter, r *http.Request, params spec.GetOembedParams) *spec.Response {
+	w.Header().Set("Access-Control-Allow-Origin", "*")
+
+	if params.Format != nil && *params.Format != "json" {
+		return spec.GetOembedJSON400Response(spec.Error{Code: "unsupported_format", Message: "only the json format is supported"})
+	}
+
+	u, err := url.Parse(params.URL)
+	if err != nil || u.Host == "" {
+		return spec.GetOembedJSON400Response(spec.Error{Code: "invalid_url", Message: "invalid url"})
+	}
+
+	token, ok := strings.CutPrefix(strings.TrimSuffix(u.Path, "/widget"), "/embed/trips/")
+	if !ok || token == "" || strings.Contains(token, "/") {
+		return spec.GetOembedJSON404Response(spec.Error{Code: "shared_trip_not_found", Message: "shared trip not found"})
+	}
+
+	_, itinerary, errResp := api.getSharedItinerary(r.Context(), token)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetOembedJSON400Response, spec.GetOembedJSON404Response)
+	}
+
+	width, height := widgetWidth, widgetHeight
+	if params.Maxwidth != nil {
+		width = min(width, *params.Maxwidth)
+	}
+	if params.Maxheight != nil {
+		height = min(height, *params.Maxheight)
+	}
+
+	src := api.urls.API("embed", "trips", token, "widget")
+	return spec.GetOembedJSON200Response(spec.OEmbedResponse{
+		Version:      "1.0",
+		Type:         "rich",
+		Title:        itinerary.Destination,
+		ProviderName: "Journey",
+		HTML:         fmt.Sprintf(`<iframe src="%s" width="%d" height="%d" frameborder="0"></iframe>`, html.EscapeString(src), width, height),
+		Width:        width,
+		Height:       height,
+	})
+}
+
+// getSharedTrip returns the trip shared with the token, or the error to be
+// sent to the client otherwise.
+func (api *API) getSharedTrip(ctx context.Context, token string) (pgstore.Trip, *apiError) {
+	tripID, err := api.store.GetSharedTripID(ctx, token)
+	if err != nil {
+		if errors.Is(err, pgstore.ErrNotFound) {
+			return pgstore.Trip{}, notFound("shared_trip_not_found", "shared trip not found")
+		}
+		api.logger.Error("failed to get shared trip", zap.Error(err))
+		return pgstore.Trip{}, badRequest(internalError, "something went wrong, try again")
+	}
+
+	return api.getTrip(ctx, tripID)
+}
+
+// getSharedItinerary builds the itinerary of the trip shared with the token,
+// returning the error to be sent to the client otherwise. Itineraries the
+// moderation heuristics flag are held for review instead, as not found. The
+// itinerary is in the public view, every shared route renders it as is.
+func (api *API) getSharedItinerary(ctx context.Context, token string) (pgstore.Trip, export.Itinerary, *apiError) {
+	trip, errResp := api.getSharedTrip(ctx, token)
+	if errResp != nil {
+		return pgstore.Trip{}, export.Itinerary{}, errResp
+	}
+
+	itinerary, err := export.Trip(ctx, api.store, trip.Domain())
+	if err != nil {
+		api.logger.Error("failed to build itinerary", zap.Error(err), zap.String("trip_id", trip.ID.String()))
+		return pgstore.Trip{}, export.Itinerary{}, badRequest(internalError, "something went wrong, try again")
+	}
+
+	held, err := api.moderateItinerary(ctx, trip.ID, itinerary)
+	if err != nil {
+		api.logger.Error("failed to moderate itinerary", zap.Error(err), zap.String("trip_id", trip.ID.String()))
+		return pgstore.Trip{}, export.Itinerary{}, badRequest(internalError, "something went wrong, try again")
+	}
+	if held {
+		return pgstore.Trip{}, export.Itinerary{}, notFound("shared_trip_not_found", "shared trip not found")
+	}
+
+	return trip, serializer.PublicView.Itinerary(itinerary), nil
+}
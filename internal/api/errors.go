@@ -0,0 +1,62 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+)
+
+// internalError is the code of the failures clients can only retry.
+const internalError = "internal_error"
+
+// apiError is what the helpers shared by handlers hand back to be sent to the
+// client. Errors about something that does not exist are sent as a 404.
+type apiError struct {
+	spec.Error
+	notFound bool
+}
+
+func badRequest(code, message string) *apiError {
+	return &apiError{Error: spec.Error{Code: code, Message: message}}
+}
+
+func notFound(code, message string) *apiError {
+	return &apiError{Error: spec.Error{Code: code, Message: message}, notFound: true}
+}
+
+// errorResponse builds the response of the operation matching the error,
+// given its 400 and 404 response constructors.
+func errorResponse(err *apiError, badRequestResponse, notFoundResponse func(spec.Error) *spec.Response) *spec.Response {
+	if err.notFound {
+		return notFoundResponse(err.Error)
+	}
+	return badRequestResponse(err.Error)
+}
+
+// NotFound answers requests for routes the API does not have.
+func NotFound(w http.ResponseWriter, r *http.Request) {
+	writeError(w, http.StatusNotFound, "route_not_found", "route not found")
+}
+
+// MethodNotAllowed answers requests for routes the API has, but not for the
+// method used.
+func MethodNotAllowed(w http.ResponseWriter, r *http.Request) {
+	writeError(w, http.StatusMethodNotAllowed, "method_not_allowed", "method not allowed")
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(spec.Error{Code: code, Message: message})
+}
+
+// missingReference is the body of the 422 sent when the database refuses a
+// write for referencing something removed while the request was handled.
+func missingReference(code, message string) spec.ValidationError {
+	return spec.ValidationError{
+		Code:    "invalid_request",
+		Message: "invalid request",
+		Errors:  []spec.ValidationErrorDetail{{Code: code, Message: message}},
+	}
+}
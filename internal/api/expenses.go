@@ -0,0 +1,500 @@
+package api
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/discord-gophers/goapi-gen/types"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/export"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/split"
+	"go.uber.org/zap"
+)
+
+// Get a trip expenses.
+// (GET /trips/{tripId}/expenses)
+func (api *API) GetTripsTripIDExpenses(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDExpensesParams) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.GetTripsTripIDExpensesJSON400Response(errID.Error)
+	}
+
+	var sort string
+	if params.Sort != nil {
+		sort = *params.Sort
+	}
+	if err := pgstore.CheckSort(sort, pgstore.ExpenseSortFields); err != nil {
+		return spec.GetTripsTripIDExpensesJSON400Response(spec.Error{Code: "invalid_sort", Message: "invalid sort: " + err.Error()})
+	}
+
+	_, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDExpensesJSON400Response, spec.GetTripsTripIDExpensesJSON404Response)
+	}
+
+	expenses, err := api.store.ListTripExpenses(r.Context(), pgstore.ListTripExpensesParams{TripID: id, Sort: sort})
+	if err != nil {
+		api.logger.Error("failed to get expenses", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDExpensesJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "fail to get trip expenses",
+		})
+	}
+
+	responseExpenses := make([]spec.GetExpensesResponseArray, 0, len(expenses))
+	for _, expense := range expenses {
+		responseExpenses = append(responseExpenses, spec.GetExpensesResponseArray{
+			ID:          expense.ID.String(),
+			PaidBy:      expense.PaidBy.String(),
+			Description: expense.Description,
+			Category:    expense.Category,
+			AmountCents: expense.AmountCents,
+			SpentAt:     expense.SpentAt.Time,
+		})
+	}
+
+	return spec.GetTripsTripIDExpensesJSON200Response(spec.GetExpensesResponse{Expenses: responseExpenses})
+}
+
+// Create a trip expense.
+// (POST /trips/{tripId}/expenses)
+func (api *API) PostTripsTripIDExpenses(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.PostTripsTripIDExpensesJSON400Response(errID.Error)
+	}
+
+	_, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDExpensesJSON400Response, spec.PostTripsTripIDExpensesJSON404Response)
+	}
+
+	var body spec.PostTripsTripIDExpensesJSONBody
+	if errJson := json.NewDecoder(r.Body).Decode(&body); errJson != nil {
+		return spec.PostTripsTripIDExpensesJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + errJson.Error()})
+	}
+
+	if errVal := api.validator.Struct(body); errVal != nil {
+		return spec.PostTripsTripIDExpensesJSON422Response(invalidBody(errVal))
+	}
+
+	payer, err := api.store.GetParticipant(r.Context(), uuid.MustParse(body.PaidBy))
+	if err != nil || payer.TripID != id {
+		if err != nil && !errors.Is(err, pgstore.ErrNotFound) {
+			api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", body.PaidBy))
+		}
+		return spec.PostTripsTripIDExpensesJSON404Response(spec.Error{
+			Code:    "participant_not_found",
+			Message: "participant not found",
+		})
+	}
+
+	splits, errResp := api.expenseSplits(r.Context(), id, body.AmountCents, body.Split)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDExpensesJSON400Response, spec.PostTripsTripIDExpensesJSON404Response)
+	}
+
+	expenseID, err := api.store.CreateExpense(r.Context(), api.pool, pgstore.InsertExpenseParams{
+		TripID:      id,
+		PaidBy:      payer.ID,
+		Description: body.Description,
+		Category:    body.Category,
+		AmountCents: body.AmountCents,
+		SpentAt:     pgtype.Timestamp{Valid: true, Time: body.SpentAt},
+	}, splits)
+	if err != nil {
+		if errors.Is(err, pgstore.ErrForeignKey) {
+			return spec.PostTripsTripIDExpensesJSON422Response(missingReference("participant_removed", "participant is no longer on the trip"))
+		}
+		api.logger.Error("failed to create expense", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDExpensesJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to create expense, try again",
+		})
+	}
+
+	return spec.PostTripsTripIDExpensesJSON201Response(spec.CreateExpenseResponse{ExpenseID: expenseID.String()})
+}
+
+// Get a trip spending breakdown.
+// (GET /trips/{tripId}/expenses/breakdown)
+func (api *API) GetTripsTripIDExpensesBreakdown(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.GetTripsTripIDExpensesBreakdownJSON400Response(errID.Error)
+	}
+
+	_, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDExpensesBreakdownJSON400Response, spec.GetTripsTripIDExpensesBreakdownJSON404Response)
+	}
+
+	byCategory, err := api.store.GetTripExpensesByCategory(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get expenses by category", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDExpensesBreakdownJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "fail to get trip expenses breakdown",
+		})
+	}
+
+	byDay, err := api.store.GetTripExpensesByDay(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get expenses by day", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDExpensesBreakdownJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "fail to get trip expenses breakdown",
+		})
+	}
+
+	byParticipant, err := api.store.GetTripExpensesByParticipant(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get expenses by participant", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDExpensesBreakdownJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "fail to get trip expenses breakdown",
+		})
+	}
+
+	response := spec.GetExpensesBreakdownResponse{
+		ByCategory:    make([]spec.GetExpensesBreakdownResponseCategoryArray, 0, len(byCategory)),
+		ByDay:         make([]spec.GetExpensesBreakdownResponseDayArray, 0, len(byDay)),
+		ByParticipant: make([]spec.GetExpensesBreakdownResponseParticipantArray, 0, len(byParticipant)),
+	}
+
+	for _, row := range byCategory {
+		response.TotalCents += row.TotalCents
+		response.ByCategory = append(response.ByCategory, spec.GetExpensesBreakdownResponseCategoryArray{
+			Category:   row.Category,
+			TotalCents: row.TotalCents,
+		})
+	}
+
+	for _, row := range byDay {
+		response.ByDay = append(response.ByDay, spec.GetExpensesBreakdownResponseDayArray{
+			Date:       types.Date{Time: row.Day.Time},
+			TotalCents: row.TotalCents,
+		})
+	}
+
+	for _, row := range byParticipant {
+		response.ByParticipant = append(response.ByParticipant, spec.GetExpensesBreakdownResponseParticipantArray{
+			ParticipantID: row.ID.String(),
+			Email:         types.Email(row.Email),
+			TotalCents:    row.TotalCents,
+		})
+	}
+
+	return spec.GetTripsTripIDExpensesBreakdownJSON200Response(response)
+}
+
+// Get how a trip expenses settle up.
+// (GET /trips/{tripId}/expenses/settlement)
+func (api *API) GetTripsTripIDExpensesSettlement(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.GetTripsTripIDExpensesSettlementJSON400Response(errID.Error)
+	}
+
+	_, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDExpensesSettlementJSON400Response, spec.GetTripsTripIDExpensesSettlementJSON404Response)
+	}
+
+	rows, err := api.store.GetTripBalances(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get balances", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDExpensesSettlementJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "fail to get trip settlement",
+		})
+	}
+
+	response := spec.GetSettlementResponse{
+		Balances: make([]spec.GetSettlementResponseBalanceArray, 0, len(rows)),
+	}
+
+	balances := make([]split.Balance, 0, len(rows))
+	for _, row := range rows {
+		balance := row.PaidCents - row.OwedCents
+		balances = append(balances, split.Balance{ParticipantID: row.ID, BalanceCents: balance})
+		response.Balances = append(response.Balances, spec.GetSettlementResponseBalanceArray{
+			ParticipantID: row.ID.String(),
+			Email:         types.Email(row.Email),
+			PaidCents:     row.PaidCents,
+			OwedCents:     row.OwedCents,
+			BalanceCents:  balance,
+		})
+	}
+
+	transfers := split.Settle(balances)
+	response.Transfers = make([]spec.GetSettlementResponseTransferArray, 0, len(transfers))
+	for _, t := range transfers {
+		response.Transfers = append(response.Transfers, spec.GetSettlementResponseTransferArray{
+			From:        t.From.String(),
+			To:          t.To.String(),
+			AmountCents: t.AmountCents,
+		})
+	}
+
+	return spec.GetTripsTripIDExpensesSettlementJSON200Response(response)
+}
+
+// expenseSplits works out how much each participant owes of an expense. With
+// no split given the amount is shared equally by everyone on the trip.
+// Companions count as people of their own in equal splits, with their part
+// owed by the participant who brought them.
+func (api *API) expenseSplits(ctx context.Context, tripID uuid.UUID, amountCents int64, body *spec.CreateExpenseRequestSplitObj) ([]pgstore.InsertExpenseSplitsParams, *apiError) {
+	participants, err := api.store.GetParticipants(ctx, tripID)
+	if err != nil {
+		api.logger.Error("failed to get participants", zap.Error(err), zap.String("trip_id", tripID.String()))
+		return nil, badRequest(internalError, "something went wrong, try again")
+	}
+
+	companions, err := api.store.GetTripCompanions(ctx, tripID)
+	if err != nil {
+		api.logger.Error("failed to get companions", zap.Error(err), zap.String("trip_id", tripID.String()))
+		return nil, badRequest(internalError, "something went wrong, try again")
+	}
+
+	people := make(map[uuid.UUID]float64, len(participants))
+	for _, c := range companions {
+		people[c.ParticipantID]++
+	}
+
+	method := split.MethodEqual
+	var ids []uuid.UUID
+	var values []float64
+	if body == nil {
+		for _, p := range participants {
+			if p.Status != pgstore.ParticipantInvited {
+				continue
+			}
+			ids = append(ids, p.ID)
+			values = append(values, 0)
+		}
+	} else {
+		onTrip := make(map[uuid.UUID]bool, len(participants))
+		for _, p := range participants {
+			onTrip[p.ID] = true
+		}
+
+		method = body.Method
+		seen := make(map[uuid.UUID]bool, len(body.Participants))
+		if len(body.GroupIds) > 0 {
+			if method != split.MethodEqual && method != split.MethodShares {
+				return nil, badRequest("invalid_split", "groups can only be split equally or by shares")
+			}
+
+			groups, err := api.store.GetTripParticipantGroups(ctx, tripID)
+			if err != nil {
+				api.logger.Error("failed to get participant groups", zap.Error(err), zap.String("trip_id", tripID.String()))
+				return nil, badRequest(internalError, "something went wrong, try again")
+			}
+
+			tripGroups := make(map[uuid.UUID]bool, len(groups))
+			for _, g := range groups {
+				tripGroups[g.ID] = true
+			}
+
+			inGroup := make(map[uuid.UUID]bool, len(body.GroupIds))
+			for _, raw := range body.GroupIds {
+				groupID := uuid.MustParse(raw)
+				if !tripGroups[groupID] {
+					return nil, notFound("group_not_found", "group not found")
+				}
+				inGroup[groupID] = true
+			}
+
+			for _, p := range participants {
+				if !p.GroupID.Valid || !inGroup[p.GroupID.Bytes] || p.Status != pgstore.ParticipantInvited {
+					continue
+				}
+				seen[p.ID] = true
+				ids = append(ids, p.ID)
+				values = append(values, 1)
+			}
+		}
+
+		for _, p := range body.Participants {
+			participantID := uuid.MustParse(p.ParticipantID)
+			if !onTrip[participantID] {
+				return nil, notFound("participant_not_found", "participant not found")
+			}
+			if seen[participantID] {
+				return nil, badRequest("participant_split_twice", "participant split more than once")
+			}
+			seen[participantID] = true
+
+			var value float64
+			if p.Value != nil {
+				value = *p.Value
+			}
+			ids = append(ids, participantID)
+			values = append(values, value)
+		}
+	}
+
+	if method == split.MethodEqual {
+		method = split.MethodShares
+		for i, participantID := range ids {
+			values[i] = 1 + people[participantID]
+		}
+	}
+
+	amounts, err := split.Amounts(method, amountCents, values)
+	if err != nil {
+		return nil, badRequest("invalid_split", "invalid split: "+err.Error())
+	}
+
+	splits := make([]pgstore.InsertExpenseSplitsParams, len(ids))
+	for i, participantID := range ids {
+		splits[i] = pgstore.InsertExpenseSplitsParams{
+			ParticipantID: participantID,
+			AmountCents:   amounts[i],
+		}
+	}
+
+	return splits, nil
+}
+
+// receiptExtensions are the file extensions of the receipt images in the
+// expenses export.
+// exportWriteTimeout is how long each part of the expenses export has to be
+// written, the server write timeout would cut the archive short otherwise.
+const exportWriteTimeout = 30 * time.Second
+
+var receiptExtensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+}
+
+// Export the trip expenses with their receipts.
+// (GET /trips/{tripId}/expenses/export.zip)
+func (api *API) GetTripsTripIDExpensesExportZip(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.GetTripsTripIDExpensesExportZipJSON400Response(errID.Error)
+	}
+
+	trip, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDExpensesExportZipJSON400Response, spec.GetTripsTripIDExpensesExportZipJSON404Response)
+	}
+
+	expenses, err := api.store.ListTripExpenses(r.Context(), pgstore.ListTripExpensesParams{TripID: id})
+	if err != nil {
+		api.logger.Error("failed to get expenses", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDExpensesExportZipJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to export expenses, try again",
+		})
+	}
+
+	participants, err := api.store.GetParticipants(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get participants", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDExpensesExportZipJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to export expenses, try again",
+		})
+	}
+
+	receipts, err := api.store.GetTripExpenseReceipts(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get receipts", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDExpensesExportZipJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to export expenses, try again",
+		})
+	}
+
+	names := make(map[uuid.UUID]string, len(participants))
+	for _, participant := range participants {
+		names[participant.ID] = participant.Email
+		if participant.Name.Valid {
+			names[participant.ID] = participant.Name.String
+		}
+	}
+
+	receiptOf := make(map[uuid.UUID]pgstore.GetTripExpenseReceiptsRow, len(receipts))
+	for _, receipt := range receipts {
+		receiptOf[receipt.ExpenseID.Bytes] = receipt
+	}
+
+	type receiptFile struct {
+		name    string
+		receipt pgstore.GetTripExpenseReceiptsRow
+		spentAt pgtype.Timestamp
+	}
+
+	rows := make([]export.Expense, 0, len(expenses))
+	var files []receiptFile
+	for _, expense := range expenses {
+		row := export.Expense{
+			SpentAt:     expense.SpentAt.Time,
+			Description: expense.Description,
+			Category:    expense.Category,
+			PaidBy:      names[expense.PaidBy],
+			AmountCents: expense.AmountCents,
+			Currency:    trip.Settings.Currency,
+		}
+		if receipt, ok := receiptOf[expense.ID]; ok {
+			row.Receipt = "receipts/" + expense.SpentAt.Time.Format("2006-01-02") + "-" + expense.ID.String() + receiptExtensions[receipt.ContentType]
+			files = append(files, receiptFile{name: row.Receipt, receipt: receipt, spentAt: expense.SpentAt})
+		}
+		rows = append(rows, row)
+	}
+
+	// The zip is streamed as it is written, each receipt read from the
+	// database a chunk at a time. Once it started the status can not change,
+	// so failures are only logged and the archive is left incomplete.
+	// The write deadline is pushed back before every part, so the whole
+	// archive is not bound by the server write timeout but a stalled client
+	// still is.
+	api.extendWriteDeadline(w, exportWriteTimeout)
+
+	w.Header().Set("Content-Type", "application/zip")
+	w.Header().Set("Content-Disposition", `attachment; filename="expenses-`+tripID+`.zip"`)
+
+	zw := zip.NewWriter(w)
+	sheet, err := zw.Create("expenses.csv")
+	if err == nil {
+		err = export.ExpensesCSV(sheet, rows)
+	}
+	if err != nil {
+		api.logger.Error("failed to write expenses export", zap.Error(err), zap.String("trip_id", tripID))
+		return nil
+	}
+
+	for _, file := range files {
+		api.extendWriteDeadline(w, exportWriteTimeout)
+		// Images are compressed already, so they are stored as they are.
+		entry, err := zw.CreateHeader(&zip.FileHeader{
+			Name:     file.name,
+			Method:   zip.Store,
+			Modified: file.spentAt.Time,
+		})
+		if err == nil {
+			_, err = io.Copy(entry, pgstore.ReceiptReader(r.Context(), api.store, file.receipt.ID, file.receipt.SizeBytes))
+		}
+		if err != nil {
+			api.logger.Error("failed to write expenses export", zap.Error(err), zap.String("trip_id", tripID))
+			return nil
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		api.logger.Error("failed to write expenses export", zap.Error(err), zap.String("trip_id", tripID))
+	}
+
+	return nil
+}
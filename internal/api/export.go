@@ -0,0 +1,82 @@
+package api
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/export"
+	"go.uber.org/zap"
+)
+
+// Export a trip itinerary as markdown.
+// (GET /trips/{tripId}/export.md)
+func (api *API) GetTripsTripIDExportMd(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.GetTripsTripIDExportMdJSON400Response(errID.Error)
+	}
+
+	trip, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDExportMdJSON400Response, spec.GetTripsTripIDExportMdJSON404Response)
+	}
+
+	itinerary, err := export.Trip(r.Context(), api.store, trip.Domain())
+	if err != nil {
+		api.logger.Error("failed to build itinerary", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDExportMdJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to export trip, try again",
+		})
+	}
+
+	doc := export.Markdown(itinerary)
+	w.Header().Set("Content-Type", "text/markdown; charset=utf-8")
+	w.Header().Set("Content-Length", strconv.Itoa(len(doc)))
+	if _, err := w.Write([]byte(doc)); err != nil {
+		api.logger.Error("failed to write export", zap.Error(err), zap.String("trip_id", tripID))
+	}
+
+	return nil
+}
+
+// Print a trip itinerary.
+// (GET /trips/{tripId}/print)
+func (api *API) GetTripsTripIDPrint(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.GetTripsTripIDPrintJSON400Response(errID.Error)
+	}
+
+	trip, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDPrintJSON400Response, spec.GetTripsTripIDPrintJSON404Response)
+	}
+
+	itinerary, err := export.Trip(r.Context(), api.store, trip.Domain())
+	if err != nil {
+		api.logger.Error("failed to build itinerary", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDPrintJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to print trip, try again",
+		})
+	}
+
+	page, err := export.HTML(itinerary)
+	if err != nil {
+		api.logger.Error("failed to render itinerary", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDPrintJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to print trip, try again",
+		})
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Content-Length", strconv.Itoa(len(page)))
+	if _, err := w.Write(page); err != nil {
+		api.logger.Error("failed to write print", zap.Error(err), zap.String("trip_id", tripID))
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/serializer"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/export"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+// feedEntries is how many of the latest changes trip feeds show.
+const feedEntries = 50
+
+// Get the update feed of a shared trip.
+// (GET /shared/{shareToken}/feed.atom)
+func (api *API) GetSharedShareTokenFeedAtom(w http.ResponseWriter, r *http.Request, shareToken string) *spec.Response {
+	trip, itinerary, errResp := api.getSharedItinerary(r.Context(), shareToken)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetSharedShareTokenFeedAtomJSON400Response, spec.GetSharedShareTokenFeedAtomJSON404Response)
+	}
+
+	changes, err := export.Changes(r.Context(), api.store, trip.ID, feedEntries)
+	if err != nil {
+		api.logger.Error("failed to list itinerary changes", zap.Error(err), zap.String("trip_id", trip.ID.String()))
+		return spec.GetSharedShareTokenFeedAtomJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	changes = serializer.PublicView.Changes(changes)
+
+	self := api.urls.API("shared", shareToken, "feed.atom")
+	link := api.urls.API("embed", "trips", shareToken, "widget")
+
+	feed, err := export.Atom(itinerary, self, self, link, changes, time.Now())
+	if err != nil {
+		api.logger.Error("failed to render trip feed", zap.Error(err))
+		return spec.GetSharedShareTokenFeedAtomJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	w.Header().Set("Content-Type", "application/atom+xml; charset=utf-8")
+	w.Header().Set("Content-Length", strconv.Itoa(len(feed)))
+	w.Header().Set("Cache-Control", "public, max-age="+strconv.Itoa(embedMaxAge))
+	if _, err := w.Write(feed); err != nil {
+		api.logger.Error("failed to write trip feed", zap.Error(err))
+	}
+
+	return nil
+}
+
+// recordItineraryChange records a change to the trip itinerary for its feed.
+// Failing to record it does not fail the change, it is only logged.
+func (api *API) recordItineraryChange(r *http.Request, tripID uuid.UUID, action string, change pgstore.ItineraryChange) {
+	details, err := json.Marshal(change)
+	if err != nil {
+		api.logger.Error("failed to encode itinerary change", zap.Error(err), zap.String("action", action))
+		return
+	}
+
+	if err := api.store.InsertAuditEvent(r.Context(), pgstore.InsertAuditEventParams{
+		TripID:     tripID,
+		Action:     action,
+		Details:    details,
+		RemoteAddr: r.RemoteAddr,
+	}); err != nil {
+		api.logger.Error("failed to record itinerary change", zap.Error(err), zap.String("trip_id", tripID.String()), zap.String("action", action))
+	}
+}
@@ -0,0 +1,37 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/fieldset"
+	"go.uber.org/zap"
+)
+
+// parseFields reads the ?fields= parameter of an endpoint returning resource.
+func parseFields(fields *string, resource any) (fieldset.Fieldset, error) {
+	if fields == nil {
+		return nil, nil
+	}
+	return fieldset.Parse(*fields, resource)
+}
+
+// sparseResponse sends only the fields asked for of the resources found at
+// path in the response body. When all fields are wanted the response is left
+// for the generated handler to send as usual.
+func (api *API) sparseResponse(w http.ResponseWriter, resp *spec.Response, fields fieldset.Fieldset, path ...string) *spec.Response {
+	if fields == nil {
+		return resp
+	}
+
+	body, err := fields.Apply(resp, path...)
+	if err != nil {
+		api.logger.Error("failed to select response fields", zap.Error(err))
+		return resp
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(resp.Code)
+	_, _ = w.Write(body)
+	return nil
+}
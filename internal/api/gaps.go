@@ -0,0 +1,64 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/discord-gophers/goapi-gen/types"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/schedule"
+	"go.uber.org/zap"
+)
+
+const (
+	defaultGapMinutes = 180
+	minGapMinutes     = 30
+)
+
+// Get a trip schedule free time.
+// (GET /trips/{tripId}/gaps)
+func (api *API) GetTripsTripIDGaps(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDGapsParams) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.GetTripsTripIDGapsJSON400Response(errID.Error)
+	}
+
+	minutes := defaultGapMinutes
+	if params.MinMinutes != nil {
+		minutes = *params.MinMinutes
+	}
+	if minutes < minGapMinutes {
+		return spec.GetTripsTripIDGapsJSON400Response(spec.Error{
+			Code:    "invalid_input",
+			Message: "invalid input: min_minutes must be at least 30",
+		})
+	}
+
+	trip, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDGapsJSON400Response, spec.GetTripsTripIDGapsJSON404Response)
+	}
+
+	acts, err := api.store.GetTripActivities(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get activities", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDGapsJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "fail to get trip activities",
+		})
+	}
+
+	gaps := schedule.Gaps(scheduleActivities(acts), trip.StartsAt.Time, trip.EndsAt.Time, time.Duration(minutes)*time.Minute)
+
+	responseGaps := make([]spec.GetGapsResponseArray, 0, len(gaps))
+	for _, gap := range gaps {
+		responseGaps = append(responseGaps, spec.GetGapsResponseArray{
+			Date:     types.Date{Time: gap.StartsAt},
+			StartsAt: gap.StartsAt,
+			EndsAt:   gap.EndsAt,
+			Minutes:  int(gap.Duration().Minutes()),
+		})
+	}
+
+	return spec.GetTripsTripIDGapsJSON200Response(spec.GetGapsResponse{Gaps: responseGaps})
+}
@@ -0,0 +1,220 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+// Get a trip participant groups.
+// (GET /trips/{tripId}/groups)
+func (api *API) GetTripsTripIDGroups(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.GetTripsTripIDGroupsJSON400Response(errID.Error)
+	}
+
+	_, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDGroupsJSON400Response, spec.GetTripsTripIDGroupsJSON404Response)
+	}
+
+	groups, err := api.store.GetTripParticipantGroups(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get participant groups", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDGroupsJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "fail to get trip groups",
+		})
+	}
+
+	participants, err := api.store.GetParticipants(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get participants", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDGroupsJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "fail to get trip groups",
+		})
+	}
+
+	membersOf := make(map[uuid.UUID][]string)
+	for _, p := range participants {
+		if p.GroupID.Valid {
+			membersOf[p.GroupID.Bytes] = append(membersOf[p.GroupID.Bytes], p.ID.String())
+		}
+	}
+
+	responseGroups := make([]spec.GetParticipantGroupsResponseArray, 0, len(groups))
+	for _, group := range groups {
+		responseGroups = append(responseGroups, spec.GetParticipantGroupsResponseArray{
+			ID:             group.ID.String(),
+			Name:           group.Name,
+			ParticipantIds: append([]string{}, membersOf[group.ID]...),
+		})
+	}
+
+	return spec.GetTripsTripIDGroupsJSON200Response(spec.GetParticipantGroupsResponse{Groups: responseGroups})
+}
+
+// Create a trip participant group.
+// (POST /trips/{tripId}/groups)
+func (api *API) PostTripsTripIDGroups(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.PostTripsTripIDGroupsJSON400Response(errID.Error)
+	}
+
+	_, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDGroupsJSON400Response, spec.PostTripsTripIDGroupsJSON404Response)
+	}
+
+	var body spec.CreateParticipantGroupRequest
+	if errJson := json.NewDecoder(r.Body).Decode(&body); errJson != nil {
+		return spec.PostTripsTripIDGroupsJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + errJson.Error()})
+	}
+
+	if errVal := api.validator.Struct(body); errVal != nil {
+		return spec.PostTripsTripIDGroupsJSON422Response(invalidBody(errVal))
+	}
+
+	members, errResp := api.groupMembers(r.Context(), id, body.ParticipantIds)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDGroupsJSON400Response, spec.PostTripsTripIDGroupsJSON404Response)
+	}
+
+	groupID, err := api.store.CreateParticipantGroup(r.Context(), api.pool, pgstore.InsertParticipantGroupParams{
+		TripID: id,
+		Name:   body.Name,
+	}, members)
+	if err != nil {
+		if errors.Is(err, pgstore.ErrDuplicate) {
+			return spec.PostTripsTripIDGroupsJSON400Response(spec.Error{Code: "duplicate_name", Message: "there is already a group with this name"})
+		}
+		api.logger.Error("failed to create participant group", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDGroupsJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to create group, try again",
+		})
+	}
+
+	return spec.PostTripsTripIDGroupsJSON201Response(spec.CreateParticipantGroupResponse{GroupID: groupID.String()})
+}
+
+// Update a trip participant group.
+// (PUT /trips/{tripId}/groups/{groupId})
+func (api *API) PutTripsTripIDGroupsGroupID(w http.ResponseWriter, r *http.Request, tripID string, groupID string) *spec.Response {
+	group, errResp := api.getTripGroup(r.Context(), tripID, groupID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PutTripsTripIDGroupsGroupIDJSON400Response, spec.PutTripsTripIDGroupsGroupIDJSON404Response)
+	}
+
+	var body spec.UpdateParticipantGroupRequest
+	if errJson := json.NewDecoder(r.Body).Decode(&body); errJson != nil {
+		return spec.PutTripsTripIDGroupsGroupIDJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + errJson.Error()})
+	}
+
+	if errVal := api.validator.Struct(body); errVal != nil {
+		return spec.PutTripsTripIDGroupsGroupIDJSON422Response(invalidBody(errVal))
+	}
+
+	var members []uuid.UUID
+	if body.ParticipantIds != nil {
+		members, errResp = api.groupMembers(r.Context(), group.TripID, body.ParticipantIds)
+		if errResp != nil {
+			return errorResponse(errResp, spec.PutTripsTripIDGroupsGroupIDJSON400Response, spec.PutTripsTripIDGroupsGroupIDJSON404Response)
+		}
+	}
+
+	if err := api.store.UpdateParticipantGroup(r.Context(), api.pool, group, body.Name, members); err != nil {
+		if errors.Is(err, pgstore.ErrDuplicate) {
+			return spec.PutTripsTripIDGroupsGroupIDJSON400Response(spec.Error{Code: "duplicate_name", Message: "there is already a group with this name"})
+		}
+		api.logger.Error("failed to update participant group", zap.Error(err), zap.String("group_id", groupID))
+		return spec.PutTripsTripIDGroupsGroupIDJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to update group, try again",
+		})
+	}
+
+	return spec.PutTripsTripIDGroupsGroupIDJSON204Response(nil)
+}
+
+// Delete a trip participant group.
+// (DELETE /trips/{tripId}/groups/{groupId})
+func (api *API) DeleteTripsTripIDGroupsGroupID(w http.ResponseWriter, r *http.Request, tripID string, groupID string) *spec.Response {
+	group, errResp := api.getTripGroup(r.Context(), tripID, groupID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.DeleteTripsTripIDGroupsGroupIDJSON400Response, spec.DeleteTripsTripIDGroupsGroupIDJSON404Response)
+	}
+
+	if err := api.store.DeleteParticipantGroup(r.Context(), group.ID); err != nil {
+		api.logger.Error("failed to delete participant group", zap.Error(err), zap.String("group_id", groupID))
+		return spec.DeleteTripsTripIDGroupsGroupIDJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to delete group, try again",
+		})
+	}
+
+	return spec.DeleteTripsTripIDGroupsGroupIDJSON204Response(nil)
+}
+
+// getTripGroup loads a participant group making sure it belongs to the given
+// trip, returning the error to be sent to the client otherwise.
+func (api *API) getTripGroup(ctx context.Context, tripID, groupID string) (pgstore.ParticipantGroup, *apiError) {
+	tripUUID, errID := pathID(ctx, "tripId", tripID)
+	if errID != nil {
+		return pgstore.ParticipantGroup{}, errID
+	}
+
+	groupUUID, errID := pathID(ctx, "groupId", groupID)
+	if errID != nil {
+		return pgstore.ParticipantGroup{}, errID
+	}
+
+	group, err := api.store.GetParticipantGroup(ctx, groupUUID)
+	if err != nil {
+		if errors.Is(err, pgstore.ErrNotFound) {
+			return pgstore.ParticipantGroup{}, notFound("group_not_found", "group not found")
+		}
+		api.logger.Error("failed to get participant group", zap.Error(err), zap.String("group_id", groupID))
+		return pgstore.ParticipantGroup{}, badRequest(internalError, "something went wrong, try again")
+	}
+
+	if group.TripID != tripUUID {
+		return pgstore.ParticipantGroup{}, notFound("group_not_found", "group not found")
+	}
+
+	return group, nil
+}
+
+// groupMembers checks the participants put in a group are on the trip.
+func (api *API) groupMembers(ctx context.Context, tripID uuid.UUID, participantIDs []string) ([]uuid.UUID, *apiError) {
+	participants, err := api.store.GetParticipants(ctx, tripID)
+	if err != nil {
+		api.logger.Error("failed to get participants", zap.Error(err), zap.String("trip_id", tripID.String()))
+		return nil, badRequest(internalError, "something went wrong, try again")
+	}
+
+	onTrip := make(map[uuid.UUID]bool, len(participants))
+	for _, p := range participants {
+		onTrip[p.ID] = true
+	}
+
+	members := make([]uuid.UUID, 0, len(participantIDs))
+	for _, raw := range participantIDs {
+		participantID := uuid.MustParse(raw)
+		if !onTrip[participantID] {
+			return nil, notFound("participant_not_found", "participant not found")
+		}
+		members = append(members, participantID)
+	}
+
+	return members, nil
+}
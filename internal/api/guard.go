@@ -0,0 +1,247 @@
+package api
+
+import (
+	"expvar"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-chi/chi/v5/middleware"
+	"go.uber.org/zap"
+)
+
+const (
+	// guardWindow is how long failed attempts are remembered after the last
+	// one.
+	guardWindow = 15 * time.Minute
+
+	// guardFreeAttempts is how many attempts can fail before the next ones
+	// are slowed down, each twice as much as the one before up to
+	// guardMaxDelay. That stays well under the server write timeout, so a
+	// delayed request still gets its response.
+	guardFreeAttempts = 5
+	guardBaseDelay    = 250 * time.Millisecond
+	guardMaxDelay     = 2 * time.Second
+
+	// guardBlockAfter is how many failed attempts get further ones refused
+	// for guardBlockFor.
+	guardBlockAfter = 20
+	guardBlockFor   = 15 * time.Minute
+
+	// guardMaxEntries is how many clients are tracked at most. Past it the
+	// ones not seen within the window are forgotten, and then the one failing
+	// the longest ago.
+	guardMaxEntries = 10000
+)
+
+// guardedRoutes are the routes reached through a token or an id sent by
+// email or shared, which is all it takes to use them.
+var guardedRoutes = []string{
+	"/date-poll/{token}",
+	"/surveys/{token}",
+	"/ownership-transfers/{token}/accept",
+	"/owner-email-changes/{token}/confirm",
+	"/participants/{participantId}/confirm",
+	"/participants/{participantId}/decline",
+	"/trips/{tripId}/confirm",
+	"/embed/trips/{shareToken}",
+	"/embed/trips/{shareToken}/widget",
+	"/shared/{shareToken}/feed.atom",
+	"/shared/{shareToken}/jsonld",
+	"/shared/{shareToken}/reports",
+}
+
+// guardMetrics are published under "token_guard" in /debug/vars.
+var (
+	guardMetrics = expvar.NewMap("token_guard")
+	guardFailed  = new(expvar.Int)
+	guardDelayed = new(expvar.Int)
+	guardBlocked = new(expvar.Int)
+)
+
+func init() {
+	guardMetrics.Set("failed", guardFailed)
+	guardMetrics.Set("delayed", guardDelayed)
+	guardMetrics.Set("blocked", guardBlocked)
+}
+
+type guardEntry struct {
+	failures     int
+	lastFailure  time.Time
+	blockedUntil time.Time
+}
+
+type tokenGuard struct {
+	mu      sync.Mutex
+	entries map[string]*guardEntry
+	logger  *zap.Logger
+}
+
+// TokenGuard returns a middleware slowing down and then refusing guessing on
+// the routes anyone holding a token can use. Attempts answered with a 404 are
+// failures, counted per client address, and blocked requests are answered
+// with a 429. Tokens are not counted: shared ones are public, and failing
+// on purpose with one would lock out everyone using it. Succeeding does not
+// clear the failures either, or a client holding a token could guess on.
+//
+// Attempts are tracked in memory, so each instance of the API counts its own.
+func TokenGuard(logger *zap.Logger) func(http.Handler) http.Handler {
+	g := &tokenGuard{entries: make(map[string]*guardEntry), logger: logger}
+	return g.middleware
+}
+
+func (g *tokenGuard) middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !guarded(r) {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ip := clientIP(r)
+		delay, blockedFor := g.check(ip, time.Now())
+		if blockedFor > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(int(blockedFor.Seconds())+1))
+			writeError(w, http.StatusTooManyRequests, "rate_limited", "too many attempts, try again later")
+			return
+		}
+		if delay > 0 {
+			guardDelayed.Add(1)
+			select {
+			case <-time.After(delay):
+			case <-r.Context().Done():
+				return
+			}
+		}
+
+		ww := middleware.NewWrapResponseWriter(w, r.ProtoMajor)
+		next.ServeHTTP(ww, r)
+
+		if ww.Status() == http.StatusNotFound {
+			g.fail(ip, time.Now())
+		}
+	})
+}
+
+// check tells how long a request from the address must wait, or for how long
+// it is refused.
+func (g *tokenGuard) check(ip string, now time.Time) (time.Duration, time.Duration) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	e, ok := g.entries[ip]
+	switch {
+	case !ok:
+		return 0, 0
+	case e.blockedUntil.After(now):
+		return 0, e.blockedUntil.Sub(now)
+	case e.failures >= guardFreeAttempts:
+		shift := min(e.failures-guardFreeAttempts, 16)
+		return min(guardBaseDelay<<shift, guardMaxDelay), 0
+	}
+	return 0, 0
+}
+
+func (g *tokenGuard) fail(ip string, now time.Time) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	guardFailed.Add(1)
+	e, ok := g.entries[ip]
+	if !ok && len(g.entries) >= guardMaxEntries {
+		g.forget(now)
+		if len(g.entries) >= guardMaxEntries {
+			g.evictOldest()
+		}
+	}
+	if !ok || now.Sub(e.lastFailure) > guardWindow {
+		e = &guardEntry{}
+		g.entries[ip] = e
+	}
+	e.failures++
+	e.lastFailure = now
+
+	if e.failures >= guardBlockAfter && !e.blockedUntil.After(now) {
+		e.blockedUntil = now.Add(guardBlockFor)
+		e.failures = 0
+		guardBlocked.Add(1)
+		g.logger.Warn("blocking attempts after too many failures", zap.String("ip", ip), zap.Duration("for", guardBlockFor))
+	}
+}
+
+// forget drops the entries neither blocked nor failing within the window.
+func (g *tokenGuard) forget(now time.Time) {
+	for key, e := range g.entries {
+		if !e.blockedUntil.After(now) && now.Sub(e.lastFailure) > guardWindow {
+			delete(g.entries, key)
+		}
+	}
+}
+
+// evictOldest drops the entry that failed the longest ago, to make room for
+// a new one when all are still within the window.
+func (g *tokenGuard) evictOldest() {
+	var oldest string
+	var oldestAt time.Time
+	for key, e := range g.entries {
+		if oldest == "" || e.lastFailure.Before(oldestAt) {
+			oldest, oldestAt = key, e.lastFailure
+		}
+	}
+	delete(g.entries, oldest)
+}
+
+// guarded tells whether the request is reached through a token. oEmbed
+// lookups carry the embed URL, and so its token, in the url parameter.
+func guarded(r *http.Request) bool {
+	if r.URL.Path == "/oembed" {
+		u, err := url.Parse(r.URL.Query().Get("url"))
+		if err != nil {
+			return false
+		}
+		_, ok := guardedToken(u.Path)
+		return ok
+	}
+
+	_, ok := guardedToken(r.URL.Path)
+	return ok
+}
+
+// guardedToken returns the token in the path when it is one of the guarded
+// routes.
+func guardedToken(path string) (string, bool) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	for _, route := range guardedRoutes {
+		parts := strings.Split(strings.Trim(route, "/"), "/")
+		if len(parts) != len(segments) {
+			continue
+		}
+
+		token, match := "", true
+		for i, part := range parts {
+			if strings.HasPrefix(part, "{") {
+				token = segments[i]
+			} else if part != segments[i] {
+				match = false
+				break
+			}
+		}
+		if match && token != "" {
+			return token, true
+		}
+	}
+	return "", false
+}
+
+// clientIP is the address the request came from. Forwarding headers are not
+// trusted, anyone can set them.
+func clientIP(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
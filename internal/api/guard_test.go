@@ -0,0 +1,84 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"testing"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+func TestTokenGuardBlocksOembed(t *testing.T) {
+	g := &tokenGuard{entries: make(map[string]*guardEntry), logger: zap.NewNop()}
+	h := g.middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, "shared_trip_not_found", "shared trip not found")
+	}))
+
+	for range guardBlockAfter {
+		g.fail("192.0.2.1", time.Now())
+	}
+
+	oembed := "/oembed?url=" + url.QueryEscape("https://journey.example/embed/trips/guess")
+	tests := []struct {
+		name       string
+		target     string
+		remoteAddr string
+		want       int
+	}{
+		{"blocked client on embed", "/embed/trips/guess", "192.0.2.1:1234", http.StatusTooManyRequests},
+		{"blocked client on oembed", oembed, "192.0.2.1:1234", http.StatusTooManyRequests},
+		{"other client on oembed", oembed, "192.0.2.2:1234", http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tt.target, nil)
+			req.RemoteAddr = tt.remoteAddr
+			rec := httptest.NewRecorder()
+
+			h.ServeHTTP(rec, req)
+
+			if rec.Code != tt.want {
+				t.Errorf("got status %d, want %d", rec.Code, tt.want)
+			}
+		})
+	}
+}
+
+func TestTokenGuardCountsOembedFailures(t *testing.T) {
+	g := &tokenGuard{entries: make(map[string]*guardEntry), logger: zap.NewNop()}
+	h := g.middleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusNotFound, "shared_trip_not_found", "shared trip not found")
+	}))
+
+	req := httptest.NewRequest(http.MethodGet, "/oembed?url="+url.QueryEscape("https://journey.example/embed/trips/guess/widget"), nil)
+	req.RemoteAddr = "192.0.2.1:1234"
+	h.ServeHTTP(httptest.NewRecorder(), req)
+
+	e, ok := g.entries["192.0.2.1"]
+	if !ok || e.failures != 1 {
+		t.Fatalf("oembed lookup not counted as a failure: %+v", e)
+	}
+}
+
+func TestTokenGuardEvictsOldestAtCapacity(t *testing.T) {
+	g := &tokenGuard{entries: make(map[string]*guardEntry), logger: zap.NewNop()}
+
+	start := time.Now()
+	for i := range guardMaxEntries {
+		g.fail("client-"+strconv.Itoa(i), start.Add(time.Duration(i)*time.Millisecond))
+	}
+	g.fail("192.0.2.1", start.Add(time.Minute))
+
+	if len(g.entries) != guardMaxEntries {
+		t.Errorf("got %d entries, want %d", len(g.entries), guardMaxEntries)
+	}
+	if _, ok := g.entries["client-0"]; ok {
+		t.Error("oldest entry not evicted")
+	}
+	if _, ok := g.entries["192.0.2.1"]; !ok {
+		t.Error("new entry not tracked")
+	}
+}
@@ -0,0 +1,113 @@
+package api
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/discord-gophers/goapi-gen/types"
+	"github.com/google/uuid"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/planning"
+	"go.uber.org/zap"
+)
+
+// Preview shifting activities to a new start.
+// (GET /trips/{tripId}/activities/shift-preview)
+func (api *API) GetTripsTripIDActivitiesShiftPreview(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDActivitiesShiftPreviewParams) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.GetTripsTripIDActivitiesShiftPreviewJSON400Response(errID.Error)
+	}
+
+	trip, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDActivitiesShiftPreviewJSON400Response, spec.GetTripsTripIDActivitiesShiftPreviewJSON404Response)
+	}
+
+	acts, err := api.store.TripActivities(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get activities", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDActivitiesShiftPreviewJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "fail to get trip activities",
+		})
+	}
+
+	days := planning.ShiftDays(trip.StartsAt.Time, params.StartsAt)
+	shifts := planning.ShiftActivities(acts, days)
+
+	response := spec.ShiftPreviewResponse{
+		ShiftedDays: days,
+		Activities:  make([]spec.ActivityShiftArray, 0, len(shifts)),
+	}
+	for _, shift := range shifts {
+		response.Activities = append(response.Activities, spec.ActivityShiftArray{
+			ID:    shift.Activity.ID.String(),
+			Title: shift.Activity.Title,
+			From:  shift.From,
+			To:    shift.To,
+		})
+	}
+
+	return spec.GetTripsTripIDActivitiesShiftPreviewJSON200Response(response)
+}
+
+// dateImpactResponse is the report of what moving a trip to startsAt and
+// endsAt, with its activities moved by shiftDays, leaves out of its plans.
+func dateImpactResponse(startsAt, endsAt time.Time, shiftDays int, impact planning.Impact, dryRun bool) spec.TripDateImpact {
+	response := spec.TripDateImpact{
+		StartsAt:        startsAt,
+		EndsAt:          endsAt,
+		DryRun:          dryRun,
+		ShiftedDays:     shiftDays,
+		Activities:      make([]spec.TripDateImpactActivityArray, 0, len(impact.Activities)),
+		UncoveredNights: make([]types.Date, 0, len(impact.UncoveredNights)),
+		Lodgings:        make([]spec.TripDateImpactLodgingArray, 0, len(impact.Lodgings)),
+		Transports:      make([]spec.TripDateImpactTransportArray, 0, len(impact.Transports)),
+	}
+
+	for _, act := range impact.Activities {
+		response.Activities = append(response.Activities, spec.TripDateImpactActivityArray{
+			ID:       act.ID.String(),
+			Title:    act.Title,
+			OccursAt: act.OccursAt,
+		})
+	}
+	for _, night := range impact.UncoveredNights {
+		response.UncoveredNights = append(response.UncoveredNights, types.Date{Time: night})
+	}
+	for _, lodging := range impact.Lodgings {
+		response.Lodgings = append(response.Lodgings, spec.TripDateImpactLodgingArray{
+			ID:       lodging.ID.String(),
+			Name:     lodging.Name,
+			CheckIn:  lodging.CheckIn,
+			CheckOut: lodging.CheckOut,
+		})
+	}
+	for _, transport := range impact.Transports {
+		response.Transports = append(response.Transports, spec.TripDateImpactTransportArray{
+			ID:          transport.ID.String(),
+			Mode:        string(transport.Mode),
+			Origin:      transport.Origin,
+			Destination: transport.Destination,
+			DepartsAt:   transport.DepartsAt,
+			ArrivesAt:   transport.ArrivesAt,
+		})
+	}
+
+	return response
+}
+
+// sendTripDatesImpact emails the trip owners, in the background, what the new
+// dates of the trip left out of its plans.
+func (api *API) sendTripDatesImpact(tripID uuid.UUID, impact planning.Impact) {
+	go func() {
+		if err := api.mailer.SendTripDatesImpact(tripID, impact); err != nil {
+			api.logger.Error(
+				"failed to send email on PutTripsTripID",
+				zap.Error(err),
+				zap.String("trip_id", tripID.String()),
+			)
+		}
+	}()
+}
@@ -0,0 +1,231 @@
+package api
+
+import (
+	"encoding/base64"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+const defaultTriggerLimit = 50
+
+// List new trip participants.
+// (GET /trips/{tripId}/triggers/participants)
+func (api *API) GetTripsTripIDTriggersParticipants(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDTriggersParticipantsParams) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.GetTripsTripIDTriggersParticipantsJSON400Response(errID.Error)
+	}
+
+	_, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDTriggersParticipantsJSON400Response, spec.GetTripsTripIDTriggersParticipantsJSON404Response)
+	}
+
+	after, afterID, err := decodeCursor(params.Cursor)
+	if err != nil {
+		return spec.GetTripsTripIDTriggersParticipantsJSON400Response(spec.Error{Code: "invalid_cursor", Message: "invalid cursor"})
+	}
+
+	participants, err := api.store.ListNewParticipants(r.Context(), pgstore.ListNewParticipantsParams{
+		TripID:         id,
+		AfterCreatedAt: after,
+		AfterID:        afterID,
+		Max:            triggerLimit(params.Limit),
+	})
+	if err != nil {
+		api.logger.Error("failed to list new participants", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDTriggersParticipantsJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	response := spec.NewParticipantsResponse{
+		Items:      make([]spec.NewParticipant, 0, len(participants)),
+		NextCursor: nextCursor(params.Cursor),
+	}
+	for _, participant := range participants {
+		item := spec.NewParticipant{
+			ID:          participant.ID.String(),
+			Email:       participant.Email,
+			Status:      participant.Status,
+			IsConfirmed: participant.IsConfirmed,
+			CreatedAt:   participant.CreatedAt.Time,
+		}
+		if participant.Name.Valid {
+			item.Name = &participant.Name.String
+		}
+		response.Items = append(response.Items, item)
+		response.NextCursor = encodeCursor(participant.CreatedAt.Time, participant.ID)
+	}
+
+	return spec.GetTripsTripIDTriggersParticipantsJSON200Response(response)
+}
+
+// List new trip activities.
+// (GET /trips/{tripId}/triggers/activities)
+func (api *API) GetTripsTripIDTriggersActivities(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDTriggersActivitiesParams) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.GetTripsTripIDTriggersActivitiesJSON400Response(errID.Error)
+	}
+
+	_, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDTriggersActivitiesJSON400Response, spec.GetTripsTripIDTriggersActivitiesJSON404Response)
+	}
+
+	after, afterID, err := decodeCursor(params.Cursor)
+	if err != nil {
+		return spec.GetTripsTripIDTriggersActivitiesJSON400Response(spec.Error{Code: "invalid_cursor", Message: "invalid cursor"})
+	}
+
+	activities, err := api.store.ListNewActivities(r.Context(), pgstore.ListNewActivitiesParams{
+		TripID:         id,
+		AfterCreatedAt: after,
+		AfterID:        afterID,
+		Max:            triggerLimit(params.Limit),
+	})
+	if err != nil {
+		api.logger.Error("failed to list new activities", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDTriggersActivitiesJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	response := spec.NewActivitiesResponse{
+		Items:      make([]spec.NewActivity, 0, len(activities)),
+		NextCursor: nextCursor(params.Cursor),
+	}
+	for _, activity := range activities {
+		response.Items = append(response.Items, spec.NewActivity{
+			ID:        activity.ID.String(),
+			Title:     activity.Title,
+			OccursAt:  activity.OccursAt.Time,
+			Status:    activity.Status,
+			CreatedAt: activity.CreatedAt.Time,
+		})
+		response.NextCursor = encodeCursor(activity.CreatedAt.Time, activity.ID)
+	}
+
+	return spec.GetTripsTripIDTriggersActivitiesJSON200Response(response)
+}
+
+// List the integration triggers and actions.
+// (GET /integrations)
+func (api *API) GetIntegrations(w http.ResponseWriter, r *http.Request) *spec.Response {
+	catalog, err := integrationCatalog()
+	if err != nil {
+		api.logger.Error("failed to build integration catalog", zap.Error(err))
+		return spec.GetIntegrationsJSON200Response(spec.IntegrationCatalog{
+			Triggers: []spec.IntegrationEndpoint{},
+			Actions:  []spec.IntegrationEndpoint{},
+		})
+	}
+	return spec.GetIntegrationsJSON200Response(catalog)
+}
+
+// integrationCatalog lists the operations marked with x-integration in the
+// specification, built once.
+var integrationCatalog = sync.OnceValues(func() (spec.IntegrationCatalog, error) {
+	swagger, err := spec.GetSwagger()
+	if err != nil {
+		return spec.IntegrationCatalog{}, err
+	}
+
+	catalog := spec.IntegrationCatalog{
+		Triggers: []spec.IntegrationEndpoint{},
+		Actions:  []spec.IntegrationEndpoint{},
+	}
+	for path, item := range swagger.Paths.Map() {
+		for method, op := range item.Operations() {
+			mark, ok := op.Extensions["x-integration"].(map[string]any)
+			if !ok {
+				continue
+			}
+
+			key, _ := mark["key"].(string)
+			name, _ := mark["name"].(string)
+			endpoint := spec.IntegrationEndpoint{
+				Key:         key,
+				Name:        name,
+				Method:      method,
+				Path:        path,
+				Description: strings.TrimSuffix(op.Summary, "."),
+			}
+
+			switch mark["kind"] {
+			case "trigger":
+				catalog.Triggers = append(catalog.Triggers, endpoint)
+			case "action":
+				catalog.Actions = append(catalog.Actions, endpoint)
+			}
+		}
+	}
+
+	byKey := func(endpoints []spec.IntegrationEndpoint) func(i, j int) bool {
+		return func(i, j int) bool { return endpoints[i].Key < endpoints[j].Key }
+	}
+	sort.Slice(catalog.Triggers, byKey(catalog.Triggers))
+	sort.Slice(catalog.Actions, byKey(catalog.Actions))
+
+	return catalog, nil
+})
+
+func triggerLimit(limit *int) int32 {
+	if limit == nil {
+		return defaultTriggerLimit
+	}
+	return int32(*limit)
+}
+
+// encodeCursor hides where a listing stopped, the creation time and id of
+// the last item, behind an opaque string.
+func encodeCursor(createdAt time.Time, id uuid.UUID) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(createdAt.Format(time.RFC3339Nano) + "," + id.String()))
+}
+
+// decodeCursor returns where to list from, the beginning when there is no
+// cursor.
+func decodeCursor(cursor *string) (pgtype.Timestamp, uuid.UUID, error) {
+	if cursor == nil || *cursor == "" {
+		return pgtype.Timestamp{Valid: true, Time: time.Time{}}, uuid.Nil, nil
+	}
+
+	b, err := base64.RawURLEncoding.DecodeString(*cursor)
+	if err != nil {
+		return pgtype.Timestamp{}, uuid.Nil, err
+	}
+
+	at, rawID, _ := strings.Cut(string(b), ",")
+	createdAt, err := time.Parse(time.RFC3339Nano, at)
+	if err != nil {
+		return pgtype.Timestamp{}, uuid.Nil, err
+	}
+
+	id, err := uuid.Parse(rawID)
+	if err != nil {
+		return pgtype.Timestamp{}, uuid.Nil, err
+	}
+
+	return pgtype.Timestamp{Valid: true, Time: createdAt}, id, nil
+}
+
+// nextCursor is the cursor to give back when nothing new was found, so
+// polling goes on from the same place.
+func nextCursor(cursor *string) string {
+	if cursor == nil {
+		return ""
+	}
+	return *cursor
+}
@@ -0,0 +1,194 @@
+package api
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/discord-gophers/goapi-gen/types"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/analytics"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/serializer"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+const (
+	maxInvitesImportSize = 1 << 20
+	maxInvitesImportRows = 1000
+)
+
+// Import invitations from a CSV.
+// (POST /trips/{tripId}/invites/import)
+func (api *API) PostTripsTripIDInvitesImport(w http.ResponseWriter, r *http.Request, tripID string, params spec.PostTripsTripIDInvitesImportParams) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.PostTripsTripIDInvitesImportJSON400Response(errID.Error)
+	}
+
+	trip, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDInvitesImportJSON400Response, spec.PostTripsTripIDInvitesImportJSON404Response)
+	}
+
+	reader := csv.NewReader(http.MaxBytesReader(w, r.Body, maxInvitesImportSize))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return spec.PostTripsTripIDInvitesImportJSON400Response(spec.Error{Code: "invalid_csv", Message: "invalid csv: " + err.Error()})
+	}
+
+	if len(records) > 0 && isInvitesHeader(records[0]) {
+		records[0] = nil
+	}
+	if len(records) > maxInvitesImportRows+1 {
+		return spec.PostTripsTripIDInvitesImportJSON400Response(spec.Error{
+			Code:    "too_many_rows",
+			Message: fmt.Sprintf("invalid csv: at most %d rows can be imported at once", maxInvitesImportRows),
+		})
+	}
+
+	participants, err := api.store.GetParticipants(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get participants", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDInvitesImportJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	invited := make(map[string]bool, len(participants)+len(records))
+	for _, p := range participants {
+		invited[strings.ToLower(p.Email)] = true
+	}
+
+	response := spec.ImportInvitesResponse{
+		Valid:      make([]spec.ImportInvitesResponseRowArray, 0, len(records)),
+		Invalid:    make([]spec.ImportInvitesResponseInvalidArray, 0),
+		Duplicates: make([]spec.ImportInvitesResponseRowArray, 0),
+	}
+
+	for i, record := range records {
+		line := i + 1
+		if record == nil || (len(record) == 1 && strings.TrimSpace(record[0]) == "") {
+			continue
+		}
+
+		if len(record) != 2 {
+			response.Invalid = append(response.Invalid, spec.ImportInvitesResponseInvalidArray{
+				Line:   line,
+				Reason: fmt.Sprintf("expected name,email but got %d columns", len(record)),
+			})
+			continue
+		}
+
+		name, email := strings.TrimSpace(record[0]), strings.TrimSpace(record[1])
+		if name == "" {
+			response.Invalid = append(response.Invalid, spec.ImportInvitesResponseInvalidArray{Line: line, Reason: "missing name"})
+			continue
+		}
+		if err := api.validator.Var(email, "required,email"); err != nil {
+			response.Invalid = append(response.Invalid, spec.ImportInvitesResponseInvalidArray{
+				Line:   line,
+				Reason: fmt.Sprintf("invalid email %q", email),
+			})
+			continue
+		}
+
+		row := spec.ImportInvitesResponseRowArray{Line: line, Name: name, Email: types.Email(email)}
+		if invited[strings.ToLower(email)] {
+			response.Duplicates = append(response.Duplicates, row)
+			continue
+		}
+		invited[strings.ToLower(email)] = true
+		response.Valid = append(response.Valid, row)
+	}
+
+	if params.Commit == nil || !*params.Commit || len(response.Valid) == 0 {
+		return spec.PostTripsTripIDInvitesImportJSON200Response(response)
+	}
+
+	active, err := api.store.CountActiveParticipants(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to count participants", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDInvitesImportJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	statuses := pgstore.InviteStatuses(trip.MaxParticipants, active, len(response.Valid))
+	invites := make([]pgstore.InviteParticipantsToTripParams, len(response.Valid))
+	for i, row := range response.Valid {
+		invites[i] = pgstore.InviteParticipantsToTripParams{
+			TripID: id,
+			Email:  string(row.Email),
+			Name:   pgtype.Text{Valid: true, String: row.Name},
+			Status: statuses[i],
+		}
+	}
+
+	if _, err := api.store.InviteParticipantsToTrip(r.Context(), invites); err != nil {
+		api.logger.Error("failed to import invites", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDInvitesImportJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to import invites, try again",
+		})
+	}
+
+	api.events.Count(analytics.InviteSent, len(invites))
+
+	response.Committed = true
+	return spec.PostTripsTripIDInvitesImportJSON200Response(response)
+}
+
+func isInvitesHeader(record []string) bool {
+	return len(record) == 2 &&
+		strings.EqualFold(strings.TrimSpace(record[0]), "name") &&
+		strings.EqualFold(strings.TrimSpace(record[1]), "email")
+}
+
+// Get a summary of the trip invites.
+// (GET /trips/{tripId}/invites/summary)
+func (api *API) GetTripsTripIDInvitesSummary(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.GetTripsTripIDInvitesSummaryJSON400Response(errID.Error)
+	}
+
+	summary, err := api.store.GetTripInviteSummary(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgstore.ErrNotFound) {
+			return spec.GetTripsTripIDInvitesSummaryJSON404Response(spec.Error{
+				Code:    "trip_not_found",
+				Message: "trip not found",
+			})
+		}
+		api.logger.Error("failed to get invite summary", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDInvitesSummaryJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	response := spec.InviteSummaryResponse{
+		Invited:       int(summary.Invited),
+		Confirmed:     int(summary.Confirmed),
+		Declined:      int(summary.Declined),
+		Waitlisted:    int(summary.Waitlisted),
+		PendingEmails: make([]string, 0, len(summary.PendingEmails)),
+	}
+	if summary.MaxParticipants.Valid {
+		remaining := max(int(summary.MaxParticipants.Int32)-int(summary.Invited+summary.Companions), 0)
+		response.RemainingCapacity = &remaining
+	}
+	for _, email := range summary.PendingEmails {
+		response.PendingEmails = append(response.PendingEmails, serializer.MaskEmail(email))
+	}
+
+	return spec.GetTripsTripIDInvitesSummaryJSON200Response(response)
+}
@@ -0,0 +1,165 @@
+package api
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+)
+
+type language string
+
+const (
+	english    language = "en"
+	portuguese language = "pt-BR"
+)
+
+// Localize is a middleware translating the messages of JSON error responses
+// to the language asked for with Accept-Language. English is answered when
+// neither language is asked for, and messages missing from the catalog are
+// left as they are. Codes are set by the handlers; errors sent without one
+// get a code from the response status.
+func Localize(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lw := &localizingWriter{ResponseWriter: w, lang: negotiateLanguage(r.Header.Get("Accept-Language"))}
+		next.ServeHTTP(lw, r)
+		lw.flush()
+	})
+}
+
+// negotiateLanguage picks the language of highest quality among those
+// supported in an Accept-Language header, any Portuguese counting as pt-BR.
+func negotiateLanguage(header string) language {
+	best, bestQuality := english, 0.0
+	for _, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(strings.TrimSpace(part), ";")
+
+		quality := 1.0
+		if q, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			var err error
+			if quality, err = strconv.ParseFloat(q, 64); err != nil {
+				continue
+			}
+		}
+
+		var lang language
+		primary, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(tag)), "-")
+		switch primary {
+		case "pt":
+			lang = portuguese
+		case "en":
+			lang = english
+		default:
+			continue
+		}
+
+		if quality > bestQuality {
+			best, bestQuality = lang, quality
+		}
+	}
+	return best
+}
+
+// localizingWriter holds back JSON error bodies to rewrite them once the
+// handler is done, passing every other response through.
+type localizingWriter struct {
+	http.ResponseWriter
+	lang      language
+	status    int
+	buffering bool
+	body      bytes.Buffer
+}
+
+func (w *localizingWriter) WriteHeader(status int) {
+	if w.status != 0 {
+		return
+	}
+	w.status = status
+
+	if status >= http.StatusBadRequest && strings.HasPrefix(w.Header().Get("Content-Type"), "application/json") {
+		w.buffering = true
+		return
+	}
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *localizingWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.WriteHeader(http.StatusOK)
+	}
+	if w.buffering {
+		return w.body.Write(b)
+	}
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *localizingWriter) flush() {
+	if !w.buffering {
+		return
+	}
+
+	body := w.body.Bytes()
+	if localized, ok := localizeError(body, w.status, w.lang); ok {
+		body = localized
+	}
+
+	w.Header().Del("Content-Length")
+	w.Header().Set("Content-Language", string(w.lang))
+	w.Header().Add("Vary", "Accept-Language")
+	w.ResponseWriter.WriteHeader(w.status)
+	_, _ = w.ResponseWriter.Write(body)
+}
+
+// localizedError is both spec.Error and spec.ValidationError, telling them
+// apart by whether there are errors.
+type localizedError struct {
+	Code    string                        `json:"code"`
+	Message string                        `json:"message"`
+	Errors  *[]spec.ValidationErrorDetail `json:"errors,omitempty"`
+}
+
+// localizeError translates the message of an error body, and those of its
+// details. Bodies it does not know are left as they are.
+func localizeError(body []byte, status int, lang language) ([]byte, bool) {
+	var e localizedError
+	if err := json.Unmarshal(body, &e); err != nil || e.Message == "" {
+		return nil, false
+	}
+
+	if e.Code == "" {
+		e.Code = strings.ReplaceAll(strings.ToLower(http.StatusText(status)), " ", "_")
+	}
+	if lang == portuguese {
+		e.Message = translate(e.Message, messagePatterns)
+		if e.Errors != nil {
+			for i, detail := range *e.Errors {
+				(*e.Errors)[i].Message = translate(detail.Message, validationPatterns)
+			}
+		}
+	}
+
+	localized, err := json.Marshal(e)
+	if err != nil {
+		return nil, false
+	}
+	return append(localized, '\n'), true
+}
+
+// translate finds the Portuguese translation of a message in the catalog, or
+// else in the patterns, answering the message itself when it has none.
+func translate(msg string, patterns []messagePattern) string {
+	if ptBR, ok := messages[msg]; ok {
+		return ptBR
+	}
+
+	for _, p := range patterns {
+		if match := p.re.FindStringSubmatchIndex(msg); match != nil {
+			return string(p.re.ExpandString(nil, p.ptBR, msg, match))
+		}
+	}
+
+	return msg
+}
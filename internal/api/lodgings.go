@@ -0,0 +1,102 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+// Get a trip lodgings.
+// (GET /trips/{tripId}/lodgings)
+func (api *API) GetTripsTripIDLodgings(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.GetTripsTripIDLodgingsJSON400Response(errID.Error)
+	}
+
+	_, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDLodgingsJSON400Response, spec.GetTripsTripIDLodgingsJSON404Response)
+	}
+
+	lodgings, err := api.store.GetTripLodgings(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get lodgings", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDLodgingsJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "fail to get trip lodgings",
+		})
+	}
+
+	responseLodgings := make([]spec.GetLodgingsResponseArray, 0, len(lodgings))
+	for _, lodging := range lodgings {
+		responseLodgings = append(responseLodgings, spec.GetLodgingsResponseArray{
+			ID:        lodging.ID.String(),
+			Name:      lodging.Name,
+			Address:   lodging.Address,
+			CheckIn:   lodging.CheckIn.Time,
+			CheckOut:  lodging.CheckOut.Time,
+			CostCents: lodging.CostCents,
+			Status:    lodging.Status,
+		})
+	}
+
+	return spec.GetTripsTripIDLodgingsJSON200Response(spec.GetLodgingsResponse{Lodgings: responseLodgings})
+}
+
+// Create a trip lodging.
+// (POST /trips/{tripId}/lodgings)
+func (api *API) PostTripsTripIDLodgings(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.PostTripsTripIDLodgingsJSON400Response(errID.Error)
+	}
+
+	trip, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDLodgingsJSON400Response, spec.PostTripsTripIDLodgingsJSON404Response)
+	}
+
+	var body spec.PostTripsTripIDLodgingsJSONBody
+	if errJson := json.NewDecoder(r.Body).Decode(&body); errJson != nil {
+		return spec.PostTripsTripIDLodgingsJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + errJson.Error()})
+	}
+
+	if errVal := api.validator.Struct(body); errVal != nil {
+		return spec.PostTripsTripIDLodgingsJSON422Response(invalidBody(errVal))
+	}
+
+	var cost int64
+	if body.CostCents != nil {
+		cost = *body.CostCents
+	}
+
+	lodgingID, status, err := api.store.AddLodging(r.Context(), api.pool, trip, pgstore.CreateLodgingParams{
+		TripID:    id,
+		Name:      body.Name,
+		Address:   body.Address,
+		CheckIn:   pgtype.Timestamp{Valid: true, Time: body.CheckIn},
+		CheckOut:  pgtype.Timestamp{Valid: true, Time: body.CheckOut},
+		CostCents: cost,
+	})
+	if err != nil {
+		api.logger.Error("failed to add lodging", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDLodgingsJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to create lodging, try again",
+		})
+	}
+
+	if status == pgstore.PlanPending {
+		api.sendBudgetApprovalRequest(id, body.Name, "PostTripsTripIDLodgings")
+	}
+
+	return spec.PostTripsTripIDLodgingsJSON201Response(spec.CreateLodgingResponse{
+		LodgingID: lodgingID.String(),
+		Status:    status,
+	})
+}
@@ -0,0 +1,72 @@
+package api
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+// Merge another trip into this one.
+// (POST /trips/{tripId}/merge-from/{sourceId})
+func (api *API) PostTripsTripIDMergeFromSourceID(w http.ResponseWriter, r *http.Request, tripID string, sourceID string) *spec.Response {
+	targetID, errResp := pathID(r.Context(), "tripId", tripID)
+	if errResp != nil {
+		return spec.PostTripsTripIDMergeFromSourceIDJSON400Response(errResp.Error)
+	}
+
+	sourceUUID, errResp := pathID(r.Context(), "sourceId", sourceID)
+	if errResp != nil {
+		return spec.PostTripsTripIDMergeFromSourceIDJSON400Response(errResp.Error)
+	}
+
+	target, errResp := api.getActiveTrip(r.Context(), targetID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDMergeFromSourceIDJSON400Response, spec.PostTripsTripIDMergeFromSourceIDJSON404Response)
+	}
+
+	source, errResp := api.getActiveTrip(r.Context(), sourceUUID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDMergeFromSourceIDJSON400Response, spec.PostTripsTripIDMergeFromSourceIDJSON404Response)
+	}
+
+	if target.ID == source.ID {
+		return spec.PostTripsTripIDMergeFromSourceIDJSON400Response(spec.Error{
+			Code:    "trip_merge_into_itself",
+			Message: "trip can not be merged into itself",
+		})
+	}
+
+	merge, err := api.store.MergeTrips(r.Context(), api.pool, target.ID, source.ID)
+	if err != nil {
+		api.logger.Error("failed to merge trips", zap.Error(err), zap.String("trip_id", tripID), zap.String("source_id", sourceID))
+		return spec.PostTripsTripIDMergeFromSourceIDJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to merge trips, try again",
+		})
+	}
+
+	return spec.PostTripsTripIDMergeFromSourceIDJSON200Response(spec.MergeTripResponse{
+		Activities:   int(merge.Activities),
+		Links:        int(merge.Links),
+		Participants: int(merge.Participants),
+	})
+}
+
+// getActiveTrip loads a trip that has not been archived, returning the error
+// to be sent to the client otherwise.
+func (api *API) getActiveTrip(ctx context.Context, id uuid.UUID) (pgstore.Trip, *apiError) {
+	trip, errResp := api.getTrip(ctx, id)
+	if errResp != nil {
+		return pgstore.Trip{}, errResp
+	}
+
+	if trip.ArchivedAt.Valid {
+		return pgstore.Trip{}, badRequest("trip_archived", "trip is archived")
+	}
+
+	return trip, nil
+}
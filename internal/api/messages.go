@@ -0,0 +1,298 @@
+package api
+
+import "regexp"
+
+// messages are the translations of the error messages sent by the API, which
+// are written in English in the handlers along with their codes.
+var messages = map[string]string{
+	// Requests
+	"invalid uuid":        "uuid inválido",
+	"invalid url":         "url inválida",
+	"invalid cursor":      "cursor inválido",
+	"invalid request":     "requisição inválida",
+	"route not found":     "rota não encontrada",
+	"method not allowed":  "método não permitido",
+	"invalid admin token": "token de administrador inválido",
+
+	"only the json format is supported":              "apenas o formato json é suportado",
+	"invalid input: due_on is required":              "entrada inválida: due_on é obrigatório",
+	"invalid input: min_minutes must be at least 30": "entrada inválida: min_minutes deve ser pelo menos 30",
+	"invalid input: date must be during the trip":    "entrada inválida: a data deve ser durante a viagem",
+
+	// Limits
+	"too many attempts, try again later":                  "tentativas demais, tente novamente mais tarde",
+	"too many trips created, try again later":             "viagens demais criadas, tente novamente mais tarde",
+	"too many confirmations, try again later":             "confirmações demais, tente novamente mais tarde",
+	"too many quick adds today, try again tomorrow":       "adições rápidas demais hoje, tente novamente amanhã",
+	"too many itinerary drafts today, try again tomorrow": "rascunhos de roteiro demais hoje, tente novamente amanhã",
+
+	// Features
+	"attachments are not enabled":         "anexos não estão habilitados",
+	"itinerary drafts are not enabled":    "rascunhos de roteiro não estão habilitados",
+	"trip bundles are not enabled":        "pacotes de viagem não estão habilitados",
+	"google sheets export is not enabled": "exportação para o google sheets não está habilitada",
+
+	// Not found
+	"trip not found":                       "viagem não encontrada",
+	"shared trip not found":                "viagem compartilhada não encontrada",
+	"participant not found":                "participante não encontrado",
+	"activity not found":                   "atividade não encontrada",
+	"activity not found in trash":          "atividade não encontrada na lixeira",
+	"checklist item not found":             "item do checklist não encontrado",
+	"date poll not found":                  "enquete de datas não encontrada",
+	"date poll option not found":           "opção da enquete de datas não encontrada",
+	"group not found":                      "grupo não encontrado",
+	"lodging not found":                    "hospedagem não encontrada",
+	"ride not found":                       "carona não encontrada",
+	"passenger not found":                  "passageiro não encontrado",
+	"room not found":                       "quarto não encontrado",
+	"shopping item not found":              "item de compra não encontrado",
+	"survey not found":                     "pesquisa não encontrada",
+	"task not found":                       "tarefa não encontrada",
+	"receipt not found":                    "recibo não encontrado",
+	"attachment not found":                 "anexo não encontrado",
+	"companion not found":                  "acompanhante não encontrado",
+	"link not found":                       "link não encontrado",
+	"link not found in trash":              "link não encontrado na lixeira",
+	"ownership transfer not found":         "transferência de propriedade não encontrada",
+	"owner email change not found":         "troca de email do dono não encontrada",
+	"connection not found, start it again": "conexão não encontrada, comece novamente",
+
+	// Trips
+	"trip is archived":                                                 "a viagem está arquivada",
+	"trip is full":                                                     "a viagem está lotada",
+	"trip is not shared":                                               "a viagem não está compartilhada",
+	"trip must have at least one owner":                                "a viagem deve ter pelo menos um dono",
+	"trip can not be merged into itself":                               "a viagem não pode ser mesclada com ela mesma",
+	"trip is not connected to a sheet":                                 "a viagem não está conectada a uma planilha",
+	"owner email is not accepted, use another one":                     "o email do dono não é aceito, use outro",
+	"an identical trip was just created, set force to create it again": "uma viagem idêntica acabou de ser criada, use force para criá-la novamente",
+	"ownership transfer expired":                                       "a transferência de propriedade expirou",
+	"owner email change expired":                                       "a troca de email do dono expirou",
+	"trip was cancelled":                                               "a viagem foi cancelada",
+	"trip can not move to that status":                                 "a viagem não pode passar para esse status",
+	"trip status changed meanwhile, try again":                         "o status da viagem mudou enquanto isso, tente novamente",
+
+	// Participants
+	"participant not confirmed":                               "participante não confirmado",
+	"participant has not confirmed the trip":                  "o participante não confirmou a viagem",
+	"participant is not going on the trip":                    "o participante não vai na viagem",
+	"participant declined the trip":                           "o participante recusou a viagem",
+	"participant declined the invitation":                     "o participante recusou o convite",
+	"participant is on the waitlist":                          "o participante está na lista de espera",
+	"participant already confirmed":                           "o participante já confirmou",
+	"participant already declined":                            "o participante já recusou",
+	"participant already owns the trip":                       "o participante já é dono da viagem",
+	"participant is not an owner":                             "o participante não é dono",
+	"participant email did not bounce":                        "o email do participante não foi devolvido",
+	"participant split more than once":                        "participante dividido mais de uma vez",
+	"participant is no longer on the trip":                    "o participante não está mais na viagem",
+	"assignee is no longer on the trip":                       "o responsável não está mais na viagem",
+	"email already participates in the trip":                  "o email já participa da viagem",
+	"owners must be removed from the owners before declining": "donos devem ser removidos dos donos antes de recusar",
+
+	// Planning
+	"activity is not pending approval":                              "a atividade não está aguardando aprovação",
+	"lodging is not pending approval":                               "a hospedagem não está aguardando aprovação",
+	"order does not match the day activities, get a new suggestion": "a ordem não corresponde às atividades do dia, peça uma nova sugestão",
+	"no activities could be drafted, try again":                     "nenhuma atividade pôde ser rascunhada, tente novamente",
+	"weather forecast unavailable, try again later":                 "previsão do tempo indisponível, tente novamente mais tarde",
+	"groups can only be split equally or by shares":                 "grupos só podem ser divididos igualmente ou por cotas",
+	"there is already a group with this name":                       "já existe um grupo com este nome",
+	"there is already a room with this name":                        "já existe um quarto com este nome",
+	"survey was already sent":                                       "a pesquisa já foi enviada",
+	"activity already paid":                                         "a atividade já foi paga",
+	"activity has no cost, give the amount paid":                    "a atividade não tem custo, informe o valor pago",
+	"activity cost is in another currency, give the amount paid":    "o custo da atividade está em outra moeda, informe o valor pago",
+
+	// Rides and shopping
+	"participant is already in a ride on this day":         "o participante já está em uma carona neste dia",
+	"participant already has a seat in the ride":           "o participante já tem um lugar na carona",
+	"not enough seats left in the ride":                    "não há lugares suficientes na carona",
+	"shopping item already purchased":                      "o item de compra já foi comprado",
+	"shopping item already claimed or purchased":           "o item de compra já foi reservado ou comprado",
+	"shopping item must be claimed before being purchased": "o item de compra deve ser reservado antes de ser comprado",
+
+	// Files
+	"receipt must be a jpeg or png image":                   "o recibo deve ser uma imagem jpeg ou png",
+	"receipt has invalid EXIF data":                         "o recibo tem dados EXIF inválidos",
+	"receipt already confirmed":                             "o recibo já foi confirmado",
+	"attachment must be a jpeg, png or heic image or a pdf": "o anexo deve ser uma imagem jpeg, png ou heic ou um pdf",
+	"attachment has invalid EXIF data":                      "o anexo tem dados EXIF inválidos",
+	"attachment was not uploaded":                           "o anexo não foi enviado",
+
+	// Bundles
+	"bundle version is not supported":       "a versão do pacote não é suportada",
+	"bundle is not from a trusted instance": "o pacote não é de uma instância confiável",
+	"bundle signature is not valid":         "a assinatura do pacote não é válida",
+
+	// Quick add
+	`could not find what the activity is, as in "dinner at Coco Bambu friday 20:00"`:     `não foi possível encontrar qual é a atividade, como em "dinner at Coco Bambu friday 20:00"`,
+	`could not find the day of the activity, as in "dinner at Coco Bambu friday 20:00"`:  `não foi possível encontrar o dia da atividade, como em "dinner at Coco Bambu friday 20:00"`,
+	`could not find the time of the activity, as in "dinner at Coco Bambu friday 20:00"`: `não foi possível encontrar o horário da atividade, como em "dinner at Coco Bambu friday 20:00"`,
+	`could not understand the activity, as in "dinner at Coco Bambu friday 20:00"`:       `não foi possível entender a atividade, como em "dinner at Coco Bambu friday 20:00"`,
+	"the activity must happen during the trip":                                           "a atividade deve acontecer durante a viagem",
+
+	// Failures
+	"something went wrong, try again":               "algo deu errado, tente novamente",
+	"fail to get trip activities":                   "falha ao buscar as atividades da viagem",
+	"fail to get trip budget":                       "falha ao buscar o orçamento da viagem",
+	"fail to get trip checklist":                    "falha ao buscar o checklist da viagem",
+	"fail to get trip conflicts":                    "falha ao buscar os conflitos da viagem",
+	"fail to get trip expenses":                     "falha ao buscar as despesas da viagem",
+	"fail to get trip expenses breakdown":           "falha ao buscar o detalhamento das despesas da viagem",
+	"fail to get trip groups":                       "falha ao buscar os grupos da viagem",
+	"fail to get trip links":                        "falha ao buscar os links da viagem",
+	"fail to get trip lodgings":                     "falha ao buscar as hospedagens da viagem",
+	"fail to get trip needs summary":                "falha ao buscar o resumo das necessidades da viagem",
+	"fail to get trip participants":                 "falha ao buscar os participantes da viagem",
+	"fail to get trip planning status":              "falha ao buscar o status do planejamento da viagem",
+	"fail to get trip settlement":                   "falha ao buscar o acerto de contas da viagem",
+	"fail to get trip shopping list":                "falha ao buscar a lista de compras da viagem",
+	"fail to get trip survey":                       "falha ao buscar a pesquisa da viagem",
+	"fail to get trip survey results":               "falha ao buscar os resultados da pesquisa da viagem",
+	"fail to get trip tasks":                        "falha ao buscar as tarefas da viagem",
+	"fail to get trip transports":                   "falha ao buscar os transportes da viagem",
+	"fail to get date poll results":                 "falha ao buscar os resultados da enquete de datas",
+	"fail to insert attachment":                     "falha ao inserir o anexo",
+	"fail to insert checklist item":                 "falha ao inserir o item do checklist",
+	"fail to insert checklist items":                "falha ao inserir os itens do checklist",
+	"fail to insert receipt":                        "falha ao inserir o recibo",
+	"fail to insert shopping item":                  "falha ao inserir o item de compra",
+	"fail to insert task":                           "falha ao inserir a tarefa",
+	"fail to insert trip link":                      "falha ao inserir o link da viagem",
+	"failed to add companion, try again":            "falha ao adicionar o acompanhante, tente novamente",
+	"failed to add owner, try again":                "falha ao adicionar o dono, tente novamente",
+	"failed to approve activity, try again":         "falha ao aprovar a atividade, tente novamente",
+	"failed to approve lodging, try again":          "falha ao aprovar a hospedagem, tente novamente",
+	"failed to assign organizer, try again":         "falha ao atribuir o organizador, tente novamente",
+	"failed to assign room, try again":              "falha ao atribuir o quarto, tente novamente",
+	"failed to cancel ride, try again":              "falha ao cancelar a carona, tente novamente",
+	"failed to change owner email, try again":       "falha ao trocar o email do dono, tente novamente",
+	"failed to claim seat, try again":               "falha ao reservar o lugar, tente novamente",
+	"failed to claim shopping item, try again":      "falha ao reservar o item de compra, tente novamente",
+	"failed to complete attachment, try again":      "falha ao concluir o anexo, tente novamente",
+	"failed to confirm trip, try again":             "falha ao confirmar a viagem, tente novamente",
+	"failed to connect google account, try again":   "falha ao conectar a conta google, tente novamente",
+	"failed to connect trip, try again":             "falha ao conectar a viagem, tente novamente",
+	"failed to create activities, try again":        "falha ao criar as atividades, tente novamente",
+	"failed to create activity, try again":          "falha ao criar a atividade, tente novamente",
+	"failed to create date poll, try again":         "falha ao criar a enquete de datas, tente novamente",
+	"failed to create expense, try again":           "falha ao criar a despesa, tente novamente",
+	"failed to create group, try again":             "falha ao criar o grupo, tente novamente",
+	"failed to create lodging, try again":           "falha ao criar a hospedagem, tente novamente",
+	"failed to create ride, try again":              "falha ao criar a carona, tente novamente",
+	"failed to create room, try again":              "falha ao criar o quarto, tente novamente",
+	"failed to create spreadsheet, try again":       "falha ao criar a planilha, tente novamente",
+	"failed to create transport, try again":         "falha ao criar o transporte, tente novamente",
+	"failed to create trip, try again":              "falha ao criar a viagem, tente novamente",
+	"failed to delete activity, try again":          "falha ao excluir a atividade, tente novamente",
+	"failed to delete checklist item, try again":    "falha ao excluir o item do checklist, tente novamente",
+	"failed to delete group, try again":             "falha ao excluir o grupo, tente novamente",
+	"failed to delete link, try again":              "falha ao excluir o link, tente novamente",
+	"failed to delete room, try again":              "falha ao excluir o quarto, tente novamente",
+	"failed to delete shopping item, try again":     "falha ao excluir o item de compra, tente novamente",
+	"failed to delete task, try again":              "falha ao excluir a tarefa, tente novamente",
+	"failed to disconnect trip, try again":          "falha ao desconectar a viagem, tente novamente",
+	"failed to draft itinerary, try again":          "falha ao rascunhar o roteiro, tente novamente",
+	"failed to estimate route, try again":           "falha ao estimar a rota, tente novamente",
+	"failed to export expenses, try again":          "falha ao exportar as despesas, tente novamente",
+	"failed to export rooming list, try again":      "falha ao exportar a lista de quartos, tente novamente",
+	"failed to export trip, try again":              "falha ao exportar a viagem, tente novamente",
+	"failed to get trip trash, try again":           "falha ao obter a lixeira da viagem, tente novamente",
+	"failed to give up seat, try again":             "falha ao liberar o lugar, tente novamente",
+	"failed to import invites, try again":           "falha ao importar os convites, tente novamente",
+	"failed to import trip, try again":              "falha ao importar a viagem, tente novamente",
+	"failed to invite participant, try again":       "falha ao convidar o participante, tente novamente",
+	"failed to insert trip links, try again":        "falha ao inserir os links da viagem, tente novamente",
+	"failed to merge trips, try again":              "falha ao mesclar as viagens, tente novamente",
+	"failed to pick date poll option, try again":    "falha ao escolher a opção da enquete de datas, tente novamente",
+	"failed to print trip, try again":               "falha ao imprimir a viagem, tente novamente",
+	"failed to reject activity, try again":          "falha ao rejeitar a atividade, tente novamente",
+	"failed to reject lodging, try again":           "falha ao rejeitar a hospedagem, tente novamente",
+	"failed to remove companion, try again":         "falha ao remover o acompanhante, tente novamente",
+	"failed to remove owner, try again":             "falha ao remover o dono, tente novamente",
+	"failed to reorder activities, try again":       "falha ao reordenar as atividades, tente novamente",
+	"failed to report trip, try again":              "falha ao denunciar a viagem, tente novamente",
+	"failed to restore activity, try again":         "falha ao restaurar a atividade, tente novamente",
+	"failed to restore link, try again":             "falha ao restaurar o link, tente novamente",
+	"failed to review shared trip, try again":       "falha ao revisar a viagem compartilhada, tente novamente",
+	"failed to save date poll answer, try again":    "falha ao salvar a resposta da enquete de datas, tente novamente",
+	"failed to save survey answer, try again":       "falha ao salvar a resposta da pesquisa, tente novamente",
+	"failed to share trip, try again":               "falha ao compartilhar a viagem, tente novamente",
+	"failed to stop sharing trip, try again":        "falha ao parar de compartilhar a viagem, tente novamente",
+	"failed to transfer trip, try again":            "falha ao transferir a viagem, tente novamente",
+	"failed to unassign organizer, try again":       "falha ao remover o organizador, tente novamente",
+	"failed to unclaim shopping item, try again":    "falha ao liberar o item de compra, tente novamente",
+	"failed to update checklist item, try again":    "falha ao atualizar o item do checklist, tente novamente",
+	"failed to update group, try again":             "falha ao atualizar o grupo, tente novamente",
+	"failed to update participant, try again":       "falha ao atualizar o participante, tente novamente",
+	"failed to update participant needs, try again": "falha ao atualizar as necessidades do participante, tente novamente",
+	"failed to update survey, try again":            "falha ao atualizar a pesquisa, tente novamente",
+	"failed to update task, try again":              "falha ao atualizar a tarefa, tente novamente",
+	"failed to update trip settings, try again":     "falha ao atualizar as configurações da viagem, tente novamente",
+	"failed to update trip status, try again":       "falha ao atualizar o status da viagem, tente novamente",
+	"failed to update trip tags, try again":         "falha ao atualizar as tags da viagem, tente novamente",
+	"failed to update trip, try again":              "falha ao atualizar a viagem, tente novamente",
+}
+
+// messagePattern translates the messages with a variable part, which the
+// translation refers to as $1, $2 and so on.
+type messagePattern struct {
+	re   *regexp.Regexp
+	ptBR string
+}
+
+// messagePatterns are tried in order, for messages not in messages.
+var messagePatterns = []messagePattern{
+	{regexp.MustCompile(`^invalid json: (.*)$`), "json inválido: $1"},
+	{regexp.MustCompile(`^invalid fields: (.*)$`), "campos inválidos: $1"},
+	{regexp.MustCompile(`^invalid sort: (.*)$`), "ordenação inválida: $1"},
+	{regexp.MustCompile(`^invalid split: (.*)$`), "divisão inválida: $1"},
+	{regexp.MustCompile(`^invalid bundle: (.*)$`), "pacote inválido: $1"},
+	{regexp.MustCompile(`^invalid csv: at most (\d+) rows can be imported at once$`), "csv inválido: no máximo $1 linhas podem ser importadas de uma vez"},
+	{regexp.MustCompile(`^invalid csv: (.*)$`), "csv inválido: $1"},
+	{regexp.MustCompile(`^invalid links: at most (\d+) links can be added at once$`), "links inválidos: no máximo $1 links podem ser adicionados de uma vez"},
+	{regexp.MustCompile(`^invalid links: (.*)$`), "links inválidos: $1"},
+	{regexp.MustCompile(`^participant not found: (.*)$`), "participante não encontrado: $1"},
+	{regexp.MustCompile(`^participant is on the waitlist: (.*)$`), "o participante está na lista de espera: $1"},
+	{regexp.MustCompile(`^participant declined the invitation: (.*)$`), "o participante recusou o convite: $1"},
+	{regexp.MustCompile(`^attachment content is not (.*)$`), "o conteúdo do anexo não é $1"},
+	{regexp.MustCompile(`^date poll option not found: (.*)$`), "opção da enquete de datas não encontrada: $1"},
+	{regexp.MustCompile(`^survey question not found: (.*)$`), "pergunta da pesquisa não encontrada: $1"},
+	{regexp.MustCompile(`^text questions take a comment only: (.*)$`), "perguntas de texto aceitam apenas comentário: $1"},
+	{regexp.MustCompile(`^rating questions take a rating only: (.*)$`), "perguntas de nota aceitam apenas nota: $1"},
+	{regexp.MustCompile(`^receipt must be at most (\d+)MB$`), "o recibo deve ter no máximo $1MB"},
+	{regexp.MustCompile(`^attachment must be at most (\d+)MB$`), "o anexo deve ter no máximo $1MB"},
+	{regexp.MustCompile(`^trip attachments can take at most (\d+)MB$`), "os anexos da viagem podem ocupar no máximo $1MB"},
+	{regexp.MustCompile(`^drafts are only made for trips of up to (\d+) days$`), "rascunhos só são feitos para viagens de até $1 dias"},
+	{regexp.MustCompile(`^room sleeps (\d+), not (\d+) people counting companions$`), "o quarto acomoda $1, não $2 pessoas contando os acompanhantes"},
+}
+
+// validationPatterns translate the problems found by the request validator
+// and by the validator of request bodies, and the references found missing,
+// in ValidationError details.
+var validationPatterns = []messagePattern{
+	{regexp.MustCompile(`^property "([^"]*)" is missing$`), `a propriedade "$1" é obrigatória`},
+	{regexp.MustCompile(`^property "([^"]*)" is unsupported$`), `a propriedade "$1" não é suportada`},
+	{regexp.MustCompile(`^value is required but missing$`), "o valor é obrigatório"},
+	{regexp.MustCompile(`^value must be an email$`), "o valor deve ser um email"},
+	{regexp.MustCompile(`^value must be a url$`), "o valor deve ser uma url"},
+	{regexp.MustCompile(`^value must be a uuid$`), "o valor deve ser um uuid"},
+	{regexp.MustCompile(`^value must be a timezone$`), "o valor deve ser um fuso horário"},
+	{regexp.MustCompile(`^value must be a currency code$`), "o valor deve ser um código de moeda"},
+	{regexp.MustCompile(`^value must be after (\S+)$`), "o valor deve ser depois de $1"},
+	{regexp.MustCompile(`^value breaks the (\S+) rule$`), "o valor não passou na regra $1"},
+	{regexp.MustCompile(`^value must be an? (\w+)$`), "o valor deve ser do tipo $1"},
+	{regexp.MustCompile(`^string doesn't match the format "([^"]*)".*$`), `o texto não corresponde ao formato "$1"`},
+	{regexp.MustCompile(`^minimum string length is (\d+)$`), "o tamanho mínimo do texto é $1"},
+	{regexp.MustCompile(`^maximum string length is (\d+)$`), "o tamanho máximo do texto é $1"},
+	{regexp.MustCompile(`^number must be at least (\S+)$`), "o número deve ser pelo menos $1"},
+	{regexp.MustCompile(`^number must be at most (\S+)$`), "o número deve ser no máximo $1"},
+	{regexp.MustCompile(`^number must be more than (\S+)$`), "o número deve ser maior que $1"},
+	{regexp.MustCompile(`^minimum number of items is (\d+)$`), "o número mínimo de itens é $1"},
+	{regexp.MustCompile(`^maximum number of items is (\d+)$`), "o número máximo de itens é $1"},
+	{regexp.MustCompile(`^value is not one of the allowed values (.*)$`), "o valor não é um dos permitidos $1"},
+	{regexp.MustCompile(`^date poll option was removed: (.*)$`), "a opção da enquete de datas foi removida: $1"},
+	{regexp.MustCompile(`^survey question was removed: (.*)$`), "a pergunta da pesquisa foi removida: $1"},
+}
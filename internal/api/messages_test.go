@@ -0,0 +1,85 @@
+package api
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// TestMessagesHaveCodesAndTranslations checks every error the handlers build
+// sets its code, and that the messages written out in full are translated,
+// so rewording one does not silently leave it untranslated.
+func TestMessagesHaveCodesAndTranslations(t *testing.T) {
+	files, err := filepath.Glob("*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fset := token.NewFileSet()
+	for _, name := range files {
+		if strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, name, nil, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		ast.Inspect(f, func(n ast.Node) bool {
+			var code, message ast.Expr
+			switch n := n.(type) {
+			case *ast.CompositeLit:
+				sel, ok := n.Type.(*ast.SelectorExpr)
+				if !ok || sel.Sel.Name != "Error" && sel.Sel.Name != "ValidationError" {
+					return true
+				}
+				for _, elt := range n.Elts {
+					kv, ok := elt.(*ast.KeyValueExpr)
+					if !ok {
+						continue
+					}
+					switch kv.Key.(*ast.Ident).Name {
+					case "Code":
+						code = kv.Value
+					case "Message":
+						message = kv.Value
+					}
+				}
+			case *ast.CallExpr:
+				fn, ok := n.Fun.(*ast.Ident)
+				if !ok || len(n.Args) < 2 {
+					return true
+				}
+				switch fn.Name {
+				case "badRequest", "notFound", "missingReference":
+					code, message = n.Args[0], n.Args[1]
+				case "writeError":
+					code, message = n.Args[2], n.Args[3]
+				default:
+					return true
+				}
+			default:
+				return true
+			}
+
+			if message == nil {
+				return true
+			}
+			pos := fset.Position(n.Pos())
+			if code == nil {
+				t.Errorf("%s: error without a code", pos)
+			}
+			if lit, ok := message.(*ast.BasicLit); ok && lit.Kind == token.STRING {
+				msg, _ := strconv.Unquote(lit.Value)
+				if translate(msg, validationPatterns) == msg && translate(msg, messagePatterns) == msg {
+					t.Errorf("%s: %q has no translation", pos, msg)
+				}
+			}
+			return true
+		})
+	}
+}
@@ -0,0 +1,244 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/export"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/moderation"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+// reportsToHold is how many clients reporting a shared trip get it held for
+// review.
+const reportsToHold = 3
+
+// Report a shared trip.
+// (POST /shared/{shareToken}/reports)
+func (api *API) PostSharedShareTokenReports(w http.ResponseWriter, r *http.Request, shareToken string) *spec.Response {
+	trip, errResp := api.getSharedTrip(r.Context(), shareToken)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostSharedShareTokenReportsJSON400Response, spec.PostSharedShareTokenReportsJSON404Response)
+	}
+
+	var body spec.ContentReportRequest
+	if errJson := json.NewDecoder(r.Body).Decode(&body); errJson != nil {
+		return spec.PostSharedShareTokenReportsJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + errJson.Error()})
+	}
+
+	if errVal := api.validator.Struct(body); errVal != nil {
+		return spec.PostSharedShareTokenReportsJSON422Response(invalidBody(errVal))
+	}
+
+	var details pgtype.Text
+	if body.Details != nil && *body.Details != "" {
+		details = pgtype.Text{Valid: true, String: *body.Details}
+	}
+
+	rows, err := api.store.InsertContentReport(r.Context(), pgstore.InsertContentReportParams{
+		TripID:     trip.ID,
+		Reason:     body.Reason,
+		Details:    details,
+		RemoteAddr: clientIP(r),
+	})
+	if err != nil {
+		api.logger.Error("failed to insert content report", zap.Error(err), zap.String("trip_id", trip.ID.String()))
+		return spec.PostSharedShareTokenReportsJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to report trip, try again",
+		})
+	}
+	if rows == 0 {
+		return spec.PostSharedShareTokenReportsJSON204Response(nil)
+	}
+
+	reports, err := api.store.CountOpenContentReports(r.Context(), trip.ID)
+	if err != nil {
+		api.logger.Error("failed to count content reports", zap.Error(err), zap.String("trip_id", trip.ID.String()))
+		return spec.PostSharedShareTokenReportsJSON204Response(nil)
+	}
+
+	if reports >= reportsToHold {
+		if _, err := api.holdSharedTrip(r.Context(), trip.ID, moderation.ReasonReported, ""); err != nil {
+			api.logger.Error("failed to hold shared trip", zap.Error(err), zap.String("trip_id", trip.ID.String()))
+		}
+	}
+
+	return spec.PostSharedShareTokenReportsJSON204Response(nil)
+}
+
+// Get the shared trips waiting for review.
+// (GET /admin/moderation)
+func (api *API) GetAdminModeration(w http.ResponseWriter, r *http.Request) *spec.Response {
+	queue, err := api.store.GetModerationQueue(r.Context())
+	if err != nil {
+		api.logger.Error("failed to get moderation queue", zap.Error(err))
+		return spec.GetAdminModerationJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	reports, err := api.store.GetOpenContentReports(r.Context())
+	if err != nil {
+		api.logger.Error("failed to get content reports", zap.Error(err))
+		return spec.GetAdminModerationJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	byTrip := make(map[uuid.UUID][]spec.ModerationReport)
+	for _, report := range reports {
+		var details *string
+		if report.Details.Valid {
+			details = &report.Details.String
+		}
+		byTrip[report.TripID] = append(byTrip[report.TripID], spec.ModerationReport{
+			Reason:    report.Reason,
+			Details:   details,
+			CreatedAt: report.CreatedAt.Time,
+		})
+	}
+
+	response := spec.ModerationQueueResponse{Trips: make([]spec.ModerationQueueTrip, 0, len(queue))}
+	for _, trip := range queue {
+		item := spec.ModerationQueueTrip{
+			TripID:      trip.TripID.String(),
+			Destination: trip.Destination,
+			Reports:     byTrip[trip.TripID],
+		}
+		if trip.HeldAt.Valid {
+			item.HeldAt = &trip.HeldAt.Time
+		}
+		if trip.HeldReason.Valid {
+			item.HeldReason = &trip.HeldReason.String
+		}
+		if item.Reports == nil {
+			item.Reports = []spec.ModerationReport{}
+		}
+		response.Trips = append(response.Trips, item)
+	}
+
+	return spec.GetAdminModerationJSON200Response(response)
+}
+
+// Approve a shared trip.
+// (POST /admin/moderation/{tripId}/approve)
+func (api *API) PostAdminModerationTripIDApprove(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.PostAdminModerationTripIDApproveJSON400Response(errID.Error)
+	}
+
+	trip, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostAdminModerationTripIDApproveJSON400Response, spec.PostAdminModerationTripIDApproveJSON404Response)
+	}
+
+	itinerary, err := export.Trip(r.Context(), api.store, trip.Domain())
+	if err != nil {
+		api.logger.Error("failed to build itinerary", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostAdminModerationTripIDApproveJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to review shared trip, try again",
+		})
+	}
+
+	digest := moderation.Digest(itineraryTexts(itinerary))
+	if err := api.store.ApproveSharedTrip(r.Context(), api.pool, id, digest); err != nil {
+		if errors.Is(err, pgstore.ErrNotFound) {
+			return spec.PostAdminModerationTripIDApproveJSON404Response(spec.Error{
+				Code:    "trip_not_shared",
+				Message: "trip is not shared",
+			})
+		}
+		api.logger.Error("failed to approve shared trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostAdminModerationTripIDApproveJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to review shared trip, try again",
+		})
+	}
+
+	return spec.PostAdminModerationTripIDApproveJSON204Response(nil)
+}
+
+// Reject a shared trip.
+// (POST /admin/moderation/{tripId}/reject)
+func (api *API) PostAdminModerationTripIDReject(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.PostAdminModerationTripIDRejectJSON400Response(errID.Error)
+	}
+
+	if err := api.store.RejectSharedTrip(r.Context(), api.pool, id); err != nil {
+		if errors.Is(err, pgstore.ErrNotFound) {
+			return spec.PostAdminModerationTripIDRejectJSON404Response(spec.Error{
+				Code:    "trip_not_shared",
+				Message: "trip is not shared",
+			})
+		}
+		api.logger.Error("failed to reject shared trip", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostAdminModerationTripIDRejectJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to review shared trip, try again",
+		})
+	}
+
+	return spec.PostAdminModerationTripIDRejectJSON204Response(nil)
+}
+
+// moderateItinerary holds the shared itinerary for review when the heuristics
+// flag it, telling whether it was held. Itineraries an admin approved are
+// left shown as long as they are not edited.
+func (api *API) moderateItinerary(ctx context.Context, tripID uuid.UUID, itinerary export.Itinerary) (bool, error) {
+	texts := itineraryTexts(itinerary)
+	reason, flagged := moderation.Review(texts)
+	if !flagged {
+		return false, nil
+	}
+
+	return api.holdSharedTrip(ctx, tripID, reason, moderation.Digest(texts))
+}
+
+// holdSharedTrip unlists the shared trip until an admin reviews it, telling
+// whether it was held. Given the digest of its texts, the trip is not held
+// when an admin approved those very texts; without one it is held anyway.
+func (api *API) holdSharedTrip(ctx context.Context, tripID uuid.UUID, reason, digest string) (bool, error) {
+	rows, err := api.store.HoldTripShare(ctx, pgstore.HoldTripShareParams{
+		Reason: pgtype.Text{Valid: true, String: reason},
+		TripID: tripID,
+		Digest: digest,
+	})
+	if err != nil {
+		return false, err
+	}
+	if rows == 0 {
+		return false, nil
+	}
+
+	api.logger.Info("shared trip held for review", zap.String("trip_id", tripID.String()), zap.String("reason", reason))
+	return true, nil
+}
+
+// itineraryTexts are the texts of the itinerary its owners wrote: its
+// destination and the titles and notes of its items and links.
+func itineraryTexts(itinerary export.Itinerary) []string {
+	texts := []string{itinerary.Destination}
+	for _, day := range itinerary.Days {
+		for _, item := range day.Items {
+			texts = append(texts, item.Title)
+			texts = append(texts, item.Notes...)
+		}
+	}
+	for _, link := range itinerary.Links {
+		texts = append(texts, link.Title)
+	}
+	return texts
+}
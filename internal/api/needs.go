@@ -0,0 +1,196 @@
+package api
+
+import (
+	"cmp"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"slices"
+
+	"github.com/discord-gophers/goapi-gen/types"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+// Get a participant dietary and accessibility needs.
+// (GET /participants/{participantId}/needs)
+func (api *API) GetParticipantsParticipantIDNeeds(w http.ResponseWriter, r *http.Request, participantID string) *spec.Response {
+	id, errID := pathID(r.Context(), "participantId", participantID)
+	if errID != nil {
+		return spec.GetParticipantsParticipantIDNeedsJSON400Response(errID.Error)
+	}
+
+	if _, err := api.store.GetParticipant(r.Context(), id); err != nil {
+		if errors.Is(err, pgstore.ErrNotFound) {
+			return spec.GetParticipantsParticipantIDNeedsJSON404Response(spec.Error{
+				Code:    "participant_not_found",
+				Message: "participant not found",
+			})
+		}
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.GetParticipantsParticipantIDNeedsJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	needs, err := api.store.GetParticipantNeeds(r.Context(), id)
+	if err != nil && !errors.Is(err, pgstore.ErrNotFound) {
+		api.logger.Error("failed to get participant needs", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.GetParticipantsParticipantIDNeedsJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	return spec.GetParticipantsParticipantIDNeedsJSON200Response(spec.GetParticipantNeedsResponse{
+		Dietary:       nonNil(needs.Dietary),
+		Accessibility: nonNil(needs.Accessibility),
+		Notes:         needs.Notes,
+	})
+}
+
+// Update a participant dietary and accessibility needs.
+// (PUT /participants/{participantId}/needs)
+func (api *API) PutParticipantsParticipantIDNeeds(w http.ResponseWriter, r *http.Request, participantID string) *spec.Response {
+	id, errID := pathID(r.Context(), "participantId", participantID)
+	if errID != nil {
+		return spec.PutParticipantsParticipantIDNeedsJSON400Response(errID.Error)
+	}
+
+	participant, err := api.store.GetParticipant(r.Context(), id)
+	if err != nil {
+		if errors.Is(err, pgstore.ErrNotFound) {
+			return spec.PutParticipantsParticipantIDNeedsJSON404Response(spec.Error{
+				Code:    "participant_not_found",
+				Message: "participant not found",
+			})
+		}
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.PutParticipantsParticipantIDNeedsJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	if !participant.IsConfirmed {
+		return spec.PutParticipantsParticipantIDNeedsJSON400Response(spec.Error{
+			Code:    "participant_not_confirmed",
+			Message: "participant not confirmed",
+		})
+	}
+
+	var body spec.PutParticipantsParticipantIDNeedsJSONBody
+	if errJson := json.NewDecoder(r.Body).Decode(&body); errJson != nil {
+		return spec.PutParticipantsParticipantIDNeedsJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + errJson.Error()})
+	}
+
+	if errVal := api.validator.Struct(body); errVal != nil {
+		return spec.PutParticipantsParticipantIDNeedsJSON422Response(invalidBody(errVal))
+	}
+
+	if err := api.store.UpsertParticipantNeeds(r.Context(), pgstore.UpsertParticipantNeedsParams{
+		ParticipantID: participant.ID,
+		Dietary:       distinct(body.Dietary),
+		Accessibility: distinct(body.Accessibility),
+		Notes:         body.Notes,
+	}); err != nil {
+		api.logger.Error("failed to update participant needs", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.PutParticipantsParticipantIDNeedsJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to update participant needs, try again",
+		})
+	}
+
+	return spec.PutParticipantsParticipantIDNeedsJSON204Response(nil)
+}
+
+// Get a trip participants needs summary.
+// (GET /trips/{tripId}/needs-summary)
+func (api *API) GetTripsTripIDNeedsSummary(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.GetTripsTripIDNeedsSummaryJSON400Response(errID.Error)
+	}
+
+	_, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDNeedsSummaryJSON400Response, spec.GetTripsTripIDNeedsSummaryJSON404Response)
+	}
+
+	participants, err := api.store.GetParticipants(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get participants", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDNeedsSummaryJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "fail to get trip needs summary",
+		})
+	}
+
+	rows, err := api.store.GetTripParticipantNeeds(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get participant needs", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDNeedsSummaryJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "fail to get trip needs summary",
+		})
+	}
+
+	dietary := make(map[string]int)
+	accessibility := make(map[string]int)
+	notes := make([]spec.GetNeedsSummaryResponseNoteArray, 0)
+	for _, row := range rows {
+		for _, need := range row.Dietary {
+			dietary[need]++
+		}
+		for _, need := range row.Accessibility {
+			accessibility[need]++
+		}
+		if row.Notes != "" {
+			notes = append(notes, spec.GetNeedsSummaryResponseNoteArray{
+				ParticipantID: row.ID.String(),
+				Email:         types.Email(row.Email),
+				Notes:         row.Notes,
+			})
+		}
+	}
+
+	return spec.GetTripsTripIDNeedsSummaryJSON200Response(spec.GetNeedsSummaryResponse{
+		Participants:  len(participants),
+		Responded:     len(rows),
+		Dietary:       needCounts(dietary),
+		Accessibility: needCounts(accessibility),
+		Notes:         notes,
+	})
+}
+
+// needCounts lists needs from the most to the least common.
+func needCounts(counts map[string]int) []spec.GetNeedsSummaryResponseNeedArray {
+	needs := make([]spec.GetNeedsSummaryResponseNeedArray, 0, len(counts))
+	for need, count := range counts {
+		needs = append(needs, spec.GetNeedsSummaryResponseNeedArray{Need: need, Count: count})
+	}
+	slices.SortFunc(needs, func(a, b spec.GetNeedsSummaryResponseNeedArray) int {
+		if c := cmp.Compare(b.Count, a.Count); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Need, b.Need)
+	})
+	return needs
+}
+
+// distinct sorts values dropping repeated ones.
+func distinct(values []string) []string {
+	values = slices.Clone(values)
+	slices.Sort(values)
+	return nonNil(slices.Compact(values))
+}
+
+// nonNil makes sure empty lists are encoded as [] rather than null.
+func nonNil(values []string) []string {
+	if values == nil {
+		return []string{}
+	}
+	return values
+}
@@ -0,0 +1,75 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+// Assign an activity organizer.
+// (PUT /trips/{tripId}/activities/{activityId}/organizer)
+func (api *API) PutTripsTripIDActivitiesActivityIDOrganizer(w http.ResponseWriter, r *http.Request, tripID string, activityID string) *spec.Response {
+	activity, errResp := api.getTripActivity(r.Context(), tripID, activityID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PutTripsTripIDActivitiesActivityIDOrganizerJSON400Response, spec.PutTripsTripIDActivitiesActivityIDOrganizerJSON404Response)
+	}
+
+	var body spec.AssignOrganizerRequest
+	if errJson := json.NewDecoder(r.Body).Decode(&body); errJson != nil {
+		return spec.PutTripsTripIDActivitiesActivityIDOrganizerJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + errJson.Error()})
+	}
+
+	if errVal := api.validator.Struct(body); errVal != nil {
+		return spec.PutTripsTripIDActivitiesActivityIDOrganizerJSON422Response(invalidBody(errVal))
+	}
+
+	_, participant, errResp := api.getTripParticipant(r.Context(), tripID, body.ParticipantID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PutTripsTripIDActivitiesActivityIDOrganizerJSON400Response, spec.PutTripsTripIDActivitiesActivityIDOrganizerJSON404Response)
+	}
+
+	if participant.Status != pgstore.ParticipantInvited {
+		return spec.PutTripsTripIDActivitiesActivityIDOrganizerJSON400Response(spec.Error{
+			Code:    "participant_not_going",
+			Message: "participant is not going on the trip",
+		})
+	}
+
+	if err := api.store.SetActivityOrganizer(r.Context(), pgstore.SetActivityOrganizerParams{
+		OrganizerID: pgtype.UUID{Valid: true, Bytes: participant.ID},
+		ID:          activity.ID,
+	}); err != nil {
+		api.logger.Error("failed to assign organizer", zap.Error(err), zap.String("activity_id", activityID))
+		return spec.PutTripsTripIDActivitiesActivityIDOrganizerJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to assign organizer, try again",
+		})
+	}
+
+	return spec.PutTripsTripIDActivitiesActivityIDOrganizerJSON204Response(nil)
+}
+
+// Unassign an activity organizer.
+// (DELETE /trips/{tripId}/activities/{activityId}/organizer)
+func (api *API) DeleteTripsTripIDActivitiesActivityIDOrganizer(w http.ResponseWriter, r *http.Request, tripID string, activityID string) *spec.Response {
+	activity, errResp := api.getTripActivity(r.Context(), tripID, activityID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.DeleteTripsTripIDActivitiesActivityIDOrganizerJSON400Response, spec.DeleteTripsTripIDActivitiesActivityIDOrganizerJSON404Response)
+	}
+
+	if err := api.store.SetActivityOrganizer(r.Context(), pgstore.SetActivityOrganizerParams{
+		ID: activity.ID,
+	}); err != nil {
+		api.logger.Error("failed to unassign organizer", zap.Error(err), zap.String("activity_id", activityID))
+		return spec.DeleteTripsTripIDActivitiesActivityIDOrganizerJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to unassign organizer, try again",
+		})
+	}
+
+	return spec.DeleteTripsTripIDActivitiesActivityIDOrganizerJSON204Response(nil)
+}
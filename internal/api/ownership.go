@@ -0,0 +1,401 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+// ownershipTransferTTL is how long the new owner has to accept a trip
+// handoff before the owner has to ask again.
+const ownershipTransferTTL = 72 * time.Hour
+
+// ownerEmailChangeTTL is how long both addresses have to confirm an owner
+// email change.
+const ownerEmailChangeTTL = 24 * time.Hour
+
+// Transfer a trip to another participant.
+// (POST /trips/{tripId}/transfer-ownership)
+func (api *API) PostTripsTripIDTransferOwnership(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.PostTripsTripIDTransferOwnershipJSON400Response(errID.Error)
+	}
+
+	trip, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDTransferOwnershipJSON400Response, spec.PostTripsTripIDTransferOwnershipJSON404Response)
+	}
+
+	var body spec.TransferOwnershipRequest
+	if errJson := json.NewDecoder(r.Body).Decode(&body); errJson != nil {
+		return spec.PostTripsTripIDTransferOwnershipJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + errJson.Error()})
+	}
+
+	if errVal := api.validator.Struct(body); errVal != nil {
+		return spec.PostTripsTripIDTransferOwnershipJSON422Response(invalidBody(errVal))
+	}
+
+	participant, err := api.store.GetParticipant(r.Context(), uuid.MustParse(body.ParticipantID))
+	if err != nil {
+		if errors.Is(err, pgstore.ErrNotFound) {
+			return spec.PostTripsTripIDTransferOwnershipJSON404Response(spec.Error{
+				Code:    "participant_not_found",
+				Message: "participant not found",
+			})
+		}
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", body.ParticipantID))
+		return spec.PostTripsTripIDTransferOwnershipJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	if participant.TripID != trip.ID {
+		return spec.PostTripsTripIDTransferOwnershipJSON404Response(spec.Error{
+			Code:    "participant_not_found",
+			Message: "participant not found",
+		})
+	}
+
+	if !participant.IsConfirmed || participant.Status != pgstore.ParticipantInvited {
+		return spec.PostTripsTripIDTransferOwnershipJSON400Response(spec.Error{
+			Code:    "participant_not_confirmed",
+			Message: "participant has not confirmed the trip",
+		})
+	}
+
+	if participant.Email == trip.OwnerEmail {
+		return spec.PostTripsTripIDTransferOwnershipJSON400Response(spec.Error{
+			Code:    "participant_already_owner",
+			Message: "participant already owns the trip",
+		})
+	}
+
+	token, err := pgstore.NewOwnershipTransferToken()
+	if err != nil {
+		api.logger.Error("failed to generate ownership transfer token", zap.Error(err))
+		return spec.PostTripsTripIDTransferOwnershipJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	if err := api.store.CreateOwnershipTransfer(r.Context(), pgstore.CreateOwnershipTransferParams{
+		Token:         token,
+		TripID:        trip.ID,
+		ParticipantID: participant.ID,
+		ExpiresAt:     pgtype.Timestamp{Valid: true, Time: time.Now().Add(ownershipTransferTTL)},
+	}); err != nil {
+		api.logger.Error("failed to create ownership transfer", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDTransferOwnershipJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to transfer trip, try again",
+		})
+	}
+
+	go func() {
+		if err := api.mailer.SendOwnershipTransferRequest(token); err != nil {
+			api.logger.Error(
+				"failed to send email on PostTripsTripIDTransferOwnership",
+				zap.Error(err),
+				zap.String("trip_id", tripID),
+			)
+		}
+	}()
+
+	return spec.PostTripsTripIDTransferOwnershipJSON204Response(nil)
+}
+
+// Accept a trip ownership transfer.
+// (PATCH /ownership-transfers/{token}/accept)
+func (api *API) PatchOwnershipTransfersTokenAccept(w http.ResponseWriter, r *http.Request, token string) *spec.Response {
+	transfer, err := api.store.GetOwnershipTransfer(r.Context(), token)
+	if err != nil {
+		if errors.Is(err, pgstore.ErrNotFound) {
+			return spec.PatchOwnershipTransfersTokenAcceptJSON404Response(spec.Error{
+				Code:    "ownership_transfer_not_found",
+				Message: "ownership transfer not found",
+			})
+		}
+		api.logger.Error("failed to get ownership transfer", zap.Error(err))
+		return spec.PatchOwnershipTransfersTokenAcceptJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	if time.Now().After(transfer.ExpiresAt.Time) {
+		return spec.PatchOwnershipTransfersTokenAcceptJSON400Response(spec.Error{
+			Code:    "ownership_transfer_expired",
+			Message: "ownership transfer expired",
+		})
+	}
+
+	if err := api.store.TransferOwnership(r.Context(), api.pool, transfer); err != nil {
+		if errors.Is(err, pgstore.ErrTransferParticipantGone) {
+			return spec.PatchOwnershipTransfersTokenAcceptJSON400Response(spec.Error{
+				Code:    "participant_not_confirmed",
+				Message: "participant has not confirmed the trip",
+			})
+		}
+		api.logger.Error("failed to transfer ownership", zap.Error(err), zap.String("trip_id", transfer.TripID.String()))
+		return spec.PatchOwnershipTransfersTokenAcceptJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to transfer trip, try again",
+		})
+	}
+
+	return spec.PatchOwnershipTransfersTokenAcceptJSON204Response(nil)
+}
+
+// Change the trip owner email.
+// (POST /trips/{tripId}/owner-email)
+func (api *API) PostTripsTripIDOwnerEmail(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.PostTripsTripIDOwnerEmailJSON400Response(errID.Error)
+	}
+
+	trip, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDOwnerEmailJSON400Response, spec.PostTripsTripIDOwnerEmailJSON404Response)
+	}
+
+	var body spec.ChangeOwnerEmailRequest
+	if errJson := json.NewDecoder(r.Body).Decode(&body); errJson != nil {
+		return spec.PostTripsTripIDOwnerEmailJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + errJson.Error()})
+	}
+
+	if errVal := api.validator.Struct(body); errVal != nil {
+		return spec.PostTripsTripIDOwnerEmailJSON422Response(invalidBody(errVal))
+	}
+
+	participants, err := api.store.GetParticipants(r.Context(), trip.ID)
+	if err != nil {
+		api.logger.Error("failed to get participants", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDOwnerEmailJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	for _, participant := range participants {
+		if strings.EqualFold(participant.Email, string(body.Email)) {
+			return spec.PostTripsTripIDOwnerEmailJSON400Response(spec.Error{
+				Code:    "email_already_participates",
+				Message: "email already participates in the trip",
+			})
+		}
+	}
+
+	oldToken, err := pgstore.NewOwnerEmailChangeToken()
+	if err != nil {
+		api.logger.Error("failed to generate owner email change token", zap.Error(err))
+		return spec.PostTripsTripIDOwnerEmailJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	newToken, err := pgstore.NewOwnerEmailChangeToken()
+	if err != nil {
+		api.logger.Error("failed to generate owner email change token", zap.Error(err))
+		return spec.PostTripsTripIDOwnerEmailJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	if err := api.store.CreateOwnerEmailChange(r.Context(), pgstore.CreateOwnerEmailChangeParams{
+		TripID:    trip.ID,
+		NewEmail:  string(body.Email),
+		OldToken:  oldToken,
+		NewToken:  newToken,
+		ExpiresAt: pgtype.Timestamp{Valid: true, Time: time.Now().Add(ownerEmailChangeTTL)},
+	}); err != nil {
+		api.logger.Error("failed to create owner email change", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDOwnerEmailJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to change owner email, try again",
+		})
+	}
+
+	go func() {
+		if err := api.mailer.SendOwnerEmailChangeRequest(trip.ID); err != nil {
+			api.logger.Error(
+				"failed to send email on PostTripsTripIDOwnerEmail",
+				zap.Error(err),
+				zap.String("trip_id", tripID),
+			)
+		}
+	}()
+
+	return spec.PostTripsTripIDOwnerEmailJSON204Response(nil)
+}
+
+// Confirm a trip owner email change.
+// (PATCH /owner-email-changes/{token}/confirm)
+func (api *API) PatchOwnerEmailChangesTokenConfirm(w http.ResponseWriter, r *http.Request, token string) *spec.Response {
+	change, err := api.store.GetOwnerEmailChangeByToken(r.Context(), token)
+	if err != nil {
+		if errors.Is(err, pgstore.ErrNotFound) {
+			return spec.PatchOwnerEmailChangesTokenConfirmJSON404Response(spec.Error{
+				Code:    "owner_email_change_not_found",
+				Message: "owner email change not found",
+			})
+		}
+		api.logger.Error("failed to get owner email change", zap.Error(err))
+		return spec.PatchOwnerEmailChangesTokenConfirmJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	if time.Now().After(change.ExpiresAt.Time) {
+		return spec.PatchOwnerEmailChangesTokenConfirmJSON400Response(spec.Error{
+			Code:    "owner_email_change_expired",
+			Message: "owner email change expired",
+		})
+	}
+
+	confirmed, err := api.store.ConfirmOwnerEmail(r.Context(), api.pool, token)
+	if err != nil {
+		if errors.Is(err, pgstore.ErrOwnerEmailInUse) {
+			return spec.PatchOwnerEmailChangesTokenConfirmJSON400Response(spec.Error{
+				Code:    "email_already_participates",
+				Message: "email already participates in the trip",
+			})
+		}
+		api.logger.Error("failed to confirm owner email change", zap.Error(err), zap.String("trip_id", change.TripID.String()))
+		return spec.PatchOwnerEmailChangesTokenConfirmJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to change owner email, try again",
+		})
+	}
+
+	return spec.PatchOwnerEmailChangesTokenConfirmJSON200Response(spec.ConfirmOwnerEmailChangeResponse{
+		Applied: confirmed.Confirmed(),
+	})
+}
+
+// Add a trip owner.
+// (POST /trips/{tripId}/owners)
+func (api *API) PostTripsTripIDOwners(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	var body spec.AddOwnerRequest
+	if errJson := json.NewDecoder(r.Body).Decode(&body); errJson != nil {
+		return spec.PostTripsTripIDOwnersJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + errJson.Error()})
+	}
+
+	if errVal := api.validator.Struct(body); errVal != nil {
+		return spec.PostTripsTripIDOwnersJSON422Response(invalidBody(errVal))
+	}
+
+	_, participant, errResp := api.getTripParticipant(r.Context(), tripID, body.ParticipantID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDOwnersJSON400Response, spec.PostTripsTripIDOwnersJSON404Response)
+	}
+
+	if participant.Role == pgstore.RoleOwner {
+		return spec.PostTripsTripIDOwnersJSON400Response(spec.Error{
+			Code:    "participant_already_owner",
+			Message: "participant already owns the trip",
+		})
+	}
+
+	if !participant.IsConfirmed || participant.Status != pgstore.ParticipantInvited {
+		return spec.PostTripsTripIDOwnersJSON400Response(spec.Error{
+			Code:    "participant_not_confirmed",
+			Message: "participant has not confirmed the trip",
+		})
+	}
+
+	if err := api.store.SetParticipantRole(r.Context(), pgstore.SetParticipantRoleParams{
+		Role: pgstore.RoleOwner,
+		ID:   participant.ID,
+	}); err != nil {
+		api.logger.Error("failed to add owner", zap.Error(err), zap.String("participant_id", body.ParticipantID))
+		return spec.PostTripsTripIDOwnersJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to add owner, try again",
+		})
+	}
+
+	return spec.PostTripsTripIDOwnersJSON204Response(nil)
+}
+
+// Remove a trip owner.
+// (DELETE /trips/{tripId}/owners/{participantId})
+func (api *API) DeleteTripsTripIDOwnersParticipantID(w http.ResponseWriter, r *http.Request, tripID string, participantID string) *spec.Response {
+	trip, participant, errResp := api.getTripParticipant(r.Context(), tripID, participantID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.DeleteTripsTripIDOwnersParticipantIDJSON400Response, spec.DeleteTripsTripIDOwnersParticipantIDJSON404Response)
+	}
+
+	if participant.Role != pgstore.RoleOwner {
+		return spec.DeleteTripsTripIDOwnersParticipantIDJSON400Response(spec.Error{
+			Code:    "participant_not_owner",
+			Message: "participant is not an owner",
+		})
+	}
+
+	if err := api.store.RemoveOwner(r.Context(), api.pool, trip, participant); err != nil {
+		if errors.Is(err, pgstore.ErrLastOwner) {
+			return spec.DeleteTripsTripIDOwnersParticipantIDJSON400Response(spec.Error{
+				Code:    "trip_needs_owner",
+				Message: "trip must have at least one owner",
+			})
+		}
+		api.logger.Error("failed to remove owner", zap.Error(err), zap.String("participant_id", participantID))
+		return spec.DeleteTripsTripIDOwnersParticipantIDJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to remove owner, try again",
+		})
+	}
+
+	return spec.DeleteTripsTripIDOwnersParticipantIDJSON204Response(nil)
+}
+
+// getTripParticipant loads a trip and one of its participants, returning the
+// error to be sent to the client when either is missing.
+func (api *API) getTripParticipant(ctx context.Context, tripID, participantID string) (pgstore.Trip, pgstore.Participant, *apiError) {
+	tripUUID, errID := pathID(ctx, "tripId", tripID)
+	if errID != nil {
+		return pgstore.Trip{}, pgstore.Participant{}, errID
+	}
+
+	// The participant comes from the body for some operations.
+	participantUUID, err := uuid.Parse(participantID)
+	if err != nil {
+		return pgstore.Trip{}, pgstore.Participant{}, badRequest("invalid_uuid", "invalid uuid")
+	}
+
+	trip, errResp := api.getTrip(ctx, tripUUID)
+	if errResp != nil {
+		return pgstore.Trip{}, pgstore.Participant{}, errResp
+	}
+
+	participant, err := api.store.GetParticipant(ctx, participantUUID)
+	if err != nil {
+		if errors.Is(err, pgstore.ErrNotFound) {
+			return pgstore.Trip{}, pgstore.Participant{}, notFound("participant_not_found", "participant not found")
+		}
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", participantID))
+		return pgstore.Trip{}, pgstore.Participant{}, badRequest(internalError, "something went wrong, try again")
+	}
+
+	if participant.TripID != trip.ID {
+		return pgstore.Trip{}, pgstore.Participant{}, notFound("participant_not_found", "participant not found")
+	}
+
+	return trip, participant, nil
+}
@@ -0,0 +1,32 @@
+package api
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+)
+
+type pathIDsKey struct{}
+
+// withPathIDs returns a copy of ctx holding the UUIDs parsed from the path of
+// the request, by parameter name.
+func withPathIDs(ctx context.Context, ids map[string]uuid.UUID) context.Context {
+	return context.WithValue(ctx, pathIDsKey{}, ids)
+}
+
+// pathID returns the UUID given as the named path parameter. RequestValidator
+// already parsed it for requests that went through it; value is only parsed
+// here for those that did not.
+func pathID(ctx context.Context, name, value string) (uuid.UUID, *apiError) {
+	if ids, ok := ctx.Value(pathIDsKey{}).(map[string]uuid.UUID); ok {
+		if id, ok := ids[name]; ok {
+			return id, nil
+		}
+	}
+
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, badRequest("invalid_uuid", "invalid uuid")
+	}
+	return id, nil
+}
@@ -0,0 +1,58 @@
+package api
+
+import (
+	"net/http"
+
+	"github.com/discord-gophers/goapi-gen/types"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/planning"
+	"go.uber.org/zap"
+)
+
+// Get a trip planning progress.
+// (GET /trips/{tripId}/planning-status)
+func (api *API) GetTripsTripIDPlanningStatus(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.GetTripsTripIDPlanningStatusJSON400Response(errID.Error)
+	}
+
+	trip, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDPlanningStatusJSON400Response, spec.GetTripsTripIDPlanningStatusJSON404Response)
+	}
+
+	status, err := planning.Load(r.Context(), api.store, trip.Domain())
+	if err != nil {
+		api.logger.Error("failed to get planning status", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDPlanningStatusJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "fail to get trip planning status",
+		})
+	}
+
+	response := spec.GetPlanningStatusResponse{
+		DatesConfirmed: status.DatesConfirmed,
+		Participants: spec.GetPlanningStatusResponseParticipantsObj{
+			Total:            status.Participants,
+			Confirmed:        status.Confirmed,
+			ConfirmedPercent: status.ConfirmedPercent,
+		},
+		DaysWithoutActivities:     make([]types.Date, len(status.EmptyDays)),
+		NightsWithoutLodging:      make([]types.Date, len(status.UncoveredNights)),
+		TransfersWithoutTransport: make([]spec.GetPlanningStatusResponseTransferArray, len(status.UncoveredMoves)),
+		Progress:                  status.Progress,
+	}
+
+	for i, day := range status.EmptyDays {
+		response.DaysWithoutActivities[i] = types.Date{Time: day}
+	}
+	for i, night := range status.UncoveredNights {
+		response.NightsWithoutLodging[i] = types.Date{Time: night}
+	}
+	for i, move := range status.UncoveredMoves {
+		response.TransfersWithoutTransport[i] = spec.GetPlanningStatusResponseTransferArray{From: move.From, To: move.To}
+	}
+
+	return spec.GetTripsTripIDPlanningStatusJSON200Response(response)
+}
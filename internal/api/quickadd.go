@@ -0,0 +1,164 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/analytics"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/drafting"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/quickadd"
+	"go.uber.org/zap"
+)
+
+const (
+	// quickAddTimeout bounds how long the client waits for the draft provider
+	// to read a quick add text.
+	quickAddTimeout = 10 * time.Second
+
+	// quickAddWriteTimeout is how long the quick add route has to respond,
+	// past the server write timeout, so the activity saved is not lost to
+	// the client, and added twice when it tries again.
+	quickAddWriteTimeout = quickAddTimeout + 10*time.Second
+)
+
+// Quick add an activity.
+// (POST /trips/{tripId}/activities/quick-add)
+func (api *API) PostTripsTripIDActivitiesQuickAdd(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	api.extendWriteDeadline(w, quickAddWriteTimeout)
+
+	tripUUID, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.PostTripsTripIDActivitiesQuickAddJSON400Response(errID.Error)
+	}
+
+	trip, errResp := api.getTrip(r.Context(), tripUUID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDActivitiesQuickAddJSON400Response, spec.PostTripsTripIDActivitiesQuickAddJSON404Response)
+	}
+
+	var body spec.QuickAddActivityRequest
+	if errJson := json.NewDecoder(r.Body).Decode(&body); errJson != nil {
+		return spec.PostTripsTripIDActivitiesQuickAddJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + errJson.Error()})
+	}
+
+	if errVal := api.validator.Struct(body); errVal != nil {
+		return spec.PostTripsTripIDActivitiesQuickAddJSON422Response(invalidBody(errVal))
+	}
+
+	activity, parsedBy, err := api.parseQuickAdd(r.Context(), trip, body.Text)
+	if err != nil {
+		if errors.Is(err, drafting.ErrLimited) {
+			return spec.PostTripsTripIDActivitiesQuickAddJSON429Response(spec.Error{
+				Code:    "daily_limit_reached",
+				Message: "too many quick adds today, try again tomorrow",
+			})
+		}
+		return spec.PostTripsTripIDActivitiesQuickAddJSON400Response(quickAddHint(err))
+	}
+
+	response := spec.QuickAddActivityResponse{
+		Title:    activity.Title,
+		OccursAt: activity.OccursAt,
+		ParsedBy: parsedBy,
+	}
+
+	if body.Commit == nil || !*body.Commit {
+		return spec.PostTripsTripIDActivitiesQuickAddJSON200Response(response)
+	}
+
+	id, status, err := api.store.AddActivity(r.Context(), api.pool, trip, pgstore.CreateActivityParams{
+		TripID:   tripUUID,
+		Title:    activity.Title,
+		OccursAt: pgtype.Timestamp{Valid: true, Time: activity.OccursAt},
+		Tags:     []string{},
+	})
+	if err != nil {
+		api.logger.Error("failed to add activity", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDActivitiesQuickAddJSON400Response(spec.Error{Code: internalError, Message: "failed to create activity, try again"})
+	}
+
+	if status == pgstore.PlanPending {
+		api.sendBudgetApprovalRequest(tripUUID, activity.Title, "PostTripsTripIDActivitiesQuickAdd")
+	} else {
+		api.recordItineraryChange(r, tripUUID, pgstore.AuditActivityAdded, pgstore.ItineraryChange{Title: activity.Title, OccursAt: &activity.OccursAt})
+	}
+
+	api.events.Count(analytics.ActivityAdded, 1)
+
+	activityID := id.String()
+	response.ActivityID = &activityID
+	response.Status = &status
+
+	return spec.PostTripsTripIDActivitiesQuickAddJSON201Response(response)
+}
+
+// parseQuickAdd reads text with the quickadd parser, falling back to the draft
+// provider for texts it does not understand. It answers with who understood
+// the text, either parser or provider. Errors are those of the parser, or
+// drafting.ErrLimited.
+func (api *API) parseQuickAdd(ctx context.Context, trip pgstore.Trip, text string) (quickadd.Activity, string, error) {
+	startsAt, endsAt := trip.StartsAt.Time, trip.EndsAt.Time
+
+	activity, parseErr := quickadd.Parse(text, startsAt, endsAt, time.Now())
+	if parseErr == nil {
+		return activity, "parser", nil
+	}
+	if errors.Is(parseErr, quickadd.ErrOutsideTrip) {
+		return quickadd.Activity{}, "", parseErr
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, quickAddTimeout)
+	defer cancel()
+
+	proposed, err := api.drafter.Parse(ctx, text, drafting.Request{
+		Destination: trip.Destination,
+		StartsAt:    startsAt,
+		EndsAt:      endsAt,
+	})
+	if err != nil {
+		if errors.Is(err, drafting.ErrLimited) {
+			return quickadd.Activity{}, "", err
+		}
+		if !errors.Is(err, drafting.ErrDisabled) {
+			api.logger.Error("failed to parse quick add", zap.Error(err), zap.String("trip_id", trip.ID.String()))
+		}
+		return quickadd.Activity{}, "", parseErr
+	}
+
+	proposed.Title = strings.TrimSpace(proposed.Title)
+	if proposed.Title == "" {
+		return quickadd.Activity{}, "", quickadd.ErrNoTitle
+	}
+
+	firstDay := time.Date(startsAt.Year(), startsAt.Month(), startsAt.Day(), 0, 0, 0, 0, startsAt.Location())
+	afterLastDay := time.Date(endsAt.Year(), endsAt.Month(), endsAt.Day()+1, 0, 0, 0, 0, endsAt.Location())
+	if proposed.OccursAt.Before(firstDay) || !proposed.OccursAt.Before(afterLastDay) {
+		return quickadd.Activity{}, "", quickadd.ErrOutsideTrip
+	}
+
+	return quickadd.Activity{Title: proposed.Title, OccursAt: proposed.OccursAt}, "provider", nil
+}
+
+// quickAddHint tells what was missing from a text the parser did not
+// understand, with an example of one it does.
+func quickAddHint(err error) spec.Error {
+	const example = `, as in "dinner at Coco Bambu friday 20:00"`
+	switch {
+	case errors.Is(err, quickadd.ErrNoTitle):
+		return spec.Error{Code: "quick_add_no_title", Message: "could not find what the activity is" + example}
+	case errors.Is(err, quickadd.ErrNoDay):
+		return spec.Error{Code: "quick_add_no_day", Message: "could not find the day of the activity" + example}
+	case errors.Is(err, quickadd.ErrNoTime):
+		return spec.Error{Code: "quick_add_no_time", Message: "could not find the time of the activity" + example}
+	case errors.Is(err, quickadd.ErrOutsideTrip):
+		return spec.Error{Code: "quick_add_outside_trip", Message: "the activity must happen during the trip"}
+	}
+	return spec.Error{Code: "quick_add_not_understood", Message: "could not understand the activity" + example}
+}
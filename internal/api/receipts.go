@@ -0,0 +1,214 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/images"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+const maxReceiptSize = 5 << 20
+
+var receiptContentTypes = map[string]bool{
+	"image/jpeg": true,
+	"image/png":  true,
+}
+
+// Upload a receipt and read it.
+// (POST /trips/{tripId}/receipts)
+func (api *API) PostTripsTripIDReceipts(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.PostTripsTripIDReceiptsJSON400Response(errID.Error)
+	}
+
+	trip, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDReceiptsJSON400Response, spec.PostTripsTripIDReceiptsJSON404Response)
+	}
+
+	image, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxReceiptSize))
+	if err != nil {
+		return spec.PostTripsTripIDReceiptsJSON400Response(spec.Error{
+			Code:    "file_too_large",
+			Message: "receipt must be at most " + strconv.Itoa(maxReceiptSize>>20) + "MB",
+		})
+	}
+
+	contentType := http.DetectContentType(image)
+	if !receiptContentTypes[contentType] {
+		return spec.PostTripsTripIDReceiptsJSON400Response(spec.Error{
+			Code:    "unsupported_file",
+			Message: "receipt must be a jpeg or png image",
+		})
+	}
+
+	if !trip.Settings.KeepPhotoLocation {
+		if _, err := images.StripGPS(image); err != nil {
+			return spec.PostTripsTripIDReceiptsJSON400Response(spec.Error{
+				Code:    "invalid_file",
+				Message: "receipt has invalid EXIF data",
+			})
+		}
+	}
+
+	read, err := api.ocr.Extract(r.Context(), contentType, image)
+	if err != nil {
+		api.logger.Warn("failed to read receipt", zap.Error(err), zap.String("trip_id", tripID))
+	}
+
+	params := pgstore.CreateReceiptParams{
+		TripID:      id,
+		ContentType: contentType,
+		Data:        image,
+		Merchant:    pgtype.Text{Valid: read.Merchant != "", String: read.Merchant},
+		AmountCents: pgtype.Int8{Valid: read.AmountCents > 0, Int64: read.AmountCents},
+		SpentAt:     pgtype.Timestamp{Valid: !read.SpentAt.IsZero(), Time: read.SpentAt},
+	}
+
+	receiptID, err := api.store.CreateReceipt(r.Context(), params)
+	if err != nil {
+		api.logger.Error("failed to insert receipt", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDReceiptsJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "fail to insert receipt",
+		})
+	}
+
+	response := spec.ScanReceiptResponse{ReceiptID: receiptID.String()}
+	if params.Merchant.Valid {
+		response.Merchant = &params.Merchant.String
+	}
+	if params.AmountCents.Valid {
+		response.AmountCents = &params.AmountCents.Int64
+	}
+	if params.SpentAt.Valid {
+		response.SpentAt = &params.SpentAt.Time
+	}
+
+	return spec.PostTripsTripIDReceiptsJSON201Response(response)
+}
+
+// Confirm a receipt as a trip expense.
+// (POST /trips/{tripId}/receipts/{receiptId}/confirm)
+func (api *API) PostTripsTripIDReceiptsReceiptIDConfirm(w http.ResponseWriter, r *http.Request, tripID string, receiptID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.PostTripsTripIDReceiptsReceiptIDConfirmJSON400Response(errID.Error)
+	}
+
+	receiptUUID, errID := pathID(r.Context(), "receiptId", receiptID)
+	if errID != nil {
+		return spec.PostTripsTripIDReceiptsReceiptIDConfirmJSON400Response(errID.Error)
+	}
+
+	receipt, err := api.store.GetReceipt(r.Context(), receiptUUID)
+	if err != nil || receipt.TripID != id {
+		if err != nil && !errors.Is(err, pgstore.ErrNotFound) {
+			api.logger.Error("failed to get receipt", zap.Error(err), zap.String("receipt_id", receiptID))
+			return spec.PostTripsTripIDReceiptsReceiptIDConfirmJSON400Response(spec.Error{
+				Code:    internalError,
+				Message: "something went wrong, try again",
+			})
+		}
+		return spec.PostTripsTripIDReceiptsReceiptIDConfirmJSON404Response(spec.Error{
+			Code:    "receipt_not_found",
+			Message: "receipt not found",
+		})
+	}
+
+	if receipt.ExpenseID.Valid {
+		return spec.PostTripsTripIDReceiptsReceiptIDConfirmJSON400Response(spec.Error{
+			Code:    "receipt_already_confirmed",
+			Message: "receipt already confirmed",
+		})
+	}
+
+	var body spec.PostTripsTripIDReceiptsReceiptIDConfirmJSONBody
+	if errJson := json.NewDecoder(r.Body).Decode(&body); errJson != nil {
+		return spec.PostTripsTripIDReceiptsReceiptIDConfirmJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + errJson.Error()})
+	}
+
+	if errVal := api.validator.Struct(body); errVal != nil {
+		return spec.PostTripsTripIDReceiptsReceiptIDConfirmJSON422Response(invalidBody(errVal))
+	}
+
+	payer, err := api.store.GetParticipant(r.Context(), uuid.MustParse(body.PaidBy))
+	if err != nil || payer.TripID != id {
+		if err != nil && !errors.Is(err, pgstore.ErrNotFound) {
+			api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", body.PaidBy))
+		}
+		return spec.PostTripsTripIDReceiptsReceiptIDConfirmJSON404Response(spec.Error{
+			Code:    "participant_not_found",
+			Message: "participant not found",
+		})
+	}
+
+	splits, errResp := api.expenseSplits(r.Context(), id, body.AmountCents, body.Split)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDReceiptsReceiptIDConfirmJSON400Response, spec.PostTripsTripIDReceiptsReceiptIDConfirmJSON404Response)
+	}
+
+	expenseID, err := api.store.CreateExpenseFromReceipt(r.Context(), api.pool, receipt.ID, pgstore.InsertExpenseParams{
+		TripID:      id,
+		PaidBy:      payer.ID,
+		Description: body.Description,
+		Category:    body.Category,
+		AmountCents: body.AmountCents,
+		SpentAt:     pgtype.Timestamp{Valid: true, Time: body.SpentAt},
+	}, splits)
+	if err != nil {
+		api.logger.Error("failed to create expense from receipt", zap.Error(err), zap.String("receipt_id", receiptID))
+		return spec.PostTripsTripIDReceiptsReceiptIDConfirmJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to create expense, try again",
+		})
+	}
+
+	return spec.PostTripsTripIDReceiptsReceiptIDConfirmJSON201Response(spec.CreateExpenseResponse{ExpenseID: expenseID.String()})
+}
+
+// Get a trip expense receipt image.
+// (GET /trips/{tripId}/expenses/{expenseId}/receipt)
+func (api *API) GetTripsTripIDExpensesExpenseIDReceipt(w http.ResponseWriter, r *http.Request, tripID string, expenseID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.GetTripsTripIDExpensesExpenseIDReceiptJSON400Response(errID.Error)
+	}
+
+	expenseUUID, errID := pathID(r.Context(), "expenseId", expenseID)
+	if errID != nil {
+		return spec.GetTripsTripIDExpensesExpenseIDReceiptJSON400Response(errID.Error)
+	}
+
+	receipt, err := api.store.GetExpenseReceipt(r.Context(), pgtype.UUID{Valid: true, Bytes: expenseUUID})
+	if err != nil || receipt.TripID != id {
+		if err != nil && !errors.Is(err, pgstore.ErrNotFound) {
+			api.logger.Error("failed to get receipt", zap.Error(err), zap.String("expense_id", expenseID))
+			return spec.GetTripsTripIDExpensesExpenseIDReceiptJSON400Response(spec.Error{
+				Code:    internalError,
+				Message: "something went wrong, try again",
+			})
+		}
+		return spec.GetTripsTripIDExpensesExpenseIDReceiptJSON404Response(spec.Error{
+			Code:    "receipt_not_found",
+			Message: "receipt not found",
+		})
+	}
+
+	w.Header().Set("Content-Type", receipt.ContentType)
+	w.Header().Set("Content-Length", strconv.Itoa(len(receipt.Data)))
+	if _, err := w.Write(receipt.Data); err != nil {
+		api.logger.Error("failed to write receipt", zap.Error(err), zap.String("expense_id", expenseID))
+	}
+
+	return nil
+}
@@ -0,0 +1,458 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/discord-gophers/goapi-gen/types"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+// Get a trip rides.
+// (GET /trips/{tripId}/rides)
+func (api *API) GetTripsTripIDRides(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.GetTripsTripIDRidesJSON400Response(errID.Error)
+	}
+
+	trip, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDRidesJSON400Response, spec.GetTripsTripIDRidesJSON404Response)
+	}
+
+	rides, errResp := api.getRides(r.Context(), trip.ID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDRidesJSON400Response, spec.GetTripsTripIDRidesJSON404Response)
+	}
+
+	responseRides := make([]spec.GetRidesResponseArray, 0, len(rides.rides))
+	for _, ride := range rides.rides {
+		responseRide := spec.GetRidesResponseArray{
+			ID:             ride.ID.String(),
+			DriverID:       ride.DriverID.String(),
+			DepartsAt:      ride.DepartsAt.Time,
+			DeparturePoint: ride.DeparturePoint,
+			Seats:          int(ride.Seats),
+			SeatsLeft:      int(ride.Seats),
+			PassengerIds:   make([]string, 0, len(rides.passengers[ride.ID])),
+		}
+		for _, passenger := range rides.passengers[ride.ID] {
+			responseRide.PassengerIds = append(responseRide.PassengerIds, passenger.ParticipantID.String())
+			responseRide.SeatsLeft -= int(passenger.Seats)
+		}
+		responseRides = append(responseRides, responseRide)
+	}
+
+	return spec.GetTripsTripIDRidesJSON200Response(spec.GetRidesResponse{Rides: responseRides})
+}
+
+// Offer a ride.
+// (POST /trips/{tripId}/rides)
+func (api *API) PostTripsTripIDRides(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.PostTripsTripIDRidesJSON400Response(errID.Error)
+	}
+
+	trip, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDRidesJSON400Response, spec.PostTripsTripIDRidesJSON404Response)
+	}
+
+	var body spec.CreateRideRequest
+	if errJson := json.NewDecoder(r.Body).Decode(&body); errJson != nil {
+		return spec.PostTripsTripIDRidesJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + errJson.Error()})
+	}
+
+	if errVal := api.validator.Struct(body); errVal != nil {
+		return spec.PostTripsTripIDRidesJSON422Response(invalidBody(errVal))
+	}
+
+	driver, errResp := api.getRider(r.Context(), trip.ID, body.DriverID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDRidesJSON400Response, spec.PostTripsTripIDRidesJSON404Response)
+	}
+
+	rides, errResp := api.getRides(r.Context(), trip.ID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDRidesJSON400Response, spec.PostTripsTripIDRidesJSON404Response)
+	}
+
+	if rides.busy(driver.ID, body.DepartsAt) {
+		return spec.PostTripsTripIDRidesJSON400Response(spec.Error{
+			Code:    "already_in_ride",
+			Message: "participant is already in a ride on this day",
+		})
+	}
+
+	rideID, err := api.store.CreateRide(r.Context(), pgstore.CreateRideParams{
+		TripID:         trip.ID,
+		DriverID:       driver.ID,
+		DepartsAt:      pgtype.Timestamp{Valid: true, Time: body.DepartsAt},
+		DeparturePoint: body.DeparturePoint,
+		Seats:          int32(body.Seats),
+	})
+	if err != nil {
+		if errors.Is(err, pgstore.ErrForeignKey) {
+			return spec.PostTripsTripIDRidesJSON422Response(missingReference("participant_removed", "participant is no longer on the trip"))
+		}
+		api.logger.Error("failed to create ride", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDRidesJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to create ride, try again",
+		})
+	}
+
+	return spec.PostTripsTripIDRidesJSON201Response(spec.CreateRideResponse{RideID: rideID.String()})
+}
+
+// Cancel a ride.
+// (DELETE /trips/{tripId}/rides/{rideId})
+func (api *API) DeleteTripsTripIDRidesRideID(w http.ResponseWriter, r *http.Request, tripID string, rideID string) *spec.Response {
+	ride, errResp := api.getTripRide(r.Context(), tripID, rideID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.DeleteTripsTripIDRidesRideIDJSON400Response, spec.DeleteTripsTripIDRidesRideIDJSON404Response)
+	}
+
+	passengers, err := api.store.GetRidePassengers(r.Context(), ride.ID)
+	if err != nil {
+		api.logger.Error("failed to get ride passengers", zap.Error(err), zap.String("ride_id", rideID))
+		return spec.DeleteTripsTripIDRidesRideIDJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	if err := api.store.DeleteRide(r.Context(), ride.ID); err != nil {
+		api.logger.Error("failed to delete ride", zap.Error(err), zap.String("ride_id", rideID))
+		return spec.DeleteTripsTripIDRidesRideIDJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to cancel ride, try again",
+		})
+	}
+
+	passengerIDs := make([]uuid.UUID, 0, len(passengers))
+	for _, passenger := range passengers {
+		passengerIDs = append(passengerIDs, passenger.ParticipantID)
+	}
+	api.sendRideCanceled(ride, passengerIDs, "DeleteTripsTripIDRidesRideID")
+
+	return spec.DeleteTripsTripIDRidesRideIDJSON204Response(nil)
+}
+
+// Claim a seat in a ride.
+// (POST /trips/{tripId}/rides/{rideId}/passengers)
+func (api *API) PostTripsTripIDRidesRideIDPassengers(w http.ResponseWriter, r *http.Request, tripID string, rideID string) *spec.Response {
+	ride, errResp := api.getTripRide(r.Context(), tripID, rideID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDRidesRideIDPassengersJSON400Response, spec.PostTripsTripIDRidesRideIDPassengersJSON404Response)
+	}
+
+	var body spec.ClaimRideSeatRequest
+	if errJson := json.NewDecoder(r.Body).Decode(&body); errJson != nil {
+		return spec.PostTripsTripIDRidesRideIDPassengersJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + errJson.Error()})
+	}
+
+	if errVal := api.validator.Struct(body); errVal != nil {
+		return spec.PostTripsTripIDRidesRideIDPassengersJSON422Response(invalidBody(errVal))
+	}
+
+	participant, errResp := api.getRider(r.Context(), ride.TripID, body.ParticipantID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDRidesRideIDPassengersJSON400Response, spec.PostTripsTripIDRidesRideIDPassengersJSON404Response)
+	}
+
+	rides, errResp := api.getRides(r.Context(), ride.TripID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDRidesRideIDPassengersJSON400Response, spec.PostTripsTripIDRidesRideIDPassengersJSON404Response)
+	}
+
+	if rides.busy(participant.ID, ride.DepartsAt.Time) {
+		return spec.PostTripsTripIDRidesRideIDPassengersJSON400Response(spec.Error{
+			Code:    "already_in_ride",
+			Message: "participant is already in a ride on this day",
+		})
+	}
+
+	seats := int32(1 + rides.companions[participant.ID])
+	full, err := api.store.ClaimRideSeats(r.Context(), api.pool, ride.ID, participant.ID, seats)
+	if err != nil {
+		switch {
+		case errors.Is(err, pgstore.ErrRideFull):
+			return spec.PostTripsTripIDRidesRideIDPassengersJSON400Response(spec.Error{
+				Code:    "ride_full",
+				Message: "not enough seats left in the ride",
+			})
+		case errors.Is(err, pgstore.ErrDuplicate):
+			return spec.PostTripsTripIDRidesRideIDPassengersJSON400Response(spec.Error{
+				Code:    "already_in_ride",
+				Message: "participant already has a seat in the ride",
+			})
+		case errors.Is(err, pgstore.ErrNotFound):
+			return spec.PostTripsTripIDRidesRideIDPassengersJSON404Response(spec.Error{
+				Code:    "ride_not_found",
+				Message: "ride not found",
+			})
+		case errors.Is(err, pgstore.ErrForeignKey):
+			return spec.PostTripsTripIDRidesRideIDPassengersJSON422Response(missingReference("participant_removed", "participant is no longer on the trip"))
+		}
+		api.logger.Error("failed to claim ride seats", zap.Error(err), zap.String("ride_id", rideID))
+		return spec.PostTripsTripIDRidesRideIDPassengersJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to claim seat, try again",
+		})
+	}
+
+	if full {
+		api.sendRideFull(ride.ID, "PostTripsTripIDRidesRideIDPassengers")
+	}
+
+	return spec.PostTripsTripIDRidesRideIDPassengersJSON204Response(nil)
+}
+
+// Give up a seat in a ride.
+// (DELETE /trips/{tripId}/rides/{rideId}/passengers/{participantId})
+func (api *API) DeleteTripsTripIDRidesRideIDPassengersParticipantID(w http.ResponseWriter, r *http.Request, tripID string, rideID string, participantID string) *spec.Response {
+	ride, errResp := api.getTripRide(r.Context(), tripID, rideID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.DeleteTripsTripIDRidesRideIDPassengersParticipantIDJSON400Response, spec.DeleteTripsTripIDRidesRideIDPassengersParticipantIDJSON404Response)
+	}
+
+	participantUUID, errID := pathID(r.Context(), "participantId", participantID)
+	if errID != nil {
+		return spec.DeleteTripsTripIDRidesRideIDPassengersParticipantIDJSON400Response(errID.Error)
+	}
+
+	rows, err := api.store.DeleteRidePassenger(r.Context(), pgstore.DeleteRidePassengerParams{
+		RideID:        ride.ID,
+		ParticipantID: participantUUID,
+	})
+	if err != nil {
+		api.logger.Error("failed to delete ride passenger", zap.Error(err), zap.String("ride_id", rideID))
+		return spec.DeleteTripsTripIDRidesRideIDPassengersParticipantIDJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to give up seat, try again",
+		})
+	}
+	if rows == 0 {
+		return spec.DeleteTripsTripIDRidesRideIDPassengersParticipantIDJSON404Response(spec.Error{
+			Code:    "passenger_not_found",
+			Message: "passenger not found",
+		})
+	}
+
+	return spec.DeleteTripsTripIDRidesRideIDPassengersParticipantIDJSON204Response(nil)
+}
+
+// Get the travelers without a ride.
+// (GET /trips/{tripId}/rides/unassigned)
+func (api *API) GetTripsTripIDRidesUnassigned(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.GetTripsTripIDRidesUnassignedJSON400Response(errID.Error)
+	}
+
+	trip, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDRidesUnassignedJSON400Response, spec.GetTripsTripIDRidesUnassignedJSON404Response)
+	}
+
+	rides, errResp := api.getRides(r.Context(), trip.ID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDRidesUnassignedJSON400Response, spec.GetTripsTripIDRidesUnassignedJSON404Response)
+	}
+
+	participants, err := api.store.GetParticipants(r.Context(), trip.ID)
+	if err != nil {
+		api.logger.Error("failed to get participants", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDRidesUnassignedJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	days := []time.Time{rideDay(trip.StartsAt.Time), rideDay(trip.EndsAt.Time)}
+	for _, ride := range rides.rides {
+		days = append(days, rideDay(ride.DepartsAt.Time))
+	}
+	slices.SortFunc(days, time.Time.Compare)
+	days = slices.CompactFunc(days, time.Time.Equal)
+
+	response := spec.GetUnassignedTravelersResponse{
+		Days: make([]spec.GetUnassignedTravelersResponseArray, 0, len(days)),
+	}
+	for _, day := range days {
+		responseDay := spec.GetUnassignedTravelersResponseArray{
+			Date:           types.Date{Time: day},
+			ParticipantIds: []string{},
+		}
+		for _, p := range participants {
+			if p.Status == pgstore.ParticipantInvited && !rides.busy(p.ID, day) {
+				responseDay.ParticipantIds = append(responseDay.ParticipantIds, p.ID.String())
+			}
+		}
+		response.Days = append(response.Days, responseDay)
+	}
+
+	return spec.GetTripsTripIDRidesUnassignedJSON200Response(response)
+}
+
+// tripRides are the rides of a trip with who is in each.
+type tripRides struct {
+	rides      []pgstore.Ride
+	passengers map[uuid.UUID][]pgstore.RidePassenger
+	companions map[uuid.UUID]int
+
+	// riding is who drives or has a seat on each day.
+	riding map[time.Time]map[uuid.UUID]bool
+}
+
+// busy tells whether the participant already drives or has a seat on the
+// day of at.
+func (t tripRides) busy(participantID uuid.UUID, at time.Time) bool {
+	return t.riding[rideDay(at)][participantID]
+}
+
+// getRides gathers the rides of the trip, returning the error to be sent to
+// the client otherwise.
+func (api *API) getRides(ctx context.Context, tripID uuid.UUID) (tripRides, *apiError) {
+	rides, err := api.store.GetTripRides(ctx, tripID)
+	if err != nil {
+		api.logger.Error("failed to get rides", zap.Error(err), zap.String("trip_id", tripID.String()))
+		return tripRides{}, badRequest(internalError, "something went wrong, try again")
+	}
+
+	passengers, err := api.store.GetTripRidePassengers(ctx, tripID)
+	if err != nil {
+		api.logger.Error("failed to get ride passengers", zap.Error(err), zap.String("trip_id", tripID.String()))
+		return tripRides{}, badRequest(internalError, "something went wrong, try again")
+	}
+
+	companions, err := api.store.GetTripCompanions(ctx, tripID)
+	if err != nil {
+		api.logger.Error("failed to get companions", zap.Error(err), zap.String("trip_id", tripID.String()))
+		return tripRides{}, badRequest(internalError, "something went wrong, try again")
+	}
+
+	result := tripRides{
+		rides:      rides,
+		passengers: make(map[uuid.UUID][]pgstore.RidePassenger, len(rides)),
+		companions: make(map[uuid.UUID]int),
+		riding:     make(map[time.Time]map[uuid.UUID]bool),
+	}
+	for _, c := range companions {
+		result.companions[c.ParticipantID]++
+	}
+
+	dayOf := make(map[uuid.UUID]time.Time, len(rides))
+	ride := func(day time.Time, participantID uuid.UUID) {
+		if result.riding[day] == nil {
+			result.riding[day] = make(map[uuid.UUID]bool)
+		}
+		result.riding[day][participantID] = true
+	}
+	for _, r := range rides {
+		dayOf[r.ID] = rideDay(r.DepartsAt.Time)
+		ride(dayOf[r.ID], r.DriverID)
+	}
+	for _, p := range passengers {
+		result.passengers[p.RideID] = append(result.passengers[p.RideID], p)
+		ride(dayOf[p.RideID], p.ParticipantID)
+	}
+
+	return result, nil
+}
+
+// getTripRide loads a ride making sure it belongs to the given trip,
+// returning the error to be sent to the client otherwise.
+func (api *API) getTripRide(ctx context.Context, tripID, rideID string) (pgstore.Ride, *apiError) {
+	tripUUID, errID := pathID(ctx, "tripId", tripID)
+	if errID != nil {
+		return pgstore.Ride{}, errID
+	}
+
+	rideUUID, errID := pathID(ctx, "rideId", rideID)
+	if errID != nil {
+		return pgstore.Ride{}, errID
+	}
+
+	ride, err := api.store.GetRide(ctx, rideUUID)
+	if err != nil {
+		if errors.Is(err, pgstore.ErrNotFound) {
+			return pgstore.Ride{}, notFound("ride_not_found", "ride not found")
+		}
+		api.logger.Error("failed to get ride", zap.Error(err), zap.String("ride_id", rideID))
+		return pgstore.Ride{}, badRequest(internalError, "something went wrong, try again")
+	}
+
+	if ride.TripID != tripUUID {
+		return pgstore.Ride{}, notFound("ride_not_found", "ride not found")
+	}
+
+	return ride, nil
+}
+
+// getRider loads a participant driving or riding, making sure they are going
+// on the trip.
+func (api *API) getRider(ctx context.Context, tripID uuid.UUID, participantID string) (pgstore.Participant, *apiError) {
+	participant, err := api.store.GetParticipant(ctx, uuid.MustParse(participantID))
+	if err != nil {
+		if errors.Is(err, pgstore.ErrNotFound) {
+			return pgstore.Participant{}, notFound("participant_not_found", "participant not found")
+		}
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", participantID))
+		return pgstore.Participant{}, badRequest(internalError, "something went wrong, try again")
+	}
+
+	if participant.TripID != tripID {
+		return pgstore.Participant{}, notFound("participant_not_found", "participant not found")
+	}
+
+	if participant.Status != pgstore.ParticipantInvited {
+		return pgstore.Participant{}, badRequest("participant_not_going", "participant is not going on the trip")
+	}
+
+	return participant, nil
+}
+
+// sendRideFull lets the driver know, in the background, that their ride is
+// full.
+func (api *API) sendRideFull(rideID uuid.UUID, handler string) {
+	go func() {
+		if err := api.mailer.SendRideFull(rideID); err != nil {
+			api.logger.Error(
+				"failed to send email on "+handler,
+				zap.Error(err),
+				zap.String("ride_id", rideID.String()),
+			)
+		}
+	}()
+}
+
+// sendRideCanceled lets the passengers of a canceled ride know in the
+// background.
+func (api *API) sendRideCanceled(ride pgstore.Ride, passengerIDs []uuid.UUID, handler string) {
+	go func() {
+		if err := api.mailer.SendRideCanceled(ride, passengerIDs); err != nil {
+			api.logger.Error(
+				"failed to send email on "+handler,
+				zap.Error(err),
+				zap.String("ride_id", ride.ID.String()),
+			)
+		}
+	}()
+}
+
+func rideDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
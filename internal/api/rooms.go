@@ -0,0 +1,354 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/google/uuid"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/export"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+// Get a lodging rooms.
+// (GET /trips/{tripId}/lodgings/{lodgingId}/rooms)
+func (api *API) GetTripsTripIDLodgingsLodgingIDRooms(w http.ResponseWriter, r *http.Request, tripID string, lodgingID string) *spec.Response {
+	lodging, errResp := api.getTripLodging(r.Context(), tripID, lodgingID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDLodgingsLodgingIDRoomsJSON400Response, spec.GetTripsTripIDLodgingsLodgingIDRoomsJSON404Response)
+	}
+
+	rooming, errResp := api.getRooming(r.Context(), lodging)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDLodgingsLodgingIDRoomsJSON400Response, spec.GetTripsTripIDLodgingsLodgingIDRoomsJSON404Response)
+	}
+
+	response := spec.GetLodgingRoomsResponse{
+		Rooms:                    make([]spec.GetLodgingRoomsResponseArray, 0, len(rooming.rooms)),
+		UnassignedParticipantIds: make([]string, 0, len(rooming.unassigned)),
+	}
+	for _, room := range rooming.rooms {
+		responseRoom := spec.GetLodgingRoomsResponseArray{
+			ID:             room.ID.String(),
+			Name:           room.Name,
+			Capacity:       int(room.Capacity),
+			Beds:           room.Beds,
+			ParticipantIds: make([]string, 0, len(rooming.guests[room.ID])),
+		}
+		for _, p := range rooming.guests[room.ID] {
+			responseRoom.ParticipantIds = append(responseRoom.ParticipantIds, p.ID.String())
+			responseRoom.Occupancy += 1 + len(rooming.companionsOf[p.ID])
+		}
+		response.Rooms = append(response.Rooms, responseRoom)
+	}
+	for _, p := range rooming.unassigned {
+		response.UnassignedParticipantIds = append(response.UnassignedParticipantIds, p.ID.String())
+	}
+
+	return spec.GetTripsTripIDLodgingsLodgingIDRoomsJSON200Response(response)
+}
+
+// Create a lodging room.
+// (POST /trips/{tripId}/lodgings/{lodgingId}/rooms)
+func (api *API) PostTripsTripIDLodgingsLodgingIDRooms(w http.ResponseWriter, r *http.Request, tripID string, lodgingID string) *spec.Response {
+	lodging, errResp := api.getTripLodging(r.Context(), tripID, lodgingID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDLodgingsLodgingIDRoomsJSON400Response, spec.PostTripsTripIDLodgingsLodgingIDRoomsJSON404Response)
+	}
+
+	var body spec.CreateLodgingRoomRequest
+	if errJson := json.NewDecoder(r.Body).Decode(&body); errJson != nil {
+		return spec.PostTripsTripIDLodgingsLodgingIDRoomsJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + errJson.Error()})
+	}
+
+	if errVal := api.validator.Struct(body); errVal != nil {
+		return spec.PostTripsTripIDLodgingsLodgingIDRoomsJSON422Response(invalidBody(errVal))
+	}
+
+	params := pgstore.CreateLodgingRoomParams{
+		LodgingID: lodging.ID,
+		Name:      body.Name,
+		Capacity:  int32(body.Capacity),
+	}
+	if body.Beds != nil {
+		params.Beds = *body.Beds
+	}
+
+	roomID, err := api.store.CreateLodgingRoom(r.Context(), params)
+	if err != nil {
+		if errors.Is(err, pgstore.ErrDuplicate) {
+			return spec.PostTripsTripIDLodgingsLodgingIDRoomsJSON400Response(spec.Error{Code: "duplicate_name", Message: "there is already a room with this name"})
+		}
+		api.logger.Error("failed to create lodging room", zap.Error(err), zap.String("lodging_id", lodgingID))
+		return spec.PostTripsTripIDLodgingsLodgingIDRoomsJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to create room, try again",
+		})
+	}
+
+	return spec.PostTripsTripIDLodgingsLodgingIDRoomsJSON201Response(spec.CreateLodgingRoomResponse{RoomID: roomID.String()})
+}
+
+// Delete a lodging room.
+// (DELETE /trips/{tripId}/lodgings/{lodgingId}/rooms/{roomId})
+func (api *API) DeleteTripsTripIDLodgingsLodgingIDRoomsRoomID(w http.ResponseWriter, r *http.Request, tripID string, lodgingID string, roomID string) *spec.Response {
+	_, room, errResp := api.getLodgingRoom(r.Context(), tripID, lodgingID, roomID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.DeleteTripsTripIDLodgingsLodgingIDRoomsRoomIDJSON400Response, spec.DeleteTripsTripIDLodgingsLodgingIDRoomsRoomIDJSON404Response)
+	}
+
+	if err := api.store.DeleteLodgingRoom(r.Context(), room.ID); err != nil {
+		api.logger.Error("failed to delete lodging room", zap.Error(err), zap.String("room_id", roomID))
+		return spec.DeleteTripsTripIDLodgingsLodgingIDRoomsRoomIDJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to delete room, try again",
+		})
+	}
+
+	return spec.DeleteTripsTripIDLodgingsLodgingIDRoomsRoomIDJSON204Response(nil)
+}
+
+// Assign participants to a lodging room.
+// (PUT /trips/{tripId}/lodgings/{lodgingId}/rooms/{roomId}/participants)
+func (api *API) PutTripsTripIDLodgingsLodgingIDRoomsRoomIDParticipants(w http.ResponseWriter, r *http.Request, tripID string, lodgingID string, roomID string) *spec.Response {
+	lodging, room, errResp := api.getLodgingRoom(r.Context(), tripID, lodgingID, roomID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PutTripsTripIDLodgingsLodgingIDRoomsRoomIDParticipantsJSON400Response, spec.PutTripsTripIDLodgingsLodgingIDRoomsRoomIDParticipantsJSON404Response)
+	}
+
+	var body spec.AssignRoomRequest
+	if errJson := json.NewDecoder(r.Body).Decode(&body); errJson != nil {
+		return spec.PutTripsTripIDLodgingsLodgingIDRoomsRoomIDParticipantsJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + errJson.Error()})
+	}
+
+	if errVal := api.validator.Struct(body); errVal != nil {
+		return spec.PutTripsTripIDLodgingsLodgingIDRoomsRoomIDParticipantsJSON422Response(invalidBody(errVal))
+	}
+
+	participants, err := api.store.GetParticipants(r.Context(), lodging.TripID)
+	if err != nil {
+		api.logger.Error("failed to get participants", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PutTripsTripIDLodgingsLodgingIDRoomsRoomIDParticipantsJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	companions, err := api.store.GetTripCompanions(r.Context(), lodging.TripID)
+	if err != nil {
+		api.logger.Error("failed to get companions", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PutTripsTripIDLodgingsLodgingIDRoomsRoomIDParticipantsJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	going := make(map[uuid.UUID]bool, len(participants))
+	for _, p := range participants {
+		going[p.ID] = p.Status == pgstore.ParticipantInvited
+	}
+
+	people := make(map[uuid.UUID]int, len(participants))
+	for _, c := range companions {
+		people[c.ParticipantID]++
+	}
+
+	ids := make([]uuid.UUID, 0, len(body.ParticipantIds))
+	seen := make(map[uuid.UUID]bool, len(body.ParticipantIds))
+	occupancy := 0
+	for _, raw := range body.ParticipantIds {
+		participantID := uuid.MustParse(raw)
+		isGoing, onTrip := going[participantID]
+		if !onTrip {
+			return spec.PutTripsTripIDLodgingsLodgingIDRoomsRoomIDParticipantsJSON404Response(spec.Error{
+				Code:    "participant_not_found",
+				Message: "participant not found",
+			})
+		}
+		if !isGoing {
+			return spec.PutTripsTripIDLodgingsLodgingIDRoomsRoomIDParticipantsJSON400Response(spec.Error{
+				Code:    "participant_not_going",
+				Message: "participant is not going on the trip",
+			})
+		}
+		if seen[participantID] {
+			continue
+		}
+		seen[participantID] = true
+		ids = append(ids, participantID)
+		occupancy += 1 + people[participantID]
+	}
+
+	if occupancy > int(room.Capacity) {
+		return spec.PutTripsTripIDLodgingsLodgingIDRoomsRoomIDParticipantsJSON400Response(spec.Error{
+			Code:    "room_over_capacity",
+			Message: fmt.Sprintf("room sleeps %d, not %d people counting companions", room.Capacity, occupancy),
+		})
+	}
+
+	if err := api.store.AssignRoom(r.Context(), api.pool, room, ids); err != nil {
+		if errors.Is(err, pgstore.ErrForeignKey) {
+			return spec.PutTripsTripIDLodgingsLodgingIDRoomsRoomIDParticipantsJSON422Response(missingReference("participant_removed", "participant is no longer on the trip"))
+		}
+		api.logger.Error("failed to assign room", zap.Error(err), zap.String("room_id", roomID))
+		return spec.PutTripsTripIDLodgingsLodgingIDRoomsRoomIDParticipantsJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to assign room, try again",
+		})
+	}
+
+	return spec.PutTripsTripIDLodgingsLodgingIDRoomsRoomIDParticipantsJSON204Response(nil)
+}
+
+// Export a lodging rooming list.
+// (GET /trips/{tripId}/lodgings/{lodgingId}/rooming-list)
+func (api *API) GetTripsTripIDLodgingsLodgingIDRoomingList(w http.ResponseWriter, r *http.Request, tripID string, lodgingID string) *spec.Response {
+	lodging, errResp := api.getTripLodging(r.Context(), tripID, lodgingID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDLodgingsLodgingIDRoomingListJSON400Response, spec.GetTripsTripIDLodgingsLodgingIDRoomingListJSON404Response)
+	}
+
+	rooming, errResp := api.getRooming(r.Context(), lodging)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDLodgingsLodgingIDRoomingListJSON400Response, spec.GetTripsTripIDLodgingsLodgingIDRoomingListJSON404Response)
+	}
+
+	guests := func(participants []pgstore.Participant) []export.Guest {
+		var guests []export.Guest
+		for _, p := range participants {
+			name := p.Email
+			if p.Name.Valid {
+				name = p.Name.String
+			}
+			guests = append(guests, export.Guest{Name: name, Email: p.Email})
+			for _, companion := range rooming.companionsOf[p.ID] {
+				guests = append(guests, export.Guest{Name: companion})
+			}
+		}
+		return guests
+	}
+
+	list := export.RoomingList{
+		CheckIn:    lodging.CheckIn.Time,
+		CheckOut:   lodging.CheckOut.Time,
+		Unassigned: guests(rooming.unassigned),
+	}
+	for _, room := range rooming.rooms {
+		list.Rooms = append(list.Rooms, export.Room{Name: room.Name, Beds: room.Beds, Guests: guests(rooming.guests[room.ID])})
+	}
+
+	doc, err := export.RoomingCSV(list)
+	if err != nil {
+		api.logger.Error("failed to render rooming list", zap.Error(err), zap.String("lodging_id", lodgingID))
+		return spec.GetTripsTripIDLodgingsLodgingIDRoomingListJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to export rooming list, try again",
+		})
+	}
+
+	w.Header().Set("Content-Type", "text/csv; charset=utf-8")
+	w.Header().Set("Content-Disposition", `attachment; filename="rooming-list.csv"`)
+	w.Header().Set("Content-Length", strconv.Itoa(len(doc)))
+	if _, err := w.Write(doc); err != nil {
+		api.logger.Error("failed to write rooming list", zap.Error(err), zap.String("lodging_id", lodgingID))
+	}
+
+	return nil
+}
+
+// rooming is who sleeps where in a lodging.
+type rooming struct {
+	rooms        []pgstore.LodgingRoom
+	guests       map[uuid.UUID][]pgstore.Participant
+	unassigned   []pgstore.Participant
+	companionsOf map[uuid.UUID][]string
+}
+
+// getRooming gathers the rooms of the lodging with the participants going on
+// the trip assigned to each, returning the error to be sent to the client
+// otherwise.
+func (api *API) getRooming(ctx context.Context, lodging pgstore.Lodging) (rooming, *apiError) {
+	rooms, err := api.store.GetLodgingRooms(ctx, lodging.ID)
+	if err != nil {
+		api.logger.Error("failed to get lodging rooms", zap.Error(err), zap.String("lodging_id", lodging.ID.String()))
+		return rooming{}, badRequest(internalError, "something went wrong, try again")
+	}
+
+	assignments, err := api.store.GetLodgingRoomAssignments(ctx, lodging.ID)
+	if err != nil {
+		api.logger.Error("failed to get room assignments", zap.Error(err), zap.String("lodging_id", lodging.ID.String()))
+		return rooming{}, badRequest(internalError, "something went wrong, try again")
+	}
+
+	participants, err := api.store.GetParticipants(ctx, lodging.TripID)
+	if err != nil {
+		api.logger.Error("failed to get participants", zap.Error(err), zap.String("trip_id", lodging.TripID.String()))
+		return rooming{}, badRequest(internalError, "something went wrong, try again")
+	}
+
+	companions, err := api.store.GetTripCompanions(ctx, lodging.TripID)
+	if err != nil {
+		api.logger.Error("failed to get companions", zap.Error(err), zap.String("trip_id", lodging.TripID.String()))
+		return rooming{}, badRequest(internalError, "something went wrong, try again")
+	}
+
+	roomOf := make(map[uuid.UUID]uuid.UUID, len(assignments))
+	for _, a := range assignments {
+		roomOf[a.ParticipantID] = a.RoomID
+	}
+
+	result := rooming{
+		rooms:        rooms,
+		guests:       make(map[uuid.UUID][]pgstore.Participant, len(rooms)),
+		companionsOf: make(map[uuid.UUID][]string),
+	}
+	for _, c := range companions {
+		result.companionsOf[c.ParticipantID] = append(result.companionsOf[c.ParticipantID], c.Name)
+	}
+	for _, p := range participants {
+		if p.Status != pgstore.ParticipantInvited {
+			continue
+		}
+		if roomID, ok := roomOf[p.ID]; ok {
+			result.guests[roomID] = append(result.guests[roomID], p)
+		} else {
+			result.unassigned = append(result.unassigned, p)
+		}
+	}
+
+	return result, nil
+}
+
+// getLodgingRoom loads a room making sure it belongs to the given lodging of
+// the trip, returning the error to be sent to the client otherwise.
+func (api *API) getLodgingRoom(ctx context.Context, tripID, lodgingID, roomID string) (pgstore.Lodging, pgstore.LodgingRoom, *apiError) {
+	lodging, errResp := api.getTripLodging(ctx, tripID, lodgingID)
+	if errResp != nil {
+		return pgstore.Lodging{}, pgstore.LodgingRoom{}, errResp
+	}
+
+	roomUUID, errID := pathID(ctx, "roomId", roomID)
+	if errID != nil {
+		return pgstore.Lodging{}, pgstore.LodgingRoom{}, errID
+	}
+
+	room, err := api.store.GetLodgingRoom(ctx, roomUUID)
+	if err != nil {
+		if errors.Is(err, pgstore.ErrNotFound) {
+			return pgstore.Lodging{}, pgstore.LodgingRoom{}, notFound("room_not_found", "room not found")
+		}
+		api.logger.Error("failed to get lodging room", zap.Error(err), zap.String("room_id", roomID))
+		return pgstore.Lodging{}, pgstore.LodgingRoom{}, badRequest(internalError, "something went wrong, try again")
+	}
+
+	if room.LodgingID != lodging.ID {
+		return pgstore.Lodging{}, pgstore.LodgingRoom{}, notFound("room_not_found", "room not found")
+	}
+
+	return lodging, room, nil
+}
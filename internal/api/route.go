@@ -0,0 +1,220 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	openapi_types "github.com/discord-gophers/goapi-gen/types"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/routing"
+	"go.uber.org/zap"
+)
+
+// Get the route between a day activities.
+// (GET /trips/{tripId}/activities/{date}/route)
+func (api *API) GetTripsTripIDActivitiesDateRoute(w http.ResponseWriter, r *http.Request, tripID string, date openapi_types.Date) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.GetTripsTripIDActivitiesDateRouteJSON400Response(errID.Error)
+	}
+
+	_, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDActivitiesDateRouteJSON400Response, spec.GetTripsTripIDActivitiesDateRouteJSON404Response)
+	}
+
+	acts, err := api.store.GetTripActivities(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get activities", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDActivitiesDateRouteJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "fail to get trip activities",
+		})
+	}
+
+	located, withoutCoordinates := dayLocatedActivities(acts, date)
+
+	response := spec.GetActivitiesRouteResponse{
+		Legs:               make([]spec.GetActivitiesRouteResponseLegArray, 0, len(located)),
+		Feasible:           true,
+		WithoutCoordinates: withoutCoordinates,
+	}
+	scheduled := scheduleActivities(located)
+	for i := 1; i < len(located); i++ {
+		leg, err := api.routing.Route(r.Context(), activityPoint(located[i-1]), activityPoint(located[i]))
+		if err != nil {
+			api.logger.Error("failed to route activities", zap.Error(err), zap.String("trip_id", tripID))
+			return spec.GetTripsTripIDActivitiesDateRouteJSON400Response(spec.Error{
+				Code:    internalError,
+				Message: "failed to estimate route, try again",
+			})
+		}
+
+		available := scheduled[i].StartsAt.Sub(scheduled[i-1].EndsAt())
+		feasible := leg.Duration <= available
+		response.Legs = append(response.Legs, spec.GetActivitiesRouteResponseLegArray{
+			FromActivityID:   located[i-1].ID.String(),
+			ToActivityID:     located[i].ID.String(),
+			DistanceMeters:   leg.DistanceMeters,
+			TravelMinutes:    int(leg.Duration.Minutes()),
+			AvailableMinutes: int(available.Minutes()),
+			Feasible:         feasible,
+		})
+		response.TotalDistanceMeters += leg.DistanceMeters
+		response.TotalTravelMinutes += int(leg.Duration.Minutes())
+		response.Feasible = response.Feasible && feasible
+	}
+
+	return spec.GetTripsTripIDActivitiesDateRouteJSON200Response(response)
+}
+
+// Suggest a shorter order for a day activities.
+// (GET /trips/{tripId}/activities/{date}/optimize)
+func (api *API) GetTripsTripIDActivitiesDateOptimize(w http.ResponseWriter, r *http.Request, tripID string, date openapi_types.Date) *spec.Response {
+	located, errResp := api.getDayLocatedActivities(r.Context(), tripID, date)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDActivitiesDateOptimizeJSON400Response, spec.GetTripsTripIDActivitiesDateOptimizeJSON404Response)
+	}
+
+	points := make([]routing.Point, len(located))
+	current := make([]int, len(located))
+	for i, act := range located {
+		points[i] = activityPoint(act)
+		current[i] = i
+	}
+	suggested := routing.Shortest(points)
+
+	response := spec.GetOptimizedDayResponse{
+		Activities:              make([]spec.GetOptimizedDayResponseActivityArray, 0, len(located)),
+		CurrentDistanceMeters:   routing.PathDistance(points, current),
+		SuggestedDistanceMeters: routing.PathDistance(points, suggested),
+	}
+	for slot, i := range suggested {
+		response.Activities = append(response.Activities, spec.GetOptimizedDayResponseActivityArray{
+			ID:       located[i].ID.String(),
+			Title:    located[i].Title,
+			OccursAt: located[slot].OccursAt.Time,
+		})
+	}
+
+	return spec.GetTripsTripIDActivitiesDateOptimizeJSON200Response(response)
+}
+
+// Accept a suggested order for a day activities.
+// (POST /trips/{tripId}/activities/{date}/optimize)
+func (api *API) PostTripsTripIDActivitiesDateOptimize(w http.ResponseWriter, r *http.Request, tripID string, date openapi_types.Date) *spec.Response {
+	located, errResp := api.getDayLocatedActivities(r.Context(), tripID, date)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDActivitiesDateOptimizeJSON400Response, spec.PostTripsTripIDActivitiesDateOptimizeJSON404Response)
+	}
+
+	var body spec.PostTripsTripIDActivitiesDateOptimizeJSONBody
+	if errJson := json.NewDecoder(r.Body).Decode(&body); errJson != nil {
+		return spec.PostTripsTripIDActivitiesDateOptimizeJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + errJson.Error()})
+	}
+
+	if errVal := api.validator.Struct(body); errVal != nil {
+		return spec.PostTripsTripIDActivitiesDateOptimizeJSON422Response(invalidBody(errVal))
+	}
+
+	// The accepted order must hold exactly the activities the day has now,
+	// otherwise the suggestion is stale.
+	remaining := make(map[uuid.UUID]bool, len(located))
+	invited := make(map[uuid.UUID]pgstore.Activity)
+	for _, act := range located {
+		remaining[act.ID] = true
+		if act.InviteSequence.Valid && act.Status == pgstore.PlanApproved {
+			invited[act.ID] = act
+		}
+	}
+
+	params := make([]pgstore.UpdateActivityOccursAtParams, 0, len(body.ActivityIds))
+	for slot, activityID := range body.ActivityIds {
+		id := uuid.MustParse(activityID)
+		if slot >= len(located) || !remaining[id] {
+			return spec.PostTripsTripIDActivitiesDateOptimizeJSON400Response(spec.Error{
+				Code:    "stale_order",
+				Message: "order does not match the day activities, get a new suggestion",
+			})
+		}
+		delete(remaining, id)
+		params = append(params, pgstore.UpdateActivityOccursAtParams{
+			ID:       id,
+			OccursAt: pgtype.Timestamp{Valid: true, Time: located[slot].OccursAt.Time},
+		})
+	}
+
+	if len(remaining) > 0 {
+		return spec.PostTripsTripIDActivitiesDateOptimizeJSON400Response(spec.Error{
+			Code:    "stale_order",
+			Message: "order does not match the day activities, get a new suggestion",
+		})
+	}
+
+	if err := api.store.RescheduleActivities(r.Context(), api.pool, params); err != nil {
+		api.logger.Error("failed to reschedule activities", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDActivitiesDateOptimizeJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to reorder activities, try again",
+		})
+	}
+
+	var moved []uuid.UUID
+	for _, p := range params {
+		if act, ok := invited[p.ID]; ok && !act.OccursAt.Time.Equal(p.OccursAt.Time) {
+			moved = append(moved, p.ID)
+		}
+	}
+	api.sendActivityInvites(moved, "PostTripsTripIDActivitiesDateOptimize")
+
+	return spec.PostTripsTripIDActivitiesDateOptimizeJSON204Response(nil)
+}
+
+// getDayLocatedActivities loads the activities with coordinates a trip has
+// on the given date, returning the error to be sent to the client otherwise.
+func (api *API) getDayLocatedActivities(ctx context.Context, tripID string, date openapi_types.Date) ([]pgstore.Activity, *apiError) {
+	id, errID := pathID(ctx, "tripId", tripID)
+	if errID != nil {
+		return nil, errID
+	}
+
+	if _, errResp := api.getTrip(ctx, id); errResp != nil {
+		return nil, errResp
+	}
+
+	acts, err := api.store.GetTripActivities(ctx, id)
+	if err != nil {
+		api.logger.Error("failed to get activities", zap.Error(err), zap.String("trip_id", tripID))
+		return nil, badRequest(internalError, "fail to get trip activities")
+	}
+
+	located, _ := dayLocatedActivities(acts, date)
+	return located, nil
+}
+
+// dayLocatedActivities splits the activities happening on the given date
+// between those with coordinates, in order, and the ids of the rest.
+func dayLocatedActivities(acts []pgstore.Activity, date openapi_types.Date) ([]pgstore.Activity, []string) {
+	located := make([]pgstore.Activity, 0, len(acts))
+	withoutCoordinates := make([]string, 0)
+	for _, act := range acts {
+		y, m, d := act.OccursAt.Time.Date()
+		if y != date.Year() || m != date.Month() || d != date.Day() {
+			continue
+		}
+		if !act.Latitude.Valid || !act.Longitude.Valid {
+			withoutCoordinates = append(withoutCoordinates, act.ID.String())
+			continue
+		}
+		located = append(located, act)
+	}
+	return located, withoutCoordinates
+}
+
+func activityPoint(act pgstore.Activity) routing.Point {
+	return routing.Point{Latitude: act.Latitude.Float64, Longitude: act.Longitude.Float64}
+}
@@ -0,0 +1,78 @@
+package serializer
+
+import (
+	"github.com/discord-gophers/goapi-gen/types"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/export"
+)
+
+// Itinerary renders the itinerary of a trip as the audience sees it, for the
+// export formats rendered from it.
+func (a Audience) Itinerary(it export.Itinerary) export.Itinerary {
+	view := it
+	view.Destination = a.Text(it.Destination)
+	view.Days = make([]export.Day, 0, len(it.Days))
+	for _, day := range it.Days {
+		items := make([]export.Item, 0, len(day.Items))
+		for _, item := range day.Items {
+			notes := make([]string, 0, len(item.Notes))
+			for _, note := range item.Notes {
+				notes = append(notes, a.Text(note))
+			}
+			item.Title, item.Notes = a.Text(item.Title), notes
+			items = append(items, item)
+		}
+		view.Days = append(view.Days, export.Day{Date: day.Date, Items: items})
+	}
+	view.Links = make([]export.Link, 0, len(it.Links))
+	for _, link := range it.Links {
+		view.Links = append(view.Links, export.Link{Title: a.Text(link.Title), URL: link.URL})
+	}
+	return view
+}
+
+// Changes renders the changes made to the itinerary of a trip as the audience
+// sees them.
+func (a Audience) Changes(changes []export.Change) []export.Change {
+	view := make([]export.Change, 0, len(changes))
+	for _, change := range changes {
+		change.Title, change.Summary = a.Text(change.Title), a.Text(change.Summary)
+		view = append(view, change)
+	}
+	return view
+}
+
+// EmbedTrip renders the itinerary of a trip for embedding.
+func EmbedTrip(a Audience, it export.Itinerary) spec.EmbedTripResponse {
+	it = a.Itinerary(it)
+	response := spec.EmbedTripResponse{
+		Destination: it.Destination,
+		StartsAt:    it.StartsAt,
+		EndsAt:      it.EndsAt,
+		Days:        make([]spec.EmbedTripDay, 0, len(it.Days)),
+		Links:       make([]spec.EmbedTripLink, 0, len(it.Links)),
+	}
+
+	for _, day := range it.Days {
+		items := make([]spec.EmbedTripItem, 0, len(day.Items))
+		for _, item := range day.Items {
+			embedItem := spec.EmbedTripItem{
+				At:    item.At,
+				Title: item.Title,
+				Notes: item.Notes,
+			}
+			if item.Duration > 0 {
+				minutes := int(item.Duration.Minutes())
+				embedItem.DurationMinutes = &minutes
+			}
+			items = append(items, embedItem)
+		}
+		response.Days = append(response.Days, spec.EmbedTripDay{Date: types.Date{Time: day.Date}, Items: items})
+	}
+
+	for _, link := range it.Links {
+		response.Links = append(response.Links, spec.EmbedTripLink{Title: link.Title, URL: link.URL})
+	}
+
+	return response
+}
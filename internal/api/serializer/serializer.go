@@ -0,0 +1,173 @@
+// Package serializer renders the trip plans into the API responses. What each
+// response shows depends on who it is for: owners see everything, while
+// participants and the public see email addresses masked, and the public does
+// not see the budget or how the trip is organized. Every route reached through
+// a shared token or id renders through the public view.
+package serializer
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/discord-gophers/goapi-gen/types"
+	"github.com/google/uuid"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/domain"
+)
+
+// Audience is who a response is rendered for.
+type Audience int
+
+const (
+	// OwnerView is for the organizers of the trip.
+	OwnerView Audience = iota
+	// ParticipantView is for the people invited to the trip.
+	ParticipantView
+	// PublicView is for anyone holding a shared token or id.
+	PublicView
+)
+
+// emailAddress matches the email addresses written in free text.
+var emailAddress = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9\-]+(?:\.[A-Za-z0-9\-]+)*`)
+
+// Email renders an email address, masked for anyone but the owners.
+func (a Audience) Email(email string) string {
+	if a == OwnerView {
+		return email
+	}
+	return MaskEmail(email)
+}
+
+// Text renders free text, such as titles and notes, with the email addresses
+// written in it masked for anyone but the owners.
+func (a Audience) Text(text string) string {
+	if a == OwnerView {
+		return text
+	}
+	return emailAddress.ReplaceAllStringFunc(text, MaskEmail)
+}
+
+// MaskEmail hides all but the first letter of the mailbox and of the domain of
+// an email, keeping its top level domain, as in j***@d***.com. It lets people
+// tell addresses apart without exposing them.
+func MaskEmail(email string) string {
+	local, domain, ok := strings.Cut(email, "@")
+	if !ok || local == "" || domain == "" {
+		return "***"
+	}
+
+	masked := local[:1] + "***@" + domain[:1] + "***"
+	if dot := strings.LastIndex(domain, "."); dot > 0 {
+		masked += domain[dot:]
+	}
+	return masked
+}
+
+// Trip renders the details of a trip.
+func Trip(a Audience, trip domain.Trip) spec.GetTripDetailsResponseTripObj {
+	response := spec.GetTripDetailsResponseTripObj{
+		ID:                  trip.ID.String(),
+		Destination:         trip.Destination,
+		IsConfirmed:         trip.Status.Confirmed(),
+		Status:              string(trip.Status),
+		StartsAt:            trip.StartsAt,
+		EndsAt:              trip.EndsAt,
+		ItineraryAttachment: trip.ItineraryAttachment,
+		Currency:            optional(trip.Currency),
+		ArchivedAt:          optional(trip.ArchivedAt),
+	}
+	if a != PublicView {
+		response.MaxParticipants = optional(trip.MaxParticipants)
+		response.BudgetPerPersonCents = optional(trip.BudgetPerPersonCents)
+	}
+	return response
+}
+
+// Activity renders an activity of a trip.
+func Activity(a Audience, act domain.Activity) spec.GetTripActivitiesResponseInnerArray {
+	response := spec.GetTripActivitiesResponseInnerArray{
+		ID:              act.ID.String(),
+		Title:           act.Title,
+		OccursAt:        act.OccursAt,
+		DurationMinutes: optional(int(act.Duration / time.Minute)),
+		Tags:            append([]string{}, act.Tags...),
+		CostCents:       act.CostCents,
+		Currency:        optional(act.Currency),
+		Status:          string(act.Status),
+	}
+	if act.Location != nil {
+		response.Latitude = &act.Location.Latitude
+		response.Longitude = &act.Location.Longitude
+	}
+	if a != PublicView {
+		response.OrganizerID = optionalID(act.OrganizerID)
+		response.ExpenseID = optionalID(act.ExpenseID)
+	}
+	return response
+}
+
+// ActivityDays renders the activities of a trip grouped by when they happen.
+// Groups follow the order of the first activity of each, so they keep the
+// order the activities are in.
+func ActivityDays(a Audience, acts []domain.Activity) []spec.GetTripActivitiesResponseOuterArray {
+	days := []spec.GetTripActivitiesResponseOuterArray{}
+	index := make(map[time.Time]int)
+	for _, act := range acts {
+		i, ok := index[act.OccursAt]
+		if !ok {
+			i = len(days)
+			index[act.OccursAt] = i
+			days = append(days, spec.GetTripActivitiesResponseOuterArray{Date: act.OccursAt})
+		}
+		days[i].Activities = append(days[i].Activities, Activity(a, act))
+	}
+	return days
+}
+
+// Link renders a link of a trip.
+func Link(link domain.Link) spec.GetLinksResponseArray {
+	return spec.GetLinksResponseArray{ID: link.ID.String(), Title: link.Title, URL: link.URL}
+}
+
+// Participant renders a participant of a trip along with their companions.
+// Participants without a name go by their email, masked like the address.
+func Participant(a Audience, part domain.Participant, companions []spec.GetTripParticipantsResponseCompanionArray) spec.GetTripParticipantsResponseArray {
+	name := part.Name
+	if name == "" {
+		name = a.Email(part.Email)
+	}
+
+	response := spec.GetTripParticipantsResponseArray{
+		ID:          part.ID.String(),
+		Email:       types.Email(a.Email(part.Email)),
+		IsConfirmed: part.Confirmed,
+		Name:        &name,
+		Status:      string(part.Status),
+		Role:        string(part.Role),
+		Companions:  append([]spec.GetTripParticipantsResponseCompanionArray{}, companions...),
+	}
+	if a != PublicView {
+		response.GroupID = optionalID(part.GroupID)
+	}
+	return response
+}
+
+// optional renders the zero value, which the domain uses for what was not
+// given, as null.
+func optional[T comparable](v T) *T {
+	var zero T
+	if v == zero {
+		return nil
+	}
+	return &v
+}
+
+// optionalID renders uuid.Nil as null.
+func optionalID(id uuid.UUID) *string {
+	if id == uuid.Nil {
+		return nil
+	}
+	s := id.String()
+	return &s
+}
@@ -0,0 +1,109 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+// Get a trip settings.
+// (GET /trips/{tripId}/settings)
+func (api *API) GetTripsTripIDSettings(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.GetTripsTripIDSettingsJSON400Response(errID.Error)
+	}
+
+	trip, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDSettingsJSON400Response, spec.GetTripsTripIDSettingsJSON404Response)
+	}
+
+	return spec.GetTripsTripIDSettingsJSON200Response(tripSettingsResponse(trip.Settings))
+}
+
+// Change a trip settings.
+// (PATCH /trips/{tripId}/settings)
+func (api *API) PatchTripsTripIDSettings(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.PatchTripsTripIDSettingsJSON400Response(errID.Error)
+	}
+
+	trip, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PatchTripsTripIDSettingsJSON400Response, spec.PatchTripsTripIDSettingsJSON404Response)
+	}
+
+	var body spec.UpdateTripSettingsRequest
+	if errJson := json.NewDecoder(r.Body).Decode(&body); errJson != nil {
+		return spec.PatchTripsTripIDSettingsJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + errJson.Error()})
+	}
+
+	if errVal := api.validator.Struct(body); errVal != nil {
+		return spec.PatchTripsTripIDSettingsJSON422Response(invalidBody(errVal))
+	}
+
+	settings := trip.Settings
+	if body.ReminderHour != nil {
+		settings.ReminderHour = *body.ReminderHour
+	}
+	if body.ReminderDays != nil {
+		settings.ReminderDays = slices.Clone(body.ReminderDays)
+		slices.Sort(settings.ReminderDays)
+		slices.Reverse(settings.ReminderDays)
+	}
+	if body.Digest != nil {
+		settings.Digest = *body.Digest
+	}
+	if body.PlanningDigest != nil {
+		settings.PlanningDigest = *body.PlanningDigest
+	}
+	if body.ProposalMode != nil {
+		settings.ProposalMode = *body.ProposalMode
+	}
+	if body.Currency != nil {
+		settings.Currency = strings.ToUpper(*body.Currency)
+	}
+	if body.Timezone != nil {
+		settings.Timezone = *body.Timezone
+	}
+	if body.ItineraryAttachment != nil {
+		settings.ItineraryAttachment = *body.ItineraryAttachment
+	}
+	if body.KeepPhotoLocation != nil {
+		settings.KeepPhotoLocation = *body.KeepPhotoLocation
+	}
+
+	if err := api.store.UpdateTripSettings(r.Context(), pgstore.UpdateTripSettingsParams{
+		ID:       id,
+		Settings: settings,
+	}); err != nil {
+		api.logger.Error("failed to update trip settings", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PatchTripsTripIDSettingsJSON400Response(spec.Error{Code: internalError, Message: "failed to update trip settings, try again"})
+	}
+
+	return spec.PatchTripsTripIDSettingsJSON200Response(tripSettingsResponse(settings))
+}
+
+func tripSettingsResponse(settings pgstore.TripSettings) spec.TripSettings {
+	response := spec.TripSettings{
+		ReminderHour:        settings.ReminderHour,
+		ReminderDays:        append([]int{}, settings.ReminderDays...),
+		Digest:              settings.Digest,
+		PlanningDigest:      settings.PlanningDigest,
+		ProposalMode:        settings.ProposalMode,
+		Timezone:            settings.Timezone,
+		ItineraryAttachment: settings.ItineraryAttachment,
+		KeepPhotoLocation:   settings.KeepPhotoLocation,
+	}
+	if settings.Currency != "" {
+		response.Currency = &settings.Currency
+	}
+	return response
+}
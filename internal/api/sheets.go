@@ -0,0 +1,189 @@
+package api
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/sheets"
+	"go.uber.org/zap"
+)
+
+// Get the Google Sheet of a trip.
+// (GET /trips/{tripId}/sheets)
+func (api *API) GetTripsTripIDSheets(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.GetTripsTripIDSheetsJSON400Response(errID.Error)
+	}
+
+	sheet, err := api.store.GetTripSheet(r.Context(), id)
+	if err != nil || !sheet.SpreadsheetID.Valid {
+		if err != nil && !errors.Is(err, pgstore.ErrNotFound) {
+			api.logger.Error("failed to get trip sheet", zap.Error(err), zap.String("trip_id", tripID))
+			return spec.GetTripsTripIDSheetsJSON400Response(spec.Error{
+				Code:    internalError,
+				Message: "something went wrong, try again",
+			})
+		}
+		return spec.GetTripsTripIDSheetsJSON404Response(spec.Error{
+			Code:    "trip_not_connected",
+			Message: "trip is not connected to a sheet",
+		})
+	}
+
+	return spec.GetTripsTripIDSheetsJSON200Response(tripSheetResponse(sheet))
+}
+
+// Connect a trip to Google Sheets.
+// (POST /trips/{tripId}/sheets)
+func (api *API) PostTripsTripIDSheets(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.PostTripsTripIDSheetsJSON400Response(errID.Error)
+	}
+
+	_, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDSheetsJSON400Response, spec.PostTripsTripIDSheetsJSON404Response)
+	}
+
+	state, err := pgstore.NewTripSheetState()
+	if err != nil {
+		api.logger.Error("failed to generate trip sheet state", zap.Error(err))
+		return spec.PostTripsTripIDSheetsJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	authURL, err := api.sheets.AuthURL(state)
+	if err != nil {
+		if errors.Is(err, sheets.ErrDisabled) {
+			return spec.PostTripsTripIDSheetsJSON400Response(spec.Error{
+				Code:    "feature_disabled",
+				Message: "google sheets export is not enabled",
+			})
+		}
+		api.logger.Error("failed to build sheets auth url", zap.Error(err))
+		return spec.PostTripsTripIDSheetsJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	if err := api.store.StartTripSheetConnection(r.Context(), pgstore.StartTripSheetConnectionParams{
+		TripID: id,
+		State:  pgtype.Text{Valid: true, String: state},
+	}); err != nil {
+		api.logger.Error("failed to start trip sheet connection", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDSheetsJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to connect trip, try again",
+		})
+	}
+
+	return spec.PostTripsTripIDSheetsJSON200Response(spec.ConnectTripSheetResponse{AuthURL: authURL})
+}
+
+// Disconnect a trip from Google Sheets.
+// (DELETE /trips/{tripId}/sheets)
+func (api *API) DeleteTripsTripIDSheets(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.DeleteTripsTripIDSheetsJSON400Response(errID.Error)
+	}
+
+	rows, err := api.store.DeleteTripSheet(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to delete trip sheet", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.DeleteTripsTripIDSheetsJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to disconnect trip, try again",
+		})
+	}
+	if rows == 0 {
+		return spec.DeleteTripsTripIDSheetsJSON404Response(spec.Error{
+			Code:    "trip_not_connected",
+			Message: "trip is not connected to a sheet",
+		})
+	}
+
+	return spec.DeleteTripsTripIDSheetsJSON204Response(nil)
+}
+
+// Finish connecting a trip to Google Sheets.
+// (GET /sheets/callback)
+func (api *API) GetSheetsCallback(w http.ResponseWriter, r *http.Request, params spec.GetSheetsCallbackParams) *spec.Response {
+	sheet, err := api.store.GetTripSheetByState(r.Context(), pgtype.Text{Valid: true, String: params.State})
+	if err != nil {
+		if errors.Is(err, pgstore.ErrNotFound) {
+			return spec.GetSheetsCallbackJSON404Response(spec.Error{
+				Code:    "connection_not_found",
+				Message: "connection not found, start it again",
+			})
+		}
+		api.logger.Error("failed to get trip sheet", zap.Error(err))
+		return spec.GetSheetsCallbackJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	trip, errResp := api.getTrip(r.Context(), sheet.TripID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetSheetsCallbackJSON400Response, spec.GetSheetsCallbackJSON404Response)
+	}
+
+	refreshToken, err := api.sheets.Connect(r.Context(), params.Code)
+	if err != nil {
+		api.logger.Warn("failed to connect google account", zap.Error(err), zap.String("trip_id", trip.ID.String()))
+		return spec.GetSheetsCallbackJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to connect google account, try again",
+		})
+	}
+
+	tabs, err := sheets.Trip(r.Context(), api.store, trip)
+	if err != nil {
+		api.logger.Error("failed to build trip sheets", zap.Error(err), zap.String("trip_id", trip.ID.String()))
+		return spec.GetSheetsCallbackJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	spreadsheet, err := api.sheets.Create(r.Context(), refreshToken, "Viagem para "+trip.Destination, tabs)
+	if err != nil {
+		api.logger.Error("failed to create trip spreadsheet", zap.Error(err), zap.String("trip_id", trip.ID.String()))
+		return spec.GetSheetsCallbackJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to create spreadsheet, try again",
+		})
+	}
+
+	connected, err := api.store.ConnectTripSheet(r.Context(), pgstore.ConnectTripSheetParams{
+		TripID:         trip.ID,
+		RefreshToken:   pgtype.Text{Valid: true, String: refreshToken},
+		SpreadsheetID:  pgtype.Text{Valid: true, String: spreadsheet.ID},
+		SpreadsheetUrl: pgtype.Text{Valid: true, String: spreadsheet.URL},
+	})
+	if err != nil {
+		api.logger.Error("failed to connect trip sheet", zap.Error(err), zap.String("trip_id", trip.ID.String()))
+		return spec.GetSheetsCallbackJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to connect trip, try again",
+		})
+	}
+
+	return spec.GetSheetsCallbackJSON200Response(tripSheetResponse(connected))
+}
+
+func tripSheetResponse(sheet pgstore.TripSheet) spec.TripSheetResponse {
+	return spec.TripSheetResponse{
+		SpreadsheetURL: sheet.SpreadsheetUrl.String,
+		SyncedAt:       sheet.SyncedAt.Time,
+	}
+}
@@ -0,0 +1,304 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+// shoppingCategory is the expense category of a purchased shopping item when
+// none is given.
+const shoppingCategory = "groceries"
+
+// Get a trip shopping list.
+// (GET /trips/{tripId}/shopping)
+func (api *API) GetTripsTripIDShopping(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.GetTripsTripIDShoppingJSON400Response(errID.Error)
+	}
+
+	_, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDShoppingJSON400Response, spec.GetTripsTripIDShoppingJSON404Response)
+	}
+
+	items, err := api.store.GetTripShoppingItems(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get shopping items", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDShoppingJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "fail to get trip shopping list",
+		})
+	}
+
+	responseItems := make([]spec.GetShoppingListResponseArray, 0, len(items))
+	for _, item := range items {
+		responseItem := spec.GetShoppingListResponseArray{
+			ID:       item.ID.String(),
+			Title:    item.Title,
+			Quantity: item.Quantity,
+		}
+		if item.ClaimedBy.Valid {
+			claimedBy := uuid.UUID(item.ClaimedBy.Bytes).String()
+			responseItem.ClaimedBy = &claimedBy
+		}
+		if item.ExpenseID.Valid {
+			expenseID := uuid.UUID(item.ExpenseID.Bytes).String()
+			responseItem.ExpenseID = &expenseID
+		}
+		if item.PurchasedAt.Valid {
+			responseItem.PurchasedAt = &item.PurchasedAt.Time
+		}
+		responseItems = append(responseItems, responseItem)
+	}
+
+	return spec.GetTripsTripIDShoppingJSON200Response(spec.GetShoppingListResponse{Items: responseItems})
+}
+
+// Add an item to a trip shopping list.
+// (POST /trips/{tripId}/shopping)
+func (api *API) PostTripsTripIDShopping(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.PostTripsTripIDShoppingJSON400Response(errID.Error)
+	}
+
+	_, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDShoppingJSON400Response, spec.PostTripsTripIDShoppingJSON404Response)
+	}
+
+	var body spec.CreateShoppingItemRequest
+	if errJson := json.NewDecoder(r.Body).Decode(&body); errJson != nil {
+		return spec.PostTripsTripIDShoppingJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + errJson.Error()})
+	}
+
+	if errVal := api.validator.Struct(body); errVal != nil {
+		return spec.PostTripsTripIDShoppingJSON422Response(invalidBody(errVal))
+	}
+
+	params := pgstore.CreateShoppingItemParams{
+		TripID: id,
+		Title:  body.Title,
+	}
+	if body.Quantity != nil {
+		params.Quantity = *body.Quantity
+	}
+
+	itemID, err := api.store.CreateShoppingItem(r.Context(), params)
+	if err != nil {
+		api.logger.Error("failed to create shopping item", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDShoppingJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "fail to insert shopping item",
+		})
+	}
+
+	return spec.PostTripsTripIDShoppingJSON201Response(spec.CreateShoppingItemResponse{ItemID: itemID.String()})
+}
+
+// Remove an item from a trip shopping list.
+// (DELETE /trips/{tripId}/shopping/{itemId})
+func (api *API) DeleteTripsTripIDShoppingItemID(w http.ResponseWriter, r *http.Request, tripID string, itemID string) *spec.Response {
+	item, errResp := api.getTripShoppingItem(r.Context(), tripID, itemID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.DeleteTripsTripIDShoppingItemIDJSON400Response, spec.DeleteTripsTripIDShoppingItemIDJSON404Response)
+	}
+
+	if err := api.store.DeleteShoppingItem(r.Context(), item.ID); err != nil {
+		api.logger.Error("failed to delete shopping item", zap.Error(err), zap.String("item_id", itemID))
+		return spec.DeleteTripsTripIDShoppingItemIDJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to delete shopping item, try again",
+		})
+	}
+
+	return spec.DeleteTripsTripIDShoppingItemIDJSON204Response(nil)
+}
+
+// Claim a shopping item.
+// (POST /trips/{tripId}/shopping/{itemId}/claim)
+func (api *API) PostTripsTripIDShoppingItemIDClaim(w http.ResponseWriter, r *http.Request, tripID string, itemID string) *spec.Response {
+	item, errResp := api.getTripShoppingItem(r.Context(), tripID, itemID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDShoppingItemIDClaimJSON400Response, spec.PostTripsTripIDShoppingItemIDClaimJSON404Response)
+	}
+
+	var body spec.ClaimShoppingItemRequest
+	if errJson := json.NewDecoder(r.Body).Decode(&body); errJson != nil {
+		return spec.PostTripsTripIDShoppingItemIDClaimJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + errJson.Error()})
+	}
+
+	if errVal := api.validator.Struct(body); errVal != nil {
+		return spec.PostTripsTripIDShoppingItemIDClaimJSON422Response(invalidBody(errVal))
+	}
+
+	participant, err := api.store.GetParticipant(r.Context(), uuid.MustParse(body.ParticipantID))
+	if err != nil || participant.TripID != item.TripID {
+		if err != nil && !errors.Is(err, pgstore.ErrNotFound) {
+			api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", body.ParticipantID))
+		}
+		return spec.PostTripsTripIDShoppingItemIDClaimJSON404Response(spec.Error{
+			Code:    "participant_not_found",
+			Message: "participant not found",
+		})
+	}
+
+	rows, err := api.store.ClaimShoppingItem(r.Context(), pgstore.ClaimShoppingItemParams{
+		ClaimedBy: pgtype.UUID{Valid: true, Bytes: participant.ID},
+		ID:        item.ID,
+	})
+	if err != nil {
+		if errors.Is(err, pgstore.ErrForeignKey) {
+			return spec.PostTripsTripIDShoppingItemIDClaimJSON422Response(missingReference("participant_removed", "participant is no longer on the trip"))
+		}
+		api.logger.Error("failed to claim shopping item", zap.Error(err), zap.String("item_id", itemID))
+		return spec.PostTripsTripIDShoppingItemIDClaimJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to claim shopping item, try again",
+		})
+	}
+	if rows == 0 {
+		return spec.PostTripsTripIDShoppingItemIDClaimJSON400Response(spec.Error{
+			Code:    "item_already_claimed",
+			Message: "shopping item already claimed or purchased",
+		})
+	}
+
+	return spec.PostTripsTripIDShoppingItemIDClaimJSON204Response(nil)
+}
+
+// Unclaim a shopping item.
+// (DELETE /trips/{tripId}/shopping/{itemId}/claim)
+func (api *API) DeleteTripsTripIDShoppingItemIDClaim(w http.ResponseWriter, r *http.Request, tripID string, itemID string) *spec.Response {
+	item, errResp := api.getTripShoppingItem(r.Context(), tripID, itemID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.DeleteTripsTripIDShoppingItemIDClaimJSON400Response, spec.DeleteTripsTripIDShoppingItemIDClaimJSON404Response)
+	}
+
+	rows, err := api.store.UnclaimShoppingItem(r.Context(), item.ID)
+	if err != nil {
+		api.logger.Error("failed to unclaim shopping item", zap.Error(err), zap.String("item_id", itemID))
+		return spec.DeleteTripsTripIDShoppingItemIDClaimJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to unclaim shopping item, try again",
+		})
+	}
+	if rows == 0 {
+		return spec.DeleteTripsTripIDShoppingItemIDClaimJSON400Response(spec.Error{
+			Code:    "item_already_purchased",
+			Message: "shopping item already purchased",
+		})
+	}
+
+	return spec.DeleteTripsTripIDShoppingItemIDClaimJSON204Response(nil)
+}
+
+// Mark a shopping item purchased.
+// (POST /trips/{tripId}/shopping/{itemId}/purchase)
+func (api *API) PostTripsTripIDShoppingItemIDPurchase(w http.ResponseWriter, r *http.Request, tripID string, itemID string) *spec.Response {
+	item, errResp := api.getTripShoppingItem(r.Context(), tripID, itemID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDShoppingItemIDPurchaseJSON400Response, spec.PostTripsTripIDShoppingItemIDPurchaseJSON404Response)
+	}
+
+	if item.PurchasedAt.Valid {
+		return spec.PostTripsTripIDShoppingItemIDPurchaseJSON400Response(spec.Error{
+			Code:    "item_already_purchased",
+			Message: "shopping item already purchased",
+		})
+	}
+
+	if !item.ClaimedBy.Valid {
+		return spec.PostTripsTripIDShoppingItemIDPurchaseJSON400Response(spec.Error{
+			Code:    "item_not_claimed",
+			Message: "shopping item must be claimed before being purchased",
+		})
+	}
+
+	var body spec.PurchaseShoppingItemRequest
+	if errJson := json.NewDecoder(r.Body).Decode(&body); errJson != nil {
+		return spec.PostTripsTripIDShoppingItemIDPurchaseJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + errJson.Error()})
+	}
+
+	if errVal := api.validator.Struct(body); errVal != nil {
+		return spec.PostTripsTripIDShoppingItemIDPurchaseJSON422Response(invalidBody(errVal))
+	}
+
+	splits, errResp := api.expenseSplits(r.Context(), item.TripID, body.AmountCents, body.Split)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDShoppingItemIDPurchaseJSON400Response, spec.PostTripsTripIDShoppingItemIDPurchaseJSON404Response)
+	}
+
+	params := pgstore.InsertExpenseParams{
+		TripID:      item.TripID,
+		PaidBy:      item.ClaimedBy.Bytes,
+		Description: item.Title,
+		Category:    shoppingCategory,
+		AmountCents: body.AmountCents,
+		SpentAt:     pgtype.Timestamp{Valid: true, Time: body.SpentAt},
+	}
+	if item.Quantity != "" {
+		params.Description += " (" + item.Quantity + ")"
+	}
+	if body.Category != nil {
+		params.Category = *body.Category
+	}
+
+	expenseID, err := api.store.PurchaseShoppingItem(r.Context(), api.pool, item.ID, params, splits)
+	if err != nil {
+		switch {
+		case errors.Is(err, pgstore.ErrItemPurchased):
+			return spec.PostTripsTripIDShoppingItemIDPurchaseJSON400Response(spec.Error{
+				Code:    "item_already_purchased",
+				Message: "shopping item already purchased",
+			})
+		case errors.Is(err, pgstore.ErrForeignKey):
+			return spec.PostTripsTripIDShoppingItemIDPurchaseJSON422Response(missingReference("participant_removed", "participant is no longer on the trip"))
+		}
+		api.logger.Error("failed to purchase shopping item", zap.Error(err), zap.String("item_id", itemID))
+		return spec.PostTripsTripIDShoppingItemIDPurchaseJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to create expense, try again",
+		})
+	}
+
+	return spec.PostTripsTripIDShoppingItemIDPurchaseJSON201Response(spec.CreateExpenseResponse{ExpenseID: expenseID.String()})
+}
+
+// getTripShoppingItem loads a shopping item making sure it belongs to the
+// given trip, returning the error to be sent to the client otherwise.
+func (api *API) getTripShoppingItem(ctx context.Context, tripID, itemID string) (pgstore.ShoppingItem, *apiError) {
+	tripUUID, errID := pathID(ctx, "tripId", tripID)
+	if errID != nil {
+		return pgstore.ShoppingItem{}, errID
+	}
+
+	itemUUID, errID := pathID(ctx, "itemId", itemID)
+	if errID != nil {
+		return pgstore.ShoppingItem{}, errID
+	}
+
+	item, err := api.store.GetShoppingItem(ctx, itemUUID)
+	if err != nil {
+		if errors.Is(err, pgstore.ErrNotFound) {
+			return pgstore.ShoppingItem{}, notFound("shopping_item_not_found", "shopping item not found")
+		}
+		api.logger.Error("failed to get shopping item", zap.Error(err), zap.String("item_id", itemID))
+		return pgstore.ShoppingItem{}, badRequest(internalError, "something went wrong, try again")
+	}
+
+	if item.TripID != tripUUID {
+		return pgstore.ShoppingItem{}, notFound("shopping_item_not_found", "shopping item not found")
+	}
+
+	return item, nil
+}
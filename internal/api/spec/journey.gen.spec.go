@@ -23,336 +23,7770 @@ import (
 	"github.com/go-chi/render"
 )
 
-// CreateActivityRequest defines model for CreateActivityRequest.
-type CreateActivityRequest struct {
-	OccursAt time.Time `json:"occurs_at" validate:"required"`
-	Title    string    `json:"title" validate:"required"`
+// AcceptOptimizedDayRequest defines model for AcceptOptimizedDayRequest.
+type AcceptOptimizedDayRequest struct {
+	// The suggested order being accepted.
+	ActivityIds []string `json:"activity_ids" validate:"required,min=1,dive,uuid"`
 }
 
-// CreateActivityResponse defines model for CreateActivityResponse.
-type CreateActivityResponse struct {
-	ActivityID string `json:"activityId"`
+// ActivityShiftArray defines model for ActivityShiftArray.
+type ActivityShiftArray struct {
+	From  time.Time `json:"from"`
+	ID    string    `json:"id"`
+	Title string    `json:"title"`
+	To    time.Time `json:"to"`
 }
 
-// CreateLinkRequest defines model for CreateLinkRequest.
-type CreateLinkRequest struct {
-	Title string `json:"title" validate:"required"`
-	URL   string `json:"url" validate:"required,url"`
+// AddOwnerRequest defines model for AddOwnerRequest.
+type AddOwnerRequest struct {
+	ParticipantID string `json:"participant_id" validate:"required,uuid"`
 }
 
-// CreateLinkResponse defines model for CreateLinkResponse.
-type CreateLinkResponse struct {
-	LinkID string `json:"linkId"`
+// AdminStatsDatabase defines model for AdminStatsDatabase.
+type AdminStatsDatabase struct {
+	SizeBytes int64             `json:"size_bytes"`
+	Tables    []AdminStatsTable `json:"tables"`
 }
 
-// CreateTripRequest defines model for CreateTripRequest.
-type CreateTripRequest struct {
-	Destination    string                `json:"destination" validate:"required,min=4"`
-	EmailsToInvite []openapi_types.Email `json:"emails_to_invite" validate:"required,dive,email"`
-	EndsAt         time.Time             `json:"ends_at" validate:"required"`
-	OwnerEmail     openapi_types.Email   `json:"owner_email" validate:"required,email"`
-	OwnerName      string                `json:"owner_name" validate:"required"`
-	StartsAt       time.Time             `json:"starts_at" validate:"required"`
+// AdminStatsDay defines model for AdminStatsDay.
+type AdminStatsDay struct {
+	Count int64              `json:"count"`
+	Day   openapi_types.Date `json:"day"`
 }
 
-// CreateTripResponse defines model for CreateTripResponse.
-type CreateTripResponse struct {
-	TripID string `json:"tripId"`
+// Emails handled by this server since it started.
+type AdminStatsEmails struct {
+	Failed int64 `json:"failed"`
+	Sent   int64 `json:"sent"`
 }
 
-// Bad request
-type Error struct {
-	Message string `json:"message"`
-}
+// AdminStatsResponse defines model for AdminStatsResponse.
+type AdminStatsResponse struct {
+	Database AdminStatsDatabase `json:"database"`
 
-// GetLinksResponse defines model for GetLinksResponse.
-type GetLinksResponse struct {
-	Links []GetLinksResponseArray `json:"links"`
+	// Emails handled by this server since it started.
+	Emails      AdminStatsEmails `json:"emails"`
+	GeneratedAt time.Time        `json:"generated_at"`
+
+	// Participants across every trip.
+	Participants int64           `json:"participants"`
+	Trips        AdminStatsTrips `json:"trips"`
 }
 
-// GetLinksResponseArray defines model for GetLinksResponseArray.
-type GetLinksResponseArray struct {
-	ID    string `json:"id"`
-	Title string `json:"title"`
-	URL   string `json:"url"`
+// AdminStatsTable defines model for AdminStatsTable.
+type AdminStatsTable struct {
+	Name         string `json:"name"`
+	RowsEstimate int64  `json:"rows_estimate"`
+	SizeBytes    int64  `json:"size_bytes"`
 }
 
-// GetTripActivitiesResponse defines model for GetTripActivitiesResponse.
-type GetTripActivitiesResponse struct {
-	Activities []GetTripActivitiesResponseOuterArray `json:"activities"`
+// AdminStatsTrips defines model for AdminStatsTrips.
+type AdminStatsTrips struct {
+	// Confirmed trips that have not ended.
+	Active int64 `json:"active"`
+
+	// Trips created on each of the last 30 days that had any.
+	CreatedPerDay []AdminStatsDay `json:"created_per_day"`
+	Total         int64           `json:"total"`
 }
 
-// GetTripActivitiesResponseInnerArray defines model for GetTripActivitiesResponseInnerArray.
-type GetTripActivitiesResponseInnerArray struct {
-	ID       string    `json:"id"`
-	OccursAt time.Time `json:"occurs_at"`
-	Title    string    `json:"title"`
+// AnswerDatePollRequest defines model for AnswerDatePollRequest.
+type AnswerDatePollRequest struct {
+	Votes []AnswerDatePollRequestVoteArray `json:"votes" validate:"required,min=1,dive"`
 }
 
-// GetTripActivitiesResponseOuterArray defines model for GetTripActivitiesResponseOuterArray.
-type GetTripActivitiesResponseOuterArray struct {
-	Activities []GetTripActivitiesResponseInnerArray `json:"activities"`
-	Date       time.Time                             `json:"date"`
+// AnswerDatePollRequestVoteArray defines model for AnswerDatePollRequestVoteArray.
+type AnswerDatePollRequestVoteArray struct {
+	Available bool   `json:"available"`
+	OptionID  string `json:"option_id" validate:"required,uuid"`
 }
 
-// GetTripDetailsResponse defines model for GetTripDetailsResponse.
-type GetTripDetailsResponse struct {
-	Trip GetTripDetailsResponseTripObj `json:"trip"`
+// AnswerSurveyRequest defines model for AnswerSurveyRequest.
+type AnswerSurveyRequest struct {
+	Answers []AnswerSurveyRequestAnswerArray `json:"answers" validate:"required,dive"`
 }
 
-// GetTripDetailsResponseTripObj defines model for GetTripDetailsResponseTripObj.
-type GetTripDetailsResponseTripObj struct {
-	Destination string    `json:"destination"`
-	EndsAt      time.Time `json:"ends_at"`
-	ID          string    `json:"id"`
-	IsConfirmed bool      `json:"is_confirmed"`
-	StartsAt    time.Time `json:"starts_at"`
+// AnswerSurveyRequestAnswerArray defines model for AnswerSurveyRequestAnswerArray.
+type AnswerSurveyRequestAnswerArray struct {
+	Comment    *string `json:"comment,omitempty" validate:"omitempty,max=2000"`
+	QuestionID string  `json:"question_id" validate:"required,uuid"`
+	Rating     *int    `json:"rating,omitempty" validate:"omitempty,min=1,max=5"`
 }
 
-// GetTripParticipantsResponse defines model for GetTripParticipantsResponse.
-type GetTripParticipantsResponse struct {
-	Participants []GetTripParticipantsResponseArray `json:"participants"`
+// AssignOrganizerRequest defines model for AssignOrganizerRequest.
+type AssignOrganizerRequest struct {
+	ParticipantID string `json:"participant_id" validate:"required,uuid"`
 }
 
-// GetTripParticipantsResponseArray defines model for GetTripParticipantsResponseArray.
-type GetTripParticipantsResponseArray struct {
-	Email       openapi_types.Email `json:"email"`
-	ID          string              `json:"id"`
-	IsConfirmed bool                `json:"is_confirmed"`
-	Name        *string             `json:"name"`
+// AssignRoomRequest defines model for AssignRoomRequest.
+type AssignRoomRequest struct {
+	// Participants in the room, replacing the ones in it. They are moved out of the other rooms of the lodging.
+	ParticipantIds []string `json:"participant_ids" validate:"dive,uuid"`
 }
 
-// InviteParticipantRequest defines model for InviteParticipantRequest.
-type InviteParticipantRequest struct {
-	Email openapi_types.Email `json:"email" validate:"required,email"`
+// AttachmentResponse defines model for AttachmentResponse.
+type AttachmentResponse struct {
+	ContentType string `json:"content_type"`
+	Filename    string `json:"filename"`
+	ID          string `json:"id"`
+
+	// The malware found in infected files.
+	ScanSignature *string `json:"scan_signature"`
+
+	// One of pending, clean, infected, failed or unscanned, when no scanner is configured. Files are scanned for malware once uploaded, and infected ones are quarantined.
+	ScanStatus string    `json:"scan_status"`
+	SizeBytes  int64     `json:"size_bytes"`
+	UploadedAt time.Time `json:"uploaded_at"`
 }
 
-// UpdateTripRequest defines model for UpdateTripRequest.
-type UpdateTripRequest struct {
-	Destination string    `json:"destination" validate:"required,min=4"`
-	EndsAt      time.Time `json:"ends_at" validate:"required"`
-	StartsAt    time.Time `json:"starts_at" validate:"required"`
+// BulkLinksResponse defines model for BulkLinksResponse.
+type BulkLinksResponse struct {
+	Links []BulkLinksResponseArray `json:"links"`
 }
 
-// PostTripsJSONBody defines parameters for PostTrips.
-type PostTripsJSONBody CreateTripRequest
+// BulkLinksResponseArray defines model for BulkLinksResponseArray.
+type BulkLinksResponseArray struct {
+	LinkID *string `json:"link_id"`
 
-// PutTripsTripIDJSONBody defines parameters for PutTripsTripID.
-type PutTripsTripIDJSONBody UpdateTripRequest
+	// Why the URL is invalid.
+	Reason *string `json:"reason"`
 
-// PostTripsTripIDActivitiesJSONBody defines parameters for PostTripsTripIDActivities.
-type PostTripsTripIDActivitiesJSONBody CreateActivityRequest
+	// created, duplicate when already on the trip or given before, or invalid.
+	Status string  `json:"status"`
+	Title  *string `json:"title"`
 
-// PostTripsTripIDInvitesJSONBody defines parameters for PostTripsTripIDInvites.
-type PostTripsTripIDInvitesJSONBody InviteParticipantRequest
+	// The URL as given.
+	URL string `json:"url"`
+}
 
-// PostTripsTripIDLinksJSONBody defines parameters for PostTripsTripIDLinks.
-type PostTripsTripIDLinksJSONBody CreateLinkRequest
+// ChangeOwnerEmailRequest defines model for ChangeOwnerEmailRequest.
+type ChangeOwnerEmailRequest struct {
+	Email openapi_types.Email `json:"email" validate:"required,email"`
+}
 
-// PostTripsJSONRequestBody defines body for PostTrips for application/json ContentType.
-type PostTripsJSONRequestBody PostTripsJSONBody
+// ClaimRideSeatRequest defines model for ClaimRideSeatRequest.
+type ClaimRideSeatRequest struct {
+	// The participant taking a seat, with one more for each of their companions.
+	ParticipantID string `json:"participant_id" validate:"required,uuid"`
+}
 
-// Bind implements render.Binder.
-func (PostTripsJSONRequestBody) Bind(*http.Request) error {
-	return nil
+// ClaimShoppingItemRequest defines model for ClaimShoppingItemRequest.
+type ClaimShoppingItemRequest struct {
+	ParticipantID string `json:"participant_id" validate:"required,uuid"`
 }
 
-// PutTripsTripIDJSONRequestBody defines body for PutTripsTripID for application/json ContentType.
-type PutTripsTripIDJSONRequestBody PutTripsTripIDJSONBody
+// ConfirmOwnerEmailChangeResponse defines model for ConfirmOwnerEmailChangeResponse.
+type ConfirmOwnerEmailChangeResponse struct {
+	// Whether the trip already uses the new email, or still waits for the other address to confirm.
+	Applied bool `json:"applied"`
+}
 
-// Bind implements render.Binder.
-func (PutTripsTripIDJSONRequestBody) Bind(*http.Request) error {
-	return nil
+// ConfirmParticipantsRequest defines model for ConfirmParticipantsRequest.
+type ConfirmParticipantsRequest struct {
+	ParticipantIds []string `json:"participant_ids" validate:"required,min=1,max=50,dive,uuid"`
 }
 
-// PostTripsTripIDActivitiesJSONRequestBody defines body for PostTripsTripIDActivities for application/json ContentType.
-type PostTripsTripIDActivitiesJSONRequestBody PostTripsTripIDActivitiesJSONBody
+// ConfirmParticipantsResponse defines model for ConfirmParticipantsResponse.
+type ConfirmParticipantsResponse struct {
+	// Participants confirmed now, leaving out the ones that already were.
+	Confirmed []string `json:"confirmed"`
+}
 
-// Bind implements render.Binder.
-func (PostTripsTripIDActivitiesJSONRequestBody) Bind(*http.Request) error {
-	return nil
+// ConfirmationSummaryResponse defines model for ConfirmationSummaryResponse.
+type ConfirmationSummaryResponse struct {
+	Confirmed int `json:"confirmed"`
+
+	// When the last participant confirmed, if anyone did.
+	LastConfirmedAt *time.Time `json:"last_confirmed_at,omitempty"`
+	Pending         int        `json:"pending"`
 }
 
-// PostTripsTripIDInvitesJSONRequestBody defines body for PostTripsTripIDInvites for application/json ContentType.
-type PostTripsTripIDInvitesJSONRequestBody PostTripsTripIDInvitesJSONBody
+// ConnectTripSheetResponse defines model for ConnectTripSheetResponse.
+type ConnectTripSheetResponse struct {
+	// Where to send the person connecting their Google account. They come back to GET /sheets/callback.
+	AuthURL string `json:"auth_url"`
+}
 
-// Bind implements render.Binder.
-func (PostTripsTripIDInvitesJSONRequestBody) Bind(*http.Request) error {
-	return nil
+// ContentReportRequest defines model for ContentReportRequest.
+type ContentReportRequest struct {
+	Details *string `json:"details,omitempty" validate:"omitempty,max=1000"`
+
+	// Why the trip is reported: spam, offensive or other.
+	Reason string `json:"reason" validate:"required,oneof=spam offensive other"`
 }
 
-// PostTripsTripIDLinksJSONRequestBody defines body for PostTripsTripIDLinks for application/json ContentType.
-type PostTripsTripIDLinksJSONRequestBody PostTripsTripIDLinksJSONBody
+// CorrectParticipantEmailRequest defines model for CorrectParticipantEmailRequest.
+type CorrectParticipantEmailRequest struct {
+	Email openapi_types.Email `json:"email" validate:"required,email"`
+}
 
-// Bind implements render.Binder.
-func (PostTripsTripIDLinksJSONRequestBody) Bind(*http.Request) error {
-	return nil
+// CreateActivityRequest defines model for CreateActivityRequest.
+type CreateActivityRequest struct {
+	// Estimated cost of the activity, in its currency.
+	CostCents *int64 `json:"cost_cents,omitempty" validate:"omitempty,gte=0"`
+
+	// ISO 4217 code of the cost, the trip currency when not given. Costs in other currencies are left out of the trip budget.
+	Currency        *string   `json:"currency,omitempty" validate:"omitempty,iso4217"`
+	DurationMinutes *int      `json:"duration_minutes,omitempty" validate:"omitempty,gt=0,lte=1440"`
+	Latitude        *float64  `json:"latitude,omitempty" validate:"required_with=Longitude,omitempty,gte=-90,lte=90"`
+	Longitude       *float64  `json:"longitude,omitempty" validate:"required_with=Latitude,omitempty,gte=-180,lte=180"`
+	OccursAt        time.Time `json:"occurs_at" validate:"required"`
+
+	// Email the participants a calendar invite for the activity, updated whenever it is rescheduled.
+	SendInvite *bool `json:"send_invite,omitempty"`
+
+	// Free-form labels such as outdoor, used to flag activities affected by the weather.
+	Tags  []string `json:"tags,omitempty" validate:"max=10,dive,required,max=30"`
+	Title string   `json:"title" validate:"required"`
 }
 
-// Response is a common response struct for all the API calls.
-// A Response object may be instantiated via functions for specific operation responses.
-// It may also be instantiated directly, for the purpose of responding with a single status code.
-type Response struct {
-	body        interface{}
-	Code        int
-	contentType string
+// CreateActivityResponse defines model for CreateActivityResponse.
+type CreateActivityResponse struct {
+	ActivityID string `json:"activityId"`
+
+	// Either approved or pending, when it is waiting for the owner because it goes over the trip budget.
+	Status string `json:"status"`
 }
 
-// Render implements the render.Renderer interface. It sets the Content-Type header
-// and status code based on the response definition.
-func (resp *Response) Render(w http.ResponseWriter, r *http.Request) error {
-	w.Header().Set("Content-Type", resp.contentType)
-	render.Status(r, resp.Code)
-	return nil
+// CreateChecklistItemRequest defines model for CreateChecklistItemRequest.
+type CreateChecklistItemRequest struct {
+	Category string `json:"category" validate:"required"`
+	Title    string `json:"title" validate:"required"`
 }
 
-// Status is a builder method to override the default status code for a response.
-func (resp *Response) Status(code int) *Response {
-	resp.Code = code
-	return resp
+// CreateChecklistItemResponse defines model for CreateChecklistItemResponse.
+type CreateChecklistItemResponse struct {
+	ItemID string `json:"itemId"`
 }
 
-// ContentType is a builder method to override the default content type for a response.
-func (resp *Response) ContentType(contentType string) *Response {
-	resp.contentType = contentType
-	return resp
+// CreateCompanionRequest defines model for CreateCompanionRequest.
+type CreateCompanionRequest struct {
+	Name string `json:"name" validate:"required,max=255"`
 }
 
-// MarshalJSON implements the json.Marshaler interface.
-// This is used to only marshal the body of the response.
-func (resp *Response) MarshalJSON() ([]byte, error) {
-	return json.Marshal(resp.body)
+// CreateCompanionResponse defines model for CreateCompanionResponse.
+type CreateCompanionResponse struct {
+	CompanionID string `json:"companionId"`
 }
 
-// MarshalXML implements the xml.Marshaler interface.
-// This is used to only marshal the body of the response.
-func (resp *Response) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
-	return e.Encode(resp.body)
+// CreateDatePollRequest defines model for CreateDatePollRequest.
+type CreateDatePollRequest struct {
+	Options []CreateDatePollRequestOptionArray `json:"options" validate:"required,min=1,dive"`
 }
 
-// PatchParticipantsParticipantIDConfirmJSON204Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PatchParticipantsParticipantIDConfirmJSON204Response(body interface{}) *Response {
-	return &Response{
-		body:        body,
-		Code:        204,
-		contentType: "application/json",
-	}
+// CreateDatePollRequestOptionArray defines model for CreateDatePollRequestOptionArray.
+type CreateDatePollRequestOptionArray struct {
+	EndsAt   time.Time `json:"ends_at" validate:"required,gtfield=StartsAt"`
+	StartsAt time.Time `json:"starts_at" validate:"required"`
 }
 
-// PatchParticipantsParticipantIDConfirmJSON400Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PatchParticipantsParticipantIDConfirmJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
+// CreateExpenseRequest defines model for CreateExpenseRequest.
+type CreateExpenseRequest struct {
+	AmountCents int64                         `json:"amount_cents" validate:"required,gt=0"`
+	Category    string                        `json:"category" validate:"required"`
+	Description string                        `json:"description" validate:"required"`
+	PaidBy      string                        `json:"paid_by" validate:"required,uuid"`
+	SpentAt     time.Time                     `json:"spent_at" validate:"required"`
+	Split       *CreateExpenseRequestSplitObj `json:"split,omitempty"`
 }
 
-// PostTripsJSON201Response is a constructor method for a PostTrips response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsJSON201Response(body CreateTripResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        201,
-		contentType: "application/json",
-	}
+// CreateExpenseRequestSplitObj defines model for CreateExpenseRequestSplitObj.
+type CreateExpenseRequestSplitObj struct {
+	// Groups whose participants are all in the split, counting as one share each. Only for the equal and shares methods.
+	GroupIds []string `json:"group_ids,omitempty" validate:"omitempty,dive,uuid"`
+
+	// One of equal, exact, percentage or shares.
+	Method       string                                         `json:"method" validate:"required,oneof=equal exact percentage shares"`
+	Participants []CreateExpenseRequestSplitObjParticipantArray `json:"participants,omitempty" validate:"required_without=GroupIds,dive"`
 }
 
-// PostTripsJSON400Response is a constructor method for a PostTrips response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
+// CreateExpenseRequestSplitObjParticipantArray defines model for CreateExpenseRequestSplitObjParticipantArray.
+type CreateExpenseRequestSplitObjParticipantArray struct {
+	ParticipantID string `json:"participant_id" validate:"required,uuid"`
+
+	// Amount in cents for exact, percentage for percentage and weight for shares. Ignored for equal.
+	Value *float64 `json:"value,omitempty" validate:"omitempty,gte=0"`
 }
 
-// GetTripsTripIDJSON200Response is a constructor method for a GetTripsTripID response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDJSON200Response(body GetTripDetailsResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        200,
-		contentType: "application/json",
-	}
+// CreateExpenseResponse defines model for CreateExpenseResponse.
+type CreateExpenseResponse struct {
+	ExpenseID string `json:"expenseId"`
 }
 
-// GetTripsTripIDJSON400Response is a constructor method for a GetTripsTripID response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
+// CreateLinkRequest defines model for CreateLinkRequest.
+type CreateLinkRequest struct {
+	Title string `json:"title" validate:"required"`
+	URL   string `json:"url" validate:"required,url"`
 }
 
-// PutTripsTripIDJSON204Response is a constructor method for a PutTripsTripID response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PutTripsTripIDJSON204Response(body interface{}) *Response {
-	return &Response{
-		body:        body,
-		Code:        204,
-		contentType: "application/json",
-	}
+// CreateLinkResponse defines model for CreateLinkResponse.
+type CreateLinkResponse struct {
+	LinkID string `json:"linkId"`
 }
 
-// PutTripsTripIDJSON400Response is a constructor method for a PutTripsTripID response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PutTripsTripIDJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
+// CreateLodgingRequest defines model for CreateLodgingRequest.
+type CreateLodgingRequest struct {
+	Address   string    `json:"address" validate:"required"`
+	CheckIn   time.Time `json:"check_in" validate:"required"`
+	CheckOut  time.Time `json:"check_out" validate:"required,gtfield=CheckIn"`
+	CostCents *int64    `json:"cost_cents,omitempty" validate:"omitempty,gte=0"`
+	Name      string    `json:"name" validate:"required"`
 }
 
-// GetTripsTripIDActivitiesJSON200Response is a constructor method for a GetTripsTripIDActivities response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDActivitiesJSON200Response(body GetTripActivitiesResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        200,
-		contentType: "application/json",
-	}
+// CreateLodgingResponse defines model for CreateLodgingResponse.
+type CreateLodgingResponse struct {
+	LodgingID string `json:"lodgingId"`
+
+	// Either approved or pending, when it is waiting for the owner because it goes over the trip budget.
+	Status string `json:"status"`
 }
 
-// GetTripsTripIDActivitiesJSON400Response is a constructor method for a GetTripsTripIDActivities response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDActivitiesJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
+// CreateLodgingRoomRequest defines model for CreateLodgingRoomRequest.
+type CreateLodgingRoomRequest struct {
+	// The beds in the room, e.g. 1 casal e 2 solteiro.
+	Beds *string `json:"beds,omitempty" validate:"omitempty,max=255"`
+
+	// How many people sleep in the room, companions included.
+	Capacity int    `json:"capacity" validate:"required,gte=1"`
+	Name     string `json:"name" validate:"required,max=255"`
 }
 
-// PostTripsTripIDActivitiesJSON201Response is a constructor method for a PostTripsTripIDActivities response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDActivitiesJSON201Response(body CreateActivityResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        201,
-		contentType: "application/json",
-	}
+// CreateLodgingRoomResponse defines model for CreateLodgingRoomResponse.
+type CreateLodgingRoomResponse struct {
+	RoomID string `json:"room_id"`
 }
 
-// PostTripsTripIDActivitiesJSON400Response is a constructor method for a PostTripsTripIDActivities response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDActivitiesJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
+// CreateParticipantGroupRequest defines model for CreateParticipantGroupRequest.
+type CreateParticipantGroupRequest struct {
+	Name string `json:"name" validate:"required,max=255"`
+
+	// Participants of the trip in the group. They are moved out of the group they were in.
+	ParticipantIds []string `json:"participant_ids,omitempty" validate:"omitempty,dive,uuid"`
+}
+
+// CreateParticipantGroupResponse defines model for CreateParticipantGroupResponse.
+type CreateParticipantGroupResponse struct {
+	GroupID string `json:"group_id"`
+}
+
+// CreateRideRequest defines model for CreateRideRequest.
+type CreateRideRequest struct {
+	DepartsAt      time.Time `json:"departs_at" validate:"required"`
+	DeparturePoint string    `json:"departure_point" validate:"required,max=255"`
+	DriverID       string    `json:"driver_id" validate:"required,uuid"`
+
+	// Seats offered to passengers, the driver not included.
+	Seats int `json:"seats" validate:"required,gte=1"`
+}
+
+// CreateRideResponse defines model for CreateRideResponse.
+type CreateRideResponse struct {
+	RideID string `json:"ride_id"`
+}
+
+// CreateShoppingItemRequest defines model for CreateShoppingItemRequest.
+type CreateShoppingItemRequest struct {
+	// How much to buy, as free text (e.g. "2 kg").
+	Quantity *string `json:"quantity,omitempty" validate:"omitempty,max=255"`
+	Title    string  `json:"title" validate:"required,max=255"`
+}
+
+// CreateShoppingItemResponse defines model for CreateShoppingItemResponse.
+type CreateShoppingItemResponse struct {
+	ItemID string `json:"item_id"`
+}
+
+// CreateTaskRequest defines model for CreateTaskRequest.
+type CreateTaskRequest struct {
+	AssigneeID *string            `json:"assignee_id,omitempty" validate:"omitempty,uuid"`
+	DueOn      openapi_types.Date `json:"due_on"`
+	Title      string             `json:"title" validate:"required"`
+}
+
+// CreateTaskResponse defines model for CreateTaskResponse.
+type CreateTaskResponse struct {
+	TaskID string `json:"task_id"`
+}
+
+// CreateTransportRequest defines model for CreateTransportRequest.
+type CreateTransportRequest struct {
+	ArrivesAt   time.Time `json:"arrives_at" validate:"required,gtfield=DepartsAt"`
+	DepartsAt   time.Time `json:"departs_at" validate:"required"`
+	Destination string    `json:"destination" validate:"required"`
+
+	// One of flight, train, bus, car, boat.
+	Mode   string `json:"mode" validate:"required,oneof=flight train bus car boat"`
+	Origin string `json:"origin" validate:"required"`
+}
+
+// CreateTransportResponse defines model for CreateTransportResponse.
+type CreateTransportResponse struct {
+	TransportID string `json:"transportId"`
+}
+
+// CreateTripRequest defines model for CreateTripRequest.
+type CreateTripRequest struct {
+	// Activities and lodgings that go over it wait for the owner approval.
+	BudgetPerPersonCents *int64 `json:"budget_per_person_cents,omitempty" validate:"omitempty,gt=0"`
+
+	// ISO 4217 code. When missing it is inferred from the destination country.
+	Currency       *string               `json:"currency,omitempty" validate:"omitempty,iso4217"`
+	Destination    string                `json:"destination" validate:"required,min=4"`
+	EmailsToInvite []openapi_types.Email `json:"emails_to_invite" validate:"required,dive,email"`
+	EndsAt         time.Time             `json:"ends_at" validate:"required"`
+
+	// Invitations beyond it go to a waitlist.
+	MaxParticipants *int                `json:"max_participants,omitempty" validate:"omitempty,gt=0"`
+	OwnerEmail      openapi_types.Email `json:"owner_email" validate:"required,email"`
+	OwnerName       string              `json:"owner_name" validate:"required"`
+	StartsAt        time.Time           `json:"starts_at" validate:"required"`
+
+	// Ignored. Leave it out: it is hidden from people and only filled by bots, whose requests are answered as if the trip was created.
+	Website *string `json:"website,omitempty"`
+}
+
+// CreateTripResponse defines model for CreateTripResponse.
+type CreateTripResponse struct {
+	TripID string `json:"tripId"`
+}
+
+// DraftActivitiesRequest defines model for DraftActivitiesRequest.
+type DraftActivitiesRequest struct {
+	// What the travelers like or want to avoid, in their own words.
+	Preferences *string `json:"preferences,omitempty" validate:"omitempty,max=500"`
+}
+
+// DraftActivitiesResponse defines model for DraftActivitiesResponse.
+type DraftActivitiesResponse struct {
+	Activities []DraftActivitiesResponseActivityArray `json:"activities"`
+}
+
+// DraftActivitiesResponseActivityArray defines model for DraftActivitiesResponseActivityArray.
+type DraftActivitiesResponseActivityArray struct {
+	CostCents       int64     `json:"cost_cents"`
+	DurationMinutes *int      `json:"duration_minutes"`
+	ID              string    `json:"id"`
+	OccursAt        time.Time `json:"occurs_at"`
+	Tags            []string  `json:"tags"`
+	Title           string    `json:"title"`
+}
+
+// DuplicateTripResponse defines model for DuplicateTripResponse.
+type DuplicateTripResponse struct {
+	Message string `json:"message"`
+
+	// The trip created before.
+	TripID string `json:"trip_id"`
+}
+
+// EmbedTripDay defines model for EmbedTripDay.
+type EmbedTripDay struct {
+	Date  openapi_types.Date `json:"date"`
+	Items []EmbedTripItem    `json:"items"`
+}
+
+// EmbedTripItem defines model for EmbedTripItem.
+type EmbedTripItem struct {
+	At              time.Time `json:"at"`
+	DurationMinutes *int      `json:"duration_minutes"`
+	Notes           []string  `json:"notes"`
+	Title           string    `json:"title"`
+}
+
+// EmbedTripLink defines model for EmbedTripLink.
+type EmbedTripLink struct {
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+// EmbedTripResponse defines model for EmbedTripResponse.
+type EmbedTripResponse struct {
+	Days        []EmbedTripDay  `json:"days"`
+	Destination string          `json:"destination"`
+	EndsAt      time.Time       `json:"ends_at"`
+	Links       []EmbedTripLink `json:"links"`
+	StartsAt    time.Time       `json:"starts_at"`
+}
+
+// Bad request. The message is in the language asked for with Accept-Language, pt-BR or en, and the code is not translated.
+type Error struct {
+	// Stable code of the error, the same whatever the language of the message.
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// GenerateChecklistResponse defines model for GenerateChecklistResponse.
+type GenerateChecklistResponse struct {
+	Climate *GenerateChecklistResponseClimateObj `json:"climate"`
+	Items   []GetChecklistResponseArray          `json:"items"`
+}
+
+// GenerateChecklistResponseClimateObj defines model for GenerateChecklistResponseClimateObj.
+type GenerateChecklistResponseClimateObj struct {
+	RainyDays int     `json:"rainy_days"`
+	TempMaxC  float32 `json:"temp_max_c"`
+	TempMinC  float32 `json:"temp_min_c"`
+}
+
+// GetActivitiesRouteResponse defines model for GetActivitiesRouteResponse.
+type GetActivitiesRouteResponse struct {
+	// Whether there is time to get to every activity after the previous one ends.
+	Feasible            bool                                 `json:"feasible"`
+	Legs                []GetActivitiesRouteResponseLegArray `json:"legs"`
+	TotalDistanceMeters float64                              `json:"total_distance_meters"`
+	TotalTravelMinutes  int                                  `json:"total_travel_minutes"`
+
+	// Activities of the day left out of the route because they have no coordinates.
+	WithoutCoordinates []string `json:"without_coordinates"`
+}
+
+// GetActivitiesRouteResponseLegArray defines model for GetActivitiesRouteResponseLegArray.
+type GetActivitiesRouteResponseLegArray struct {
+	// Time between the end of the first activity and the start of the next one.
+	AvailableMinutes int     `json:"available_minutes"`
+	DistanceMeters   float64 `json:"distance_meters"`
+	Feasible         bool    `json:"feasible"`
+	FromActivityID   string  `json:"from_activity_id"`
+	ToActivityID     string  `json:"to_activity_id"`
+	TravelMinutes    int     `json:"travel_minutes"`
+}
+
+// GetChecklistResponse defines model for GetChecklistResponse.
+type GetChecklistResponse struct {
+	Items []GetChecklistResponseArray `json:"items"`
+}
+
+// GetChecklistResponseArray defines model for GetChecklistResponseArray.
+type GetChecklistResponseArray struct {
+	Category  string `json:"category"`
+	ID        string `json:"id"`
+	IsChecked bool   `json:"is_checked"`
+	Title     string `json:"title"`
+}
+
+// GetConflictsResponse defines model for GetConflictsResponse.
+type GetConflictsResponse struct {
+	Conflicts []GetConflictsResponseArray `json:"conflicts"`
+}
+
+// GetConflictsResponseArray defines model for GetConflictsResponseArray.
+type GetConflictsResponseArray struct {
+	ActivityIds []string `json:"activity_ids"`
+
+	// One of overlap, outside_lodging or after_last_transport.
+	Kind    string `json:"kind"`
+	Message string `json:"message"`
+}
+
+// GetDatePollResponse defines model for GetDatePollResponse.
+type GetDatePollResponse struct {
+	Destination string                           `json:"destination"`
+	Options     []GetDatePollResponseOptionArray `json:"options"`
+}
+
+// GetDatePollResponseOptionArray defines model for GetDatePollResponseOptionArray.
+type GetDatePollResponseOptionArray struct {
+	Available *bool     `json:"available"`
+	EndsAt    time.Time `json:"ends_at"`
+	ID        string    `json:"id"`
+	StartsAt  time.Time `json:"starts_at"`
+}
+
+// GetDatePollResultsResponse defines model for GetDatePollResultsResponse.
+type GetDatePollResultsResponse struct {
+	Options []GetDatePollResultsResponseOptionArray `json:"options"`
+}
+
+// GetDatePollResultsResponseOptionArray defines model for GetDatePollResultsResponseOptionArray.
+type GetDatePollResultsResponseOptionArray struct {
+	Available   int       `json:"available"`
+	EndsAt      time.Time `json:"ends_at"`
+	ID          string    `json:"id"`
+	StartsAt    time.Time `json:"starts_at"`
+	Unavailable int       `json:"unavailable"`
+}
+
+// GetExpensesBreakdownResponse defines model for GetExpensesBreakdownResponse.
+type GetExpensesBreakdownResponse struct {
+	ByCategory    []GetExpensesBreakdownResponseCategoryArray    `json:"by_category"`
+	ByDay         []GetExpensesBreakdownResponseDayArray         `json:"by_day"`
+	ByParticipant []GetExpensesBreakdownResponseParticipantArray `json:"by_participant"`
+	TotalCents    int64                                          `json:"total_cents"`
+}
+
+// GetExpensesBreakdownResponseCategoryArray defines model for GetExpensesBreakdownResponseCategoryArray.
+type GetExpensesBreakdownResponseCategoryArray struct {
+	Category   string `json:"category"`
+	TotalCents int64  `json:"total_cents"`
+}
+
+// GetExpensesBreakdownResponseDayArray defines model for GetExpensesBreakdownResponseDayArray.
+type GetExpensesBreakdownResponseDayArray struct {
+	Date       openapi_types.Date `json:"date"`
+	TotalCents int64              `json:"total_cents"`
+}
+
+// GetExpensesBreakdownResponseParticipantArray defines model for GetExpensesBreakdownResponseParticipantArray.
+type GetExpensesBreakdownResponseParticipantArray struct {
+	Email         openapi_types.Email `json:"email"`
+	ParticipantID string              `json:"participant_id"`
+	TotalCents    int64               `json:"total_cents"`
+}
+
+// GetExpensesResponse defines model for GetExpensesResponse.
+type GetExpensesResponse struct {
+	Expenses []GetExpensesResponseArray `json:"expenses"`
+}
+
+// GetExpensesResponseArray defines model for GetExpensesResponseArray.
+type GetExpensesResponseArray struct {
+	AmountCents int64     `json:"amount_cents"`
+	Category    string    `json:"category"`
+	Description string    `json:"description"`
+	ID          string    `json:"id"`
+	PaidBy      string    `json:"paid_by"`
+	SpentAt     time.Time `json:"spent_at"`
+}
+
+// GetGapsResponse defines model for GetGapsResponse.
+type GetGapsResponse struct {
+	Gaps []GetGapsResponseArray `json:"gaps"`
+}
+
+// GetGapsResponseArray defines model for GetGapsResponseArray.
+type GetGapsResponseArray struct {
+	Date     openapi_types.Date `json:"date"`
+	EndsAt   time.Time          `json:"ends_at"`
+	Minutes  int                `json:"minutes"`
+	StartsAt time.Time          `json:"starts_at"`
+}
+
+// GetLinksResponse defines model for GetLinksResponse.
+type GetLinksResponse struct {
+	Links []GetLinksResponseArray `json:"links"`
+}
+
+// GetLinksResponseArray defines model for GetLinksResponseArray.
+type GetLinksResponseArray struct {
+	ID    string `json:"id"`
+	Title string `json:"title"`
+	URL   string `json:"url"`
+}
+
+// GetLodgingRoomsResponse defines model for GetLodgingRoomsResponse.
+type GetLodgingRoomsResponse struct {
+	Rooms []GetLodgingRoomsResponseArray `json:"rooms"`
+
+	// Participants going on the trip without a room in the lodging.
+	UnassignedParticipantIds []string `json:"unassigned_participant_ids"`
+}
+
+// GetLodgingRoomsResponseArray defines model for GetLodgingRoomsResponseArray.
+type GetLodgingRoomsResponseArray struct {
+	Beds     string `json:"beds"`
+	Capacity int    `json:"capacity"`
+	ID       string `json:"id"`
+	Name     string `json:"name"`
+
+	// How many people are assigned to the room, companions included.
+	Occupancy      int      `json:"occupancy"`
+	ParticipantIds []string `json:"participant_ids"`
+}
+
+// GetLodgingsResponse defines model for GetLodgingsResponse.
+type GetLodgingsResponse struct {
+	Lodgings []GetLodgingsResponseArray `json:"lodgings"`
+}
+
+// GetLodgingsResponseArray defines model for GetLodgingsResponseArray.
+type GetLodgingsResponseArray struct {
+	Address   string    `json:"address"`
+	CheckIn   time.Time `json:"check_in"`
+	CheckOut  time.Time `json:"check_out"`
+	CostCents int64     `json:"cost_cents"`
+	ID        string    `json:"id"`
+	Name      string    `json:"name"`
+
+	// Either approved or pending, when it is waiting for the owner because it goes over the trip budget.
+	Status string `json:"status"`
+}
+
+// GetNeedsSummaryResponse defines model for GetNeedsSummaryResponse.
+type GetNeedsSummaryResponse struct {
+	Accessibility []GetNeedsSummaryResponseNeedArray `json:"accessibility"`
+	Dietary       []GetNeedsSummaryResponseNeedArray `json:"dietary"`
+	Notes         []GetNeedsSummaryResponseNoteArray `json:"notes"`
+	Participants  int                                `json:"participants"`
+	Responded     int                                `json:"responded"`
+}
+
+// GetNeedsSummaryResponseNeedArray defines model for GetNeedsSummaryResponseNeedArray.
+type GetNeedsSummaryResponseNeedArray struct {
+	Count int    `json:"count"`
+	Need  string `json:"need"`
+}
+
+// GetNeedsSummaryResponseNoteArray defines model for GetNeedsSummaryResponseNoteArray.
+type GetNeedsSummaryResponseNoteArray struct {
+	Email         openapi_types.Email `json:"email"`
+	Notes         string              `json:"notes"`
+	ParticipantID string              `json:"participant_id"`
+}
+
+// GetOptimizedDayResponse defines model for GetOptimizedDayResponse.
+type GetOptimizedDayResponse struct {
+	Activities              []GetOptimizedDayResponseActivityArray `json:"activities"`
+	CurrentDistanceMeters   float64                                `json:"current_distance_meters"`
+	SuggestedDistanceMeters float64                                `json:"suggested_distance_meters"`
+}
+
+// GetOptimizedDayResponseActivityArray defines model for GetOptimizedDayResponseActivityArray.
+type GetOptimizedDayResponseActivityArray struct {
+	ID string `json:"id"`
+
+	// When the activity would happen in the suggested order.
+	OccursAt time.Time `json:"occurs_at"`
+	Title    string    `json:"title"`
+}
+
+// GetParticipantGroupsResponse defines model for GetParticipantGroupsResponse.
+type GetParticipantGroupsResponse struct {
+	Groups []GetParticipantGroupsResponseArray `json:"groups"`
+}
+
+// GetParticipantGroupsResponseArray defines model for GetParticipantGroupsResponseArray.
+type GetParticipantGroupsResponseArray struct {
+	ID             string   `json:"id"`
+	Name           string   `json:"name"`
+	ParticipantIds []string `json:"participant_ids"`
+}
+
+// GetParticipantNeedsResponse defines model for GetParticipantNeedsResponse.
+type GetParticipantNeedsResponse struct {
+	Accessibility []string `json:"accessibility"`
+	Dietary       []string `json:"dietary"`
+	Notes         string   `json:"notes"`
+}
+
+// GetPlanningStatusResponse defines model for GetPlanningStatusResponse.
+type GetPlanningStatusResponse struct {
+	DatesConfirmed        bool                                     `json:"dates_confirmed"`
+	DaysWithoutActivities []openapi_types.Date                     `json:"days_without_activities"`
+	NightsWithoutLodging  []openapi_types.Date                     `json:"nights_without_lodging"`
+	Participants          GetPlanningStatusResponseParticipantsObj `json:"participants"`
+
+	// Overall completeness, from 0 to 100.
+	Progress                  int                                      `json:"progress"`
+	TransfersWithoutTransport []GetPlanningStatusResponseTransferArray `json:"transfers_without_transport"`
+}
+
+// GetPlanningStatusResponseParticipantsObj defines model for GetPlanningStatusResponseParticipantsObj.
+type GetPlanningStatusResponseParticipantsObj struct {
+	Confirmed        int `json:"confirmed"`
+	ConfirmedPercent int `json:"confirmed_percent"`
+	Total            int `json:"total"`
+}
+
+// GetPlanningStatusResponseTransferArray defines model for GetPlanningStatusResponseTransferArray.
+type GetPlanningStatusResponseTransferArray struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// GetRidesResponse defines model for GetRidesResponse.
+type GetRidesResponse struct {
+	Rides []GetRidesResponseArray `json:"rides"`
+}
+
+// GetRidesResponseArray defines model for GetRidesResponseArray.
+type GetRidesResponseArray struct {
+	DepartsAt      time.Time `json:"departs_at"`
+	DeparturePoint string    `json:"departure_point"`
+	DriverID       string    `json:"driver_id"`
+	ID             string    `json:"id"`
+	PassengerIds   []string  `json:"passenger_ids"`
+	Seats          int       `json:"seats"`
+	SeatsLeft      int       `json:"seats_left"`
+}
+
+// GetSettlementResponse defines model for GetSettlementResponse.
+type GetSettlementResponse struct {
+	Balances  []GetSettlementResponseBalanceArray  `json:"balances"`
+	Transfers []GetSettlementResponseTransferArray `json:"transfers"`
+}
+
+// GetSettlementResponseBalanceArray defines model for GetSettlementResponseBalanceArray.
+type GetSettlementResponseBalanceArray struct {
+	BalanceCents  int64               `json:"balance_cents"`
+	Email         openapi_types.Email `json:"email"`
+	OwedCents     int64               `json:"owed_cents"`
+	PaidCents     int64               `json:"paid_cents"`
+	ParticipantID string              `json:"participant_id"`
+}
+
+// GetSettlementResponseTransferArray defines model for GetSettlementResponseTransferArray.
+type GetSettlementResponseTransferArray struct {
+	AmountCents int64  `json:"amount_cents"`
+	From        string `json:"from"`
+	To          string `json:"to"`
+}
+
+// GetShoppingListResponse defines model for GetShoppingListResponse.
+type GetShoppingListResponse struct {
+	Items []GetShoppingListResponseArray `json:"items"`
+}
+
+// GetShoppingListResponseArray defines model for GetShoppingListResponseArray.
+type GetShoppingListResponseArray struct {
+	ClaimedBy   *string    `json:"claimed_by"`
+	ExpenseID   *string    `json:"expense_id"`
+	ID          string     `json:"id"`
+	PurchasedAt *time.Time `json:"purchased_at"`
+	Quantity    string     `json:"quantity"`
+	Title       string     `json:"title"`
+}
+
+// GetSurveyQuestionsResponse defines model for GetSurveyQuestionsResponse.
+type GetSurveyQuestionsResponse struct {
+	// Whether the owners did not define questions, so the default ones will be asked.
+	IsDefault bool                                      `json:"is_default"`
+	Questions []GetSurveyQuestionsResponseQuestionArray `json:"questions"`
+	SentAt    *time.Time                                `json:"sent_at"`
+}
+
+// GetSurveyQuestionsResponseQuestionArray defines model for GetSurveyQuestionsResponseQuestionArray.
+type GetSurveyQuestionsResponseQuestionArray struct {
+	Kind   string `json:"kind"`
+	Prompt string `json:"prompt"`
+}
+
+// GetSurveyResponse defines model for GetSurveyResponse.
+type GetSurveyResponse struct {
+	Destination string                           `json:"destination"`
+	Questions   []GetSurveyResponseQuestionArray `json:"questions"`
+}
+
+// GetSurveyResponseQuestionArray defines model for GetSurveyResponseQuestionArray.
+type GetSurveyResponseQuestionArray struct {
+	Comment *string `json:"comment"`
+	ID      string  `json:"id"`
+	Kind    string  `json:"kind"`
+	Prompt  string  `json:"prompt"`
+	Rating  *int    `json:"rating"`
+}
+
+// GetSurveyResultsResponse defines model for GetSurveyResultsResponse.
+type GetSurveyResultsResponse struct {
+	// Participants the survey was sent to.
+	Invited   int                                     `json:"invited"`
+	Questions []GetSurveyResultsResponseQuestionArray `json:"questions"`
+
+	// Participants who answered at least one question.
+	Responded int        `json:"responded"`
+	SentAt    *time.Time `json:"sent_at"`
+}
+
+// GetSurveyResultsResponseQuestionArray defines model for GetSurveyResultsResponseQuestionArray.
+type GetSurveyResultsResponseQuestionArray struct {
+	Answers int `json:"answers"`
+
+	// Average rating, for rating questions answered at least once.
+	Average  *float32 `json:"average"`
+	Comments []string `json:"comments"`
+	ID       string   `json:"id"`
+	Kind     string   `json:"kind"`
+	Prompt   string   `json:"prompt"`
+
+	// How many answered each rating from 1 to 5, for rating questions.
+	Ratings []int `json:"ratings"`
+}
+
+// GetTasksResponse defines model for GetTasksResponse.
+type GetTasksResponse struct {
+	Tasks []GetTasksResponseArray `json:"tasks"`
+}
+
+// GetTasksResponseArray defines model for GetTasksResponseArray.
+type GetTasksResponseArray struct {
+	AssigneeID *string            `json:"assignee_id"`
+	DueOn      openapi_types.Date `json:"due_on"`
+	ID         string             `json:"id"`
+	IsDone     bool               `json:"is_done"`
+
+	// Whether the deadline passed with the task not done.
+	IsOverdue bool   `json:"is_overdue"`
+	Title     string `json:"title"`
+}
+
+// GetTransportsResponse defines model for GetTransportsResponse.
+type GetTransportsResponse struct {
+	Transports []GetTransportsResponseArray `json:"transports"`
+}
+
+// GetTransportsResponseArray defines model for GetTransportsResponseArray.
+type GetTransportsResponseArray struct {
+	ArrivesAt   time.Time `json:"arrives_at"`
+	DepartsAt   time.Time `json:"departs_at"`
+	Destination string    `json:"destination"`
+	ID          string    `json:"id"`
+	Mode        string    `json:"mode"`
+	Origin      string    `json:"origin"`
+}
+
+// GetTripActivitiesResponse defines model for GetTripActivitiesResponse.
+type GetTripActivitiesResponse struct {
+	Activities []GetTripActivitiesResponseOuterArray `json:"activities"`
+}
+
+// GetTripActivitiesResponseInnerArray defines model for GetTripActivitiesResponseInnerArray.
+type GetTripActivitiesResponseInnerArray struct {
+	CostCents int64 `json:"cost_cents"`
+
+	// ISO 4217 code of the cost, null for the trip currency.
+	Currency        *string `json:"currency"`
+	DurationMinutes *int    `json:"duration_minutes"`
+
+	// Expense the activity was paid with.
+	ExpenseID *string   `json:"expense_id"`
+	ID        string    `json:"id"`
+	Latitude  *float64  `json:"latitude"`
+	Longitude *float64  `json:"longitude"`
+	OccursAt  time.Time `json:"occurs_at"`
+
+	// Participant organizing the activity.
+	OrganizerID *string `json:"organizer_id"`
+
+	// Either approved or pending, when it is waiting for the owner because it goes over the trip budget.
+	Status string   `json:"status"`
+	Tags   []string `json:"tags"`
+	Title  string   `json:"title"`
+}
+
+// GetTripActivitiesResponseOuterArray defines model for GetTripActivitiesResponseOuterArray.
+type GetTripActivitiesResponseOuterArray struct {
+	Activities []GetTripActivitiesResponseInnerArray `json:"activities"`
+	Date       time.Time                             `json:"date"`
+}
+
+// GetTripDetailsResponse defines model for GetTripDetailsResponse.
+type GetTripDetailsResponse struct {
+	Trip GetTripDetailsResponseTripObj `json:"trip"`
+}
+
+// GetTripDetailsResponseTripObj defines model for GetTripDetailsResponseTripObj.
+type GetTripDetailsResponseTripObj struct {
+	// When the trip was merged into another one.
+	ArchivedAt           *time.Time `json:"archived_at,omitempty"`
+	BudgetPerPersonCents *int64     `json:"budget_per_person_cents"`
+	Currency             *string    `json:"currency"`
+	Destination          string     `json:"destination"`
+	EndsAt               time.Time  `json:"ends_at"`
+	ID                   string     `json:"id"`
+
+	// Whether the trip was confirmed, started or ended.
+	IsConfirmed         bool      `json:"is_confirmed"`
+	ItineraryAttachment string    `json:"itinerary_attachment"`
+	MaxParticipants     *int      `json:"max_participants"`
+	StartsAt            time.Time `json:"starts_at"`
+
+	// One of draft, confirmed, ongoing, finished or cancelled.
+	Status string `json:"status"`
+}
+
+// GetTripParticipantsResponse defines model for GetTripParticipantsResponse.
+type GetTripParticipantsResponse struct {
+	Participants []GetTripParticipantsResponseArray `json:"participants"`
+}
+
+// GetTripParticipantsResponseArray defines model for GetTripParticipantsResponseArray.
+type GetTripParticipantsResponseArray struct {
+	Companions  []GetTripParticipantsResponseCompanionArray `json:"companions"`
+	Email       openapi_types.Email                         `json:"email"`
+	GroupID     *string                                     `json:"group_id"`
+	ID          string                                      `json:"id"`
+	IsConfirmed bool                                        `json:"is_confirmed"`
+	Name        *string                                     `json:"name"`
+
+	// Either owner or participant. Owners manage the trip.
+	Role string `json:"role"`
+
+	// One of invited, waitlisted, declined or email_invalid.
+	Status string `json:"status"`
+}
+
+// GetTripParticipantsResponseCompanionArray defines model for GetTripParticipantsResponseCompanionArray.
+type GetTripParticipantsResponseCompanionArray struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// GetUnassignedTravelersResponse defines model for GetUnassignedTravelersResponse.
+type GetUnassignedTravelersResponse struct {
+	Days []GetUnassignedTravelersResponseArray `json:"days"`
+}
+
+// GetUnassignedTravelersResponseArray defines model for GetUnassignedTravelersResponseArray.
+type GetUnassignedTravelersResponseArray struct {
+	Date           openapi_types.Date `json:"date"`
+	ParticipantIds []string           `json:"participant_ids"`
+}
+
+// GetWarningsResponse defines model for GetWarningsResponse.
+type GetWarningsResponse struct {
+	Warnings []GetWarningsResponseArray `json:"warnings"`
+}
+
+// GetWarningsResponseArray defines model for GetWarningsResponseArray.
+type GetWarningsResponseArray struct {
+	ActivityID               string    `json:"activity_id"`
+	Kind                     string    `json:"kind"`
+	Message                  string    `json:"message"`
+	OccursAt                 time.Time `json:"occurs_at"`
+	PrecipitationProbability int       `json:"precipitation_probability"`
+	Title                    string    `json:"title"`
+}
+
+// ImportInvitesResponse defines model for ImportInvitesResponse.
+type ImportInvitesResponse struct {
+	// Whether the valid rows were invited, false for a dry run.
+	Committed  bool                                `json:"committed"`
+	Duplicates []ImportInvitesResponseRowArray     `json:"duplicates"`
+	Invalid    []ImportInvitesResponseInvalidArray `json:"invalid"`
+	Valid      []ImportInvitesResponseRowArray     `json:"valid"`
+}
+
+// ImportInvitesResponseInvalidArray defines model for ImportInvitesResponseInvalidArray.
+type ImportInvitesResponseInvalidArray struct {
+	Line   int    `json:"line"`
+	Reason string `json:"reason"`
+}
+
+// ImportInvitesResponseRowArray defines model for ImportInvitesResponseRowArray.
+type ImportInvitesResponseRowArray struct {
+	Email openapi_types.Email `json:"email"`
+	Line  int                 `json:"line"`
+	Name  string              `json:"name"`
+}
+
+// ImportTripResponse defines model for ImportTripResponse.
+type ImportTripResponse struct {
+	Attachments []ImportTripResponseAttachmentArray `json:"attachments"`
+	TripID      string                              `json:"tripId"`
+}
+
+// ImportTripResponseAttachmentArray defines model for ImportTripResponseAttachmentArray.
+type ImportTripResponseAttachmentArray struct {
+	AttachmentID string `json:"attachment_id"`
+
+	// Where the file is to be copied to in the storage of this instance.
+	Key      string `json:"key"`
+	SourceID string `json:"source_id"`
+
+	// Where the file is kept in the storage of the source instance.
+	SourceKey string `json:"source_key"`
+}
+
+// InstanceKeyResponse defines model for InstanceKeyResponse.
+type InstanceKeyResponse struct {
+	Instance  string `json:"instance"`
+	PublicKey string `json:"public_key"`
+}
+
+// IntegrationCatalog defines model for IntegrationCatalog.
+type IntegrationCatalog struct {
+	Actions  []IntegrationEndpoint `json:"actions"`
+	Triggers []IntegrationEndpoint `json:"triggers"`
+}
+
+// IntegrationEndpoint defines model for IntegrationEndpoint.
+type IntegrationEndpoint struct {
+	Description string `json:"description"`
+	Key         string `json:"key"`
+	Method      string `json:"method"`
+	Name        string `json:"name"`
+	Path        string `json:"path"`
+}
+
+// InviteParticipantRequest defines model for InviteParticipantRequest.
+type InviteParticipantRequest struct {
+	Email openapi_types.Email `json:"email" validate:"required,email"`
+}
+
+// InviteSummaryResponse defines model for InviteSummaryResponse.
+type InviteSummaryResponse struct {
+	Confirmed int `json:"confirmed"`
+	Declined  int `json:"declined"`
+
+	// Participants taking up a spot, confirmed or not.
+	Invited int `json:"invited"`
+
+	// Masked emails of the invited participants who have not confirmed yet, in the order they were invited.
+	PendingEmails []string `json:"pending_emails"`
+
+	// Spots left, counting companions. Missing when the trip has no limit.
+	RemainingCapacity *int `json:"remaining_capacity,omitempty"`
+	Waitlisted        int  `json:"waitlisted"`
+}
+
+// MailBounceRequest defines model for MailBounceRequest.
+type MailBounceRequest struct {
+	Email openapi_types.Email `json:"email" validate:"required,email"`
+
+	// Whether the address does not exist (hard) or the mailbox is only temporarily unavailable (soft).
+	Type string `json:"type" validate:"required,oneof=hard soft"`
+}
+
+// MergeTripResponse defines model for MergeTripResponse.
+type MergeTripResponse struct {
+	// Activities moved.
+	Activities int `json:"activities"`
+
+	// Links moved.
+	Links int `json:"links"`
+
+	// Participants moved, not counting the ones already on the trip.
+	Participants int `json:"participants"`
+}
+
+// ModerationQueueResponse defines model for ModerationQueueResponse.
+type ModerationQueueResponse struct {
+	Trips []ModerationQueueTrip `json:"trips"`
+}
+
+// ModerationQueueTrip defines model for ModerationQueueTrip.
+type ModerationQueueTrip struct {
+	Destination string             `json:"destination"`
+	HeldAt      *time.Time         `json:"held_at"`
+	HeldReason  *string            `json:"held_reason"`
+	Reports     []ModerationReport `json:"reports"`
+	TripID      string             `json:"trip_id"`
+}
+
+// ModerationReport defines model for ModerationReport.
+type ModerationReport struct {
+	CreatedAt time.Time `json:"created_at"`
+	Details   *string   `json:"details"`
+	Reason    string    `json:"reason"`
+}
+
+// NewActivitiesResponse defines model for NewActivitiesResponse.
+type NewActivitiesResponse struct {
+	Items []NewActivity `json:"items"`
+
+	// Pass it as cursor to get what was added after these items.
+	NextCursor string `json:"next_cursor"`
+}
+
+// NewActivity defines model for NewActivity.
+type NewActivity struct {
+	CreatedAt time.Time `json:"created_at"`
+	ID        string    `json:"id"`
+	OccursAt  time.Time `json:"occurs_at"`
+	Status    string    `json:"status"`
+	Title     string    `json:"title"`
+}
+
+// NewParticipant defines model for NewParticipant.
+type NewParticipant struct {
+	CreatedAt   time.Time `json:"created_at"`
+	Email       string    `json:"email"`
+	ID          string    `json:"id"`
+	IsConfirmed bool      `json:"is_confirmed"`
+	Name        *string   `json:"name"`
+	Status      string    `json:"status"`
+}
+
+// NewParticipantsResponse defines model for NewParticipantsResponse.
+type NewParticipantsResponse struct {
+	Items []NewParticipant `json:"items"`
+
+	// Pass it as cursor to get what was added after these items.
+	NextCursor string `json:"next_cursor"`
+}
+
+// OEmbedResponse defines model for OEmbedResponse.
+type OEmbedResponse struct {
+	Height       int    `json:"height"`
+	HTML         string `json:"html"`
+	ProviderName string `json:"provider_name"`
+	Title        string `json:"title"`
+	Type         string `json:"type"`
+	Version      string `json:"version"`
+	Width        int    `json:"width"`
+}
+
+// PayActivityRequest defines model for PayActivityRequest.
+type PayActivityRequest struct {
+	// What was paid in the trip currency, the activity cost when not given.
+	AmountCents *int64 `json:"amount_cents,omitempty" validate:"omitempty,gt=0"`
+
+	// Expense category, activities when not given.
+	Category *string `json:"category,omitempty" validate:"omitempty,max=255"`
+	PaidBy   string  `json:"paid_by" validate:"required,uuid"`
+
+	// When it was paid, the time of the activity when not given.
+	SpentAt *time.Time                    `json:"spent_at,omitempty"`
+	Split   *CreateExpenseRequestSplitObj `json:"split,omitempty"`
+}
+
+// PresignAttachmentRequest defines model for PresignAttachmentRequest.
+type PresignAttachmentRequest struct {
+	// One of image/jpeg, image/png, image/heic or application/pdf.
+	ContentType string `json:"content_type" validate:"required"`
+	Filename    string `json:"filename" validate:"required,max=255"`
+
+	// The participant uploading the file, told by email if it is found infected. The trip owner is told otherwise.
+	ParticipantID *string `json:"participant_id"`
+	SizeBytes     int64   `json:"size_bytes" validate:"required,min=1"`
+}
+
+// PresignAttachmentResponse defines model for PresignAttachmentResponse.
+type PresignAttachmentResponse struct {
+	AttachmentID string    `json:"attachment_id"`
+	ExpiresAt    time.Time `json:"expires_at"`
+
+	// Headers the upload must be sent with.
+	Headers   PresignAttachmentResponse_Headers `json:"headers"`
+	Method    string                            `json:"method"`
+	UploadURL string                            `json:"upload_url"`
+}
+
+// Headers the upload must be sent with.
+type PresignAttachmentResponse_Headers struct {
+	AdditionalProperties map[string]string `json:"-"`
+}
+
+// PurchaseShoppingItemRequest defines model for PurchaseShoppingItemRequest.
+type PurchaseShoppingItemRequest struct {
+	AmountCents int64 `json:"amount_cents" validate:"required,gt=0"`
+
+	// Expense category, groceries when not given.
+	Category *string                       `json:"category,omitempty" validate:"omitempty,max=255"`
+	SpentAt  time.Time                     `json:"spent_at" validate:"required"`
+	Split    *CreateExpenseRequestSplitObj `json:"split,omitempty"`
+}
+
+// QuickAddActivityRequest defines model for QuickAddActivityRequest.
+type QuickAddActivityRequest struct {
+	// Creates the activity instead of only previewing it.
+	Commit *bool  `json:"commit,omitempty"`
+	Text   string `json:"text" validate:"required,max=500"`
+}
+
+// QuickAddActivityResponse defines model for QuickAddActivityResponse.
+type QuickAddActivityResponse struct {
+	ActivityID *string   `json:"activityId"`
+	OccursAt   time.Time `json:"occurs_at"`
+
+	// Either parser or provider, the draft provider.
+	ParsedBy string `json:"parsed_by"`
+
+	// Either approved or pending, when it is waiting for the owner because it goes over the trip budget. Only given when committed.
+	Status *string `json:"status"`
+	Title  string  `json:"title"`
+}
+
+// ScanReceiptResponse defines model for ScanReceiptResponse.
+type ScanReceiptResponse struct {
+	AmountCents *int64     `json:"amount_cents"`
+	Merchant    *string    `json:"merchant"`
+	ReceiptID   string     `json:"receiptId"`
+	SpentAt     *time.Time `json:"spent_at"`
+}
+
+// Where each activity of a trip goes when the trip starts at another date.
+type ShiftPreviewResponse struct {
+	Activities []ActivityShiftArray `json:"activities"`
+
+	// Days every activity moves by.
+	ShiftedDays int `json:"shifted_days"`
+}
+
+// SuggestTimesResponse defines model for SuggestTimesResponse.
+type SuggestTimesResponse struct {
+	// Duration the times were suggested for.
+	DurationMinutes int                             `json:"duration_minutes"`
+	Slots           []SuggestTimesResponseSlotArray `json:"slots"`
+}
+
+// SuggestTimesResponseSlotArray defines model for SuggestTimesResponseSlotArray.
+type SuggestTimesResponseSlotArray struct {
+	EndsAt   time.Time `json:"ends_at"`
+	StartsAt time.Time `json:"starts_at"`
+}
+
+// SurveyQuestionsRequest defines model for SurveyQuestionsRequest.
+type SurveyQuestionsRequest struct {
+	Questions []SurveyQuestionsRequestQuestionArray `json:"questions" validate:"required,min=1,max=10,dive"`
+}
+
+// SurveyQuestionsRequestQuestionArray defines model for SurveyQuestionsRequestQuestionArray.
+type SurveyQuestionsRequestQuestionArray struct {
+	// One of rating, answered from 1 to 5, or text.
+	Kind   string `json:"kind" validate:"required,oneof=rating text"`
+	Prompt string `json:"prompt" validate:"required,max=255"`
+}
+
+// TransferOwnershipRequest defines model for TransferOwnershipRequest.
+type TransferOwnershipRequest struct {
+	ParticipantID string `json:"participant_id" validate:"required,uuid"`
+}
+
+// TrashResponse defines model for TrashResponse.
+type TrashResponse struct {
+	Activities []TrashedActivityArray `json:"activities"`
+	Links      []TrashedLinkArray     `json:"links"`
+}
+
+// TrashedActivityArray defines model for TrashedActivityArray.
+type TrashedActivityArray struct {
+	DeletedAt time.Time `json:"deleted_at"`
+	ID        string    `json:"id"`
+	OccursAt  time.Time `json:"occurs_at"`
+	PurgeAt   time.Time `json:"purge_at"`
+	Title     string    `json:"title"`
+}
+
+// TrashedLinkArray defines model for TrashedLinkArray.
+type TrashedLinkArray struct {
+	DeletedAt time.Time `json:"deleted_at"`
+	ID        string    `json:"id"`
+	PurgeAt   time.Time `json:"purge_at"`
+	Title     string    `json:"title"`
+	URL       string    `json:"url"`
+}
+
+// TripBudgetResponse defines model for TripBudgetResponse.
+type TripBudgetResponse struct {
+	// Budget per person times people, null when the trip has no budget.
+	BudgetCents *int64 `json:"budget_cents"`
+
+	// ISO 4217 code of the amounts, null when the trip has no currency.
+	Currency *string `json:"currency"`
+
+	// Estimated cost of the approved activities not paid yet and of the approved lodgings.
+	EstimatedCents int64 `json:"estimated_cents"`
+
+	// Estimates in currencies other than the trip's, left out of the totals.
+	OtherCurrencies []TripBudgetResponseCurrencyArray `json:"other_currencies"`
+
+	// People the budget is for.
+	People int `json:"people"`
+
+	// What the trip is set to cost, spent plus estimated.
+	ProjectedCents int64 `json:"projected_cents"`
+
+	// Budget left after the projected cost, negative when over budget.
+	RemainingCents *int64 `json:"remaining_cents"`
+
+	// Total of the trip expenses.
+	SpentCents int64 `json:"spent_cents"`
+}
+
+// TripBudgetResponseCurrencyArray defines model for TripBudgetResponseCurrencyArray.
+type TripBudgetResponseCurrencyArray struct {
+	Currency       string `json:"currency"`
+	EstimatedCents int64  `json:"estimated_cents"`
+}
+
+// TripBundle defines model for TripBundle.
+type TripBundle struct {
+	Manifest TripBundleManifest `json:"manifest"`
+
+	// The trip records, opaque to clients.
+	Payload map[string]interface{} `json:"payload"`
+
+	// Base64 ed25519 signature of the manifest.
+	Signature string `json:"signature"`
+}
+
+// TripBundleManifest defines model for TripBundleManifest.
+type TripBundleManifest struct {
+	ExportedAt time.Time `json:"exported_at"`
+	Instance   string    `json:"instance"`
+
+	// Base64 ed25519 public key of the instance that signed the bundle.
+	PublicKey string `json:"public_key"`
+
+	// Hex SHA-256 digest of the payload, as sent.
+	Sha256  string `json:"sha256"`
+	TripID  string `json:"trip_id"`
+	Version int    `json:"version"`
+}
+
+// What new dates leave out of the plans of a trip.
+type TripDateImpact struct {
+	Activities []TripDateImpactActivityArray `json:"activities"`
+	DryRun     bool                          `json:"dry_run"`
+	EndsAt     time.Time                     `json:"ends_at"`
+	Lodgings   []TripDateImpactLodgingArray  `json:"lodgings"`
+
+	// Days the activities were moved by, or would be with dry_run.
+	ShiftedDays     int                            `json:"shifted_days"`
+	StartsAt        time.Time                      `json:"starts_at"`
+	Transports      []TripDateImpactTransportArray `json:"transports"`
+	UncoveredNights []openapi_types.Date           `json:"uncovered_nights"`
+}
+
+// TripDateImpactActivityArray defines model for TripDateImpactActivityArray.
+type TripDateImpactActivityArray struct {
+	ID       string    `json:"id"`
+	OccursAt time.Time `json:"occurs_at"`
+	Title    string    `json:"title"`
+}
+
+// TripDateImpactLodgingArray defines model for TripDateImpactLodgingArray.
+type TripDateImpactLodgingArray struct {
+	CheckIn  time.Time `json:"check_in"`
+	CheckOut time.Time `json:"check_out"`
+	ID       string    `json:"id"`
+	Name     string    `json:"name"`
+}
+
+// TripDateImpactTransportArray defines model for TripDateImpactTransportArray.
+type TripDateImpactTransportArray struct {
+	ArrivesAt   time.Time `json:"arrives_at"`
+	DepartsAt   time.Time `json:"departs_at"`
+	Destination string    `json:"destination"`
+	ID          string    `json:"id"`
+	Mode        string    `json:"mode"`
+	Origin      string    `json:"origin"`
+}
+
+// TripSettings defines model for TripSettings.
+type TripSettings struct {
+	// ISO 4217 code used by default for the trip expenses and estimates.
+	Currency *string `json:"currency,omitempty"`
+
+	// Whether participants get the daily digest email.
+	Digest bool `json:"digest"`
+
+	// Format of the itinerary attached to the invitation and confirmation emails, or none.
+	ItineraryAttachment string `json:"itinerary_attachment"`
+
+	// Whether photos uploaded to the trip keep their GPS position. It is removed from their EXIF data otherwise.
+	KeepPhotoLocation bool `json:"keep_photo_location"`
+
+	// Whether owners get a weekly digest of what is still pending while the trip is not confirmed.
+	PlanningDigest bool `json:"planning_digest"`
+
+	// open adds new activities and lodgings to the plans, approval makes them wait for an owner approval.
+	ProposalMode string `json:"proposal_mode"`
+
+	// How many days before the trip starts its participants are reminded of it, such as [14, 3, 1]. Empty turns the reminders off.
+	ReminderDays []int `json:"reminder_days"`
+
+	// Hour of the day, in the trip timezone, from which daily digests, trip and overdue task reminders are sent.
+	ReminderHour int `json:"reminder_hour"`
+
+	// IANA timezone of the trip.
+	Timezone string `json:"timezone"`
+}
+
+// TripShareResponse defines model for TripShareResponse.
+type TripShareResponse struct {
+	// Absolute URL of the embed JSON.
+	EmbedURL   string `json:"embed_url"`
+	ShareToken string `json:"share_token"`
+}
+
+// TripSheetResponse defines model for TripSheetResponse.
+type TripSheetResponse struct {
+	SpreadsheetURL string    `json:"spreadsheet_url"`
+	SyncedAt       time.Time `json:"synced_at"`
+}
+
+// TripTagsRequest defines model for TripTagsRequest.
+type TripTagsRequest struct {
+	Tags []string `json:"tags" validate:"required,max=20,dive,required,max=30"`
+}
+
+// TripTagsResponse defines model for TripTagsResponse.
+type TripTagsResponse struct {
+	Tags []string `json:"tags"`
+}
+
+// TripTransitionRequest defines model for TripTransitionRequest.
+type TripTransitionRequest struct {
+	// The status to move the trip to: confirmed, ongoing, finished or cancelled.
+	Status string `json:"status" validate:"required,oneof=confirmed ongoing finished cancelled"`
+}
+
+// TripTransitionResponse defines model for TripTransitionResponse.
+type TripTransitionResponse struct {
+	// The status the trip was in.
+	From string `json:"from"`
+
+	// The status the trip is in now.
+	Status string `json:"status"`
+
+	// The statuses the trip may move to next.
+	Transitions []string `json:"transitions"`
+}
+
+// UpdateChecklistItemRequest defines model for UpdateChecklistItemRequest.
+type UpdateChecklistItemRequest struct {
+	IsChecked bool   `json:"is_checked"`
+	Title     string `json:"title" validate:"required"`
+}
+
+// UpdateParticipantGroupRequest defines model for UpdateParticipantGroupRequest.
+type UpdateParticipantGroupRequest struct {
+	Name string `json:"name" validate:"required,max=255"`
+
+	// Participants of the trip in the group, replacing the ones in it. Members are kept when not given.
+	ParticipantIds []string `json:"participant_ids,omitempty" validate:"omitempty,dive,uuid"`
+}
+
+// UpdateParticipantNeedsRequest defines model for UpdateParticipantNeedsRequest.
+type UpdateParticipantNeedsRequest struct {
+	// Any of wheelchair, reduced_mobility, visual, hearing, service_animal.
+	Accessibility []string `json:"accessibility" validate:"dive,oneof=wheelchair reduced_mobility visual hearing service_animal"`
+
+	// Any of vegetarian, vegan, gluten_free, lactose_free, halal, kosher, nut_allergy, seafood_allergy.
+	Dietary []string `json:"dietary" validate:"dive,oneof=vegetarian vegan gluten_free lactose_free halal kosher nut_allergy seafood_allergy"`
+	Notes   string   `json:"notes" validate:"max=500"`
+}
+
+// UpdateTaskRequest defines model for UpdateTaskRequest.
+type UpdateTaskRequest struct {
+	AssigneeID *string            `json:"assignee_id" validate:"omitempty,uuid"`
+	DueOn      openapi_types.Date `json:"due_on"`
+	IsDone     bool               `json:"is_done"`
+	Title      string             `json:"title" validate:"required"`
+}
+
+// UpdateTripRequest defines model for UpdateTripRequest.
+type UpdateTripRequest struct {
+	// Activities and lodgings that go over it wait for the owner approval. Without it the trip has no budget.
+	BudgetPerPersonCents *int64    `json:"budget_per_person_cents,omitempty" validate:"omitempty,gt=0"`
+	Destination          string    `json:"destination" validate:"required,min=4"`
+	EndsAt               time.Time `json:"ends_at" validate:"required"`
+
+	// Invitations beyond it go to a waitlist. Without it the trip has no limit.
+	MaxParticipants *int      `json:"max_participants,omitempty" validate:"omitempty,gt=0"`
+	StartsAt        time.Time `json:"starts_at" validate:"required"`
+}
+
+// UpdateTripSettingsRequest defines model for UpdateTripSettingsRequest.
+type UpdateTripSettingsRequest struct {
+	// ISO 4217 code used by default for the trip expenses and estimates.
+	Currency *string `json:"currency,omitempty" validate:"omitempty,iso4217"`
+
+	// Whether participants get the daily digest email.
+	Digest *bool `json:"digest,omitempty"`
+
+	// Format of the itinerary attached to the invitation and confirmation emails, or none.
+	ItineraryAttachment *string `json:"itinerary_attachment,omitempty" validate:"omitempty,oneof=none ics markdown"`
+
+	// Whether photos uploaded to the trip keep their GPS position. It is removed from their EXIF data otherwise.
+	KeepPhotoLocation *bool `json:"keep_photo_location,omitempty"`
+
+	// Whether owners get a weekly digest of what is still pending while the trip is not confirmed.
+	PlanningDigest *bool `json:"planning_digest,omitempty"`
+
+	// open adds new activities and lodgings to the plans, approval makes them wait for an owner approval.
+	ProposalMode *string `json:"proposal_mode,omitempty" validate:"omitempty,oneof=open approval"`
+
+	// How many days before the trip starts its participants are reminded of it, such as [14, 3, 1]. Empty turns the reminders off.
+	ReminderDays []int `json:"reminder_days,omitempty" validate:"omitempty,max=5,unique,dive,min=1,max=90"`
+
+	// Hour of the day, in the trip timezone, from which daily digests, trip and overdue task reminders are sent.
+	ReminderHour *int `json:"reminder_hour,omitempty" validate:"omitempty,min=0,max=23"`
+
+	// IANA timezone of the trip.
+	Timezone *string `json:"timezone,omitempty" validate:"omitempty,timezone"`
+}
+
+// ValidationError defines model for ValidationError.
+type ValidationError struct {
+	// Stable code of the error, the same whatever the language of the message.
+	Code    string                  `json:"code"`
+	Errors  []ValidationErrorDetail `json:"errors"`
+	Message string                  `json:"message"`
+}
+
+// ValidationErrorDetail defines model for ValidationErrorDetail.
+type ValidationErrorDetail struct {
+	// Stable code of the error, the same whatever the language of the message.
+	Code    string `json:"code"`
+	Message string `json:"message"`
+
+	// Name of the invalid path or query parameter.
+	Parameter *string `json:"parameter,omitempty"`
+
+	// JSON pointer to the invalid value in the request body.
+	Pointer *string `json:"pointer,omitempty"`
+
+	// Validation rule the value broke, as in required, email or max.
+	Rule *string `json:"rule,omitempty"`
+}
+
+// PutDatePollTokenJSONBody defines parameters for PutDatePollToken.
+type PutDatePollTokenJSONBody AnswerDatePollRequest
+
+// PostMailBouncesJSONBody defines parameters for PostMailBounces.
+type PostMailBouncesJSONBody MailBounceRequest
+
+// GetOembedParams defines parameters for GetOembed.
+type GetOembedParams struct {
+	// The embed URL of a shared trip.
+	URL string `json:"url"`
+
+	// Only json is supported.
+	Format    *string `json:"format,omitempty"`
+	Maxwidth  *int    `json:"maxwidth,omitempty"`
+	Maxheight *int    `json:"maxheight,omitempty"`
+}
+
+// PostParticipantsParticipantIDCompanionsJSONBody defines parameters for PostParticipantsParticipantIDCompanions.
+type PostParticipantsParticipantIDCompanionsJSONBody CreateCompanionRequest
+
+// PutParticipantsParticipantIDNeedsJSONBody defines parameters for PutParticipantsParticipantIDNeeds.
+type PutParticipantsParticipantIDNeedsJSONBody UpdateParticipantNeedsRequest
+
+// PostSharedShareTokenReportsJSONBody defines parameters for PostSharedShareTokenReports.
+type PostSharedShareTokenReportsJSONBody ContentReportRequest
+
+// GetSheetsCallbackParams defines parameters for GetSheetsCallback.
+type GetSheetsCallbackParams struct {
+	Code  string `json:"code"`
+	State string `json:"state"`
+}
+
+// PutSurveysTokenJSONBody defines parameters for PutSurveysToken.
+type PutSurveysTokenJSONBody AnswerSurveyRequest
+
+// PostTripsJSONBody defines parameters for PostTrips.
+type PostTripsJSONBody CreateTripRequest
+
+// PostTripsParams defines parameters for PostTrips.
+type PostTripsParams struct {
+	// Create the trip even if the same owner has just created an identical one.
+	Force *bool `json:"force,omitempty"`
+}
+
+// PostTripsImportJSONBody defines parameters for PostTripsImport.
+type PostTripsImportJSONBody TripBundle
+
+// GetTripsTripIDParams defines parameters for GetTripsTripID.
+type GetTripsTripIDParams struct {
+	// Comma separated trip fields to include in the response; all fields when not given. The id is always included.
+	Fields *string `json:"fields,omitempty"`
+}
+
+// PutTripsTripIDJSONBody defines parameters for PutTripsTripID.
+type PutTripsTripIDJSONBody UpdateTripRequest
+
+// PutTripsTripIDParams defines parameters for PutTripsTripID.
+type PutTripsTripIDParams struct {
+	// Only report the impact of the new dates, without updating the trip.
+	DryRun *bool `json:"dry_run,omitempty"`
+
+	// Email the report to the trip owners when the dates change and something is left out.
+	Notify *bool `json:"notify,omitempty"`
+
+	// Move every activity by as many days as the start of the trip moves, keeping their time of day.
+	ShiftActivities *bool `json:"shift_activities,omitempty"`
+}
+
+// GetTripsTripIDActivitiesParams defines parameters for GetTripsTripIDActivities.
+type GetTripsTripIDActivitiesParams struct {
+	// Only the activities organized by this participant.
+	OrganizerID *string `json:"organizer_id,omitempty"`
+
+	// Comma separated activity fields to include in the response; all fields when not given. The id is always included.
+	Fields *string `json:"fields,omitempty"`
+
+	// Field to sort by, prefixed with - for descending order: occurs_at, title, cost_cents.
+	Sort *string `json:"sort,omitempty"`
+}
+
+// PostTripsTripIDActivitiesJSONBody defines parameters for PostTripsTripIDActivities.
+type PostTripsTripIDActivitiesJSONBody CreateActivityRequest
+
+// PostTripsTripIDActivitiesDraftJSONBody defines parameters for PostTripsTripIDActivitiesDraft.
+type PostTripsTripIDActivitiesDraftJSONBody DraftActivitiesRequest
+
+// PostTripsTripIDActivitiesQuickAddJSONBody defines parameters for PostTripsTripIDActivitiesQuickAdd.
+type PostTripsTripIDActivitiesQuickAddJSONBody QuickAddActivityRequest
+
+// GetTripsTripIDActivitiesShiftPreviewParams defines parameters for GetTripsTripIDActivitiesShiftPreview.
+type GetTripsTripIDActivitiesShiftPreviewParams struct {
+	// New start of the trip.
+	StartsAt time.Time `json:"starts_at"`
+}
+
+// GetTripsTripIDActivitiesSuggestedTimesParams defines parameters for GetTripsTripIDActivitiesSuggestedTimes.
+type GetTripsTripIDActivitiesSuggestedTimesParams struct {
+	// Day of the trip to suggest times in.
+	Date openapi_types.Date `json:"date"`
+
+	// How long the activity takes. When not given, the typical duration of the trip activities is used.
+	DurationMinutes *int `json:"duration_minutes,omitempty"`
+
+	// Takes the typical duration from the trip activities with this tag, when they have durations.
+	Tag *string `json:"tag,omitempty"`
+}
+
+// PutTripsTripIDActivitiesActivityIDOrganizerJSONBody defines parameters for PutTripsTripIDActivitiesActivityIDOrganizer.
+type PutTripsTripIDActivitiesActivityIDOrganizerJSONBody AssignOrganizerRequest
+
+// PostTripsTripIDActivitiesActivityIDPayJSONBody defines parameters for PostTripsTripIDActivitiesActivityIDPay.
+type PostTripsTripIDActivitiesActivityIDPayJSONBody PayActivityRequest
+
+// PostTripsTripIDActivitiesDateOptimizeJSONBody defines parameters for PostTripsTripIDActivitiesDateOptimize.
+type PostTripsTripIDActivitiesDateOptimizeJSONBody AcceptOptimizedDayRequest
+
+// PostTripsTripIDAttachmentsPresignJSONBody defines parameters for PostTripsTripIDAttachmentsPresign.
+type PostTripsTripIDAttachmentsPresignJSONBody PresignAttachmentRequest
+
+// PostTripsTripIDChecklistJSONBody defines parameters for PostTripsTripIDChecklist.
+type PostTripsTripIDChecklistJSONBody CreateChecklistItemRequest
+
+// PutTripsTripIDChecklistItemIDJSONBody defines parameters for PutTripsTripIDChecklistItemID.
+type PutTripsTripIDChecklistItemIDJSONBody UpdateChecklistItemRequest
+
+// PostTripsTripIDDatePollJSONBody defines parameters for PostTripsTripIDDatePoll.
+type PostTripsTripIDDatePollJSONBody CreateDatePollRequest
+
+// GetTripsTripIDExpensesParams defines parameters for GetTripsTripIDExpenses.
+type GetTripsTripIDExpensesParams struct {
+	// Field to sort by, prefixed with - for descending order: spent_at, amount_cents, category, description.
+	Sort *string `json:"sort,omitempty"`
+}
+
+// PostTripsTripIDExpensesJSONBody defines parameters for PostTripsTripIDExpenses.
+type PostTripsTripIDExpensesJSONBody CreateExpenseRequest
+
+// GetTripsTripIDGapsParams defines parameters for GetTripsTripIDGaps.
+type GetTripsTripIDGapsParams struct {
+	// Shortest free stretch worth reporting, in minutes.
+	MinMinutes *int `json:"min_minutes,omitempty"`
+}
+
+// PostTripsTripIDGroupsJSONBody defines parameters for PostTripsTripIDGroups.
+type PostTripsTripIDGroupsJSONBody CreateParticipantGroupRequest
+
+// PutTripsTripIDGroupsGroupIDJSONBody defines parameters for PutTripsTripIDGroupsGroupID.
+type PutTripsTripIDGroupsGroupIDJSONBody UpdateParticipantGroupRequest
+
+// PostTripsTripIDInvitesJSONBody defines parameters for PostTripsTripIDInvites.
+type PostTripsTripIDInvitesJSONBody InviteParticipantRequest
+
+// PostTripsTripIDInvitesImportParams defines parameters for PostTripsTripIDInvitesImport.
+type PostTripsTripIDInvitesImportParams struct {
+	// Invite the valid rows. Without it the file is only validated.
+	Commit *bool `json:"commit,omitempty"`
+}
+
+// GetTripsTripIDLinksParams defines parameters for GetTripsTripIDLinks.
+type GetTripsTripIDLinksParams struct {
+	// Field to sort by, prefixed with - for descending order: title, url.
+	Sort *string `json:"sort,omitempty"`
+}
+
+// PostTripsTripIDLinksJSONBody defines parameters for PostTripsTripIDLinks.
+type PostTripsTripIDLinksJSONBody CreateLinkRequest
+
+// PostTripsTripIDLinksBulkJSONBody defines parameters for PostTripsTripIDLinksBulk.
+type PostTripsTripIDLinksBulkJSONBody []string
+
+// PostTripsTripIDLodgingsJSONBody defines parameters for PostTripsTripIDLodgings.
+type PostTripsTripIDLodgingsJSONBody CreateLodgingRequest
+
+// PostTripsTripIDLodgingsLodgingIDRoomsJSONBody defines parameters for PostTripsTripIDLodgingsLodgingIDRooms.
+type PostTripsTripIDLodgingsLodgingIDRoomsJSONBody CreateLodgingRoomRequest
+
+// PutTripsTripIDLodgingsLodgingIDRoomsRoomIDParticipantsJSONBody defines parameters for PutTripsTripIDLodgingsLodgingIDRoomsRoomIDParticipants.
+type PutTripsTripIDLodgingsLodgingIDRoomsRoomIDParticipantsJSONBody AssignRoomRequest
+
+// PostTripsTripIDOwnerEmailJSONBody defines parameters for PostTripsTripIDOwnerEmail.
+type PostTripsTripIDOwnerEmailJSONBody ChangeOwnerEmailRequest
+
+// PostTripsTripIDOwnersJSONBody defines parameters for PostTripsTripIDOwners.
+type PostTripsTripIDOwnersJSONBody AddOwnerRequest
+
+// GetTripsTripIDParticipantsParams defines parameters for GetTripsTripIDParticipants.
+type GetTripsTripIDParticipantsParams struct {
+	// Comma separated participant fields to include in the response; all fields when not given. The id is always included.
+	Fields *string `json:"fields,omitempty"`
+
+	// Field to sort by, prefixed with - for descending order: name, email, invited_at.
+	Sort *string `json:"sort,omitempty"`
+
+	// Only list the participants of the group.
+	GroupID *string `json:"group_id,omitempty"`
+}
+
+// PostTripsTripIDParticipantsConfirmBulkJSONBody defines parameters for PostTripsTripIDParticipantsConfirmBulk.
+type PostTripsTripIDParticipantsConfirmBulkJSONBody ConfirmParticipantsRequest
+
+// PatchTripsTripIDParticipantsParticipantIDEmailJSONBody defines parameters for PatchTripsTripIDParticipantsParticipantIDEmail.
+type PatchTripsTripIDParticipantsParticipantIDEmailJSONBody CorrectParticipantEmailRequest
+
+// PostTripsTripIDReceiptsReceiptIDConfirmJSONBody defines parameters for PostTripsTripIDReceiptsReceiptIDConfirm.
+type PostTripsTripIDReceiptsReceiptIDConfirmJSONBody CreateExpenseRequest
+
+// PostTripsTripIDRidesJSONBody defines parameters for PostTripsTripIDRides.
+type PostTripsTripIDRidesJSONBody CreateRideRequest
+
+// PostTripsTripIDRidesRideIDPassengersJSONBody defines parameters for PostTripsTripIDRidesRideIDPassengers.
+type PostTripsTripIDRidesRideIDPassengersJSONBody ClaimRideSeatRequest
+
+// PatchTripsTripIDSettingsJSONBody defines parameters for PatchTripsTripIDSettings.
+type PatchTripsTripIDSettingsJSONBody UpdateTripSettingsRequest
+
+// PostTripsTripIDShoppingJSONBody defines parameters for PostTripsTripIDShopping.
+type PostTripsTripIDShoppingJSONBody CreateShoppingItemRequest
+
+// PostTripsTripIDShoppingItemIDClaimJSONBody defines parameters for PostTripsTripIDShoppingItemIDClaim.
+type PostTripsTripIDShoppingItemIDClaimJSONBody ClaimShoppingItemRequest
+
+// PostTripsTripIDShoppingItemIDPurchaseJSONBody defines parameters for PostTripsTripIDShoppingItemIDPurchase.
+type PostTripsTripIDShoppingItemIDPurchaseJSONBody PurchaseShoppingItemRequest
+
+// PutTripsTripIDSurveyQuestionsJSONBody defines parameters for PutTripsTripIDSurveyQuestions.
+type PutTripsTripIDSurveyQuestionsJSONBody SurveyQuestionsRequest
+
+// PutTripsTripIDTagsJSONBody defines parameters for PutTripsTripIDTags.
+type PutTripsTripIDTagsJSONBody TripTagsRequest
+
+// PostTripsTripIDTasksJSONBody defines parameters for PostTripsTripIDTasks.
+type PostTripsTripIDTasksJSONBody CreateTaskRequest
+
+// PutTripsTripIDTasksTaskIDJSONBody defines parameters for PutTripsTripIDTasksTaskID.
+type PutTripsTripIDTasksTaskIDJSONBody UpdateTaskRequest
+
+// PostTripsTripIDTransferOwnershipJSONBody defines parameters for PostTripsTripIDTransferOwnership.
+type PostTripsTripIDTransferOwnershipJSONBody TransferOwnershipRequest
+
+// PostTripsTripIDTransitionsJSONBody defines parameters for PostTripsTripIDTransitions.
+type PostTripsTripIDTransitionsJSONBody TripTransitionRequest
+
+// PostTripsTripIDTransportsJSONBody defines parameters for PostTripsTripIDTransports.
+type PostTripsTripIDTransportsJSONBody CreateTransportRequest
+
+// GetTripsTripIDTriggersActivitiesParams defines parameters for GetTripsTripIDTriggersActivities.
+type GetTripsTripIDTriggersActivitiesParams struct {
+	// The next_cursor of the previous page. Without it, items are listed from the first one.
+	Cursor *string `json:"cursor,omitempty"`
+
+	// How many items to return, 50 by default.
+	Limit *int `json:"limit,omitempty"`
+}
+
+// GetTripsTripIDTriggersParticipantsParams defines parameters for GetTripsTripIDTriggersParticipants.
+type GetTripsTripIDTriggersParticipantsParams struct {
+	// The next_cursor of the previous page. Without it, items are listed from the first one.
+	Cursor *string `json:"cursor,omitempty"`
+
+	// How many items to return, 50 by default.
+	Limit *int `json:"limit,omitempty"`
+}
+
+// PutDatePollTokenJSONRequestBody defines body for PutDatePollToken for application/json ContentType.
+type PutDatePollTokenJSONRequestBody PutDatePollTokenJSONBody
+
+// Bind implements render.Binder.
+func (PutDatePollTokenJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostMailBouncesJSONRequestBody defines body for PostMailBounces for application/json ContentType.
+type PostMailBouncesJSONRequestBody PostMailBouncesJSONBody
+
+// Bind implements render.Binder.
+func (PostMailBouncesJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostParticipantsParticipantIDCompanionsJSONRequestBody defines body for PostParticipantsParticipantIDCompanions for application/json ContentType.
+type PostParticipantsParticipantIDCompanionsJSONRequestBody PostParticipantsParticipantIDCompanionsJSONBody
+
+// Bind implements render.Binder.
+func (PostParticipantsParticipantIDCompanionsJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutParticipantsParticipantIDNeedsJSONRequestBody defines body for PutParticipantsParticipantIDNeeds for application/json ContentType.
+type PutParticipantsParticipantIDNeedsJSONRequestBody PutParticipantsParticipantIDNeedsJSONBody
+
+// Bind implements render.Binder.
+func (PutParticipantsParticipantIDNeedsJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostSharedShareTokenReportsJSONRequestBody defines body for PostSharedShareTokenReports for application/json ContentType.
+type PostSharedShareTokenReportsJSONRequestBody PostSharedShareTokenReportsJSONBody
+
+// Bind implements render.Binder.
+func (PostSharedShareTokenReportsJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutSurveysTokenJSONRequestBody defines body for PutSurveysToken for application/json ContentType.
+type PutSurveysTokenJSONRequestBody PutSurveysTokenJSONBody
+
+// Bind implements render.Binder.
+func (PutSurveysTokenJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsJSONRequestBody defines body for PostTrips for application/json ContentType.
+type PostTripsJSONRequestBody PostTripsJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsImportJSONRequestBody defines body for PostTripsImport for application/json ContentType.
+type PostTripsImportJSONRequestBody PostTripsImportJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsImportJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDJSONRequestBody defines body for PutTripsTripID for application/json ContentType.
+type PutTripsTripIDJSONRequestBody PutTripsTripIDJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDActivitiesJSONRequestBody defines body for PostTripsTripIDActivities for application/json ContentType.
+type PostTripsTripIDActivitiesJSONRequestBody PostTripsTripIDActivitiesJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDActivitiesJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDActivitiesDraftJSONRequestBody defines body for PostTripsTripIDActivitiesDraft for application/json ContentType.
+type PostTripsTripIDActivitiesDraftJSONRequestBody PostTripsTripIDActivitiesDraftJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDActivitiesDraftJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDActivitiesQuickAddJSONRequestBody defines body for PostTripsTripIDActivitiesQuickAdd for application/json ContentType.
+type PostTripsTripIDActivitiesQuickAddJSONRequestBody PostTripsTripIDActivitiesQuickAddJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDActivitiesQuickAddJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDActivitiesActivityIDOrganizerJSONRequestBody defines body for PutTripsTripIDActivitiesActivityIDOrganizer for application/json ContentType.
+type PutTripsTripIDActivitiesActivityIDOrganizerJSONRequestBody PutTripsTripIDActivitiesActivityIDOrganizerJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDActivitiesActivityIDOrganizerJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDActivitiesActivityIDPayJSONRequestBody defines body for PostTripsTripIDActivitiesActivityIDPay for application/json ContentType.
+type PostTripsTripIDActivitiesActivityIDPayJSONRequestBody PostTripsTripIDActivitiesActivityIDPayJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDActivitiesActivityIDPayJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDActivitiesDateOptimizeJSONRequestBody defines body for PostTripsTripIDActivitiesDateOptimize for application/json ContentType.
+type PostTripsTripIDActivitiesDateOptimizeJSONRequestBody PostTripsTripIDActivitiesDateOptimizeJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDActivitiesDateOptimizeJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDAttachmentsPresignJSONRequestBody defines body for PostTripsTripIDAttachmentsPresign for application/json ContentType.
+type PostTripsTripIDAttachmentsPresignJSONRequestBody PostTripsTripIDAttachmentsPresignJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDAttachmentsPresignJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDChecklistJSONRequestBody defines body for PostTripsTripIDChecklist for application/json ContentType.
+type PostTripsTripIDChecklistJSONRequestBody PostTripsTripIDChecklistJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDChecklistJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDChecklistItemIDJSONRequestBody defines body for PutTripsTripIDChecklistItemID for application/json ContentType.
+type PutTripsTripIDChecklistItemIDJSONRequestBody PutTripsTripIDChecklistItemIDJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDChecklistItemIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDDatePollJSONRequestBody defines body for PostTripsTripIDDatePoll for application/json ContentType.
+type PostTripsTripIDDatePollJSONRequestBody PostTripsTripIDDatePollJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDDatePollJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDExpensesJSONRequestBody defines body for PostTripsTripIDExpenses for application/json ContentType.
+type PostTripsTripIDExpensesJSONRequestBody PostTripsTripIDExpensesJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDExpensesJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDGroupsJSONRequestBody defines body for PostTripsTripIDGroups for application/json ContentType.
+type PostTripsTripIDGroupsJSONRequestBody PostTripsTripIDGroupsJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDGroupsJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDGroupsGroupIDJSONRequestBody defines body for PutTripsTripIDGroupsGroupID for application/json ContentType.
+type PutTripsTripIDGroupsGroupIDJSONRequestBody PutTripsTripIDGroupsGroupIDJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDGroupsGroupIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDInvitesJSONRequestBody defines body for PostTripsTripIDInvites for application/json ContentType.
+type PostTripsTripIDInvitesJSONRequestBody PostTripsTripIDInvitesJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDInvitesJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDLinksJSONRequestBody defines body for PostTripsTripIDLinks for application/json ContentType.
+type PostTripsTripIDLinksJSONRequestBody PostTripsTripIDLinksJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDLinksJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDLinksBulkJSONRequestBody defines body for PostTripsTripIDLinksBulk for application/json ContentType.
+type PostTripsTripIDLinksBulkJSONRequestBody PostTripsTripIDLinksBulkJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDLinksBulkJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDLodgingsJSONRequestBody defines body for PostTripsTripIDLodgings for application/json ContentType.
+type PostTripsTripIDLodgingsJSONRequestBody PostTripsTripIDLodgingsJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDLodgingsJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDLodgingsLodgingIDRoomsJSONRequestBody defines body for PostTripsTripIDLodgingsLodgingIDRooms for application/json ContentType.
+type PostTripsTripIDLodgingsLodgingIDRoomsJSONRequestBody PostTripsTripIDLodgingsLodgingIDRoomsJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDLodgingsLodgingIDRoomsJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDLodgingsLodgingIDRoomsRoomIDParticipantsJSONRequestBody defines body for PutTripsTripIDLodgingsLodgingIDRoomsRoomIDParticipants for application/json ContentType.
+type PutTripsTripIDLodgingsLodgingIDRoomsRoomIDParticipantsJSONRequestBody PutTripsTripIDLodgingsLodgingIDRoomsRoomIDParticipantsJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDLodgingsLodgingIDRoomsRoomIDParticipantsJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDOwnerEmailJSONRequestBody defines body for PostTripsTripIDOwnerEmail for application/json ContentType.
+type PostTripsTripIDOwnerEmailJSONRequestBody PostTripsTripIDOwnerEmailJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDOwnerEmailJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDOwnersJSONRequestBody defines body for PostTripsTripIDOwners for application/json ContentType.
+type PostTripsTripIDOwnersJSONRequestBody PostTripsTripIDOwnersJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDOwnersJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDParticipantsConfirmBulkJSONRequestBody defines body for PostTripsTripIDParticipantsConfirmBulk for application/json ContentType.
+type PostTripsTripIDParticipantsConfirmBulkJSONRequestBody PostTripsTripIDParticipantsConfirmBulkJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDParticipantsConfirmBulkJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PatchTripsTripIDParticipantsParticipantIDEmailJSONRequestBody defines body for PatchTripsTripIDParticipantsParticipantIDEmail for application/json ContentType.
+type PatchTripsTripIDParticipantsParticipantIDEmailJSONRequestBody PatchTripsTripIDParticipantsParticipantIDEmailJSONBody
+
+// Bind implements render.Binder.
+func (PatchTripsTripIDParticipantsParticipantIDEmailJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDReceiptsReceiptIDConfirmJSONRequestBody defines body for PostTripsTripIDReceiptsReceiptIDConfirm for application/json ContentType.
+type PostTripsTripIDReceiptsReceiptIDConfirmJSONRequestBody PostTripsTripIDReceiptsReceiptIDConfirmJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDReceiptsReceiptIDConfirmJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDRidesJSONRequestBody defines body for PostTripsTripIDRides for application/json ContentType.
+type PostTripsTripIDRidesJSONRequestBody PostTripsTripIDRidesJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDRidesJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDRidesRideIDPassengersJSONRequestBody defines body for PostTripsTripIDRidesRideIDPassengers for application/json ContentType.
+type PostTripsTripIDRidesRideIDPassengersJSONRequestBody PostTripsTripIDRidesRideIDPassengersJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDRidesRideIDPassengersJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PatchTripsTripIDSettingsJSONRequestBody defines body for PatchTripsTripIDSettings for application/json ContentType.
+type PatchTripsTripIDSettingsJSONRequestBody PatchTripsTripIDSettingsJSONBody
+
+// Bind implements render.Binder.
+func (PatchTripsTripIDSettingsJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDShoppingJSONRequestBody defines body for PostTripsTripIDShopping for application/json ContentType.
+type PostTripsTripIDShoppingJSONRequestBody PostTripsTripIDShoppingJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDShoppingJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDShoppingItemIDClaimJSONRequestBody defines body for PostTripsTripIDShoppingItemIDClaim for application/json ContentType.
+type PostTripsTripIDShoppingItemIDClaimJSONRequestBody PostTripsTripIDShoppingItemIDClaimJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDShoppingItemIDClaimJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDShoppingItemIDPurchaseJSONRequestBody defines body for PostTripsTripIDShoppingItemIDPurchase for application/json ContentType.
+type PostTripsTripIDShoppingItemIDPurchaseJSONRequestBody PostTripsTripIDShoppingItemIDPurchaseJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDShoppingItemIDPurchaseJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDSurveyQuestionsJSONRequestBody defines body for PutTripsTripIDSurveyQuestions for application/json ContentType.
+type PutTripsTripIDSurveyQuestionsJSONRequestBody PutTripsTripIDSurveyQuestionsJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDSurveyQuestionsJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDTagsJSONRequestBody defines body for PutTripsTripIDTags for application/json ContentType.
+type PutTripsTripIDTagsJSONRequestBody PutTripsTripIDTagsJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDTagsJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDTasksJSONRequestBody defines body for PostTripsTripIDTasks for application/json ContentType.
+type PostTripsTripIDTasksJSONRequestBody PostTripsTripIDTasksJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDTasksJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PutTripsTripIDTasksTaskIDJSONRequestBody defines body for PutTripsTripIDTasksTaskID for application/json ContentType.
+type PutTripsTripIDTasksTaskIDJSONRequestBody PutTripsTripIDTasksTaskIDJSONBody
+
+// Bind implements render.Binder.
+func (PutTripsTripIDTasksTaskIDJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDTransferOwnershipJSONRequestBody defines body for PostTripsTripIDTransferOwnership for application/json ContentType.
+type PostTripsTripIDTransferOwnershipJSONRequestBody PostTripsTripIDTransferOwnershipJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDTransferOwnershipJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDTransitionsJSONRequestBody defines body for PostTripsTripIDTransitions for application/json ContentType.
+type PostTripsTripIDTransitionsJSONRequestBody PostTripsTripIDTransitionsJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDTransitionsJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// PostTripsTripIDTransportsJSONRequestBody defines body for PostTripsTripIDTransports for application/json ContentType.
+type PostTripsTripIDTransportsJSONRequestBody PostTripsTripIDTransportsJSONBody
+
+// Bind implements render.Binder.
+func (PostTripsTripIDTransportsJSONRequestBody) Bind(*http.Request) error {
+	return nil
+}
+
+// Response is a common response struct for all the API calls.
+// A Response object may be instantiated via functions for specific operation responses.
+// It may also be instantiated directly, for the purpose of responding with a single status code.
+type Response struct {
+	body        interface{}
+	Code        int
+	contentType string
+}
+
+// Render implements the render.Renderer interface. It sets the Content-Type header
+// and status code based on the response definition.
+func (resp *Response) Render(w http.ResponseWriter, r *http.Request) error {
+	w.Header().Set("Content-Type", resp.contentType)
+	render.Status(r, resp.Code)
+	return nil
+}
+
+// Status is a builder method to override the default status code for a response.
+func (resp *Response) Status(code int) *Response {
+	resp.Code = code
+	return resp
+}
+
+// ContentType is a builder method to override the default content type for a response.
+func (resp *Response) ContentType(contentType string) *Response {
+	resp.contentType = contentType
+	return resp
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// This is used to only marshal the body of the response.
+func (resp *Response) MarshalJSON() ([]byte, error) {
+	return json.Marshal(resp.body)
+}
+
+// MarshalXML implements the xml.Marshaler interface.
+// This is used to only marshal the body of the response.
+func (resp *Response) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return e.Encode(resp.body)
+}
+
+// GetAdminModerationJSON200Response is a constructor method for a GetAdminModeration response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetAdminModerationJSON200Response(body ModerationQueueResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetAdminModerationJSON400Response is a constructor method for a GetAdminModeration response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetAdminModerationJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetAdminModerationJSON401Response is a constructor method for a GetAdminModeration response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetAdminModerationJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetAdminModerationJSON422Response is a constructor method for a GetAdminModeration response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetAdminModerationJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostAdminModerationTripIDApproveJSON204Response is a constructor method for a PostAdminModerationTripIDApprove response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostAdminModerationTripIDApproveJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostAdminModerationTripIDApproveJSON400Response is a constructor method for a PostAdminModerationTripIDApprove response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostAdminModerationTripIDApproveJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostAdminModerationTripIDApproveJSON401Response is a constructor method for a PostAdminModerationTripIDApprove response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostAdminModerationTripIDApproveJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostAdminModerationTripIDApproveJSON404Response is a constructor method for a PostAdminModerationTripIDApprove response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostAdminModerationTripIDApproveJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostAdminModerationTripIDApproveJSON422Response is a constructor method for a PostAdminModerationTripIDApprove response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostAdminModerationTripIDApproveJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostAdminModerationTripIDRejectJSON204Response is a constructor method for a PostAdminModerationTripIDReject response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostAdminModerationTripIDRejectJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostAdminModerationTripIDRejectJSON400Response is a constructor method for a PostAdminModerationTripIDReject response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostAdminModerationTripIDRejectJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostAdminModerationTripIDRejectJSON401Response is a constructor method for a PostAdminModerationTripIDReject response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostAdminModerationTripIDRejectJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// PostAdminModerationTripIDRejectJSON404Response is a constructor method for a PostAdminModerationTripIDReject response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostAdminModerationTripIDRejectJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostAdminModerationTripIDRejectJSON422Response is a constructor method for a PostAdminModerationTripIDReject response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostAdminModerationTripIDRejectJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetAdminStatsJSON200Response is a constructor method for a GetAdminStats response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetAdminStatsJSON200Response(body AdminStatsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetAdminStatsJSON400Response is a constructor method for a GetAdminStats response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetAdminStatsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetAdminStatsJSON401Response is a constructor method for a GetAdminStats response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetAdminStatsJSON401Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        401,
+		contentType: "application/json",
+	}
+}
+
+// GetAdminStatsJSON422Response is a constructor method for a GetAdminStats response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetAdminStatsJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetDatePollTokenJSON200Response is a constructor method for a GetDatePollToken response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetDatePollTokenJSON200Response(body GetDatePollResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetDatePollTokenJSON400Response is a constructor method for a GetDatePollToken response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetDatePollTokenJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetDatePollTokenJSON404Response is a constructor method for a GetDatePollToken response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetDatePollTokenJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetDatePollTokenJSON422Response is a constructor method for a GetDatePollToken response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetDatePollTokenJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetDatePollTokenJSON429Response is a constructor method for a GetDatePollToken response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetDatePollTokenJSON429Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        429,
+		contentType: "application/json",
+	}
+}
+
+// PutDatePollTokenJSON204Response is a constructor method for a PutDatePollToken response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutDatePollTokenJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutDatePollTokenJSON400Response is a constructor method for a PutDatePollToken response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutDatePollTokenJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutDatePollTokenJSON404Response is a constructor method for a PutDatePollToken response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutDatePollTokenJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutDatePollTokenJSON422Response is a constructor method for a PutDatePollToken response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutDatePollTokenJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PutDatePollTokenJSON429Response is a constructor method for a PutDatePollToken response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutDatePollTokenJSON429Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        429,
+		contentType: "application/json",
+	}
+}
+
+// GetEmbedTripsShareTokenJSON200Response is a constructor method for a GetEmbedTripsShareToken response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetEmbedTripsShareTokenJSON200Response(body EmbedTripResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetEmbedTripsShareTokenJSON304Response is a constructor method for a GetEmbedTripsShareToken response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetEmbedTripsShareTokenJSON304Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        304,
+		contentType: "application/json",
+	}
+}
+
+// GetEmbedTripsShareTokenJSON400Response is a constructor method for a GetEmbedTripsShareToken response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetEmbedTripsShareTokenJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetEmbedTripsShareTokenJSON404Response is a constructor method for a GetEmbedTripsShareToken response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetEmbedTripsShareTokenJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetEmbedTripsShareTokenJSON422Response is a constructor method for a GetEmbedTripsShareToken response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetEmbedTripsShareTokenJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetEmbedTripsShareTokenJSON429Response is a constructor method for a GetEmbedTripsShareToken response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetEmbedTripsShareTokenJSON429Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        429,
+		contentType: "application/json",
+	}
+}
+
+// GetEmbedTripsShareTokenWidgetJSON400Response is a constructor method for a GetEmbedTripsShareTokenWidget response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetEmbedTripsShareTokenWidgetJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetEmbedTripsShareTokenWidgetJSON404Response is a constructor method for a GetEmbedTripsShareTokenWidget response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetEmbedTripsShareTokenWidgetJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetEmbedTripsShareTokenWidgetJSON422Response is a constructor method for a GetEmbedTripsShareTokenWidget response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetEmbedTripsShareTokenWidgetJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetEmbedTripsShareTokenWidgetJSON429Response is a constructor method for a GetEmbedTripsShareTokenWidget response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetEmbedTripsShareTokenWidgetJSON429Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        429,
+		contentType: "application/json",
+	}
+}
+
+// GetFederationKeyJSON200Response is a constructor method for a GetFederationKey response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetFederationKeyJSON200Response(body InstanceKeyResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetFederationKeyJSON400Response is a constructor method for a GetFederationKey response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetFederationKeyJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetIntegrationsJSON200Response is a constructor method for a GetIntegrations response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetIntegrationsJSON200Response(body IntegrationCatalog) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetIntegrationsJSON422Response is a constructor method for a GetIntegrations response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetIntegrationsJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostMailBouncesJSON204Response is a constructor method for a PostMailBounces response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostMailBouncesJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostMailBouncesJSON400Response is a constructor method for a PostMailBounces response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostMailBouncesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostMailBouncesJSON422Response is a constructor method for a PostMailBounces response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostMailBouncesJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetOembedJSON200Response is a constructor method for a GetOembed response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetOembedJSON200Response(body OEmbedResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetOembedJSON400Response is a constructor method for a GetOembed response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetOembedJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetOembedJSON404Response is a constructor method for a GetOembed response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetOembedJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetOembedJSON422Response is a constructor method for a GetOembed response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetOembedJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PatchOwnerEmailChangesTokenConfirmJSON200Response is a constructor method for a PatchOwnerEmailChangesTokenConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchOwnerEmailChangesTokenConfirmJSON200Response(body ConfirmOwnerEmailChangeResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PatchOwnerEmailChangesTokenConfirmJSON400Response is a constructor method for a PatchOwnerEmailChangesTokenConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchOwnerEmailChangesTokenConfirmJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchOwnerEmailChangesTokenConfirmJSON404Response is a constructor method for a PatchOwnerEmailChangesTokenConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchOwnerEmailChangesTokenConfirmJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PatchOwnerEmailChangesTokenConfirmJSON422Response is a constructor method for a PatchOwnerEmailChangesTokenConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchOwnerEmailChangesTokenConfirmJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PatchOwnerEmailChangesTokenConfirmJSON429Response is a constructor method for a PatchOwnerEmailChangesTokenConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchOwnerEmailChangesTokenConfirmJSON429Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        429,
+		contentType: "application/json",
+	}
+}
+
+// PatchOwnershipTransfersTokenAcceptJSON204Response is a constructor method for a PatchOwnershipTransfersTokenAccept response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchOwnershipTransfersTokenAcceptJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchOwnershipTransfersTokenAcceptJSON400Response is a constructor method for a PatchOwnershipTransfersTokenAccept response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchOwnershipTransfersTokenAcceptJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchOwnershipTransfersTokenAcceptJSON404Response is a constructor method for a PatchOwnershipTransfersTokenAccept response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchOwnershipTransfersTokenAcceptJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PatchOwnershipTransfersTokenAcceptJSON422Response is a constructor method for a PatchOwnershipTransfersTokenAccept response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchOwnershipTransfersTokenAcceptJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PatchOwnershipTransfersTokenAcceptJSON429Response is a constructor method for a PatchOwnershipTransfersTokenAccept response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchOwnershipTransfersTokenAcceptJSON429Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        429,
+		contentType: "application/json",
+	}
+}
+
+// PostParticipantsParticipantIDCompanionsJSON201Response is a constructor method for a PostParticipantsParticipantIDCompanions response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostParticipantsParticipantIDCompanionsJSON201Response(body CreateCompanionResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostParticipantsParticipantIDCompanionsJSON400Response is a constructor method for a PostParticipantsParticipantIDCompanions response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostParticipantsParticipantIDCompanionsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostParticipantsParticipantIDCompanionsJSON404Response is a constructor method for a PostParticipantsParticipantIDCompanions response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostParticipantsParticipantIDCompanionsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostParticipantsParticipantIDCompanionsJSON422Response is a constructor method for a PostParticipantsParticipantIDCompanions response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostParticipantsParticipantIDCompanionsJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// DeleteParticipantsParticipantIDCompanionsCompanionIDJSON204Response is a constructor method for a DeleteParticipantsParticipantIDCompanionsCompanionID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteParticipantsParticipantIDCompanionsCompanionIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteParticipantsParticipantIDCompanionsCompanionIDJSON400Response is a constructor method for a DeleteParticipantsParticipantIDCompanionsCompanionID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteParticipantsParticipantIDCompanionsCompanionIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteParticipantsParticipantIDCompanionsCompanionIDJSON404Response is a constructor method for a DeleteParticipantsParticipantIDCompanionsCompanionID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteParticipantsParticipantIDCompanionsCompanionIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteParticipantsParticipantIDCompanionsCompanionIDJSON422Response is a constructor method for a DeleteParticipantsParticipantIDCompanionsCompanionID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteParticipantsParticipantIDCompanionsCompanionIDJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDConfirmJSON204Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDConfirmJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDConfirmJSON400Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDConfirmJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDConfirmJSON404Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDConfirmJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDConfirmJSON422Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDConfirmJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDConfirmJSON429Response is a constructor method for a PatchParticipantsParticipantIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDConfirmJSON429Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        429,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDDeclineJSON204Response is a constructor method for a PatchParticipantsParticipantIDDecline response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDDeclineJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDDeclineJSON400Response is a constructor method for a PatchParticipantsParticipantIDDecline response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDDeclineJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDDeclineJSON404Response is a constructor method for a PatchParticipantsParticipantIDDecline response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDDeclineJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDDeclineJSON422Response is a constructor method for a PatchParticipantsParticipantIDDecline response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDDeclineJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PatchParticipantsParticipantIDDeclineJSON429Response is a constructor method for a PatchParticipantsParticipantIDDecline response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchParticipantsParticipantIDDeclineJSON429Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        429,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDNeedsJSON200Response is a constructor method for a GetParticipantsParticipantIDNeeds response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDNeedsJSON200Response(body GetParticipantNeedsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDNeedsJSON400Response is a constructor method for a GetParticipantsParticipantIDNeeds response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDNeedsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDNeedsJSON404Response is a constructor method for a GetParticipantsParticipantIDNeeds response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDNeedsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetParticipantsParticipantIDNeedsJSON422Response is a constructor method for a GetParticipantsParticipantIDNeeds response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetParticipantsParticipantIDNeedsJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PutParticipantsParticipantIDNeedsJSON204Response is a constructor method for a PutParticipantsParticipantIDNeeds response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutParticipantsParticipantIDNeedsJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutParticipantsParticipantIDNeedsJSON400Response is a constructor method for a PutParticipantsParticipantIDNeeds response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutParticipantsParticipantIDNeedsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutParticipantsParticipantIDNeedsJSON404Response is a constructor method for a PutParticipantsParticipantIDNeeds response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutParticipantsParticipantIDNeedsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutParticipantsParticipantIDNeedsJSON422Response is a constructor method for a PutParticipantsParticipantIDNeeds response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutParticipantsParticipantIDNeedsJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetSharedShareTokenFeedAtomJSON400Response is a constructor method for a GetSharedShareTokenFeedAtom response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetSharedShareTokenFeedAtomJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetSharedShareTokenFeedAtomJSON404Response is a constructor method for a GetSharedShareTokenFeedAtom response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetSharedShareTokenFeedAtomJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetSharedShareTokenFeedAtomJSON422Response is a constructor method for a GetSharedShareTokenFeedAtom response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetSharedShareTokenFeedAtomJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetSharedShareTokenFeedAtomJSON429Response is a constructor method for a GetSharedShareTokenFeedAtom response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetSharedShareTokenFeedAtomJSON429Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        429,
+		contentType: "application/json",
+	}
+}
+
+// GetSharedShareTokenJsonldJSON400Response is a constructor method for a GetSharedShareTokenJsonld response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetSharedShareTokenJsonldJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetSharedShareTokenJsonldJSON404Response is a constructor method for a GetSharedShareTokenJsonld response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetSharedShareTokenJsonldJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetSharedShareTokenJsonldJSON422Response is a constructor method for a GetSharedShareTokenJsonld response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetSharedShareTokenJsonldJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetSharedShareTokenJsonldJSON429Response is a constructor method for a GetSharedShareTokenJsonld response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetSharedShareTokenJsonldJSON429Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        429,
+		contentType: "application/json",
+	}
+}
+
+// PostSharedShareTokenReportsJSON204Response is a constructor method for a PostSharedShareTokenReports response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostSharedShareTokenReportsJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostSharedShareTokenReportsJSON400Response is a constructor method for a PostSharedShareTokenReports response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostSharedShareTokenReportsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostSharedShareTokenReportsJSON404Response is a constructor method for a PostSharedShareTokenReports response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostSharedShareTokenReportsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostSharedShareTokenReportsJSON422Response is a constructor method for a PostSharedShareTokenReports response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostSharedShareTokenReportsJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostSharedShareTokenReportsJSON429Response is a constructor method for a PostSharedShareTokenReports response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostSharedShareTokenReportsJSON429Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        429,
+		contentType: "application/json",
+	}
+}
+
+// GetSheetsCallbackJSON200Response is a constructor method for a GetSheetsCallback response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetSheetsCallbackJSON200Response(body TripSheetResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetSheetsCallbackJSON400Response is a constructor method for a GetSheetsCallback response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetSheetsCallbackJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetSheetsCallbackJSON404Response is a constructor method for a GetSheetsCallback response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetSheetsCallbackJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetSheetsCallbackJSON422Response is a constructor method for a GetSheetsCallback response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetSheetsCallbackJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetSurveysTokenJSON200Response is a constructor method for a GetSurveysToken response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetSurveysTokenJSON200Response(body GetSurveyResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetSurveysTokenJSON400Response is a constructor method for a GetSurveysToken response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetSurveysTokenJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetSurveysTokenJSON404Response is a constructor method for a GetSurveysToken response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetSurveysTokenJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetSurveysTokenJSON422Response is a constructor method for a GetSurveysToken response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetSurveysTokenJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PutSurveysTokenJSON204Response is a constructor method for a PutSurveysToken response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutSurveysTokenJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutSurveysTokenJSON400Response is a constructor method for a PutSurveysToken response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutSurveysTokenJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutSurveysTokenJSON404Response is a constructor method for a PutSurveysToken response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutSurveysTokenJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutSurveysTokenJSON422Response is a constructor method for a PutSurveysToken response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutSurveysTokenJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsJSON201Response is a constructor method for a PostTrips response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsJSON201Response(body CreateTripResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsJSON400Response is a constructor method for a PostTrips response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsJSON409Response is a constructor method for a PostTrips response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsJSON409Response(body DuplicateTripResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsJSON422Response is a constructor method for a PostTrips response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsJSON429Response is a constructor method for a PostTrips response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsJSON429Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        429,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsImportJSON201Response is a constructor method for a PostTripsImport response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsImportJSON201Response(body ImportTripResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsImportJSON400Response is a constructor method for a PostTripsImport response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsImportJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsImportJSON422Response is a constructor method for a PostTripsImport response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsImportJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsImportJSON429Response is a constructor method for a PostTripsImport response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsImportJSON429Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        429,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDJSON200Response is a constructor method for a GetTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDJSON200Response(body GetTripDetailsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDJSON400Response is a constructor method for a GetTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDJSON404Response is a constructor method for a GetTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDJSON422Response is a constructor method for a GetTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDJSON200Response is a constructor method for a PutTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDJSON200Response(body TripDateImpact) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDJSON204Response is a constructor method for a PutTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDJSON400Response is a constructor method for a PutTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDJSON404Response is a constructor method for a PutTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDJSON422Response is a constructor method for a PutTripsTripID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesJSON200Response is a constructor method for a GetTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesJSON200Response(body GetTripActivitiesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesJSON400Response is a constructor method for a GetTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesJSON404Response is a constructor method for a GetTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesJSON422Response is a constructor method for a GetTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesJSON201Response is a constructor method for a PostTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesJSON201Response(body CreateActivityResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesJSON400Response is a constructor method for a PostTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesJSON404Response is a constructor method for a PostTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesJSON422Response is a constructor method for a PostTripsTripIDActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesDraftJSON201Response is a constructor method for a PostTripsTripIDActivitiesDraft response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesDraftJSON201Response(body DraftActivitiesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesDraftJSON400Response is a constructor method for a PostTripsTripIDActivitiesDraft response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesDraftJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesDraftJSON404Response is a constructor method for a PostTripsTripIDActivitiesDraft response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesDraftJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesDraftJSON422Response is a constructor method for a PostTripsTripIDActivitiesDraft response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesDraftJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesDraftJSON429Response is a constructor method for a PostTripsTripIDActivitiesDraft response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesDraftJSON429Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        429,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesQuickAddJSON200Response is a constructor method for a PostTripsTripIDActivitiesQuickAdd response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesQuickAddJSON200Response(body QuickAddActivityResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesQuickAddJSON201Response is a constructor method for a PostTripsTripIDActivitiesQuickAdd response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesQuickAddJSON201Response(body QuickAddActivityResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesQuickAddJSON400Response is a constructor method for a PostTripsTripIDActivitiesQuickAdd response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesQuickAddJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesQuickAddJSON404Response is a constructor method for a PostTripsTripIDActivitiesQuickAdd response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesQuickAddJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesQuickAddJSON422Response is a constructor method for a PostTripsTripIDActivitiesQuickAdd response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesQuickAddJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesQuickAddJSON429Response is a constructor method for a PostTripsTripIDActivitiesQuickAdd response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesQuickAddJSON429Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        429,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesShiftPreviewJSON200Response is a constructor method for a GetTripsTripIDActivitiesShiftPreview response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesShiftPreviewJSON200Response(body ShiftPreviewResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesShiftPreviewJSON400Response is a constructor method for a GetTripsTripIDActivitiesShiftPreview response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesShiftPreviewJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesShiftPreviewJSON404Response is a constructor method for a GetTripsTripIDActivitiesShiftPreview response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesShiftPreviewJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesShiftPreviewJSON422Response is a constructor method for a GetTripsTripIDActivitiesShiftPreview response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesShiftPreviewJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesSuggestedTimesJSON200Response is a constructor method for a GetTripsTripIDActivitiesSuggestedTimes response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesSuggestedTimesJSON200Response(body SuggestTimesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesSuggestedTimesJSON400Response is a constructor method for a GetTripsTripIDActivitiesSuggestedTimes response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesSuggestedTimesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesSuggestedTimesJSON404Response is a constructor method for a GetTripsTripIDActivitiesSuggestedTimes response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesSuggestedTimesJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesSuggestedTimesJSON422Response is a constructor method for a GetTripsTripIDActivitiesSuggestedTimes response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesSuggestedTimesJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDJSON204Response is a constructor method for a DeleteTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDJSON400Response is a constructor method for a DeleteTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDJSON404Response is a constructor method for a DeleteTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDJSON422Response is a constructor method for a DeleteTripsTripIDActivitiesActivityID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesActivityIDApproveJSON204Response is a constructor method for a PatchTripsTripIDActivitiesActivityIDApprove response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesActivityIDApproveJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesActivityIDApproveJSON400Response is a constructor method for a PatchTripsTripIDActivitiesActivityIDApprove response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesActivityIDApproveJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesActivityIDApproveJSON404Response is a constructor method for a PatchTripsTripIDActivitiesActivityIDApprove response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesActivityIDApproveJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesActivityIDApproveJSON422Response is a constructor method for a PatchTripsTripIDActivitiesActivityIDApprove response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesActivityIDApproveJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDOrganizerJSON204Response is a constructor method for a DeleteTripsTripIDActivitiesActivityIDOrganizer response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDOrganizerJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDOrganizerJSON400Response is a constructor method for a DeleteTripsTripIDActivitiesActivityIDOrganizer response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDOrganizerJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDOrganizerJSON404Response is a constructor method for a DeleteTripsTripIDActivitiesActivityIDOrganizer response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDOrganizerJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDActivitiesActivityIDOrganizerJSON422Response is a constructor method for a DeleteTripsTripIDActivitiesActivityIDOrganizer response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDActivitiesActivityIDOrganizerJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDOrganizerJSON204Response is a constructor method for a PutTripsTripIDActivitiesActivityIDOrganizer response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDOrganizerJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDOrganizerJSON400Response is a constructor method for a PutTripsTripIDActivitiesActivityIDOrganizer response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDOrganizerJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDOrganizerJSON404Response is a constructor method for a PutTripsTripIDActivitiesActivityIDOrganizer response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDOrganizerJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDActivitiesActivityIDOrganizerJSON422Response is a constructor method for a PutTripsTripIDActivitiesActivityIDOrganizer response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDActivitiesActivityIDOrganizerJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesActivityIDPayJSON201Response is a constructor method for a PostTripsTripIDActivitiesActivityIDPay response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesActivityIDPayJSON201Response(body CreateExpenseResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesActivityIDPayJSON400Response is a constructor method for a PostTripsTripIDActivitiesActivityIDPay response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesActivityIDPayJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesActivityIDPayJSON404Response is a constructor method for a PostTripsTripIDActivitiesActivityIDPay response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesActivityIDPayJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesActivityIDPayJSON422Response is a constructor method for a PostTripsTripIDActivitiesActivityIDPay response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesActivityIDPayJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesActivityIDRejectJSON204Response is a constructor method for a PatchTripsTripIDActivitiesActivityIDReject response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesActivityIDRejectJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesActivityIDRejectJSON400Response is a constructor method for a PatchTripsTripIDActivitiesActivityIDReject response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesActivityIDRejectJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesActivityIDRejectJSON404Response is a constructor method for a PatchTripsTripIDActivitiesActivityIDReject response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesActivityIDRejectJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDActivitiesActivityIDRejectJSON422Response is a constructor method for a PatchTripsTripIDActivitiesActivityIDReject response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDActivitiesActivityIDRejectJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesDateOptimizeJSON200Response is a constructor method for a GetTripsTripIDActivitiesDateOptimize response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesDateOptimizeJSON200Response(body GetOptimizedDayResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesDateOptimizeJSON400Response is a constructor method for a GetTripsTripIDActivitiesDateOptimize response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesDateOptimizeJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesDateOptimizeJSON404Response is a constructor method for a GetTripsTripIDActivitiesDateOptimize response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesDateOptimizeJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesDateOptimizeJSON422Response is a constructor method for a GetTripsTripIDActivitiesDateOptimize response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesDateOptimizeJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesDateOptimizeJSON204Response is a constructor method for a PostTripsTripIDActivitiesDateOptimize response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesDateOptimizeJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesDateOptimizeJSON400Response is a constructor method for a PostTripsTripIDActivitiesDateOptimize response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesDateOptimizeJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesDateOptimizeJSON404Response is a constructor method for a PostTripsTripIDActivitiesDateOptimize response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesDateOptimizeJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDActivitiesDateOptimizeJSON422Response is a constructor method for a PostTripsTripIDActivitiesDateOptimize response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDActivitiesDateOptimizeJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesDateRouteJSON200Response is a constructor method for a GetTripsTripIDActivitiesDateRoute response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesDateRouteJSON200Response(body GetActivitiesRouteResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesDateRouteJSON400Response is a constructor method for a GetTripsTripIDActivitiesDateRoute response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesDateRouteJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesDateRouteJSON404Response is a constructor method for a GetTripsTripIDActivitiesDateRoute response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesDateRouteJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDActivitiesDateRouteJSON422Response is a constructor method for a GetTripsTripIDActivitiesDateRoute response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDActivitiesDateRouteJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDAttachmentsPresignJSON201Response is a constructor method for a PostTripsTripIDAttachmentsPresign response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDAttachmentsPresignJSON201Response(body PresignAttachmentResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDAttachmentsPresignJSON400Response is a constructor method for a PostTripsTripIDAttachmentsPresign response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDAttachmentsPresignJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDAttachmentsPresignJSON404Response is a constructor method for a PostTripsTripIDAttachmentsPresign response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDAttachmentsPresignJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDAttachmentsPresignJSON422Response is a constructor method for a PostTripsTripIDAttachmentsPresign response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDAttachmentsPresignJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDAttachmentsAttachmentIDJSON200Response is a constructor method for a GetTripsTripIDAttachmentsAttachmentID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDAttachmentsAttachmentIDJSON200Response(body AttachmentResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDAttachmentsAttachmentIDJSON400Response is a constructor method for a GetTripsTripIDAttachmentsAttachmentID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDAttachmentsAttachmentIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDAttachmentsAttachmentIDJSON404Response is a constructor method for a GetTripsTripIDAttachmentsAttachmentID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDAttachmentsAttachmentIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDAttachmentsAttachmentIDJSON422Response is a constructor method for a GetTripsTripIDAttachmentsAttachmentID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDAttachmentsAttachmentIDJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDAttachmentsAttachmentIDCompleteJSON200Response is a constructor method for a PostTripsTripIDAttachmentsAttachmentIDComplete response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDAttachmentsAttachmentIDCompleteJSON200Response(body AttachmentResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDAttachmentsAttachmentIDCompleteJSON400Response is a constructor method for a PostTripsTripIDAttachmentsAttachmentIDComplete response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDAttachmentsAttachmentIDCompleteJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDAttachmentsAttachmentIDCompleteJSON404Response is a constructor method for a PostTripsTripIDAttachmentsAttachmentIDComplete response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDAttachmentsAttachmentIDCompleteJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDAttachmentsAttachmentIDCompleteJSON422Response is a constructor method for a PostTripsTripIDAttachmentsAttachmentIDComplete response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDAttachmentsAttachmentIDCompleteJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDBudgetJSON200Response is a constructor method for a GetTripsTripIDBudget response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDBudgetJSON200Response(body TripBudgetResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDBudgetJSON400Response is a constructor method for a GetTripsTripIDBudget response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDBudgetJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDBudgetJSON404Response is a constructor method for a GetTripsTripIDBudget response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDBudgetJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDBudgetJSON422Response is a constructor method for a GetTripsTripIDBudget response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDBudgetJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDBundleJSON200Response is a constructor method for a GetTripsTripIDBundle response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDBundleJSON200Response(body TripBundle) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDBundleJSON400Response is a constructor method for a GetTripsTripIDBundle response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDBundleJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDBundleJSON404Response is a constructor method for a GetTripsTripIDBundle response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDBundleJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDBundleJSON422Response is a constructor method for a GetTripsTripIDBundle response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDBundleJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDChecklistJSON200Response is a constructor method for a GetTripsTripIDChecklist response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDChecklistJSON200Response(body GetChecklistResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDChecklistJSON400Response is a constructor method for a GetTripsTripIDChecklist response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDChecklistJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDChecklistJSON404Response is a constructor method for a GetTripsTripIDChecklist response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDChecklistJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDChecklistJSON422Response is a constructor method for a GetTripsTripIDChecklist response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDChecklistJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDChecklistJSON201Response is a constructor method for a PostTripsTripIDChecklist response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDChecklistJSON201Response(body CreateChecklistItemResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDChecklistJSON400Response is a constructor method for a PostTripsTripIDChecklist response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDChecklistJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDChecklistJSON404Response is a constructor method for a PostTripsTripIDChecklist response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDChecklistJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDChecklistJSON422Response is a constructor method for a PostTripsTripIDChecklist response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDChecklistJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDChecklistGenerateJSON201Response is a constructor method for a PostTripsTripIDChecklistGenerate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDChecklistGenerateJSON201Response(body GenerateChecklistResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDChecklistGenerateJSON400Response is a constructor method for a PostTripsTripIDChecklistGenerate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDChecklistGenerateJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDChecklistGenerateJSON404Response is a constructor method for a PostTripsTripIDChecklistGenerate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDChecklistGenerateJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDChecklistGenerateJSON422Response is a constructor method for a PostTripsTripIDChecklistGenerate response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDChecklistGenerateJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDChecklistItemIDJSON204Response is a constructor method for a DeleteTripsTripIDChecklistItemID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDChecklistItemIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDChecklistItemIDJSON400Response is a constructor method for a DeleteTripsTripIDChecklistItemID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDChecklistItemIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDChecklistItemIDJSON404Response is a constructor method for a DeleteTripsTripIDChecklistItemID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDChecklistItemIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDChecklistItemIDJSON422Response is a constructor method for a DeleteTripsTripIDChecklistItemID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDChecklistItemIDJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDChecklistItemIDJSON204Response is a constructor method for a PutTripsTripIDChecklistItemID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDChecklistItemIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDChecklistItemIDJSON400Response is a constructor method for a PutTripsTripIDChecklistItemID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDChecklistItemIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDChecklistItemIDJSON404Response is a constructor method for a PutTripsTripIDChecklistItemID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDChecklistItemIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDChecklistItemIDJSON422Response is a constructor method for a PutTripsTripIDChecklistItemID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDChecklistItemIDJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
 }
 
 // GetTripsTripIDConfirmJSON204Response is a constructor method for a GetTripsTripIDConfirm response.
 // A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDConfirmJSON204Response(body interface{}) *Response {
+func GetTripsTripIDConfirmJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConfirmJSON400Response is a constructor method for a GetTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConfirmJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConfirmJSON404Response is a constructor method for a GetTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConfirmJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConfirmJSON422Response is a constructor method for a GetTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConfirmJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConfirmJSON429Response is a constructor method for a GetTripsTripIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConfirmJSON429Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        429,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConfirmationsSummaryJSON200Response is a constructor method for a GetTripsTripIDConfirmationsSummary response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConfirmationsSummaryJSON200Response(body ConfirmationSummaryResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConfirmationsSummaryJSON304Response is a constructor method for a GetTripsTripIDConfirmationsSummary response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConfirmationsSummaryJSON304Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        304,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConfirmationsSummaryJSON400Response is a constructor method for a GetTripsTripIDConfirmationsSummary response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConfirmationsSummaryJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConfirmationsSummaryJSON404Response is a constructor method for a GetTripsTripIDConfirmationsSummary response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConfirmationsSummaryJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConfirmationsSummaryJSON422Response is a constructor method for a GetTripsTripIDConfirmationsSummary response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConfirmationsSummaryJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConflictsJSON200Response is a constructor method for a GetTripsTripIDConflicts response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConflictsJSON200Response(body GetConflictsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConflictsJSON400Response is a constructor method for a GetTripsTripIDConflicts response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConflictsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConflictsJSON404Response is a constructor method for a GetTripsTripIDConflicts response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConflictsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDConflictsJSON422Response is a constructor method for a GetTripsTripIDConflicts response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDConflictsJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDDatePollJSON200Response is a constructor method for a GetTripsTripIDDatePoll response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDDatePollJSON200Response(body GetDatePollResultsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDDatePollJSON400Response is a constructor method for a GetTripsTripIDDatePoll response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDDatePollJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDDatePollJSON404Response is a constructor method for a GetTripsTripIDDatePoll response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDDatePollJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDDatePollJSON422Response is a constructor method for a GetTripsTripIDDatePoll response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDDatePollJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDDatePollJSON204Response is a constructor method for a PostTripsTripIDDatePoll response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDDatePollJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDDatePollJSON400Response is a constructor method for a PostTripsTripIDDatePoll response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDDatePollJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDDatePollJSON404Response is a constructor method for a PostTripsTripIDDatePoll response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDDatePollJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDDatePollJSON422Response is a constructor method for a PostTripsTripIDDatePoll response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDDatePollJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDDatePollOptionIDPickJSON204Response is a constructor method for a PostTripsTripIDDatePollOptionIDPick response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDDatePollOptionIDPickJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDDatePollOptionIDPickJSON400Response is a constructor method for a PostTripsTripIDDatePollOptionIDPick response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDDatePollOptionIDPickJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDDatePollOptionIDPickJSON404Response is a constructor method for a PostTripsTripIDDatePollOptionIDPick response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDDatePollOptionIDPickJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDDatePollOptionIDPickJSON422Response is a constructor method for a PostTripsTripIDDatePollOptionIDPick response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDDatePollOptionIDPickJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExpensesJSON200Response is a constructor method for a GetTripsTripIDExpenses response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExpensesJSON200Response(body GetExpensesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExpensesJSON400Response is a constructor method for a GetTripsTripIDExpenses response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExpensesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExpensesJSON404Response is a constructor method for a GetTripsTripIDExpenses response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExpensesJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExpensesJSON422Response is a constructor method for a GetTripsTripIDExpenses response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExpensesJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDExpensesJSON201Response is a constructor method for a PostTripsTripIDExpenses response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDExpensesJSON201Response(body CreateExpenseResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDExpensesJSON400Response is a constructor method for a PostTripsTripIDExpenses response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDExpensesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDExpensesJSON404Response is a constructor method for a PostTripsTripIDExpenses response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDExpensesJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDExpensesJSON422Response is a constructor method for a PostTripsTripIDExpenses response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDExpensesJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExpensesBreakdownJSON200Response is a constructor method for a GetTripsTripIDExpensesBreakdown response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExpensesBreakdownJSON200Response(body GetExpensesBreakdownResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExpensesBreakdownJSON400Response is a constructor method for a GetTripsTripIDExpensesBreakdown response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExpensesBreakdownJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExpensesBreakdownJSON404Response is a constructor method for a GetTripsTripIDExpensesBreakdown response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExpensesBreakdownJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExpensesBreakdownJSON422Response is a constructor method for a GetTripsTripIDExpensesBreakdown response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExpensesBreakdownJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExpensesExportZipJSON400Response is a constructor method for a GetTripsTripIDExpensesExportZip response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExpensesExportZipJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExpensesExportZipJSON404Response is a constructor method for a GetTripsTripIDExpensesExportZip response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExpensesExportZipJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExpensesExportZipJSON422Response is a constructor method for a GetTripsTripIDExpensesExportZip response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExpensesExportZipJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExpensesSettlementJSON200Response is a constructor method for a GetTripsTripIDExpensesSettlement response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExpensesSettlementJSON200Response(body GetSettlementResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExpensesSettlementJSON400Response is a constructor method for a GetTripsTripIDExpensesSettlement response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExpensesSettlementJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExpensesSettlementJSON404Response is a constructor method for a GetTripsTripIDExpensesSettlement response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExpensesSettlementJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExpensesSettlementJSON422Response is a constructor method for a GetTripsTripIDExpensesSettlement response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExpensesSettlementJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExpensesExpenseIDReceiptJSON400Response is a constructor method for a GetTripsTripIDExpensesExpenseIDReceipt response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExpensesExpenseIDReceiptJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExpensesExpenseIDReceiptJSON404Response is a constructor method for a GetTripsTripIDExpensesExpenseIDReceipt response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExpensesExpenseIDReceiptJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExpensesExpenseIDReceiptJSON422Response is a constructor method for a GetTripsTripIDExpensesExpenseIDReceipt response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExpensesExpenseIDReceiptJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExportMdJSON400Response is a constructor method for a GetTripsTripIDExportMd response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExportMdJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExportMdJSON404Response is a constructor method for a GetTripsTripIDExportMd response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExportMdJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDExportMdJSON422Response is a constructor method for a GetTripsTripIDExportMd response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDExportMdJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDGapsJSON200Response is a constructor method for a GetTripsTripIDGaps response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDGapsJSON200Response(body GetGapsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDGapsJSON400Response is a constructor method for a GetTripsTripIDGaps response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDGapsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDGapsJSON404Response is a constructor method for a GetTripsTripIDGaps response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDGapsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDGapsJSON422Response is a constructor method for a GetTripsTripIDGaps response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDGapsJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDGroupsJSON200Response is a constructor method for a GetTripsTripIDGroups response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDGroupsJSON200Response(body GetParticipantGroupsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDGroupsJSON400Response is a constructor method for a GetTripsTripIDGroups response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDGroupsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDGroupsJSON404Response is a constructor method for a GetTripsTripIDGroups response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDGroupsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDGroupsJSON422Response is a constructor method for a GetTripsTripIDGroups response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDGroupsJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDGroupsJSON201Response is a constructor method for a PostTripsTripIDGroups response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDGroupsJSON201Response(body CreateParticipantGroupResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDGroupsJSON400Response is a constructor method for a PostTripsTripIDGroups response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDGroupsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDGroupsJSON404Response is a constructor method for a PostTripsTripIDGroups response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDGroupsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDGroupsJSON422Response is a constructor method for a PostTripsTripIDGroups response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDGroupsJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDGroupsGroupIDJSON204Response is a constructor method for a DeleteTripsTripIDGroupsGroupID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDGroupsGroupIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDGroupsGroupIDJSON400Response is a constructor method for a DeleteTripsTripIDGroupsGroupID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDGroupsGroupIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDGroupsGroupIDJSON404Response is a constructor method for a DeleteTripsTripIDGroupsGroupID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDGroupsGroupIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDGroupsGroupIDJSON422Response is a constructor method for a DeleteTripsTripIDGroupsGroupID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDGroupsGroupIDJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDGroupsGroupIDJSON204Response is a constructor method for a PutTripsTripIDGroupsGroupID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDGroupsGroupIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDGroupsGroupIDJSON400Response is a constructor method for a PutTripsTripIDGroupsGroupID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDGroupsGroupIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDGroupsGroupIDJSON404Response is a constructor method for a PutTripsTripIDGroupsGroupID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDGroupsGroupIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDGroupsGroupIDJSON422Response is a constructor method for a PutTripsTripIDGroupsGroupID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDGroupsGroupIDJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesJSON201Response is a constructor method for a PostTripsTripIDInvites response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesJSON201Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesJSON400Response is a constructor method for a PostTripsTripIDInvites response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesJSON404Response is a constructor method for a PostTripsTripIDInvites response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesJSON422Response is a constructor method for a PostTripsTripIDInvites response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesImportJSON200Response is a constructor method for a PostTripsTripIDInvitesImport response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesImportJSON200Response(body ImportInvitesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesImportJSON400Response is a constructor method for a PostTripsTripIDInvitesImport response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesImportJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesImportJSON404Response is a constructor method for a PostTripsTripIDInvitesImport response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesImportJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDInvitesImportJSON422Response is a constructor method for a PostTripsTripIDInvitesImport response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDInvitesImportJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDInvitesSummaryJSON200Response is a constructor method for a GetTripsTripIDInvitesSummary response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDInvitesSummaryJSON200Response(body InviteSummaryResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDInvitesSummaryJSON400Response is a constructor method for a GetTripsTripIDInvitesSummary response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDInvitesSummaryJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDInvitesSummaryJSON404Response is a constructor method for a GetTripsTripIDInvitesSummary response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDInvitesSummaryJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDInvitesSummaryJSON422Response is a constructor method for a GetTripsTripIDInvitesSummary response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDInvitesSummaryJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksJSON200Response is a constructor method for a GetTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksJSON200Response(body GetLinksResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksJSON400Response is a constructor method for a GetTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksJSON404Response is a constructor method for a GetTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLinksJSON422Response is a constructor method for a GetTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLinksJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksJSON201Response is a constructor method for a PostTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksJSON201Response(body CreateLinkResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksJSON400Response is a constructor method for a PostTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksJSON404Response is a constructor method for a PostTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksJSON422Response is a constructor method for a PostTripsTripIDLinks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksBulkJSON200Response is a constructor method for a PostTripsTripIDLinksBulk response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksBulkJSON200Response(body BulkLinksResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksBulkJSON400Response is a constructor method for a PostTripsTripIDLinksBulk response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksBulkJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksBulkJSON404Response is a constructor method for a PostTripsTripIDLinksBulk response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksBulkJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLinksBulkJSON422Response is a constructor method for a PostTripsTripIDLinksBulk response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLinksBulkJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDLinksLinkIDJSON204Response is a constructor method for a DeleteTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDLinksLinkIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDLinksLinkIDJSON400Response is a constructor method for a DeleteTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDLinksLinkIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDLinksLinkIDJSON404Response is a constructor method for a DeleteTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDLinksLinkIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDLinksLinkIDJSON422Response is a constructor method for a DeleteTripsTripIDLinksLinkID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDLinksLinkIDJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLodgingsJSON200Response is a constructor method for a GetTripsTripIDLodgings response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLodgingsJSON200Response(body GetLodgingsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLodgingsJSON400Response is a constructor method for a GetTripsTripIDLodgings response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLodgingsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLodgingsJSON404Response is a constructor method for a GetTripsTripIDLodgings response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLodgingsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLodgingsJSON422Response is a constructor method for a GetTripsTripIDLodgings response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLodgingsJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLodgingsJSON201Response is a constructor method for a PostTripsTripIDLodgings response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLodgingsJSON201Response(body CreateLodgingResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLodgingsJSON400Response is a constructor method for a PostTripsTripIDLodgings response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLodgingsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLodgingsJSON404Response is a constructor method for a PostTripsTripIDLodgings response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLodgingsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLodgingsJSON422Response is a constructor method for a PostTripsTripIDLodgings response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLodgingsJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDLodgingsLodgingIDApproveJSON204Response is a constructor method for a PatchTripsTripIDLodgingsLodgingIDApprove response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDLodgingsLodgingIDApproveJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDLodgingsLodgingIDApproveJSON400Response is a constructor method for a PatchTripsTripIDLodgingsLodgingIDApprove response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDLodgingsLodgingIDApproveJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDLodgingsLodgingIDApproveJSON404Response is a constructor method for a PatchTripsTripIDLodgingsLodgingIDApprove response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDLodgingsLodgingIDApproveJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDLodgingsLodgingIDApproveJSON422Response is a constructor method for a PatchTripsTripIDLodgingsLodgingIDApprove response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDLodgingsLodgingIDApproveJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDLodgingsLodgingIDRejectJSON204Response is a constructor method for a PatchTripsTripIDLodgingsLodgingIDReject response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDLodgingsLodgingIDRejectJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDLodgingsLodgingIDRejectJSON400Response is a constructor method for a PatchTripsTripIDLodgingsLodgingIDReject response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDLodgingsLodgingIDRejectJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDLodgingsLodgingIDRejectJSON404Response is a constructor method for a PatchTripsTripIDLodgingsLodgingIDReject response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDLodgingsLodgingIDRejectJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDLodgingsLodgingIDRejectJSON422Response is a constructor method for a PatchTripsTripIDLodgingsLodgingIDReject response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDLodgingsLodgingIDRejectJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLodgingsLodgingIDRoomingListJSON400Response is a constructor method for a GetTripsTripIDLodgingsLodgingIDRoomingList response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLodgingsLodgingIDRoomingListJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLodgingsLodgingIDRoomingListJSON404Response is a constructor method for a GetTripsTripIDLodgingsLodgingIDRoomingList response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLodgingsLodgingIDRoomingListJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLodgingsLodgingIDRoomingListJSON422Response is a constructor method for a GetTripsTripIDLodgingsLodgingIDRoomingList response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLodgingsLodgingIDRoomingListJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLodgingsLodgingIDRoomsJSON200Response is a constructor method for a GetTripsTripIDLodgingsLodgingIDRooms response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLodgingsLodgingIDRoomsJSON200Response(body GetLodgingRoomsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLodgingsLodgingIDRoomsJSON400Response is a constructor method for a GetTripsTripIDLodgingsLodgingIDRooms response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLodgingsLodgingIDRoomsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLodgingsLodgingIDRoomsJSON404Response is a constructor method for a GetTripsTripIDLodgingsLodgingIDRooms response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLodgingsLodgingIDRoomsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDLodgingsLodgingIDRoomsJSON422Response is a constructor method for a GetTripsTripIDLodgingsLodgingIDRooms response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDLodgingsLodgingIDRoomsJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLodgingsLodgingIDRoomsJSON201Response is a constructor method for a PostTripsTripIDLodgingsLodgingIDRooms response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLodgingsLodgingIDRoomsJSON201Response(body CreateLodgingRoomResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLodgingsLodgingIDRoomsJSON400Response is a constructor method for a PostTripsTripIDLodgingsLodgingIDRooms response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLodgingsLodgingIDRoomsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLodgingsLodgingIDRoomsJSON404Response is a constructor method for a PostTripsTripIDLodgingsLodgingIDRooms response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLodgingsLodgingIDRoomsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDLodgingsLodgingIDRoomsJSON422Response is a constructor method for a PostTripsTripIDLodgingsLodgingIDRooms response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDLodgingsLodgingIDRoomsJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDLodgingsLodgingIDRoomsRoomIDJSON204Response is a constructor method for a DeleteTripsTripIDLodgingsLodgingIDRoomsRoomID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDLodgingsLodgingIDRoomsRoomIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDLodgingsLodgingIDRoomsRoomIDJSON400Response is a constructor method for a DeleteTripsTripIDLodgingsLodgingIDRoomsRoomID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDLodgingsLodgingIDRoomsRoomIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDLodgingsLodgingIDRoomsRoomIDJSON404Response is a constructor method for a DeleteTripsTripIDLodgingsLodgingIDRoomsRoomID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDLodgingsLodgingIDRoomsRoomIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDLodgingsLodgingIDRoomsRoomIDJSON422Response is a constructor method for a DeleteTripsTripIDLodgingsLodgingIDRoomsRoomID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDLodgingsLodgingIDRoomsRoomIDJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDLodgingsLodgingIDRoomsRoomIDParticipantsJSON204Response is a constructor method for a PutTripsTripIDLodgingsLodgingIDRoomsRoomIDParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDLodgingsLodgingIDRoomsRoomIDParticipantsJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDLodgingsLodgingIDRoomsRoomIDParticipantsJSON400Response is a constructor method for a PutTripsTripIDLodgingsLodgingIDRoomsRoomIDParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDLodgingsLodgingIDRoomsRoomIDParticipantsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDLodgingsLodgingIDRoomsRoomIDParticipantsJSON404Response is a constructor method for a PutTripsTripIDLodgingsLodgingIDRoomsRoomIDParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDLodgingsLodgingIDRoomsRoomIDParticipantsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDLodgingsLodgingIDRoomsRoomIDParticipantsJSON422Response is a constructor method for a PutTripsTripIDLodgingsLodgingIDRoomsRoomIDParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDLodgingsLodgingIDRoomsRoomIDParticipantsJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDMergeFromSourceIDJSON200Response is a constructor method for a PostTripsTripIDMergeFromSourceID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDMergeFromSourceIDJSON200Response(body MergeTripResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDMergeFromSourceIDJSON400Response is a constructor method for a PostTripsTripIDMergeFromSourceID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDMergeFromSourceIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDMergeFromSourceIDJSON404Response is a constructor method for a PostTripsTripIDMergeFromSourceID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDMergeFromSourceIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDMergeFromSourceIDJSON422Response is a constructor method for a PostTripsTripIDMergeFromSourceID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDMergeFromSourceIDJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDNeedsSummaryJSON200Response is a constructor method for a GetTripsTripIDNeedsSummary response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDNeedsSummaryJSON200Response(body GetNeedsSummaryResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDNeedsSummaryJSON400Response is a constructor method for a GetTripsTripIDNeedsSummary response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDNeedsSummaryJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDNeedsSummaryJSON404Response is a constructor method for a GetTripsTripIDNeedsSummary response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDNeedsSummaryJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDNeedsSummaryJSON422Response is a constructor method for a GetTripsTripIDNeedsSummary response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDNeedsSummaryJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDOwnerEmailJSON204Response is a constructor method for a PostTripsTripIDOwnerEmail response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDOwnerEmailJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDOwnerEmailJSON400Response is a constructor method for a PostTripsTripIDOwnerEmail response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDOwnerEmailJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDOwnerEmailJSON404Response is a constructor method for a PostTripsTripIDOwnerEmail response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDOwnerEmailJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDOwnerEmailJSON422Response is a constructor method for a PostTripsTripIDOwnerEmail response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDOwnerEmailJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDOwnersJSON204Response is a constructor method for a PostTripsTripIDOwners response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDOwnersJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDOwnersJSON400Response is a constructor method for a PostTripsTripIDOwners response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDOwnersJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDOwnersJSON404Response is a constructor method for a PostTripsTripIDOwners response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDOwnersJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDOwnersJSON422Response is a constructor method for a PostTripsTripIDOwners response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDOwnersJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDOwnersParticipantIDJSON204Response is a constructor method for a DeleteTripsTripIDOwnersParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDOwnersParticipantIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDOwnersParticipantIDJSON400Response is a constructor method for a DeleteTripsTripIDOwnersParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDOwnersParticipantIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDOwnersParticipantIDJSON404Response is a constructor method for a DeleteTripsTripIDOwnersParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDOwnersParticipantIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDOwnersParticipantIDJSON422Response is a constructor method for a DeleteTripsTripIDOwnersParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDOwnersParticipantIDJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsJSON200Response is a constructor method for a GetTripsTripIDParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsJSON200Response(body GetTripParticipantsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsJSON400Response is a constructor method for a GetTripsTripIDParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsJSON404Response is a constructor method for a GetTripsTripIDParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDParticipantsJSON422Response is a constructor method for a GetTripsTripIDParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDParticipantsJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDParticipantsConfirmBulkJSON200Response is a constructor method for a PostTripsTripIDParticipantsConfirmBulk response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDParticipantsConfirmBulkJSON200Response(body ConfirmParticipantsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDParticipantsConfirmBulkJSON400Response is a constructor method for a PostTripsTripIDParticipantsConfirmBulk response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDParticipantsConfirmBulkJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDParticipantsConfirmBulkJSON404Response is a constructor method for a PostTripsTripIDParticipantsConfirmBulk response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDParticipantsConfirmBulkJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDParticipantsConfirmBulkJSON422Response is a constructor method for a PostTripsTripIDParticipantsConfirmBulk response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDParticipantsConfirmBulkJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDParticipantsConfirmBulkJSON429Response is a constructor method for a PostTripsTripIDParticipantsConfirmBulk response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDParticipantsConfirmBulkJSON429Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        429,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDParticipantsParticipantIDEmailJSON204Response is a constructor method for a PatchTripsTripIDParticipantsParticipantIDEmail response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDParticipantsParticipantIDEmailJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDParticipantsParticipantIDEmailJSON400Response is a constructor method for a PatchTripsTripIDParticipantsParticipantIDEmail response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDParticipantsParticipantIDEmailJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDParticipantsParticipantIDEmailJSON404Response is a constructor method for a PatchTripsTripIDParticipantsParticipantIDEmail response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDParticipantsParticipantIDEmailJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDParticipantsParticipantIDEmailJSON422Response is a constructor method for a PatchTripsTripIDParticipantsParticipantIDEmail response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDParticipantsParticipantIDEmailJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDPlanningStatusJSON200Response is a constructor method for a GetTripsTripIDPlanningStatus response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDPlanningStatusJSON200Response(body GetPlanningStatusResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDPlanningStatusJSON400Response is a constructor method for a GetTripsTripIDPlanningStatus response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDPlanningStatusJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDPlanningStatusJSON404Response is a constructor method for a GetTripsTripIDPlanningStatus response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDPlanningStatusJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDPlanningStatusJSON422Response is a constructor method for a GetTripsTripIDPlanningStatus response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDPlanningStatusJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDPrintJSON400Response is a constructor method for a GetTripsTripIDPrint response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDPrintJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDPrintJSON404Response is a constructor method for a GetTripsTripIDPrint response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDPrintJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDPrintJSON422Response is a constructor method for a GetTripsTripIDPrint response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDPrintJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDReceiptsJSON201Response is a constructor method for a PostTripsTripIDReceipts response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDReceiptsJSON201Response(body ScanReceiptResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDReceiptsJSON400Response is a constructor method for a PostTripsTripIDReceipts response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDReceiptsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDReceiptsJSON404Response is a constructor method for a PostTripsTripIDReceipts response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDReceiptsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDReceiptsJSON422Response is a constructor method for a PostTripsTripIDReceipts response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDReceiptsJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDReceiptsReceiptIDConfirmJSON201Response is a constructor method for a PostTripsTripIDReceiptsReceiptIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDReceiptsReceiptIDConfirmJSON201Response(body CreateExpenseResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDReceiptsReceiptIDConfirmJSON400Response is a constructor method for a PostTripsTripIDReceiptsReceiptIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDReceiptsReceiptIDConfirmJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDReceiptsReceiptIDConfirmJSON404Response is a constructor method for a PostTripsTripIDReceiptsReceiptIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDReceiptsReceiptIDConfirmJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDReceiptsReceiptIDConfirmJSON422Response is a constructor method for a PostTripsTripIDReceiptsReceiptIDConfirm response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDReceiptsReceiptIDConfirmJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDRidesJSON200Response is a constructor method for a GetTripsTripIDRides response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDRidesJSON200Response(body GetRidesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDRidesJSON400Response is a constructor method for a GetTripsTripIDRides response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDRidesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDRidesJSON404Response is a constructor method for a GetTripsTripIDRides response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDRidesJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDRidesJSON422Response is a constructor method for a GetTripsTripIDRides response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDRidesJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDRidesJSON201Response is a constructor method for a PostTripsTripIDRides response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDRidesJSON201Response(body CreateRideResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDRidesJSON400Response is a constructor method for a PostTripsTripIDRides response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDRidesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDRidesJSON404Response is a constructor method for a PostTripsTripIDRides response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDRidesJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDRidesJSON422Response is a constructor method for a PostTripsTripIDRides response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDRidesJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDRidesUnassignedJSON200Response is a constructor method for a GetTripsTripIDRidesUnassigned response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDRidesUnassignedJSON200Response(body GetUnassignedTravelersResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDRidesUnassignedJSON400Response is a constructor method for a GetTripsTripIDRidesUnassigned response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDRidesUnassignedJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDRidesUnassignedJSON404Response is a constructor method for a GetTripsTripIDRidesUnassigned response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDRidesUnassignedJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDRidesUnassignedJSON422Response is a constructor method for a GetTripsTripIDRidesUnassigned response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDRidesUnassignedJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDRidesRideIDJSON204Response is a constructor method for a DeleteTripsTripIDRidesRideID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDRidesRideIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDRidesRideIDJSON400Response is a constructor method for a DeleteTripsTripIDRidesRideID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDRidesRideIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDRidesRideIDJSON404Response is a constructor method for a DeleteTripsTripIDRidesRideID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDRidesRideIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDRidesRideIDJSON422Response is a constructor method for a DeleteTripsTripIDRidesRideID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDRidesRideIDJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDRidesRideIDPassengersJSON204Response is a constructor method for a PostTripsTripIDRidesRideIDPassengers response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDRidesRideIDPassengersJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDRidesRideIDPassengersJSON400Response is a constructor method for a PostTripsTripIDRidesRideIDPassengers response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDRidesRideIDPassengersJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDRidesRideIDPassengersJSON404Response is a constructor method for a PostTripsTripIDRidesRideIDPassengers response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDRidesRideIDPassengersJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDRidesRideIDPassengersJSON422Response is a constructor method for a PostTripsTripIDRidesRideIDPassengers response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDRidesRideIDPassengersJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDRidesRideIDPassengersParticipantIDJSON204Response is a constructor method for a DeleteTripsTripIDRidesRideIDPassengersParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDRidesRideIDPassengersParticipantIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDRidesRideIDPassengersParticipantIDJSON400Response is a constructor method for a DeleteTripsTripIDRidesRideIDPassengersParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDRidesRideIDPassengersParticipantIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDRidesRideIDPassengersParticipantIDJSON404Response is a constructor method for a DeleteTripsTripIDRidesRideIDPassengersParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDRidesRideIDPassengersParticipantIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDRidesRideIDPassengersParticipantIDJSON422Response is a constructor method for a DeleteTripsTripIDRidesRideIDPassengersParticipantID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDRidesRideIDPassengersParticipantIDJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDSettingsJSON200Response is a constructor method for a GetTripsTripIDSettings response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDSettingsJSON200Response(body TripSettings) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDSettingsJSON400Response is a constructor method for a GetTripsTripIDSettings response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDSettingsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDSettingsJSON404Response is a constructor method for a GetTripsTripIDSettings response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDSettingsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDSettingsJSON422Response is a constructor method for a GetTripsTripIDSettings response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDSettingsJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDSettingsJSON200Response is a constructor method for a PatchTripsTripIDSettings response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDSettingsJSON200Response(body TripSettings) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDSettingsJSON400Response is a constructor method for a PatchTripsTripIDSettings response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDSettingsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDSettingsJSON404Response is a constructor method for a PatchTripsTripIDSettings response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDSettingsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PatchTripsTripIDSettingsJSON422Response is a constructor method for a PatchTripsTripIDSettings response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PatchTripsTripIDSettingsJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDShareJSON204Response is a constructor method for a DeleteTripsTripIDShare response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDShareJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDShareJSON400Response is a constructor method for a DeleteTripsTripIDShare response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDShareJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDShareJSON404Response is a constructor method for a DeleteTripsTripIDShare response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDShareJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDShareJSON422Response is a constructor method for a DeleteTripsTripIDShare response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDShareJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDShareJSON201Response is a constructor method for a PostTripsTripIDShare response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDShareJSON201Response(body TripShareResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDShareJSON400Response is a constructor method for a PostTripsTripIDShare response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDShareJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDShareJSON404Response is a constructor method for a PostTripsTripIDShare response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDShareJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDShareJSON422Response is a constructor method for a PostTripsTripIDShare response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDShareJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDSheetsJSON204Response is a constructor method for a DeleteTripsTripIDSheets response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDSheetsJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDSheetsJSON400Response is a constructor method for a DeleteTripsTripIDSheets response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDSheetsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDSheetsJSON404Response is a constructor method for a DeleteTripsTripIDSheets response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDSheetsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDSheetsJSON422Response is a constructor method for a DeleteTripsTripIDSheets response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDSheetsJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDSheetsJSON200Response is a constructor method for a GetTripsTripIDSheets response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDSheetsJSON200Response(body TripSheetResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDSheetsJSON400Response is a constructor method for a GetTripsTripIDSheets response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDSheetsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDSheetsJSON404Response is a constructor method for a GetTripsTripIDSheets response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDSheetsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDSheetsJSON422Response is a constructor method for a GetTripsTripIDSheets response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDSheetsJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDSheetsJSON200Response is a constructor method for a PostTripsTripIDSheets response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDSheetsJSON200Response(body ConnectTripSheetResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDSheetsJSON400Response is a constructor method for a PostTripsTripIDSheets response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDSheetsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDSheetsJSON404Response is a constructor method for a PostTripsTripIDSheets response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDSheetsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDSheetsJSON422Response is a constructor method for a PostTripsTripIDSheets response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDSheetsJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDShoppingJSON200Response is a constructor method for a GetTripsTripIDShopping response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDShoppingJSON200Response(body GetShoppingListResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDShoppingJSON400Response is a constructor method for a GetTripsTripIDShopping response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDShoppingJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDShoppingJSON404Response is a constructor method for a GetTripsTripIDShopping response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDShoppingJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDShoppingJSON422Response is a constructor method for a GetTripsTripIDShopping response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDShoppingJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDShoppingJSON201Response is a constructor method for a PostTripsTripIDShopping response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDShoppingJSON201Response(body CreateShoppingItemResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDShoppingJSON400Response is a constructor method for a PostTripsTripIDShopping response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDShoppingJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDShoppingJSON404Response is a constructor method for a PostTripsTripIDShopping response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDShoppingJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDShoppingJSON422Response is a constructor method for a PostTripsTripIDShopping response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDShoppingJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDShoppingItemIDJSON204Response is a constructor method for a DeleteTripsTripIDShoppingItemID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDShoppingItemIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDShoppingItemIDJSON400Response is a constructor method for a DeleteTripsTripIDShoppingItemID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDShoppingItemIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDShoppingItemIDJSON404Response is a constructor method for a DeleteTripsTripIDShoppingItemID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDShoppingItemIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDShoppingItemIDJSON422Response is a constructor method for a DeleteTripsTripIDShoppingItemID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDShoppingItemIDJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDShoppingItemIDClaimJSON204Response is a constructor method for a DeleteTripsTripIDShoppingItemIDClaim response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDShoppingItemIDClaimJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDShoppingItemIDClaimJSON400Response is a constructor method for a DeleteTripsTripIDShoppingItemIDClaim response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDShoppingItemIDClaimJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDShoppingItemIDClaimJSON404Response is a constructor method for a DeleteTripsTripIDShoppingItemIDClaim response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDShoppingItemIDClaimJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDShoppingItemIDClaimJSON422Response is a constructor method for a DeleteTripsTripIDShoppingItemIDClaim response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDShoppingItemIDClaimJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDShoppingItemIDClaimJSON204Response is a constructor method for a PostTripsTripIDShoppingItemIDClaim response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDShoppingItemIDClaimJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDShoppingItemIDClaimJSON400Response is a constructor method for a PostTripsTripIDShoppingItemIDClaim response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDShoppingItemIDClaimJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDShoppingItemIDClaimJSON404Response is a constructor method for a PostTripsTripIDShoppingItemIDClaim response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDShoppingItemIDClaimJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDShoppingItemIDClaimJSON422Response is a constructor method for a PostTripsTripIDShoppingItemIDClaim response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDShoppingItemIDClaimJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDShoppingItemIDPurchaseJSON201Response is a constructor method for a PostTripsTripIDShoppingItemIDPurchase response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDShoppingItemIDPurchaseJSON201Response(body CreateExpenseResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDShoppingItemIDPurchaseJSON400Response is a constructor method for a PostTripsTripIDShoppingItemIDPurchase response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDShoppingItemIDPurchaseJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDShoppingItemIDPurchaseJSON404Response is a constructor method for a PostTripsTripIDShoppingItemIDPurchase response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDShoppingItemIDPurchaseJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDShoppingItemIDPurchaseJSON422Response is a constructor method for a PostTripsTripIDShoppingItemIDPurchase response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDShoppingItemIDPurchaseJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDSurveyQuestionsJSON200Response is a constructor method for a GetTripsTripIDSurveyQuestions response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDSurveyQuestionsJSON200Response(body GetSurveyQuestionsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDSurveyQuestionsJSON400Response is a constructor method for a GetTripsTripIDSurveyQuestions response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDSurveyQuestionsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDSurveyQuestionsJSON404Response is a constructor method for a GetTripsTripIDSurveyQuestions response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDSurveyQuestionsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDSurveyQuestionsJSON422Response is a constructor method for a GetTripsTripIDSurveyQuestions response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDSurveyQuestionsJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDSurveyQuestionsJSON204Response is a constructor method for a PutTripsTripIDSurveyQuestions response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDSurveyQuestionsJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDSurveyQuestionsJSON400Response is a constructor method for a PutTripsTripIDSurveyQuestions response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDSurveyQuestionsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDSurveyQuestionsJSON404Response is a constructor method for a PutTripsTripIDSurveyQuestions response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDSurveyQuestionsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDSurveyQuestionsJSON422Response is a constructor method for a PutTripsTripIDSurveyQuestions response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDSurveyQuestionsJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDSurveyResultsJSON200Response is a constructor method for a GetTripsTripIDSurveyResults response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDSurveyResultsJSON200Response(body GetSurveyResultsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDSurveyResultsJSON400Response is a constructor method for a GetTripsTripIDSurveyResults response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDSurveyResultsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDSurveyResultsJSON404Response is a constructor method for a GetTripsTripIDSurveyResults response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDSurveyResultsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDSurveyResultsJSON422Response is a constructor method for a GetTripsTripIDSurveyResults response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDSurveyResultsJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDTagsJSON200Response is a constructor method for a GetTripsTripIDTags response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDTagsJSON200Response(body TripTagsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDTagsJSON400Response is a constructor method for a GetTripsTripIDTags response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDTagsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDTagsJSON404Response is a constructor method for a GetTripsTripIDTags response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDTagsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDTagsJSON204Response is a constructor method for a PutTripsTripIDTags response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDTagsJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDTagsJSON400Response is a constructor method for a PutTripsTripIDTags response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDTagsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDTagsJSON404Response is a constructor method for a PutTripsTripIDTags response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDTagsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDTagsJSON422Response is a constructor method for a PutTripsTripIDTags response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDTagsJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDTasksJSON200Response is a constructor method for a GetTripsTripIDTasks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDTasksJSON200Response(body GetTasksResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDTasksJSON400Response is a constructor method for a GetTripsTripIDTasks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDTasksJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDTasksJSON404Response is a constructor method for a GetTripsTripIDTasks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDTasksJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDTasksJSON422Response is a constructor method for a GetTripsTripIDTasks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDTasksJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDTasksJSON201Response is a constructor method for a PostTripsTripIDTasks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDTasksJSON201Response(body CreateTaskResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDTasksJSON400Response is a constructor method for a PostTripsTripIDTasks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDTasksJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDTasksJSON404Response is a constructor method for a PostTripsTripIDTasks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDTasksJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDTasksJSON422Response is a constructor method for a PostTripsTripIDTasks response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDTasksJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDTasksTaskIDJSON204Response is a constructor method for a DeleteTripsTripIDTasksTaskID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDTasksTaskIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDTasksTaskIDJSON400Response is a constructor method for a DeleteTripsTripIDTasksTaskID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDTasksTaskIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDTasksTaskIDJSON404Response is a constructor method for a DeleteTripsTripIDTasksTaskID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDTasksTaskIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// DeleteTripsTripIDTasksTaskIDJSON422Response is a constructor method for a DeleteTripsTripIDTasksTaskID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func DeleteTripsTripIDTasksTaskIDJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDTasksTaskIDJSON204Response is a constructor method for a PutTripsTripIDTasksTaskID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDTasksTaskIDJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDTasksTaskIDJSON400Response is a constructor method for a PutTripsTripIDTasksTaskID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDTasksTaskIDJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDTasksTaskIDJSON404Response is a constructor method for a PutTripsTripIDTasksTaskID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDTasksTaskIDJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PutTripsTripIDTasksTaskIDJSON422Response is a constructor method for a PutTripsTripIDTasksTaskID response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PutTripsTripIDTasksTaskIDJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDTransferOwnershipJSON204Response is a constructor method for a PostTripsTripIDTransferOwnership response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDTransferOwnershipJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDTransferOwnershipJSON400Response is a constructor method for a PostTripsTripIDTransferOwnership response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDTransferOwnershipJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDTransferOwnershipJSON404Response is a constructor method for a PostTripsTripIDTransferOwnership response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDTransferOwnershipJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDTransferOwnershipJSON422Response is a constructor method for a PostTripsTripIDTransferOwnership response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDTransferOwnershipJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDTransitionsJSON200Response is a constructor method for a PostTripsTripIDTransitions response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDTransitionsJSON200Response(body TripTransitionResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDTransitionsJSON400Response is a constructor method for a PostTripsTripIDTransitions response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDTransitionsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDTransitionsJSON404Response is a constructor method for a PostTripsTripIDTransitions response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDTransitionsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDTransitionsJSON409Response is a constructor method for a PostTripsTripIDTransitions response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDTransitionsJSON409Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        409,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDTransitionsJSON422Response is a constructor method for a PostTripsTripIDTransitions response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDTransitionsJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDTransportsJSON200Response is a constructor method for a GetTripsTripIDTransports response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDTransportsJSON200Response(body GetTransportsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDTransportsJSON400Response is a constructor method for a GetTripsTripIDTransports response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDTransportsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDTransportsJSON404Response is a constructor method for a GetTripsTripIDTransports response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDTransportsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDTransportsJSON422Response is a constructor method for a GetTripsTripIDTransports response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDTransportsJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDTransportsJSON201Response is a constructor method for a PostTripsTripIDTransports response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDTransportsJSON201Response(body CreateTransportResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        201,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDTransportsJSON400Response is a constructor method for a PostTripsTripIDTransports response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDTransportsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDTransportsJSON404Response is a constructor method for a PostTripsTripIDTransports response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDTransportsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDTransportsJSON422Response is a constructor method for a PostTripsTripIDTransports response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDTransportsJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDTrashJSON200Response is a constructor method for a GetTripsTripIDTrash response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDTrashJSON200Response(body TrashResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDTrashJSON400Response is a constructor method for a GetTripsTripIDTrash response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDTrashJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDTrashJSON404Response is a constructor method for a GetTripsTripIDTrash response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDTrashJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDTrashJSON422Response is a constructor method for a GetTripsTripIDTrash response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDTrashJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDTrashActivitiesActivityIDRestoreJSON204Response is a constructor method for a PostTripsTripIDTrashActivitiesActivityIDRestore response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDTrashActivitiesActivityIDRestoreJSON204Response(body interface{}) *Response {
+	return &Response{
+		body:        body,
+		Code:        204,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDTrashActivitiesActivityIDRestoreJSON400Response is a constructor method for a PostTripsTripIDTrashActivitiesActivityIDRestore response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDTrashActivitiesActivityIDRestoreJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDTrashActivitiesActivityIDRestoreJSON404Response is a constructor method for a PostTripsTripIDTrashActivitiesActivityIDRestore response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDTrashActivitiesActivityIDRestoreJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDTrashActivitiesActivityIDRestoreJSON422Response is a constructor method for a PostTripsTripIDTrashActivitiesActivityIDRestore response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDTrashActivitiesActivityIDRestoreJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDTrashLinksLinkIDRestoreJSON204Response is a constructor method for a PostTripsTripIDTrashLinksLinkIDRestore response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDTrashLinksLinkIDRestoreJSON204Response(body interface{}) *Response {
 	return &Response{
 		body:        body,
 		Code:        204,
@@ -360,153 +7794,4481 @@ func GetTripsTripIDConfirmJSON204Response(body interface{}) *Response {
 	}
 }
 
-// GetTripsTripIDConfirmJSON400Response is a constructor method for a GetTripsTripIDConfirm response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDConfirmJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
-}
+// PostTripsTripIDTrashLinksLinkIDRestoreJSON400Response is a constructor method for a PostTripsTripIDTrashLinksLinkIDRestore response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDTrashLinksLinkIDRestoreJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDTrashLinksLinkIDRestoreJSON404Response is a constructor method for a PostTripsTripIDTrashLinksLinkIDRestore response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDTrashLinksLinkIDRestoreJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// PostTripsTripIDTrashLinksLinkIDRestoreJSON422Response is a constructor method for a PostTripsTripIDTrashLinksLinkIDRestore response.
+// A *Response is returned with the configured status code and content type from the spec.
+func PostTripsTripIDTrashLinksLinkIDRestoreJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDTriggersActivitiesJSON200Response is a constructor method for a GetTripsTripIDTriggersActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDTriggersActivitiesJSON200Response(body NewActivitiesResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDTriggersActivitiesJSON400Response is a constructor method for a GetTripsTripIDTriggersActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDTriggersActivitiesJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDTriggersActivitiesJSON404Response is a constructor method for a GetTripsTripIDTriggersActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDTriggersActivitiesJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDTriggersActivitiesJSON422Response is a constructor method for a GetTripsTripIDTriggersActivities response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDTriggersActivitiesJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDTriggersParticipantsJSON200Response is a constructor method for a GetTripsTripIDTriggersParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDTriggersParticipantsJSON200Response(body NewParticipantsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDTriggersParticipantsJSON400Response is a constructor method for a GetTripsTripIDTriggersParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDTriggersParticipantsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDTriggersParticipantsJSON404Response is a constructor method for a GetTripsTripIDTriggersParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDTriggersParticipantsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDTriggersParticipantsJSON422Response is a constructor method for a GetTripsTripIDTriggersParticipants response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDTriggersParticipantsJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDWarningsJSON200Response is a constructor method for a GetTripsTripIDWarnings response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDWarningsJSON200Response(body GetWarningsResponse) *Response {
+	return &Response{
+		body:        body,
+		Code:        200,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDWarningsJSON400Response is a constructor method for a GetTripsTripIDWarnings response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDWarningsJSON400Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        400,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDWarningsJSON404Response is a constructor method for a GetTripsTripIDWarnings response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDWarningsJSON404Response(body Error) *Response {
+	return &Response{
+		body:        body,
+		Code:        404,
+		contentType: "application/json",
+	}
+}
+
+// GetTripsTripIDWarningsJSON422Response is a constructor method for a GetTripsTripIDWarnings response.
+// A *Response is returned with the configured status code and content type from the spec.
+func GetTripsTripIDWarningsJSON422Response(body ValidationError) *Response {
+	return &Response{
+		body:        body,
+		Code:        422,
+		contentType: "application/json",
+	}
+}
+
+// Getter for additional properties for PresignAttachmentResponse_Headers. Returns the specified
+// element and whether it was found
+func (a PresignAttachmentResponse_Headers) Get(fieldName string) (value string, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for PresignAttachmentResponse_Headers
+func (a *PresignAttachmentResponse_Headers) Set(fieldName string, value string) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]string)
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for PresignAttachmentResponse_Headers to handle AdditionalProperties
+func (a *PresignAttachmentResponse_Headers) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]string)
+		for fieldName, fieldBuf := range object {
+			var fieldVal string
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for PresignAttachmentResponse_Headers to handle AdditionalProperties
+func (a PresignAttachmentResponse_Headers) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// Get the shared trips waiting for review.
+	// (GET /admin/moderation)
+	GetAdminModeration(w http.ResponseWriter, r *http.Request) *Response
+	// Approve a shared trip.
+	// (POST /admin/moderation/{tripId}/approve)
+	PostAdminModerationTripIDApprove(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Reject a shared trip.
+	// (POST /admin/moderation/{tripId}/reject)
+	PostAdminModerationTripIDReject(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get instance statistics.
+	// (GET /admin/stats)
+	GetAdminStats(w http.ResponseWriter, r *http.Request) *Response
+	// Get a date poll to answer.
+	// (GET /date-poll/{token})
+	GetDatePollToken(w http.ResponseWriter, r *http.Request, token string) *Response
+	// Answer a date poll.
+	// (PUT /date-poll/{token})
+	PutDatePollToken(w http.ResponseWriter, r *http.Request, token string) *Response
+	// Get a shared trip itinerary.
+	// (GET /embed/trips/{shareToken})
+	GetEmbedTripsShareToken(w http.ResponseWriter, r *http.Request, shareToken string) *Response
+	// Get a shared trip itinerary widget.
+	// (GET /embed/trips/{shareToken}/widget)
+	GetEmbedTripsShareTokenWidget(w http.ResponseWriter, r *http.Request, shareToken string) *Response
+	// Get the instance key.
+	// (GET /federation/key)
+	GetFederationKey(w http.ResponseWriter, r *http.Request) *Response
+	// List the integration triggers and actions.
+	// (GET /integrations)
+	GetIntegrations(w http.ResponseWriter, r *http.Request) *Response
+	// Report a bounced email.
+	// (POST /mail/bounces)
+	PostMailBounces(w http.ResponseWriter, r *http.Request) *Response
+	// Get the oEmbed of a shared trip.
+	// (GET /oembed)
+	GetOembed(w http.ResponseWriter, r *http.Request, params GetOembedParams) *Response
+	// Confirm a trip owner email change.
+	// (PATCH /owner-email-changes/{token}/confirm)
+	PatchOwnerEmailChangesTokenConfirm(w http.ResponseWriter, r *http.Request, token string) *Response
+	// Accept a trip ownership transfer.
+	// (PATCH /ownership-transfers/{token}/accept)
+	PatchOwnershipTransfersTokenAccept(w http.ResponseWriter, r *http.Request, token string) *Response
+	// Add a companion to a participant.
+	// (POST /participants/{participantId}/companions)
+	PostParticipantsParticipantIDCompanions(w http.ResponseWriter, r *http.Request, participantID string) *Response
+	// Remove a companion from a participant.
+	// (DELETE /participants/{participantId}/companions/{companionId})
+	DeleteParticipantsParticipantIDCompanionsCompanionID(w http.ResponseWriter, r *http.Request, participantID string, companionID string) *Response
+	// Confirms a participant on a trip.
+	// (PATCH /participants/{participantId}/confirm)
+	PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request, participantID string) *Response
+	// Declines a participant invitation.
+	// (PATCH /participants/{participantId}/decline)
+	PatchParticipantsParticipantIDDecline(w http.ResponseWriter, r *http.Request, participantID string) *Response
+	// Get a participant dietary and accessibility needs.
+	// (GET /participants/{participantId}/needs)
+	GetParticipantsParticipantIDNeeds(w http.ResponseWriter, r *http.Request, participantID string) *Response
+	// Update a participant dietary and accessibility needs.
+	// (PUT /participants/{participantId}/needs)
+	PutParticipantsParticipantIDNeeds(w http.ResponseWriter, r *http.Request, participantID string) *Response
+	// Get the update feed of a shared trip.
+	// (GET /shared/{shareToken}/feed.atom)
+	GetSharedShareTokenFeedAtom(w http.ResponseWriter, r *http.Request, shareToken string) *Response
+	// Get the schema.org markup of a shared trip.
+	// (GET /shared/{shareToken}/jsonld)
+	GetSharedShareTokenJsonld(w http.ResponseWriter, r *http.Request, shareToken string) *Response
+	// Report a shared trip.
+	// (POST /shared/{shareToken}/reports)
+	PostSharedShareTokenReports(w http.ResponseWriter, r *http.Request, shareToken string) *Response
+	// Finish connecting a trip to Google Sheets.
+	// (GET /sheets/callback)
+	GetSheetsCallback(w http.ResponseWriter, r *http.Request, params GetSheetsCallbackParams) *Response
+	// Get a trip survey to answer.
+	// (GET /surveys/{token})
+	GetSurveysToken(w http.ResponseWriter, r *http.Request, token string) *Response
+	// Answer a trip survey.
+	// (PUT /surveys/{token})
+	PutSurveysToken(w http.ResponseWriter, r *http.Request, token string) *Response
+	// Create a new trip
+	// (POST /trips)
+	PostTrips(w http.ResponseWriter, r *http.Request, params PostTripsParams) *Response
+	// Import a trip bundle.
+	// (POST /trips/import)
+	PostTripsImport(w http.ResponseWriter, r *http.Request) *Response
+	// Get a trip details.
+	// (GET /trips/{tripId})
+	GetTripsTripID(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDParams) *Response
+	// Update a trip.
+	// (PUT /trips/{tripId})
+	PutTripsTripID(w http.ResponseWriter, r *http.Request, tripID string, params PutTripsTripIDParams) *Response
+	// Get a trip activities.
+	// (GET /trips/{tripId}/activities)
+	GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDActivitiesParams) *Response
+	// Create a trip activity.
+	// (POST /trips/{tripId}/activities)
+	PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Draft the trip itinerary.
+	// (POST /trips/{tripId}/activities/draft)
+	PostTripsTripIDActivitiesDraft(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Quick add an activity.
+	// (POST /trips/{tripId}/activities/quick-add)
+	PostTripsTripIDActivitiesQuickAdd(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Preview shifting activities to a new start.
+	// (GET /trips/{tripId}/activities/shift-preview)
+	GetTripsTripIDActivitiesShiftPreview(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDActivitiesShiftPreviewParams) *Response
+	// Suggest times for an activity.
+	// (GET /trips/{tripId}/activities/suggested-times)
+	GetTripsTripIDActivitiesSuggestedTimes(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDActivitiesSuggestedTimesParams) *Response
+	// Delete an activity, moving it to the trip trash.
+	// (DELETE /trips/{tripId}/activities/{activityId})
+	DeleteTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request, tripID string, activityID string) *Response
+	// Approve an activity over budget.
+	// (PATCH /trips/{tripId}/activities/{activityId}/approve)
+	PatchTripsTripIDActivitiesActivityIDApprove(w http.ResponseWriter, r *http.Request, tripID string, activityID string) *Response
+	// Unassign an activity organizer.
+	// (DELETE /trips/{tripId}/activities/{activityId}/organizer)
+	DeleteTripsTripIDActivitiesActivityIDOrganizer(w http.ResponseWriter, r *http.Request, tripID string, activityID string) *Response
+	// Assign an activity organizer.
+	// (PUT /trips/{tripId}/activities/{activityId}/organizer)
+	PutTripsTripIDActivitiesActivityIDOrganizer(w http.ResponseWriter, r *http.Request, tripID string, activityID string) *Response
+	// Pay for an activity.
+	// (POST /trips/{tripId}/activities/{activityId}/pay)
+	PostTripsTripIDActivitiesActivityIDPay(w http.ResponseWriter, r *http.Request, tripID string, activityID string) *Response
+	// Reject an activity over budget.
+	// (PATCH /trips/{tripId}/activities/{activityId}/reject)
+	PatchTripsTripIDActivitiesActivityIDReject(w http.ResponseWriter, r *http.Request, tripID string, activityID string) *Response
+	// Suggest a shorter order for a day activities.
+	// (GET /trips/{tripId}/activities/{date}/optimize)
+	GetTripsTripIDActivitiesDateOptimize(w http.ResponseWriter, r *http.Request, tripID string, date openapi_types.Date) *Response
+	// Accept a suggested order for a day activities.
+	// (POST /trips/{tripId}/activities/{date}/optimize)
+	PostTripsTripIDActivitiesDateOptimize(w http.ResponseWriter, r *http.Request, tripID string, date openapi_types.Date) *Response
+	// Get the route between a day activities.
+	// (GET /trips/{tripId}/activities/{date}/route)
+	GetTripsTripIDActivitiesDateRoute(w http.ResponseWriter, r *http.Request, tripID string, date openapi_types.Date) *Response
+	// Start uploading a trip attachment.
+	// (POST /trips/{tripId}/attachments/presign)
+	PostTripsTripIDAttachmentsPresign(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get a trip attachment.
+	// (GET /trips/{tripId}/attachments/{attachmentId})
+	GetTripsTripIDAttachmentsAttachmentID(w http.ResponseWriter, r *http.Request, tripID string, attachmentID string) *Response
+	// Complete a trip attachment upload.
+	// (POST /trips/{tripId}/attachments/{attachmentId}/complete)
+	PostTripsTripIDAttachmentsAttachmentIDComplete(w http.ResponseWriter, r *http.Request, tripID string, attachmentID string) *Response
+	// Get a trip budget.
+	// (GET /trips/{tripId}/budget)
+	GetTripsTripIDBudget(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Export a trip bundle.
+	// (GET /trips/{tripId}/bundle)
+	GetTripsTripIDBundle(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get a trip checklist.
+	// (GET /trips/{tripId}/checklist)
+	GetTripsTripIDChecklist(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Create a trip checklist item.
+	// (POST /trips/{tripId}/checklist)
+	PostTripsTripIDChecklist(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Generate a starter packing checklist.
+	// (POST /trips/{tripId}/checklist/generate)
+	PostTripsTripIDChecklistGenerate(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Delete a trip checklist item.
+	// (DELETE /trips/{tripId}/checklist/{itemId})
+	DeleteTripsTripIDChecklistItemID(w http.ResponseWriter, r *http.Request, tripID string, itemID string) *Response
+	// Update a trip checklist item.
+	// (PUT /trips/{tripId}/checklist/{itemId})
+	PutTripsTripIDChecklistItemID(w http.ResponseWriter, r *http.Request, tripID string, itemID string) *Response
+	// Confirm a trip and send e-mail invitations.
+	// (GET /trips/{tripId}/confirm)
+	GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get a summary of the trip confirmations.
+	// (GET /trips/{tripId}/confirmations/summary)
+	GetTripsTripIDConfirmationsSummary(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get a trip schedule conflicts.
+	// (GET /trips/{tripId}/conflicts)
+	GetTripsTripIDConflicts(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get the date poll results.
+	// (GET /trips/{tripId}/date-poll)
+	GetTripsTripIDDatePoll(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Propose dates for a trip.
+	// (POST /trips/{tripId}/date-poll)
+	PostTripsTripIDDatePoll(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Pick the winning dates of a trip.
+	// (POST /trips/{tripId}/date-poll/{optionId}/pick)
+	PostTripsTripIDDatePollOptionIDPick(w http.ResponseWriter, r *http.Request, tripID string, optionID string) *Response
+	// Get a trip expenses.
+	// (GET /trips/{tripId}/expenses)
+	GetTripsTripIDExpenses(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDExpensesParams) *Response
+	// Create a trip expense.
+	// (POST /trips/{tripId}/expenses)
+	PostTripsTripIDExpenses(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get a trip spending breakdown.
+	// (GET /trips/{tripId}/expenses/breakdown)
+	GetTripsTripIDExpensesBreakdown(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Export the trip expenses with their receipts.
+	// (GET /trips/{tripId}/expenses/export.zip)
+	GetTripsTripIDExpensesExportZip(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get how a trip expenses settle up.
+	// (GET /trips/{tripId}/expenses/settlement)
+	GetTripsTripIDExpensesSettlement(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get a trip expense receipt image.
+	// (GET /trips/{tripId}/expenses/{expenseId}/receipt)
+	GetTripsTripIDExpensesExpenseIDReceipt(w http.ResponseWriter, r *http.Request, tripID string, expenseID string) *Response
+	// Export a trip itinerary as markdown.
+	// (GET /trips/{tripId}/export.md)
+	GetTripsTripIDExportMd(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get a trip schedule free time.
+	// (GET /trips/{tripId}/gaps)
+	GetTripsTripIDGaps(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDGapsParams) *Response
+	// Get a trip participant groups.
+	// (GET /trips/{tripId}/groups)
+	GetTripsTripIDGroups(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Create a trip participant group.
+	// (POST /trips/{tripId}/groups)
+	PostTripsTripIDGroups(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Delete a trip participant group.
+	// (DELETE /trips/{tripId}/groups/{groupId})
+	DeleteTripsTripIDGroupsGroupID(w http.ResponseWriter, r *http.Request, tripID string, groupID string) *Response
+	// Update a trip participant group.
+	// (PUT /trips/{tripId}/groups/{groupId})
+	PutTripsTripIDGroupsGroupID(w http.ResponseWriter, r *http.Request, tripID string, groupID string) *Response
+	// Invite someone to the trip.
+	// (POST /trips/{tripId}/invites)
+	PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Import invitations from a CSV.
+	// (POST /trips/{tripId}/invites/import)
+	PostTripsTripIDInvitesImport(w http.ResponseWriter, r *http.Request, tripID string, params PostTripsTripIDInvitesImportParams) *Response
+	// Get a summary of the trip invites.
+	// (GET /trips/{tripId}/invites/summary)
+	GetTripsTripIDInvitesSummary(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get a trip links.
+	// (GET /trips/{tripId}/links)
+	GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDLinksParams) *Response
+	// Create a trip link.
+	// (POST /trips/{tripId}/links)
+	PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Add many trip links at once.
+	// (POST /trips/{tripId}/links/bulk)
+	PostTripsTripIDLinksBulk(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Delete a link, moving it to the trip trash.
+	// (DELETE /trips/{tripId}/links/{linkId})
+	DeleteTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request, tripID string, linkID string) *Response
+	// Get a trip lodgings.
+	// (GET /trips/{tripId}/lodgings)
+	GetTripsTripIDLodgings(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Create a trip lodging.
+	// (POST /trips/{tripId}/lodgings)
+	PostTripsTripIDLodgings(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Approve a lodging over budget.
+	// (PATCH /trips/{tripId}/lodgings/{lodgingId}/approve)
+	PatchTripsTripIDLodgingsLodgingIDApprove(w http.ResponseWriter, r *http.Request, tripID string, lodgingID string) *Response
+	// Reject a lodging over budget.
+	// (PATCH /trips/{tripId}/lodgings/{lodgingId}/reject)
+	PatchTripsTripIDLodgingsLodgingIDReject(w http.ResponseWriter, r *http.Request, tripID string, lodgingID string) *Response
+	// Export a lodging rooming list.
+	// (GET /trips/{tripId}/lodgings/{lodgingId}/rooming-list)
+	GetTripsTripIDLodgingsLodgingIDRoomingList(w http.ResponseWriter, r *http.Request, tripID string, lodgingID string) *Response
+	// Get a lodging rooms.
+	// (GET /trips/{tripId}/lodgings/{lodgingId}/rooms)
+	GetTripsTripIDLodgingsLodgingIDRooms(w http.ResponseWriter, r *http.Request, tripID string, lodgingID string) *Response
+	// Create a lodging room.
+	// (POST /trips/{tripId}/lodgings/{lodgingId}/rooms)
+	PostTripsTripIDLodgingsLodgingIDRooms(w http.ResponseWriter, r *http.Request, tripID string, lodgingID string) *Response
+	// Delete a lodging room.
+	// (DELETE /trips/{tripId}/lodgings/{lodgingId}/rooms/{roomId})
+	DeleteTripsTripIDLodgingsLodgingIDRoomsRoomID(w http.ResponseWriter, r *http.Request, tripID string, lodgingID string, roomID string) *Response
+	// Assign participants to a lodging room.
+	// (PUT /trips/{tripId}/lodgings/{lodgingId}/rooms/{roomId}/participants)
+	PutTripsTripIDLodgingsLodgingIDRoomsRoomIDParticipants(w http.ResponseWriter, r *http.Request, tripID string, lodgingID string, roomID string) *Response
+	// Merge another trip into this one.
+	// (POST /trips/{tripId}/merge-from/{sourceId})
+	PostTripsTripIDMergeFromSourceID(w http.ResponseWriter, r *http.Request, tripID string, sourceID string) *Response
+	// Get a trip participants needs summary.
+	// (GET /trips/{tripId}/needs-summary)
+	GetTripsTripIDNeedsSummary(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Change the trip owner email.
+	// (POST /trips/{tripId}/owner-email)
+	PostTripsTripIDOwnerEmail(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Add a trip owner.
+	// (POST /trips/{tripId}/owners)
+	PostTripsTripIDOwners(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Remove a trip owner.
+	// (DELETE /trips/{tripId}/owners/{participantId})
+	DeleteTripsTripIDOwnersParticipantID(w http.ResponseWriter, r *http.Request, tripID string, participantID string) *Response
+	// Get a trip participants.
+	// (GET /trips/{tripId}/participants)
+	GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDParticipantsParams) *Response
+	// Confirm trip participants on their behalf.
+	// (POST /trips/{tripId}/participants/confirm-bulk)
+	PostTripsTripIDParticipantsConfirmBulk(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Correct a participant email.
+	// (PATCH /trips/{tripId}/participants/{participantId}/email)
+	PatchTripsTripIDParticipantsParticipantIDEmail(w http.ResponseWriter, r *http.Request, tripID string, participantID string) *Response
+	// Get a trip planning progress.
+	// (GET /trips/{tripId}/planning-status)
+	GetTripsTripIDPlanningStatus(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Print a trip itinerary.
+	// (GET /trips/{tripId}/print)
+	GetTripsTripIDPrint(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Upload a receipt and read it.
+	// (POST /trips/{tripId}/receipts)
+	PostTripsTripIDReceipts(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Confirm a receipt as a trip expense.
+	// (POST /trips/{tripId}/receipts/{receiptId}/confirm)
+	PostTripsTripIDReceiptsReceiptIDConfirm(w http.ResponseWriter, r *http.Request, tripID string, receiptID string) *Response
+	// Get a trip rides.
+	// (GET /trips/{tripId}/rides)
+	GetTripsTripIDRides(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Offer a ride.
+	// (POST /trips/{tripId}/rides)
+	PostTripsTripIDRides(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get the travelers without a ride.
+	// (GET /trips/{tripId}/rides/unassigned)
+	GetTripsTripIDRidesUnassigned(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Cancel a ride.
+	// (DELETE /trips/{tripId}/rides/{rideId})
+	DeleteTripsTripIDRidesRideID(w http.ResponseWriter, r *http.Request, tripID string, rideID string) *Response
+	// Claim a seat in a ride.
+	// (POST /trips/{tripId}/rides/{rideId}/passengers)
+	PostTripsTripIDRidesRideIDPassengers(w http.ResponseWriter, r *http.Request, tripID string, rideID string) *Response
+	// Give up a seat in a ride.
+	// (DELETE /trips/{tripId}/rides/{rideId}/passengers/{participantId})
+	DeleteTripsTripIDRidesRideIDPassengersParticipantID(w http.ResponseWriter, r *http.Request, tripID string, rideID string, participantID string) *Response
+	// Get a trip settings.
+	// (GET /trips/{tripId}/settings)
+	GetTripsTripIDSettings(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Change a trip settings.
+	// (PATCH /trips/{tripId}/settings)
+	PatchTripsTripIDSettings(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Stop sharing a trip itinerary.
+	// (DELETE /trips/{tripId}/share)
+	DeleteTripsTripIDShare(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Share a trip itinerary.
+	// (POST /trips/{tripId}/share)
+	PostTripsTripIDShare(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Disconnect a trip from Google Sheets.
+	// (DELETE /trips/{tripId}/sheets)
+	DeleteTripsTripIDSheets(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get the Google Sheet of a trip.
+	// (GET /trips/{tripId}/sheets)
+	GetTripsTripIDSheets(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Connect a trip to Google Sheets.
+	// (POST /trips/{tripId}/sheets)
+	PostTripsTripIDSheets(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get a trip shopping list.
+	// (GET /trips/{tripId}/shopping)
+	GetTripsTripIDShopping(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Add an item to a trip shopping list.
+	// (POST /trips/{tripId}/shopping)
+	PostTripsTripIDShopping(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Remove an item from a trip shopping list.
+	// (DELETE /trips/{tripId}/shopping/{itemId})
+	DeleteTripsTripIDShoppingItemID(w http.ResponseWriter, r *http.Request, tripID string, itemID string) *Response
+	// Unclaim a shopping item.
+	// (DELETE /trips/{tripId}/shopping/{itemId}/claim)
+	DeleteTripsTripIDShoppingItemIDClaim(w http.ResponseWriter, r *http.Request, tripID string, itemID string) *Response
+	// Claim a shopping item.
+	// (POST /trips/{tripId}/shopping/{itemId}/claim)
+	PostTripsTripIDShoppingItemIDClaim(w http.ResponseWriter, r *http.Request, tripID string, itemID string) *Response
+	// Mark a shopping item purchased.
+	// (POST /trips/{tripId}/shopping/{itemId}/purchase)
+	PostTripsTripIDShoppingItemIDPurchase(w http.ResponseWriter, r *http.Request, tripID string, itemID string) *Response
+	// Get the questions of a trip survey.
+	// (GET /trips/{tripId}/survey/questions)
+	GetTripsTripIDSurveyQuestions(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Set the questions of a trip survey.
+	// (PUT /trips/{tripId}/survey/questions)
+	PutTripsTripIDSurveyQuestions(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get the results of a trip survey.
+	// (GET /trips/{tripId}/survey/results)
+	GetTripsTripIDSurveyResults(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get the tags of a trip.
+	// (GET /trips/{tripId}/tags)
+	GetTripsTripIDTags(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Set the tags of a trip.
+	// (PUT /trips/{tripId}/tags)
+	PutTripsTripIDTags(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get a trip tasks.
+	// (GET /trips/{tripId}/tasks)
+	GetTripsTripIDTasks(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Create a trip task.
+	// (POST /trips/{tripId}/tasks)
+	PostTripsTripIDTasks(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Delete a trip task.
+	// (DELETE /trips/{tripId}/tasks/{taskId})
+	DeleteTripsTripIDTasksTaskID(w http.ResponseWriter, r *http.Request, tripID string, taskID string) *Response
+	// Update a trip task.
+	// (PUT /trips/{tripId}/tasks/{taskId})
+	PutTripsTripIDTasksTaskID(w http.ResponseWriter, r *http.Request, tripID string, taskID string) *Response
+	// Transfer a trip to another participant.
+	// (POST /trips/{tripId}/transfer-ownership)
+	PostTripsTripIDTransferOwnership(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Move a trip to another status.
+	// (POST /trips/{tripId}/transitions)
+	PostTripsTripIDTransitions(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Get a trip transports.
+	// (GET /trips/{tripId}/transports)
+	GetTripsTripIDTransports(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Create a trip transport.
+	// (POST /trips/{tripId}/transports)
+	PostTripsTripIDTransports(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// List the activities and links deleted from a trip.
+	// (GET /trips/{tripId}/trash)
+	GetTripsTripIDTrash(w http.ResponseWriter, r *http.Request, tripID string) *Response
+	// Restore a deleted activity.
+	// (POST /trips/{tripId}/trash/activities/{activityId}/restore)
+	PostTripsTripIDTrashActivitiesActivityIDRestore(w http.ResponseWriter, r *http.Request, tripID string, activityID string) *Response
+	// Restore a deleted link.
+	// (POST /trips/{tripId}/trash/links/{linkId}/restore)
+	PostTripsTripIDTrashLinksLinkIDRestore(w http.ResponseWriter, r *http.Request, tripID string, linkID string) *Response
+	// List new trip activities.
+	// (GET /trips/{tripId}/triggers/activities)
+	GetTripsTripIDTriggersActivities(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDTriggersActivitiesParams) *Response
+	// List new trip participants.
+	// (GET /trips/{tripId}/triggers/participants)
+	GetTripsTripIDTriggersParticipants(w http.ResponseWriter, r *http.Request, tripID string, params GetTripsTripIDTriggersParticipantsParams) *Response
+	// Get a trip schedule weather warnings.
+	// (GET /trips/{tripId}/warnings)
+	GetTripsTripIDWarnings(w http.ResponseWriter, r *http.Request, tripID string) *Response
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler          ServerInterface
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// GetAdminModeration operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminModeration(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetAdminModeration(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostAdminModerationTripIDApprove operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminModerationTripIDApprove(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostAdminModerationTripIDApprove(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostAdminModerationTripIDReject operation middleware
+func (siw *ServerInterfaceWrapper) PostAdminModerationTripIDReject(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostAdminModerationTripIDReject(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetAdminStats operation middleware
+func (siw *ServerInterfaceWrapper) GetAdminStats(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetAdminStats(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetDatePollToken operation middleware
+func (siw *ServerInterfaceWrapper) GetDatePollToken(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	if err := runtime.BindStyledParameter("simple", false, "token", chi.URLParam(r, "token"), &token); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "token"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetDatePollToken(w, r, token)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutDatePollToken operation middleware
+func (siw *ServerInterfaceWrapper) PutDatePollToken(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	if err := runtime.BindStyledParameter("simple", false, "token", chi.URLParam(r, "token"), &token); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "token"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutDatePollToken(w, r, token)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetEmbedTripsShareToken operation middleware
+func (siw *ServerInterfaceWrapper) GetEmbedTripsShareToken(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "shareToken" -------------
+	var shareToken string
+
+	if err := runtime.BindStyledParameter("simple", false, "shareToken", chi.URLParam(r, "shareToken"), &shareToken); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "shareToken"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetEmbedTripsShareToken(w, r, shareToken)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetEmbedTripsShareTokenWidget operation middleware
+func (siw *ServerInterfaceWrapper) GetEmbedTripsShareTokenWidget(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "shareToken" -------------
+	var shareToken string
+
+	if err := runtime.BindStyledParameter("simple", false, "shareToken", chi.URLParam(r, "shareToken"), &shareToken); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "shareToken"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetEmbedTripsShareTokenWidget(w, r, shareToken)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetFederationKey operation middleware
+func (siw *ServerInterfaceWrapper) GetFederationKey(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetFederationKey(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetIntegrations operation middleware
+func (siw *ServerInterfaceWrapper) GetIntegrations(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetIntegrations(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostMailBounces operation middleware
+func (siw *ServerInterfaceWrapper) PostMailBounces(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostMailBounces(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetOembed operation middleware
+func (siw *ServerInterfaceWrapper) GetOembed(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOembedParams
+
+	// ------------- Required query parameter "url" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "url", r.URL.Query(), &params.URL); err != nil {
+		err = fmt.Errorf("invalid format for parameter url: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "url"})
+		return
+	}
+
+	// ------------- Optional query parameter "format" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "format", r.URL.Query(), &params.Format); err != nil {
+		err = fmt.Errorf("invalid format for parameter format: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "format"})
+		return
+	}
+
+	// ------------- Optional query parameter "maxwidth" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "maxwidth", r.URL.Query(), &params.Maxwidth); err != nil {
+		err = fmt.Errorf("invalid format for parameter maxwidth: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "maxwidth"})
+		return
+	}
+
+	// ------------- Optional query parameter "maxheight" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "maxheight", r.URL.Query(), &params.Maxheight); err != nil {
+		err = fmt.Errorf("invalid format for parameter maxheight: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "maxheight"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetOembed(w, r, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchOwnerEmailChangesTokenConfirm operation middleware
+func (siw *ServerInterfaceWrapper) PatchOwnerEmailChangesTokenConfirm(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	if err := runtime.BindStyledParameter("simple", false, "token", chi.URLParam(r, "token"), &token); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "token"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchOwnerEmailChangesTokenConfirm(w, r, token)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchOwnershipTransfersTokenAccept operation middleware
+func (siw *ServerInterfaceWrapper) PatchOwnershipTransfersTokenAccept(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	if err := runtime.BindStyledParameter("simple", false, "token", chi.URLParam(r, "token"), &token); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "token"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchOwnershipTransfersTokenAccept(w, r, token)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostParticipantsParticipantIDCompanions operation middleware
+func (siw *ServerInterfaceWrapper) PostParticipantsParticipantIDCompanions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostParticipantsParticipantIDCompanions(w, r, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteParticipantsParticipantIDCompanionsCompanionID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteParticipantsParticipantIDCompanionsCompanionID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	// ------------- Path parameter "companionId" -------------
+	var companionID string
+
+	if err := runtime.BindStyledParameter("simple", false, "companionId", chi.URLParam(r, "companionId"), &companionID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "companionId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteParticipantsParticipantIDCompanionsCompanionID(w, r, participantID, companionID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchParticipantsParticipantIDConfirm operation middleware
+func (siw *ServerInterfaceWrapper) PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchParticipantsParticipantIDConfirm(w, r, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchParticipantsParticipantIDDecline operation middleware
+func (siw *ServerInterfaceWrapper) PatchParticipantsParticipantIDDecline(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchParticipantsParticipantIDDecline(w, r, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetParticipantsParticipantIDNeeds operation middleware
+func (siw *ServerInterfaceWrapper) GetParticipantsParticipantIDNeeds(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetParticipantsParticipantIDNeeds(w, r, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutParticipantsParticipantIDNeeds operation middleware
+func (siw *ServerInterfaceWrapper) PutParticipantsParticipantIDNeeds(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutParticipantsParticipantIDNeeds(w, r, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetSharedShareTokenFeedAtom operation middleware
+func (siw *ServerInterfaceWrapper) GetSharedShareTokenFeedAtom(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "shareToken" -------------
+	var shareToken string
+
+	if err := runtime.BindStyledParameter("simple", false, "shareToken", chi.URLParam(r, "shareToken"), &shareToken); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "shareToken"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetSharedShareTokenFeedAtom(w, r, shareToken)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetSharedShareTokenJsonld operation middleware
+func (siw *ServerInterfaceWrapper) GetSharedShareTokenJsonld(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "shareToken" -------------
+	var shareToken string
+
+	if err := runtime.BindStyledParameter("simple", false, "shareToken", chi.URLParam(r, "shareToken"), &shareToken); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "shareToken"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetSharedShareTokenJsonld(w, r, shareToken)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostSharedShareTokenReports operation middleware
+func (siw *ServerInterfaceWrapper) PostSharedShareTokenReports(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "shareToken" -------------
+	var shareToken string
+
+	if err := runtime.BindStyledParameter("simple", false, "shareToken", chi.URLParam(r, "shareToken"), &shareToken); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "shareToken"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostSharedShareTokenReports(w, r, shareToken)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetSheetsCallback operation middleware
+func (siw *ServerInterfaceWrapper) GetSheetsCallback(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSheetsCallbackParams
+
+	// ------------- Required query parameter "code" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "code", r.URL.Query(), &params.Code); err != nil {
+		err = fmt.Errorf("invalid format for parameter code: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "code"})
+		return
+	}
+
+	// ------------- Required query parameter "state" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "state", r.URL.Query(), &params.State); err != nil {
+		err = fmt.Errorf("invalid format for parameter state: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "state"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetSheetsCallback(w, r, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetSurveysToken operation middleware
+func (siw *ServerInterfaceWrapper) GetSurveysToken(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	if err := runtime.BindStyledParameter("simple", false, "token", chi.URLParam(r, "token"), &token); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "token"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetSurveysToken(w, r, token)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutSurveysToken operation middleware
+func (siw *ServerInterfaceWrapper) PutSurveysToken(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "token" -------------
+	var token string
+
+	if err := runtime.BindStyledParameter("simple", false, "token", chi.URLParam(r, "token"), &token); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "token"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutSurveysToken(w, r, token)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTrips operation middleware
+func (siw *ServerInterfaceWrapper) PostTrips(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTripsParams
+
+	// ------------- Optional query parameter "force" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "force", r.URL.Query(), &params.Force); err != nil {
+		err = fmt.Errorf("invalid format for parameter force: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "force"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTrips(w, r, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsImport operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsImport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsImport(w, r)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripID operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDParams
+
+	// ------------- Optional query parameter "fields" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "fields", r.URL.Query(), &params.Fields); err != nil {
+		err = fmt.Errorf("invalid format for parameter fields: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "fields"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripID(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutTripsTripIDParams
+
+	// ------------- Optional query parameter "dry_run" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "dry_run", r.URL.Query(), &params.DryRun); err != nil {
+		err = fmt.Errorf("invalid format for parameter dry_run: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "dry_run"})
+		return
+	}
+
+	// ------------- Optional query parameter "notify" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "notify", r.URL.Query(), &params.Notify); err != nil {
+		err = fmt.Errorf("invalid format for parameter notify: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "notify"})
+		return
+	}
+
+	// ------------- Optional query parameter "shift_activities" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "shift_activities", r.URL.Query(), &params.ShiftActivities); err != nil {
+		err = fmt.Errorf("invalid format for parameter shift_activities: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "shift_activities"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripID(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDActivities operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDActivitiesParams
+
+	// ------------- Optional query parameter "organizer_id" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "organizer_id", r.URL.Query(), &params.OrganizerID); err != nil {
+		err = fmt.Errorf("invalid format for parameter organizer_id: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "organizer_id"})
+		return
+	}
+
+	// ------------- Optional query parameter "fields" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "fields", r.URL.Query(), &params.Fields); err != nil {
+		err = fmt.Errorf("invalid format for parameter fields: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "fields"})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort); err != nil {
+		err = fmt.Errorf("invalid format for parameter sort: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "sort"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDActivities(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDActivities operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDActivities(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDActivitiesDraft operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDActivitiesDraft(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDActivitiesDraft(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDActivitiesQuickAdd operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDActivitiesQuickAdd(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDActivitiesQuickAdd(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDActivitiesShiftPreview operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDActivitiesShiftPreview(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDActivitiesShiftPreviewParams
+
+	// ------------- Required query parameter "starts_at" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "starts_at", r.URL.Query(), &params.StartsAt); err != nil {
+		err = fmt.Errorf("invalid format for parameter starts_at: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "starts_at"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDActivitiesShiftPreview(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDActivitiesSuggestedTimes operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDActivitiesSuggestedTimes(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDActivitiesSuggestedTimesParams
+
+	// ------------- Required query parameter "date" -------------
+
+	if err := runtime.BindQueryParameter("form", true, true, "date", r.URL.Query(), &params.Date); err != nil {
+		err = fmt.Errorf("invalid format for parameter date: %w", err)
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{err, "date"})
+		return
+	}
+
+	// ------------- Optional query parameter "duration_minutes" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "duration_minutes", r.URL.Query(), &params.DurationMinutes); err != nil {
+		err = fmt.Errorf("invalid format for parameter duration_minutes: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "duration_minutes"})
+		return
+	}
+
+	// ------------- Optional query parameter "tag" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "tag", r.URL.Query(), &params.Tag); err != nil {
+		err = fmt.Errorf("invalid format for parameter tag: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tag"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDActivitiesSuggestedTimes(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDActivitiesActivityID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDActivitiesActivityID(w, r, tripID, activityID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchTripsTripIDActivitiesActivityIDApprove operation middleware
+func (siw *ServerInterfaceWrapper) PatchTripsTripIDActivitiesActivityIDApprove(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchTripsTripIDActivitiesActivityIDApprove(w, r, tripID, activityID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDActivitiesActivityIDOrganizer operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDActivitiesActivityIDOrganizer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDActivitiesActivityIDOrganizer(w, r, tripID, activityID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripIDActivitiesActivityIDOrganizer operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDActivitiesActivityIDOrganizer(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripIDActivitiesActivityIDOrganizer(w, r, tripID, activityID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDActivitiesActivityIDPay operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDActivitiesActivityIDPay(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDActivitiesActivityIDPay(w, r, tripID, activityID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchTripsTripIDActivitiesActivityIDReject operation middleware
+func (siw *ServerInterfaceWrapper) PatchTripsTripIDActivitiesActivityIDReject(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchTripsTripIDActivitiesActivityIDReject(w, r, tripID, activityID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDActivitiesDateOptimize operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDActivitiesDateOptimize(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "date" -------------
+	var date openapi_types.Date
+
+	if err := runtime.BindStyledParameter("simple", false, "date", chi.URLParam(r, "date"), &date); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "date"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDActivitiesDateOptimize(w, r, tripID, date)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDActivitiesDateOptimize operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDActivitiesDateOptimize(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "date" -------------
+	var date openapi_types.Date
+
+	if err := runtime.BindStyledParameter("simple", false, "date", chi.URLParam(r, "date"), &date); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "date"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDActivitiesDateOptimize(w, r, tripID, date)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDActivitiesDateRoute operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDActivitiesDateRoute(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "date" -------------
+	var date openapi_types.Date
+
+	if err := runtime.BindStyledParameter("simple", false, "date", chi.URLParam(r, "date"), &date); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "date"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDActivitiesDateRoute(w, r, tripID, date)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDAttachmentsPresign operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDAttachmentsPresign(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDAttachmentsPresign(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDAttachmentsAttachmentID operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDAttachmentsAttachmentID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "attachmentId" -------------
+	var attachmentID string
+
+	if err := runtime.BindStyledParameter("simple", false, "attachmentId", chi.URLParam(r, "attachmentId"), &attachmentID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "attachmentId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDAttachmentsAttachmentID(w, r, tripID, attachmentID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDAttachmentsAttachmentIDComplete operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDAttachmentsAttachmentIDComplete(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "attachmentId" -------------
+	var attachmentID string
+
+	if err := runtime.BindStyledParameter("simple", false, "attachmentId", chi.URLParam(r, "attachmentId"), &attachmentID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "attachmentId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDAttachmentsAttachmentIDComplete(w, r, tripID, attachmentID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDBudget operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDBudget(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDBudget(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDBundle operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDBundle(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDBundle(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDChecklist operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDChecklist(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDChecklist(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDChecklist operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDChecklist(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDChecklist(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDChecklistGenerate operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDChecklistGenerate(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDChecklistGenerate(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDChecklistItemID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDChecklistItemID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemID string
+
+	if err := runtime.BindStyledParameter("simple", false, "itemId", chi.URLParam(r, "itemId"), &itemID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "itemId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDChecklistItemID(w, r, tripID, itemID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripIDChecklistItemID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDChecklistItemID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemID string
+
+	if err := runtime.BindStyledParameter("simple", false, "itemId", chi.URLParam(r, "itemId"), &itemID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "itemId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripIDChecklistItemID(w, r, tripID, itemID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDConfirm operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDConfirm(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDConfirmationsSummary operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDConfirmationsSummary(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDConfirmationsSummary(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDConflicts operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDConflicts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDConflicts(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDDatePoll operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDDatePoll(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDDatePoll(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDDatePoll operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDDatePoll(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDDatePoll(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDDatePollOptionIDPick operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDDatePollOptionIDPick(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "optionId" -------------
+	var optionID string
+
+	if err := runtime.BindStyledParameter("simple", false, "optionId", chi.URLParam(r, "optionId"), &optionID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "optionId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDDatePollOptionIDPick(w, r, tripID, optionID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDExpenses operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDExpenses(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDExpensesParams
+
+	// ------------- Optional query parameter "sort" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort); err != nil {
+		err = fmt.Errorf("invalid format for parameter sort: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "sort"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDExpenses(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDExpenses operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDExpenses(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDExpenses(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDExpensesBreakdown operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDExpensesBreakdown(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDExpensesBreakdown(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDExpensesExportZip operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDExpensesExportZip(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDExpensesExportZip(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDExpensesSettlement operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDExpensesSettlement(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDExpensesSettlement(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDExpensesExpenseIDReceipt operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDExpensesExpenseIDReceipt(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "expenseId" -------------
+	var expenseID string
+
+	if err := runtime.BindStyledParameter("simple", false, "expenseId", chi.URLParam(r, "expenseId"), &expenseID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "expenseId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDExpensesExpenseIDReceipt(w, r, tripID, expenseID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDExportMd operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDExportMd(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDExportMd(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDGaps operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDGaps(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDGapsParams
+
+	// ------------- Optional query parameter "min_minutes" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "min_minutes", r.URL.Query(), &params.MinMinutes); err != nil {
+		err = fmt.Errorf("invalid format for parameter min_minutes: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "min_minutes"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDGaps(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDGroups operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDGroups(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDGroups(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDGroups operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDGroups(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDGroups(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDGroupsGroupID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDGroupsGroupID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "groupId" -------------
+	var groupID string
+
+	if err := runtime.BindStyledParameter("simple", false, "groupId", chi.URLParam(r, "groupId"), &groupID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "groupId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDGroupsGroupID(w, r, tripID, groupID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripIDGroupsGroupID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDGroupsGroupID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "groupId" -------------
+	var groupID string
+
+	if err := runtime.BindStyledParameter("simple", false, "groupId", chi.URLParam(r, "groupId"), &groupID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "groupId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripIDGroupsGroupID(w, r, tripID, groupID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDInvites operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDInvites(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDInvitesImport operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDInvitesImport(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostTripsTripIDInvitesImportParams
+
+	// ------------- Optional query parameter "commit" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "commit", r.URL.Query(), &params.Commit); err != nil {
+		err = fmt.Errorf("invalid format for parameter commit: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "commit"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDInvitesImport(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDInvitesSummary operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDInvitesSummary(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDInvitesSummary(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDLinks operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDLinksParams
+
+	// ------------- Optional query parameter "sort" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort); err != nil {
+		err = fmt.Errorf("invalid format for parameter sort: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "sort"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDLinks(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDLinks operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDLinks(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDLinksBulk operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDLinksBulk(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDLinksBulk(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDLinksLinkID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "linkId" -------------
+	var linkID string
+
+	if err := runtime.BindStyledParameter("simple", false, "linkId", chi.URLParam(r, "linkId"), &linkID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "linkId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDLinksLinkID(w, r, tripID, linkID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDLodgings operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDLodgings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDLodgings(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDLodgings operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDLodgings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDLodgings(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchTripsTripIDLodgingsLodgingIDApprove operation middleware
+func (siw *ServerInterfaceWrapper) PatchTripsTripIDLodgingsLodgingIDApprove(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "lodgingId" -------------
+	var lodgingID string
+
+	if err := runtime.BindStyledParameter("simple", false, "lodgingId", chi.URLParam(r, "lodgingId"), &lodgingID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "lodgingId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchTripsTripIDLodgingsLodgingIDApprove(w, r, tripID, lodgingID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchTripsTripIDLodgingsLodgingIDReject operation middleware
+func (siw *ServerInterfaceWrapper) PatchTripsTripIDLodgingsLodgingIDReject(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "lodgingId" -------------
+	var lodgingID string
+
+	if err := runtime.BindStyledParameter("simple", false, "lodgingId", chi.URLParam(r, "lodgingId"), &lodgingID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "lodgingId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchTripsTripIDLodgingsLodgingIDReject(w, r, tripID, lodgingID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDLodgingsLodgingIDRoomingList operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDLodgingsLodgingIDRoomingList(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "lodgingId" -------------
+	var lodgingID string
+
+	if err := runtime.BindStyledParameter("simple", false, "lodgingId", chi.URLParam(r, "lodgingId"), &lodgingID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "lodgingId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDLodgingsLodgingIDRoomingList(w, r, tripID, lodgingID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDLodgingsLodgingIDRooms operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDLodgingsLodgingIDRooms(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "lodgingId" -------------
+	var lodgingID string
+
+	if err := runtime.BindStyledParameter("simple", false, "lodgingId", chi.URLParam(r, "lodgingId"), &lodgingID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "lodgingId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDLodgingsLodgingIDRooms(w, r, tripID, lodgingID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDLodgingsLodgingIDRooms operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDLodgingsLodgingIDRooms(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "lodgingId" -------------
+	var lodgingID string
+
+	if err := runtime.BindStyledParameter("simple", false, "lodgingId", chi.URLParam(r, "lodgingId"), &lodgingID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "lodgingId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDLodgingsLodgingIDRooms(w, r, tripID, lodgingID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDLodgingsLodgingIDRoomsRoomID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDLodgingsLodgingIDRoomsRoomID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "lodgingId" -------------
+	var lodgingID string
+
+	if err := runtime.BindStyledParameter("simple", false, "lodgingId", chi.URLParam(r, "lodgingId"), &lodgingID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "lodgingId"})
+		return
+	}
+
+	// ------------- Path parameter "roomId" -------------
+	var roomID string
+
+	if err := runtime.BindStyledParameter("simple", false, "roomId", chi.URLParam(r, "roomId"), &roomID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "roomId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDLodgingsLodgingIDRoomsRoomID(w, r, tripID, lodgingID, roomID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripIDLodgingsLodgingIDRoomsRoomIDParticipants operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDLodgingsLodgingIDRoomsRoomIDParticipants(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "lodgingId" -------------
+	var lodgingID string
+
+	if err := runtime.BindStyledParameter("simple", false, "lodgingId", chi.URLParam(r, "lodgingId"), &lodgingID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "lodgingId"})
+		return
+	}
+
+	// ------------- Path parameter "roomId" -------------
+	var roomID string
+
+	if err := runtime.BindStyledParameter("simple", false, "roomId", chi.URLParam(r, "roomId"), &roomID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "roomId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripIDLodgingsLodgingIDRoomsRoomIDParticipants(w, r, tripID, lodgingID, roomID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDMergeFromSourceID operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDMergeFromSourceID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "sourceId" -------------
+	var sourceID string
+
+	if err := runtime.BindStyledParameter("simple", false, "sourceId", chi.URLParam(r, "sourceId"), &sourceID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "sourceId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDMergeFromSourceID(w, r, tripID, sourceID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDNeedsSummary operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDNeedsSummary(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDNeedsSummary(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDOwnerEmail operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDOwnerEmail(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDOwnerEmail(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDOwners operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDOwners(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDOwners(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDOwnersParticipantID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDOwnersParticipantID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDOwnersParticipantID(w, r, tripID, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDParticipants operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDParticipantsParams
+
+	// ------------- Optional query parameter "fields" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "fields", r.URL.Query(), &params.Fields); err != nil {
+		err = fmt.Errorf("invalid format for parameter fields: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "fields"})
+		return
+	}
+
+	// ------------- Optional query parameter "sort" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "sort", r.URL.Query(), &params.Sort); err != nil {
+		err = fmt.Errorf("invalid format for parameter sort: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "sort"})
+		return
+	}
+
+	// ------------- Optional query parameter "group_id" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "group_id", r.URL.Query(), &params.GroupID); err != nil {
+		err = fmt.Errorf("invalid format for parameter group_id: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "group_id"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDParticipants(w, r, tripID, params)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDParticipantsConfirmBulk operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDParticipantsConfirmBulk(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDParticipantsConfirmBulk(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchTripsTripIDParticipantsParticipantIDEmail operation middleware
+func (siw *ServerInterfaceWrapper) PatchTripsTripIDParticipantsParticipantIDEmail(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchTripsTripIDParticipantsParticipantIDEmail(w, r, tripID, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDPlanningStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDPlanningStatus(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDPlanningStatus(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDPrint operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDPrint(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDPrint(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDReceipts operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDReceipts(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDReceipts(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDReceiptsReceiptIDConfirm operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDReceiptsReceiptIDConfirm(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "receiptId" -------------
+	var receiptID string
+
+	if err := runtime.BindStyledParameter("simple", false, "receiptId", chi.URLParam(r, "receiptId"), &receiptID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "receiptId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDReceiptsReceiptIDConfirm(w, r, tripID, receiptID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDRides operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDRides(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDRides(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDRides operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDRides(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDRides(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDRidesUnassigned operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDRidesUnassigned(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDRidesUnassigned(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDRidesRideID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDRidesRideID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "rideId" -------------
+	var rideID string
+
+	if err := runtime.BindStyledParameter("simple", false, "rideId", chi.URLParam(r, "rideId"), &rideID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "rideId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDRidesRideID(w, r, tripID, rideID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDRidesRideIDPassengers operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDRidesRideIDPassengers(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "rideId" -------------
+	var rideID string
+
+	if err := runtime.BindStyledParameter("simple", false, "rideId", chi.URLParam(r, "rideId"), &rideID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "rideId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDRidesRideIDPassengers(w, r, tripID, rideID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDRidesRideIDPassengersParticipantID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDRidesRideIDPassengersParticipantID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "rideId" -------------
+	var rideID string
+
+	if err := runtime.BindStyledParameter("simple", false, "rideId", chi.URLParam(r, "rideId"), &rideID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "rideId"})
+		return
+	}
+
+	// ------------- Path parameter "participantId" -------------
+	var participantID string
+
+	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDRidesRideIDPassengersParticipantID(w, r, tripID, rideID, participantID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDSettings operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDSettings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDSettings(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PatchTripsTripIDSettings operation middleware
+func (siw *ServerInterfaceWrapper) PatchTripsTripIDSettings(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PatchTripsTripIDSettings(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDShare operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDShare(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDShare(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDShare operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDShare(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDShare(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDSheets operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDSheets(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDSheets(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDSheets operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDSheets(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDSheets(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDSheets operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDSheets(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDSheets(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDShopping operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDShopping(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDShopping(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDShopping operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDShopping(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDShopping(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDShoppingItemID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDShoppingItemID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemID string
+
+	if err := runtime.BindStyledParameter("simple", false, "itemId", chi.URLParam(r, "itemId"), &itemID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "itemId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDShoppingItemID(w, r, tripID, itemID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// DeleteTripsTripIDShoppingItemIDClaim operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDShoppingItemIDClaim(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemID string
+
+	if err := runtime.BindStyledParameter("simple", false, "itemId", chi.URLParam(r, "itemId"), &itemID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "itemId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDShoppingItemIDClaim(w, r, tripID, itemID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDShoppingItemIDClaim operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDShoppingItemIDClaim(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemID string
+
+	if err := runtime.BindStyledParameter("simple", false, "itemId", chi.URLParam(r, "itemId"), &itemID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "itemId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDShoppingItemIDClaim(w, r, tripID, itemID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PostTripsTripIDShoppingItemIDPurchase operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDShoppingItemIDPurchase(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "itemId" -------------
+	var itemID string
+
+	if err := runtime.BindStyledParameter("simple", false, "itemId", chi.URLParam(r, "itemId"), &itemID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "itemId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDShoppingItemIDPurchase(w, r, tripID, itemID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDSurveyQuestions operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDSurveyQuestions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDSurveyQuestions(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripIDSurveyQuestions operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDSurveyQuestions(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripIDSurveyQuestions(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDSurveyResults operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDSurveyResults(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDSurveyResults(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// GetTripsTripIDTags operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDTags(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDTags(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
+}
+
+// PutTripsTripIDTags operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDTags(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
 
-// PostTripsTripIDInvitesJSON201Response is a constructor method for a PostTripsTripIDInvites response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDInvitesJSON201Response(body interface{}) *Response {
-	return &Response{
-		body:        body,
-		Code:        201,
-		contentType: "application/json",
-	}
-}
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
 
-// PostTripsTripIDInvitesJSON400Response is a constructor method for a PostTripsTripIDInvites response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDInvitesJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
 	}
-}
 
-// GetTripsTripIDLinksJSON200Response is a constructor method for a GetTripsTripIDLinks response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDLinksJSON200Response(body GetLinksResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        200,
-		contentType: "application/json",
-	}
-}
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PutTripsTripIDTags(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
 
-// GetTripsTripIDLinksJSON400Response is a constructor method for a GetTripsTripIDLinks response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDLinksJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
-	}
+	handler(w, r.WithContext(ctx))
 }
 
-// PostTripsTripIDLinksJSON201Response is a constructor method for a PostTripsTripIDLinks response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDLinksJSON201Response(body CreateLinkResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        201,
-		contentType: "application/json",
+// GetTripsTripIDTasks operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDTasks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
 	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.GetTripsTripIDTasks(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
 }
 
-// PostTripsTripIDLinksJSON400Response is a constructor method for a PostTripsTripIDLinks response.
-// A *Response is returned with the configured status code and content type from the spec.
-func PostTripsTripIDLinksJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
+// PostTripsTripIDTasks operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDTasks(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
 	}
+
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.PostTripsTripIDTasks(w, r, tripID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
+
+	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDParticipantsJSON200Response is a constructor method for a GetTripsTripIDParticipants response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDParticipantsJSON200Response(body GetTripParticipantsResponse) *Response {
-	return &Response{
-		body:        body,
-		Code:        200,
-		contentType: "application/json",
+// DeleteTripsTripIDTasksTaskID operation middleware
+func (siw *ServerInterfaceWrapper) DeleteTripsTripIDTasksTaskID(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
 	}
-}
 
-// GetTripsTripIDParticipantsJSON400Response is a constructor method for a GetTripsTripIDParticipants response.
-// A *Response is returned with the configured status code and content type from the spec.
-func GetTripsTripIDParticipantsJSON400Response(body Error) *Response {
-	return &Response{
-		body:        body,
-		Code:        400,
-		contentType: "application/json",
+	// ------------- Path parameter "taskId" -------------
+	var taskID string
+
+	if err := runtime.BindStyledParameter("simple", false, "taskId", chi.URLParam(r, "taskId"), &taskID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "taskId"})
+		return
 	}
-}
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// Confirms a participant on a trip.
-	// (PATCH /participants/{participantId}/confirm)
-	PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request, participantID string) *Response
-	// Create a new trip
-	// (POST /trips)
-	PostTrips(w http.ResponseWriter, r *http.Request) *Response
-	// Get a trip details.
-	// (GET /trips/{tripId})
-	GetTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Update a trip.
-	// (PUT /trips/{tripId})
-	PutTripsTripID(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Get a trip activities.
-	// (GET /trips/{tripId}/activities)
-	GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Create a trip activity.
-	// (POST /trips/{tripId}/activities)
-	PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Confirm a trip and send e-mail invitations.
-	// (GET /trips/{tripId}/confirm)
-	GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Invite someone to the trip.
-	// (POST /trips/{tripId}/invites)
-	PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Get a trip links.
-	// (GET /trips/{tripId}/links)
-	GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Create a trip link.
-	// (POST /trips/{tripId}/links)
-	PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request, tripID string) *Response
-	// Get a trip participants.
-	// (GET /trips/{tripId}/participants)
-	GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request, tripID string) *Response
-}
+	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		resp := siw.Handler.DeleteTripsTripIDTasksTaskID(w, r, tripID, taskID)
+		if resp != nil {
+			if resp.body != nil {
+				render.Render(w, r, resp)
+			} else {
+				w.WriteHeader(resp.Code)
+			}
+		}
+	})
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler          ServerInterface
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+	handler(w, r.WithContext(ctx))
 }
 
-// PatchParticipantsParticipantIDConfirm operation middleware
-func (siw *ServerInterfaceWrapper) PatchParticipantsParticipantIDConfirm(w http.ResponseWriter, r *http.Request) {
+// PutTripsTripIDTasksTaskID operation middleware
+func (siw *ServerInterfaceWrapper) PutTripsTripIDTasksTaskID(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
-	// ------------- Path parameter "participantId" -------------
-	var participantID string
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
 
-	if err := runtime.BindStyledParameter("simple", false, "participantId", chi.URLParam(r, "participantId"), &participantID); err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "participantId"})
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
+	// ------------- Path parameter "taskId" -------------
+	var taskID string
+
+	if err := runtime.BindStyledParameter("simple", false, "taskId", chi.URLParam(r, "taskId"), &taskID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "taskId"})
 		return
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PatchParticipantsParticipantIDConfirm(w, r, participantID)
+		resp := siw.Handler.PutTripsTripIDTasksTaskID(w, r, tripID, taskID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -519,12 +12281,20 @@ func (siw *ServerInterfaceWrapper) PatchParticipantsParticipantIDConfirm(w http.
 	handler(w, r.WithContext(ctx))
 }
 
-// PostTrips operation middleware
-func (siw *ServerInterfaceWrapper) PostTrips(w http.ResponseWriter, r *http.Request) {
+// PostTripsTripIDTransferOwnership operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDTransferOwnership(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
+	// ------------- Path parameter "tripId" -------------
+	var tripID string
+
+	if err := runtime.BindStyledParameter("simple", false, "tripId", chi.URLParam(r, "tripId"), &tripID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "tripId"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PostTrips(w, r)
+		resp := siw.Handler.PostTripsTripIDTransferOwnership(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -537,8 +12307,8 @@ func (siw *ServerInterfaceWrapper) PostTrips(w http.ResponseWriter, r *http.Requ
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripID operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripID(w http.ResponseWriter, r *http.Request) {
+// PostTripsTripIDTransitions operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDTransitions(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -550,7 +12320,7 @@ func (siw *ServerInterfaceWrapper) GetTripsTripID(w http.ResponseWriter, r *http
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripID(w, r, tripID)
+		resp := siw.Handler.PostTripsTripIDTransitions(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -563,8 +12333,8 @@ func (siw *ServerInterfaceWrapper) GetTripsTripID(w http.ResponseWriter, r *http
 	handler(w, r.WithContext(ctx))
 }
 
-// PutTripsTripID operation middleware
-func (siw *ServerInterfaceWrapper) PutTripsTripID(w http.ResponseWriter, r *http.Request) {
+// GetTripsTripIDTransports operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDTransports(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -576,7 +12346,7 @@ func (siw *ServerInterfaceWrapper) PutTripsTripID(w http.ResponseWriter, r *http
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PutTripsTripID(w, r, tripID)
+		resp := siw.Handler.GetTripsTripIDTransports(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -589,8 +12359,8 @@ func (siw *ServerInterfaceWrapper) PutTripsTripID(w http.ResponseWriter, r *http
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDActivities operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripIDActivities(w http.ResponseWriter, r *http.Request) {
+// PostTripsTripIDTransports operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDTransports(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -602,7 +12372,7 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDActivities(w http.ResponseWrite
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripIDActivities(w, r, tripID)
+		resp := siw.Handler.PostTripsTripIDTransports(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -615,8 +12385,8 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDActivities(w http.ResponseWrite
 	handler(w, r.WithContext(ctx))
 }
 
-// PostTripsTripIDActivities operation middleware
-func (siw *ServerInterfaceWrapper) PostTripsTripIDActivities(w http.ResponseWriter, r *http.Request) {
+// GetTripsTripIDTrash operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDTrash(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -628,7 +12398,7 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDActivities(w http.ResponseWrit
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PostTripsTripIDActivities(w, r, tripID)
+		resp := siw.Handler.GetTripsTripIDTrash(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -641,8 +12411,8 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDActivities(w http.ResponseWrit
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDConfirm operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripIDConfirm(w http.ResponseWriter, r *http.Request) {
+// PostTripsTripIDTrashActivitiesActivityIDRestore operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDTrashActivitiesActivityIDRestore(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -653,8 +12423,16 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDConfirm(w http.ResponseWriter,
 		return
 	}
 
+	// ------------- Path parameter "activityId" -------------
+	var activityID string
+
+	if err := runtime.BindStyledParameter("simple", false, "activityId", chi.URLParam(r, "activityId"), &activityID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "activityId"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripIDConfirm(w, r, tripID)
+		resp := siw.Handler.PostTripsTripIDTrashActivitiesActivityIDRestore(w, r, tripID, activityID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -667,8 +12445,8 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDConfirm(w http.ResponseWriter,
 	handler(w, r.WithContext(ctx))
 }
 
-// PostTripsTripIDInvites operation middleware
-func (siw *ServerInterfaceWrapper) PostTripsTripIDInvites(w http.ResponseWriter, r *http.Request) {
+// PostTripsTripIDTrashLinksLinkIDRestore operation middleware
+func (siw *ServerInterfaceWrapper) PostTripsTripIDTrashLinksLinkIDRestore(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -679,8 +12457,16 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDInvites(w http.ResponseWriter,
 		return
 	}
 
+	// ------------- Path parameter "linkId" -------------
+	var linkID string
+
+	if err := runtime.BindStyledParameter("simple", false, "linkId", chi.URLParam(r, "linkId"), &linkID); err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "linkId"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PostTripsTripIDInvites(w, r, tripID)
+		resp := siw.Handler.PostTripsTripIDTrashLinksLinkIDRestore(w, r, tripID, linkID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -693,8 +12479,8 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDInvites(w http.ResponseWriter,
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDLinks operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripIDLinks(w http.ResponseWriter, r *http.Request) {
+// GetTripsTripIDTriggersActivities operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDTriggersActivities(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -705,8 +12491,27 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDLinks(w http.ResponseWriter, r
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDTriggersActivitiesParams
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor); err != nil {
+		err = fmt.Errorf("invalid format for parameter cursor: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "cursor"})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit); err != nil {
+		err = fmt.Errorf("invalid format for parameter limit: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "limit"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripIDLinks(w, r, tripID)
+		resp := siw.Handler.GetTripsTripIDTriggersActivities(w, r, tripID, params)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -719,8 +12524,8 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDLinks(w http.ResponseWriter, r
 	handler(w, r.WithContext(ctx))
 }
 
-// PostTripsTripIDLinks operation middleware
-func (siw *ServerInterfaceWrapper) PostTripsTripIDLinks(w http.ResponseWriter, r *http.Request) {
+// GetTripsTripIDTriggersParticipants operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDTriggersParticipants(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -731,8 +12536,27 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDLinks(w http.ResponseWriter, r
 		return
 	}
 
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetTripsTripIDTriggersParticipantsParams
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "cursor", r.URL.Query(), &params.Cursor); err != nil {
+		err = fmt.Errorf("invalid format for parameter cursor: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "cursor"})
+		return
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	if err := runtime.BindQueryParameter("form", true, false, "limit", r.URL.Query(), &params.Limit); err != nil {
+		err = fmt.Errorf("invalid format for parameter limit: %w", err)
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{err, "limit"})
+		return
+	}
+
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.PostTripsTripIDLinks(w, r, tripID)
+		resp := siw.Handler.GetTripsTripIDTriggersParticipants(w, r, tripID, params)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -745,8 +12569,8 @@ func (siw *ServerInterfaceWrapper) PostTripsTripIDLinks(w http.ResponseWriter, r
 	handler(w, r.WithContext(ctx))
 }
 
-// GetTripsTripIDParticipants operation middleware
-func (siw *ServerInterfaceWrapper) GetTripsTripIDParticipants(w http.ResponseWriter, r *http.Request) {
+// GetTripsTripIDWarnings operation middleware
+func (siw *ServerInterfaceWrapper) GetTripsTripIDWarnings(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 
 	// ------------- Path parameter "tripId" -------------
@@ -758,7 +12582,7 @@ func (siw *ServerInterfaceWrapper) GetTripsTripIDParticipants(w http.ResponseWri
 	}
 
 	var handler = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		resp := siw.Handler.GetTripsTripIDParticipants(w, r, tripID)
+		resp := siw.Handler.GetTripsTripIDWarnings(w, r, tripID)
 		if resp != nil {
 			if resp.body != nil {
 				render.Render(w, r, resp)
@@ -886,17 +12710,145 @@ func Handler(si ServerInterface, opts ...ServerOption) http.Handler {
 	}
 
 	r.Route(options.BaseURL, func(r chi.Router) {
+		r.Get("/admin/moderation", wrapper.GetAdminModeration)
+		r.Post("/admin/moderation/{tripId}/approve", wrapper.PostAdminModerationTripIDApprove)
+		r.Post("/admin/moderation/{tripId}/reject", wrapper.PostAdminModerationTripIDReject)
+		r.Get("/admin/stats", wrapper.GetAdminStats)
+		r.Get("/date-poll/{token}", wrapper.GetDatePollToken)
+		r.Put("/date-poll/{token}", wrapper.PutDatePollToken)
+		r.Get("/embed/trips/{shareToken}", wrapper.GetEmbedTripsShareToken)
+		r.Get("/embed/trips/{shareToken}/widget", wrapper.GetEmbedTripsShareTokenWidget)
+		r.Get("/federation/key", wrapper.GetFederationKey)
+		r.Get("/integrations", wrapper.GetIntegrations)
+		r.Post("/mail/bounces", wrapper.PostMailBounces)
+		r.Get("/oembed", wrapper.GetOembed)
+		r.Patch("/owner-email-changes/{token}/confirm", wrapper.PatchOwnerEmailChangesTokenConfirm)
+		r.Patch("/ownership-transfers/{token}/accept", wrapper.PatchOwnershipTransfersTokenAccept)
+		r.Post("/participants/{participantId}/companions", wrapper.PostParticipantsParticipantIDCompanions)
+		r.Delete("/participants/{participantId}/companions/{companionId}", wrapper.DeleteParticipantsParticipantIDCompanionsCompanionID)
 		r.Patch("/participants/{participantId}/confirm", wrapper.PatchParticipantsParticipantIDConfirm)
+		r.Patch("/participants/{participantId}/decline", wrapper.PatchParticipantsParticipantIDDecline)
+		r.Get("/participants/{participantId}/needs", wrapper.GetParticipantsParticipantIDNeeds)
+		r.Put("/participants/{participantId}/needs", wrapper.PutParticipantsParticipantIDNeeds)
+		r.Get("/shared/{shareToken}/feed.atom", wrapper.GetSharedShareTokenFeedAtom)
+		r.Get("/shared/{shareToken}/jsonld", wrapper.GetSharedShareTokenJsonld)
+		r.Post("/shared/{shareToken}/reports", wrapper.PostSharedShareTokenReports)
+		r.Get("/sheets/callback", wrapper.GetSheetsCallback)
+		r.Get("/surveys/{token}", wrapper.GetSurveysToken)
+		r.Put("/surveys/{token}", wrapper.PutSurveysToken)
 		r.Post("/trips", wrapper.PostTrips)
+		r.Post("/trips/import", wrapper.PostTripsImport)
 		r.Get("/trips/{tripId}", wrapper.GetTripsTripID)
 		r.Put("/trips/{tripId}", wrapper.PutTripsTripID)
 		r.Get("/trips/{tripId}/activities", wrapper.GetTripsTripIDActivities)
 		r.Post("/trips/{tripId}/activities", wrapper.PostTripsTripIDActivities)
+		r.Post("/trips/{tripId}/activities/draft", wrapper.PostTripsTripIDActivitiesDraft)
+		r.Post("/trips/{tripId}/activities/quick-add", wrapper.PostTripsTripIDActivitiesQuickAdd)
+		r.Get("/trips/{tripId}/activities/shift-preview", wrapper.GetTripsTripIDActivitiesShiftPreview)
+		r.Get("/trips/{tripId}/activities/suggested-times", wrapper.GetTripsTripIDActivitiesSuggestedTimes)
+		r.Delete("/trips/{tripId}/activities/{activityId}", wrapper.DeleteTripsTripIDActivitiesActivityID)
+		r.Patch("/trips/{tripId}/activities/{activityId}/approve", wrapper.PatchTripsTripIDActivitiesActivityIDApprove)
+		r.Delete("/trips/{tripId}/activities/{activityId}/organizer", wrapper.DeleteTripsTripIDActivitiesActivityIDOrganizer)
+		r.Put("/trips/{tripId}/activities/{activityId}/organizer", wrapper.PutTripsTripIDActivitiesActivityIDOrganizer)
+		r.Post("/trips/{tripId}/activities/{activityId}/pay", wrapper.PostTripsTripIDActivitiesActivityIDPay)
+		r.Patch("/trips/{tripId}/activities/{activityId}/reject", wrapper.PatchTripsTripIDActivitiesActivityIDReject)
+		r.Get("/trips/{tripId}/activities/{date}/optimize", wrapper.GetTripsTripIDActivitiesDateOptimize)
+		r.Post("/trips/{tripId}/activities/{date}/optimize", wrapper.PostTripsTripIDActivitiesDateOptimize)
+		r.Get("/trips/{tripId}/activities/{date}/route", wrapper.GetTripsTripIDActivitiesDateRoute)
+		r.Post("/trips/{tripId}/attachments/presign", wrapper.PostTripsTripIDAttachmentsPresign)
+		r.Get("/trips/{tripId}/attachments/{attachmentId}", wrapper.GetTripsTripIDAttachmentsAttachmentID)
+		r.Post("/trips/{tripId}/attachments/{attachmentId}/complete", wrapper.PostTripsTripIDAttachmentsAttachmentIDComplete)
+		r.Get("/trips/{tripId}/budget", wrapper.GetTripsTripIDBudget)
+		r.Get("/trips/{tripId}/bundle", wrapper.GetTripsTripIDBundle)
+		r.Get("/trips/{tripId}/checklist", wrapper.GetTripsTripIDChecklist)
+		r.Post("/trips/{tripId}/checklist", wrapper.PostTripsTripIDChecklist)
+		r.Post("/trips/{tripId}/checklist/generate", wrapper.PostTripsTripIDChecklistGenerate)
+		r.Delete("/trips/{tripId}/checklist/{itemId}", wrapper.DeleteTripsTripIDChecklistItemID)
+		r.Put("/trips/{tripId}/checklist/{itemId}", wrapper.PutTripsTripIDChecklistItemID)
 		r.Get("/trips/{tripId}/confirm", wrapper.GetTripsTripIDConfirm)
+		r.Get("/trips/{tripId}/confirmations/summary", wrapper.GetTripsTripIDConfirmationsSummary)
+		r.Get("/trips/{tripId}/conflicts", wrapper.GetTripsTripIDConflicts)
+		r.Get("/trips/{tripId}/date-poll", wrapper.GetTripsTripIDDatePoll)
+		r.Post("/trips/{tripId}/date-poll", wrapper.PostTripsTripIDDatePoll)
+		r.Post("/trips/{tripId}/date-poll/{optionId}/pick", wrapper.PostTripsTripIDDatePollOptionIDPick)
+		r.Get("/trips/{tripId}/expenses", wrapper.GetTripsTripIDExpenses)
+		r.Post("/trips/{tripId}/expenses", wrapper.PostTripsTripIDExpenses)
+		r.Get("/trips/{tripId}/expenses/breakdown", wrapper.GetTripsTripIDExpensesBreakdown)
+		r.Get("/trips/{tripId}/expenses/export.zip", wrapper.GetTripsTripIDExpensesExportZip)
+		r.Get("/trips/{tripId}/expenses/settlement", wrapper.GetTripsTripIDExpensesSettlement)
+		r.Get("/trips/{tripId}/expenses/{expenseId}/receipt", wrapper.GetTripsTripIDExpensesExpenseIDReceipt)
+		r.Get("/trips/{tripId}/export.md", wrapper.GetTripsTripIDExportMd)
+		r.Get("/trips/{tripId}/gaps", wrapper.GetTripsTripIDGaps)
+		r.Get("/trips/{tripId}/groups", wrapper.GetTripsTripIDGroups)
+		r.Post("/trips/{tripId}/groups", wrapper.PostTripsTripIDGroups)
+		r.Delete("/trips/{tripId}/groups/{groupId}", wrapper.DeleteTripsTripIDGroupsGroupID)
+		r.Put("/trips/{tripId}/groups/{groupId}", wrapper.PutTripsTripIDGroupsGroupID)
 		r.Post("/trips/{tripId}/invites", wrapper.PostTripsTripIDInvites)
+		r.Post("/trips/{tripId}/invites/import", wrapper.PostTripsTripIDInvitesImport)
+		r.Get("/trips/{tripId}/invites/summary", wrapper.GetTripsTripIDInvitesSummary)
 		r.Get("/trips/{tripId}/links", wrapper.GetTripsTripIDLinks)
 		r.Post("/trips/{tripId}/links", wrapper.PostTripsTripIDLinks)
+		r.Post("/trips/{tripId}/links/bulk", wrapper.PostTripsTripIDLinksBulk)
+		r.Delete("/trips/{tripId}/links/{linkId}", wrapper.DeleteTripsTripIDLinksLinkID)
+		r.Get("/trips/{tripId}/lodgings", wrapper.GetTripsTripIDLodgings)
+		r.Post("/trips/{tripId}/lodgings", wrapper.PostTripsTripIDLodgings)
+		r.Patch("/trips/{tripId}/lodgings/{lodgingId}/approve", wrapper.PatchTripsTripIDLodgingsLodgingIDApprove)
+		r.Patch("/trips/{tripId}/lodgings/{lodgingId}/reject", wrapper.PatchTripsTripIDLodgingsLodgingIDReject)
+		r.Get("/trips/{tripId}/lodgings/{lodgingId}/rooming-list", wrapper.GetTripsTripIDLodgingsLodgingIDRoomingList)
+		r.Get("/trips/{tripId}/lodgings/{lodgingId}/rooms", wrapper.GetTripsTripIDLodgingsLodgingIDRooms)
+		r.Post("/trips/{tripId}/lodgings/{lodgingId}/rooms", wrapper.PostTripsTripIDLodgingsLodgingIDRooms)
+		r.Delete("/trips/{tripId}/lodgings/{lodgingId}/rooms/{roomId}", wrapper.DeleteTripsTripIDLodgingsLodgingIDRoomsRoomID)
+		r.Put("/trips/{tripId}/lodgings/{lodgingId}/rooms/{roomId}/participants", wrapper.PutTripsTripIDLodgingsLodgingIDRoomsRoomIDParticipants)
+		r.Post("/trips/{tripId}/merge-from/{sourceId}", wrapper.PostTripsTripIDMergeFromSourceID)
+		r.Get("/trips/{tripId}/needs-summary", wrapper.GetTripsTripIDNeedsSummary)
+		r.Post("/trips/{tripId}/owner-email", wrapper.PostTripsTripIDOwnerEmail)
+		r.Post("/trips/{tripId}/owners", wrapper.PostTripsTripIDOwners)
+		r.Delete("/trips/{tripId}/owners/{participantId}", wrapper.DeleteTripsTripIDOwnersParticipantID)
 		r.Get("/trips/{tripId}/participants", wrapper.GetTripsTripIDParticipants)
+		r.Post("/trips/{tripId}/participants/confirm-bulk", wrapper.PostTripsTripIDParticipantsConfirmBulk)
+		r.Patch("/trips/{tripId}/participants/{participantId}/email", wrapper.PatchTripsTripIDParticipantsParticipantIDEmail)
+		r.Get("/trips/{tripId}/planning-status", wrapper.GetTripsTripIDPlanningStatus)
+		r.Get("/trips/{tripId}/print", wrapper.GetTripsTripIDPrint)
+		r.Post("/trips/{tripId}/receipts", wrapper.PostTripsTripIDReceipts)
+		r.Post("/trips/{tripId}/receipts/{receiptId}/confirm", wrapper.PostTripsTripIDReceiptsReceiptIDConfirm)
+		r.Get("/trips/{tripId}/rides", wrapper.GetTripsTripIDRides)
+		r.Post("/trips/{tripId}/rides", wrapper.PostTripsTripIDRides)
+		r.Get("/trips/{tripId}/rides/unassigned", wrapper.GetTripsTripIDRidesUnassigned)
+		r.Delete("/trips/{tripId}/rides/{rideId}", wrapper.DeleteTripsTripIDRidesRideID)
+		r.Post("/trips/{tripId}/rides/{rideId}/passengers", wrapper.PostTripsTripIDRidesRideIDPassengers)
+		r.Delete("/trips/{tripId}/rides/{rideId}/passengers/{participantId}", wrapper.DeleteTripsTripIDRidesRideIDPassengersParticipantID)
+		r.Get("/trips/{tripId}/settings", wrapper.GetTripsTripIDSettings)
+		r.Patch("/trips/{tripId}/settings", wrapper.PatchTripsTripIDSettings)
+		r.Delete("/trips/{tripId}/share", wrapper.DeleteTripsTripIDShare)
+		r.Post("/trips/{tripId}/share", wrapper.PostTripsTripIDShare)
+		r.Delete("/trips/{tripId}/sheets", wrapper.DeleteTripsTripIDSheets)
+		r.Get("/trips/{tripId}/sheets", wrapper.GetTripsTripIDSheets)
+		r.Post("/trips/{tripId}/sheets", wrapper.PostTripsTripIDSheets)
+		r.Get("/trips/{tripId}/shopping", wrapper.GetTripsTripIDShopping)
+		r.Post("/trips/{tripId}/shopping", wrapper.PostTripsTripIDShopping)
+		r.Delete("/trips/{tripId}/shopping/{itemId}", wrapper.DeleteTripsTripIDShoppingItemID)
+		r.Delete("/trips/{tripId}/shopping/{itemId}/claim", wrapper.DeleteTripsTripIDShoppingItemIDClaim)
+		r.Post("/trips/{tripId}/shopping/{itemId}/claim", wrapper.PostTripsTripIDShoppingItemIDClaim)
+		r.Post("/trips/{tripId}/shopping/{itemId}/purchase", wrapper.PostTripsTripIDShoppingItemIDPurchase)
+		r.Get("/trips/{tripId}/survey/questions", wrapper.GetTripsTripIDSurveyQuestions)
+		r.Put("/trips/{tripId}/survey/questions", wrapper.PutTripsTripIDSurveyQuestions)
+		r.Get("/trips/{tripId}/survey/results", wrapper.GetTripsTripIDSurveyResults)
+		r.Get("/trips/{tripId}/tags", wrapper.GetTripsTripIDTags)
+		r.Put("/trips/{tripId}/tags", wrapper.PutTripsTripIDTags)
+		r.Get("/trips/{tripId}/tasks", wrapper.GetTripsTripIDTasks)
+		r.Post("/trips/{tripId}/tasks", wrapper.PostTripsTripIDTasks)
+		r.Delete("/trips/{tripId}/tasks/{taskId}", wrapper.DeleteTripsTripIDTasksTaskID)
+		r.Put("/trips/{tripId}/tasks/{taskId}", wrapper.PutTripsTripIDTasksTaskID)
+		r.Post("/trips/{tripId}/transfer-ownership", wrapper.PostTripsTripIDTransferOwnership)
+		r.Post("/trips/{tripId}/transitions", wrapper.PostTripsTripIDTransitions)
+		r.Get("/trips/{tripId}/transports", wrapper.GetTripsTripIDTransports)
+		r.Post("/trips/{tripId}/transports", wrapper.PostTripsTripIDTransports)
+		r.Get("/trips/{tripId}/trash", wrapper.GetTripsTripIDTrash)
+		r.Post("/trips/{tripId}/trash/activities/{activityId}/restore", wrapper.PostTripsTripIDTrashActivitiesActivityIDRestore)
+		r.Post("/trips/{tripId}/trash/links/{linkId}/restore", wrapper.PostTripsTripIDTrashLinksLinkIDRestore)
+		r.Get("/trips/{tripId}/triggers/activities", wrapper.GetTripsTripIDTriggersActivities)
+		r.Get("/trips/{tripId}/triggers/participants", wrapper.GetTripsTripIDTriggersParticipants)
+		r.Get("/trips/{tripId}/warnings", wrapper.GetTripsTripIDWarnings)
 	})
 	return r
 }
@@ -922,30 +12874,364 @@ func WithErrorHandler(handler func(w http.ResponseWriter, r *http.Request, err e
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+Raz27bOBN/FYLfd5TjdDcnAXtom6LwItgGRRd7KIqAlsY2E4lUyZFTw/DT7GFPe9wn",
-	"yIstSMo2Jcs2rcSbOr20tiJy/vxmfjNDek4TmRdSgEBN4znVyQRyZj++VcAQXifIpxxnH+FrCRrNH1ia",
-	"cuRSsOxayQIUctA0HrFMQ0QL79GcyiQplb5hdt1Iqtx8oilD6CHPgUYUZwXQmGpUXIxpRL/1xrIH31Cx",
-	"HrKx3WTKMm6W0Jgq+FpyBSldLCKKHDMwL3TeYxGtv8WfPW2Xm39ZKSiHt5AgXUQbftGFFBoOdAyrlg/S",
-	"mmfKkqcbTmmq6a3drt8VF3fdMHu8WyNaqqxul+KdsY7MZhtYOS2dpH1e6IRQxsVdF3Sqddt1+qR40Q2Z",
-	"FDRywczb5mvOxRWIMU5ofNHZuTkXv1xYIyBnPNM3KG+4mHK0/uIIua75wL616YTVA6YUm4WLT/kUIren",
-	"1UGkx2ILeS9A3ThR+w0KNmCtuxMgWP7Y5NHIFB7HDY1Y9QPKl7sGoiUsapbW/bov6DslIipedEnEal2b",
-	"Tu+UkmqvGinoRPHCpRt9w1KiqrRtqpiD1mzcgntTp+WLbUq9BzR0pR/BV7qWs/9XMKIx/V9/XeL7VX3v",
-	"N4W9tmnbTOM2btNByrv9DrOAh4C8tewHVp2mSU7GnmLyHtAEcFXzOejHVf2lvYFAtYv+UCKoMNg8sQdZ",
-	"NxBiKeIoSB7aHe4AfxeqazEHWe85+PlQ9iDYQDmijuDDfNekfmapPCw0LgFNEXgEgQc6oCHIPPowvG2l",
-	"9gP0XW5ztG7r4M5lEYXmCNc3iRQjrnJIvbgfSpkBE7RDu9CaKyGdQE2VHd6/Zgp5wgsmsGvIFN4WhyZR",
-	"m/gwnqxJPdDALkQR2oyuoqVDdCz7UVFmGRsa7kRVQlBMVA3eUqe98A9sf+g5p9uUc7QWvWHj9pb19yL9",
-	"nue0481I39PksQmM2YOLkaxc7PXm73QBCR/xhD389fAPaJIy8vp6QAqmGJFkyJK7HojUPGZF5l77U5Ii",
-	"Y0KcgSKJFBpV+fB3ykhaKiYQiCS/Xf1BfpWlEjAzKz/K5A5QA8OzVXMR0+UeNKJTUNrp8+rs/OzcdjgF",
-	"CFZwGtOf7aOIFgwn1k19n236c+/bIF30q0xzXIjJxB6mFaCsx8w0RK/NY5+JvM+Dy7fVeiNQsRwQlKbx",
-	"5znlRj+jxDLBY1oTTX2cHFU4fg0ZwL6YxY4PrY0/nV+Y/xIpEITLosL631jRv9UuP9b7gyhzEx2GrEwA",
-	"1EnLBkAd+EsYsTJDsiozi4henJ8fJHRXSXGDYotgfxq0mVPmOVMzGtPK85ow4jmWSEEYMX2LDR6bKs2C",
-	"Y/bpm1dcCZSOdxqoS21LkK5wAo1vZDp7MoM3j6gaqWuB2ID51VEUWGJ6GrhbxQkjAu4t0B7ODlQP4P7c",
-	"nU4sjCJjaAG6ajW0+WdwGZTH1YHH0ybw0/l0yyxxGui+B6zyl6TOgLMWfCNalG1JWz4blk/PEJvNURBD",
-	"/HiFwDmqhfW3s0G/fnRQEUNd4KcJ10TJEoHc8ywjCrBUgrAsIzgBYmRqMgS8BxD2iQ3aVYdFmEhJ1WO5",
-	"lyMCU/uq1GZLnMgSyVoRo/kualqfWbwgkmo56Ts5nqpDuAw+/8DH8NXuLuNZIT5Wd9O80n6WDmfj/vjE",
-	"uhw/xGZbA6yF4rzJJqDxOWSOOQq1/LADzApjkRJthmfo5YxnxN4CWlV0YFFz94YhQ43DfFC9f9pcs/U0",
-	"7Ah08xLCzvmLaJmDFEBQrpqXkIl5HW2re9AAdrFXli+kbanfHZ9ct2Jh85Gu7ppDe5T/HspjtSf+L7ee",
-	"pTWp/WjqFNsSEzptodTCFs2LpgDS8M9cX9DI03prd3I04uO5q24sFv8GAAD//6I5Fyf6KgAA",
+	"H4sIAAAAAAAC/+y9XZPbOLIo+FcQtRux98alq8o97bMzPtEPbtvd43O72x6X5/TGnjNRgSJTEqYogA2A",
+	"VVY7/Gv24T7t4/6C88c2MgGQoERKJCW5XGq+2CqJBBJAZiK/89NZqpaFkiCtOXv+6cykC1hy+vgiTaGw",
+	"bwsrluJ3yF7x1Xv4rQRj8UeeZcIKJXn+TqsCtBVgzp7PeG4gOSuirz6d8dSKO2FX1yKjvzMwqRYFvn32",
+	"/OzDApgp53MwFjKmdAaa3YCQc8ZpfsjOz5IzYWFJL8+UXnJ79vysLEV2lpzZVQFnz8+M1ULOzz5XX3Ct",
+	"+eosOfv4ZK6ewEer+RPL5zTEHc9Fxi0+peG3UmjIkqWQ3z1NMnEHCQ38+fPnpPr17Pl/NBfxj2oadfNP",
+	"SC3O+8I/cLUQM/uCZh+2TTOtlo0VIoxPrFhC2zJF1m83hM0Bn9z8RfWdbG0n3EQ0buKApsFa9yTL3t5L",
+	"0OPwpuDailQUXNrrPsvtfdjtJ7w2Xft6lkJeWW7NK275DTcwcElG/A7XNysLTVwW0v7Lt/V6hLQwB02n",
+	"xG9y93BFAf+7htnZ87P/7aIm3AtPtRc1gB/wxQ16WF9zBE81166FD8XrVJXS9lxuxleNJ+nkdiFkRoTu",
+	"ptkO/OslF7nZCX+TQbmX2ILLLIeM3ayYXQjDDOg70MwImQITlhnLtWdWa3TNRQ5Zzw0w0HOv1g8S30vC",
+	"XNt34T2YQsnBuJtFKN8PBysi+ZycQbX1/d71R/U5OZuDBM0tZNfc9uePETW3XDrvol8ZT7UyhsEd6BWz",
+	"WhR4hn1oU4tiCEXS4+sH11hdGHMN/Gr3kvoQth+xo/5h5yv5sv2m0OreXIOxYkl8tB8eD2N0a5tCoKxP",
+	"3Bh0x/LDyQyVUmATVV4qORN6CRmhhmF2wS1b8DtgUlkGMnM032NPUg100AXoa8/o1kQhmsA/xpRkwNMF",
+	"UzNmF8Bybiz70yXL+KoCImNcrhriUV/CXG1eDXiLW56POS/3YhL2cHOprcclzT3oV9zCO5Xn40SEO2WH",
+	"3I5tM/67svCi2oE9hcdNqcJB2Hv9NTQDsfeOizwQvZ/qRqkcuMS5FKHYl5Ci6pmSCKju9V+V+g7GKhY0",
+	"wtDzb8zovtrz/NtPPkDXc+0xJEMFrOXSiw3DjlItcdsKu0qW/ON331xeXhJlEzjHQpfkTHOLLz7/dLbk",
+	"H8WyXJ49f5acLYV0n59ucJsByyBCxMU82zyPeFmtZ2KMmMu3es6l+P2EdBZa1nullodY0S5ZSki6rLRS",
+	"y4RpKHKeoiqP3ykJ9Luw5+zDAlaMa2BLdYdXXWnDNafsAjS9b8JXucrmQs6PaAbYovevL791i63l6QJp",
+	"cKRonSppQdprN3KLCDYTOXTKZz1NASbl8hpxgdtSQ7shZsnzezyWmSplRoclZ5CiNIIQGDwCWeb+orG6",
+	"hM55LLdlC7K8lYDHWoDMhJwnLMUbKqmmSZjTYJjSrJQ4ksQv7xcgmVTMfaGZMCxFsWxeasjO2Q8IG6GT",
+	"f4PNlK7WolBBK4tc8QzH4jKrpnM4iQ/9VnLNpRXSSXObixqquIcJBygtbXaW6uCTJpIkTdU9nq15Ahvn",
+	"3obA35f57U9C3o5VDXN8t/c1vDFb+/W7vh9ukl7gj7lFcfgOlr0T5TVwo+Qmtv+6WBEH+/v7nxBnhSSe",
+	"04+KOgjIS9YJy8oiFym34KiD5xp4tkKtAWe0WhRIRHNxB5LdwExpSPCLCIZuY+FO6EqdtzMQXCk3btrz",
+	"nSiOw1RLbTvZlwsu50A2RDIIjLvCSHtuHKz7ZvRl7F7fuCvc163ryLlYvhcZXAG3h5IsNnc/eoZZfktG",
+	"dGaA24TdC7tAdseWivi7jpVLoRnSKZdCSdPQZh9IaKH9ulqoohBy/sbC8lSkMW9NqDHaYfhIvsuLIheQ",
+	"tTEeIDmqYgWBPZQGDH0r4Z4RvhJXMFbkObvnwhrCjVoS41mmwRhmlbty9TIi60rDXFd9PFxbdiCWGg8m",
+	"mPYXD5f84xv38LNLUj78X0/3swGQ6nGZ7ClPtm7RWMHSGa92iO3Vc0yq+4TlwO+QeaBcXonuZHMKeHQP",
+	"GvYQyNd3pYZzy35whPyqXC65Xh1iPzaFtpwbe10940W3DcqStT0uZrjVewkTMzTMIbfNRNM6uN1m7aTi",
+	"Ntg696t+q2PnJKQWDYtXC4Cx+gkv7eK69c7/dQEakDkYkBntSwHaKMlSN7NX/oRmPyo1zwGduuio8Spg",
+	"qpbAbnh6i0P8+PoDuzAIprlIeZ7j97tliAq2jvVbUssKpUfeuxnY4LlY8o8/gZzbxdnzp5eXl8le5pan",
+	"wdyyS3gk/i0MatNKW8ieM1PwZcLUbAbSiDtABk7M+nz8haYkqNl3OHA8Lg66ycI8wO37rTWkNuItj1ts",
+	"I2E7ePbHrSJVyFOg1Q312vs2MoZPBXtHCDVInLXEsLTUGmS6avUzVIazyz0MZ3ML3zlsDHNtAvvm6i37",
+	"9pun/ydLVQYBVoQ7qdE0vB00dus1AfZSGWcdclKFf0547TuHmY2NQDTWTZnNwQ7H6XpVwigEmNaVlZru",
+	"j+ulkKVX4yv749Nvv708kAlybr+7THIL3+GYNHPOrbBl1vScZaq8oQCKCoa/xBA8+Ut9mrJc3vQAIWD0",
+	"NUr63/2k5JxmTZqH/OQvDrq/eNjCYzuAe/rnBnRP/7wveNy2Qvf0zw68p3928Kk0LbXpb0PpCwUNjlfW",
+	"tZB3wrZYw4hvuQut4StmKc9BZpw0aWGhEpdrqi2LjGgaaQAwRkBYx7/RAJKVOWRtInRyFuBtAvKDBniC",
+	"S2c5v4HcMFOmC9SxVWkzpXSCMn2G9+cs5/MABlHWzFu5btxFcg883BKV2Laf3dTdY07crSVh/vG7P7nj",
+	"64g+GnBK696lCh/C4H3Y9jiZx7/+pqd1tcNe81o4NaootDN169r4SUzSIQeqXigrVcoXKojsBlJeGgov",
+	"mSswTN2B3s0gu0LX3mQ7jC20bS8XkN7mwtjxanfKLcyVXu118kfAnhC0VsHXexdGYRASWS/sWQPTv7cF",
+	"uGCrGXc87Y6E/pou+gqftTjYaNxeUI/U3fz7Y/Y0frkbxP2CEZzru78dvHXOtzTIEQMSApS9dyGGaNiG",
+	"gMyOcHcnczsTkGffXVmurXlh3WVOfxxFUljbwHqmpFph92a+/liANDAOo/gSdeVae+gW/oeLrNF2BtH/",
+	"MGy7cf/tNVLBRXZ9szpGEIIp0JV2JLmyyIXtR/xN7LjCF9/e/PNs02joNqK5udGJJU1UidbXFzOruYdh",
+	"6FyrsmiPC/gRfzLsfqHMuhCtgfE8D8ECtF8JI7sQuSwMOSrMAp9DL8U5eyvzVSUbwW8lz8mPS48YtgS7",
+	"UJk5YoBArabEpt3kzM3c6eYmSBMGH3lqE1aAxuPhc7LYONj3Ntm4zaAZ4gnc6J6KmpGwA+6mdhSJrDv7",
+	"3VOkC6rSfkeo8iYzHVeW3+WhqLwB59flPErwx7JF9XxBpIzUQdRMeL+JQjOl4z+RHO5BzBeWfvHYxd7M",
+	"pdI+IIJQpWmNrhT9TSNST71+04Y0winWPMRR0iG4t8fIhvWr3cBheMG4O3x/LaZyt9fL0mIP9NN5t2rU",
+	"aUiPdmF0iMiYw/HvbYHJRYeNFLCcd3PP40lRWbwW8hjChBtblUeToknTfeN8uE1b9bFNzfvpoR36Z1Kd",
+	"aXQu8Tb2wKRxCO7efvzmonohPaxFYc9Gh5feQFdSKP7SjCeF8/k5e8pSblDkYd8wo3ILQqt9nAS1OQPl",
+	"6YKnwrY4P/6q7tmSyxUrQBU5MJMDFE3o6ggaJmSalz4x5DAqGnz39AA0s8N2E21AzxMfRSm4Xb0kqnUg",
+	"w4vdwEUiH8mUD2wgSwZGT8fuL49bpGBtC5imB/CTC8pgQn5xPWiYHXDzjEZhUdA8h6NR9WY3jBiqNzZc",
+	"oDiaISrxo5cargslxqR8tCJppsUd6CMpOQZ4m+sbAyENxRpo570quDEg56CN8yc7oMiHfCx2up5HXG1D",
+	"Eh/j5q6HRe3Cn3HcUWQwjjv6F7uh2j+g8reSS9t9QaJn0ip2U64StOLMNACz8NGy/0ZX93+efcNu5/95",
+	"9t8PdV/vqVt1X4e7fIvNnRztHRp1zuHFbug+cDNSWeWULARwEF5Qn1nFDLISrpXskeJ/RO+fh2HX9o06",
+	"VMvN7ahDDS9ugUpzacaHsXGN3O2Y7phXUET+mGPfg8YKyQ/gY1iqDDrtt7McDWoJs5oLmbCb0iQs5Tph",
+	"N4rbvU23bnQ3OI6NQ9PIBJjSYi7kIQmAlloN3NzEtRsvwpZeGDmOWML7Y+xC8cvbQBQjdQCnLVMCu4to",
+	"7YrhexEF3MgsJCv6cOm5ckq4sKSyr+nrTstfs8kewLXXjEYbEtZ3zii+eSmMQfOCMzYIOQNNRmStlk42",
+	"qzHHuW306mBxek3KXgoZQm6/HU1u6A7/lkZ3dTSurYrivjZVpfYw073Sw6vY0+RIXnHHzPjH6+2FT97g",
+	"sml3DbuBlcJkSEJTqxgnHM2FsecHxz9C+OujRfSGCfY2KR41kCA5u4cb0xpu6P005+wnwNIigsJhn3sC",
+	"XIgsA+nIz9ufkNUocoqK3FclulHWJN7dqh3P865WqiwAGYrkIrIw3POq2Mhuq2DzsmiLgWihrsaxNJFg",
+	"F88eeaOIYtxlQu+1wfRK85mtefzIVCUNM0D+C6Ytzp9bfyj8DnLQhuXilnzE95TIpxi/UyJLvElIaLw+",
+	"2L3SmdlXkXp26T12u9e9TxClGFCkpWNi/82qX65wNO8/+i+uOcc+Yf59Sp21BKR35N1Gb/XM9R8aNx1F",
+	"H/cMDd5SW3BbvcA4gHdjBxoOKA9R6/GF1Oc9WMUSjOHzjtKIWhSdObYuz8HXaHKJ1bszZjcjGtzs9Vxt",
+	"63y9vIEM1zi86F62XqqrS82ujrsXcVYQoeFjJxX6Od3IWxdIww1kLQNwexytyY3iUociiS70D1QiO4tG",
+	"VTuGrvjDRCLU8QU7LskdIQIVaKPrC65GIGJHKbOtBoLBQjhmygwqcdE8phb4hkqZY8Qx2tBkS92M11or",
+	"PbAa5vc8C9IleaWYZ2VOUfSZsXJe4lfc3PrQI6o+4OoJP/nJ/5ywwj75/j3KOSBdXRaXUZbRYGj4JyU/",
+	"561lNdNWw80VFTFtpKgBrtL5FAxfYrkMbiF4xitY/cN+Na3lMbqvjI1gczK0hOfbtv5HX/axyjMYGxSf",
+	"V1UZt+Fj53Qv3fsUdDr0MvgR7MZ4/cSzAPW2u6EPyLv2ap3HN/dOcyFX14HtbPJ/lJOvUaVOo999WFz1",
+	"s5CtP6/zzvrZxrhJDET7LsRyqirtWLfSDLgRN3kLyUS1IjRRHjIg1DrmQMqHq4sakogYn1lPO4WGO6FK",
+	"F62LbKc9rS2H+SCc6ljvTzDvQC5fuPI6E8ZymcL1EqwvC7gZ6bh5jPSu071i+WATH3y06nWqlM6Q+cJ2",
+	"c6BnKRlfbSS4alxZFa9DzntfUpRFox+wwAIdQtdGdWxCUiNN++KHIWx1gCMrW8aHsyaWI8LegL0HX5sB",
+	"ZBZ2eia0sRH2+luGbszwjEQXpZIx148VtVFoFdPbJk2gKec6Kinf74DV8Fd2ovUanmwAtjHt5oZsTJO0",
+	"HFq0Ix1os+9V+KUur21XVteYh8qf7G8BEOaaYh4ha8fAMcp7lG4SDd+1E0rOcpHuVbqG3h90pOuT9pRH",
+	"qrn6LmYUJ1vrgzGWtSdnt0J2J52gwynnRYL3jREZXHuXFAradHlfU5mbyoG2n6xLoCTNte0SfW2dYThO",
+	"U9yh3A1NxGyBaFsa5nZdbFt+5Y6J9ij53GHNiAh+sMYrekcy76XJiqxTgd1eP7q5mWU+mtPshy7xxEOw",
+	"pj+edM2wf4XwSMr5atAjOSvlVljH4E9z0I4t9wlI5nsN/DZT92MT1W9W1/EN3henOqd/6QfrVH9uVqGf",
+	"wN5zveJbp4mcyweZbmcqYaWg9XettPUmqHwK8dlUG7extKEI0jyhAwp7e649Wmo80tDlveKjVtbbB7Hn",
+	"KsOwe6xwz1TRvnENGwkBPfW+vbZnbcakhq3/hu2XlGnG8IphEnw1U8+FjLpBd1VjaGn5so24txZK6H/D",
+	"9q6SMLzsQetde+BiBD+C/ZEXYzFszotB2BVP1Q+zaIYegB+VQw6WzrYaMvd2Pjko24WuMHPHln25MvPr",
+	"k+1bZb59vGEr2LtrY59M8K02nC7vLa6uzuwze6T2DTuhljk7JcFS+vyE7HpYXt1ckf0jKo3vzdmMU/Jm",
+	"5bncu9NIW8qiOdsK+oDTGINyIcF2A+442XV0oFFnUxIM9Cm4TLtShaJcWopR9LuDDqedKbWb0O5VC3zr",
+	"AdIr69mxidvVeJXJ2bBzNfulmY8hsqGcMMzUcyGjRKqu+gvDqyqMqJWwu+LB4eniK078j1F9RxGFah2N",
+	"HexAlF8AMrNf3XaepmCMuBG5Z1h9Ub9tbvyu847JBFiujzuHHNS1sGuGzr6FLTWfNvFY0zBZeyX8bg3S",
+	"nMWv1tuVrB3Rthi2nVs2sr/w5iIlNNbXgff01LYGwjtP4GjWggpT9rcj9LUKbD23ZjP4LxUS3jHxjpDw",
+	"kPRkRwaGVE3pR73fHZDeDde2OQccyD5h7GPiyzsaZFQxF/eqzDO24EWB15j7ca3jf/8eGfsFnXfs4npJ",
+	"CrNPTYpBeN05c0/jhJtw6LKOiBmdgs+XEdF7CuHRzhBnP7hYstOF3yZn7Hyp6zpYt8+Mu5Tf5VxKIedX",
+	"JNqN7xIP5rqtz07ki874yoTij9cdF8Jur8H65mA2dT2sV1/2G3NdjtpFza07GJsifKBtodU8KD5rQRx3",
+	"oLE2Kk6QgwUJxiQu9e8SleOnl5fnHd3ouTQz0PUOVBEegxhS6xI++MH7caVqdckGOmx0tu9Chc7z3L7S",
+	"Qai9fjAH7SVV/Xztq3S2P1b1XO/ZYj3eys0pBi2/eagDw4m1WnZ4LHfzJ3qZHu2A973IRvuctMjch74Y",
+	"35isH4K7OfoAP8orMLR0Rp/CUMPKPA3xPvmyTftHtVWVojaphH66xijqvhEhg4s5NSZZX1fHUV+BtTns",
+	"0fj5huc8ZAX3xdfNSb93o3RHUASGud80wy6Bamnx/L33sbGkUXs6yKo3QCVX95ANGpv8pcNeOJJqH0HS",
+	"WEeytme9T2mfG2SENz1cOj0iJobvWn0prfmvu3bDlwD76QtGrLfNeYCg9e5hhyajcbGErmCEnU2lfQzH",
+	"2CbcfS+sUqcLbrZ3Zt85WVwI7yA2imrAJN7GNXAbe9R1mKW+g9XfSjB4amOlKGGuM5jxMrfbmwuT/8Gw",
+	"TGSUsJnBTEjspu9nT5hx/jw/mOske4/Nhm98fmh70lg1wiDyaF96+KLzfjQ7gmJ2YMP6sdZbVw8dr2jY",
+	"wTWhH3aKIU9gkwi0WhZ2N4r653zGwVbAjxTLvwci9Dz/rdH8PU/tEIeVquXSq4mH4nXDzz8509x6s8mu",
+	"CgmtwWENhKlGS6rV7drGPeL4XdGhXa2unfUZZ6MCSEigzKp2m8p+yBcvZScPavjjtoB/v1BRQSfLcuCG",
+	"2GrFdNuXclgeV7O1sOlNt2B/sunepIHiJO1Jh/rI0ag2b2u14n5gDk0Tcqu7z/Ud1rrdKZx3b1rtQ/JY",
+	"P7CKyNGp22wJyqlWCzxdhM0g++NTtD8+a9+ktqai0QHstt+vM45wnvXh1cBH+9qBXljC1exRw3UQwTcm",
+	"63fJuDn6AD+KFrZX8d15uwyo0ts/GzVTsiMZWphrDFjJyu3FCVgGPMtRvCTbTOaKiuAPuJtO/Gwmce+Z",
+	"7uq3IWnsZ72WBuBdRxkM02bfGqnDMHJj2p5oWc/We0GjEHRoMeIxBYV7VAHqib2hRvDGD101elvR6nDl",
+	"d+kcRPEQ1fk6p35b2r6WwR3F+TqneCPlOFPT4CC/UU3wka1WcXmNbvjn/ZjumBplTZvJWkih+20tGIMb",
+	"VnDhuGdb4bpD6R7b297vlJx2dKbf+f6ICohKz7kUv4Nu3c1ICmf+SZSA4s0dtZ1fU0DoQ1SBpBlbq+G1",
+	"hZhGtBmhWIwua+fYx2S2k6M9HFuNeF7L3rfmDw3K4enHi1+B5SI3e1Tl7bkBaxPhV239cGnE/vCGYYYK",
+	"KelC3FV24o4ot6qS8hL0HDImpEUFXRHxenG0H/vZUnF+48rafTHEV9juu6d/0fUjlgoQa3FD3ZpAtenV",
+	"84mr6OT4JMisy66MzBI016trbi1PF0vocJa3lVLfvesjqh108X9fzSXTfGaTeKFKUjpTwmZCCrNwS065",
+	"TCHP+1Ty9i7x3fUjRTNwp2LHG1vTjbsNdt2691uoOLZ7jWQ9o3ofb5m+Z/xTPOvABY60HPs0qUOs8WUY",
+	"rfPaGeAt39alraekuZNPbFJ5CELdOYNWOXSKXk6UQrmr3qVz9tZ5vJZcouEwcKPzEaTtTadJ1XYBP2eQ",
+	"5kJ6Poa7irX0eS6yYfk74UC6aDhCGb8Lyfa+eP0R5kuGFXfuQMcS/l6lYX4Ixfa/RLXi7TP39EttKRG6",
+	"c/AjpaUfL6bbz9gznPtXruUeOZX3/vUh57k+Zb9DrGbquZA9y8zt50bYViJ/hHpdaEhF4bvPXBda3fA6",
+	"cL7FZdCzlHqzVmWLgukdCt3Tby9X92ZJXaaIV4+vZbhcCmt3ibXE55lW9ya0cvUXBA1KKj5nmV4xXcp2",
+	"8TYLnRH643Lr+t6r+87b399H+03wxg3SOckBpuhew0b1x3A6Yd56kY0t7Y0ejdUNrnEBXRmb3KgexmYa",
+	"oXq8N8zVdh0tmbF7af1ud7+whoTTvbw9OhHU6slQMoonfVGNsiUwd69+RUkD0n5bsQ7V2J3pfbvAqpXp",
+	"afDlmXNXc1xhQFiqCuFqQIQ0Qat0VZ2fOgy4rMh2cVuVOoW+gPmne8J3C4VtBQqYG2gbaGunV8OZrG1o",
+	"Ayq3d62n6qf6n6OjrgKw7eED5U0u0rAz29dSDdR4rR1oC3Nn433JLc/VfIRcM0TFjSZ8LTMX7N9Og/M5",
+	"6AOPu0mwbpKkWsaOPaqGHhxOt7WmWPuhJmdLsAvVLgZuyei0i5Yf1gsEEyp7pu2n8e82K4i1bwjeUZHS",
+	"Oa7/2tH6Dq6tdcudRAvZrwjGjoSzYDlo/7VncBy/RWdSWTDOTKFiwyMaJKSyHfV3nGfK9fbbRMSzn10r",
+	"GPdzYJweJFasx7f5Vgg2mnwFNvS/cxnjrmlCLCa3hSB1u600woK61nVcB2mtmUyhrKHeDYlrc4p7U1tO",
+	"ztnPvk/qfcMbsOAGGznkYik6tqs2+PRJZ6oi7GJLTnXajdE2TqINF3/mIv9elTKFr4yawgDbdCRfmIZl",
+	"ClyPIPgojGX/bcF19t+Zd4LieDfqI17c1CTTAspBXIt8xaJCwOy/GTWz/33vRs44N8OhujiCH7/1MEDP",
+	"9+lj13Q2dvYiWaIPuR0Zq6p6zZep1t2297Y3mm2wFRol8STt6YgoWYJhPNfAs1Vcnu18d1XTRqqwW0Ky",
+	"2/D+s8rA3a9/K6GEPRya/eWFtTnxrPvIC0WvFXzwvtUDRtwvIN8vN4YGqLXVnc9rGBbgVm/Be3qzS7Ma",
+	"14jfv7juIgu70lxeDfz2s/KADrzvXZPJgRFvNtzAPfa9n0GhWmsYPIlBa1v4L3C/d3DasOS4esb2ilvw",
+	"0V6jSVDpNlZlDEbKoD+ZHgnNr7BTHLmZeZZBVne+osAaWJoeLhmCvTn/9g1bfQEkOV4T2drjdeDCPbHr",
+	"aifuvWtWqT/yblbi0KHiHvbwZ3buf9suVxW/nG5WbfCaz3DQfn8xao/P+BES/FvqEDpysxaApVHa9byF",
+	"XeZdSSF3Iuvskb+16nAQyzd+uANtuuSIe5HZRRuQa1sWxvDT1NTfhNgvLYybhF1o2913fBXY6TgVZz05",
+	"vaVjexVIK+RmxG/SDLpNlbFOSUQReC7uQDbiwkJY11JIsSyXZ8+fbsi//fu6z+13l7TJcfH59sjg8ETC",
+	"anG6Bc59Osx/8+wZAdO7Sn1v3Yve/rxW1L4lSk/UZ+WOhfpbehtEHRfdfTrbb7wiF3YXv3pJLNTvusfI",
+	"K3yxLbgx7FQrYmtAP39txB+H3qmSFvesXd0O4SlLPoeLfxYwT/znQlYfFyBSaihWOO+YUPKiyGbjVWna",
+	"BrS2B/a05B9DEOI3z56NxpImDq4X1ths7x49w8oiVzwLyioClzCr8ozdrJwpi4mZD8OeqVIiK5hBiqYo",
+	"VnWKd0FE5OHIM0ZhoffCwLhQcfE7XN+svHf3kNyj3i4hv3u6acaoDiZp4k4Dpp4Iu6dLrq9/Bz4WQg/M",
+	"MVoAz7wroB22XV08zv7qRiCEcejDlqWx6NyiTOOQdbGxUVsM8G6c615N2tf9SZXBPRqkXmdjl1qPz1ef",
+	"CBVCsE//YW7Uo2DvqKtvrlUK+ng338CeK4MY5uFvnyGtW/5WivT2RZbtJ2u58IvNk3Jgm+Y1LaSxwKm7",
+	"L1l2qQc13COPbhjb49RP+NgS4j3oAnl2ebnJE2ncftuyj2V39WZc8OyYCC2uTVW+pzUklp5wMbFeOHci",
+	"FUWpV98NCoY9fp4Te4uYQjTtRqwCfnol5vU0XLTZLOoNTeLjrDajDXuuUi7fQwqiGH1X7mK1u/MYloB8",
+	"v2cxEu2gfZMdpgXVsEoU9eQR1MM6UF0txMy+c4yk95a3BYxQxYSKVakZ4w4PCTWbPkKXccG4rXKFcAvO",
+	"zw6QSxbYDi2ru+YR/grZdYhjbq7nFV+Z9cb/6MQx7GbVwznTGHxnktmVqwWOXdxHR2O3JM+urcg/UWmA",
+	"PsayLkQ+U7qjdEquBngn2lZzlSvbM8q7JQ2Spu+7cfVUw3ZwcFLXvk3E2nKO2he5VhZrjIwxvJBP+7Qb",
+	"ZXyW/OMbN9zTS5Jhw19r5zxMASOh4+llkok72BQ8thfX6QP4uCpirVaCUDmnKhvTKBWDlzV8tHt72N0s",
+	"NJbT5Dsq3IwwCwyueBaKXrpEoIUo/BaPT03rpdIOtYttLwrasTCz+HKlJGg6yHZ28RjW9c+PitELg6tR",
+	"JFu6/7VCO9TxnsPX46orSj2HQW/s68GL1h9Nv2Wz60P8ajb6cLtW9XIc2Lxx2D6K4ntSgsbWp6aXu/wg",
+	"bmhWoGJIWb9esHJ9/XxFlNbYuLoCxZ6p7T2qszhVwGwDZ1ChFjBWLMkj2rEtr8MDzu8TwAgKbuRqQVsT",
+	"+ZBWgIpAtvFs6MHXulObO0OaxLVfjYAtsBl0W9UPOsM0swteb87/YRKKfWTYJ9MDRl0ImsXetjPjdfx7",
+	"6Xe6u3MZ4U6Lz5a+JyAc8jire4fIXmj1T0i3nBG58Co0EIYZsCizuFI+pC6yIi8Nq0675xFEcaVbiYZ2",
+	"tnIwswpgD4GEObfiDhy+klljL5pxCnAHSB/wVKsjxg0J7bR7LXo9narO+PeHmTT5yCYJNeHbPL7NbW3B",
+	"9X4MsImAAy2VEePpwxb22Ln10boXJ7N8KFdfcilmXmLdTb44wc/hDbISrtCV0O4+I+zRkCqdmYSpgv9W",
+	"AtFVLnDoVtcHOoi4LXUL1X/PDfzLtwyyb549e/oXVj0ZkDWsZHdgRrXmegHxzNv39+dow4a1v1d6sCDS",
+	"PzVn6165R9ktrOp4ezcysnnLQr9dYqi4yHar7YJ/8+xfWsqFwkd29dcXT7559i8sE3Oo7zm/uwnzxXVb",
+	"h+0fntkWY7I7kqQ9LameN2mcTbXMLix4xS28WRY8tYPtgtwyCfdk3TMsB8xsiO7TIufS1JbCg9j/mgDv",
+	"VK8yvbrWpWwPPBtsFRrcnrgJre8pvI/VMnIXiWDko/hzdrMig4RrR3gDrn6oX36H5W94OZ8RBTubW1BV",
+	"2NzSBj1FgQCya9cia58WZz2McjWKJE1leQOO6PgbG7F2brup7Et3sHyo1pNbkH+gZPIlemUfqVRLR4vp",
+	"3fu1RilTCdovWIIWTwL7AQVeP1KK3qa+l4Z4dtU6pFFaNSgopDUHYdm0yhpOPOnOKmukH6JqhpNkHHPF",
+	"3Ksu1mtYPbnmVD/Q8VZyWHiHuXdcxnuVEencVLguH4XtviAgTOLSMGW7tHYLUFwXC2XVda7SCuk61o3P",
+	"GR+lVMNA24sD4V9Csx/fXbFCGTrdc/aG9G4N7kYlW7977PX/9eYHlHJ4M8Ztc8cK34vwete5+NYyeCKc",
+	"3QPc1geiZi5UG/V2i81kfMQAu1+IHBp6fSONtAMirQpleH4diKwJjypAYkC4IUEuEi3wiMKFF3YPV2cS",
+	"b8DhOVvyWxfCsqQIBkJiLt3aqqdaz1LDUsgMdIesU9Xrx5/ZDcyUhg33srCmid5cA/MDk7FJoLGjRK+1",
+	"Yf/x9NuE/SlhT/9xzl5jFBOzpZZOoArAoKw6G1TrP1rIQpW6bSGlDpSR8VXSiKdGrvq7kuA7oN4vRLpo",
+	"0KZJ3INkPHO12F05+BpiXPOaIhJD62do4UYvfnlRARBbR3Yrm80lr59lxZI2iWEdGSP4OnhNO9F3suwF",
+	"12OzHgEzFkIU4lqi6Y1ReWmB/f39T2Gn6HH2b1dvf+lSLDVcW3ULPS6v+OEkAqR7mTDa5G0KDTwzOEJH",
+	"yGVyZlYyHaTWr69nbY54xK41feDzkc7v3TWday/2N5ejHdfoUXUu66Tx5Z/aYuf43OxY6cjeHcPKVw8C",
+	"S3PprsFxx9AVAPeBSsrgb3iJ4MUa8T/1fJ/SrwO97Gld68FNVM9TTbJ5llti2da3bdSZhq6P3bsWVwQW",
+	"clD0YdswVGeISXV/3qngiyqYpGs4iAZc8pU/V8WkD4cYiaG+S2WVqxdD03YCfy/wqF+iVpULY8fHb2NS",
+	"II7SlaLYoRwPiGbuiKeMJu5eYJQN+KNW5ciQjHbN9QCpJrvqI8SuFy/+UDHWhGkocp426iUIiaHO7Ge8",
+	"Br14Q9WpNqPXR9bi7B/mTsy+Pd6kswrrxoH9ApCNvNl4moIxoi4juSaWyJVTFQDydMGFxv3MSrxol8q9",
+	"lLA7YUqeJ2wBXBNvNaDvRArXXIqlk8170uqufaPdcpy2BmkDIg9QgGcNHEKuTIDlunvBdzDHBwSXCX7G",
+	"/+YonsnrmQZIWM5Tqwz4vxY8x/XfKrMAjV5ye83zHPR8hXvBZ0pl4YvjbEYNroM2BrYBqwPVQxoDug4n",
+	"7ZJUti1lZxdgnfH+YdeTNbQLM3UjOzb0Gong+3Ty6k/HVSrlkM5f21p6He022NGTa8sZ6LGBelv6MnSW",
+	"22maBtBUMVfOiy9sbQio8xgqSwD7VdgFOoiEHRI0c9jE4QHtIAbFtX5Low/zKQ1Kh2pr2rCm1FcWNjSa",
+	"rJTMXO4Iima8KsS+9Riqul6H3fShvqbxtLS798N2Mgp235HpXl/S/Nv/HIRROLe/X/8YFuP+u+MuahyF",
+	"idSwJde3mbqXtFuT0flEjM5D0cHB6Ifzmuop2KyHpfk+S0opfivBKUN1+sZfLptb8vVZvwesU8jvLp1+",
+	"+yda1UGs5v3nr6b7/Plzy9X07+4doeRrrZUeeiG1EuCVpQqRcSAx4OAu39XwJRA3gJBwmnM5L6Oq0L6l",
+	"QKsJiQbqHx+ytjzX3KzN09LdsGGj4n0GUduDCqJ/7N5cP/vXvsXbelcUXPMlWGghx194XRrGtwBgBbcL",
+	"vEN/KzEdsnq5dVoq2dw2MPpBmP81urtpgjuelxAIXzuhit2obNU6hS7bwqPrU2L4QGgnUQK70eoWKBpP",
+	"SFaJ475+idJsyT/udmitIcwmnnymyMWZaos4LyAVM5Hy//pf//X/gWEZZy/evaGNZIrd8PT2CcgMv+ZU",
+	"S+a//td//T+K7jR5DhrvUWN1+V//b8YZZkdKC0yxX376lf2bKrUEvE3Ye5XegjXAHZ9z2udZGOMsCiI8",
+	"e3p+eX6JG4nXFy/E2fOzP9FXrig14esFz5ZCXiyr+o345Rxa5AZyqGXE4AzDypB02bpc4gTFVzyIBZRa",
+	"GIuSky6lL3QqNCM43ZVOJgQ8jpsV8yUlE/qhMvu5b1EmLi0+7majusihEbbQjO5kPwBuhSr8CjA7GzvM",
+	"vMCV1YUpq779xlHqN5eXUYEg/BhX+PmnrxfpONPAwqeV+f/z542yKa+8kF8/k5x9e0BQ3L3QMvH3PAsk",
+	"5+Z8evw5Q9VopSsWQPjGyMGZuEIx3DDOboBr4hfo+ETwvvnmYOCtX5otgHoFr661vOQ2JUwjCq4Imx7H",
+	"142rb+4wjZ4zMX3ENRwciZyHBrCYiId7cPYPHGeD/i4+uZYXny98agyurlCmlSKxf04lQ/E5F143ypUB",
+	"J18GAmEfmtTp4nPpXfhoTZSzk5MYhqRHzoj18ZVMwReqgExYyP6V5HY/j9cXFlgBSthNsnynzDpdop79",
+	"5tULv9jozsKt+nQmcKW+hr7zWtQ9QWq+7YyCNTrsqoT7jw1m8O0gdAOJ1pD/ILMk3hFN8+RE9qPJ/vLb",
+	"44P3i7KuitnjYjSeRBiPWc1wtqKB5JhurmJVYWiK4IurFK8WvtKfwt+7eScCnwh8IvBWAncUMoS+jeXW",
+	"dErslOwYVYq6X6g8yo1C2cTRrtLmnGFv0xKlbm7ZkrJ58SHOXHmWbgn7imA4onBdzzLJ1X8wubrCVUR0",
+	"J7l2EQS5jwqV5xefaLGfI7LYQFzMrHin8vyDj7PscSX5J7tvpN030OGQMVrC46CJielHaI7g/eX4G/JB",
+	"KeeDmHGRE18nG7NpoTNOWZsMyYfcw1ThKKa0ZrsZSs+1bUE5+F4QG2m0ake8k8j9Uo+23gtnTZgsvxyp",
+	"0gl+r7LV4S4u2o6aUD09fP68DtvnP66wOjGGr5YxOPSNecMWjoBXMGUrXJAB7OITybAf1m/izRji2uFP",
+	"ueGR6JswDTwjxwk5BRFil8/nlFEXHYBS7DMvpBpfALUyZrmkc66Bua5xSbBAL5BJ8RtV2nWG1G5Lph4W",
+	"qMeaq2pdvZiRiR//OoSHai1DRYc/TWxpYktfibwS8YmahcT8iZjRLs50cS8yz5lGMCinYRV8Ts0SqPD9",
+	"Qt1LRgyKiRnyht7c5FcHyYPyFHQFXIS2Ot0DTXQ70e1B6ZY5Muwk3xlUZmxfD6iTWuNaQBTmFkwIhlld",
+	"Gqp8JqhVvK8FZFiojxPC7aiVajvh/lAB8j+pyM7R7ui2vudfLeG1ukMr080tNPiy7/dJ5yrqJuBm66lW",
+	"B2HYErh0cX5SPaGAGqtUbpywGI4Q2Mcn0eAMPlqQBj+F9voNUmk96zcxcEc96o1u8X1P+tHY8n4SJmBF",
+	"fSihUzzJ5L5XfIwpDexwCLPkIr+4oYbOptuD9SvcLJS6reKor37+8K7u2MDerTXhNqGvNqPuxm74jLQG",
+	"jP7Fj8YFEV0HY2sprchr35gLN02V1pBa4+NTfPvmdg9Z3ZjanB3H+LDZ+noyPESSwiNyCtFlxSu8rAPu",
+	"uzVxRddnJ0t9RX/d+MRdd/luSrczleeK+s0oElgTIigjXKcabn3dAyokXUd6qft2dvrWgbQh324yezes",
+	"r6+w4QwjeZgCFGuB2BUW6C8JJ5sV3vMVw1OnkPeycAJB13Te97xjhrY3l/xj6OlYv7sluWbbQL4pZO+R",
+	"jmlSWOvxOakIj9L/HEQ3R+6t1NcqntP194T40pN0weUcTHDCXXizP13WCMimO+4dfk0dBl7jCC/dAKTe",
+	"vvQvPz4HnYd8fVkThUxK9F5KtMer0G7JCZ4uwN1RXpeqpUILjyfWN/WoaZSnKRS2F4mahS9zggMQjb5w",
+	"L38pEp0s0BMRPrhjjFC+QYNIFyxQVhcNxoL6xafoLwzLRDi5DJaQdsX2ZfUMS9USKFZ77nIieJ2FGXuz",
+	"Emb5LYWMFqrhayelmy73kI4TF8LfVFhjpTn6/OZVDVMvHtBY9f4RnofXm10vzmpVg5Tnp8eDYpIbHnXo",
+	"dpYRhfrjdCUYIlLYoc73ZBwXn6rPb7LPdXugzQv9FX3fg6arT29efWHyTlrHjxY4hYdPVHpoU9vS5VfU",
+	"hOoCTw5Hqr2U4S102V8fPvBFO9HKJIR/jZqwaVInirh8w1o1lE4zSHMhoUGna0VrNHjreTy5KZRNfPEK",
+	"6oTs099nQlM+BQQJvKq+tClrb2UArzxgEwOYGMAfnQF4WlhnAHWZqH04gATIzLYMkk4SpRqfD06gB001",
+	"2axgOmmjj93P0yQaX/DTR2JEJT8ZEcLwTBByqLZapAxLuWQzrBIggs+4mmQj++PrI7PDW5y2lwmeojYm",
+	"ou5D1A6LDkbXeEM6328zYHoGkJ1zq5bR5bgZwpFzi8uoi/klPkyEU/kjC95bZTajTqICkPg4e2HVks0g",
+	"hJ/gJwr1A92eqeHKFNVx1T8AZDjG15Otgbv3Pz5OQdaTUHycIGsEtHTcgKildxxHG70j+Hm2R4KEW9m5",
+	"0nP2IfidXt+BtBRcWVIXACwZ9+SnV47CDXCdLhjIuZPukXUZI4ztTM5aJ/l/czB/NQSfZ/9jEwtaqspN",
+	"9D7R+0h6j6jMk9VeVO+r+3R7ol/IlZLNSsB+LvJGo4jvxqAatlRJxMVMC1/gGEuiQXZOPCGq+Ef2doN1",
+	"MHkeIqjBZXBulByUiqH/G7RLu0r8DFz6Whg+C9SVB273aq9zjvcQ2rgel3UcwW3tBnELmHSHiY09KjZW",
+	"Bbn3Y1gA1lykPM+xpGqnbPLrAjSwH5Wa51QYOjOsAFXkQJVYHVOyC1gxjnHuvkF8qqSE1BVjd0EYzswf",
+	"9bFzlfg/unqHUf+y1oKHJJ4gvC8DuO28ZS2+21ehHRTS3jaOsdwOG+iYtsTNhoUTw3iUxoYfqFleRSxy",
+	"HoLhrAoE57A+JmJHt4GIS30HK9OnWNOVe/QR12pyK5iQ/hTM5oTmDnvbSyW537bYxt9zohiCg2I5fYim",
+	"dt/j7YKJ+NEDFJGyxNYGzBVlcUb0m2BGo+4xvCq+RIV6W83pX4qUjlVLKRDSJN5OhDsg+jIUMopot51i",
+	"8WayFLEdab6baiMprbtSGZ30WAuIcAeSiVnd8cElbyy4Yf8sjWUpPZ9R6ZAMpBUpz0Mhgo4sxBTakhCr",
+	"1jvHDZGO28w9SHT0mBJGD0Oah1O0XpXuzZ2LfxFjUdUyOEa0SUVdU1GJ8CsyrCpJEK16U9R6DJojcU4V",
+	"8PH1jsQP+nzhyo5sye7w6qbnUz701FUpqYuU4E3vSplAVhXZ8I0xEAyRmXP2ouq3xjSkSmd189zE+9xn",
+	"IgfDlogQKEeoQuDgYO8BXIyasUrzObruuPHl0+rOLQ7x2o1qxB3fuMUehwHhDN/TvnxpzuOW9Wg4z0Te",
+	"HeS9RsjuWAPlOZI730rMoZz+NsWVCMEVv/9SZe+TlpSxJWcGcHZbVXYQkGcUnSpkmpcZrBP2v6JNLDy2",
+	"1m2bOomIjAnDeH7PVyYM0l0vgcY5e0AFHA/BtfOagtdORwvP3Im2EWqH6v3rwl9uGd20TntOvOps3CVK",
+	"nS/xGbxN3XOuWY4qqy6lVFf0eTO+JXSqJP1dc2mcgTh6yxU1xd8llu5wLXbdayzFKv348mqpNLiuvCzT",
+	"q2tdyoabLYcZlXYnR1rdOMt72yzkuamX4BbI7lWZZyxTflizEDN7XQNPj0Z/uiyYOrfUSQNcry1EyFo+",
+	"cvEGrTaHr4ELUjhi2CJkX8uCp9VyqoN2YhSeGK0n7n/Sxdv8EW3XwzbgoZIQjXNTa9WkPNtdx1U6b6OW",
+	"4CrYBnxQpe0CUCorZquB8P2MGIBe2FXAixUJniZqaspNO2IQ+piEetz6HaQGcK7dYMZXXZCuo+WD6Lab",
+	"LdR7SZiH9ZFgyfA3hKJ9b6nJzjVdjXUI6Lr7tFt8vYjIrTvGSximVWmxVFieMw221JIkxJo9xZpj1OP4",
+	"mnt3qWu3Htgs2cIsld4LHLcGpNWJGt0iL2IO8YD3ydqtqfScS/G7U9GpxuRa1mgbzwsv6Wua+ICCfsW3",
+	"vz5hfwP2H/AdhNBQXdZVwgoNM/ExhBM9obgffMf3IVc6A/2cqTQtNeJV4hqMJixVxl6n4CMFW28ZZ5Z4",
+	"UFWkxuBJGzkZbaTJwALrrb91Wsl2n8JDMbijOgr8clYP6iyogZgI7jETXGVyj2lu1UVx2Hc/KiOMoFMB",
+	"8TNnBgzKBl4Pt0IiKXKK/aqJK8wnq7nOPm+Xoy4yzWdbzPzYQp7aaHJUhlBYwP/QolAVLK7t/8IavPes",
+	"kLSAxEtbQeunS5ouS9AgU/B9fgs3RRaLJ1yTFwOT8dwlGnoWVM1SKLQWo9GDCANBFc3FbVPUwY6CN2Wo",
+	"2d6Tmb2ifXncHI3WEF/fD8LSNqCYeNoUfrvV+UE8yTCrMr5az6PHnyITY1szlYYUs537/VaK9PYJz7Ju",
+	"DvgeeGZilsrutbAWqHNKkXMh2T36LBPHeP7zLBNSgmbcspcqVex7vrwp2UwLZJzfXD6/vPzPMzRHUgiu",
+	"caqAY5FiCefsAzU4xwXelCK3NAnXBnR9VqXMQBuL7yCfpFYCngXSzlXV4xOnIIFEY0l2zv4uczBUjW/p",
+	"MhsMOA9rvTZqJ5GvWOHyFiAL9mYR7KvfXF42+lJ5H9UA1vo33PQXWfbIuWtYxiiJ8fKIYAzjr4dk9fvC",
+	"MvH6PxyvJw6Msl47v/9b+DnmwCOZPZnsn3jO1mlAxO4fZFnSwICni4jvk2NqrkJcXG05dH2om0ZEZO71",
+	"5OwexyMIIHPuKgpVeff3D2wNZHdQ922+r/7Gxit8851f6kMZHn+B+02PS6epK+xePxiogzJemV+4wky8",
+	"sRN7e9zquT9GR2YUi17TKxU9lQGBx3Kccj4HYyEjTO12WmCBNpL/TKCUjK+Syk1x+efnXuj65pvnl5dJ",
+	"QxqdIaMRknGNB7Bu5+c5iocrSrnNyhzluRvcLSryds4+iKWPGMDlL3g+w7EXqtTByRuPVc2wdBWdaRAS",
+	"IIN/JHKjurb8s7AyMhdQtEB/HhZ2j6B8MC72iq8aHmOrmD9Xf2QufaHV374rn63BzvoA81d1T3nETakd",
+	"czLMOfu14Q5xkr1dFRRVm5VuxxtLiQ5XGFaabkdJeP3a965tdnLhH30nl2+/vUzqxi7P2lvErEkCCHs7",
+	"qFVU6zqwPtBDGGb5PKmCD1ZsgaEv4f1Or4rl8wdzqnikJpSe7o/HfX9cNdgAMrj9hdRP4f1eRbFb+eaL",
+	"MMKXDGFqGbheyVTQcyK9w5KeQ/+Y3hIM5aIws2aAmtXcLA5AjBfe9bCrHvYOknzhR5koc6LM08xfdAje",
+	"0FHWXHD7UmIVh7T3Bfm2Gmmix4keTzPOUnJjxFw2CTLg/bbon7Kra3lUs/MGUoXCr8c7cZNDFRhQzUZh",
+	"4AAuDSCU0c24yFcsEyhB7wrF/4OQ7hEKEdDRV1s11SKYeMegu3wM5xhwkRd8tc3x7nKBKT8I88ELLrIW",
+	"5lLlIPuecAkzRS4o7chFHCnN4LeS5znFL1GiSNTdgnwS7AdvapLhaz8YS1UpKRbhniMwzjFPLaiFNBZd",
+	"72rWBAiMFcv2/CJldnG1d3w18bNudH/HV19FgOZrhx2TAe+RO4D46ghmuwsXmLilIc+HtZAbTb28sqbB",
+	"u6Pdzg4G8t7NPakzk0hyom3vEL8PbV3IuIXPF6qwYil+h04/7XugXB4TXLQbPqlUKZ0J6QpxKqYhK13d",
+	"TpYJV/yEWc3vICdPbOwtdT6E4K69UeoWamEH81DZLyHgzjcjcAWE8WfvjhRKUuoTNfyFrL+jFTM434a1",
+	"Pyjn2NtheuR8qLBL2Ss+BbediPeOM7NQ2oJ2iXpOIFij7h5JUps56RulCqyKSL1SGxy1usmHJCicGNEe",
+	"wfhBW9sk2cn+MbGIAfaP0Du/CuQawyN6yR6Ust4peLzy0kNVsOUOcs9HQpBYikiUllbcwTaxhBKp0gWk",
+	"txg2YxdQSRgoO8yAkw13mOzwnmCfBIctgkOU/4SbNckOjz+TGp8jqq1IcC+OUBVBNBeFBrS7bjON2lJT",
+	"qeW/v//JN+3NgWL4ilxxzJu0ihmrOZZuqs0KaS5A2rpw0Fw59UOrcl4t3GVluoGikovLIgcb6SQ1wD41",
+	"E+s1nrO/03so+nDrI+apYnQdxlcv1Gluzy4vf/7eZzLNQgzidiGoHuKd36rHnUrkV1Gv64GMmy1wTHzq",
+	"Ues4lH3haDlqfFDTYINFVd/24FGf6j/6F5aMCLf++OBhitFCvtrGxhNJnmIRlkOT4UW4pnd7VRFUI36H",
+	"YIeoBAeSJChigxKymEm5lD6uknI4sLQSFn38gWoy51zPSYfgzm+ai6WwTOluAYAufWEN+61Ulif47D0l",
+	"j/jDY8JtqQeMS6lKmaJIsyogIUEhEyblOqOWbAtgP767YoUyIlhAG+6UYqGsQmsp5T5XUBiwlipgohG2",
+	"tRlSt9AR866XYccnHjbxsD9MXRuP9JuMzPORQfzMuW46bR84GdfQiL1Yy8eiEJGqpAF+kypjn9ehI6ZA",
+	"2Iq8dKOEEA2ywrqXDRW1kZCF4gYu3GQFdpct5HsH/pfUQI5YzdOtZiLQkxEyNh2jPrSpkxqpVUEXNb5e",
+	"ayXomzngfR43ZU8226knzuyYC2PrAtRJVH06CUFX+C03+MZCGKtCS/iNNhEJQ2011F1EiEKLCXYLq1Ay",
+	"ynWycP0guFRk8gzPBSlGzRp2CZQxcMPj8pHbWQDt2cmwgLpZxUT6j430HYGO6Q5xUdFnT3X+ZfX8CWD+",
+	"j2Cr9UzX38lcfxVOxzRQfdm/zOnD4PqxqpxWq3ljYfmgkbRrkEx0dzr1TisqY8LCsov+tt1DF3OQSJNb",
+	"7FkvsHJUwdNbZ6KCpWE33KCjPirvnoOc20VViDTNSfsjcTP1xZvw+6h26Tl7Q2OFgDxfhbxeUuhQVqmL",
+	"WWh2t9t/VeH8j2F5D3Z/Pj3g/enWMl2iJ3OJugNl3Bc50xWd7bxUtxL1JyTToeUtGvfEQ7uM3AKm4PaJ",
+	"5I5T1WLI/Vkl6m5LoD1J6jlWP6XxwvFEwlO6fdzWaA8RWMmZ0Mu+hhj/9IOJkRPiT7WCj18reMZFTtqa",
+	"hWVhN/pbOyKofJJUdEJmDJ5Q/0Ih74St6wL2MYe6Ad07F9VEXW7KBfCCgXShlOR5KFROddcD2hqWck3e",
+	"DPb6A5//K8HnQytueHqLWuab2ZNflIQnP9PGz8EaxtmfLr9l9wuRA5ONJLCdjomX8RKu/ApOwFgbr8sv",
+	"a6i6+aeJaU23tTMU+78bUQUN4o8ZRuzl7OYbuUhtd73ft3egc14UzZrDsc+U3cBMafCx3dpYJ0o8EVTO",
+	"gs+sz9vIefWTKq3rsBuNsvZg5WtlXGtxt7ug+MtqKSfi4QnrmYxTJ+PhCaWtWUV3Q/IuqI48XtTdxIr9",
+	"UBbq3skgJEaAb1GldBUqwO+4oPuAgiSpcYAqQkCiWah7mTCJtWcw2HEX2WFS1TuE6TSoLiznPZgyn2jv",
+	"VJKfSNFF0mHaHWxHb/tuvw2NoF05g7hmKw2KVxmg6G58c29PeoyzArRRkucUWIRvLrm+9XXlPCGK3Bdz",
+	"2eqJeRBCO5ZTtyazyWQ10fOQNhjUgNF3a3SpzQOaclc36MUnd+Phl4VIb7udtnV1hBDB65yryoCMmkam",
+	"ObWexN9w/N7U/NaB8eodAvGgpu6wIZO5bSLaAxMt9sXCB++FS8/JQjz7IOKtYoH7GZpfh8cfqhnL2Obr",
+	"lABAvdf5UpXSt11PWMotzJVeJSya52vtxh52fxKgT0Z5DfTXEZ/fMzjxS5PlUcXYqrbmVN9zIrRDxiN6",
+	"umontV3N1/2TfXqvh0c/b7twL2408NtM3ctOw9MHZXlusGZxfUv5BuxcVi1/42rs9wtFaWsJc1GL3g2V",
+	"K9ujHmBgIt9XgJ2G9WljXRNVn47tt/BiXkVN/RPdKkoEypg5/10UnaT4gv0uCidhVjd2au4SpiQwre5Z",
+	"AbquPx7ijDWkIArLxJLPKdGezML+MZcgv+CGxqCe3uEFc0G9YTXMQAOlvntaf3n17+fsZ0BSRzFXg1je",
+	"lNoAJcUVvAB9r/RtX0J3mUL/tyi+TkL359Ey/I2Qzo+9PsFEzo83Ya2yBgUCq7I6RU0YI6jbgLU5UUgn",
+	"dX/Pc0oaDRQaXamJSw+n5pKUEL4U0iWRC83MgpxAs7hhgKnJfwb3EJyuM1c1mFvm4KnbEJRFX3q9qldy",
+	"GjdzvaDpSn78VzK6SNe02oDsZTGCcD/5T2+ooj7R/0Arlf8fi+K71x/UFlwt58gkSdLGxT8LmA+9PhP/",
+	"biHn0837h7RDNUXWQUSLIvQy665gy1dBeRVWSNAYcOVueLEE014Rgq5SXzyiodFyV4uXul0D1peiSKqi",
+	"MExpNteqxNBrbk2Pq1Vp+3P29VyoFj7aC3RnB9NAt7V5orlHX56hJgVuWDj1nq6bOS+6IwyvrAabLpxH",
+	"qG7CHyrVXv75+eUlUdc33+AnNXMCqYMq46uEFExXEklTcMZM5PkucvoRQXoo19AVVfE31i3XuA1g90rb",
+	"BdOAuy7kPGFCMt/DvsvNsxTtbe4zR15nz5/+Oe5y/6fLljb3R5accaMnmfn0QhgrSh0Swkj3XTcr+JF+",
+	"ZnNOlY/i8GVSYF1ZWK3UksIZ2YwvRe6KJ7k+epUwf7PaSf8OktPQTt/VO+XWNRHcyRBc7DRx5BMTnPum",
+	"v/v1AdD+WM7XdaR/UC/sJjATAZ6OO3aDBltJsPO+u/hE/2/UkWhC+2atLiHF6+cws1UPBF5PvqMEhSNz",
+	"+vehU+j90qewwolEj1mBoh+J9qpAcYrEc6wCFHtdwhMRTzUoGjUoRt+zLt/GxGH8W8XgN/75xy0Hu1VE",
+	"JHhEEXiivhOkPodAzKglKAlxXlt3Gnln9KGjwevo6e4IRD8xjym+PQjRU/aFK429pbgi9T80DCdIKBcP",
+	"g52Mr/nNpU9x5TlbAM9Au9gHZ2w1mK+HG02vxNl8GgrgviB31btM6ajW4p1ojVds5zdv3CIeyuzsdx0X",
+	"Ui/3nP3q1QthG/3ZFCYT3zn8c0tss0CnarkUrakGN0rlwOUu9kdepNTc7XQg7eJnh2Mt7pj8mU2a/CPn",
+	"cXSYcU0d12yHU4jioGoZgRftrK+jSmoZGNXo8K9G8ZZLLigZyhTKmsT3K5IcHcsUHC1MxV2oc5DnSH7Q",
+	"EExajauBLbm53R077dH6hCrsuBWNrK0zketjKXPjUX0YyVJERs9YrJ/o2ceWLmiFzSFhpc6/1lxA2teJ",
+	"Lk/GI0U0FZMhfdHfB/VF6eyoLihcyYO6nRwAE2WdjqsJaamNtrrutoubMr/drRYjIH9//5PL4ClA4zzA",
+	"uGGoflFjS24YZ/929fYXxrXmdPU6IjLn7DWmGghTa4NOjsWbJ/OF21xXrXmUUkQP43xuQ1ZgG40OUGLl",
+	"mev/Pafu/ju1aGIc3+N6v37mQf0dWi7bahLaZUIF0oCLnAv5FenAuM3TxX0C7OVFlrlycPXdzTiSYgqD",
+	"+Mwn/G9oKwTCIPznoR1pDvjJCT1R15Gc0IhgCVuqO18kOa4RZzU3i77E5nML+uqs4fHTCGQMy5kundPR",
+	"Fv2RNvDffzdAZ3wIPD+a2ugW87CaY4BhIrQTUh7doXaQ2pbb5uKT/4Rf8qLQ6s41skNAWogTv26hTv//",
+	"m1cv/BAPK/OFJU1i30R2B1aqHH6j3OeQjCmsl73ZwHwE+Wn4J6S2QX1rxZawRr6fVhimYanuIHNezTh+",
+	"YyDNvnfzTiQ7kewpkqxD7+NQrFJLIedP1vqRr9fmr8svzWkRcdEYpZYJwz3hkkIUQjv/xKeRG5CVTlnk",
+	"PAV2oxR6+9m7OGWgzhTAEV0GgaAE9Jwbu8kU2pXJmiW4hf0kzInyhcuxwUgT/T/abPZA/55q2Xpz2JEM",
+	"YKjFpkFk5o9BXoewDdF2TWrrKdiHYko8kH3oRKnq2JYopZZfhTWK4JhI+yQsUjF1H+J+vfiE/w32QLYy",
+	"BvznwV2SB2EP7WO7nZqU6Im4j+XuPBZxXzRiep9/Cgm7a8GyFAt/vwC5Xli8CrEXOtanM0UrnQkbUnkC",
+	"5Nsygbcxj1jvnhjJlxdgXhgj5nKw5DIxscl4T5jTZBpWjWZqS9BzeILW94tPRpU6BS+j7GooFrfT9XFZ",
+	"MmuC5fMf3LBRBzLhAjnxea7ThQhDugfXjIIhWZGiL4WhYRK3X65eO6UWJVV/UHIn7AzG/BmX/YNWyyu3",
+	"5geWpsLOf7UWDNov3LpJwXnc7IMOknGpqEidT02KqLJnTUwJkJknu1IJ/xqa+TbYwoLfgav/ngmwVJOT",
+	"mmmnYIxw/UQZju/SCpWecyl+98Uxi5xLpsFYXupKXqpZ0S4fwS8I9gklD/4INl7SRJynWDjPEDWYkFY4",
+	"LIVQ3UvQT+iO7L7VP1BTUC7nlDpPu0NVn8lR59x8LC21BmmrBAkJ95gBocGY0MOfCVv78aljcHD8IbXv",
+	"vJPfIqivCdJHHidHW1kvZxLxJzYwyAjpSLGKwCYadnJuz+uZ3jBbxHh+C4bxQLjQENyp3ggOkFROfmb4",
+	"kjKvlsIYMknw0CzcCRL0fD8Kf+xRsC+yjNYxUfVE1UNTmXhE0INI+eJTRKA7SnF+WGtWaCxfmTh70ZXk",
+	"yLmxnrVUzYxZyiWu6gZCYF6Pcp2OqiOl/aG16cZWTW6EiZAPHYu3dNGzg2l53TvQI+DmgQz16yWBlkvO",
+	"DODsdk1YmAnIM9LNfdRf5aLwKPyvjOd5eIycHrjdc3EH0jEikZHWkd8jm/KDdFbscuNsLQhysOIkOGUS",
+	"7Iu+nNE1t6MrlSSbUZX5iiK4Nv1A3nZa1ZFsm5B+vBZZY9IHNkcg1sY4O5kkTtIkMcwIET9x4XWOJ9tL",
+	"P/yg1lpoYFWxWl3xwcROfDFqCV4Pueerc/aaFJMU2Q4yljJDwnWlHcjsGCQd9KsKXN4M7l13LFR9Fqrc",
+	"rcnEKP7SQfVISjtsNVy4lTTpd4CWc3lcSCZO8sg4CYL3l+NvyAelnJvBn4RZt6d48+SmYdUpRUKzG1jw",
+	"fLYHV1vTzy5qi2t7GtR7oEQI70v1dlTSw9b6zBvwoge7USX1qEY+ZkBm7l3/I59zIXfnTcUE1dDYvqjd",
+	"9Yuobcdgj1pDGvcrmsy7EyMcbt51aLRG6hvm3R4MKOdSYuqWsdyWZqsbFldJ0W+VVTm8zYR5HklWGQ+V",
+	"XmMAEuxV6DuFS9UI/pBivrD1TyEMBUdwPiXia+Hr8FjVe3SXy/adB/PKrfFEWp41FjVJNqejIwWiKrSa",
+	"azCmr2VIiy198z8ED0yjjalvhq80kicVwMMSdszYVQ5ZaOCL4+4uq/yOpv+6evMu7DKfMhlPjlgI1Tba",
+	"8vYkE981e4tn88oq7aXqRott3zDBllq6X/lSldImzHVwkRlbgsb7ylIDbBfHIOw5+0XZha9VYDhWKuBk",
+	"JQh9vEtpRd6cztS3qTNw/vjuihXKCASxteaBg7CUORhTX9AGrBVybtgtAG7VTqPE+7A7X4MV4qG643+5",
+	"5K+rlEu/5dMN/tgbOeWKo3s2ELHjFjzzZNevOb9/2Vx88p/wS88Lejd3CkTs/3/zylsvHlY3rxb09WaD",
+	"vnZn86CZoBUMEzt43Bq6MxhG/MAEkcWzgAFcQWTQ19v7np49DR2X1jJRwsmotoTHMdrTF40iB5taa6YF",
+	"FipaloaCiuaK3OtxIXWZEUh1T7IqOQHHD8+S9pvx1W4Z+ItT0LHuM1zJg15mDoCJfh8z/b6dzUDjPSYy",
+	"aKPdrvvqopScEg0hi66uTRe9a7mnjZOYKaSQDMVx6yP8xcUKZ3zlOxoSQMlm2Msmg0C/vwRBHIG4CZNK",
+	"uxwizgxwy+yC23be0HK5/r1e12lcs/WCPmh+Bzno6dI9gUvX4b8/0Lgy3lBC/oT/9WrebwzIOc7mU2nF",
+	"TEQZtj0CgZ3Eh9M9cACwW/IU+TsR5oH1Qi5TyPehwouazLbEvjXqg2iocttBqnK+oFvPsBxmlim9foe6",
+	"WNpamG4Xo1kknAuzSe0u+Q9fodeFYbMyz/tJ344DvKsXehK84Ahifs7FEjfrCridgkgmVjSIFSHyBAm4",
+	"ovN9edL2NKP+139N/F9RWtABOMGUbzSR+pdXB1DpLYuxxB7cyD1N0Ffh8RNQj3FF1Xom7H/sFuiAyW3B",
+	"IklXoDXlWOFE4W1XlAJFaheemO2Omn4Qmji8wPn3IuPuxg4LeqDsjokuT6ZIRQ/SbLuTFlzDIOHyit54",
+	"sDtpEsP+8Ah/ZVXBEHEpur1H/GKXX/S9j0LkzKpbsvFwy3KgYmYrJfGmcqaXavTYnUI9VWB5Axlz5WCd",
+	"s9QIC+acXQX4MB2I6TjJiCZLmPFvG1YafBJ/Ujm1umYGl3iv9K1vw7bV1vPAFPn0sLcRLmbymzxyCl1Q",
+	"W/ZxocVmAWBN805qicIv0LJKzzKBkblFVZL5R6XmOTCepi6wWNATCsVPSotBcwgrSQTrU1XlysEz3XgT",
+	"PT1YvXRhUiWly1UjoqKIdY/oDkFj6vIkhDdfH0PDAyP4gdUZXM10gZyG4z3G8Lo4Vgeqd+WhcG0N8/Tj",
+	"RMb1G+J+ITxgm5npFDTj40rJWOEyvVxiF5kAsQBndB05l17b/VRS2W3Kc3FhOE+fsaWQpQV0Moo8ygl1",
+	"rsBQlTs7Zy8j+DdFynj63eLiqZC735OJ6k8n2ju+46zqccO1CpCqKITLWep1/fnHTyMMLSwHu21OBHE6",
+	"Jnd/rBt9JsMP/ZvcPQjCHys4OyzmjYWH7T3XBGSiu0dfIVYyYWHpWrr0JsEt19HFJxxvaCxHjFYPHbfh",
+	"4J/MGxO5HaeOq6c4sm0cmOYuUgzT2oPyKMxrIr+J/E4w516mIYYxUBui2k4hc3uxc0udDQRaPVzM89JA",
+	"Tg3GFLspV96vBstz9sLTPUHhQp+NWoKSwCA3wJSu4qiLUqcLbiCL6qP713rYPU6Uno8UED1asp5YymTJ",
+	"GcBQel3fgfC7czXeQ6o0FTbnlt1zwwouss1yAe7rmxWmM6IRtuI6gR8lzBS5oEIDVBod2Q/8VvI8X+Fr",
+	"ZLhF1hS3cRjGet6FtUzcpxU1w/58Nar9VEzkNDoucn27zpNqiWIAdyr1HawuCA6hZO94bnrtb9VbJ2Ju",
+	"bq5qopHTcLxWyB21JHJ436AT+sZ7X8uuepn0EBPGJTTWPQPWCoCnkfsTZGYSxmcWtHfOCmsioLz07+LG",
+	"d7Vff0jCO/ztuEFwk2Q+EfiA0LyRBN59D2owZW6H3YLv/TundAf6NU034GncgB6tx5OHe6gXUXzgp5Pm",
+	"h2uZaGCTBlqRDHGkPa4tyl1oFaz43EWjzTTAEzxzlvMbyA0zZbpA48WML0W+SiiLgCnNvrn85lnUqil0",
+	"czc0s2E3rhTFigY1VmnImJAsV/egWcoNJFXtGw0FcGsSH4IWcheIRhD8XfLYF8f2wwthNaJP0td0uQyX",
+	"vnbTfeuFYm773yjm9ssS2RE7GeJapivlZELZCI8bOI9fbHMt4u90MVEHAHIhzgHBkMBuYKZ0ZDq4WTHO",
+	"MuBZLiQk1XV4o9StC/5eKGMhp0YdqiiUcQaJupGOSwNc8KIAyThC7fwAViyBZaV2psOdNv8vT4HHCrHD",
+	"lTyo/d0BMNH/o/YI0knGLKCFAyRnH58IaWHuyAohvgV8O6W3r/Gxs+TsVkgkOCRZJWs6qqfAxz53XqEX",
+	"n/C/oYF4RM/4z0NH4TngpzCgiUIPnGRIGL+DQmt9dLuGd2K0crQSMEOv1olOp3C9Itt9k7ZefppLMwP9",
+	"hCxAZiGK7mga6qdqNkqacpYLeevk5RQKW4fAUNKjNy1hpn3VcdL79Vb+jd1ys4fybQXkYzcVra1noveJ",
+	"3ofQe0CgKC0yNOaISLOvDQkHE1XYSoe+je+wuXKR+JnmM4uzVs30qPKNkq5FQKiOvGLGcu3aD1jFZkIK",
+	"s4As+h1k9q/u05KvKHKXqjhjREDVvG/lX6SEZ5wNp4CaGVGTbdoll5nth8CnqHDPRlzBrVT3/XiOOIXo",
+	"ADJMV6t5wKpyMRCT5t7Cfy6/RD/+YBRLuXRcB/NsfEdNw1y36hCOoyQwbm6xHabSj4tF/qzuoIU9uvUN",
+	"4YzUw7evib1+4UTs7NWCJpI9HWN7dahNOgjf9k8bfyB8P5pVOyznYU3bNRQTyZ2QfTscayfRtd9AZtHZ",
+	"5MuZ57LYUUWdvoS8pXhYFKg1+MiNSJ7mGjDIfY5i+58uXVMwF1h7Ayg2OwP4zsb0Hwi4k4hR4mYxUdvj",
+	"pjYsbkMPtlKDR+k4kby/EGgWF/WgF5/859UbaitN5NW7gzSh2otqsBdhqFfv/UAPahuvVzb5kib6PHRF",
+	"B0JwxitaDNgWE2JNZ9uokWj64hP+N5oIf8Ix8J+vhPbcYia6m+ju2HSHmBbTHP7dSW5iTr2nIrrskkbx",
+	"Ao5yrXmW1XldroClS49WOgPNRPRUSOvCX9NSG6XP2TvlTLjCuq60+Bu1sJXw0V67p5j21drJvUQzC4O1",
+	"N3dLrm5Z9UX8BWl/0x4XL8lXky803AlVGlbwOZyzX32ctaDa1bB0oW+5MJVMUzf7VZLS3wj+30rQq3oB",
+	"bo6zGOCdAP5V3bMllys/r1V+1xP27BIj6zLHA7qmzMVS2MaMS/5RLJHRPL28TM6WQvq/qs2icB/QRxb6",
+	"f4H7+vgn4f8EhH8suuuaWlfnGrO5KIpsW1yZhPvrIJnUgWWeEdZ4/QvcVwJMR2BZ4J2x8+mUuOe7eF0T",
+	"//zj8c8YASYOekocNGZZI3loNMQONho/2cpJ77mWa10Sm+t+EUXq8/kcMqZKmylqwMxdPzncxazEwAIl",
+	"ncWTGCNnCzFfUGhSCsg8NBcU4o97loGxQtLadvHEXwOIp+H3C8uZqPp0qkV7AmD3wMkVHqiq2/zy+fP/",
+	"PwCg3AuPgS0DAA==",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
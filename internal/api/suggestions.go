@@ -0,0 +1,89 @@
+package api
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/schedule"
+	"go.uber.org/zap"
+)
+
+// maxSuggestedTimes is how many times are suggested, at most.
+const maxSuggestedTimes = 5
+
+// Suggest times for an activity.
+// (GET /trips/{tripId}/activities/suggested-times)
+func (api *API) GetTripsTripIDActivitiesSuggestedTimes(w http.ResponseWriter, r *http.Request, tripID string, params spec.GetTripsTripIDActivitiesSuggestedTimesParams) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.GetTripsTripIDActivitiesSuggestedTimesJSON400Response(errID.Error)
+	}
+
+	trip, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDActivitiesSuggestedTimesJSON400Response, spec.GetTripsTripIDActivitiesSuggestedTimesJSON404Response)
+	}
+
+	day := time.Date(params.Date.Year(), params.Date.Month(), params.Date.Day(), 0, 0, 0, 0, trip.StartsAt.Time.Location())
+	firstDay := time.Date(trip.StartsAt.Time.Year(), trip.StartsAt.Time.Month(), trip.StartsAt.Time.Day(), 0, 0, 0, 0, day.Location())
+	lastDay := time.Date(trip.EndsAt.Time.Year(), trip.EndsAt.Time.Month(), trip.EndsAt.Time.Day(), 0, 0, 0, 0, day.Location())
+	if day.Before(firstDay) || day.After(lastDay) {
+		return spec.GetTripsTripIDActivitiesSuggestedTimesJSON400Response(spec.Error{
+			Code:    "invalid_input",
+			Message: "invalid input: date must be during the trip",
+		})
+	}
+
+	acts, err := api.store.GetTripActivities(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get activities", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDActivitiesSuggestedTimesJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "fail to get trip activities",
+		})
+	}
+
+	var duration time.Duration
+	if params.DurationMinutes != nil {
+		duration = time.Duration(*params.DurationMinutes) * time.Minute
+	} else {
+		duration = typicalDuration(acts, params.Tag)
+	}
+
+	slots := schedule.Suggest(scheduleActivities(acts), day, duration, maxSuggestedTimes)
+
+	response := spec.SuggestTimesResponse{
+		DurationMinutes: int(duration.Minutes()),
+		Slots:           make([]spec.SuggestTimesResponseSlotArray, 0, len(slots)),
+	}
+	for _, slot := range slots {
+		response.Slots = append(response.Slots, spec.SuggestTimesResponseSlotArray{
+			StartsAt: slot.StartsAt,
+			EndsAt:   slot.EndsAt,
+		})
+	}
+
+	return spec.GetTripsTripIDActivitiesSuggestedTimesJSON200Response(response)
+}
+
+// typicalDuration is how long the trip activities with the given tag usually
+// take, or all of them when no tag is given or none with it has a duration.
+func typicalDuration(acts []pgstore.Activity, tag *string) time.Duration {
+	if tag != nil {
+		t := strings.ToLower(strings.TrimSpace(*tag))
+		tagged := make([]pgstore.Activity, 0, len(acts))
+		for _, act := range acts {
+			if act.DurationMinutes.Valid && slices.Contains(act.Tags, t) {
+				tagged = append(tagged, act)
+			}
+		}
+		if len(tagged) > 0 {
+			return schedule.TypicalDuration(scheduleActivities(tagged))
+		}
+	}
+	return schedule.TypicalDuration(scheduleActivities(acts))
+}
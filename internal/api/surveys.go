@@ -0,0 +1,394 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/serializer"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+// Get the questions of a trip survey.
+// (GET /trips/{tripId}/survey/questions)
+func (api *API) GetTripsTripIDSurveyQuestions(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.GetTripsTripIDSurveyQuestionsJSON400Response(errID.Error)
+	}
+
+	_, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDSurveyQuestionsJSON400Response, spec.GetTripsTripIDSurveyQuestionsJSON404Response)
+	}
+
+	questions, err := api.store.GetSurveyQuestions(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get survey questions", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDSurveyQuestionsJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "fail to get trip survey",
+		})
+	}
+
+	sentAt, errResp := api.surveySentAt(r, id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDSurveyQuestionsJSON400Response, spec.GetTripsTripIDSurveyQuestionsJSON404Response)
+	}
+
+	response := spec.GetSurveyQuestionsResponse{
+		IsDefault: len(questions) == 0,
+		SentAt:    sentAt,
+		Questions: make([]spec.GetSurveyQuestionsResponseQuestionArray, 0, len(questions)),
+	}
+	for _, question := range questions {
+		response.Questions = append(response.Questions, spec.GetSurveyQuestionsResponseQuestionArray{
+			Prompt: question.Prompt,
+			Kind:   question.Kind,
+		})
+	}
+	if response.IsDefault {
+		for _, question := range pgstore.DefaultSurveyQuestions {
+			response.Questions = append(response.Questions, spec.GetSurveyQuestionsResponseQuestionArray{
+				Prompt: question.Prompt,
+				Kind:   question.Kind,
+			})
+		}
+	}
+
+	return spec.GetTripsTripIDSurveyQuestionsJSON200Response(response)
+}
+
+// Set the questions of a trip survey.
+// (PUT /trips/{tripId}/survey/questions)
+func (api *API) PutTripsTripIDSurveyQuestions(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.PutTripsTripIDSurveyQuestionsJSON400Response(errID.Error)
+	}
+
+	_, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PutTripsTripIDSurveyQuestionsJSON400Response, spec.PutTripsTripIDSurveyQuestionsJSON404Response)
+	}
+
+	var body spec.SurveyQuestionsRequest
+	if errJson := json.NewDecoder(r.Body).Decode(&body); errJson != nil {
+		return spec.PutTripsTripIDSurveyQuestionsJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + errJson.Error()})
+	}
+
+	if errVal := api.validator.Struct(body); errVal != nil {
+		return spec.PutTripsTripIDSurveyQuestionsJSON422Response(invalidBody(errVal))
+	}
+
+	questions := make([]pgstore.InsertSurveyQuestionsParams, len(body.Questions))
+	for i, question := range body.Questions {
+		questions[i] = pgstore.InsertSurveyQuestionsParams{
+			TripID:   id,
+			Position: int32(i),
+			Prompt:   question.Prompt,
+			Kind:     question.Kind,
+		}
+	}
+
+	if err := api.store.ReplaceSurveyQuestions(r.Context(), api.pool, id, questions); err != nil {
+		if errors.Is(err, pgstore.ErrSurveySent) {
+			return spec.PutTripsTripIDSurveyQuestionsJSON400Response(spec.Error{
+				Code:    "survey_already_sent",
+				Message: "survey was already sent",
+			})
+		}
+		api.logger.Error("failed to replace survey questions", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PutTripsTripIDSurveyQuestionsJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to update survey, try again",
+		})
+	}
+
+	return spec.PutTripsTripIDSurveyQuestionsJSON204Response(nil)
+}
+
+// Get the results of a trip survey.
+// (GET /trips/{tripId}/survey/results)
+func (api *API) GetTripsTripIDSurveyResults(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.GetTripsTripIDSurveyResultsJSON400Response(errID.Error)
+	}
+
+	_, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDSurveyResultsJSON400Response, spec.GetTripsTripIDSurveyResultsJSON404Response)
+	}
+
+	sentAt, errResp := api.surveySentAt(r, id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDSurveyResultsJSON400Response, spec.GetTripsTripIDSurveyResultsJSON404Response)
+	}
+
+	questions, err := api.store.GetSurveyQuestions(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get survey questions", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDSurveyResultsJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "fail to get trip survey results",
+		})
+	}
+
+	answers, err := api.store.GetTripSurveyAnswers(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get survey answers", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDSurveyResultsJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "fail to get trip survey results",
+		})
+	}
+
+	tokens, err := api.store.GetTripSurveyTokens(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get survey tokens", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDSurveyResultsJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "fail to get trip survey results",
+		})
+	}
+
+	answersTo := make(map[uuid.UUID][]pgstore.SurveyAnswer, len(questions))
+	respondents := make(map[uuid.UUID]bool)
+	for _, answer := range answers {
+		answersTo[answer.QuestionID] = append(answersTo[answer.QuestionID], answer)
+		respondents[answer.ParticipantID] = true
+	}
+
+	response := spec.GetSurveyResultsResponse{
+		SentAt:    sentAt,
+		Invited:   len(tokens),
+		Responded: len(respondents),
+		Questions: make([]spec.GetSurveyResultsResponseQuestionArray, 0, len(questions)),
+	}
+	for _, question := range questions {
+		result := spec.GetSurveyResultsResponseQuestionArray{
+			ID:       question.ID.String(),
+			Prompt:   question.Prompt,
+			Kind:     question.Kind,
+			Answers:  len(answersTo[question.ID]),
+			Ratings:  []int{},
+			Comments: []string{},
+		}
+
+		if question.Kind == pgstore.SurveyRating {
+			result.Ratings = make([]int, 5)
+			var total, rated int
+			for _, answer := range answersTo[question.ID] {
+				if answer.Rating.Valid {
+					result.Ratings[answer.Rating.Int32-1]++
+					total += int(answer.Rating.Int32)
+					rated++
+				}
+			}
+			if rated > 0 {
+				average := float32(total) / float32(rated)
+				result.Average = &average
+			}
+		}
+
+		for _, answer := range answersTo[question.ID] {
+			if answer.Comment.Valid {
+				result.Comments = append(result.Comments, answer.Comment.String)
+			}
+		}
+
+		response.Questions = append(response.Questions, result)
+	}
+
+	return spec.GetTripsTripIDSurveyResultsJSON200Response(response)
+}
+
+// Get a trip survey to answer.
+// (GET /surveys/{token})
+func (api *API) GetSurveysToken(w http.ResponseWriter, r *http.Request, token string) *spec.Response {
+	participant, errResp := api.getSurveyParticipant(r, token)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetSurveysTokenJSON400Response, spec.GetSurveysTokenJSON404Response)
+	}
+
+	trip, err := api.store.GetTrip(r.Context(), participant.TripID)
+	if err != nil {
+		api.logger.Error("failed to get trip", zap.Error(err), zap.String("trip_id", participant.TripID.String()))
+		return spec.GetSurveysTokenJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	questions, err := api.store.GetSurveyQuestions(r.Context(), trip.ID)
+	if err != nil {
+		api.logger.Error("failed to get survey questions", zap.Error(err), zap.String("trip_id", trip.ID.String()))
+		return spec.GetSurveysTokenJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	answers, err := api.store.GetParticipantSurveyAnswers(r.Context(), participant.ID)
+	if err != nil {
+		api.logger.Error("failed to get survey answers", zap.Error(err), zap.String("participant_id", participant.ID.String()))
+		return spec.GetSurveysTokenJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	answerTo := make(map[uuid.UUID]pgstore.SurveyAnswer, len(answers))
+	for _, answer := range answers {
+		answerTo[answer.QuestionID] = answer
+	}
+
+	response := spec.GetSurveyResponse{
+		Destination: serializer.ParticipantView.Text(trip.Destination),
+		Questions:   make([]spec.GetSurveyResponseQuestionArray, 0, len(questions)),
+	}
+	for _, question := range questions {
+		responseQuestion := spec.GetSurveyResponseQuestionArray{
+			ID:     question.ID.String(),
+			Prompt: question.Prompt,
+			Kind:   question.Kind,
+		}
+		if answer, ok := answerTo[question.ID]; ok {
+			if answer.Rating.Valid {
+				rating := int(answer.Rating.Int32)
+				responseQuestion.Rating = &rating
+			}
+			if answer.Comment.Valid {
+				responseQuestion.Comment = &answer.Comment.String
+			}
+		}
+		response.Questions = append(response.Questions, responseQuestion)
+	}
+
+	return spec.GetSurveysTokenJSON200Response(response)
+}
+
+// Answer a trip survey.
+// (PUT /surveys/{token})
+func (api *API) PutSurveysToken(w http.ResponseWriter, r *http.Request, token string) *spec.Response {
+	participant, errResp := api.getSurveyParticipant(r, token)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PutSurveysTokenJSON400Response, spec.PutSurveysTokenJSON404Response)
+	}
+
+	var body spec.AnswerSurveyRequest
+	if errJson := json.NewDecoder(r.Body).Decode(&body); errJson != nil {
+		return spec.PutSurveysTokenJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + errJson.Error()})
+	}
+
+	if errVal := api.validator.Struct(body); errVal != nil {
+		return spec.PutSurveysTokenJSON422Response(invalidBody(errVal))
+	}
+
+	questions, err := api.store.GetSurveyQuestions(r.Context(), participant.TripID)
+	if err != nil {
+		api.logger.Error("failed to get survey questions", zap.Error(err), zap.String("trip_id", participant.TripID.String()))
+		return spec.PutSurveysTokenJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	kindOf := make(map[uuid.UUID]string, len(questions))
+	for _, question := range questions {
+		kindOf[question.ID] = question.Kind
+	}
+
+	answers := make([]pgstore.UpsertSurveyAnswerParams, len(body.Answers))
+	for i, answer := range body.Answers {
+		questionID := uuid.MustParse(answer.QuestionID)
+		switch kindOf[questionID] {
+		case pgstore.SurveyRating:
+			if answer.Rating == nil || answer.Comment != nil {
+				return spec.PutSurveysTokenJSON400Response(spec.Error{
+					Code:    "invalid_answer",
+					Message: "rating questions take a rating only: " + answer.QuestionID,
+				})
+			}
+		case pgstore.SurveyText:
+			if answer.Comment == nil || answer.Rating != nil {
+				return spec.PutSurveysTokenJSON400Response(spec.Error{
+					Code:    "invalid_answer",
+					Message: "text questions take a comment only: " + answer.QuestionID,
+				})
+			}
+		default:
+			return spec.PutSurveysTokenJSON400Response(spec.Error{
+				Code:    "survey_question_not_found",
+				Message: "survey question not found: " + answer.QuestionID,
+			})
+		}
+
+		answers[i] = pgstore.UpsertSurveyAnswerParams{
+			QuestionID:    questionID,
+			ParticipantID: participant.ID,
+		}
+		if answer.Rating != nil {
+			answers[i].Rating = pgtype.Int4{Valid: true, Int32: int32(*answer.Rating)}
+		}
+		if answer.Comment != nil {
+			answers[i].Comment = pgtype.Text{Valid: true, String: *answer.Comment}
+		}
+	}
+
+	for _, answer := range answers {
+		if err := api.store.UpsertSurveyAnswer(r.Context(), answer); err != nil {
+			if errors.Is(err, pgstore.ErrForeignKey) {
+				return spec.PutSurveysTokenJSON422Response(missingReference("survey_question_removed", "survey question was removed: "+answer.QuestionID.String()))
+			}
+			api.logger.Error("failed to save survey answer", zap.Error(err), zap.String("participant_id", participant.ID.String()))
+			return spec.PutSurveysTokenJSON400Response(spec.Error{
+				Code:    internalError,
+				Message: "failed to save survey answer, try again",
+			})
+		}
+	}
+
+	return spec.PutSurveysTokenJSON204Response(nil)
+}
+
+// surveySentAt tells when the trip survey was sent, if it was.
+func (api *API) surveySentAt(r *http.Request, tripID uuid.UUID) (*time.Time, *apiError) {
+	survey, err := api.store.GetTripSurvey(r.Context(), tripID)
+	if err != nil {
+		if errors.Is(err, pgstore.ErrNotFound) {
+			return nil, nil
+		}
+		api.logger.Error("failed to get trip survey", zap.Error(err), zap.String("trip_id", tripID.String()))
+		return nil, badRequest(internalError, "something went wrong, try again")
+	}
+
+	return &survey.SentAt.Time, nil
+}
+
+// getSurveyParticipant resolves a survey token to the participant it was
+// issued to, returning the error to be sent to the client otherwise.
+func (api *API) getSurveyParticipant(r *http.Request, token string) (pgstore.Participant, *apiError) {
+	surveyToken, err := api.store.GetSurveyToken(r.Context(), token)
+	if err != nil {
+		if errors.Is(err, pgstore.ErrNotFound) {
+			return pgstore.Participant{}, notFound("survey_not_found", "survey not found")
+		}
+		api.logger.Error("failed to get survey token", zap.Error(err))
+		return pgstore.Participant{}, badRequest(internalError, "something went wrong, try again")
+	}
+
+	participant, err := api.store.GetParticipant(r.Context(), surveyToken.ParticipantID)
+	if err != nil {
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", surveyToken.ParticipantID.String()))
+		return pgstore.Participant{}, badRequest(internalError, "something went wrong, try again")
+	}
+
+	return participant, nil
+}
@@ -0,0 +1,73 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"go.uber.org/zap"
+)
+
+// Get the tags of a trip.
+// (GET /trips/{tripId}/tags)
+func (api *API) GetTripsTripIDTags(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.GetTripsTripIDTagsJSON400Response(errID.Error)
+	}
+
+	_, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDTagsJSON400Response, spec.GetTripsTripIDTagsJSON404Response)
+	}
+
+	tags, err := api.store.GetTripTags(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get trip tags", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDTagsJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "something went wrong, try again",
+		})
+	}
+
+	return spec.GetTripsTripIDTagsJSON200Response(spec.TripTagsResponse{Tags: nonNil(tags)})
+}
+
+// Set the tags of a trip.
+// (PUT /trips/{tripId}/tags)
+func (api *API) PutTripsTripIDTags(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.PutTripsTripIDTagsJSON400Response(errID.Error)
+	}
+
+	_, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PutTripsTripIDTagsJSON400Response, spec.PutTripsTripIDTagsJSON404Response)
+	}
+
+	var body spec.TripTagsRequest
+	if errJson := json.NewDecoder(r.Body).Decode(&body); errJson != nil {
+		return spec.PutTripsTripIDTagsJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + errJson.Error()})
+	}
+
+	if errVal := api.validator.Struct(body); errVal != nil {
+		return spec.PutTripsTripIDTagsJSON422Response(invalidBody(errVal))
+	}
+
+	tags := make([]string, len(body.Tags))
+	for i, tag := range body.Tags {
+		tags[i] = strings.ToLower(strings.TrimSpace(tag))
+	}
+
+	if err := api.store.ReplaceTripTags(r.Context(), api.pool, id, distinct(tags)); err != nil {
+		api.logger.Error("failed to replace trip tags", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PutTripsTripIDTagsJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to update trip tags, try again",
+		})
+	}
+
+	return spec.PutTripsTripIDTagsJSON204Response(nil)
+}
@@ -0,0 +1,230 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	openapi_types "github.com/discord-gophers/goapi-gen/types"
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+// Get a trip tasks.
+// (GET /trips/{tripId}/tasks)
+func (api *API) GetTripsTripIDTasks(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.GetTripsTripIDTasksJSON400Response(errID.Error)
+	}
+
+	trip, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDTasksJSON400Response, spec.GetTripsTripIDTasksJSON404Response)
+	}
+
+	tasks, err := api.store.GetTripTasks(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get tasks", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDTasksJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "fail to get trip tasks",
+		})
+	}
+
+	now := time.Now().In(trip.Settings.Location())
+	responseTasks := make([]spec.GetTasksResponseArray, 0, len(tasks))
+	for _, task := range tasks {
+		responseTask := spec.GetTasksResponseArray{
+			ID:        task.ID.String(),
+			Title:     task.Title,
+			DueOn:     openapi_types.Date{Time: task.DueOn.Time},
+			IsDone:    task.IsDone,
+			IsOverdue: pgstore.IsTaskOverdue(task, now),
+		}
+		if task.AssigneeID.Valid {
+			assignee := uuid.UUID(task.AssigneeID.Bytes).String()
+			responseTask.AssigneeID = &assignee
+		}
+		responseTasks = append(responseTasks, responseTask)
+	}
+
+	return spec.GetTripsTripIDTasksJSON200Response(spec.GetTasksResponse{Tasks: responseTasks})
+}
+
+// Create a trip task.
+// (POST /trips/{tripId}/tasks)
+func (api *API) PostTripsTripIDTasks(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.PostTripsTripIDTasksJSON400Response(errID.Error)
+	}
+
+	_, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDTasksJSON400Response, spec.PostTripsTripIDTasksJSON404Response)
+	}
+
+	var body spec.CreateTaskRequest
+	if errJson := json.NewDecoder(r.Body).Decode(&body); errJson != nil {
+		return spec.PostTripsTripIDTasksJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + errJson.Error()})
+	}
+
+	if errVal := api.validator.Struct(body); errVal != nil {
+		return spec.PostTripsTripIDTasksJSON422Response(invalidBody(errVal))
+	}
+
+	if body.DueOn.IsZero() {
+		return spec.PostTripsTripIDTasksJSON400Response(spec.Error{Code: "invalid_input", Message: "invalid input: due_on is required"})
+	}
+
+	assignee, errResp := api.taskAssignee(r.Context(), id, body.AssigneeID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDTasksJSON400Response, spec.PostTripsTripIDTasksJSON404Response)
+	}
+
+	taskID, err := api.store.CreateTask(r.Context(), pgstore.CreateTaskParams{
+		TripID:     id,
+		Title:      body.Title,
+		DueOn:      pgtype.Date{Valid: true, Time: body.DueOn.Time},
+		AssigneeID: assignee,
+	})
+	if err != nil {
+		if errors.Is(err, pgstore.ErrForeignKey) {
+			return spec.PostTripsTripIDTasksJSON422Response(missingReference("participant_removed", "assignee is no longer on the trip"))
+		}
+		api.logger.Error("failed to create task", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDTasksJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "fail to insert task",
+		})
+	}
+
+	return spec.PostTripsTripIDTasksJSON201Response(spec.CreateTaskResponse{TaskID: taskID.String()})
+}
+
+// Update a trip task.
+// (PUT /trips/{tripId}/tasks/{taskId})
+func (api *API) PutTripsTripIDTasksTaskID(w http.ResponseWriter, r *http.Request, tripID string, taskID string) *spec.Response {
+	task, errResp := api.getTripTask(r.Context(), tripID, taskID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PutTripsTripIDTasksTaskIDJSON400Response, spec.PutTripsTripIDTasksTaskIDJSON404Response)
+	}
+
+	var body spec.UpdateTaskRequest
+	if errJson := json.NewDecoder(r.Body).Decode(&body); errJson != nil {
+		return spec.PutTripsTripIDTasksTaskIDJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + errJson.Error()})
+	}
+
+	if errVal := api.validator.Struct(body); errVal != nil {
+		return spec.PutTripsTripIDTasksTaskIDJSON422Response(invalidBody(errVal))
+	}
+
+	if body.DueOn.IsZero() {
+		return spec.PutTripsTripIDTasksTaskIDJSON400Response(spec.Error{Code: "invalid_input", Message: "invalid input: due_on is required"})
+	}
+
+	assignee, errResp := api.taskAssignee(r.Context(), task.TripID, body.AssigneeID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PutTripsTripIDTasksTaskIDJSON400Response, spec.PutTripsTripIDTasksTaskIDJSON404Response)
+	}
+
+	if err := api.store.UpdateTask(r.Context(), pgstore.UpdateTaskParams{
+		ID:         task.ID,
+		Title:      body.Title,
+		DueOn:      pgtype.Date{Valid: true, Time: body.DueOn.Time},
+		AssigneeID: assignee,
+		IsDone:     body.IsDone,
+	}); err != nil {
+		if errors.Is(err, pgstore.ErrForeignKey) {
+			return spec.PutTripsTripIDTasksTaskIDJSON422Response(missingReference("participant_removed", "assignee is no longer on the trip"))
+		}
+		api.logger.Error("failed to update task", zap.Error(err), zap.String("task_id", taskID))
+		return spec.PutTripsTripIDTasksTaskIDJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to update task, try again",
+		})
+	}
+
+	return spec.PutTripsTripIDTasksTaskIDJSON204Response(nil)
+}
+
+// Delete a trip task.
+// (DELETE /trips/{tripId}/tasks/{taskId})
+func (api *API) DeleteTripsTripIDTasksTaskID(w http.ResponseWriter, r *http.Request, tripID string, taskID string) *spec.Response {
+	task, errResp := api.getTripTask(r.Context(), tripID, taskID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.DeleteTripsTripIDTasksTaskIDJSON400Response, spec.DeleteTripsTripIDTasksTaskIDJSON404Response)
+	}
+
+	if err := api.store.DeleteTask(r.Context(), task.ID); err != nil {
+		api.logger.Error("failed to delete task", zap.Error(err), zap.String("task_id", taskID))
+		return spec.DeleteTripsTripIDTasksTaskIDJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to delete task, try again",
+		})
+	}
+
+	return spec.DeleteTripsTripIDTasksTaskIDJSON204Response(nil)
+}
+
+// getTripTask loads a task making sure it belongs to the given trip,
+// returning the error to be sent to the client otherwise.
+func (api *API) getTripTask(ctx context.Context, tripID, taskID string) (pgstore.Task, *apiError) {
+	tripUUID, errID := pathID(ctx, "tripId", tripID)
+	if errID != nil {
+		return pgstore.Task{}, errID
+	}
+
+	taskUUID, errID := pathID(ctx, "taskId", taskID)
+	if errID != nil {
+		return pgstore.Task{}, errID
+	}
+
+	task, err := api.store.GetTask(ctx, taskUUID)
+	if err != nil {
+		if errors.Is(err, pgstore.ErrNotFound) {
+			return pgstore.Task{}, notFound("task_not_found", "task not found")
+		}
+		api.logger.Error("failed to get task", zap.Error(err), zap.String("task_id", taskID))
+		return pgstore.Task{}, badRequest(internalError, "something went wrong, try again")
+	}
+
+	if task.TripID != tripUUID {
+		return pgstore.Task{}, notFound("task_not_found", "task not found")
+	}
+
+	return task, nil
+}
+
+// taskAssignee checks the participant a task is assigned to is going on the
+// trip. Tasks without an assignee are left to the owners.
+func (api *API) taskAssignee(ctx context.Context, tripID uuid.UUID, assigneeID *string) (pgtype.UUID, *apiError) {
+	if assigneeID == nil {
+		return pgtype.UUID{}, nil
+	}
+
+	participant, err := api.store.GetParticipant(ctx, uuid.MustParse(*assigneeID))
+	if err != nil {
+		if errors.Is(err, pgstore.ErrNotFound) {
+			return pgtype.UUID{}, notFound("participant_not_found", "participant not found")
+		}
+		api.logger.Error("failed to get participant", zap.Error(err), zap.String("participant_id", *assigneeID))
+		return pgtype.UUID{}, badRequest(internalError, "something went wrong, try again")
+	}
+
+	if participant.TripID != tripID {
+		return pgtype.UUID{}, notFound("participant_not_found", "participant not found")
+	}
+
+	if participant.Status != pgstore.ParticipantInvited {
+		return pgtype.UUID{}, badRequest("participant_not_going", "participant is not going on the trip")
+	}
+
+	return pgtype.UUID{Valid: true, Bytes: participant.ID}, nil
+}
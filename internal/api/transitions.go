@@ -0,0 +1,58 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/domain"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/lifecycle"
+	"go.uber.org/zap"
+)
+
+// Move a trip to another status.
+// (POST /trips/{tripId}/transitions)
+func (api *API) PostTripsTripIDTransitions(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.PostTripsTripIDTransitionsJSON400Response(errID.Error)
+	}
+
+	trip, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDTransitionsJSON400Response, spec.PostTripsTripIDTransitionsJSON404Response)
+	}
+
+	var body spec.TripTransitionRequest
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		return spec.PostTripsTripIDTransitionsJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + err.Error()})
+	}
+
+	if err := api.validator.Struct(body); err != nil {
+		return spec.PostTripsTripIDTransitionsJSON422Response(invalidBody(err))
+	}
+
+	from, to := domain.TripStatus(trip.Status), domain.TripStatus(body.Status)
+	if err := api.lifecycle().Transition(r.Context(), trip.ID, from, to, r.RemoteAddr); err != nil {
+		switch {
+		case errors.Is(err, domain.ErrInvalidTransition):
+			return spec.PostTripsTripIDTransitionsJSON409Response(spec.Error{Code: "invalid_trip_transition", Message: "trip can not move to that status"})
+		case errors.Is(err, lifecycle.ErrConflict):
+			return spec.PostTripsTripIDTransitionsJSON409Response(spec.Error{Code: "trip_status_changed", Message: "trip status changed meanwhile, try again"})
+		}
+		api.logger.Error("failed to update trip status", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.PostTripsTripIDTransitionsJSON400Response(spec.Error{Code: internalError, Message: "failed to update trip status, try again"})
+	}
+
+	response := spec.TripTransitionResponse{
+		From:        string(from),
+		Status:      string(to),
+		Transitions: []string{},
+	}
+	for _, next := range to.Transitions() {
+		response.Transitions = append(response.Transitions, string(next))
+	}
+
+	return spec.PostTripsTripIDTransitionsJSON200Response(response)
+}
@@ -0,0 +1,88 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+// Get a trip transports.
+// (GET /trips/{tripId}/transports)
+func (api *API) GetTripsTripIDTransports(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.GetTripsTripIDTransportsJSON400Response(errID.Error)
+	}
+
+	_, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDTransportsJSON400Response, spec.GetTripsTripIDTransportsJSON404Response)
+	}
+
+	transports, err := api.store.GetTripTransports(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get transports", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDTransportsJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "fail to get trip transports",
+		})
+	}
+
+	responseTransports := make([]spec.GetTransportsResponseArray, 0, len(transports))
+	for _, transport := range transports {
+		responseTransports = append(responseTransports, spec.GetTransportsResponseArray{
+			ID:          transport.ID.String(),
+			Mode:        transport.Mode,
+			Origin:      transport.Origin,
+			Destination: transport.Destination,
+			DepartsAt:   transport.DepartsAt.Time,
+			ArrivesAt:   transport.ArrivesAt.Time,
+		})
+	}
+
+	return spec.GetTripsTripIDTransportsJSON200Response(spec.GetTransportsResponse{Transports: responseTransports})
+}
+
+// Create a trip transport.
+// (POST /trips/{tripId}/transports)
+func (api *API) PostTripsTripIDTransports(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.PostTripsTripIDTransportsJSON400Response(errID.Error)
+	}
+
+	_, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDTransportsJSON400Response, spec.PostTripsTripIDTransportsJSON404Response)
+	}
+
+	var body spec.PostTripsTripIDTransportsJSONBody
+	if errJson := json.NewDecoder(r.Body).Decode(&body); errJson != nil {
+		return spec.PostTripsTripIDTransportsJSON400Response(spec.Error{Code: "invalid_json", Message: "invalid json: " + errJson.Error()})
+	}
+
+	if errVal := api.validator.Struct(body); errVal != nil {
+		return spec.PostTripsTripIDTransportsJSON422Response(invalidBody(errVal))
+	}
+
+	transportID, err := api.store.CreateTransport(r.Context(), pgstore.CreateTransportParams{
+		TripID:      id,
+		Mode:        body.Mode,
+		Origin:      body.Origin,
+		Destination: body.Destination,
+		DepartsAt:   pgtype.Timestamp{Valid: true, Time: body.DepartsAt},
+		ArrivesAt:   pgtype.Timestamp{Valid: true, Time: body.ArrivesAt},
+	})
+	if err != nil {
+		return spec.PostTripsTripIDTransportsJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to create transport, try again",
+		})
+	}
+
+	return spec.PostTripsTripIDTransportsJSON201Response(spec.CreateTransportResponse{TransportID: transportID.String()})
+}
@@ -0,0 +1,182 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+// Delete an activity, moving it to the trip trash.
+// (DELETE /trips/{tripId}/activities/{activityId})
+func (api *API) DeleteTripsTripIDActivitiesActivityID(w http.ResponseWriter, r *http.Request, tripID string, activityID string) *spec.Response {
+	tripUUID, activityUUID, errResp := api.trashItemIDs(r.Context(), tripID, "activityId", activityID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.DeleteTripsTripIDActivitiesActivityIDJSON400Response, spec.DeleteTripsTripIDActivitiesActivityIDJSON404Response)
+	}
+
+	activity, err := api.store.TrashActivity(r.Context(), pgstore.TrashActivityParams{ID: activityUUID, TripID: tripUUID})
+	if err != nil {
+		if errors.Is(err, pgstore.ErrNotFound) {
+			return spec.DeleteTripsTripIDActivitiesActivityIDJSON404Response(spec.Error{Code: "activity_not_found", Message: "activity not found"})
+		}
+		api.logger.Error("failed to trash activity", zap.Error(err), zap.String("activity_id", activityID))
+		return spec.DeleteTripsTripIDActivitiesActivityIDJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to delete activity, try again",
+		})
+	}
+
+	api.recordItineraryChange(r, tripUUID, pgstore.AuditActivityRemoved, pgstore.ItineraryChange{Title: activity.Title, OccursAt: &activity.OccursAt.Time})
+
+	return spec.DeleteTripsTripIDActivitiesActivityIDJSON204Response(nil)
+}
+
+// Delete a link, moving it to the trip trash.
+// (DELETE /trips/{tripId}/links/{linkId})
+func (api *API) DeleteTripsTripIDLinksLinkID(w http.ResponseWriter, r *http.Request, tripID string, linkID string) *spec.Response {
+	tripUUID, linkUUID, errResp := api.trashItemIDs(r.Context(), tripID, "linkId", linkID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.DeleteTripsTripIDLinksLinkIDJSON400Response, spec.DeleteTripsTripIDLinksLinkIDJSON404Response)
+	}
+
+	link, err := api.store.TrashLink(r.Context(), pgstore.TrashLinkParams{ID: linkUUID, TripID: tripUUID})
+	if err != nil {
+		if errors.Is(err, pgstore.ErrNotFound) {
+			return spec.DeleteTripsTripIDLinksLinkIDJSON404Response(spec.Error{Code: "link_not_found", Message: "link not found"})
+		}
+		api.logger.Error("failed to trash link", zap.Error(err), zap.String("link_id", linkID))
+		return spec.DeleteTripsTripIDLinksLinkIDJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to delete link, try again",
+		})
+	}
+
+	api.recordItineraryChange(r, tripUUID, pgstore.AuditLinkRemoved, pgstore.ItineraryChange{Title: link.Title, URL: link.Url})
+
+	return spec.DeleteTripsTripIDLinksLinkIDJSON204Response(nil)
+}
+
+// List the activities and links deleted from a trip.
+// (GET /trips/{tripId}/trash)
+func (api *API) GetTripsTripIDTrash(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.GetTripsTripIDTrashJSON400Response(errID.Error)
+	}
+
+	if _, errResp := api.getTrip(r.Context(), id); errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDTrashJSON400Response, spec.GetTripsTripIDTrashJSON404Response)
+	}
+
+	activities, err := api.store.GetTripTrashedActivities(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get trashed activities", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDTrashJSON400Response(spec.Error{Code: internalError, Message: "failed to get trip trash, try again"})
+	}
+
+	links, err := api.store.GetTripTrashedLinks(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get trashed links", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDTrashJSON400Response(spec.Error{Code: internalError, Message: "failed to get trip trash, try again"})
+	}
+
+	response := spec.TrashResponse{
+		Activities: make([]spec.TrashedActivityArray, 0, len(activities)),
+		Links:      make([]spec.TrashedLinkArray, 0, len(links)),
+	}
+	for _, activity := range activities {
+		response.Activities = append(response.Activities, spec.TrashedActivityArray{
+			ID:        activity.ID.String(),
+			Title:     activity.Title,
+			OccursAt:  activity.OccursAt.Time,
+			DeletedAt: activity.DeletedAt.Time,
+			PurgeAt:   activity.DeletedAt.Time.Add(pgstore.TrashRetention),
+		})
+	}
+	for _, link := range links {
+		response.Links = append(response.Links, spec.TrashedLinkArray{
+			ID:        link.ID.String(),
+			Title:     link.Title,
+			URL:       link.Url,
+			DeletedAt: link.DeletedAt.Time,
+			PurgeAt:   link.DeletedAt.Time.Add(pgstore.TrashRetention),
+		})
+	}
+
+	return spec.GetTripsTripIDTrashJSON200Response(response)
+}
+
+// Restore a deleted activity.
+// (POST /trips/{tripId}/trash/activities/{activityId}/restore)
+func (api *API) PostTripsTripIDTrashActivitiesActivityIDRestore(w http.ResponseWriter, r *http.Request, tripID string, activityID string) *spec.Response {
+	tripUUID, activityUUID, errResp := api.trashItemIDs(r.Context(), tripID, "activityId", activityID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDTrashActivitiesActivityIDRestoreJSON400Response, spec.PostTripsTripIDTrashActivitiesActivityIDRestoreJSON404Response)
+	}
+
+	activity, err := api.store.RestoreActivity(r.Context(), pgstore.RestoreActivityParams{ID: activityUUID, TripID: tripUUID})
+	if err != nil {
+		if errors.Is(err, pgstore.ErrNotFound) {
+			return spec.PostTripsTripIDTrashActivitiesActivityIDRestoreJSON404Response(spec.Error{Code: "activity_not_in_trash", Message: "activity not found in trash"})
+		}
+		api.logger.Error("failed to restore activity", zap.Error(err), zap.String("activity_id", activityID))
+		return spec.PostTripsTripIDTrashActivitiesActivityIDRestoreJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to restore activity, try again",
+		})
+	}
+
+	api.recordItineraryChange(r, tripUUID, pgstore.AuditActivityAdded, pgstore.ItineraryChange{Title: activity.Title, OccursAt: &activity.OccursAt.Time})
+
+	return spec.PostTripsTripIDTrashActivitiesActivityIDRestoreJSON204Response(nil)
+}
+
+// Restore a deleted link.
+// (POST /trips/{tripId}/trash/links/{linkId}/restore)
+func (api *API) PostTripsTripIDTrashLinksLinkIDRestore(w http.ResponseWriter, r *http.Request, tripID string, linkID string) *spec.Response {
+	tripUUID, linkUUID, errResp := api.trashItemIDs(r.Context(), tripID, "linkId", linkID)
+	if errResp != nil {
+		return errorResponse(errResp, spec.PostTripsTripIDTrashLinksLinkIDRestoreJSON400Response, spec.PostTripsTripIDTrashLinksLinkIDRestoreJSON404Response)
+	}
+
+	link, err := api.store.RestoreLink(r.Context(), pgstore.RestoreLinkParams{ID: linkUUID, TripID: tripUUID})
+	if err != nil {
+		if errors.Is(err, pgstore.ErrNotFound) {
+			return spec.PostTripsTripIDTrashLinksLinkIDRestoreJSON404Response(spec.Error{Code: "link_not_in_trash", Message: "link not found in trash"})
+		}
+		api.logger.Error("failed to restore link", zap.Error(err), zap.String("link_id", linkID))
+		return spec.PostTripsTripIDTrashLinksLinkIDRestoreJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "failed to restore link, try again",
+		})
+	}
+
+	api.recordItineraryChange(r, tripUUID, pgstore.AuditLinkAdded, pgstore.ItineraryChange{Title: link.Title, URL: link.Url})
+
+	return spec.PostTripsTripIDTrashLinksLinkIDRestoreJSON204Response(nil)
+}
+
+// trashItemIDs parses the ids of a trip and of one of its activities or links,
+// checking the trip exists.
+func (api *API) trashItemIDs(ctx context.Context, tripID, itemName, itemID string) (uuid.UUID, uuid.UUID, *apiError) {
+	tripUUID, errID := pathID(ctx, "tripId", tripID)
+	if errID != nil {
+		return uuid.Nil, uuid.Nil, errID
+	}
+
+	itemUUID, errID := pathID(ctx, itemName, itemID)
+	if errID != nil {
+		return uuid.Nil, uuid.Nil, errID
+	}
+
+	if _, errResp := api.getTrip(ctx, tripUUID); errResp != nil {
+		return uuid.Nil, uuid.Nil, errResp
+	}
+
+	return tripUUID, itemUUID, nil
+}
@@ -0,0 +1,65 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/google/uuid"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+type tripKey struct{}
+
+// LoadTrip returns a middleware loading the trip of requests for routes under
+// /trips/{tripId} before they reach the handlers, so the trip is fetched once
+// per request. Requests for trips that do not exist are answered with a 404.
+//
+// It relies on the IDs parsed by RequestValidator, and must come after it.
+func (api *API) LoadTrip(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ids, _ := r.Context().Value(pathIDsKey{}).(map[string]uuid.UUID)
+		id, ok := ids["tripId"]
+		if !ok {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		trip, err := api.store.GetTrip(r.Context(), id)
+		if err != nil {
+			if errors.Is(err, pgstore.ErrNotFound) {
+				writeError(w, http.StatusNotFound, "trip_not_found", "trip not found")
+				return
+			}
+			api.logger.Error("failed to get trip", zap.Error(err), zap.String("trip_id", id.String()))
+			writeError(w, http.StatusBadRequest, internalError, "something went wrong, try again")
+			return
+		}
+
+		next.ServeHTTP(w, r.WithContext(context.WithValue(r.Context(), tripKey{}, trip)))
+	})
+}
+
+// getTrip returns the trip with the given ID, taking the one LoadTrip already
+// loaded for the request when it is the same, or the error to be sent to the
+// client otherwise.
+//
+// The loaded trip is how it was when the request arrived, so handlers changing
+// it must fetch it again from the store to see their changes.
+func (api *API) getTrip(ctx context.Context, id uuid.UUID) (pgstore.Trip, *apiError) {
+	if trip, ok := ctx.Value(tripKey{}).(pgstore.Trip); ok && trip.ID == id {
+		return trip, nil
+	}
+
+	trip, err := api.store.GetTrip(ctx, id)
+	if err != nil {
+		if errors.Is(err, pgstore.ErrNotFound) {
+			return pgstore.Trip{}, notFound("trip_not_found", "trip not found")
+		}
+		api.logger.Error("failed to get trip", zap.Error(err), zap.String("trip_id", id.String()))
+		return pgstore.Trip{}, badRequest(internalError, "something went wrong, try again")
+	}
+
+	return trip, nil
+}
@@ -0,0 +1,335 @@
+package api
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/getkin/kin-openapi/routers"
+	"github.com/getkin/kin-openapi/routers/legacy"
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+)
+
+func init() {
+	openapi3.DefineStringFormat("uuid", openapi3.FormatOfStringForUUIDOfRFC4122)
+	openapi3.DefineStringFormat("email", openapi3.FormatOfStringForEmail)
+
+	// Receipts are uploaded as the raw image.
+	openapi3filter.RegisterBodyDecoder("image/jpeg", openapi3filter.FileBodyDecoder)
+	openapi3filter.RegisterBodyDecoder("image/png", openapi3filter.FileBodyDecoder)
+}
+
+// RequestValidator returns a middleware checking every request against the
+// embedded OpenAPI specification before it reaches the handlers. Requests
+// that do not match are answered with a 422 listing every problem found;
+// requests for routes the specification does not know are passed through.
+//
+// Path parameters declared as UUIDs are parsed first, answering a 400 when
+// one is malformed, and the results are kept in the request context for the
+// handlers to get with pathID.
+//
+// Rules the specification cannot express, like currency codes, are still
+// checked by the handlers.
+func RequestValidator() (func(http.Handler) http.Handler, error) {
+	swagger, err := spec.GetSwagger()
+	if err != nil {
+		return nil, fmt.Errorf("api: failed to load spec for RequestValidator: %w", err)
+	}
+
+	// Requests are matched on their path only, whatever host they came to.
+	swagger.Servers = nil
+
+	router, err := legacy.NewRouter(swagger)
+	if err != nil {
+		return nil, fmt.Errorf("api: failed to build router for RequestValidator: %w", err)
+	}
+
+	options := &openapi3filter.Options{
+		MultiError:          true,
+		SkipSettingDefaults: true,
+		AuthenticationFunc:  openapi3filter.NoopAuthenticationFunc,
+	}
+
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			route, pathParams, err := router.FindRoute(r)
+			if err != nil {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			ids, err := parsePathIDs(route, pathParams)
+			if err != nil {
+				writeError(w, http.StatusBadRequest, "invalid_uuid", "invalid uuid")
+				return
+			}
+			r = r.WithContext(withPathIDs(r.Context(), ids))
+
+			err = openapi3filter.ValidateRequest(r.Context(), &openapi3filter.RequestValidationInput{
+				Request:    r,
+				PathParams: pathParams,
+				Route:      route,
+				Options:    options,
+			})
+			if err != nil {
+				w.Header().Set("Content-Type", "application/json")
+				w.WriteHeader(http.StatusUnprocessableEntity)
+				_ = json.NewEncoder(w).Encode(spec.ValidationError{
+					Code:    "invalid_request",
+					Message: "invalid request",
+					Errors:  validationDetails(err, ""),
+				})
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}, nil
+}
+
+// parsePathIDs parses the path parameters the route declares as UUIDs.
+func parsePathIDs(route *routers.Route, pathParams map[string]string) (map[string]uuid.UUID, error) {
+	ids := make(map[string]uuid.UUID)
+	for _, params := range []openapi3.Parameters{route.PathItem.Parameters, route.Operation.Parameters} {
+		for _, param := range params {
+			p := param.Value
+			if p == nil || p.In != openapi3.ParameterInPath || p.Schema == nil || p.Schema.Value == nil || p.Schema.Value.Format != "uuid" {
+				continue
+			}
+
+			id, err := uuid.Parse(pathParams[p.Name])
+			if err != nil {
+				return nil, fmt.Errorf("invalid %s: %w", p.Name, err)
+			}
+			ids[p.Name] = id
+		}
+	}
+	return ids, nil
+}
+
+// validationDetails flattens the errors found validating a request, pointing
+// each one to the parameter or the part of the body it is about.
+func validationDetails(err error, parameter string) []spec.ValidationErrorDetail {
+	switch e := err.(type) {
+	case openapi3.MultiError:
+		var details []spec.ValidationErrorDetail
+		for _, err := range e {
+			details = append(details, validationDetails(err, parameter)...)
+		}
+		return details
+
+	case *openapi3filter.RequestError:
+		if e.Parameter != nil {
+			parameter = e.Parameter.Name
+		}
+		if errors.Is(e.Err, openapi3filter.ErrInvalidRequired) {
+			return []spec.ValidationErrorDetail{newValidationErrorDetail("required", e.Err.Error(), parameter)}
+		}
+		if e.Err != nil {
+			return validationDetails(e.Err, parameter)
+		}
+		return []spec.ValidationErrorDetail{newValidationErrorDetail("invalid_value", e.Reason, parameter)}
+
+	case *openapi3filter.ParseError:
+		return []spec.ValidationErrorDetail{newValidationErrorDetail("invalid_type", e.Error(), parameter)}
+
+	case *openapi3.SchemaError:
+		detail := newValidationErrorDetail(schemaCode(e.SchemaField), e.Reason, parameter)
+		if parameter == "" {
+			pointer := jsonPointer(e.JSONPointer())
+			detail.Pointer = &pointer
+		}
+		return []spec.ValidationErrorDetail{detail}
+
+	default:
+		return []spec.ValidationErrorDetail{newValidationErrorDetail("invalid_value", err.Error(), parameter)}
+	}
+}
+
+func newValidationErrorDetail(code, message, parameter string) spec.ValidationErrorDetail {
+	detail := spec.ValidationErrorDetail{Code: code, Message: message}
+	if parameter != "" {
+		detail.Parameter = &parameter
+	}
+	return detail
+}
+
+// schemaCode is the code of a problem with a value, given the keyword of the
+// schema it broke.
+func schemaCode(keyword string) string {
+	switch keyword {
+	case "required":
+		return "required"
+	case "properties":
+		return "unknown_property"
+	case "type":
+		return "invalid_type"
+	case "format", "pattern":
+		return "invalid_format"
+	case "enum":
+		return "not_allowed"
+	case "minLength":
+		return "too_short"
+	case "maxLength":
+		return "too_long"
+	case "minimum", "exclusiveMinimum":
+		return "too_small"
+	case "maximum", "exclusiveMaximum":
+		return "too_large"
+	case "minItems":
+		return "too_few_items"
+	case "maxItems":
+		return "too_many_items"
+	}
+	return "invalid_value"
+}
+
+// jsonPointer formats the path to a value as an RFC 6901 JSON pointer.
+func jsonPointer(tokens []string) string {
+	escaper := strings.NewReplacer("~", "~0", "/", "~1")
+	var b strings.Builder
+	for _, token := range tokens {
+		b.WriteString("/" + escaper.Replace(token))
+	}
+	return b.String()
+}
+
+// newValidator returns the validator of request bodies, naming fields by
+// their JSON names.
+func newValidator() *validator.Validate {
+	v := validator.New(validator.WithRequiredStructEnabled())
+	v.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" {
+			return ""
+		}
+		return name
+	})
+	return v
+}
+
+// invalidBody is the body of the 422 sent for request bodies the validator
+// refuses, with a detail for each field, pointing to it and naming the rule
+// it broke.
+func invalidBody(err error) spec.ValidationError {
+	var errs validator.ValidationErrors
+	if !errors.As(err, &errs) {
+		return spec.ValidationError{
+			Code:    "invalid_request",
+			Message: "invalid request",
+			Errors:  []spec.ValidationErrorDetail{{Code: "invalid_value", Message: err.Error()}},
+		}
+	}
+
+	details := make([]spec.ValidationErrorDetail, 0, len(errs))
+	for _, fe := range errs {
+		pointer := fieldPointer(fe.Namespace())
+		rule := fe.Tag()
+		code, message := ruleMessage(fe)
+		details = append(details, spec.ValidationErrorDetail{
+			Code:    code,
+			Message: message,
+			Pointer: &pointer,
+			Rule:    &rule,
+		})
+	}
+
+	return spec.ValidationError{Code: "invalid_request", Message: "invalid request", Errors: details}
+}
+
+// fieldPointer turns the namespace of a field, as in
+// "CreateTripRequest.emails_to_invite[1]", into a JSON pointer to it.
+func fieldPointer(namespace string) string {
+	_, path, _ := strings.Cut(namespace, ".")
+
+	var tokens []string
+	for _, part := range strings.Split(path, ".") {
+		name, indexes, _ := strings.Cut(part, "[")
+		tokens = append(tokens, name)
+		for indexes != "" {
+			index, rest, _ := strings.Cut(indexes, "]")
+			tokens = append(tokens, index)
+			indexes = strings.TrimPrefix(rest, "[")
+		}
+	}
+	return jsonPointer(tokens)
+}
+
+// ruleMessage tells the code of what is wrong with a field and what it is, in
+// the words the request validator uses for the same rules where it has them.
+func ruleMessage(fe validator.FieldError) (string, string) {
+	param := fe.Param()
+
+	switch fe.Tag() {
+	case "required", "required_with", "required_without":
+		return "required", "value is required but missing"
+	case "email":
+		return "invalid_format", "value must be an email"
+	case "url":
+		return "invalid_format", "value must be a url"
+	case "uuid":
+		return "invalid_format", "value must be a uuid"
+	case "timezone":
+		return "invalid_format", "value must be a timezone"
+	case "iso4217":
+		return "invalid_format", "value must be a currency code"
+	case "oneof":
+		return "not_allowed", "value is not one of the allowed values [" + strings.Join(strings.Fields(param), ", ") + "]"
+	case "gtfield":
+		return "invalid_order", "value must be after " + snakeCase(param)
+	}
+
+	var length, items bool
+	switch fe.Kind() {
+	case reflect.String:
+		length = true
+	case reflect.Slice, reflect.Array, reflect.Map:
+		items = true
+	}
+
+	switch fe.Tag() {
+	case "min", "gte":
+		switch {
+		case length:
+			return "too_short", "minimum string length is " + param
+		case items:
+			return "too_few_items", "minimum number of items is " + param
+		}
+		return "too_small", "number must be at least " + param
+	case "max", "lte":
+		switch {
+		case length:
+			return "too_long", "maximum string length is " + param
+		case items:
+			return "too_many_items", "maximum number of items is " + param
+		}
+		return "too_large", "number must be at most " + param
+	case "gt":
+		return "too_small", "number must be more than " + param
+	}
+
+	return "invalid_value", "value breaks the " + fe.Tag() + " rule"
+}
+
+// snakeCase turns the Go name of a field into its JSON name.
+func snakeCase(name string) string {
+	var b strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,91 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/weather"
+	"go.uber.org/zap"
+)
+
+// outdoorTag marks activities that are ruined by rain.
+const outdoorTag = "outdoor"
+
+const warningKindRain = "rain"
+
+// Get a trip schedule weather warnings.
+// (GET /trips/{tripId}/warnings)
+func (api *API) GetTripsTripIDWarnings(w http.ResponseWriter, r *http.Request, tripID string) *spec.Response {
+	id, errID := pathID(r.Context(), "tripId", tripID)
+	if errID != nil {
+		return spec.GetTripsTripIDWarningsJSON400Response(errID.Error)
+	}
+
+	trip, errResp := api.getTrip(r.Context(), id)
+	if errResp != nil {
+		return errorResponse(errResp, spec.GetTripsTripIDWarningsJSON400Response, spec.GetTripsTripIDWarningsJSON404Response)
+	}
+
+	acts, err := api.store.GetTripActivities(r.Context(), id)
+	if err != nil {
+		api.logger.Error("failed to get activities", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDWarningsJSON400Response(spec.Error{
+			Code:    internalError,
+			Message: "fail to get trip activities",
+		})
+	}
+
+	warnings := make([]spec.GetWarningsResponseArray, 0)
+
+	outdoor := slices.ContainsFunc(acts, func(act pgstore.Activity) bool {
+		return slices.Contains(act.Tags, outdoorTag)
+	})
+	if !outdoor {
+		return spec.GetTripsTripIDWarningsJSON200Response(spec.GetWarningsResponse{Warnings: warnings})
+	}
+
+	ctx, cancel := context.WithTimeout(r.Context(), forecastTimeout)
+	defer cancel()
+
+	days, err := api.weather.Forecast(ctx, trip.Destination, trip.StartsAt.Time, trip.EndsAt.Time)
+	if err != nil {
+		api.logger.Warn("failed to get destination weather", zap.Error(err), zap.String("trip_id", tripID))
+		return spec.GetTripsTripIDWarningsJSON400Response(spec.Error{
+			Code:    "forecast_unavailable",
+			Message: "weather forecast unavailable, try again later",
+		})
+	}
+
+	byDate := make(map[string]weather.Day, len(days))
+	for _, day := range days {
+		byDate[day.Date.Format(time.DateOnly)] = day
+	}
+
+	for _, act := range acts {
+		if !slices.Contains(act.Tags, outdoorTag) {
+			continue
+		}
+
+		date := act.OccursAt.Time.Format(time.DateOnly)
+		day, ok := byDate[date]
+		if !ok || !day.IsRainy() {
+			continue
+		}
+
+		warnings = append(warnings, spec.GetWarningsResponseArray{
+			ActivityID:               act.ID.String(),
+			Title:                    act.Title,
+			OccursAt:                 act.OccursAt.Time,
+			Kind:                     warningKindRain,
+			PrecipitationProbability: day.PrecipitationProbability,
+			Message:                  fmt.Sprintf("%d%% chance of rain on %s", day.PrecipitationProbability, date),
+		})
+	}
+
+	return spec.GetTripsTripIDWarningsJSON200Response(spec.GetWarningsResponse{Warnings: warnings})
+}
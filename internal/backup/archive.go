@@ -0,0 +1,136 @@
+package backup
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// Create creates the file at path to write a backup to, compressed as its
+// name tells: .tar.zst with the zstd command, .tar.gz with gzip, anything
+// else as a plain tar.
+func Create(path string) (io.WriteCloser, error) {
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0o600)
+	if err != nil {
+		return nil, fmt.Errorf("backup: failed to create %s: %w", path, err)
+	}
+
+	switch {
+	case strings.HasSuffix(path, ".zst"):
+		cmd := exec.Command("zstd", "-q", "-c")
+		cmd.Stdout = f
+		cmd.Stderr = os.Stderr
+		in, err := cmd.StdinPipe()
+		if err != nil {
+			f.Close()
+			return nil, fmt.Errorf("backup: failed to pipe to zstd: %w", err)
+		}
+		if err := cmd.Start(); err != nil {
+			f.Close()
+			return nil, fmt.Errorf("backup: failed to start zstd, is it installed? %w", err)
+		}
+		return &zstdWriter{WriteCloser: in, cmd: cmd, f: f}, nil
+	case strings.HasSuffix(path, ".gz"):
+		return &gzipWriter{Writer: gzip.NewWriter(f), f: f}, nil
+	}
+
+	return f, nil
+}
+
+// Open opens the backup at path, decompressed as its name tells, the same
+// way as Create.
+func Open(path string) (io.ReadCloser, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("backup: failed to open %s: %w", path, err)
+	}
+
+	switch {
+	case strings.HasSuffix(path, ".zst"):
+		cmd := exec.Command("zstd", "-d", "-q", "-c")
+		cmd.Stdin = f
+		cmd.Stderr = os.Stderr
+		out, err := cmd.StdoutPipe()
+		if err != nil {
+			f.Close()
+			return nil, fmt.Errorf("backup: failed to pipe from zstd: %w", err)
+		}
+		if err := cmd.Start(); err != nil {
+			f.Close()
+			return nil, fmt.Errorf("backup: failed to start zstd, is it installed? %w", err)
+		}
+		return &zstdReader{ReadCloser: out, cmd: cmd, f: f}, nil
+	case strings.HasSuffix(path, ".gz"):
+		r, err := gzip.NewReader(f)
+		if err != nil {
+			f.Close()
+			return nil, fmt.Errorf("backup: failed to read %s: %w", path, err)
+		}
+		return &gzipReader{Reader: r, f: f}, nil
+	}
+
+	return f, nil
+}
+
+type zstdWriter struct {
+	io.WriteCloser
+	cmd *exec.Cmd
+	f   *os.File
+}
+
+func (w *zstdWriter) Close() error {
+	err := w.WriteCloser.Close()
+	if errWait := w.cmd.Wait(); err == nil {
+		err = errWait
+	}
+	if errClose := w.f.Close(); err == nil {
+		err = errClose
+	}
+	return err
+}
+
+type zstdReader struct {
+	io.ReadCloser
+	cmd *exec.Cmd
+	f   *os.File
+}
+
+// Close reads what is left first, so zstd is not cut off writing the end of
+// the archive, past what tar reads.
+func (r *zstdReader) Close() error {
+	_, _ = io.Copy(io.Discard, r.ReadCloser)
+	err := r.cmd.Wait()
+	if errClose := r.f.Close(); err == nil {
+		err = errClose
+	}
+	return err
+}
+
+type gzipWriter struct {
+	*gzip.Writer
+	f *os.File
+}
+
+func (w *gzipWriter) Close() error {
+	err := w.Writer.Close()
+	if errClose := w.f.Close(); err == nil {
+		err = errClose
+	}
+	return err
+}
+
+type gzipReader struct {
+	*gzip.Reader
+	f *os.File
+}
+
+func (r *gzipReader) Close() error {
+	err := r.Reader.Close()
+	if errClose := r.f.Close(); err == nil {
+		err = errClose
+	}
+	return err
+}
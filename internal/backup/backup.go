@@ -0,0 +1,413 @@
+package backup
+
+import (
+	"archive/tar"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/storage"
+)
+
+var (
+	ErrNotBackup     = errors.New("backup: file is not a backup")
+	ErrSchemaVersion = errors.New("backup: database is not at the schema version of the backup")
+	ErrNotEmpty      = errors.New("backup: database already has data")
+)
+
+const (
+	manifestName = "manifest.json"
+	tablesDir    = "tables/"
+	filesDir     = "files/"
+
+	// schemaTable is where tern keeps the version of the schema. It is not
+	// backed up, restores are into databases migrated to the same version.
+	schemaTable = "schema_version"
+
+	// contentTypeRecord keeps the content type of files in their headers.
+	contentTypeRecord = "JOURNEY.content_type"
+)
+
+// Manifest is the first entry of a backup, describing the rest: the data of
+// each table, in an order they can be restored in, then the files.
+type Manifest struct {
+	CreatedAt     time.Time `json:"created_at"`
+	SchemaVersion int32     `json:"schema_version"`
+	Tables        []Table   `json:"tables"`
+	Files         int       `json:"files"`
+}
+
+type Table struct {
+	Name    string   `json:"name"`
+	Columns []string `json:"columns"`
+	Rows    int64    `json:"rows"`
+}
+
+// Progress is told of every step of a backup or restore, once done, as the
+// number of steps done out of total.
+type Progress func(done, total int, step string)
+
+// Backup writes a tar archive with every table of the database, as of a
+// single snapshot, and the files of uploaded attachments when files is not
+// nil.
+func Backup(ctx context.Context, pool *pgxpool.Pool, w io.Writer, files storage.Provider, progress Progress) (Manifest, error) {
+	conn, err := pool.Acquire(ctx)
+	if err != nil {
+		return Manifest{}, fmt.Errorf("backup: failed to acquire connection for Backup: %w", err)
+	}
+	defer conn.Release()
+
+	tx, err := conn.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead, AccessMode: pgx.ReadOnly})
+	if err != nil {
+		return Manifest{}, fmt.Errorf("backup: failed to begin tx for Backup: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	m := Manifest{CreatedAt: time.Now().UTC()}
+	if err := tx.QueryRow(ctx, "SELECT version FROM "+schemaTable).Scan(&m.SchemaVersion); err != nil {
+		return Manifest{}, fmt.Errorf("backup: failed to get schema version for Backup: %w", err)
+	}
+
+	if m.Tables, err = listTables(ctx, tx); err != nil {
+		return Manifest{}, err
+	}
+
+	var uploaded []pgstore.ListUploadedAttachmentsRow
+	if files != nil {
+		if uploaded, err = pgstore.New(tx).ListUploadedAttachments(ctx); err != nil {
+			return Manifest{}, fmt.Errorf("backup: failed to list attachments for Backup: %w", err)
+		}
+	}
+
+	// Tables are copied aside first, as entries of the archive are written
+	// with their size ahead.
+	dir, err := os.MkdirTemp("", "journey-backup-*")
+	if err != nil {
+		return Manifest{}, fmt.Errorf("backup: failed to create temporary directory for Backup: %w", err)
+	}
+	defer os.RemoveAll(dir)
+
+	total := len(m.Tables) + len(uploaded)
+	for i, t := range m.Tables {
+		f, err := os.Create(filepath.Join(dir, t.Name))
+		if err != nil {
+			return Manifest{}, fmt.Errorf("backup: failed to create temporary file for Backup: %w", err)
+		}
+
+		tag, err := conn.Conn().PgConn().CopyTo(ctx, f, copySQL(t, "TO STDOUT"))
+		if errClose := f.Close(); err == nil {
+			err = errClose
+		}
+		if err != nil {
+			return Manifest{}, fmt.Errorf("backup: failed to copy %s for Backup: %w", t.Name, err)
+		}
+
+		m.Tables[i].Rows = tag.RowsAffected()
+		progress(i+1, total, fmt.Sprintf("%s: %d rows", t.Name, m.Tables[i].Rows))
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return Manifest{}, fmt.Errorf("backup: failed to commit tx for Backup: %w", err)
+	}
+
+	// Files can be gone since the snapshot, when their trip expired.
+	keys := make([]string, 0, len(uploaded))
+	for _, a := range uploaded {
+		key := pgstore.AttachmentKey(a.TripID, a.ID)
+		if a.ScanStatus == pgstore.ScanInfected {
+			key = pgstore.QuarantineKey(a.TripID, a.ID)
+		}
+		keys = append(keys, key)
+	}
+	m.Files = len(keys)
+
+	tw := tar.NewWriter(w)
+
+	manifest, err := json.Marshal(m)
+	if err != nil {
+		return Manifest{}, fmt.Errorf("backup: failed to encode manifest for Backup: %w", err)
+	}
+	if err := writeEntry(tw, &tar.Header{Name: manifestName, Size: int64(len(manifest))}, m.CreatedAt, strings.NewReader(string(manifest))); err != nil {
+		return Manifest{}, err
+	}
+
+	for _, t := range m.Tables {
+		if err := writeFile(tw, tablesDir+t.Name, filepath.Join(dir, t.Name), m.CreatedAt); err != nil {
+			return Manifest{}, err
+		}
+	}
+
+	for i, key := range keys {
+		written, err := writeObject(ctx, tw, files, key, m.CreatedAt)
+		if err != nil {
+			return Manifest{}, err
+		}
+
+		step := key
+		if !written {
+			step += ": gone, skipped"
+		}
+		progress(len(m.Tables)+i+1, total, step)
+	}
+
+	if err := tw.Close(); err != nil {
+		return Manifest{}, fmt.Errorf("backup: failed to finish archive for Backup: %w", err)
+	}
+
+	return m, nil
+}
+
+// Restore loads a backup into a database migrated to the same schema
+// version. The database must have no data, unless clean is set, which
+// truncates every table first. The files of the backup are put back when
+// files is not nil, once the tables are restored.
+func Restore(ctx context.Context, pool *pgxpool.Pool, r io.Reader, files storage.Provider, clean bool, progress Progress) (Manifest, error) {
+	tr := tar.NewReader(r)
+
+	hdr, err := tr.Next()
+	if err != nil || hdr.Name != manifestName {
+		return Manifest{}, ErrNotBackup
+	}
+
+	var m Manifest
+	if err := json.NewDecoder(tr).Decode(&m); err != nil {
+		return Manifest{}, fmt.Errorf("backup: failed to decode manifest for Restore: %w", err)
+	}
+
+	conn, err := pool.Acquire(ctx)
+	if err != nil {
+		return Manifest{}, fmt.Errorf("backup: failed to acquire connection for Restore: %w", err)
+	}
+	defer conn.Release()
+
+	tx, err := conn.Begin(ctx)
+	if err != nil {
+		return Manifest{}, fmt.Errorf("backup: failed to begin tx for Restore: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	var version int32
+	if err := tx.QueryRow(ctx, "SELECT version FROM "+schemaTable).Scan(&version); err != nil {
+		return Manifest{}, fmt.Errorf("backup: failed to get schema version for Restore: %w", err)
+	}
+	if version != m.SchemaVersion {
+		return Manifest{}, fmt.Errorf("%w: backup is at %d, database at %d", ErrSchemaVersion, m.SchemaVersion, version)
+	}
+
+	names := make([]string, len(m.Tables))
+	for i, t := range m.Tables {
+		names[i] = pgx.Identifier{t.Name}.Sanitize()
+	}
+
+	if clean {
+		if _, err := tx.Exec(ctx, "TRUNCATE "+strings.Join(names, ", ")+" CASCADE"); err != nil {
+			return Manifest{}, fmt.Errorf("backup: failed to truncate tables for Restore: %w", err)
+		}
+	} else {
+		for i, name := range names {
+			var exists bool
+			if err := tx.QueryRow(ctx, "SELECT EXISTS (SELECT 1 FROM "+name+")").Scan(&exists); err != nil {
+				return Manifest{}, fmt.Errorf("backup: failed to check %s for Restore: %w", m.Tables[i].Name, err)
+			}
+			if exists {
+				return Manifest{}, fmt.Errorf("%w: %s has rows", ErrNotEmpty, m.Tables[i].Name)
+			}
+		}
+	}
+
+	total := len(m.Tables)
+	if files != nil {
+		total += m.Files
+	}
+
+	for i, t := range m.Tables {
+		hdr, err := tr.Next()
+		if err != nil || hdr.Name != tablesDir+t.Name {
+			return Manifest{}, fmt.Errorf("%w: data of %s missing", ErrNotBackup, t.Name)
+		}
+
+		if _, err := conn.Conn().PgConn().CopyFrom(ctx, tr, copySQL(t, "FROM STDIN")); err != nil {
+			return Manifest{}, fmt.Errorf("backup: failed to copy %s for Restore: %w", t.Name, err)
+		}
+		progress(i+1, total, fmt.Sprintf("%s: %d rows", t.Name, t.Rows))
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return Manifest{}, fmt.Errorf("backup: failed to commit tx for Restore: %w", err)
+	}
+
+	if files == nil {
+		return m, nil
+	}
+
+	for done := len(m.Tables); ; done++ {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return Manifest{}, fmt.Errorf("backup: failed to read archive for Restore: %w", err)
+		}
+
+		key, ok := strings.CutPrefix(hdr.Name, filesDir)
+		if !ok {
+			continue
+		}
+
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			return Manifest{}, fmt.Errorf("backup: failed to read %s for Restore: %w", key, err)
+		}
+		if err := files.Put(ctx, key, hdr.PAXRecords[contentTypeRecord], data); err != nil {
+			return Manifest{}, fmt.Errorf("backup: failed to put %s for Restore: %w", key, err)
+		}
+		progress(done+1, total, key)
+	}
+
+	return m, nil
+}
+
+// listTables lists the tables of the database with their columns, each after
+// the ones it refers to.
+func listTables(ctx context.Context, tx pgx.Tx) ([]Table, error) {
+	rows, err := tx.Query(ctx, `
+		SELECT c.relname::TEXT, array_agg(a.attname::TEXT ORDER BY a.attnum)
+		FROM pg_class c
+		JOIN pg_namespace n ON n.oid = c.relnamespace
+		JOIN pg_attribute a ON a.attrelid = c.oid AND a.attnum > 0 AND NOT a.attisdropped AND a.attgenerated = ''
+		WHERE n.nspname = current_schema() AND c.relkind = 'r' AND c.relname <> $1
+		GROUP BY c.relname
+		ORDER BY c.relname`, schemaTable)
+	if err != nil {
+		return nil, fmt.Errorf("backup: failed to list tables: %w", err)
+	}
+	tables, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (Table, error) {
+		var t Table
+		err := row.Scan(&t.Name, &t.Columns)
+		return t, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("backup: failed to list tables: %w", err)
+	}
+
+	rows, err = tx.Query(ctx, `
+		SELECT t.relname::TEXT, r.relname::TEXT
+		FROM pg_constraint c
+		JOIN pg_class t ON t.oid = c.conrelid
+		JOIN pg_class r ON r.oid = c.confrelid
+		JOIN pg_namespace n ON n.oid = t.relnamespace
+		WHERE c.contype = 'f' AND n.nspname = current_schema()`)
+	if err != nil {
+		return nil, fmt.Errorf("backup: failed to list foreign keys: %w", err)
+	}
+	refs := make(map[string][]string)
+	var table, referred string
+	_, err = pgx.ForEachRow(rows, []any{&table, &referred}, func() error {
+		if table != referred {
+			refs[table] = append(refs[table], referred)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("backup: failed to list foreign keys: %w", err)
+	}
+
+	byName := make(map[string]Table, len(tables))
+	for _, t := range tables {
+		byName[t.Name] = t
+	}
+
+	ordered := make([]Table, 0, len(tables))
+	visited := make(map[string]bool, len(tables))
+	var visit func(name string)
+	visit = func(name string) {
+		if visited[name] {
+			return
+		}
+		visited[name] = true
+		referred := refs[name]
+		slices.Sort(referred)
+		for _, r := range referred {
+			visit(r)
+		}
+		if t, ok := byName[name]; ok {
+			ordered = append(ordered, t)
+		}
+	}
+	for _, t := range tables {
+		visit(t.Name)
+	}
+
+	return ordered, nil
+}
+
+func copySQL(t Table, direction string) string {
+	columns := make([]string, len(t.Columns))
+	for i, c := range t.Columns {
+		columns[i] = pgx.Identifier{c}.Sanitize()
+	}
+	return fmt.Sprintf("COPY %s (%s) %s (FORMAT binary)", pgx.Identifier{t.Name}.Sanitize(), strings.Join(columns, ", "), direction)
+}
+
+func writeEntry(tw *tar.Writer, hdr *tar.Header, modTime time.Time, r io.Reader) error {
+	hdr.Mode = 0o600
+	hdr.ModTime = modTime
+	hdr.Format = tar.FormatPAX
+	if err := tw.WriteHeader(hdr); err != nil {
+		return fmt.Errorf("backup: failed to write %s header: %w", hdr.Name, err)
+	}
+	if _, err := io.Copy(tw, r); err != nil {
+		return fmt.Errorf("backup: failed to write %s: %w", hdr.Name, err)
+	}
+	return nil
+}
+
+func writeFile(tw *tar.Writer, name, path string, modTime time.Time) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("backup: failed to open %s: %w", name, err)
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return fmt.Errorf("backup: failed to stat %s: %w", name, err)
+	}
+
+	return writeEntry(tw, &tar.Header{Name: name, Size: info.Size()}, modTime, f)
+}
+
+// writeObject writes the file kept under key in the storage, reporting
+// whether it was still there.
+func writeObject(ctx context.Context, tw *tar.Writer, files storage.Provider, key string, modTime time.Time) (bool, error) {
+	object, err := files.Stat(ctx, key)
+	if errors.Is(err, storage.ErrNotFound) {
+		return false, nil
+	}
+	if err != nil {
+		return false, fmt.Errorf("backup: failed to stat %s: %w", key, err)
+	}
+
+	rc, err := files.Open(ctx, key)
+	if err != nil {
+		return false, fmt.Errorf("backup: failed to open %s: %w", key, err)
+	}
+	defer rc.Close()
+
+	hdr := &tar.Header{
+		Name:       filesDir + key,
+		Size:       object.Size,
+		PAXRecords: map[string]string{contentTypeRecord: object.ContentType},
+	}
+	return true, writeEntry(tw, hdr, modTime, rc)
+}
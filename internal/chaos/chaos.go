@@ -0,0 +1,174 @@
+// Package chaos injects faults into the API, its database queries and the
+// emails it sends, to see how it copes with them. It is meant for development
+// and staging only: nothing is injected unless configured.
+package chaos
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+)
+
+// Config is how often each fault is injected, each rate from 0 (never) to 1
+// (always).
+type Config struct {
+	// LatencyRate is how often requests and queries are slowed down, by up
+	// to MaxLatency.
+	LatencyRate float64
+	MaxLatency  time.Duration
+
+	// SMTPFailureRate is how often the connection to the SMTP server drops
+	// before an email is sent.
+	SMTPFailureRate float64
+
+	// DBFailureRate is how often queries fail with a serialization failure,
+	// as when a concurrent transaction got in the way.
+	DBFailureRate float64
+}
+
+// Enabled reports whether any fault is injected.
+func (c Config) Enabled() bool {
+	return (c.LatencyRate > 0 && c.MaxLatency > 0) || c.SMTPFailureRate > 0 || c.DBFailureRate > 0
+}
+
+// Validate checks every rate is within 0 and 1.
+func (c Config) Validate() error {
+	for name, rate := range map[string]float64{"latency": c.LatencyRate, "smtp failure": c.SMTPFailureRate, "db failure": c.DBFailureRate} {
+		if rate < 0 || rate > 1 {
+			return fmt.Errorf("chaos: %s rate must be between 0 and 1, got %v", name, rate)
+		}
+	}
+	if c.MaxLatency < 0 {
+		return fmt.Errorf("chaos: max latency must not be negative, got %v", c.MaxLatency)
+	}
+	return nil
+}
+
+// ErrSMTPDropped is the error of the SMTP connections dropped on purpose.
+var ErrSMTPDropped = fmt.Errorf("chaos: smtp connection dropped: %w", io.ErrUnexpectedEOF)
+
+// serializationFailure is the SQLSTATE of a transaction that could not be
+// serialized.
+const serializationFailure = "40001"
+
+// Injector injects the faults of its Config. The zero value injects none.
+type Injector struct {
+	cfg Config
+}
+
+// New returns an Injector for cfg.
+func New(cfg Config) *Injector {
+	return &Injector{cfg: cfg}
+}
+
+// Middleware slows requests down before they reach next.
+func (i *Injector) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := i.delay(r.Context()); err != nil {
+			return
+		}
+		next.ServeHTTP(w, r)
+	})
+}
+
+// SMTP returns ErrSMTPDropped as often as the connection to the SMTP server
+// should drop, nil otherwise.
+func (i *Injector) SMTP(ctx context.Context) error {
+	if err := i.delay(ctx); err != nil {
+		return err
+	}
+	if i.happens(i.cfg.SMTPFailureRate) {
+		return ErrSMTPDropped
+	}
+	return nil
+}
+
+// DB wraps db, slowing its queries down and failing some of them with a
+// serialization failure before they run.
+func (i *Injector) DB(db pgstore.DBTX) pgstore.DBTX {
+	return faultyDB{db: db, i: i}
+}
+
+// fault is the error a query fails with, if it does.
+func (i *Injector) fault(ctx context.Context) error {
+	if err := i.delay(ctx); err != nil {
+		return err
+	}
+	if i.happens(i.cfg.DBFailureRate) {
+		return &pgconn.PgError{
+			Severity: "ERROR",
+			Code:     serializationFailure,
+			Message:  "could not serialize access due to concurrent update (injected by chaos)",
+		}
+	}
+	return nil
+}
+
+// delay waits for a random latency as often as configured, or until ctx is
+// done.
+func (i *Injector) delay(ctx context.Context) error {
+	if i.cfg.MaxLatency <= 0 || !i.happens(i.cfg.LatencyRate) {
+		return nil
+	}
+
+	t := time.NewTimer(rand.N(i.cfg.MaxLatency))
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func (i *Injector) happens(rate float64) bool {
+	return rate > 0 && rand.Float64() < rate
+}
+
+type faultyDB struct {
+	db pgstore.DBTX
+	i  *Injector
+}
+
+func (d faultyDB) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	if err := d.i.fault(ctx); err != nil {
+		return pgconn.CommandTag{}, err
+	}
+	return d.db.Exec(ctx, sql, args...)
+}
+
+func (d faultyDB) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	if err := d.i.fault(ctx); err != nil {
+		return nil, err
+	}
+	return d.db.Query(ctx, sql, args...)
+}
+
+func (d faultyDB) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	if err := d.i.fault(ctx); err != nil {
+		return failedRow{err}
+	}
+	return d.db.QueryRow(ctx, sql, args...)
+}
+
+func (d faultyDB) CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error) {
+	if err := d.i.fault(ctx); err != nil {
+		return 0, err
+	}
+	return d.db.CopyFrom(ctx, tableName, columnNames, rowSrc)
+}
+
+type failedRow struct {
+	err error
+}
+
+func (r failedRow) Scan(...any) error {
+	return r.err
+}
@@ -0,0 +1,57 @@
+package currency
+
+import "strings"
+
+// byCountry maps ISO 3166-1 alpha-2 country codes to the ISO 4217 code of
+// the currency used there.
+var byCountry = map[string]string{
+	// Americas
+	"AR": "ARS", "BO": "BOB", "BR": "BRL", "BS": "BSD", "BZ": "BZD",
+	"CA": "CAD", "CL": "CLP", "CO": "COP", "CR": "CRC", "CU": "CUP",
+	"DO": "DOP", "EC": "USD", "GT": "GTQ", "GY": "GYD", "HN": "HNL",
+	"HT": "HTG", "JM": "JMD", "MX": "MXN", "NI": "NIO", "PA": "PAB",
+	"PE": "PEN", "PR": "USD", "PY": "PYG", "SR": "SRD", "SV": "USD",
+	"TT": "TTD", "US": "USD", "UY": "UYU", "VE": "VES",
+	"AG": "XCD", "DM": "XCD", "GD": "XCD", "KN": "XCD", "LC": "XCD", "VC": "XCD",
+	"AW": "AWG", "BB": "BBD", "BM": "BMD", "KY": "KYD",
+
+	// Europe
+	"AD": "EUR", "AT": "EUR", "BE": "EUR", "CY": "EUR", "DE": "EUR",
+	"EE": "EUR", "ES": "EUR", "FI": "EUR", "FR": "EUR", "GR": "EUR",
+	"HR": "EUR", "IE": "EUR", "IT": "EUR", "LT": "EUR", "LU": "EUR",
+	"LV": "EUR", "MC": "EUR", "ME": "EUR", "MT": "EUR", "NL": "EUR",
+	"PT": "EUR", "SI": "EUR", "SK": "EUR", "SM": "EUR", "VA": "EUR",
+	"AL": "ALL", "BA": "BAM", "BG": "BGN", "BY": "BYN", "CH": "CHF",
+	"CZ": "CZK", "DK": "DKK", "GB": "GBP", "HU": "HUF", "IS": "ISK",
+	"LI": "CHF", "MD": "MDL", "MK": "MKD", "NO": "NOK", "PL": "PLN",
+	"RO": "RON", "RS": "RSD", "RU": "RUB", "SE": "SEK", "TR": "TRY",
+	"UA": "UAH",
+
+	// Africa
+	"AO": "AOA", "BW": "BWP", "CV": "CVE", "DZ": "DZD", "EG": "EGP",
+	"ET": "ETB", "GH": "GHS", "KE": "KES", "MA": "MAD", "MG": "MGA",
+	"MU": "MUR", "MZ": "MZN", "NA": "NAD", "NG": "NGN", "RW": "RWF",
+	"SC": "SCR", "SN": "XOF", "CI": "XOF", "CM": "XAF", "TN": "TND",
+	"TZ": "TZS", "UG": "UGX", "ZA": "ZAR", "ZM": "ZMW", "ZW": "USD",
+
+	// Asia and the Middle East
+	"AE": "AED", "AM": "AMD", "AZ": "AZN", "BD": "BDT", "BH": "BHD",
+	"BN": "BND", "BT": "BTN", "CN": "CNY", "GE": "GEL", "HK": "HKD",
+	"ID": "IDR", "IL": "ILS", "IN": "INR", "IQ": "IQD", "IR": "IRR",
+	"JO": "JOD", "JP": "JPY", "KG": "KGS", "KH": "KHR", "KR": "KRW",
+	"KW": "KWD", "KZ": "KZT", "LA": "LAK", "LB": "LBP", "LK": "LKR",
+	"MM": "MMK", "MN": "MNT", "MO": "MOP", "MV": "MVR", "MY": "MYR",
+	"NP": "NPR", "OM": "OMR", "PH": "PHP", "PK": "PKR", "QA": "QAR",
+	"SA": "SAR", "SG": "SGD", "TH": "THB", "TJ": "TJS", "TL": "USD",
+	"TM": "TMT", "TW": "TWD", "UZ": "UZS", "VN": "VND",
+
+	// Oceania
+	"AU": "AUD", "FJ": "FJD", "NC": "XPF", "NZ": "NZD", "PF": "XPF",
+	"PG": "PGK", "SB": "SBD", "TO": "TOP", "VU": "VUV", "WS": "WST",
+}
+
+// ForCountry returns the currency used in the given country, if known.
+func ForCountry(countryCode string) (string, bool) {
+	code, ok := byCountry[strings.ToUpper(countryCode)]
+	return code, ok
+}
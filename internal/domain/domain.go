@@ -0,0 +1,156 @@
+// Package domain holds the trip plans as the rest of the app reasons about
+// them: plain times and durations, typed statuses and no database nulls.
+// Packages that only read plans, like export and planning, depend on these
+// types rather than on the rows generated for the queries.
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PlanStatus is whether an activity or a lodging counts towards the trip.
+// Plans that do not fit the budget wait for the owner approval.
+type PlanStatus string
+
+const (
+	PlanApproved PlanStatus = "approved"
+	PlanPending  PlanStatus = "pending"
+)
+
+// ParticipantStatus is where a participant stands on the trip. Confirmation
+// is tracked apart, on the participant itself.
+type ParticipantStatus string
+
+const (
+	ParticipantInvited      ParticipantStatus = "invited"
+	ParticipantWaitlisted   ParticipantStatus = "waitlisted"
+	ParticipantDeclined     ParticipantStatus = "declined"
+	ParticipantEmailInvalid ParticipantStatus = "email_invalid"
+)
+
+// Role is what a participant may do on the trip.
+type Role string
+
+const (
+	RoleParticipant Role = "participant"
+	RoleOwner       Role = "owner"
+)
+
+// TransportMode is how a transport gets from its origin to its destination.
+type TransportMode string
+
+const (
+	TransportFlight TransportMode = "flight"
+	TransportTrain  TransportMode = "train"
+	TransportBus    TransportMode = "bus"
+	TransportCar    TransportMode = "car"
+	TransportBoat   TransportMode = "boat"
+)
+
+// Trip is a trip and its dates. MaxParticipants and BudgetPerPersonCents are
+// zero when the trip has no such limit, and ArchivedAt is zero while the trip
+// is not archived.
+type Trip struct {
+	ID                   uuid.UUID
+	Destination          string
+	OwnerEmail           string
+	OwnerName            string
+	Status               TripStatus
+	StartsAt             time.Time
+	EndsAt               time.Time
+	MaxParticipants      int
+	BudgetPerPersonCents int64
+	// Currency is empty when the owners did not choose one.
+	Currency            string
+	ItineraryAttachment string
+	// TimeZone is where the trip happens, never nil.
+	TimeZone   *time.Location
+	ArchivedAt time.Time
+}
+
+// Archived reports whether the trip was archived.
+func (t Trip) Archived() bool {
+	return !t.ArchivedAt.IsZero()
+}
+
+// Activity is something planned for a moment of the trip. Duration is zero
+// when it was not given, Location nil when it was not placed on the map and
+// OrganizerID uuid.Nil while nobody organizes it. CostCents is an estimate in
+// Currency, the trip currency when empty, and ExpenseID is uuid.Nil until the
+// activity is paid.
+type Activity struct {
+	ID          uuid.UUID
+	TripID      uuid.UUID
+	Title       string
+	OccursAt    time.Time
+	Duration    time.Duration
+	Tags        []string
+	CostCents   int64
+	Currency    string
+	Status      PlanStatus
+	Location    *Coordinates
+	OrganizerID uuid.UUID
+	ExpenseID   uuid.UUID
+}
+
+// Coordinates are a point on the map, in degrees.
+type Coordinates struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// Link is a page saved to the trip.
+type Link struct {
+	ID     uuid.UUID
+	TripID uuid.UUID
+	Title  string
+	URL    string
+}
+
+// Participant is someone invited to the trip. Name is empty until they
+// give it, and GroupID is uuid.Nil while they are in no group.
+type Participant struct {
+	ID        uuid.UUID
+	TripID    uuid.UUID
+	Email     string
+	Name      string
+	Confirmed bool
+	Status    ParticipantStatus
+	Role      Role
+	InvitedAt time.Time
+	GroupID   uuid.UUID
+}
+
+// DisplayName returns the name of the participant, or their email when they
+// did not give one.
+func (p Participant) DisplayName() string {
+	if p.Name != "" {
+		return p.Name
+	}
+	return p.Email
+}
+
+// Lodging is where the trip stays between its check-in and check-out.
+type Lodging struct {
+	ID        uuid.UUID
+	TripID    uuid.UUID
+	Name      string
+	Address   string
+	CheckIn   time.Time
+	CheckOut  time.Time
+	CostCents int64
+	Status    PlanStatus
+}
+
+// Transport is a leg of the trip from one place to another.
+type Transport struct {
+	ID          uuid.UUID
+	TripID      uuid.UUID
+	Mode        TransportMode
+	Origin      string
+	Destination string
+	DepartsAt   time.Time
+	ArrivesAt   time.Time
+}
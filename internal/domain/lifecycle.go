@@ -0,0 +1,63 @@
+package domain
+
+import (
+	"errors"
+	"fmt"
+)
+
+// TripStatus is where a trip is in its lifecycle. Trips are drafts until an
+// owner confirms them, become ongoing when they start and finished when they
+// end. Drafts and trips not finished yet may be cancelled instead.
+type TripStatus string
+
+const (
+	TripDraft     TripStatus = "draft"
+	TripConfirmed TripStatus = "confirmed"
+	TripOngoing   TripStatus = "ongoing"
+	TripFinished  TripStatus = "finished"
+	TripCancelled TripStatus = "cancelled"
+)
+
+// ErrInvalidTransition is returned when a trip can not move from its status
+// to the one asked for.
+var ErrInvalidTransition = errors.New("domain: invalid trip transition")
+
+// tripTransitions are the statuses a trip may move to from each status.
+// Finished and cancelled trips stay as they are.
+var tripTransitions = map[TripStatus][]TripStatus{
+	TripDraft:     {TripConfirmed, TripCancelled},
+	TripConfirmed: {TripOngoing, TripCancelled},
+	TripOngoing:   {TripFinished, TripCancelled},
+}
+
+// ParseTripStatus reads a trip status, failing on unknown ones.
+func ParseTripStatus(s string) (TripStatus, error) {
+	switch status := TripStatus(s); status {
+	case TripDraft, TripConfirmed, TripOngoing, TripFinished, TripCancelled:
+		return status, nil
+	default:
+		return "", fmt.Errorf("domain: unknown trip status %q", s)
+	}
+}
+
+// Transitions returns the statuses the trip may move to from s.
+func (s TripStatus) Transitions() []TripStatus {
+	return append([]TripStatus{}, tripTransitions[s]...)
+}
+
+// CheckTransition returns ErrInvalidTransition unless a trip may move from s
+// to to.
+func (s TripStatus) CheckTransition(to TripStatus) error {
+	for _, next := range tripTransitions[s] {
+		if next == to {
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: from %s to %s", ErrInvalidTransition, s, to)
+}
+
+// Confirmed reports whether the trip was confirmed, whether or not it already
+// started or ended.
+func (s TripStatus) Confirmed() bool {
+	return s == TripConfirmed || s == TripOngoing || s == TripFinished
+}
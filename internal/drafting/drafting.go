@@ -0,0 +1,124 @@
+package drafting
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	ErrDisabled = errors.New("drafting: no itinerary draft provider configured")
+	ErrLimited  = errors.New("drafting: daily draft limit reached")
+)
+
+// Request is what a draft is made from. Preferences are free text from the
+// people planning the trip, such as what they like or want to avoid.
+type Request struct {
+	Destination string
+	StartsAt    time.Time
+	EndsAt      time.Time
+	Preferences string
+	// MaxActivities caps how many activities the draft has in total.
+	MaxActivities int
+}
+
+// Activity is a proposed activity, at a time local to the destination.
+type Activity struct {
+	Title           string
+	OccursAt        time.Time
+	DurationMinutes int
+	CostCents       int64
+	Tags            []string
+}
+
+// Provider proposes a day by day plan for a trip, and reads activities
+// described in plain words. Implementations must give up when ctx is done, as
+// both are done while the client waits.
+type Provider interface {
+	Draft(ctx context.Context, req Request) ([]Activity, error)
+	// Parse reads a single activity of the trip from text, such as "dinner
+	// at Coco Bambu friday 20:00".
+	Parse(ctx context.Context, text string, req Request) (Activity, error)
+}
+
+// None is the provider used when no draft provider is configured. Every
+// draft fails with ErrDisabled.
+type None struct{}
+
+func (None) Draft(context.Context, Request) ([]Activity, error) {
+	return nil, ErrDisabled
+}
+
+func (None) Parse(context.Context, string, Request) (Activity, error) {
+	return Activity{}, ErrDisabled
+}
+
+// Limited caps how many calls a provider takes a day, in memory, so each
+// instance of the API counts its own. Every call counts, even failed ones, as
+// they are billed all the same.
+type Limited struct {
+	provider Provider
+	perDay   int
+
+	mu    sync.Mutex
+	day   time.Time
+	count int
+}
+
+func NewLimited(provider Provider, perDay int) *Limited {
+	return &Limited{provider: provider, perDay: perDay}
+}
+
+func (l *Limited) Draft(ctx context.Context, req Request) ([]Activity, error) {
+	if !l.take(time.Now()) {
+		return nil, ErrLimited
+	}
+	return l.provider.Draft(ctx, req)
+}
+
+func (l *Limited) Parse(ctx context.Context, text string, req Request) (Activity, error) {
+	if !l.take(time.Now()) {
+		return Activity{}, ErrLimited
+	}
+	return l.provider.Parse(ctx, text, req)
+}
+
+func (l *Limited) take(now time.Time) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if today := now.Truncate(24 * time.Hour); !today.Equal(l.day) {
+		l.day, l.count = today, 0
+	}
+	if l.count >= l.perDay {
+		return false
+	}
+	l.count++
+	return true
+}
+
+// Clean keeps the proposed activities that can be added to the trip: titled,
+// within its dates and no more than asked for, in order.
+func Clean(req Request, activities []Activity) []Activity {
+	cleaned := make([]Activity, 0, min(len(activities), req.MaxActivities))
+	for _, a := range activities {
+		if len(cleaned) == req.MaxActivities {
+			break
+		}
+
+		a.Title = strings.TrimSpace(a.Title)
+		if a.Title == "" || len(a.Title) > 255 {
+			continue
+		}
+		if a.OccursAt.Before(req.StartsAt) || a.OccursAt.After(req.EndsAt) {
+			continue
+		}
+
+		a.DurationMinutes = max(a.DurationMinutes, 0)
+		a.CostCents = max(a.CostCents, 0)
+		cleaned = append(cleaned, a)
+	}
+	return cleaned
+}
@@ -0,0 +1,223 @@
+package openai
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/drafting"
+)
+
+const (
+	defaultURL = "https://api.openai.com/v1"
+
+	// defaultMaxTokens bounds how long, and so how costly, each answer is
+	// when not configured otherwise.
+	defaultMaxTokens = 2000
+
+	// parseMaxTokens bounds the answers to Parse, which are a single
+	// activity.
+	parseMaxTokens = 200
+
+	// maxResponseSize bounds how much of an answer is read.
+	maxResponseSize = 1 << 20
+)
+
+// Config is the model drafts are asked to. URL is the base of any OpenAI
+// compatible chat completions API, the OpenAI one when empty.
+type Config struct {
+	URL       string
+	APIKey    string
+	Model     string
+	MaxTokens int
+}
+
+// OpenAI drafts itineraries with a chat completions API.
+type OpenAI struct {
+	client *http.Client
+	cfg    Config
+}
+
+func NewOpenAI(client *http.Client, cfg Config) (OpenAI, error) {
+	if cfg.APIKey == "" || cfg.Model == "" {
+		return OpenAI{}, fmt.Errorf("openai: api key and model are required")
+	}
+	if cfg.URL == "" {
+		cfg.URL = defaultURL
+	}
+	if cfg.MaxTokens <= 0 {
+		cfg.MaxTokens = defaultMaxTokens
+	}
+	cfg.URL = strings.TrimSuffix(cfg.URL, "/")
+	return OpenAI{client, cfg}, nil
+}
+
+type message struct {
+	Role    string `json:"role"`
+	Content string `json:"content"`
+}
+
+type completionRequest struct {
+	Model          string    `json:"model"`
+	Messages       []message `json:"messages"`
+	MaxTokens      int       `json:"max_tokens"`
+	Temperature    float64   `json:"temperature"`
+	ResponseFormat struct {
+		Type string `json:"type"`
+	} `json:"response_format"`
+}
+
+type completionResponse struct {
+	Choices []struct {
+		Message message `json:"message"`
+	} `json:"choices"`
+}
+
+// draft is the JSON the model is asked to answer with.
+type draft struct {
+	Activities []struct {
+		Title           string   `json:"title"`
+		Date            string   `json:"date"`
+		Time            string   `json:"time"`
+		DurationMinutes int      `json:"duration_minutes"`
+		CostCents       int64    `json:"cost_cents"`
+		Tags            []string `json:"tags"`
+	} `json:"activities"`
+}
+
+const systemPrompt = `You plan trips. Answer only with a JSON object of the form
+{"activities": [{"title": string, "date": "YYYY-MM-DD", "time": "HH:MM", "duration_minutes": integer, "cost_cents": integer, "tags": [string]}]}
+with activities spread over every day of the trip, at local times of the destination.
+cost_cents is the estimated cost per person in cents, 0 when free. Titles are short and in Portuguese.`
+
+func (o OpenAI) Draft(ctx context.Context, req drafting.Request) ([]drafting.Activity, error) {
+	prompt := fmt.Sprintf(
+		"Destination: %s\nFirst day: %s\nLast day: %s\nAt most %d activities.",
+		req.Destination, req.StartsAt.Format(time.DateOnly), req.EndsAt.Format(time.DateOnly), req.MaxActivities,
+	)
+	if req.Preferences != "" {
+		prompt += "\nPreferences of the travelers: " + req.Preferences
+	}
+
+	body := completionRequest{
+		Model: o.cfg.Model,
+		Messages: []message{
+			{Role: "system", Content: systemPrompt},
+			{Role: "user", Content: prompt},
+		},
+		MaxTokens:   o.cfg.MaxTokens,
+		Temperature: 0.7,
+	}
+	body.ResponseFormat.Type = "json_object"
+
+	var res completionResponse
+	if err := o.post(ctx, body, &res); err != nil {
+		return nil, fmt.Errorf("openai: failed to complete for Draft: %w", err)
+	}
+	if len(res.Choices) == 0 {
+		return nil, fmt.Errorf("openai: no answer given for Draft")
+	}
+
+	var d draft
+	if err := json.Unmarshal([]byte(res.Choices[0].Message.Content), &d); err != nil {
+		return nil, fmt.Errorf("openai: failed to decode draft for Draft: %w", err)
+	}
+
+	activities := make([]drafting.Activity, 0, len(d.Activities))
+	for _, a := range d.Activities {
+		occursAt, err := time.Parse(time.DateOnly+" 15:04", a.Date+" "+a.Time)
+		if err != nil {
+			continue
+		}
+		activities = append(activities, drafting.Activity{
+			Title:           a.Title,
+			OccursAt:        occursAt,
+			DurationMinutes: a.DurationMinutes,
+			CostCents:       a.CostCents,
+			Tags:            a.Tags,
+		})
+	}
+
+	return activities, nil
+}
+
+// parsed is the JSON the model is asked to answer with when reading a single
+// activity.
+type parsed struct {
+	Title string `json:"title"`
+	Date  string `json:"date"`
+	Time  string `json:"time"`
+}
+
+const parsePrompt = `You read activities of a trip described by travelers. Answer only with a JSON object of the form
+{"title": string, "date": "YYYY-MM-DD", "time": "HH:MM"}
+where title is what the activity is, without when it happens, in the words of the traveler.`
+
+func (o OpenAI) Parse(ctx context.Context, text string, req drafting.Request) (drafting.Activity, error) {
+	prompt := fmt.Sprintf(
+		"Destination: %s\nFirst day: %s\nLast day: %s\nToday: %s\nActivity: %s",
+		req.Destination, req.StartsAt.Format(time.DateOnly), req.EndsAt.Format(time.DateOnly), time.Now().Format(time.DateOnly), text,
+	)
+
+	body := completionRequest{
+		Model: o.cfg.Model,
+		Messages: []message{
+			{Role: "system", Content: parsePrompt},
+			{Role: "user", Content: prompt},
+		},
+		MaxTokens: min(o.cfg.MaxTokens, parseMaxTokens),
+	}
+	body.ResponseFormat.Type = "json_object"
+
+	var res completionResponse
+	if err := o.post(ctx, body, &res); err != nil {
+		return drafting.Activity{}, fmt.Errorf("openai: failed to complete for Parse: %w", err)
+	}
+	if len(res.Choices) == 0 {
+		return drafting.Activity{}, fmt.Errorf("openai: no answer given for Parse")
+	}
+
+	var p parsed
+	if err := json.Unmarshal([]byte(res.Choices[0].Message.Content), &p); err != nil {
+		return drafting.Activity{}, fmt.Errorf("openai: failed to decode activity for Parse: %w", err)
+	}
+
+	occursAt, err := time.Parse(time.DateOnly+" 15:04", p.Date+" "+p.Time)
+	if err != nil {
+		return drafting.Activity{}, fmt.Errorf("openai: failed to parse activity time for Parse: %w", err)
+	}
+
+	return drafting.Activity{Title: p.Title, OccursAt: occursAt}, nil
+}
+
+func (o OpenAI) post(ctx context.Context, body, v any) error {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, o.cfg.URL+"/chat/completions", bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+o.cfg.APIKey)
+
+	resp, err := o.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, bytes.TrimSpace(body))
+	}
+
+	return json.NewDecoder(io.LimitReader(resp.Body, maxResponseSize)).Decode(v)
+}
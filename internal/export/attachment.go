@@ -0,0 +1,29 @@
+package export
+
+import "time"
+
+// Formats the itinerary can be attached to the trip emails in.
+const (
+	FormatNone     = "none"
+	FormatICS      = "ics"
+	FormatMarkdown = "markdown"
+)
+
+// Attachment is an itinerary rendered as a file to be sent by email.
+type Attachment struct {
+	Name        string
+	ContentType string
+	Content     []byte
+}
+
+// Attach renders the itinerary in the given format. It returns false for
+// FormatNone or unknown formats.
+func Attach(it Itinerary, format, domain string, now time.Time) (Attachment, bool) {
+	switch format {
+	case FormatICS:
+		return Attachment{"roteiro.ics", "text/calendar", []byte(ICS(it, domain, now))}, true
+	case FormatMarkdown:
+		return Attachment{"roteiro.md", "text/markdown", []byte(Markdown(it))}, true
+	}
+	return Attachment{}, false
+}
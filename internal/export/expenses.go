@@ -0,0 +1,44 @@
+package export
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+	"time"
+)
+
+// Expense is a row of the expenses sheet sent for reimbursement, with the
+// path of its receipt in the bundle, if it has one.
+type Expense struct {
+	SpentAt     time.Time
+	Description string
+	Category    string
+	PaidBy      string
+	AmountCents int64
+	Currency    string
+	Receipt     string
+}
+
+// ExpensesCSV writes the expenses with one row each.
+func ExpensesCSV(w io.Writer, expenses []Expense) error {
+	cw := csv.NewWriter(w)
+
+	rows := [][]string{{"Data", "Descrição", "Categoria", "Pago por", "Valor", "Moeda", "Recibo"}}
+	for _, expense := range expenses {
+		rows = append(rows, []string{
+			expense.SpentAt.Format("2006-01-02"),
+			cell(expense.Description),
+			cell(expense.Category),
+			cell(expense.PaidBy),
+			strconv.FormatFloat(float64(expense.AmountCents)/100, 'f', 2, 64),
+			expense.Currency,
+			expense.Receipt,
+		})
+	}
+
+	if err := cw.WriteAll(rows); err != nil {
+		return fmt.Errorf("export: failed to write rows for ExpensesCSV: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,74 @@
+package export
+
+import (
+	"slices"
+	"time"
+)
+
+// Itinerary is a trip as shared outside the app. Every export format is
+// rendered from it, so they all show the same plan.
+type Itinerary struct {
+	Destination string
+	StartsAt    time.Time
+	EndsAt      time.Time
+	Days        []Day
+	Links       []Link
+
+	// TimeZone is where the trip happens, the times above being wall clock
+	// times there. It is nil when not known.
+	TimeZone *time.Location
+}
+
+type Day struct {
+	Date  time.Time
+	Items []Item
+}
+
+// Item is something happening at a given time of a day: an activity, a
+// check-in or a departure.
+type Item struct {
+	At       time.Time
+	Duration time.Duration
+	Title    string
+	Notes    []string
+}
+
+type Link struct {
+	Title string
+	URL   string
+}
+
+// New returns an empty itinerary with one day for each date of the trip.
+func New(destination string, startsAt, endsAt time.Time) Itinerary {
+	it := Itinerary{Destination: destination, StartsAt: startsAt, EndsAt: endsAt}
+	for day := truncateDay(startsAt); !day.After(endsAt); day = day.AddDate(0, 0, 1) {
+		it.Days = append(it.Days, Day{Date: day})
+	}
+	return it
+}
+
+// Add places the item on its day, in time order. Items out of the trip dates
+// get a day of their own, so nothing planned is left out.
+func (it *Itinerary) Add(item Item) {
+	date := truncateDay(item.At)
+	i, found := slices.BinarySearchFunc(it.Days, date, func(d Day, t time.Time) int {
+		return d.Date.Compare(t)
+	})
+	if !found {
+		it.Days = slices.Insert(it.Days, i, Day{Date: date})
+	}
+
+	items := it.Days[i].Items
+	j, _ := slices.BinarySearchFunc(items, item.At, func(x Item, t time.Time) int {
+		if x.At.After(t) {
+			return 1
+		}
+		return -1
+	})
+	it.Days[i].Items = slices.Insert(items, j, item)
+}
+
+func truncateDay(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,144 @@
+package export
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+)
+
+// Change is an update to the itinerary, as told to the people following it.
+type Change struct {
+	ID      uuid.UUID
+	At      time.Time
+	Title   string
+	Summary string
+	URL     string
+}
+
+// ChangeSource is where the changes made to a trip are read from.
+type ChangeSource interface {
+	ListTripFeedEvents(ctx context.Context, arg pgstore.ListTripFeedEventsParams) ([]pgstore.AuditEvent, error)
+}
+
+// Changes returns the latest changes made to the itinerary of the trip,
+// newest first.
+func Changes(ctx context.Context, src ChangeSource, tripID uuid.UUID, max int32) ([]Change, error) {
+	events, err := src.ListTripFeedEvents(ctx, pgstore.ListTripFeedEventsParams{
+		TripID:  tripID,
+		Actions: pgstore.ItineraryActions,
+		Max:     max,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("export: failed to list events for Changes: %w", err)
+	}
+
+	changes := make([]Change, 0, len(events))
+	for _, event := range events {
+		var details pgstore.ItineraryChange
+		if err := json.Unmarshal(event.Details, &details); err != nil {
+			return nil, fmt.Errorf("export: failed to decode event %s for Changes: %w", event.ID, err)
+		}
+
+		change := Change{ID: event.ID, At: event.CreatedAt.Time}
+		switch event.Action {
+		case pgstore.AuditActivityAdded:
+			change.Title = "Nova atividade: " + details.Title
+			if details.OccursAt != nil {
+				at := *details.OccursAt
+				change.Summary = fmt.Sprintf("%s, %s às %s", weekdays[at.Weekday()], at.Format("02/01"), at.Format("15:04"))
+			}
+		case pgstore.AuditActivityRemoved:
+			change.Title = "Atividade removida: " + details.Title
+		case pgstore.AuditLinkAdded:
+			change.Title = "Novo link: " + details.Title
+			change.Summary = details.URL
+			change.URL = details.URL
+		case pgstore.AuditLinkRemoved:
+			change.Title = "Link removido: " + details.Title
+		case pgstore.AuditTripDatesChanged:
+			change.Title = "Datas da viagem alteradas"
+			if details.StartsAt != nil && details.EndsAt != nil {
+				change.Summary = fmt.Sprintf("Agora de %s a %s", details.StartsAt.Format("02/01/2006"), details.EndsAt.Format("02/01/2006"))
+			}
+		default:
+			continue
+		}
+		changes = append(changes, change)
+	}
+
+	return changes, nil
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Links   []atomLink  `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+	Href string `xml:"href,attr"`
+}
+
+type atomEntry struct {
+	ID      string     `xml:"id"`
+	Title   string     `xml:"title"`
+	Updated string     `xml:"updated"`
+	Author  atomAuthor `xml:"author"`
+	Summary string     `xml:"summary,omitempty"`
+	Links   []atomLink `xml:"link"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+// Atom renders the changes as an Atom feed of the itinerary. id identifies
+// the feed, self is where it is read from and link where the itinerary can
+// be seen. The feed is as recent as its newest change, or now when there
+// are none yet.
+func Atom(it Itinerary, id, self, link string, changes []Change, now time.Time) ([]byte, error) {
+	updated := now
+	if len(changes) > 0 {
+		updated = changes[0].At
+	}
+
+	feed := atomFeed{
+		ID:      id,
+		Title:   "Viagem para " + it.Destination,
+		Updated: updated.UTC().Format(time.RFC3339),
+		Links: []atomLink{
+			{Rel: "self", Type: "application/atom+xml", Href: self},
+			{Rel: "alternate", Type: "text/html", Href: link},
+		},
+	}
+	for _, change := range changes {
+		href := link
+		if change.URL != "" {
+			href = change.URL
+		}
+		feed.Entries = append(feed.Entries, atomEntry{
+			ID:      "urn:uuid:" + change.ID.String(),
+			Title:   change.Title,
+			Updated: change.At.UTC().Format(time.RFC3339),
+			Author:  atomAuthor{Name: "Journey"},
+			Summary: change.Summary,
+			Links:   []atomLink{{Rel: "alternate", Href: href}},
+		})
+	}
+
+	b, err := xml.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("export: failed to encode feed for Atom: %w", err)
+	}
+	return append([]byte(xml.Header), b...), nil
+}
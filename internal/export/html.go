@@ -0,0 +1,27 @@
+package export
+
+import (
+	"bytes"
+	"embed"
+	"html/template"
+	"time"
+)
+
+//go:embed templates/print.html
+var templates embed.FS
+
+var printTemplate = template.Must(template.New("print.html").Funcs(template.FuncMap{
+	"date":     func(t time.Time) string { return t.Format("02/01/2006") },
+	"weekday":  func(t time.Time) string { return weekdays[t.Weekday()] },
+	"duration": duration,
+}).ParseFS(templates, "templates/print.html"))
+
+// HTML renders the itinerary as a standalone page styled to be printed, with
+// no scripts or external resources.
+func HTML(it Itinerary) ([]byte, error) {
+	var b bytes.Buffer
+	if err := printTemplate.Execute(&b, it); err != nil {
+		return nil, err
+	}
+	return b.Bytes(), nil
+}
@@ -0,0 +1,31 @@
+package export
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"strings"
+	"time"
+
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/ical"
+)
+
+// ICS renders the itinerary as a calendar with one event per item. Items
+// keep the same UID across exports while their time and title do not
+// change, so importing the calendar again does not duplicate them.
+func ICS(it Itinerary, domain string, now time.Time) string {
+	var events []ical.Event
+	for _, day := range it.Days {
+		for _, item := range day.Items {
+			sum := sha1.Sum([]byte(item.At.Format(time.RFC3339) + item.Title))
+			events = append(events, ical.Event{
+				UID:         hex.EncodeToString(sum[:8]) + "@" + domain,
+				Summary:     item.Title,
+				Description: strings.Join(item.Notes, "\n"),
+				StartsAt:    item.At,
+				EndsAt:      item.At.Add(item.Duration),
+				TimeZone:    it.TimeZone,
+			})
+		}
+	}
+	return ical.Publish(events, now)
+}
@@ -0,0 +1,102 @@
+package export
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// jsonldTime is how times are written in JSON-LD. Trip times are local to
+// the destination, so no offset is given.
+const jsonldTime = "2006-01-02T15:04:05"
+
+type jsonldTrip struct {
+	Context       string         `json:"@context"`
+	Type          string         `json:"@type"`
+	Name          string         `json:"name"`
+	URL           string         `json:"url,omitempty"`
+	DepartureTime string         `json:"departureTime"`
+	ArrivalTime   string         `json:"arrivalTime"`
+	Itinerary     jsonldItemList `json:"itinerary"`
+	SubjectOf     []jsonldWork   `json:"subjectOf,omitempty"`
+}
+
+type jsonldItemList struct {
+	Type            string           `json:"@type"`
+	NumberOfItems   int              `json:"numberOfItems"`
+	ItemListElement []jsonldListItem `json:"itemListElement"`
+}
+
+type jsonldListItem struct {
+	Type     string      `json:"@type"`
+	Position int         `json:"position"`
+	Item     jsonldEvent `json:"item"`
+}
+
+type jsonldEvent struct {
+	Type        string      `json:"@type"`
+	Name        string      `json:"name"`
+	StartDate   string      `json:"startDate"`
+	EndDate     string      `json:"endDate,omitempty"`
+	Description string      `json:"description,omitempty"`
+	Location    jsonldPlace `json:"location"`
+}
+
+type jsonldPlace struct {
+	Type string `json:"@type"`
+	Name string `json:"name"`
+}
+
+type jsonldWork struct {
+	Type string `json:"@type"`
+	Name string `json:"name"`
+	URL  string `json:"url"`
+}
+
+// JSONLD renders the itinerary as schema.org markup: a Trip whose itinerary
+// lists every item as an Event at the destination, and whose links are the
+// works it is the subject of. url is where the itinerary can be seen.
+func JSONLD(it Itinerary, url string) ([]byte, error) {
+	trip := jsonldTrip{
+		Context:       "https://schema.org",
+		Type:          "Trip",
+		Name:          "Viagem para " + it.Destination,
+		URL:           url,
+		DepartureTime: it.StartsAt.Format(jsonldTime),
+		ArrivalTime:   it.EndsAt.Format(jsonldTime),
+		Itinerary:     jsonldItemList{Type: "ItemList", ItemListElement: []jsonldListItem{}},
+	}
+
+	place := jsonldPlace{Type: "Place", Name: it.Destination}
+	for _, day := range it.Days {
+		for _, item := range day.Items {
+			event := jsonldEvent{
+				Type:        "Event",
+				Name:        item.Title,
+				StartDate:   item.At.Format(jsonldTime),
+				Description: strings.Join(item.Notes, "\n"),
+				Location:    place,
+			}
+			if item.Duration > 0 {
+				event.EndDate = item.At.Add(item.Duration).Format(jsonldTime)
+			}
+
+			trip.Itinerary.ItemListElement = append(trip.Itinerary.ItemListElement, jsonldListItem{
+				Type:     "ListItem",
+				Position: len(trip.Itinerary.ItemListElement) + 1,
+				Item:     event,
+			})
+		}
+	}
+	trip.Itinerary.NumberOfItems = len(trip.Itinerary.ItemListElement)
+
+	for _, link := range it.Links {
+		trip.SubjectOf = append(trip.SubjectOf, jsonldWork{Type: "CreativeWork", Name: link.Title, URL: link.URL})
+	}
+
+	b, err := json.Marshal(trip)
+	if err != nil {
+		return nil, fmt.Errorf("export: failed to encode trip for JSONLD: %w", err)
+	}
+	return b, nil
+}
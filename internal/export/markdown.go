@@ -0,0 +1,66 @@
+package export
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+var weekdays = [...]string{"Domingo", "Segunda", "Terça", "Quarta", "Quinta", "Sexta", "Sábado"}
+
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "*", `\*`, "_", `\_`, "`", "\\`", "[", `\[`, "]", `\]`, "#", `\#`, "<", `\<`, ">", `\>`, "|", `\|`,
+)
+
+// Markdown renders the itinerary as a markdown document ready to be pasted
+// on note taking apps or group chats.
+func Markdown(it Itinerary) string {
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "# Viagem para %s\n\n", escape(it.Destination))
+	fmt.Fprintf(&b, "**%s a %s**\n", it.StartsAt.Format("02/01/2006"), it.EndsAt.Format("02/01/2006"))
+
+	for _, day := range it.Days {
+		fmt.Fprintf(&b, "\n## %s, %s\n\n", weekdays[day.Date.Weekday()], day.Date.Format("02/01"))
+		if len(day.Items) == 0 {
+			b.WriteString("_Nada planejado_\n")
+			continue
+		}
+
+		for _, item := range day.Items {
+			fmt.Fprintf(&b, "- **%s** %s", item.At.Format("15:04"), escape(item.Title))
+			if item.Duration > 0 {
+				fmt.Fprintf(&b, " (%s)", duration(item.Duration))
+			}
+			b.WriteString("\n")
+			for _, note := range item.Notes {
+				fmt.Fprintf(&b, "  - %s\n", escape(note))
+			}
+		}
+	}
+
+	if len(it.Links) > 0 {
+		b.WriteString("\n## Links\n\n")
+		for _, link := range it.Links {
+			fmt.Fprintf(&b, "- [%s](<%s>)\n", escape(link.Title), strings.ReplaceAll(link.URL, ">", "%3E"))
+		}
+	}
+
+	return b.String()
+}
+
+func escape(s string) string {
+	return markdownEscaper.Replace(s)
+}
+
+func duration(d time.Duration) string {
+	h, m := int(d.Hours()), int(d.Minutes())%60
+	switch {
+	case h == 0:
+		return fmt.Sprintf("%dmin", m)
+	case m == 0:
+		return fmt.Sprintf("%dh", h)
+	default:
+		return fmt.Sprintf("%dh%02d", h, m)
+	}
+}
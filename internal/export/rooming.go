@@ -0,0 +1,62 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// RoomingList is who sleeps in which room of a lodging, as sent to the place
+// booked.
+type RoomingList struct {
+	CheckIn    time.Time
+	CheckOut   time.Time
+	Rooms      []Room
+	Unassigned []Guest
+}
+
+type Room struct {
+	Name   string
+	Beds   string
+	Guests []Guest
+}
+
+// Guest is a participant or one of their companions, who have no email.
+type Guest struct {
+	Name  string
+	Email string
+}
+
+// RoomingCSV renders the rooming list with one row per guest. Guests without
+// a room are listed last.
+func RoomingCSV(list RoomingList) ([]byte, error) {
+	var b bytes.Buffer
+	w := csv.NewWriter(&b)
+
+	checkIn, checkOut := list.CheckIn.Format("02/01/2006 15:04"), list.CheckOut.Format("02/01/2006 15:04")
+	rows := [][]string{{"Quarto", "Camas", "Hóspede", "E-mail", "Check-in", "Check-out"}}
+	for _, room := range list.Rooms {
+		for _, guest := range room.Guests {
+			rows = append(rows, []string{cell(room.Name), cell(room.Beds), cell(guest.Name), cell(guest.Email), checkIn, checkOut})
+		}
+	}
+	for _, guest := range list.Unassigned {
+		rows = append(rows, []string{"Sem quarto", "", cell(guest.Name), cell(guest.Email), checkIn, checkOut})
+	}
+
+	if err := w.WriteAll(rows); err != nil {
+		return nil, fmt.Errorf("export: failed to write rows for RoomingCSV: %w", err)
+	}
+	return b.Bytes(), nil
+}
+
+// cell keeps what people typed from being taken for a formula by the
+// spreadsheet the list is opened with.
+func cell(s string) string {
+	if s != "" && strings.ContainsRune("=+-@", rune(s[0])) {
+		return "'" + s
+	}
+	return s
+}
@@ -0,0 +1,89 @@
+package export
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/domain"
+)
+
+var transportModes = map[domain.TransportMode]string{
+	domain.TransportFlight: "Voo",
+	domain.TransportTrain:  "Trem",
+	domain.TransportBus:    "Ônibus",
+	domain.TransportCar:    "Carro",
+	domain.TransportBoat:   "Barco",
+}
+
+// Source is where the plans of a trip are read from.
+type Source interface {
+	TripActivities(ctx context.Context, tripID uuid.UUID) ([]domain.Activity, error)
+	TripLodgings(ctx context.Context, tripID uuid.UUID) ([]domain.Lodging, error)
+	TripTransports(ctx context.Context, tripID uuid.UUID) ([]domain.Transport, error)
+	TripLinks(ctx context.Context, tripID uuid.UUID) ([]domain.Link, error)
+}
+
+// Trip gathers everything planned for the trip into an itinerary. Plans
+// still waiting for the owner approval are left out.
+func Trip(ctx context.Context, src Source, trip domain.Trip) (Itinerary, error) {
+	itinerary := New(trip.Destination, trip.StartsAt, trip.EndsAt)
+	itinerary.TimeZone = trip.TimeZone
+
+	acts, err := src.TripActivities(ctx, trip.ID)
+	if err != nil {
+		return Itinerary{}, fmt.Errorf("export: failed to get activities for Trip: %w", err)
+	}
+	for _, act := range acts {
+		if act.Status != domain.PlanApproved {
+			continue
+		}
+		itinerary.Add(Item{
+			At:       act.OccursAt,
+			Duration: act.Duration,
+			Title:    act.Title,
+			Notes:    act.Tags,
+		})
+	}
+
+	lodgings, err := src.TripLodgings(ctx, trip.ID)
+	if err != nil {
+		return Itinerary{}, fmt.Errorf("export: failed to get lodgings for Trip: %w", err)
+	}
+	for _, lodging := range lodgings {
+		if lodging.Status != domain.PlanApproved {
+			continue
+		}
+		itinerary.Add(Item{
+			At:    lodging.CheckIn,
+			Title: "Check-in: " + lodging.Name,
+			Notes: []string{lodging.Address},
+		})
+		itinerary.Add(Item{
+			At:    lodging.CheckOut,
+			Title: "Check-out: " + lodging.Name,
+		})
+	}
+
+	transports, err := src.TripTransports(ctx, trip.ID)
+	if err != nil {
+		return Itinerary{}, fmt.Errorf("export: failed to get transports for Trip: %w", err)
+	}
+	for _, transport := range transports {
+		itinerary.Add(Item{
+			At:    transport.DepartsAt,
+			Title: fmt.Sprintf("%s: %s → %s", transportModes[transport.Mode], transport.Origin, transport.Destination),
+			Notes: []string{"Chegada em " + transport.ArrivesAt.Format("02/01 15:04")},
+		})
+	}
+
+	links, err := src.TripLinks(ctx, trip.ID)
+	if err != nil {
+		return Itinerary{}, fmt.Errorf("export: failed to get links for Trip: %w", err)
+	}
+	for _, link := range links {
+		itinerary.Links = append(itinerary.Links, Link{Title: link.Title, URL: link.URL})
+	}
+
+	return itinerary, nil
+}
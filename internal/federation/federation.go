@@ -0,0 +1,310 @@
+package federation
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+)
+
+var (
+	ErrDisabled     = errors.New("federation: no instance key configured")
+	ErrInvalidKey   = errors.New("federation: instance keys must be ed25519")
+	ErrVersion      = errors.New("federation: unsupported bundle version")
+	ErrUntrusted    = errors.New("federation: bundle signed by an untrusted instance")
+	ErrBadSignature = errors.New("federation: bundle signature does not match")
+	ErrInconsistent = errors.New("federation: bundle refers to records it does not hold")
+)
+
+// Version is the version of the bundles made. It changes when the snapshot
+// they carry does in ways older instances can not read.
+const Version = 1
+
+// Manifest describes a bundle: which trip, from which instance and when. It
+// is what gets signed, holding the digest of the payload.
+type Manifest struct {
+	Version    int       `json:"version"`
+	Instance   string    `json:"instance"`
+	PublicKey  string    `json:"public_key"`
+	TripID     uuid.UUID `json:"trip_id"`
+	ExportedAt time.Time `json:"exported_at"`
+	SHA256     string    `json:"sha256"`
+}
+
+// Bundle is a trip exported from an instance, to be imported in another.
+type Bundle struct {
+	Manifest  Manifest        `json:"manifest"`
+	Payload   json.RawMessage `json:"payload"`
+	Signature string          `json:"signature"`
+}
+
+// Instance signs the bundles exported from this instance and verifies those
+// imported. The zero Instance signs nothing and trusts no one.
+type Instance struct {
+	name    string
+	key     ed25519.PrivateKey
+	trusted []ed25519.PublicKey
+}
+
+// NewInstance parses the PEM encoded ed25519 private key of the instance,
+// and the base64 public keys of the instances bundles are imported from.
+// Bundles from the instance itself are always trusted.
+func NewInstance(name string, pemKey []byte, trusted []string) (Instance, error) {
+	block, _ := pem.Decode(pemKey)
+	if block == nil || block.Type != "PRIVATE KEY" {
+		return Instance{}, ErrInvalidKey
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return Instance{}, fmt.Errorf("federation: failed to parse private key: %w", err)
+	}
+	key, ok := parsed.(ed25519.PrivateKey)
+	if !ok {
+		return Instance{}, ErrInvalidKey
+	}
+
+	i := Instance{name: name, key: key}
+	i.trusted = append(i.trusted, key.Public().(ed25519.PublicKey))
+	for _, encoded := range trusted {
+		if encoded = strings.TrimSpace(encoded); encoded == "" {
+			continue
+		}
+		public, err := base64.StdEncoding.DecodeString(encoded)
+		if err != nil || len(public) != ed25519.PublicKeySize {
+			return Instance{}, fmt.Errorf("federation: invalid trusted key %q", encoded)
+		}
+		i.trusted = append(i.trusted, ed25519.PublicKey(public))
+	}
+
+	return i, nil
+}
+
+// Name is how the instance calls itself in the bundles it signs.
+func (i Instance) Name() string {
+	return i.name
+}
+
+// PublicKey is the key other instances trust to import bundles from this one,
+// empty when it has no key.
+func (i Instance) PublicKey() string {
+	if i.key == nil {
+		return ""
+	}
+	return base64.StdEncoding.EncodeToString(i.key.Public().(ed25519.PublicKey))
+}
+
+// Sign bundles the snapshot of a trip, signed with the instance key.
+func (i Instance) Sign(snapshot pgstore.TripSnapshot, now time.Time) (Bundle, error) {
+	if i.key == nil {
+		return Bundle{}, ErrDisabled
+	}
+
+	payload, err := json.Marshal(snapshot)
+	if err != nil {
+		return Bundle{}, fmt.Errorf("federation: failed to encode snapshot for Sign: %w", err)
+	}
+
+	digest := sha256.Sum256(payload)
+	manifest := Manifest{
+		Version:    Version,
+		Instance:   i.name,
+		PublicKey:  i.PublicKey(),
+		TripID:     snapshot.Trip.ID,
+		ExportedAt: now.UTC(),
+		SHA256:     hex.EncodeToString(digest[:]),
+	}
+
+	signed, err := json.Marshal(manifest)
+	if err != nil {
+		return Bundle{}, fmt.Errorf("federation: failed to encode manifest for Sign: %w", err)
+	}
+
+	return Bundle{
+		Manifest:  manifest,
+		Payload:   payload,
+		Signature: base64.StdEncoding.EncodeToString(ed25519.Sign(i.key, signed)),
+	}, nil
+}
+
+// Verify checks the bundle was signed by a trusted instance and not changed
+// since, returning the snapshot it carries.
+func (i Instance) Verify(b Bundle) (pgstore.TripSnapshot, error) {
+	if b.Manifest.Version != Version {
+		return pgstore.TripSnapshot{}, ErrVersion
+	}
+
+	public, err := base64.StdEncoding.DecodeString(b.Manifest.PublicKey)
+	if err != nil || !i.trusts(public) {
+		return pgstore.TripSnapshot{}, ErrUntrusted
+	}
+
+	signature, err := base64.StdEncoding.DecodeString(b.Signature)
+	if err != nil {
+		return pgstore.TripSnapshot{}, ErrBadSignature
+	}
+
+	signed, err := json.Marshal(b.Manifest)
+	if err != nil {
+		return pgstore.TripSnapshot{}, fmt.Errorf("federation: failed to encode manifest for Verify: %w", err)
+	}
+	if !ed25519.Verify(public, signed, signature) {
+		return pgstore.TripSnapshot{}, ErrBadSignature
+	}
+
+	// Bundles may have been indented on their way, which leaves what they
+	// hold as it was.
+	var payload bytes.Buffer
+	if err := json.Compact(&payload, b.Payload); err != nil {
+		return pgstore.TripSnapshot{}, fmt.Errorf("federation: failed to read payload for Verify: %w", err)
+	}
+	digest := sha256.Sum256(payload.Bytes())
+	if hex.EncodeToString(digest[:]) != b.Manifest.SHA256 {
+		return pgstore.TripSnapshot{}, ErrBadSignature
+	}
+
+	var snapshot pgstore.TripSnapshot
+	if err := json.Unmarshal(b.Payload, &snapshot); err != nil {
+		return pgstore.TripSnapshot{}, fmt.Errorf("federation: failed to decode snapshot for Verify: %w", err)
+	}
+
+	return snapshot, nil
+}
+
+func (i Instance) trusts(public []byte) bool {
+	for _, key := range i.trusted {
+		if key.Equal(ed25519.PublicKey(public)) {
+			return true
+		}
+	}
+	return false
+}
+
+// Remap gives every record of the snapshot a new id, so it can be imported
+// next to the trip it was taken from, and points every reference between
+// them to the new ids, the ones in audit event details included. It returns
+// the new id of each of the old ones.
+func Remap(s pgstore.TripSnapshot) (pgstore.TripSnapshot, map[uuid.UUID]uuid.UUID, error) {
+	ids := make(map[uuid.UUID]uuid.UUID)
+	renew := func(id uuid.UUID) uuid.UUID {
+		ids[id] = uuid.New()
+		return ids[id]
+	}
+
+	tripID := renew(s.Trip.ID)
+	s.Trip.ID = tripID
+
+	s.Participants = slices.Clone(s.Participants)
+	for i := range s.Participants {
+		s.Participants[i].ID = renew(s.Participants[i].ID)
+		s.Participants[i].TripID = tripID
+		// Groups are not carried.
+		s.Participants[i].GroupID = pgtype.UUID{}
+	}
+
+	s.Activities = slices.Clone(s.Activities)
+	for i := range s.Activities {
+		s.Activities[i].ID = renew(s.Activities[i].ID)
+		s.Activities[i].TripID = tripID
+	}
+	s.Links = slices.Clone(s.Links)
+	for i := range s.Links {
+		s.Links[i].ID = renew(s.Links[i].ID)
+		s.Links[i].TripID = tripID
+	}
+	s.ChecklistItems = slices.Clone(s.ChecklistItems)
+	for i := range s.ChecklistItems {
+		s.ChecklistItems[i].ID = renew(s.ChecklistItems[i].ID)
+		s.ChecklistItems[i].TripID = tripID
+	}
+	s.Lodgings = slices.Clone(s.Lodgings)
+	for i := range s.Lodgings {
+		s.Lodgings[i].ID = renew(s.Lodgings[i].ID)
+		s.Lodgings[i].TripID = tripID
+	}
+	s.Transports = slices.Clone(s.Transports)
+	for i := range s.Transports {
+		s.Transports[i].ID = renew(s.Transports[i].ID)
+		s.Transports[i].TripID = tripID
+	}
+	s.Expenses = slices.Clone(s.Expenses)
+	for i := range s.Expenses {
+		s.Expenses[i].ID = renew(s.Expenses[i].ID)
+		s.Expenses[i].TripID = tripID
+	}
+	s.Tasks = slices.Clone(s.Tasks)
+	for i := range s.Tasks {
+		s.Tasks[i].ID = renew(s.Tasks[i].ID)
+		s.Tasks[i].TripID = tripID
+	}
+	s.AuditEvents = slices.Clone(s.AuditEvents)
+	for i := range s.AuditEvents {
+		s.AuditEvents[i].ID = renew(s.AuditEvents[i].ID)
+		s.AuditEvents[i].TripID = tripID
+	}
+	s.Attachments = slices.Clone(s.Attachments)
+	for i := range s.Attachments {
+		s.Attachments[i].ID = renew(s.Attachments[i].ID)
+		s.Attachments[i].TripID = tripID
+	}
+
+	// Only participants are referred to by id, besides the trip, and the
+	// expenses by their splits.
+	var ok bool
+	for i, e := range s.Expenses {
+		if s.Expenses[i].PaidBy, ok = ids[e.PaidBy]; !ok {
+			return pgstore.TripSnapshot{}, nil, ErrInconsistent
+		}
+	}
+	s.ExpenseSplits = slices.Clone(s.ExpenseSplits)
+	for i, split := range s.ExpenseSplits {
+		if s.ExpenseSplits[i].ExpenseID, ok = ids[split.ExpenseID]; !ok {
+			return pgstore.TripSnapshot{}, nil, ErrInconsistent
+		}
+		if s.ExpenseSplits[i].ParticipantID, ok = ids[split.ParticipantID]; !ok {
+			return pgstore.TripSnapshot{}, nil, ErrInconsistent
+		}
+	}
+	for i, a := range s.Activities {
+		s.Activities[i].OrganizerID = remapOptional(ids, a.OrganizerID)
+	}
+	for i, t := range s.Tasks {
+		s.Tasks[i].AssigneeID = remapOptional(ids, t.AssigneeID)
+	}
+	for i, a := range s.Attachments {
+		s.Attachments[i].UploadedBy = remapOptional(ids, a.UploadedBy)
+	}
+
+	for i, e := range s.AuditEvents {
+		details := e.Details
+		for old, id := range ids {
+			details = bytes.ReplaceAll(details, []byte(old.String()), []byte(id.String()))
+		}
+		s.AuditEvents[i].Details = details
+	}
+
+	return s, ids, nil
+}
+
+// remapOptional points an optional reference to its new id, dropping it when
+// the record it refers to was not carried.
+func remapOptional(ids map[uuid.UUID]uuid.UUID, ref pgtype.UUID) pgtype.UUID {
+	if !ref.Valid {
+		return ref
+	}
+	id, ok := ids[ref.Bytes]
+	return pgtype.UUID{Valid: ok, Bytes: id}
+}
@@ -0,0 +1,67 @@
+package federation
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+)
+
+// Source is where the records of a trip are read from.
+type Source interface {
+	GetParticipants(ctx context.Context, tripID uuid.UUID) ([]pgstore.Participant, error)
+	GetTripActivities(ctx context.Context, tripID uuid.UUID) ([]pgstore.Activity, error)
+	GetTripLinks(ctx context.Context, tripID uuid.UUID) ([]pgstore.Link, error)
+	GetTripChecklistItems(ctx context.Context, tripID uuid.UUID) ([]pgstore.ChecklistItem, error)
+	GetTripLodgings(ctx context.Context, tripID uuid.UUID) ([]pgstore.Lodging, error)
+	GetTripTransports(ctx context.Context, tripID uuid.UUID) ([]pgstore.Transport, error)
+	ListTripExpenses(ctx context.Context, arg pgstore.ListTripExpensesParams) ([]pgstore.Expense, error)
+	GetTripExpenseSplits(ctx context.Context, tripID uuid.UUID) ([]pgstore.GetTripExpenseSplitsRow, error)
+	GetTripTasks(ctx context.Context, tripID uuid.UUID) ([]pgstore.Task, error)
+	GetTripAuditEvents(ctx context.Context, tripID uuid.UUID) ([]pgstore.AuditEvent, error)
+	GetTripAttachments(ctx context.Context, tripID uuid.UUID) ([]pgstore.Attachment, error)
+}
+
+// Snapshot reads everything the trip holds that is carried to other
+// instances.
+func Snapshot(ctx context.Context, src Source, trip pgstore.Trip) (pgstore.TripSnapshot, error) {
+	s := pgstore.TripSnapshot{Trip: trip}
+
+	var err error
+	if s.Participants, err = src.GetParticipants(ctx, trip.ID); err != nil {
+		return pgstore.TripSnapshot{}, fmt.Errorf("federation: failed to get participants for Snapshot: %w", err)
+	}
+	if s.Activities, err = src.GetTripActivities(ctx, trip.ID); err != nil {
+		return pgstore.TripSnapshot{}, fmt.Errorf("federation: failed to get activities for Snapshot: %w", err)
+	}
+	if s.Links, err = src.GetTripLinks(ctx, trip.ID); err != nil {
+		return pgstore.TripSnapshot{}, fmt.Errorf("federation: failed to get links for Snapshot: %w", err)
+	}
+	if s.ChecklistItems, err = src.GetTripChecklistItems(ctx, trip.ID); err != nil {
+		return pgstore.TripSnapshot{}, fmt.Errorf("federation: failed to get checklist items for Snapshot: %w", err)
+	}
+	if s.Lodgings, err = src.GetTripLodgings(ctx, trip.ID); err != nil {
+		return pgstore.TripSnapshot{}, fmt.Errorf("federation: failed to get lodgings for Snapshot: %w", err)
+	}
+	if s.Transports, err = src.GetTripTransports(ctx, trip.ID); err != nil {
+		return pgstore.TripSnapshot{}, fmt.Errorf("federation: failed to get transports for Snapshot: %w", err)
+	}
+	if s.Expenses, err = src.ListTripExpenses(ctx, pgstore.ListTripExpensesParams{TripID: trip.ID}); err != nil {
+		return pgstore.TripSnapshot{}, fmt.Errorf("federation: failed to get expenses for Snapshot: %w", err)
+	}
+	if s.ExpenseSplits, err = src.GetTripExpenseSplits(ctx, trip.ID); err != nil {
+		return pgstore.TripSnapshot{}, fmt.Errorf("federation: failed to get expense splits for Snapshot: %w", err)
+	}
+	if s.Tasks, err = src.GetTripTasks(ctx, trip.ID); err != nil {
+		return pgstore.TripSnapshot{}, fmt.Errorf("federation: failed to get tasks for Snapshot: %w", err)
+	}
+	if s.AuditEvents, err = src.GetTripAuditEvents(ctx, trip.ID); err != nil {
+		return pgstore.TripSnapshot{}, fmt.Errorf("federation: failed to get audit events for Snapshot: %w", err)
+	}
+	if s.Attachments, err = src.GetTripAttachments(ctx, trip.ID); err != nil {
+		return pgstore.TripSnapshot{}, fmt.Errorf("federation: failed to get attachments for Snapshot: %w", err)
+	}
+
+	return s, nil
+}
@@ -0,0 +1,99 @@
+// Package fieldset implements sparse fieldsets: clients list the fields of a
+// resource they render and responses carry only those, which keeps payloads
+// small on slow networks.
+package fieldset
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// idField is sent whatever the fields asked for, so clients can always tell
+// resources apart.
+const idField = "id"
+
+// Fieldset is the set of fields asked for. A nil Fieldset asks for all of
+// them.
+type Fieldset map[string]bool
+
+// Parse reads a comma separated list of fields, checking each one is a JSON
+// field of resource, a struct value. An empty list asks for all the fields.
+func Parse(fields string, resource any) (Fieldset, error) {
+	known := jsonFields(reflect.TypeOf(resource))
+
+	var fs Fieldset
+	for _, field := range strings.Split(fields, ",") {
+		field = strings.TrimSpace(field)
+		if field == "" {
+			continue
+		}
+		if !known[field] {
+			return nil, fmt.Errorf("unknown field %q", field)
+		}
+		if fs == nil {
+			fs = Fieldset{idField: true}
+		}
+		fs[field] = true
+	}
+
+	return fs, nil
+}
+
+// Apply marshals v, keeping only the fields in the fieldset of the resources
+// found at path. Each path element is an object key; arrays along the way
+// have every element visited, so the path to a list of resources ends at the
+// list itself.
+func (fs Fieldset) Apply(v any, path ...string) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	if fs == nil {
+		return data, nil
+	}
+
+	// Numbers are kept as they were written, so large ones keep precision.
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+
+	var doc any
+	if err := dec.Decode(&doc); err != nil {
+		return nil, err
+	}
+	fs.filter(doc, path)
+
+	return json.Marshal(doc)
+}
+
+func (fs Fieldset) filter(value any, path []string) {
+	switch value := value.(type) {
+	case []any:
+		for _, element := range value {
+			fs.filter(element, path)
+		}
+	case map[string]any:
+		if len(path) > 0 {
+			fs.filter(value[path[0]], path[1:])
+			return
+		}
+		for field := range value {
+			if !fs[field] {
+				delete(value, field)
+			}
+		}
+	}
+}
+
+func jsonFields(t reflect.Type) map[string]bool {
+	fields := make(map[string]bool, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		name, _, _ := strings.Cut(t.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			fields[name] = true
+		}
+	}
+	return fields
+}
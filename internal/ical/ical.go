@@ -0,0 +1,223 @@
+package ical
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Event is a calendar event sent as an invitation. Sending an event again
+// with the same UID and a higher Sequence updates it in the attendees'
+// calendars instead of creating a new one.
+type Event struct {
+	UID         string
+	Sequence    int
+	Summary     string
+	Description string
+	StartsAt    time.Time
+	EndsAt      time.Time
+	Organizer   Attendee
+	Attendees   []Attendee
+
+	// TimeZone is where the trip happens. StartsAt and EndsAt are read as
+	// wall clock times there and sent with its TZID, so calendars in other
+	// zones show them at the right moment. Without one, or in UTC, the zone
+	// trips have until it is set, times are floating.
+	TimeZone *time.Location
+}
+
+type Attendee struct {
+	Name  string
+	Email string
+}
+
+// floatingLayout formats times without a zone: floating, shown at the same
+// wall clock time wherever the attendee is, unless a TZID says where.
+const floatingLayout = "20060102T150405"
+
+var textEscaper = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`)
+
+// Request renders the event as an iCalendar REQUEST, the method mail clients
+// answer with an "Add to calendar" prompt.
+func Request(e Event, now time.Time) string {
+	return calendar("REQUEST", []Event{e}, now)
+}
+
+// Publish renders the events as an iCalendar PUBLISH, a calendar to be
+// imported as is, with nobody to answer to.
+func Publish(events []Event, now time.Time) string {
+	return calendar("PUBLISH", events, now)
+}
+
+func calendar(method string, events []Event, now time.Time) string {
+	lines := []string{
+		"BEGIN:VCALENDAR",
+		"VERSION:2.0",
+		"PRODID:-//journey//trips//PT",
+		"CALSCALE:GREGORIAN",
+		"METHOD:" + method,
+	}
+	lines = append(lines, timezones(events)...)
+	for _, e := range events {
+		lines = append(lines,
+			"BEGIN:VEVENT",
+			"UID:"+e.UID,
+			fmt.Sprintf("SEQUENCE:%d", e.Sequence),
+			"DTSTAMP:"+now.UTC().Format(floatingLayout)+"Z",
+			"DTSTART"+tzid(e)+":"+e.StartsAt.Format(floatingLayout),
+			"DTEND"+tzid(e)+":"+e.EndsAt.Format(floatingLayout),
+			"SUMMARY:"+textEscaper.Replace(e.Summary),
+		)
+		if e.Description != "" {
+			lines = append(lines, "DESCRIPTION:"+textEscaper.Replace(e.Description))
+		}
+		if e.Organizer.Email != "" {
+			lines = append(lines, "ORGANIZER"+cn(e.Organizer.Name)+":mailto:"+e.Organizer.Email)
+		}
+		for _, a := range e.Attendees {
+			lines = append(lines, "ATTENDEE"+cn(a.Name)+";ROLE=REQ-PARTICIPANT;PARTSTAT=NEEDS-ACTION;RSVP=TRUE:mailto:"+a.Email)
+		}
+		lines = append(lines,
+			"STATUS:CONFIRMED",
+			"END:VEVENT",
+		)
+	}
+	lines = append(lines, "END:VCALENDAR")
+
+	var b strings.Builder
+	for _, line := range lines {
+		b.WriteString(fold(line))
+		b.WriteString("\r\n")
+	}
+	return b.String()
+}
+
+// zoned reports whether the times of the event are sent with a TZID.
+func zoned(e Event) bool {
+	return e.TimeZone != nil && e.TimeZone != time.UTC
+}
+
+func tzid(e Event) string {
+	if !zoned(e) {
+		return ""
+	}
+	return ";TZID=" + e.TimeZone.String()
+}
+
+// timezones renders a VTIMEZONE for each zone the events are in, covering
+// the time from their first start to their last end.
+func timezones(events []Event) []string {
+	type span struct {
+		loc      *time.Location
+		from, to time.Time
+	}
+
+	var spans []*span
+	byName := make(map[string]*span)
+	for _, e := range events {
+		if !zoned(e) {
+			continue
+		}
+		from, to := inZone(e.StartsAt, e.TimeZone), inZone(e.EndsAt, e.TimeZone)
+		s, ok := byName[e.TimeZone.String()]
+		if !ok {
+			s = &span{loc: e.TimeZone, from: from, to: to}
+			byName[e.TimeZone.String()] = s
+			spans = append(spans, s)
+		}
+		if from.Before(s.from) {
+			s.from = from
+		}
+		if to.After(s.to) {
+			s.to = to
+		}
+	}
+
+	var lines []string
+	for _, s := range spans {
+		lines = append(lines, vtimezone(s.loc, s.from, s.to)...)
+	}
+	return lines
+}
+
+// vtimezone renders loc from the offset in effect at from to the one in
+// effect at to, one observance per offset, each with the moment it began.
+func vtimezone(loc *time.Location, from, to time.Time) []string {
+	lines := []string{"BEGIN:VTIMEZONE", "TZID:" + loc.String()}
+	for t := from; ; {
+		name, offset := t.Zone()
+		start, end := t.ZoneBounds()
+
+		// Observances begin at the wall clock time of the offset before
+		// them. The first offset of a zone was always in effect.
+		onset, offsetFrom := "19700101T000000", offset
+		if !start.IsZero() {
+			_, offsetFrom = start.Add(-time.Second).Zone()
+			onset = start.In(time.FixedZone("", offsetFrom)).Format(floatingLayout)
+		}
+
+		kind := "STANDARD"
+		if t.IsDST() {
+			kind = "DAYLIGHT"
+		}
+		lines = append(lines,
+			"BEGIN:"+kind,
+			"DTSTART:"+onset,
+			"TZOFFSETFROM:"+utcOffset(offsetFrom),
+			"TZOFFSETTO:"+utcOffset(offset),
+			"TZNAME:"+name,
+			"END:"+kind,
+		)
+
+		if end.IsZero() || end.After(to) {
+			break
+		}
+		t = end
+	}
+	return append(lines, "END:VTIMEZONE")
+}
+
+// inZone is the moment the wall clock time t happens in loc.
+func inZone(t time.Time, loc *time.Location) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0, loc)
+}
+
+// utcOffset formats an offset in seconds as in -0300, with seconds only
+// when there are some.
+func utcOffset(offset int) string {
+	sign := "+"
+	if offset < 0 {
+		sign, offset = "-", -offset
+	}
+	s := fmt.Sprintf("%s%02d%02d", sign, offset/3600, offset/60%60)
+	if offset%60 != 0 {
+		s += fmt.Sprintf("%02d", offset%60)
+	}
+	return s
+}
+
+func cn(name string) string {
+	if name == "" {
+		return ""
+	}
+	return `;CN="` + strings.NewReplacer(`"`, "", "\n", " ").Replace(name) + `"`
+}
+
+// fold splits lines longer than 75 octets, as required by RFC 5545, without
+// breaking multi-byte characters.
+func fold(line string) string {
+	const limit = 75
+
+	var b strings.Builder
+	width := 0
+	for _, r := range line {
+		size := len(string(r))
+		if width+size > limit {
+			b.WriteString("\r\n ")
+			width = 1
+		}
+		b.WriteRune(r)
+		width += size
+	}
+	return b.String()
+}
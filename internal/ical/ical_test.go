@@ -0,0 +1,59 @@
+package ical
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+// TestPublishAcrossDST compares the calendars of events spanning a change of
+// offset with the golden files in testdata, VTIMEZONE included.
+func TestPublishAcrossDST(t *testing.T) {
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		zone     string
+		startsAt time.Time
+		endsAt   time.Time
+	}{
+		{"new_york_spring_forward", "America/New_York", time.Date(2024, 3, 9, 20, 0, 0, 0, time.UTC), time.Date(2024, 3, 10, 10, 0, 0, 0, time.UTC)},
+		{"new_york_fall_back", "America/New_York", time.Date(2024, 11, 2, 20, 0, 0, 0, time.UTC), time.Date(2024, 11, 3, 10, 0, 0, 0, time.UTC)},
+		{"lord_howe_spring_forward", "Australia/Lord_Howe", time.Date(2024, 10, 5, 20, 0, 0, 0, time.UTC), time.Date(2024, 10, 6, 10, 0, 0, 0, time.UTC)},
+		{"lord_howe_fall_back", "Australia/Lord_Howe", time.Date(2024, 4, 6, 20, 0, 0, 0, time.UTC), time.Date(2024, 4, 7, 10, 0, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			loc, err := time.LoadLocation(tt.zone)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			got := Publish([]Event{{
+				UID:      tt.name + "@journey",
+				Summary:  "Passeio noturno",
+				StartsAt: tt.startsAt,
+				EndsAt:   tt.endsAt,
+				TimeZone: loc,
+			}}, now)
+
+			golden := filepath.Join("testdata", tt.name+".ics")
+			if *update {
+				if err := os.WriteFile(golden, []byte(got), 0o644); err != nil {
+					t.Fatal(err)
+				}
+			}
+			want, err := os.ReadFile(golden)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != string(want) {
+				t.Errorf("calendar differs from %s:\n%s", golden, got)
+			}
+		})
+	}
+}
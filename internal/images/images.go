@@ -0,0 +1,180 @@
+package images
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"hash/crc32"
+)
+
+var ErrInvalidExif = errors.New("images: invalid exif data")
+
+// Sniff returns the content type of a file from its first bytes, or an empty
+// string when it is none of the types attachments can have.
+func Sniff(head []byte) string {
+	switch {
+	case bytes.HasPrefix(head, []byte{0xFF, 0xD8, 0xFF}):
+		return "image/jpeg"
+	case bytes.HasPrefix(head, []byte("\x89PNG\r\n\x1a\n")):
+		return "image/png"
+	case bytes.HasPrefix(head, []byte("%PDF-")):
+		return "application/pdf"
+	case len(head) >= 12 && string(head[4:8]) == "ftyp" && heicBrands[string(head[8:12])]:
+		return "image/heic"
+	}
+	return ""
+}
+
+var heicBrands = map[string]bool{
+	"heic": true, "heix": true, "hevc": true, "hevx": true,
+	"heim": true, "heis": true, "mif1": true, "msf1": true,
+}
+
+// StripGPS removes the GPS data from the EXIF metadata of a JPEG or PNG
+// image, telling whether there was any. The image is changed in place: the
+// GPS entries are zeroed, so nothing else in the file moves. Other images are
+// left untouched.
+func StripGPS(image []byte) (bool, error) {
+	switch Sniff(image) {
+	case "image/jpeg":
+		return stripJPEG(image)
+	case "image/png":
+		return stripPNG(image)
+	}
+	return false, nil
+}
+
+// stripJPEG looks for the EXIF data in the APP1 segments before the image
+// data starts.
+func stripJPEG(image []byte) (bool, error) {
+	stripped := false
+	pos := 2
+	for pos+4 <= len(image) {
+		if image[pos] != 0xFF {
+			return stripped, ErrInvalidExif
+		}
+		marker := image[pos+1]
+		if marker == 0xDA || marker == 0xD9 {
+			break
+		}
+
+		end := pos + 2 + int(binary.BigEndian.Uint16(image[pos+2:]))
+		if end > len(image) {
+			return stripped, ErrInvalidExif
+		}
+
+		segment := image[pos+4 : end]
+		if marker == 0xE1 && bytes.HasPrefix(segment, []byte("Exif\x00\x00")) {
+			found, err := stripTIFF(segment[6:])
+			if err != nil {
+				return stripped, err
+			}
+			stripped = stripped || found
+		}
+		pos = end
+	}
+	return stripped, nil
+}
+
+// stripPNG looks for the EXIF data in the eXIf chunk, updating its checksum.
+func stripPNG(image []byte) (bool, error) {
+	pos := 8
+	for pos+12 <= len(image) {
+		length := int(binary.BigEndian.Uint32(image[pos:]))
+		kind := string(image[pos+4 : pos+8])
+		end := pos + 12 + length
+		if length < 0 || end > len(image) {
+			return false, ErrInvalidExif
+		}
+
+		if kind == "eXIf" {
+			found, err := stripTIFF(image[pos+8 : pos+8+length])
+			if err != nil || !found {
+				return false, err
+			}
+			binary.BigEndian.PutUint32(image[pos+8+length:], crc32.ChecksumIEEE(image[pos+4:pos+8+length]))
+			return true, nil
+		}
+		if kind == "IEND" {
+			break
+		}
+		pos = end
+	}
+	return false, nil
+}
+
+// tiffTypeSizes is the size of one value of each TIFF field type.
+var tiffTypeSizes = map[uint16]int{
+	1: 1, 2: 1, 3: 2, 4: 4, 5: 8, 6: 1, 7: 1, 8: 2, 9: 4, 10: 8, 11: 4, 12: 8,
+}
+
+const gpsIFDTag = 0x8825
+
+// stripTIFF empties the GPS IFD of the TIFF structure EXIF data is kept in,
+// zeroing its entries and the values they point to.
+func stripTIFF(tiff []byte) (bool, error) {
+	if len(tiff) < 8 {
+		return false, ErrInvalidExif
+	}
+
+	var order binary.ByteOrder
+	switch string(tiff[:2]) {
+	case "II":
+		order = binary.LittleEndian
+	case "MM":
+		order = binary.BigEndian
+	default:
+		return false, ErrInvalidExif
+	}
+
+	entries := func(offset int) (int, error) {
+		if offset < 8 || offset+2 > len(tiff) {
+			return 0, ErrInvalidExif
+		}
+		n := int(order.Uint16(tiff[offset:]))
+		if offset+2+12*n+4 > len(tiff) {
+			return 0, ErrInvalidExif
+		}
+		return n, nil
+	}
+
+	ifd0 := int(order.Uint32(tiff[4:]))
+	n, err := entries(ifd0)
+	if err != nil {
+		return false, err
+	}
+
+	gps := -1
+	for i := 0; i < n; i++ {
+		entry := tiff[ifd0+2+12*i:]
+		if order.Uint16(entry) == gpsIFDTag {
+			gps = int(order.Uint32(entry[8:]))
+			break
+		}
+	}
+	if gps < 0 {
+		return false, nil
+	}
+
+	n, err = entries(gps)
+	if err != nil || n == 0 {
+		return false, err
+	}
+
+	for i := 0; i < n; i++ {
+		entry := tiff[gps+2+12*i:]
+		size := tiffTypeSizes[order.Uint16(entry[2:])] * int(order.Uint32(entry[4:]))
+		if size <= 4 {
+			continue
+		}
+		offset := int(order.Uint32(entry[8:]))
+		if offset < 0 || size < 0 || offset+size > len(tiff) {
+			return false, ErrInvalidExif
+		}
+		clear(tiff[offset : offset+size])
+	}
+
+	// An empty IFD: no entries, and no next IFD right after.
+	clear(tiff[gps : gps+2+12*n+4])
+	return true, nil
+}
@@ -0,0 +1,100 @@
+// Package lifecycle moves trips through their statuses, by the owners or as
+// their dates come, and sets off what each move calls for: the audit trail,
+// the usage counters and the emails to the people on the trip.
+package lifecycle
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/analytics"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/domain"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+// ErrConflict is returned when the trip was no longer in the status it was
+// moved from, as another request moved it first.
+var ErrConflict = errors.New("lifecycle: trip status changed meanwhile")
+
+type store interface {
+	TransitionTrip(ctx context.Context, arg pgstore.TransitionTripParams) (int64, error)
+	InsertAuditEvent(ctx context.Context, arg pgstore.InsertAuditEventParams) error
+}
+
+type mailer interface {
+	SendEmailInvitations(tripID uuid.UUID) error
+	SendTripCancelled(tripID uuid.UUID) error
+}
+
+// Lifecycle moves trips between statuses.
+type Lifecycle struct {
+	store  store
+	mailer mailer
+	events analytics.Sink
+	logger *zap.Logger
+}
+
+func New(store store, mailer mailer, events analytics.Sink, logger *zap.Logger) Lifecycle {
+	return Lifecycle{store: store, mailer: mailer, events: events, logger: logger}
+}
+
+// Transition moves a trip from the status it is in to another, failing with
+// domain.ErrInvalidTransition when it may not and with ErrConflict when it
+// was moved meanwhile. Once moved, the transition is recorded from remoteAddr,
+// empty when the dates moved it, and the emails go out in the background:
+// their failures are logged, the trip stays moved.
+func (l Lifecycle) Transition(ctx context.Context, tripID uuid.UUID, from, to domain.TripStatus, remoteAddr string) error {
+	if err := from.CheckTransition(to); err != nil {
+		return err
+	}
+
+	n, err := l.store.TransitionTrip(ctx, pgstore.TransitionTripParams{ToStatus: string(to), ID: tripID, FromStatus: string(from)})
+	if err != nil {
+		return fmt.Errorf("lifecycle: failed to update trip status for Transition: %w", err)
+	}
+	if n == 0 {
+		return ErrConflict
+	}
+
+	l.record(ctx, tripID, from, to, remoteAddr)
+
+	switch to {
+	case domain.TripConfirmed:
+		l.events.Count(analytics.TripConfirmed, 1)
+		l.send(tripID, "SendEmailInvitations", l.mailer.SendEmailInvitations)
+	case domain.TripCancelled:
+		l.events.Count(analytics.TripCancelled, 1)
+		l.send(tripID, "SendTripCancelled", l.mailer.SendTripCancelled)
+	}
+
+	return nil
+}
+
+func (l Lifecycle) record(ctx context.Context, tripID uuid.UUID, from, to domain.TripStatus, remoteAddr string) {
+	details, err := json.Marshal(pgstore.TripStatusChange{From: string(from), To: string(to)})
+	if err != nil {
+		l.logger.Error("failed to encode trip status change", zap.Error(err))
+		return
+	}
+
+	if err := l.store.InsertAuditEvent(ctx, pgstore.InsertAuditEventParams{
+		TripID:     tripID,
+		Action:     pgstore.AuditTripStatusChanged,
+		Details:    details,
+		RemoteAddr: remoteAddr,
+	}); err != nil {
+		l.logger.Error("failed to record trip status change", zap.Error(err), zap.String("trip_id", tripID.String()))
+	}
+}
+
+func (l Lifecycle) send(tripID uuid.UUID, name string, send func(uuid.UUID) error) {
+	go func() {
+		if err := send(tripID); err != nil {
+			l.logger.Error("failed to send email on "+name, zap.Error(err), zap.String("trip_id", tripID.String()))
+		}
+	}()
+}
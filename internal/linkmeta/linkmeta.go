@@ -0,0 +1,30 @@
+package linkmeta
+
+import (
+	"context"
+	"net/url"
+	"strings"
+)
+
+// Provider finds the title of the page a link points to.
+type Provider interface {
+	Title(ctx context.Context, pageURL string) (string, error)
+}
+
+// None is the provider used when titles are not fetched. Every link goes by
+// its host, as Fallback names it.
+type None struct{}
+
+func (None) Title(context.Context, string) (string, error) {
+	return "", nil
+}
+
+// Fallback is the title of a link whose page had none: its host, without the
+// www. most sites are served from.
+func Fallback(pageURL string) string {
+	u, err := url.Parse(pageURL)
+	if err != nil || u.Hostname() == "" {
+		return pageURL
+	}
+	return strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+}
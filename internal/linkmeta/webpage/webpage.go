@@ -0,0 +1,117 @@
+package webpage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"mime"
+	"net"
+	"net/http"
+	"regexp"
+	"strings"
+	"syscall"
+	"unicode/utf8"
+)
+
+// maxHead is how much of a page is read looking for its title, which is in
+// its head.
+const maxHead = 256 << 10
+
+// maxTitle caps the titles found, in runes, as some pages stuff them.
+const maxTitle = 200
+
+var errPrivateAddress = errors.New("webpage: refusing to connect to a private address")
+
+var (
+	ogTitleRE = regexp.MustCompile(`(?is)<meta\s[^>]*property\s*=\s*["']og:title["'][^>]*>`)
+	contentRE = regexp.MustCompile(`(?is)\bcontent\s*=\s*(?:"([^"]*)"|'([^']*)')`)
+	titleRE   = regexp.MustCompile(`(?is)<title[^>]*>(.*?)</title>`)
+)
+
+// Webpage fetches the pages links point to and reads their title, preferring
+// the one they give for sharing, og:title, to the one in <title>. Links are
+// pasted by users, so only public addresses are fetched.
+type Webpage struct {
+	client *http.Client
+}
+
+// NewWebpage returns a provider fetching pages with client, whose transport is
+// replaced by one that refuses to connect to private addresses.
+func NewWebpage(client *http.Client) Webpage {
+	dialer := &net.Dialer{Control: refusePrivate}
+	guarded := *client
+	guarded.Transport = &http.Transport{
+		DialContext:         dialer.DialContext,
+		TLSHandshakeTimeout: client.Timeout,
+	}
+	return Webpage{client: &guarded}
+}
+
+func (w Webpage) Title(ctx context.Context, pageURL string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, pageURL, nil)
+	if err != nil {
+		return "", fmt.Errorf("webpage: failed to create request for Title: %w", err)
+	}
+	req.Header.Set("Accept", "text/html")
+
+	res, err := w.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("webpage: failed to fetch page for Title: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("webpage: unexpected status %d for Title", res.StatusCode)
+	}
+	if mediaType, _, _ := mime.ParseMediaType(res.Header.Get("Content-Type")); mediaType != "text/html" {
+		return "", nil
+	}
+
+	head, err := io.ReadAll(io.LimitReader(res.Body, maxHead))
+	if err != nil {
+		return "", fmt.Errorf("webpage: failed to read page for Title: %w", err)
+	}
+
+	return ParseTitle(string(head)), nil
+}
+
+// ParseTitle returns the title of an HTML page, or empty when it has none.
+func ParseTitle(page string) string {
+	var title string
+	if meta := ogTitleRE.FindString(page); meta != "" {
+		if m := contentRE.FindStringSubmatch(meta); m != nil {
+			title = m[1] + m[2]
+		}
+	}
+	if strings.TrimSpace(title) == "" {
+		if m := titleRE.FindStringSubmatch(page); m != nil {
+			title = m[1]
+		}
+	}
+
+	title = strings.Join(strings.Fields(html.UnescapeString(title)), " ")
+	if !utf8.ValidString(title) {
+		return ""
+	}
+	if runes := []rune(title); len(runes) > maxTitle {
+		title = strings.TrimSpace(string(runes[:maxTitle-1])) + "…"
+	}
+	return title
+}
+
+// refusePrivate fails connections to loopback, private and link local
+// addresses, checked once resolved so hosts pointing there are refused too.
+func refusePrivate(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsMulticast() {
+		return errPrivateAddress
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+// Package links builds the absolute URLs sent outside the app, in emails and
+// share links, from the addresses the frontend and the API are served at.
+package links
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Builder builds links to the frontend, where people are sent to act, and to
+// the API, for what is fetched by other apps such as feeds and embeds. The
+// zero value builds links relative to the host.
+type Builder struct {
+	app string
+	api string
+}
+
+// New returns a Builder for the base URLs of the frontend and the API, which
+// must be absolute http or https URLs without a query or fragment.
+func New(appURL, apiURL string) (Builder, error) {
+	app, err := baseURL(appURL)
+	if err != nil {
+		return Builder{}, fmt.Errorf("links: invalid app url: %w", err)
+	}
+
+	api, err := baseURL(apiURL)
+	if err != nil {
+		return Builder{}, fmt.Errorf("links: invalid api url: %w", err)
+	}
+
+	return Builder{app: app, api: api}, nil
+}
+
+func baseURL(raw string) (string, error) {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", err
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", fmt.Errorf("%q is not an http or https url", raw)
+	}
+	if u.Host == "" {
+		return "", fmt.Errorf("%q has no host", raw)
+	}
+	if u.RawQuery != "" || u.Fragment != "" || u.User != nil {
+		return "", fmt.Errorf("%q must not have credentials, a query or a fragment", raw)
+	}
+	return strings.TrimSuffix(u.String(), "/"), nil
+}
+
+// App is the frontend page at the path of the given elements.
+func (b Builder) App(elem ...string) string {
+	return b.app + path(elem)
+}
+
+// API is the API route at the path of the given elements.
+func (b Builder) API(elem ...string) string {
+	return b.api + path(elem)
+}
+
+// path joins the elements, escaping each of them, so a token or name can not
+// change where the link points to.
+func path(elem []string) string {
+	var b strings.Builder
+	for _, e := range elem {
+		b.WriteString("/")
+		b.WriteString(url.PathEscape(e))
+	}
+	return b.String()
+}
@@ -0,0 +1,117 @@
+// Package logging keeps personal data of travelers out of the logs.
+package logging
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"go.uber.org/zap/zapcore"
+)
+
+// Mode is what is logged in place of emails and names.
+type Mode string
+
+const (
+	// Redact replaces them with a placeholder.
+	Redact Mode = "redact"
+	// Hash replaces them with a short hash, the same for the same value, so
+	// the entries about someone can still be told apart and followed.
+	Hash Mode = "hash"
+	// Show logs them as they are, for debugging in development.
+	Show Mode = "show"
+)
+
+// ParseMode reads a mode, defaulting to Redact when empty.
+func ParseMode(s string) (Mode, error) {
+	switch mode := Mode(strings.ToLower(s)); mode {
+	case "":
+		return Redact, nil
+	case Redact, Hash, Show:
+		return mode, nil
+	default:
+		return "", fmt.Errorf("logging: invalid mode %q, expected redact, hash or show", s)
+	}
+}
+
+// piiKeys are the fields holding an email or a name as a whole.
+var piiKeys = map[string]bool{
+	"email":       true,
+	"owner_email": true,
+	"name":        true,
+	"owner_name":  true,
+	"recipient":   true,
+	"to":          true,
+}
+
+// emailAddress matches the emails found anywhere else: messages, strings and
+// errors, such as those of SMTP servers or unique constraint violations.
+var emailAddress = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9\-]+(?:\.[A-Za-z0-9\-]+)*`)
+
+// Sanitize wraps core so the entries written through it carry no emails nor
+// names, replaced as mode says. Errors are logged by their scrubbed message.
+// In Show mode core is returned as it is.
+func Sanitize(core zapcore.Core, mode Mode) zapcore.Core {
+	if mode == Show {
+		return core
+	}
+	return sanitizingCore{Core: core, mode: mode}
+}
+
+type sanitizingCore struct {
+	zapcore.Core
+	mode Mode
+}
+
+func (c sanitizingCore) With(fields []zapcore.Field) zapcore.Core {
+	return sanitizingCore{Core: c.Core.With(c.fields(fields)), mode: c.mode}
+}
+
+func (c sanitizingCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+	return checked
+}
+
+func (c sanitizingCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	entry.Message = c.scrub(entry.Message)
+	return c.Core.Write(entry, c.fields(fields))
+}
+
+func (c sanitizingCore) fields(fields []zapcore.Field) []zapcore.Field {
+	sanitized := make([]zapcore.Field, len(fields))
+	for i, f := range fields {
+		switch {
+		case f.Type == zapcore.StringType && piiKeys[strings.ToLower(f.Key)]:
+			f.String = c.replace(f.String)
+		case f.Type == zapcore.StringType:
+			f.String = c.scrub(f.String)
+		case f.Type == zapcore.ErrorType:
+			if err, ok := f.Interface.(error); ok && err != nil {
+				f = zapcore.Field{Key: f.Key, Type: zapcore.StringType, String: c.scrub(err.Error())}
+			}
+		}
+		sanitized[i] = f
+	}
+	return sanitized
+}
+
+// scrub replaces the emails within s.
+func (c sanitizingCore) scrub(s string) string {
+	if !strings.Contains(s, "@") {
+		return s
+	}
+	return emailAddress.ReplaceAllStringFunc(s, c.replace)
+}
+
+// replace is what is logged in place of value.
+func (c sanitizingCore) replace(value string) string {
+	if c.mode == Hash {
+		sum := sha256.Sum256([]byte(strings.ToLower(value)))
+		return "sha256:" + hex.EncodeToString(sum[:6])
+	}
+	return "[redacted]"
+}
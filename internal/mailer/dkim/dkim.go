@@ -0,0 +1,223 @@
+package dkim
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// signedHeaders are the headers covered by the signature, when present.
+var signedHeaders = []string{
+	"From", "Reply-To", "To", "Cc", "Subject", "Date", "Message-ID",
+	"In-Reply-To", "References", "MIME-Version", "Content-Type",
+}
+
+var (
+	ErrInvalidKey     = errors.New("dkim: private key must be a PEM encoded RSA or Ed25519 key")
+	ErrMissingHeaders = errors.New("dkim: message has no header and body separator")
+
+	wsp = regexp.MustCompile(`[ \t]+`)
+)
+
+// Signer adds DKIM-Signature headers to outgoing messages, using relaxed
+// canonicalization for both headers and body.
+type Signer struct {
+	domain    string
+	selector  string
+	key       crypto.Signer
+	algorithm string
+}
+
+// NewSigner parses the PEM encoded private key published under the given
+// selector of the signing domain.
+func NewSigner(domain, selector string, pemKey []byte) (*Signer, error) {
+	if domain == "" || selector == "" {
+		return nil, errors.New("dkim: domain and selector are required")
+	}
+
+	block, _ := pem.Decode(pemKey)
+	if block == nil {
+		return nil, ErrInvalidKey
+	}
+
+	var key any
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, ErrInvalidKey
+	}
+	if err != nil {
+		return nil, fmt.Errorf("dkim: failed to parse private key: %w", err)
+	}
+
+	s := &Signer{domain: domain, selector: selector}
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		if k.N.BitLen() < 1024 {
+			return nil, errors.New("dkim: RSA keys must have at least 1024 bits")
+		}
+		s.key, s.algorithm = k, "rsa-sha256"
+	case ed25519.PrivateKey:
+		s.key, s.algorithm = k, "ed25519-sha256"
+	default:
+		return nil, ErrInvalidKey
+	}
+
+	return s, nil
+}
+
+func (s *Signer) Domain() string {
+	return s.domain
+}
+
+// Sign returns the message with a DKIM-Signature header prepended. Line
+// endings are normalized to CRLF, so the returned bytes must be sent as is.
+func (s *Signer) Sign(message []byte, now time.Time) ([]byte, error) {
+	message = normalizeCRLF(message)
+
+	header, body, ok := bytes.Cut(message, []byte("\r\n\r\n"))
+	if !ok {
+		return nil, ErrMissingHeaders
+	}
+
+	bodyHash := sha256.Sum256(relaxedBody(body))
+
+	fields := parseHeader(string(header))
+	var names []string
+	var canonical strings.Builder
+	for _, name := range signedHeaders {
+		if value, ok := lastField(fields, name); ok {
+			names = append(names, strings.ToLower(name))
+			canonical.WriteString(relaxedHeader(name, value))
+			canonical.WriteString("\r\n")
+		}
+	}
+
+	value := fmt.Sprintf("v=1; a=%s; c=relaxed/relaxed; d=%s; s=%s; t=%d; h=%s; bh=%s; b=",
+		s.algorithm, s.domain, s.selector, now.Unix(),
+		strings.Join(names, ":"),
+		base64.StdEncoding.EncodeToString(bodyHash[:]),
+	)
+	canonical.WriteString(relaxedHeader("DKIM-Signature", value))
+
+	hash := sha256.Sum256([]byte(canonical.String()))
+	var signature []byte
+	var err error
+	switch key := s.key.(type) {
+	case ed25519.PrivateKey:
+		signature = ed25519.Sign(key, hash[:])
+	default:
+		signature, err = s.key.Sign(rand.Reader, hash[:], crypto.SHA256)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("dkim: failed to sign message: %w", err)
+	}
+
+	signed := make([]byte, 0, len(message)+512)
+	signed = append(signed, "DKIM-Signature: "...)
+	signed = append(signed, fold(value+base64.StdEncoding.EncodeToString(signature))...)
+	signed = append(signed, "\r\n"...)
+	return append(signed, message...), nil
+}
+
+type field struct {
+	name  string
+	value string
+}
+
+// parseHeader splits the header into its fields, keeping folded values
+// together.
+func parseHeader(header string) []field {
+	var fields []field
+	for _, line := range strings.Split(header, "\r\n") {
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(fields) > 0 {
+			fields[len(fields)-1].value += "\r\n" + line
+			continue
+		}
+		name, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		fields = append(fields, field{name: name, value: value})
+	}
+	return fields
+}
+
+// lastField returns the value of the last field with the given name, the
+// one verifiers pick first.
+func lastField(fields []field, name string) (string, bool) {
+	for i := len(fields) - 1; i >= 0; i-- {
+		if strings.EqualFold(strings.TrimSpace(fields[i].name), name) {
+			return fields[i].value, true
+		}
+	}
+	return "", false
+}
+
+func relaxedHeader(name, value string) string {
+	value = strings.ReplaceAll(value, "\r\n", "")
+	value = wsp.ReplaceAllString(value, " ")
+	return strings.ToLower(strings.TrimSpace(name)) + ":" + strings.TrimSpace(value)
+}
+
+func relaxedBody(body []byte) []byte {
+	lines := strings.Split(string(body), "\r\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(wsp.ReplaceAllString(line, " "), " ")
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	if len(lines) == 0 {
+		return nil
+	}
+	return []byte(strings.Join(lines, "\r\n") + "\r\n")
+}
+
+func normalizeCRLF(message []byte) []byte {
+	message = bytes.ReplaceAll(message, []byte("\r\n"), []byte("\n"))
+	return bytes.ReplaceAll(message, []byte("\n"), []byte("\r\n"))
+}
+
+// fold breaks the header value on its tag separators to keep lines short.
+// Only the signature itself is split mid value, as it is the one tag left out
+// when verifying; splitting any other would change what was signed.
+func fold(value string) string {
+	const limit = 76
+
+	var b strings.Builder
+	width := len("DKIM-Signature: ")
+	for i, tag := range strings.Split(value, " ") {
+		if i > 0 {
+			if width+1+len(tag) > limit {
+				b.WriteString("\r\n ")
+				width = 1
+			} else {
+				b.WriteString(" ")
+				width++
+			}
+		}
+		for strings.HasPrefix(tag, "b=") && len(tag) > limit-1 && width == 1 {
+			b.WriteString(tag[:limit-1] + "\r\n ")
+			tag = tag[limit-1:]
+		}
+		b.WriteString(tag)
+		width += len(tag)
+	}
+	return b.String()
+}
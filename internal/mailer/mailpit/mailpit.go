@@ -1,27 +1,148 @@
 package mailpit
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"net"
+	netmail "net/mail"
+	"net/smtp"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/wneessen/go-mail"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/chaos"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/export"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/ical"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/links"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/mailer/dkim"
 	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/planning"
 )
 
 type store interface {
 	GetTrip(context.Context, uuid.UUID) (pgstore.Trip, error)
 	GetParticipants(ctx context.Context, tripID uuid.UUID) ([]pgstore.Participant, error)
+	GetTripActivities(ctx context.Context, tripID uuid.UUID) ([]pgstore.Activity, error)
+	GetParticipant(ctx context.Context, id uuid.UUID) (pgstore.Participant, error)
+	GetTripDatePollTokens(ctx context.Context, tripID uuid.UUID) ([]pgstore.GetTripDatePollTokensRow, error)
+	GetActivity(ctx context.Context, id uuid.UUID) (pgstore.Activity, error)
+	NextActivityInviteSequence(ctx context.Context, id uuid.UUID) (int32, error)
+	GetOwnershipTransfer(ctx context.Context, token string) (pgstore.OwnershipTransfer, error)
+	GetOwnerEmailChange(ctx context.Context, tripID uuid.UUID) (pgstore.OwnerEmailChange, error)
+	GetTripOwners(ctx context.Context, tripID uuid.UUID) ([]pgstore.Participant, error)
+	GetTask(ctx context.Context, id uuid.UUID) (pgstore.Task, error)
+	GetTripTasks(ctx context.Context, tripID uuid.UUID) ([]pgstore.Task, error)
+	GetAttachment(ctx context.Context, id uuid.UUID) (pgstore.Attachment, error)
+	GetRide(ctx context.Context, id uuid.UUID) (pgstore.Ride, error)
+	GetRidePassengers(ctx context.Context, rideID uuid.UUID) ([]pgstore.RidePassenger, error)
+	GetTripSurveyTokens(ctx context.Context, tripID uuid.UUID) ([]pgstore.GetTripSurveyTokensRow, error)
+	GetTripDatePollNonVoters(ctx context.Context, tripID uuid.UUID) ([]pgstore.GetTripDatePollNonVotersRow, error)
+	ClaimNotifications(ctx context.Context, arg pgstore.ClaimNotificationsParams) ([]uuid.UUID, error)
+	ReleaseNotifications(ctx context.Context, arg pgstore.ReleaseNotificationsParams) error
+	export.Source
+	planning.Source
+}
+
+const (
+	// inviteDuration is how long activities without a duration last in the
+	// calendar invites.
+	inviteDuration = time.Hour
+
+	// digestTaskDays is how many days ahead the daily digest lists the tasks
+	// of each participant.
+	digestTaskDays = 7
+
+	smtpHost = "localhost"
+	smtpPort = 1025
+)
+
+// Config is how emails identify who sends them and how many are sent at
+// once. Emails are DKIM signed when a signer is given. Workers and QueueSize
+// fall back to defaults when not set.
+//
+// RedirectTo, when set, sends every email to that address instead of its
+// recipients, so staging environments can use a real SMTP provider without
+// emailing real people.
+//
+// Chaos, when set, injects faults into the queries of the mailer and drops
+// SMTP connections, for testing.
+//
+// Links builds the links emails point people to.
+type Config struct {
+	From       string
+	ReplyTo    string
+	Signer     *dkim.Signer
+	Workers    int
+	QueueSize  int
+	RedirectTo string
+	Chaos      *chaos.Injector
+	Links      links.Builder
 }
 
 type Mailpit struct {
-	store store
+	store      store
+	from       string
+	replyTo    string
+	domain     string
+	signer     *dkim.Signer
+	pool       *sendPool
+	redirectTo string
+	chaos      *chaos.Injector
+	urls       links.Builder
 }
 
-func NewMailPit(pool *pgxpool.Pool) Mailpit {
-	return Mailpit{pgstore.New(pool)}
+// NewMailPit validates the sender configuration, so a mailer that would have
+// its emails rejected fails at startup instead of on the first email.
+func NewMailPit(pool *pgxpool.Pool, cfg Config) (Mailpit, error) {
+	from, err := netmail.ParseAddress(cfg.From)
+	if err != nil {
+		return Mailpit{}, fmt.Errorf("mailpit: invalid from address %q: %w", cfg.From, err)
+	}
+
+	if cfg.ReplyTo != "" {
+		if _, err := netmail.ParseAddress(cfg.ReplyTo); err != nil {
+			return Mailpit{}, fmt.Errorf("mailpit: invalid reply-to address %q: %w", cfg.ReplyTo, err)
+		}
+	}
+
+	if cfg.RedirectTo != "" {
+		if _, err := netmail.ParseAddress(cfg.RedirectTo); err != nil {
+			return Mailpit{}, fmt.Errorf("mailpit: invalid redirect address %q: %w", cfg.RedirectTo, err)
+		}
+	}
+
+	_, domain, _ := strings.Cut(from.Address, "@")
+	domain = strings.ToLower(domain)
+	if cfg.Signer != nil {
+		// DMARC only accepts the signature when it aligns with the From domain.
+		signing := strings.ToLower(cfg.Signer.Domain())
+		if domain != signing && !strings.HasSuffix(domain, "."+signing) {
+			return Mailpit{}, fmt.Errorf("mailpit: DKIM domain %q does not match from domain %q", signing, domain)
+		}
+	}
+
+	var db pgstore.DBTX = pool
+	if cfg.Chaos != nil {
+		db = cfg.Chaos.DB(pool)
+	}
+
+	mp := Mailpit{
+		store:      pgstore.NewStore(db),
+		from:       cfg.From,
+		replyTo:    cfg.ReplyTo,
+		domain:     domain,
+		signer:     cfg.Signer,
+		redirectTo: cfg.RedirectTo,
+		chaos:      cfg.Chaos,
+		urls:       cfg.Links,
+	}
+	mp.pool = newSendPool(cfg.Workers, cfg.QueueSize, mp.deliver)
+
+	return mp, nil
 }
 
 func (mp Mailpit) SendConfirmTripEmailToTripOwner(trupID uuid.UUID) error {
@@ -31,8 +152,8 @@ func (mp Mailpit) SendConfirmTripEmailToTripOwner(trupID uuid.UUID) error {
 		return fmt.Errorf("mailpit: failed to get trip for SendConfirmTripEmailToTripOwner: %w", err)
 	}
 
-	msg := mail.NewMsg()
-	if err := msg.From("mailpit@journey.com"); err != nil {
+	msg, err := mp.newTripMsg(trip.ID)
+	if err != nil {
 		return fmt.Errorf("mailpit: failed to set 'From' in email SendConfirmTripEmailToTripOwner: %w", err)
 	}
 
@@ -50,18 +171,19 @@ func (mp Mailpit) SendConfirmTripEmailToTripOwner(trupID uuid.UUID) error {
 		trip.OwnerName, trip.Destination, trip.StartsAt.Time.Format(time.DateOnly),
 	))
 
-	client, err := mail.NewClient("localhost", mail.WithTLSPortPolicy(mail.NoTLS), mail.WithPort(1025))
-	if err != nil {
-		return fmt.Errorf("mailpit: failed create email client SendConfirmTripEmailToTripOwner: %w", err)
+	if err := mp.attachItinerary(ctx, msg, trip); err != nil {
+		return fmt.Errorf("mailpit: failed to attach itinerary in email SendConfirmTripEmailToTripOwner: %w", err)
 	}
 
-	if err := client.DialAndSend(msg); err != nil {
+	if err := mp.send(msg); err != nil {
 		return fmt.Errorf("mailpit: failed send email client SendConfirmTripEmailToTripOwner: %w", err)
 	}
 
 	return nil
 }
 
+// SendEmailInvitations invites the participants of the trip not invited yet,
+// so confirming the trip again does not email anyone twice.
 func (mp Mailpit) SendEmailInvitations(trupID uuid.UUID) error {
 	ctx := context.Background()
 	trip, err := mp.store.GetTrip(ctx, trupID)
@@ -74,13 +196,33 @@ func (mp Mailpit) SendEmailInvitations(trupID uuid.UUID) error {
 		return fmt.Errorf("mailpit: failed to get trip for SendEmailInvitations: %w", err)
 	}
 
-	msg := mail.NewMsg()
-	if err := msg.From("mailpit@journey.com"); err != nil {
+	var invited []uuid.UUID
+	for _, part := range participants {
+		if part.Status == pgstore.ParticipantInvited && part.Role != pgstore.RoleOwner {
+			invited = append(invited, part.ID)
+		}
+	}
+
+	claimed, err := mp.claim(ctx, pgstore.NotificationInvitation, invited)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to claim invitations for SendEmailInvitations: %w", err)
+	}
+	if len(claimed) == 0 {
+		return nil
+	}
+
+	msg, err := mp.newTripMsg(trip.ID)
+	if err != nil {
+		mp.release(pgstore.NotificationInvitation, claimed)
 		return fmt.Errorf("mailpit: failed to set 'From' in email SendEmailInvitations: %w", err)
 	}
 
 	for _, part := range participants {
-		if err := msg.To(part.Email); err != nil {
+		if !claimed[part.ID] {
+			continue
+		}
+		if err := msg.AddTo(part.Email); err != nil {
+			mp.release(pgstore.NotificationInvitation, claimed)
 			return fmt.Errorf("mailpit: failed to set 'to' in email SendEmailInvitations: %w", err)
 		}
 	}
@@ -95,14 +237,1334 @@ func (mp Mailpit) SendEmailInvitations(trupID uuid.UUID) error {
 		trip.Destination, trip.StartsAt.Time.Format(time.DateOnly),
 	))
 
-	client, err := mail.NewClient("localhost", mail.WithTLSPortPolicy(mail.NoTLS), mail.WithPort(1025))
-	if err != nil {
-		return fmt.Errorf("mailpit: failed create email client SendEmailInvitations: %w", err)
+	if err := mp.attachItinerary(ctx, msg, trip); err != nil {
+		mp.release(pgstore.NotificationInvitation, claimed)
+		return fmt.Errorf("mailpit: failed to attach itinerary in email SendEmailInvitations: %w", err)
 	}
 
-	if err := client.DialAndSend(msg); err != nil {
+	if err := mp.send(msg); err != nil {
+		mp.release(pgstore.NotificationInvitation, claimed)
 		return fmt.Errorf("mailpit: failed send email client SendEmailInvitations: %w", err)
 	}
 
 	return nil
 }
+
+// SendParticipantInvitation invites a single participant, as done when the
+// owner corrects the address an invite bounced from. The participant is
+// invited even if they already were, at the address they had before.
+func (mp Mailpit) SendParticipantInvitation(participantID uuid.UUID) error {
+	ctx := context.Background()
+	participant, err := mp.store.GetParticipant(ctx, participantID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get participant for SendParticipantInvitation: %w", err)
+	}
+
+	trip, err := mp.store.GetTrip(ctx, participant.TripID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get trip for SendParticipantInvitation: %w", err)
+	}
+
+	msg, err := mp.newTripMsg(trip.ID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to set 'From' in email SendParticipantInvitation: %w", err)
+	}
+
+	if err := msg.To(participant.Email); err != nil {
+		return fmt.Errorf("mailpit: failed to set 'to' in email SendParticipantInvitation: %w", err)
+	}
+
+	msg.Subject("Confirme sua viagem")
+	msg.SetBodyString(mail.TypeTextPlain, fmt.Sprintf(`
+		Olá!
+
+		A sua viagem para %s que começa no dia %s precisa ser confirmada.
+		Clique no botão abaixo para confirmar.
+		`,
+		trip.Destination, trip.StartsAt.Time.Format(time.DateOnly),
+	))
+
+	if err := mp.attachItinerary(ctx, msg, trip); err != nil {
+		return fmt.Errorf("mailpit: failed to attach itinerary in email SendParticipantInvitation: %w", err)
+	}
+
+	if err := mp.send(msg); err != nil {
+		return fmt.Errorf("mailpit: failed send email client SendParticipantInvitation: %w", err)
+	}
+
+	if _, err := mp.claim(ctx, pgstore.NotificationInvitation, []uuid.UUID{participant.ID}); err != nil {
+		return fmt.Errorf("mailpit: failed to record invitation for SendParticipantInvitation: %w", err)
+	}
+
+	return nil
+}
+
+// SendWaitlistPromotion lets a participant know they left the waitlist, once.
+func (mp Mailpit) SendWaitlistPromotion(participantID uuid.UUID) error {
+	ctx := context.Background()
+	participant, err := mp.store.GetParticipant(ctx, participantID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get participant for SendWaitlistPromotion: %w", err)
+	}
+
+	claimed, err := mp.claim(ctx, pgstore.NotificationWaitlistPromotion, []uuid.UUID{participant.ID})
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to claim promotion for SendWaitlistPromotion: %w", err)
+	}
+	if len(claimed) == 0 {
+		return nil
+	}
+
+	trip, err := mp.store.GetTrip(ctx, participant.TripID)
+	if err != nil {
+		mp.release(pgstore.NotificationWaitlistPromotion, claimed)
+		return fmt.Errorf("mailpit: failed to get trip for SendWaitlistPromotion: %w", err)
+	}
+
+	msg, err := mp.newTripMsg(trip.ID)
+	if err != nil {
+		mp.release(pgstore.NotificationWaitlistPromotion, claimed)
+		return fmt.Errorf("mailpit: failed to set 'From' in email SendWaitlistPromotion: %w", err)
+	}
+
+	if err := msg.To(participant.Email); err != nil {
+		mp.release(pgstore.NotificationWaitlistPromotion, claimed)
+		return fmt.Errorf("mailpit: failed to set 'to' in email SendWaitlistPromotion: %w", err)
+	}
+
+	msg.Subject("Abriu uma vaga na sua viagem")
+	msg.SetBodyString(mail.TypeTextPlain, fmt.Sprintf(`
+		Olá!
+
+		Abriu uma vaga na viagem para %s que começa no dia %s e você saiu da lista de espera.
+		Clique no botão abaixo para confirmar sua presença.
+		`,
+		trip.Destination, trip.StartsAt.Time.Format(time.DateOnly),
+	))
+
+	if err := mp.send(msg); err != nil {
+		mp.release(pgstore.NotificationWaitlistPromotion, claimed)
+		return fmt.Errorf("mailpit: failed send email client SendWaitlistPromotion: %w", err)
+	}
+
+	return nil
+}
+
+func (mp Mailpit) SendDatePollInvitations(tripID uuid.UUID) error {
+	ctx := context.Background()
+	trip, err := mp.store.GetTrip(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get trip for SendDatePollInvitations: %w", err)
+	}
+
+	tokens, err := mp.store.GetTripDatePollTokens(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get poll tokens for SendDatePollInvitations: %w", err)
+	}
+
+	// Every invitee gets their own message, as the link identifies who answers.
+	msgs := make([]*mail.Msg, 0, len(tokens))
+	for _, token := range tokens {
+		msg, err := mp.newTripMsg(trip.ID)
+		if err != nil {
+			return fmt.Errorf("mailpit: failed to set 'From' in email SendDatePollInvitations: %w", err)
+		}
+
+		if err := msg.To(token.Email); err != nil {
+			return fmt.Errorf("mailpit: failed to set 'to' in email SendDatePollInvitations: %w", err)
+		}
+
+		msg.Subject("Escolha as datas da viagem")
+		msg.SetBodyString(mail.TypeTextPlain, fmt.Sprintf(`
+		Olá!
+
+		Ajude a escolher quando vai ser a viagem para %s.
+		Marque as datas em que você pode ir no link abaixo:
+
+		%s
+		`,
+			trip.Destination, mp.urls.App("date-poll", token.Token),
+		))
+		msgs = append(msgs, msg)
+	}
+
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	if err := mp.send(msgs...); err != nil {
+		return fmt.Errorf("mailpit: failed send email client SendDatePollInvitations: %w", err)
+	}
+
+	return nil
+}
+
+func (mp Mailpit) SendBudgetApprovalRequest(tripID uuid.UUID, plan string) error {
+	ctx := context.Background()
+	trip, err := mp.store.GetTrip(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get trip for SendBudgetApprovalRequest: %w", err)
+	}
+
+	msg, err := mp.newTripMsg(trip.ID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to set 'From' in email SendBudgetApprovalRequest: %w", err)
+	}
+
+	if err := mp.toOwners(ctx, msg, trip); err != nil {
+		return fmt.Errorf("mailpit: failed to set 'to' in email SendBudgetApprovalRequest: %w", err)
+	}
+
+	subject, reason := "Aprovação de orçamento pendente", "mas ultrapassa o orçamento restante"
+	if trip.Settings.ProposalMode == pgstore.ProposalApproval {
+		subject, reason = "Aprovação pendente", "e novas sugestões precisam da sua aprovação"
+	}
+
+	msg.Subject(subject)
+	msg.SetBodyString(mail.TypeTextPlain, fmt.Sprintf(`
+		Olá!
+
+		"%s" foi adicionado à viagem para %s, %s.
+		Aprove ou recuse para que ele entre no planejamento.
+		`,
+		plan, trip.Destination, reason,
+	))
+
+	if err := mp.send(msg); err != nil {
+		return fmt.Errorf("mailpit: failed send email client SendBudgetApprovalRequest: %w", err)
+	}
+
+	return nil
+}
+
+// SendActivityInvite emails the trip participants a calendar invite for the
+// activity. Invites for the same activity share their UID, so sending it
+// again after a change updates the event already in their calendars.
+func (mp Mailpit) SendActivityInvite(activityID uuid.UUID) error {
+	ctx := context.Background()
+	activity, err := mp.store.GetActivity(ctx, activityID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get activity for SendActivityInvite: %w", err)
+	}
+
+	trip, err := mp.store.GetTrip(ctx, activity.TripID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get trip for SendActivityInvite: %w", err)
+	}
+
+	participants, err := mp.store.GetParticipants(ctx, activity.TripID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get participants for SendActivityInvite: %w", err)
+	}
+
+	msg, err := mp.newTripMsg(trip.ID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to set 'From' in email SendActivityInvite: %w", err)
+	}
+
+	var attendees []ical.Attendee
+	for _, part := range participants {
+		if part.Status != pgstore.ParticipantInvited {
+			continue
+		}
+		if err := msg.AddTo(part.Email); err != nil {
+			return fmt.Errorf("mailpit: failed to set 'to' in email SendActivityInvite: %w", err)
+		}
+		attendees = append(attendees, ical.Attendee{Name: part.Name.String, Email: part.Email})
+	}
+
+	if len(attendees) == 0 {
+		return nil
+	}
+
+	sequence, err := mp.store.NextActivityInviteSequence(ctx, activityID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get invite sequence for SendActivityInvite: %w", err)
+	}
+
+	duration := time.Duration(activity.DurationMinutes.Int32) * time.Minute
+	if duration == 0 {
+		duration = inviteDuration
+	}
+
+	invite := ical.Request(ical.Event{
+		UID:       "activity-" + activity.ID.String() + "@" + mp.domain,
+		Sequence:  int(sequence),
+		Summary:   activity.Title,
+		StartsAt:  activity.OccursAt.Time,
+		EndsAt:    activity.OccursAt.Time.Add(duration),
+		Organizer: ical.Attendee{Name: trip.OwnerName, Email: trip.OwnerEmail},
+		Attendees: attendees,
+		TimeZone:  trip.Settings.Location(),
+	}, time.Now())
+
+	msg.Subject(activity.Title)
+	msg.SetBodyString(mail.TypeTextPlain, fmt.Sprintf(`
+		Olá!
+
+		A atividade "%s" da viagem para %s está marcada para %s.
+		Adicione ao seu calendário pelo convite em anexo.
+		`,
+		activity.Title, trip.Destination, activity.OccursAt.Time.Format("02/01/2006 15:04"),
+	))
+	msg.AddAlternativeString("text/calendar; method=REQUEST", invite)
+	if err := msg.AttachReader("invite.ics", bytes.NewReader([]byte(invite)),
+		mail.WithFileContentType("application/ics"),
+	); err != nil {
+		return fmt.Errorf("mailpit: failed to attach invite in email SendActivityInvite: %w", err)
+	}
+
+	if err := mp.send(msg); err != nil {
+		return fmt.Errorf("mailpit: failed send email client SendActivityInvite: %w", err)
+	}
+
+	return nil
+}
+
+// SendOwnerSummary sends the trip owner how the planning is going: who did
+// not confirm yet and which days have nothing planned, with links to where
+// each of them is sorted out.
+func (mp Mailpit) SendOwnerSummary(tripID uuid.UUID) error {
+	ctx := context.Background()
+	trip, err := mp.store.GetTrip(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get trip for SendOwnerSummary: %w", err)
+	}
+
+	status, err := planning.Load(ctx, mp.store, trip.Domain())
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get planning status for SendOwnerSummary: %w", err)
+	}
+
+	msg, err := mp.newTripMsg(trip.ID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to set 'From' in email SendOwnerSummary: %w", err)
+	}
+
+	if err := mp.toOwners(ctx, msg, trip); err != nil {
+		return fmt.Errorf("mailpit: failed to set 'to' in email SendOwnerSummary: %w", err)
+	}
+
+	tripURL := mp.urls.App("trips", trip.ID.String())
+
+	pending := "Todos já responderam."
+	if len(status.Pending) > 0 {
+		pending = "Aguardando: " + strings.Join(status.Pending, ", ")
+	}
+
+	emptyDays := "Todos os dias têm atividades."
+	if len(status.EmptyDays) > 0 {
+		days := make([]string, len(status.EmptyDays))
+		for i, day := range status.EmptyDays {
+			days[i] = day.Format("02/01")
+		}
+		emptyDays = "Sem atividades: " + strings.Join(days, ", ")
+	}
+
+	msg.Subject("Como está o planejamento da sua viagem")
+	msg.SetBodyString(mail.TypeTextPlain, fmt.Sprintf(`
+		Olá!
+
+		O planejamento da viagem para %s está %d%% completo.
+
+		Participantes: %d de %d confirmaram.
+		%s
+		%s/participants
+
+		Atividades:
+		%s
+		%s/activities
+
+		Veja tudo o que falta em %s/planning-status
+		`,
+		trip.Destination, status.Progress,
+		status.Confirmed, status.Participants, pending, tripURL,
+		emptyDays, tripURL,
+		tripURL,
+	))
+
+	if err := mp.send(msg); err != nil {
+		return fmt.Errorf("mailpit: failed send email client SendOwnerSummary: %w", err)
+	}
+
+	return nil
+}
+
+// SendPlanningDigest sends the owners of a trip not confirmed yet what is
+// still pending: participants who did not confirm, who did not answer the
+// date poll, and days without activities. Nothing is sent when nothing is
+// pending.
+func (mp Mailpit) SendPlanningDigest(tripID uuid.UUID) error {
+	ctx := context.Background()
+	trip, err := mp.store.GetTrip(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get trip for SendPlanningDigest: %w", err)
+	}
+
+	status, err := planning.Load(ctx, mp.store, trip.Domain())
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get planning status for SendPlanningDigest: %w", err)
+	}
+
+	nonVoters, err := mp.store.GetTripDatePollNonVoters(ctx, trip.ID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get date poll votes for SendPlanningDigest: %w", err)
+	}
+
+	if len(status.Pending) == 0 && len(nonVoters) == 0 && len(status.EmptyDays) == 0 {
+		return nil
+	}
+
+	tripURL := mp.urls.App("trips", trip.ID.String())
+
+	var pending strings.Builder
+	if len(status.Pending) > 0 {
+		fmt.Fprintf(&pending, `
+		Participantes que ainda não confirmaram: %s
+		%s/participants
+		`, strings.Join(status.Pending, ", "), tripURL)
+	}
+	if len(nonVoters) > 0 {
+		names := make([]string, len(nonVoters))
+		for i, voter := range nonVoters {
+			names[i] = voter.Email
+			if voter.Name.Valid {
+				names[i] = voter.Name.String
+			}
+		}
+		fmt.Fprintf(&pending, `
+		Ainda não votaram nas datas: %s
+		%s/date-poll
+		`, strings.Join(names, ", "), tripURL)
+	}
+	if len(status.EmptyDays) > 0 {
+		days := make([]string, len(status.EmptyDays))
+		for i, day := range status.EmptyDays {
+			days[i] = day.Format("02/01")
+		}
+		fmt.Fprintf(&pending, `
+		Dias sem atividades: %s
+		%s/activities
+		`, strings.Join(days, ", "), tripURL)
+	}
+
+	msg, err := mp.newTripMsg(trip.ID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to set 'From' in email SendPlanningDigest: %w", err)
+	}
+
+	if err := mp.toOwners(ctx, msg, trip); err != nil {
+		return fmt.Errorf("mailpit: failed to set 'to' in email SendPlanningDigest: %w", err)
+	}
+
+	msg.Subject("O que falta para a sua viagem")
+	msg.SetBodyString(mail.TypeTextPlain, fmt.Sprintf(`
+		Olá!
+
+		A viagem para %s começa no dia %s e ainda não foi confirmada.
+		Veja o que está pendente:
+		%s
+		Você recebe este resumo toda semana até a viagem ser confirmada.
+		Para não recebê-lo mais, desative-o nas configurações da viagem:
+		%s/settings
+		`,
+		trip.Destination, trip.StartsAt.Time.Format(time.DateOnly),
+		pending.String(),
+		tripURL,
+	))
+
+	if err := mp.send(msg); err != nil {
+		return fmt.Errorf("mailpit: failed send email client SendPlanningDigest: %w", err)
+	}
+
+	return nil
+}
+
+// SendOwnershipTransferRequest asks the participant the trip is being handed
+// to to accept it, through the link only they receive.
+func (mp Mailpit) SendOwnershipTransferRequest(token string) error {
+	ctx := context.Background()
+	transfer, err := mp.store.GetOwnershipTransfer(ctx, token)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get transfer for SendOwnershipTransferRequest: %w", err)
+	}
+
+	trip, err := mp.store.GetTrip(ctx, transfer.TripID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get trip for SendOwnershipTransferRequest: %w", err)
+	}
+
+	participant, err := mp.store.GetParticipant(ctx, transfer.ParticipantID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get participant for SendOwnershipTransferRequest: %w", err)
+	}
+
+	msg, err := mp.newTripMsg(trip.ID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to set 'From' in email SendOwnershipTransferRequest: %w", err)
+	}
+
+	if err := msg.To(participant.Email); err != nil {
+		return fmt.Errorf("mailpit: failed to set 'to' in email SendOwnershipTransferRequest: %w", err)
+	}
+
+	msg.Subject("Você quer organizar esta viagem?")
+	msg.SetBodyString(mail.TypeTextPlain, fmt.Sprintf(`
+		Olá!
+
+		%s quer passar para você a organização da viagem para %s que começa no dia %s.
+		Para aceitar, acesse o link abaixo até %s:
+
+		%s
+		`,
+		trip.OwnerName, trip.Destination, trip.StartsAt.Time.Format(time.DateOnly),
+		transfer.ExpiresAt.Time.Format("02/01/2006 15:04"),
+		mp.urls.App("ownership-transfers", transfer.Token),
+	))
+
+	if err := mp.send(msg); err != nil {
+		return fmt.Errorf("mailpit: failed send email client SendOwnershipTransferRequest: %w", err)
+	}
+
+	return nil
+}
+
+// SendOwnerEmailChangeRequest asks both the current and the new owner
+// address to confirm the change, each through its own link.
+func (mp Mailpit) SendOwnerEmailChangeRequest(tripID uuid.UUID) error {
+	ctx := context.Background()
+	change, err := mp.store.GetOwnerEmailChange(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get change for SendOwnerEmailChangeRequest: %w", err)
+	}
+
+	trip, err := mp.store.GetTrip(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get trip for SendOwnerEmailChangeRequest: %w", err)
+	}
+
+	recipients := []struct {
+		email, token string
+	}{
+		{trip.OwnerEmail, change.OldToken},
+		{change.NewEmail, change.NewToken},
+	}
+
+	msgs := make([]*mail.Msg, 0, len(recipients))
+	for _, recipient := range recipients {
+		msg, err := mp.newTripMsg(trip.ID)
+		if err != nil {
+			return fmt.Errorf("mailpit: failed to set 'From' in email SendOwnerEmailChangeRequest: %w", err)
+		}
+
+		if err := msg.To(recipient.email); err != nil {
+			return fmt.Errorf("mailpit: failed to set 'to' in email SendOwnerEmailChangeRequest: %w", err)
+		}
+
+		msg.Subject("Confirme a troca de email da viagem")
+		msg.SetBodyString(mail.TypeTextPlain, fmt.Sprintf(`
+			Olá, %s!
+
+			Foi pedido que os emails da viagem para %s passem de %s para %s.
+			A troca só acontece depois que os dois endereços confirmarem. Para confirmar por este, acesse o link abaixo até %s:
+
+			%s
+			`,
+			trip.OwnerName, trip.Destination, trip.OwnerEmail, change.NewEmail,
+			change.ExpiresAt.Time.Format("02/01/2006 15:04"),
+			mp.urls.App("owner-email-changes", recipient.token),
+		))
+		msgs = append(msgs, msg)
+	}
+
+	if err := mp.send(msgs...); err != nil {
+		return fmt.Errorf("mailpit: failed send email client SendOwnerEmailChangeRequest: %w", err)
+	}
+
+	return nil
+}
+
+// SendDailyDigest sends each confirmed participant what the trip has planned
+// for today, the activities they organize from today on and their open tasks
+// due soon. Participants with nothing to read about are not emailed.
+func (mp Mailpit) SendDailyDigest(tripID uuid.UUID) error {
+	ctx := context.Background()
+	trip, err := mp.store.GetTrip(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get trip for SendDailyDigest: %w", err)
+	}
+
+	participants, err := mp.store.GetParticipants(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get participants for SendDailyDigest: %w", err)
+	}
+
+	acts, err := mp.store.GetTripActivities(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get activities for SendDailyDigest: %w", err)
+	}
+
+	tasks, err := mp.store.GetTripTasks(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get tasks for SendDailyDigest: %w", err)
+	}
+
+	// Plans are stored in the destination local time.
+	now := time.Now().In(trip.Settings.Location())
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	tomorrow := today.AddDate(0, 0, 1)
+	tasksUntil := today.AddDate(0, 0, digestTaskDays)
+
+	var msgs []*mail.Msg
+	for _, part := range participants {
+		if part.Status != pgstore.ParticipantInvited || !part.IsConfirmed {
+			continue
+		}
+
+		var todayLines, organizingLines []string
+		for _, act := range acts {
+			if act.Status != pgstore.PlanApproved || act.OccursAt.Time.Before(today) {
+				continue
+			}
+
+			organizing := act.OrganizerID.Valid && act.OrganizerID.Bytes == part.ID
+			if act.OccursAt.Time.Before(tomorrow) {
+				line := "- " + act.OccursAt.Time.Format("15:04") + " " + act.Title
+				if organizing {
+					line += " (organizado por você)"
+				}
+				todayLines = append(todayLines, line)
+			}
+			if organizing {
+				organizingLines = append(organizingLines, "- "+act.OccursAt.Time.Format("02/01 15:04")+" "+act.Title)
+			}
+		}
+
+		var taskLines []string
+		for _, task := range tasks {
+			if task.IsDone || !task.AssigneeID.Valid || task.AssigneeID.Bytes != part.ID || task.DueOn.Time.After(tasksUntil) {
+				continue
+			}
+
+			line := "- " + task.DueOn.Time.Format("02/01") + " " + task.Title
+			if pgstore.IsTaskOverdue(task, now) {
+				line += " (atrasada)"
+			}
+			taskLines = append(taskLines, line)
+		}
+
+		if len(todayLines) == 0 && len(organizingLines) == 0 && len(taskLines) == 0 {
+			continue
+		}
+
+		msg, err := mp.newTripMsg(trip.ID)
+		if err != nil {
+			return fmt.Errorf("mailpit: failed to set 'From' in email SendDailyDigest: %w", err)
+		}
+
+		if err := msg.To(part.Email); err != nil {
+			return fmt.Errorf("mailpit: failed to set 'to' in email SendDailyDigest: %w", err)
+		}
+
+		name := part.Email
+		if part.Name.Valid {
+			name = part.Name.String
+		}
+
+		var b strings.Builder
+		fmt.Fprintf(&b, "Olá, %s!\n\nO resumo do dia da viagem para %s.\n", name, trip.Destination)
+		if len(todayLines) > 0 {
+			fmt.Fprintf(&b, "\nHoje:\n%s\n", strings.Join(todayLines, "\n"))
+		}
+		if len(organizingLines) > 0 {
+			fmt.Fprintf(&b, "\nVocê organiza:\n%s\n", strings.Join(organizingLines, "\n"))
+		}
+		if len(taskLines) > 0 {
+			fmt.Fprintf(&b, "\nTarefas:\n%s\n", strings.Join(taskLines, "\n"))
+		}
+		fmt.Fprintf(&b, "\nVeja o roteiro em %s\n", mp.urls.App("trips", trip.ID.String(), "activities"))
+
+		msg.Subject("Resumo do dia: " + trip.Destination)
+		msg.SetBodyString(mail.TypeTextPlain, b.String())
+		msgs = append(msgs, msg)
+	}
+
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	if err := mp.send(msgs...); err != nil {
+		return fmt.Errorf("mailpit: failed send email client SendDailyDigest: %w", err)
+	}
+
+	return nil
+}
+
+// SendOverdueTaskReminder tells whoever the task is assigned to that its
+// deadline passed. Tasks nobody is assigned to are the owners' to chase.
+func (mp Mailpit) SendOverdueTaskReminder(taskID uuid.UUID) error {
+	ctx := context.Background()
+	task, err := mp.store.GetTask(ctx, taskID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get task for SendOverdueTaskReminder: %w", err)
+	}
+
+	trip, err := mp.store.GetTrip(ctx, task.TripID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get trip for SendOverdueTaskReminder: %w", err)
+	}
+
+	msg, err := mp.newTripMsg(trip.ID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to set 'From' in email SendOverdueTaskReminder: %w", err)
+	}
+
+	greeting := "Olá!"
+	if task.AssigneeID.Valid {
+		assignee, err := mp.store.GetParticipant(ctx, task.AssigneeID.Bytes)
+		if err != nil {
+			return fmt.Errorf("mailpit: failed to get assignee for SendOverdueTaskReminder: %w", err)
+		}
+
+		if err := msg.To(assignee.Email); err != nil {
+			return fmt.Errorf("mailpit: failed to set 'to' in email SendOverdueTaskReminder: %w", err)
+		}
+
+		if assignee.Name.Valid {
+			greeting = "Olá, " + assignee.Name.String + "!"
+		}
+	} else if err := mp.toOwners(ctx, msg, trip); err != nil {
+		return fmt.Errorf("mailpit: failed to set 'to' in email SendOverdueTaskReminder: %w", err)
+	}
+
+	msg.Subject("Tarefa atrasada: " + task.Title)
+	msg.SetBodyString(mail.TypeTextPlain, fmt.Sprintf(
+		"%s\n\nA tarefa \"%s\" da viagem para %s venceu em %s e ainda não foi concluída.\n\nVeja as tarefas em %s\n",
+		greeting, task.Title, trip.Destination, task.DueOn.Time.Format("02/01/2006"), mp.urls.App("trips", trip.ID.String(), "tasks"),
+	))
+
+	if err := mp.send(msg); err != nil {
+		return fmt.Errorf("mailpit: failed send email client SendOverdueTaskReminder: %w", err)
+	}
+
+	return nil
+}
+
+// SendAttachmentQuarantined tells whoever uploaded an attachment, or the trip
+// owners when nobody is known, that its file was found infected and removed.
+func (mp Mailpit) SendAttachmentQuarantined(attachmentID uuid.UUID) error {
+	ctx := context.Background()
+	attachment, err := mp.store.GetAttachment(ctx, attachmentID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get attachment for SendAttachmentQuarantined: %w", err)
+	}
+
+	trip, err := mp.store.GetTrip(ctx, attachment.TripID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get trip for SendAttachmentQuarantined: %w", err)
+	}
+
+	msg, err := mp.newTripMsg(trip.ID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to set 'From' in email SendAttachmentQuarantined: %w", err)
+	}
+
+	if attachment.UploadedBy.Valid {
+		participant, err := mp.store.GetParticipant(ctx, attachment.UploadedBy.Bytes)
+		if err != nil {
+			return fmt.Errorf("mailpit: failed to get participant for SendAttachmentQuarantined: %w", err)
+		}
+		err = msg.To(participant.Email)
+	} else {
+		err = mp.toOwners(ctx, msg, trip)
+	}
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to set 'to' in email SendAttachmentQuarantined: %w", err)
+	}
+
+	msg.Subject("Um anexo da sua viagem foi removido")
+	msg.SetBodyString(mail.TypeTextPlain, fmt.Sprintf(`
+		Olá!
+
+		O arquivo %s enviado para a viagem para %s foi identificado como malicioso (%s) e foi removido.
+		Verifique o seu dispositivo com um antivírus antes de enviá-lo novamente.
+		`,
+		attachment.Filename, trip.Destination, attachment.ScanSignature.String,
+	))
+
+	if err := mp.send(msg); err != nil {
+		return fmt.Errorf("mailpit: failed send email client SendAttachmentQuarantined: %w", err)
+	}
+
+	return nil
+}
+
+// SendTripArchived tells the trip owners their trip was archived once it was
+// over, with where to export it.
+func (mp Mailpit) SendTripArchived(tripID uuid.UUID) error {
+	ctx := context.Background()
+	trip, err := mp.store.GetTrip(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get trip for SendTripArchived: %w", err)
+	}
+
+	msg, err := mp.newTripMsg(trip.ID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to set 'From' in email SendTripArchived: %w", err)
+	}
+
+	if err := mp.toOwners(ctx, msg, trip); err != nil {
+		return fmt.Errorf("mailpit: failed to set 'to' in email SendTripArchived: %w", err)
+	}
+
+	msg.Subject("Sua viagem foi arquivada")
+	msg.SetBodyString(mail.TypeTextPlain, fmt.Sprintf(`
+		Olá!
+
+		A viagem para %s terminou em %s e foi arquivada.
+		O roteiro continua disponível para exportar em %s
+		`,
+		trip.Destination, trip.EndsAt.Time.Format("02/01/2006"), mp.urls.App("trips", trip.ID.String(), "export.md"),
+	))
+
+	if err := mp.send(msg); err != nil {
+		return fmt.Errorf("mailpit: failed send email client SendTripArchived: %w", err)
+	}
+
+	return nil
+}
+
+// SendTripCancelled lets everyone still on the trip know it was cancelled.
+func (mp Mailpit) SendTripCancelled(tripID uuid.UUID) error {
+	ctx := context.Background()
+	trip, err := mp.store.GetTrip(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get trip for SendTripCancelled: %w", err)
+	}
+
+	participants, err := mp.store.GetParticipants(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get participants for SendTripCancelled: %w", err)
+	}
+
+	msg, err := mp.newTripMsg(trip.ID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to set 'From' in email SendTripCancelled: %w", err)
+	}
+
+	var to int
+	for _, part := range participants {
+		if part.Status != pgstore.ParticipantInvited {
+			continue
+		}
+		if err := msg.AddTo(part.Email); err != nil {
+			return fmt.Errorf("mailpit: failed to set 'to' in email SendTripCancelled: %w", err)
+		}
+		to++
+	}
+	if to == 0 {
+		return nil
+	}
+
+	msg.Subject("A viagem foi cancelada")
+	msg.SetBodyString(mail.TypeTextPlain, fmt.Sprintf(`
+		Olá!
+
+		A viagem para %s que começaria no dia %s foi cancelada.
+		`,
+		trip.Destination, trip.StartsAt.Time.Format("02/01/2006"),
+	))
+
+	if err := mp.send(msg); err != nil {
+		return fmt.Errorf("mailpit: failed send email client SendTripCancelled: %w", err)
+	}
+
+	return nil
+}
+
+// SendTripReminder reminds everyone on the trip that it starts in daysLeft
+// days, along with where to find its plans.
+func (mp Mailpit) SendTripReminder(tripID uuid.UUID, daysLeft int) error {
+	ctx := context.Background()
+	trip, err := mp.store.GetTrip(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get trip for SendTripReminder: %w", err)
+	}
+
+	participants, err := mp.store.GetParticipants(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get participants for SendTripReminder: %w", err)
+	}
+
+	msg, err := mp.newTripMsg(trip.ID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to set 'From' in email SendTripReminder: %w", err)
+	}
+
+	var to int
+	for _, part := range participants {
+		if part.Status != pgstore.ParticipantInvited {
+			continue
+		}
+		if err := msg.AddTo(part.Email); err != nil {
+			return fmt.Errorf("mailpit: failed to set 'to' in email SendTripReminder: %w", err)
+		}
+		to++
+	}
+	if to == 0 {
+		return nil
+	}
+
+	when := fmt.Sprintf("em %d dias", daysLeft)
+	switch {
+	case daysLeft <= 0:
+		when = "hoje"
+	case daysLeft == 1:
+		when = "amanhã"
+	}
+
+	msg.Subject(fmt.Sprintf("Sua viagem para %s começa %s", trip.Destination, when))
+	msg.SetBodyString(mail.TypeTextPlain, fmt.Sprintf(`
+		Olá!
+
+		A viagem para %s começa %s, no dia %s.
+
+		Confira o roteiro em %s
+		`,
+		trip.Destination, when, trip.StartsAt.Time.Format("02/01/2006"), mp.urls.App("trips", trip.ID.String()),
+	))
+
+	if err := mp.send(msg); err != nil {
+		return fmt.Errorf("mailpit: failed send email client SendTripReminder: %w", err)
+	}
+
+	return nil
+}
+
+// SendRideFull lets the driver know the last seats of their ride were
+// claimed, and by whom.
+func (mp Mailpit) SendRideFull(rideID uuid.UUID) error {
+	ctx := context.Background()
+	ride, err := mp.store.GetRide(ctx, rideID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get ride for SendRideFull: %w", err)
+	}
+
+	driver, err := mp.store.GetParticipant(ctx, ride.DriverID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get driver for SendRideFull: %w", err)
+	}
+
+	passengers, err := mp.store.GetRidePassengers(ctx, rideID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get passengers for SendRideFull: %w", err)
+	}
+
+	var names []string
+	for _, passenger := range passengers {
+		participant, err := mp.store.GetParticipant(ctx, passenger.ParticipantID)
+		if err != nil {
+			return fmt.Errorf("mailpit: failed to get passenger for SendRideFull: %w", err)
+		}
+		names = append(names, participantName(participant))
+	}
+
+	msg, err := mp.newTripMsg(ride.TripID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to set 'From' in email SendRideFull: %w", err)
+	}
+
+	if err := msg.To(driver.Email); err != nil {
+		return fmt.Errorf("mailpit: failed to set 'to' in email SendRideFull: %w", err)
+	}
+
+	msg.Subject("Sua carona está cheia")
+	msg.SetBodyString(mail.TypeTextPlain, fmt.Sprintf(`
+		Olá!
+
+		Todos os lugares da sua carona saindo de %s em %s foram ocupados.
+		Vão com você: %s.
+		`,
+		ride.DeparturePoint, ride.DepartsAt.Time.Format("02/01/2006 às 15:04"), strings.Join(names, ", "),
+	))
+
+	if err := mp.send(msg); err != nil {
+		return fmt.Errorf("mailpit: failed send email client SendRideFull: %w", err)
+	}
+
+	return nil
+}
+
+// SendRideCanceled lets the passengers of a ride canceled by the driver know
+// they need another way to get there. The ride is already gone, so it is
+// given as it was.
+func (mp Mailpit) SendRideCanceled(ride pgstore.Ride, passengerIDs []uuid.UUID) error {
+	if len(passengerIDs) == 0 {
+		return nil
+	}
+
+	ctx := context.Background()
+	driver, err := mp.store.GetParticipant(ctx, ride.DriverID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get driver for SendRideCanceled: %w", err)
+	}
+
+	msg, err := mp.newTripMsg(ride.TripID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to set 'From' in email SendRideCanceled: %w", err)
+	}
+
+	for _, passengerID := range passengerIDs {
+		passenger, err := mp.store.GetParticipant(ctx, passengerID)
+		if err != nil {
+			return fmt.Errorf("mailpit: failed to get passenger for SendRideCanceled: %w", err)
+		}
+		if err := msg.AddTo(passenger.Email); err != nil {
+			return fmt.Errorf("mailpit: failed to set 'to' in email SendRideCanceled: %w", err)
+		}
+	}
+
+	msg.Subject("Uma carona da sua viagem foi cancelada")
+	msg.SetBodyString(mail.TypeTextPlain, fmt.Sprintf(`
+		Olá!
+
+		%s cancelou a carona saindo de %s em %s.
+		Procure outra carona em %s
+		`,
+		participantName(driver), ride.DeparturePoint, ride.DepartsAt.Time.Format("02/01/2006 às 15:04"), mp.urls.App("trips", ride.TripID.String(), "rides"),
+	))
+
+	if err := mp.send(msg); err != nil {
+		return fmt.Errorf("mailpit: failed send email client SendRideCanceled: %w", err)
+	}
+
+	return nil
+}
+
+// SendTripDatesImpact lets the trip owners know what the new dates of the
+// trip left out of its plans, for them to move or cancel.
+func (mp Mailpit) SendTripDatesImpact(tripID uuid.UUID, impact planning.Impact) error {
+	ctx := context.Background()
+	trip, err := mp.store.GetTrip(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get trip for SendTripDatesImpact: %w", err)
+	}
+
+	msg, err := mp.newTripMsg(trip.ID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to set 'From' in email SendTripDatesImpact: %w", err)
+	}
+
+	if err := mp.toOwners(ctx, msg, trip); err != nil {
+		return fmt.Errorf("mailpit: failed to set 'to' in email SendTripDatesImpact: %w", err)
+	}
+
+	var report strings.Builder
+	for _, act := range impact.Activities {
+		fmt.Fprintf(&report, "\t\t- Atividade \"%s\" em %s\n", act.Title, act.OccursAt.Format("02/01/2006 às 15:04"))
+	}
+	for _, lodging := range impact.Lodgings {
+		fmt.Fprintf(&report, "\t\t- Hospedagem \"%s\" de %s a %s\n", lodging.Name, lodging.CheckIn.Format("02/01/2006"), lodging.CheckOut.Format("02/01/2006"))
+	}
+	for _, transport := range impact.Transports {
+		fmt.Fprintf(&report, "\t\t- Transporte de %s para %s em %s\n", transport.Origin, transport.Destination, transport.DepartsAt.Format("02/01/2006 às 15:04"))
+	}
+	for _, night := range impact.UncoveredNights {
+		fmt.Fprintf(&report, "\t\t- Noite de %s sem hospedagem\n", night.Format("02/01/2006"))
+	}
+
+	msg.Subject("As novas datas da viagem afetam o planejamento")
+	msg.SetBodyString(mail.TypeTextPlain, fmt.Sprintf(`
+		Olá!
+
+		A viagem para %s agora vai de %s a %s, e isto ficou de fora:
+
+%s
+		Reveja o planejamento em %s
+		`,
+		trip.Destination, trip.StartsAt.Time.Format("02/01/2006"), trip.EndsAt.Time.Format("02/01/2006"), report.String(), mp.urls.App("trips", trip.ID.String()),
+	))
+
+	if err := mp.send(msg); err != nil {
+		return fmt.Errorf("mailpit: failed send email client SendTripDatesImpact: %w", err)
+	}
+
+	return nil
+}
+
+// participantName is how a participant is called in emails, their email
+// when they gave no name.
+func participantName(p pgstore.Participant) string {
+	if p.Name.Valid {
+		return p.Name.String
+	}
+	return p.Email
+}
+
+// claim records the participants as sent the kind of email, answering those
+// who were not already. Only they are sent it, so concurrent or repeated
+// sends email each participant once.
+func (mp Mailpit) claim(ctx context.Context, kind string, participantIDs []uuid.UUID) (map[uuid.UUID]bool, error) {
+	if len(participantIDs) == 0 {
+		return nil, nil
+	}
+
+	ids, err := mp.store.ClaimNotifications(ctx, pgstore.ClaimNotificationsParams{
+		Kind:           kind,
+		ParticipantIds: participantIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	claimed := make(map[uuid.UUID]bool, len(ids))
+	for _, id := range ids {
+		claimed[id] = true
+	}
+	return claimed, nil
+}
+
+// release forgets the participants claimed for an email that could not be
+// sent, so sending it again reaches them. It is best effort: failing to
+// release only keeps the email from being sent again.
+func (mp Mailpit) release(kind string, claimed map[uuid.UUID]bool) {
+	ids := make([]uuid.UUID, 0, len(claimed))
+	for id := range claimed {
+		ids = append(ids, id)
+	}
+
+	_ = mp.store.ReleaseNotifications(context.Background(), pgstore.ReleaseNotificationsParams{
+		Kind:           kind,
+		ParticipantIds: ids,
+	})
+}
+
+// toOwners addresses the message to every owner of the trip, falling back to
+// the owner the trip is listed under.
+func (mp Mailpit) toOwners(ctx context.Context, msg *mail.Msg, trip pgstore.Trip) error {
+	owners, err := mp.store.GetTripOwners(ctx, trip.ID)
+	if err != nil {
+		return err
+	}
+
+	if len(owners) == 0 {
+		return msg.To(trip.OwnerEmail)
+	}
+
+	for _, owner := range owners {
+		if err := msg.AddTo(owner.Email); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// attachItinerary attaches the trip itinerary in the format chosen for the
+// trip, rendered with the plans as they are now. Nothing is attached when the
+// trip has it turned off.
+func (mp Mailpit) attachItinerary(ctx context.Context, msg *mail.Msg, trip pgstore.Trip) error {
+	if trip.Settings.ItineraryAttachment == export.FormatNone {
+		return nil
+	}
+
+	itinerary, err := export.Trip(ctx, mp.store, trip.Domain())
+	if err != nil {
+		return err
+	}
+
+	file, ok := export.Attach(itinerary, trip.Settings.ItineraryAttachment, mp.domain, time.Now())
+	if !ok {
+		return nil
+	}
+
+	return msg.AttachReader(file.Name, bytes.NewReader(file.Content), mail.WithFileContentType(mail.ContentType(file.ContentType)))
+}
+
+// newTripMsg starts an email about a trip. Every email of a trip references
+// the same thread root, so mail clients group reminders and updates into a
+// single conversation.
+func (mp Mailpit) newTripMsg(tripID uuid.UUID) (*mail.Msg, error) {
+	msg := mail.NewMsg()
+	if err := msg.From(mp.from); err != nil {
+		return nil, err
+	}
+
+	if mp.replyTo != "" {
+		if err := msg.ReplyTo(mp.replyTo); err != nil {
+			return nil, err
+		}
+	}
+
+	root := "<" + mp.tripThreadID(tripID) + ">"
+	msg.SetMessageIDWithValue(uuid.NewString() + "@" + mp.domain)
+	msg.SetGenHeader(mail.HeaderInReplyTo, root)
+	msg.SetGenHeader(mail.HeaderReferences, root)
+
+	return msg, nil
+}
+
+// tripThreadID is the Message-ID all emails of a trip hang from. It is stable
+// so emails sent days apart still thread together.
+func (mp Mailpit) tripThreadID(tripID uuid.UUID) string {
+	return "trip-" + tripID.String() + "@" + mp.domain
+}
+
+// send hands the messages to the send pool and waits for them to go out.
+func (mp Mailpit) send(msgs ...*mail.Msg) error {
+	if mp.redirectTo != "" {
+		for _, msg := range msgs {
+			if err := mp.redirect(msg); err != nil {
+				return err
+			}
+		}
+	}
+	return mp.pool.send(msgs...)
+}
+
+// redirect replaces the recipients of the message with the redirect address,
+// keeping who it was meant for in the X-Original-To header and at the top of
+// the plain text body.
+func (mp Mailpit) redirect(msg *mail.Msg) error {
+	var original []string
+	original = append(original, msg.GetToString()...)
+	original = append(original, msg.GetCcString()...)
+	original = append(original, msg.GetBccString()...)
+
+	if err := msg.To(mp.redirectTo); err != nil {
+		return err
+	}
+	if err := msg.Cc(); err != nil {
+		return err
+	}
+	if err := msg.Bcc(); err != nil {
+		return err
+	}
+	msg.SetGenHeader("X-Original-To", strings.Join(original, ", "))
+
+	note := fmt.Sprintf("[Redirecionado] Destinatários originais: %s\n\n", strings.Join(original, ", "))
+	for _, part := range msg.GetParts() {
+		if part.GetContentType() != mail.TypeTextPlain {
+			continue
+		}
+		content, err := part.GetContent()
+		if err != nil {
+			return err
+		}
+		part.SetContent(note + string(content))
+	}
+
+	return nil
+}
+
+// deliver sends a message to the SMTP server. Signed messages are rendered
+// once and sent byte for byte, as any later rendering would not match the
+// signature.
+func (mp Mailpit) deliver(msg *mail.Msg) error {
+	if mp.chaos != nil {
+		if err := mp.chaos.SMTP(context.Background()); err != nil {
+			return err
+		}
+	}
+
+	if mp.signer == nil {
+		client, err := mail.NewClient(smtpHost, mail.WithTLSPortPolicy(mail.NoTLS), mail.WithPort(smtpPort))
+		if err != nil {
+			return err
+		}
+		return client.DialAndSend(msg)
+	}
+
+	var buf bytes.Buffer
+	if _, err := msg.WriteTo(&buf); err != nil {
+		return err
+	}
+
+	signed, err := mp.signer.Sign(buf.Bytes(), time.Now())
+	if err != nil {
+		return err
+	}
+
+	from, err := msg.GetSender(false)
+	if err != nil {
+		return err
+	}
+
+	rcpts, err := msg.GetRecipients()
+	if err != nil {
+		return err
+	}
+
+	return smtp.SendMail(net.JoinHostPort(smtpHost, strconv.Itoa(smtpPort)), nil, from, rcpts, signed)
+}
+
+// Ping checks the SMTP server answers, greeting it and hanging up without
+// sending anything.
+func Ping(ctx context.Context) error {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, "tcp", net.JoinHostPort(smtpHost, strconv.Itoa(smtpPort)))
+	if err != nil {
+		return err
+	}
+
+	client, err := smtp.NewClient(conn, smtpHost)
+	if err != nil {
+		conn.Close()
+		return err
+	}
+	defer client.Close()
+
+	if err := client.Hello("localhost"); err != nil {
+		return err
+	}
+	return client.Quit()
+}
+
+// SendTripSurvey asks everyone who went on the trip how it was, each with
+// their own link to the survey.
+func (mp Mailpit) SendTripSurvey(tripID uuid.UUID) error {
+	ctx := context.Background()
+	trip, err := mp.store.GetTrip(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get trip for SendTripSurvey: %w", err)
+	}
+
+	tokens, err := mp.store.GetTripSurveyTokens(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("mailpit: failed to get survey tokens for SendTripSurvey: %w", err)
+	}
+
+	msgs := make([]*mail.Msg, 0, len(tokens))
+	for _, token := range tokens {
+		msg, err := mp.newTripMsg(trip.ID)
+		if err != nil {
+			return fmt.Errorf("mailpit: failed to set 'From' in email SendTripSurvey: %w", err)
+		}
+
+		if err := msg.To(token.Email); err != nil {
+			return fmt.Errorf("mailpit: failed to set 'to' in email SendTripSurvey: %w", err)
+		}
+
+		msg.Subject("Como foi a viagem?")
+		msg.SetBodyString(mail.TypeTextPlain, fmt.Sprintf(`
+		Olá!
+
+		A viagem para %s terminou. Conte como foi respondendo
+		algumas perguntas rápidas no link abaixo:
+
+		%s
+		`,
+			trip.Destination, mp.urls.App("surveys", token.Token),
+		))
+		msgs = append(msgs, msg)
+	}
+
+	if len(msgs) == 0 {
+		return nil
+	}
+
+	if err := mp.send(msgs...); err != nil {
+		return fmt.Errorf("mailpit: failed send email client SendTripSurvey: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+package mailpit
+
+import (
+	"errors"
+	"expvar"
+	"time"
+
+	"github.com/wneessen/go-mail"
+)
+
+const (
+	defaultWorkers   = 4
+	defaultQueueSize = 100
+)
+
+// metrics are published under "mailer" in /debug/vars. The average send
+// latency is send_latency_ms_total / (sent + failed).
+var (
+	metrics          = expvar.NewMap("mailer")
+	queueDepth       = new(expvar.Int)
+	inFlight         = new(expvar.Int)
+	sentTotal        = new(expvar.Int)
+	failedTotal      = new(expvar.Int)
+	sendLatencyTotal = new(expvar.Float)
+	sendLatencyLast  = new(expvar.Float)
+)
+
+func init() {
+	metrics.Set("queue_depth", queueDepth)
+	metrics.Set("in_flight", inFlight)
+	metrics.Set("sent", sentTotal)
+	metrics.Set("failed", failedTotal)
+	metrics.Set("send_latency_ms_total", sendLatencyTotal)
+	metrics.Set("send_latency_ms_last", sendLatencyLast)
+}
+
+type sendJob struct {
+	msg  *mail.Msg
+	done chan<- error
+}
+
+// sendPool delivers messages on a fixed number of workers so a big trip
+// does not send its emails one after the other, nor open one connection per
+// participant. The queue is bounded: once it is full, senders block until a
+// worker frees a slot, which slows callers down when the SMTP server does.
+type sendPool struct {
+	jobs chan sendJob
+}
+
+func newSendPool(workers, queueSize int, deliver func(*mail.Msg) error) *sendPool {
+	if workers < 1 {
+		workers = defaultWorkers
+	}
+	if queueSize < 1 {
+		queueSize = defaultQueueSize
+	}
+
+	p := &sendPool{jobs: make(chan sendJob, queueSize)}
+	for range workers {
+		go p.work(deliver)
+	}
+	return p
+}
+
+func (p *sendPool) work(deliver func(*mail.Msg) error) {
+	for job := range p.jobs {
+		queueDepth.Add(-1)
+		inFlight.Add(1)
+
+		start := time.Now()
+		err := deliver(job.msg)
+		elapsed := float64(time.Since(start)) / float64(time.Millisecond)
+
+		inFlight.Add(-1)
+		sendLatencyTotal.Add(elapsed)
+		sendLatencyLast.Set(elapsed)
+		if err != nil {
+			failedTotal.Add(1)
+		} else {
+			sentTotal.Add(1)
+		}
+
+		job.done <- err
+	}
+}
+
+// send queues the messages and waits for all of them to be delivered,
+// returning the errors of the ones that failed.
+func (p *sendPool) send(msgs ...*mail.Msg) error {
+	done := make(chan error, len(msgs))
+	for _, msg := range msgs {
+		queueDepth.Add(1)
+		p.jobs <- sendJob{msg: msg, done: done}
+	}
+
+	var errs []error
+	for range msgs {
+		if err := <-done; err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// SendCounts returns how many emails were sent and how many failed since the
+// process started.
+func (mp Mailpit) SendCounts() (sent, failed int64) {
+	return sentTotal.Value(), failedTotal.Value()
+}
@@ -0,0 +1,77 @@
+package moderation
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"regexp"
+	"strings"
+	"unicode"
+)
+
+// Reasons a shared trip is held for review.
+const (
+	ReasonProfanity = "profanity"
+	ReasonLinkSpam  = "link spam"
+	ReasonReported  = "reported"
+)
+
+// maxLinks is how many URLs titles and notes can carry together before they
+// are taken for spam. Links meant to be shared go in the trip links, which
+// are not counted.
+const maxLinks = 3
+
+var urlPattern = regexp.MustCompile(`(?i)\b(?:https?://|www\.)\S+`)
+
+// profanity are the words, in English and Portuguese, that hold a text for
+// review. Words are matched whole, after undoing the usual digit and symbol
+// stand-ins for letters.
+var profanity = map[string]bool{
+	"asshole": true, "bastard": true, "bitch": true, "cunt": true,
+	"fuck": true, "fucker": true, "fucking": true, "motherfucker": true,
+	"shit": true, "slut": true, "whore": true,
+	"arrombado": true, "buceta": true, "caralho": true, "cuzão": true,
+	"foda": true, "fodase": true, "merda": true, "porra": true,
+	"puta": true, "puto": true,
+}
+
+var standIns = strings.NewReplacer("0", "o", "1", "i", "3", "e", "4", "a", "5", "s", "7", "t", "@", "a", "$", "s")
+
+// Review runs the heuristics over the texts, returning the reason they should
+// be held for review, if any.
+func Review(texts []string) (string, bool) {
+	links := 0
+	for _, text := range texts {
+		if profane(text) {
+			return ReasonProfanity, true
+		}
+		links += len(urlPattern.FindAllStringIndex(text, -1))
+	}
+
+	if links > maxLinks {
+		return ReasonLinkSpam, true
+	}
+	return "", false
+}
+
+// Digest sums up the texts, telling whether they changed since they were
+// reviewed.
+func Digest(texts []string) string {
+	h := sha256.New()
+	for _, text := range texts {
+		h.Write([]byte(text))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func profane(text string) bool {
+	words := strings.FieldsFunc(standIns.Replace(strings.ToLower(text)), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+	for _, word := range words {
+		if profanity[word] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+package ocr
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Result holds what could be read from a receipt. Fields that could not be
+// extracted are left empty so the user fills them in before confirming.
+type Result struct {
+	Merchant    string
+	AmountCents int64
+	SpentAt     time.Time
+}
+
+// Provider reads a receipt image. Implementations should return an empty
+// Result, not an error, when the image is readable but has no useful text.
+type Provider interface {
+	Extract(ctx context.Context, contentType string, image []byte) (Result, error)
+}
+
+// None is the provider used when no OCR engine is configured. Receipts are
+// still stored, but nothing is prefilled.
+type None struct{}
+
+func (None) Extract(context.Context, string, []byte) (Result, error) {
+	return Result{}, nil
+}
+
+var (
+	totalLineRE = regexp.MustCompile(`(?i)\b(total|valor a pagar|amount due)\b`)
+	amountRE    = regexp.MustCompile(`(\d{1,3}(?:[.,\s]\d{3})*|\d+)[.,](\d{2})\b`)
+	dateRE      = regexp.MustCompile(`\b(\d{2})[/.-](\d{2})[/.-](\d{4})\b|\b(\d{4})-(\d{2})-(\d{2})\b`)
+)
+
+// ParseText extracts receipt fields from raw OCR text. The merchant is assumed
+// to be the first line, the amount is taken from the last "total" line (or the
+// largest amount found) and the date is the first one found.
+func ParseText(text string) Result {
+	var res Result
+
+	lines := strings.Split(text, "\n")
+	for _, line := range lines {
+		if line = strings.TrimSpace(line); line != "" {
+			res.Merchant = line
+			break
+		}
+	}
+
+	var largest int64
+	for _, line := range lines {
+		for _, m := range amountRE.FindAllStringSubmatch(line, -1) {
+			cents := parseCents(m[1], m[2])
+			if totalLineRE.MatchString(line) {
+				res.AmountCents = cents
+			}
+			largest = max(largest, cents)
+		}
+	}
+	if res.AmountCents == 0 {
+		res.AmountCents = largest
+	}
+
+	if m := dateRE.FindStringSubmatch(text); m != nil {
+		layout, value := "02/01/2006", m[1]+"/"+m[2]+"/"+m[3]
+		if m[4] != "" {
+			layout, value = time.DateOnly, m[4]+"-"+m[5]+"-"+m[6]
+		}
+		if t, err := time.Parse(layout, value); err == nil {
+			res.SpentAt = t
+		}
+	}
+
+	return res
+}
+
+func parseCents(units, cents string) int64 {
+	units = strings.NewReplacer(".", "", ",", "", " ", "").Replace(units)
+	v, err := strconv.ParseInt(units+cents, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return v
+}
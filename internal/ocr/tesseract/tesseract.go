@@ -0,0 +1,36 @@
+package tesseract
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os/exec"
+
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/ocr"
+)
+
+// Tesseract reads receipts using the tesseract CLI, which must be installed
+// on the host running the API.
+type Tesseract struct {
+	bin  string
+	lang string
+}
+
+func NewTesseract(bin, lang string) Tesseract {
+	return Tesseract{bin, lang}
+}
+
+func (t Tesseract) Extract(ctx context.Context, contentType string, image []byte) (ocr.Result, error) {
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, t.bin, "stdin", "stdout", "-l", t.lang)
+	cmd.Stdin = bytes.NewReader(image)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		return ocr.Result{}, fmt.Errorf("tesseract: failed to read %s receipt: %w: %s", contentType, err, stderr.String())
+	}
+
+	return ocr.ParseText(stdout.String()), nil
+}
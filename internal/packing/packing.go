@@ -0,0 +1,97 @@
+package packing
+
+import (
+	"fmt"
+
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/weather"
+)
+
+const (
+	CategoryDocuments = "documentos"
+	CategoryClothes   = "roupas"
+	CategoryHygiene   = "higiene"
+	CategoryHealth    = "saude"
+	CategoryGadgets   = "eletronicos"
+)
+
+// Item is an item of a packing list. Key names the template it comes from,
+// the same for a trip whatever its length, while the title can count how
+// many to pack.
+type Item struct {
+	Key      string
+	Title    string
+	Category string
+}
+
+var essentials = []Item{
+	{"id_card", "Documento de identidade", CategoryDocuments},
+	{"cards_and_cash", "Cartões e dinheiro", CategoryDocuments},
+	{"bookings", "Reservas e passagens", CategoryDocuments},
+	{"toothbrush", "Escova e pasta de dente", CategoryHygiene},
+	{"deodorant", "Desodorante", CategoryHygiene},
+	{"medicines", "Remédios de uso pessoal", CategoryHealth},
+	{"phone_charger", "Carregador de celular", CategoryGadgets},
+}
+
+var cold = []Item{
+	{"coat", "Casaco", CategoryClothes},
+	{"sweaters", "Blusas de frio", CategoryClothes},
+	{"scarf_and_gloves", "Cachecol e luvas", CategoryClothes},
+	{"lip_balm", "Hidratante labial", CategoryHygiene},
+}
+
+var hot = []Item{
+	{"sunscreen", "Protetor solar", CategoryHealth},
+	{"sunglasses", "Óculos de sol", CategoryClothes},
+	{"hat", "Boné ou chapéu", CategoryClothes},
+	{"swimwear", "Roupa de banho", CategoryClothes},
+	{"water_bottle", "Garrafa de água", CategoryHealth},
+}
+
+var rainy = []Item{
+	{"umbrella", "Guarda-chuva", CategoryClothes},
+	{"raincoat", "Capa de chuva", CategoryClothes},
+	{"waterproof_shoes", "Calçado impermeável", CategoryClothes},
+}
+
+var long = []Item{
+	{"laundry_soap", "Sabão para lavar roupa", CategoryHygiene},
+	{"plug_adapter", "Adaptador de tomada", CategoryGadgets},
+}
+
+// longTripDays is the trip length from which laundry and extra gear is
+// suggested instead of packing one outfit per day.
+const longTripDays = 7
+
+// Generate builds a starter packing list for a trip of the given number of
+// days, adjusted to the climate expected at the destination.
+func Generate(days int, climate weather.Climate) []Item {
+	if days < 1 {
+		days = 1
+	}
+
+	outfits := min(days, longTripDays)
+	items := make([]Item, 0, len(essentials)+8)
+	items = append(items, essentials...)
+	items = append(items,
+		Item{"t_shirts", fmt.Sprintf("Camisetas (%d)", outfits), CategoryClothes},
+		Item{"underwear", fmt.Sprintf("Roupas íntimas (%d)", outfits+1), CategoryClothes},
+		Item{"socks", fmt.Sprintf("Meias (%d)", outfits+1), CategoryClothes},
+		Item{"pajamas", "Pijama", CategoryClothes},
+	)
+
+	if days >= longTripDays {
+		items = append(items, long...)
+	}
+	if climate.IsCold() {
+		items = append(items, cold...)
+	}
+	if climate.IsHot() {
+		items = append(items, hot...)
+	}
+	if climate.IsRainy() {
+		items = append(items, rainy...)
+	}
+
+	return items
+}
@@ -0,0 +1,24 @@
+package pgstore
+
+import "github.com/google/uuid"
+
+// AttachmentKey is where the file of an attachment is kept in the storage.
+func AttachmentKey(tripID, attachmentID uuid.UUID) string {
+	return "trips/" + tripID.String() + "/attachments/" + attachmentID.String()
+}
+
+// Attachment scan statuses. Attachments are pending until their file is
+// uploaded and scanned, and unscanned when no scanner is configured.
+const (
+	ScanPending   = "pending"
+	ScanClean     = "clean"
+	ScanInfected  = "infected"
+	ScanFailed    = "failed"
+	ScanUnscanned = "unscanned"
+)
+
+// QuarantineKey is where the file of an attachment found infected is moved
+// to in the storage, out of reach of the trip.
+func QuarantineKey(tripID, attachmentID uuid.UUID) string {
+	return "quarantine/" + AttachmentKey(tripID, attachmentID)
+}
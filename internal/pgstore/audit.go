@@ -0,0 +1,56 @@
+package pgstore
+
+import "time"
+
+// Actions recorded in audit_events.
+const (
+	// AuditParticipantsConfirmed is the owner confirming participants on
+	// their behalf.
+	AuditParticipantsConfirmed = "participants.confirmed_by_owner"
+
+	// AuditActivityAdded is an activity making it into the itinerary, when
+	// created, once approved if it went over budget, or restored from the
+	// trash.
+	AuditActivityAdded = "activity.added"
+
+	// AuditActivityRemoved is an activity moved to the trip trash.
+	AuditActivityRemoved = "activity.removed"
+
+	// AuditLinkAdded is a link added to the trip, or restored from the trash.
+	AuditLinkAdded = "link.added"
+
+	// AuditLinkRemoved is a link moved to the trip trash.
+	AuditLinkRemoved = "link.removed"
+
+	// AuditTripDatesChanged is the trip moved to other dates, edited or
+	// picked from a date poll.
+	AuditTripDatesChanged = "trip.dates_changed"
+
+	// AuditTripImported is the trip brought from another instance, with the
+	// history it had there recorded before it.
+	AuditTripImported = "trip.imported"
+
+	// AuditTripStatusChanged is the trip moved to another status, by an owner
+	// or by its dates, recorded with a TripStatusChange.
+	AuditTripStatusChanged = "trip.status_changed"
+)
+
+// ItineraryActions are the actions changing what the itinerary of a trip
+// shows, recorded with an ItineraryChange.
+var ItineraryActions = []string{AuditActivityAdded, AuditActivityRemoved, AuditLinkAdded, AuditLinkRemoved, AuditTripDatesChanged}
+
+// TripStatusChange are the details of AuditTripStatusChanged.
+type TripStatusChange struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
+
+// ItineraryChange are the details of the actions changing a trip itinerary.
+// Only the fields of the action are set.
+type ItineraryChange struct {
+	Title    string     `json:"title,omitempty"`
+	URL      string     `json:"url,omitempty"`
+	OccursAt *time.Time `json:"occurs_at,omitempty"`
+	StartsAt *time.Time `json:"starts_at,omitempty"`
+	EndsAt   *time.Time `json:"ends_at,omitempty"`
+}
@@ -0,0 +1,33 @@
+package pgstore
+
+import (
+	"errors"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// ErrActivityPaid is returned when an activity was already paid, its cost
+// recorded as an expense.
+var ErrActivityPaid = errors.New("pgstore: activity already paid")
+
+// Plan statuses of activities and lodgings. Only approved plans count
+// towards the trip budget.
+const (
+	PlanApproved = "approved"
+	PlanPending  = "pending"
+)
+
+// PlanStatus returns the status of a new plan costing costCents on a trip
+// with the given per person budget, headcount and already committed costs:
+// plans that do not fit the remaining budget wait for the owner approval.
+func PlanStatus(budgetPerPerson pgtype.Int8, people, committedCents, costCents int64) string {
+	if !budgetPerPerson.Valid || costCents == 0 {
+		return PlanApproved
+	}
+
+	remaining := budgetPerPerson.Int64*max(people, 1) - committedCents
+	if costCents > remaining {
+		return PlanPending
+	}
+	return PlanApproved
+}
@@ -9,6 +9,566 @@ import (
 	"context"
 )
 
+// iteratorForInsertChecklistItems implements pgx.CopyFromSource.
+type iteratorForInsertChecklistItems struct {
+	rows                 []InsertChecklistItemsParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForInsertChecklistItems) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForInsertChecklistItems) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].TripID,
+		r.rows[0].Title,
+		r.rows[0].Category,
+		r.rows[0].TemplateKey,
+	}, nil
+}
+
+func (r iteratorForInsertChecklistItems) Err() error {
+	return nil
+}
+
+func (q *Queries) InsertChecklistItems(ctx context.Context, arg []InsertChecklistItemsParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"checklist_items"}, []string{"trip_id", "title", "category", "template_key"}, &iteratorForInsertChecklistItems{rows: arg})
+}
+
+// iteratorForInsertDatePollOptions implements pgx.CopyFromSource.
+type iteratorForInsertDatePollOptions struct {
+	rows                 []InsertDatePollOptionsParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForInsertDatePollOptions) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForInsertDatePollOptions) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].TripID,
+		r.rows[0].StartsAt,
+		r.rows[0].EndsAt,
+	}, nil
+}
+
+func (r iteratorForInsertDatePollOptions) Err() error {
+	return nil
+}
+
+func (q *Queries) InsertDatePollOptions(ctx context.Context, arg []InsertDatePollOptionsParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"date_poll_options"}, []string{"trip_id", "starts_at", "ends_at"}, &iteratorForInsertDatePollOptions{rows: arg})
+}
+
+// iteratorForInsertExpenseSplits implements pgx.CopyFromSource.
+type iteratorForInsertExpenseSplits struct {
+	rows                 []InsertExpenseSplitsParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForInsertExpenseSplits) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForInsertExpenseSplits) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].ExpenseID,
+		r.rows[0].ParticipantID,
+		r.rows[0].AmountCents,
+	}, nil
+}
+
+func (r iteratorForInsertExpenseSplits) Err() error {
+	return nil
+}
+
+func (q *Queries) InsertExpenseSplits(ctx context.Context, arg []InsertExpenseSplitsParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"expense_splits"}, []string{"expense_id", "participant_id", "amount_cents"}, &iteratorForInsertExpenseSplits{rows: arg})
+}
+
+// iteratorForInsertImportedActivities implements pgx.CopyFromSource.
+type iteratorForInsertImportedActivities struct {
+	rows                 []InsertImportedActivitiesParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForInsertImportedActivities) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForInsertImportedActivities) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].ID,
+		r.rows[0].TripID,
+		r.rows[0].Title,
+		r.rows[0].OccursAt,
+		r.rows[0].Tags,
+		r.rows[0].DurationMinutes,
+		r.rows[0].CostCents,
+		r.rows[0].Status,
+		r.rows[0].Latitude,
+		r.rows[0].Longitude,
+		r.rows[0].InviteSequence,
+		r.rows[0].OrganizerID,
+		r.rows[0].Currency,
+		r.rows[0].ExpenseID,
+	}, nil
+}
+
+func (r iteratorForInsertImportedActivities) Err() error {
+	return nil
+}
+
+func (q *Queries) InsertImportedActivities(ctx context.Context, arg []InsertImportedActivitiesParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"activities"}, []string{"id", "trip_id", "title", "occurs_at", "tags", "duration_minutes", "cost_cents", "status", "latitude", "longitude", "invite_sequence", "organizer_id", "currency", "expense_id"}, &iteratorForInsertImportedActivities{rows: arg})
+}
+
+// iteratorForInsertImportedAttachments implements pgx.CopyFromSource.
+type iteratorForInsertImportedAttachments struct {
+	rows                 []InsertImportedAttachmentsParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForInsertImportedAttachments) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForInsertImportedAttachments) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].ID,
+		r.rows[0].TripID,
+		r.rows[0].Filename,
+		r.rows[0].ContentType,
+		r.rows[0].SizeBytes,
+		r.rows[0].UploadedAt,
+		r.rows[0].CreatedAt,
+		r.rows[0].UploadedBy,
+		r.rows[0].ScanStatus,
+		r.rows[0].ScanSignature,
+		r.rows[0].ScannedAt,
+	}, nil
+}
+
+func (r iteratorForInsertImportedAttachments) Err() error {
+	return nil
+}
+
+func (q *Queries) InsertImportedAttachments(ctx context.Context, arg []InsertImportedAttachmentsParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"attachments"}, []string{"id", "trip_id", "filename", "content_type", "size_bytes", "uploaded_at", "created_at", "uploaded_by", "scan_status", "scan_signature", "scanned_at"}, &iteratorForInsertImportedAttachments{rows: arg})
+}
+
+// iteratorForInsertImportedAuditEvents implements pgx.CopyFromSource.
+type iteratorForInsertImportedAuditEvents struct {
+	rows                 []InsertImportedAuditEventsParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForInsertImportedAuditEvents) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForInsertImportedAuditEvents) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].ID,
+		r.rows[0].TripID,
+		r.rows[0].Action,
+		r.rows[0].Details,
+		r.rows[0].RemoteAddr,
+		r.rows[0].CreatedAt,
+	}, nil
+}
+
+func (r iteratorForInsertImportedAuditEvents) Err() error {
+	return nil
+}
+
+func (q *Queries) InsertImportedAuditEvents(ctx context.Context, arg []InsertImportedAuditEventsParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"audit_events"}, []string{"id", "trip_id", "action", "details", "remote_addr", "created_at"}, &iteratorForInsertImportedAuditEvents{rows: arg})
+}
+
+// iteratorForInsertImportedChecklistItems implements pgx.CopyFromSource.
+type iteratorForInsertImportedChecklistItems struct {
+	rows                 []InsertImportedChecklistItemsParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForInsertImportedChecklistItems) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForInsertImportedChecklistItems) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].ID,
+		r.rows[0].TripID,
+		r.rows[0].Title,
+		r.rows[0].Category,
+		r.rows[0].IsChecked,
+		r.rows[0].TemplateKey,
+	}, nil
+}
+
+func (r iteratorForInsertImportedChecklistItems) Err() error {
+	return nil
+}
+
+func (q *Queries) InsertImportedChecklistItems(ctx context.Context, arg []InsertImportedChecklistItemsParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"checklist_items"}, []string{"id", "trip_id", "title", "category", "is_checked", "template_key"}, &iteratorForInsertImportedChecklistItems{rows: arg})
+}
+
+// iteratorForInsertImportedExpenses implements pgx.CopyFromSource.
+type iteratorForInsertImportedExpenses struct {
+	rows                 []InsertImportedExpensesParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForInsertImportedExpenses) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForInsertImportedExpenses) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].ID,
+		r.rows[0].TripID,
+		r.rows[0].PaidBy,
+		r.rows[0].Description,
+		r.rows[0].Category,
+		r.rows[0].AmountCents,
+		r.rows[0].SpentAt,
+	}, nil
+}
+
+func (r iteratorForInsertImportedExpenses) Err() error {
+	return nil
+}
+
+func (q *Queries) InsertImportedExpenses(ctx context.Context, arg []InsertImportedExpensesParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"expenses"}, []string{"id", "trip_id", "paid_by", "description", "category", "amount_cents", "spent_at"}, &iteratorForInsertImportedExpenses{rows: arg})
+}
+
+// iteratorForInsertImportedLinks implements pgx.CopyFromSource.
+type iteratorForInsertImportedLinks struct {
+	rows                 []InsertImportedLinksParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForInsertImportedLinks) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForInsertImportedLinks) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].ID,
+		r.rows[0].TripID,
+		r.rows[0].Title,
+		r.rows[0].Url,
+	}, nil
+}
+
+func (r iteratorForInsertImportedLinks) Err() error {
+	return nil
+}
+
+func (q *Queries) InsertImportedLinks(ctx context.Context, arg []InsertImportedLinksParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"links"}, []string{"id", "trip_id", "title", "url"}, &iteratorForInsertImportedLinks{rows: arg})
+}
+
+// iteratorForInsertImportedLodgings implements pgx.CopyFromSource.
+type iteratorForInsertImportedLodgings struct {
+	rows                 []InsertImportedLodgingsParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForInsertImportedLodgings) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForInsertImportedLodgings) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].ID,
+		r.rows[0].TripID,
+		r.rows[0].Name,
+		r.rows[0].Address,
+		r.rows[0].CheckIn,
+		r.rows[0].CheckOut,
+		r.rows[0].CostCents,
+		r.rows[0].Status,
+	}, nil
+}
+
+func (r iteratorForInsertImportedLodgings) Err() error {
+	return nil
+}
+
+func (q *Queries) InsertImportedLodgings(ctx context.Context, arg []InsertImportedLodgingsParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"lodgings"}, []string{"id", "trip_id", "name", "address", "check_in", "check_out", "cost_cents", "status"}, &iteratorForInsertImportedLodgings{rows: arg})
+}
+
+// iteratorForInsertImportedParticipants implements pgx.CopyFromSource.
+type iteratorForInsertImportedParticipants struct {
+	rows                 []InsertImportedParticipantsParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForInsertImportedParticipants) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForInsertImportedParticipants) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].ID,
+		r.rows[0].TripID,
+		r.rows[0].Email,
+		r.rows[0].IsConfirmed,
+		r.rows[0].Name,
+		r.rows[0].Status,
+		r.rows[0].InvitedAt,
+		r.rows[0].Role,
+	}, nil
+}
+
+func (r iteratorForInsertImportedParticipants) Err() error {
+	return nil
+}
+
+func (q *Queries) InsertImportedParticipants(ctx context.Context, arg []InsertImportedParticipantsParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"participants"}, []string{"id", "trip_id", "email", "is_confirmed", "name", "status", "invited_at", "role"}, &iteratorForInsertImportedParticipants{rows: arg})
+}
+
+// iteratorForInsertImportedTasks implements pgx.CopyFromSource.
+type iteratorForInsertImportedTasks struct {
+	rows                 []InsertImportedTasksParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForInsertImportedTasks) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForInsertImportedTasks) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].ID,
+		r.rows[0].TripID,
+		r.rows[0].Title,
+		r.rows[0].DueOn,
+		r.rows[0].AssigneeID,
+		r.rows[0].IsDone,
+		r.rows[0].OverdueNotifiedAt,
+	}, nil
+}
+
+func (r iteratorForInsertImportedTasks) Err() error {
+	return nil
+}
+
+func (q *Queries) InsertImportedTasks(ctx context.Context, arg []InsertImportedTasksParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"tasks"}, []string{"id", "trip_id", "title", "due_on", "assignee_id", "is_done", "overdue_notified_at"}, &iteratorForInsertImportedTasks{rows: arg})
+}
+
+// iteratorForInsertImportedTransports implements pgx.CopyFromSource.
+type iteratorForInsertImportedTransports struct {
+	rows                 []InsertImportedTransportsParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForInsertImportedTransports) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForInsertImportedTransports) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].ID,
+		r.rows[0].TripID,
+		r.rows[0].Mode,
+		r.rows[0].Origin,
+		r.rows[0].Destination,
+		r.rows[0].DepartsAt,
+		r.rows[0].ArrivesAt,
+	}, nil
+}
+
+func (r iteratorForInsertImportedTransports) Err() error {
+	return nil
+}
+
+func (q *Queries) InsertImportedTransports(ctx context.Context, arg []InsertImportedTransportsParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"transports"}, []string{"id", "trip_id", "mode", "origin", "destination", "departs_at", "arrives_at"}, &iteratorForInsertImportedTransports{rows: arg})
+}
+
+// iteratorForInsertRoomAssignments implements pgx.CopyFromSource.
+type iteratorForInsertRoomAssignments struct {
+	rows                 []InsertRoomAssignmentsParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForInsertRoomAssignments) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForInsertRoomAssignments) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].RoomID,
+		r.rows[0].LodgingID,
+		r.rows[0].ParticipantID,
+	}, nil
+}
+
+func (r iteratorForInsertRoomAssignments) Err() error {
+	return nil
+}
+
+func (q *Queries) InsertRoomAssignments(ctx context.Context, arg []InsertRoomAssignmentsParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"room_assignments"}, []string{"room_id", "lodging_id", "participant_id"}, &iteratorForInsertRoomAssignments{rows: arg})
+}
+
+// iteratorForInsertSurveyQuestions implements pgx.CopyFromSource.
+type iteratorForInsertSurveyQuestions struct {
+	rows                 []InsertSurveyQuestionsParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForInsertSurveyQuestions) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForInsertSurveyQuestions) Values() ([]interface{}, error) {
+	return []interface{}{
+		r.rows[0].TripID,
+		r.rows[0].Position,
+		r.rows[0].Prompt,
+		r.rows[0].Kind,
+	}, nil
+}
+
+func (r iteratorForInsertSurveyQuestions) Err() error {
+	return nil
+}
+
+func (q *Queries) InsertSurveyQuestions(ctx context.Context, arg []InsertSurveyQuestionsParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"survey_questions"}, []string{"trip_id", "position", "prompt", "kind"}, &iteratorForInsertSurveyQuestions{rows: arg})
+}
+
 // iteratorForInviteParticipantsToTrip implements pgx.CopyFromSource.
 type iteratorForInviteParticipantsToTrip struct {
 	rows                 []InviteParticipantsToTripParams
@@ -31,6 +591,8 @@ func (r iteratorForInviteParticipantsToTrip) Values() ([]interface{}, error) {
 	return []interface{}{
 		r.rows[0].TripID,
 		r.rows[0].Email,
+		r.rows[0].Name,
+		r.rows[0].Status,
 	}, nil
 }
 
@@ -39,5 +601,5 @@ func (r iteratorForInviteParticipantsToTrip) Err() error {
 }
 
 func (q *Queries) InviteParticipantsToTrip(ctx context.Context, arg []InviteParticipantsToTripParams) (int64, error) {
-	return q.db.CopyFrom(ctx, []string{"participants"}, []string{"trip_id", "email"}, &iteratorForInviteParticipantsToTrip{rows: arg})
+	return q.db.CopyFrom(ctx, []string{"participants"}, []string{"trip_id", "email", "name", "status"}, &iteratorForInviteParticipantsToTrip{rows: arg})
 }
@@ -0,0 +1,20 @@
+package pgstore
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+)
+
+// NewDatePollToken returns a random, URL safe token that lets a participant
+// answer the date poll of their trip without any other credential.
+func NewDatePollToken() (string, error) {
+	return newToken()
+}
+
+func newToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
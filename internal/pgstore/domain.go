@@ -0,0 +1,141 @@
+package pgstore
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/domain"
+)
+
+// Domain returns the trip as the rest of the app reasons about it.
+func (t Trip) Domain() domain.Trip {
+	return domain.Trip{
+		ID:                   t.ID,
+		Destination:          t.Destination,
+		OwnerEmail:           t.OwnerEmail,
+		OwnerName:            t.OwnerName,
+		Status:               domain.TripStatus(t.Status),
+		StartsAt:             t.StartsAt.Time,
+		EndsAt:               t.EndsAt.Time,
+		MaxParticipants:      int(t.MaxParticipants.Int32),
+		BudgetPerPersonCents: t.BudgetPerPersonCents.Int64,
+		Currency:             t.Settings.Currency,
+		ItineraryAttachment:  t.Settings.ItineraryAttachment,
+		TimeZone:             t.Settings.Location(),
+		ArchivedAt:           t.ArchivedAt.Time,
+	}
+}
+
+// Domain returns the activity as the rest of the app reasons about it.
+func (a Activity) Domain() domain.Activity {
+	act := domain.Activity{
+		ID:          a.ID,
+		TripID:      a.TripID,
+		Title:       a.Title,
+		OccursAt:    a.OccursAt.Time,
+		Duration:    time.Duration(a.DurationMinutes.Int32) * time.Minute,
+		Tags:        a.Tags,
+		CostCents:   a.CostCents,
+		Currency:    a.Currency.String,
+		Status:      domain.PlanStatus(a.Status),
+		OrganizerID: a.OrganizerID.Bytes,
+		ExpenseID:   a.ExpenseID.Bytes,
+	}
+	if a.Latitude.Valid && a.Longitude.Valid {
+		act.Location = &domain.Coordinates{Latitude: a.Latitude.Float64, Longitude: a.Longitude.Float64}
+	}
+	return act
+}
+
+// Domain returns the link as the rest of the app reasons about it.
+func (l Link) Domain() domain.Link {
+	return domain.Link{ID: l.ID, TripID: l.TripID, Title: l.Title, URL: l.Url}
+}
+
+// Domain returns the participant as the rest of the app reasons about it.
+func (p Participant) Domain() domain.Participant {
+	return domain.Participant{
+		ID:        p.ID,
+		TripID:    p.TripID,
+		Email:     p.Email,
+		Name:      p.Name.String,
+		Confirmed: p.IsConfirmed,
+		Status:    domain.ParticipantStatus(p.Status),
+		Role:      domain.Role(p.Role),
+		InvitedAt: p.InvitedAt.Time,
+		GroupID:   p.GroupID.Bytes,
+	}
+}
+
+// Domain returns the lodging as the rest of the app reasons about it.
+func (l Lodging) Domain() domain.Lodging {
+	return domain.Lodging{
+		ID:        l.ID,
+		TripID:    l.TripID,
+		Name:      l.Name,
+		Address:   l.Address,
+		CheckIn:   l.CheckIn.Time,
+		CheckOut:  l.CheckOut.Time,
+		CostCents: l.CostCents,
+		Status:    domain.PlanStatus(l.Status),
+	}
+}
+
+// Domain returns the transport as the rest of the app reasons about it.
+func (t Transport) Domain() domain.Transport {
+	return domain.Transport{
+		ID:          t.ID,
+		TripID:      t.TripID,
+		Mode:        domain.TransportMode(t.Mode),
+		Origin:      t.Origin,
+		Destination: t.Destination,
+		DepartsAt:   t.DepartsAt.Time,
+		ArrivesAt:   t.ArrivesAt.Time,
+	}
+}
+
+// TripActivities returns the activities of a trip, in the order
+// GetTripActivities does.
+func (q *Queries) TripActivities(ctx context.Context, tripID uuid.UUID) ([]domain.Activity, error) {
+	rows, err := q.GetTripActivities(ctx, tripID)
+	return toDomain(rows, Activity.Domain), err
+}
+
+// TripLinks returns the links of a trip, in the order GetTripLinks does.
+func (q *Queries) TripLinks(ctx context.Context, tripID uuid.UUID) ([]domain.Link, error) {
+	rows, err := q.GetTripLinks(ctx, tripID)
+	return toDomain(rows, Link.Domain), err
+}
+
+// TripParticipants returns the participants of a trip, in the order
+// GetParticipants does.
+func (q *Queries) TripParticipants(ctx context.Context, tripID uuid.UUID) ([]domain.Participant, error) {
+	rows, err := q.GetParticipants(ctx, tripID)
+	return toDomain(rows, Participant.Domain), err
+}
+
+// TripLodgings returns the lodgings of a trip, in the order GetTripLodgings
+// does.
+func (q *Queries) TripLodgings(ctx context.Context, tripID uuid.UUID) ([]domain.Lodging, error) {
+	rows, err := q.GetTripLodgings(ctx, tripID)
+	return toDomain(rows, Lodging.Domain), err
+}
+
+// TripTransports returns the transports of a trip, in the order
+// GetTripTransports does.
+func (q *Queries) TripTransports(ctx context.Context, tripID uuid.UUID) ([]domain.Transport, error) {
+	rows, err := q.GetTripTransports(ctx, tripID)
+	return toDomain(rows, Transport.Domain), err
+}
+
+func toDomain[R, D any](rows []R, convert func(R) D) []D {
+	if rows == nil {
+		return nil
+	}
+	out := make([]D, len(rows))
+	for i, row := range rows {
+		out[i] = convert(row)
+	}
+	return out
+}
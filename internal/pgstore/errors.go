@@ -0,0 +1,125 @@
+package pgstore
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// Errors the queries of a store made with NewStore fail with, so callers do
+// not need to know about pgx or SQLSTATE codes. The pgx error is still
+// wrapped and can be inspected.
+var (
+	ErrNotFound   = errors.New("pgstore: not found")
+	ErrDuplicate  = errors.New("pgstore: already exists")
+	ErrForeignKey = errors.New("pgstore: references a missing row")
+)
+
+// SQLSTATE codes of the constraint violations translated.
+const (
+	foreignKeyViolation = "23503"
+	uniqueViolation     = "23505"
+)
+
+// Error is a database error classified as one of the pgstore errors.
+type Error struct {
+	Kind error
+	Err  error
+}
+
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+func (e *Error) Unwrap() []error {
+	return []error{e.Kind, e.Err}
+}
+
+// NewStore returns the queries the app runs. They are the same as New, but
+// fail with the pgstore errors.
+func NewStore(db DBTX) *Queries {
+	return New(errorsDB{db})
+}
+
+// Tx is WithTx for stores made with NewStore, keeping the errors translated
+// inside the transaction.
+func (q *Queries) Tx(tx pgx.Tx) *Queries {
+	return New(errorsDB{tx})
+}
+
+func translate(err error) error {
+	if err == nil {
+		return nil
+	}
+
+	var translated *Error
+	if errors.As(err, &translated) {
+		return err
+	}
+
+	if errors.Is(err, pgx.ErrNoRows) {
+		return &Error{ErrNotFound, err}
+	}
+
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		switch pgErr.Code {
+		case uniqueViolation:
+			return &Error{ErrDuplicate, err}
+		case foreignKeyViolation:
+			return &Error{ErrForeignKey, err}
+		}
+	}
+
+	return err
+}
+
+// errorsDB translates the errors of every query it runs, including the ones
+// only seen when scanning the results.
+type errorsDB struct {
+	db DBTX
+}
+
+func (d errorsDB) Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error) {
+	tag, err := d.db.Exec(ctx, sql, args...)
+	return tag, translate(err)
+}
+
+func (d errorsDB) Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error) {
+	rows, err := d.db.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, translate(err)
+	}
+	return errorsRows{rows}, nil
+}
+
+func (d errorsDB) QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row {
+	return errorsRow{d.db.QueryRow(ctx, sql, args...)}
+}
+
+func (d errorsDB) CopyFrom(ctx context.Context, tableName pgx.Identifier, columnNames []string, rowSrc pgx.CopyFromSource) (int64, error) {
+	n, err := d.db.CopyFrom(ctx, tableName, columnNames, rowSrc)
+	return n, translate(err)
+}
+
+type errorsRow struct {
+	row pgx.Row
+}
+
+func (r errorsRow) Scan(dest ...any) error {
+	return translate(r.row.Scan(dest...))
+}
+
+type errorsRows struct {
+	pgx.Rows
+}
+
+func (r errorsRows) Scan(dest ...any) error {
+	return translate(r.Rows.Scan(dest...))
+}
+
+func (r errorsRows) Err() error {
+	return translate(r.Rows.Err())
+}
@@ -0,0 +1,33 @@
+package pgstore
+
+import (
+	"embed"
+	"io/fs"
+	"strconv"
+	"strings"
+)
+
+//go:embed migrations/*.sql
+var migrations embed.FS
+
+// LatestMigration is the version of the last migration, the one the database
+// is at once fully migrated. Migrations are numbered by the leading digits of
+// their file names.
+func LatestMigration() (int32, error) {
+	names, err := fs.Glob(migrations, "migrations/*.sql")
+	if err != nil {
+		return 0, err
+	}
+
+	var latest int32
+	for _, name := range names {
+		base := strings.TrimPrefix(name, "migrations/")
+		digits := strings.IndexFunc(base, func(r rune) bool { return r < '0' || r > '9' })
+		version, err := strconv.ParseInt(base[:digits], 10, 32)
+		if err != nil {
+			continue
+		}
+		latest = max(latest, int32(version))
+	}
+	return latest, nil
+}
@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS checklist_items (
+    "id"            uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "trip_id"       uuid                        NOT NULL,
+    "title"         VARCHAR(255)                NOT NULL,
+    "category"      VARCHAR(50)                 NOT NULL,
+    "is_checked"    BOOLEAN                     NOT NULL    DEFAULT FALSE,
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS checklist_items;
\ No newline at end of file
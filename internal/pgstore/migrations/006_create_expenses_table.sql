@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS expenses (
+    "id"            uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "trip_id"       uuid                        NOT NULL,
+    "paid_by"       uuid                        NOT NULL,
+    "description"   VARCHAR(255)                NOT NULL,
+    "category"      VARCHAR(50)                 NOT NULL,
+    "amount_cents"  BIGINT                      NOT NULL,
+    "spent_at"      TIMESTAMP                   NOT NULL,
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+    FOREIGN KEY (paid_by) REFERENCES participants(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS expenses;
\ No newline at end of file
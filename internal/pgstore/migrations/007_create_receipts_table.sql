@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS receipts (
+    "id"            uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "trip_id"       uuid                        NOT NULL,
+    "expense_id"    uuid,
+    "content_type"  VARCHAR(100)                NOT NULL,
+    "data"          BYTEA                       NOT NULL,
+    "merchant"      VARCHAR(255),
+    "amount_cents"  BIGINT,
+    "spent_at"      TIMESTAMP,
+    "created_at"    TIMESTAMP                   NOT NULL    DEFAULT NOW(),
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+    FOREIGN KEY (expense_id) REFERENCES expenses(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS receipts;
\ No newline at end of file
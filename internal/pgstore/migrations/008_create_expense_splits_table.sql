@@ -0,0 +1,37 @@
+CREATE TABLE IF NOT EXISTS expense_splits (
+    "id"                uuid        PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "expense_id"        uuid                    NOT NULL,
+    "participant_id"    uuid                    NOT NULL,
+    "amount_cents"      BIGINT                  NOT NULL,
+
+    UNIQUE (expense_id, participant_id),
+    FOREIGN KEY (expense_id) REFERENCES expenses(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+    FOREIGN KEY (participant_id) REFERENCES participants(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+-- Expenses created before splits existed were meant to be shared equally by
+-- everyone on the trip; the leftover cents go to the first participants.
+INSERT INTO expense_splits
+    ( "expense_id", "participant_id", "amount_cents" )
+SELECT
+    e.id,
+    p.id,
+    e.amount_cents / c.total + CASE WHEN p.position <= e.amount_cents % c.total THEN 1 ELSE 0 END
+FROM expenses e
+JOIN (
+    SELECT id, trip_id, ROW_NUMBER() OVER (PARTITION BY trip_id ORDER BY email) AS position
+    FROM participants
+) p ON p.trip_id = e.trip_id
+JOIN (
+    SELECT trip_id, COUNT(*) AS total
+    FROM participants
+    GROUP BY trip_id
+) c ON c.trip_id = e.trip_id;
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS expense_splits;
\ No newline at end of file
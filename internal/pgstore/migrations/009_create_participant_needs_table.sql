@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS participant_needs (
+    "participant_id"    uuid        PRIMARY KEY NOT NULL,
+    "dietary"           TEXT[]                  NOT NULL    DEFAULT '{}',
+    "accessibility"     TEXT[]                  NOT NULL    DEFAULT '{}',
+    "notes"             TEXT                    NOT NULL    DEFAULT '',
+    "updated_at"        TIMESTAMP               NOT NULL    DEFAULT NOW(),
+
+    FOREIGN KEY (participant_id) REFERENCES participants(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS participant_needs;
\ No newline at end of file
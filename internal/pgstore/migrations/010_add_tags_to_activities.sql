@@ -0,0 +1,7 @@
+ALTER TABLE activities
+    ADD COLUMN IF NOT EXISTS "tags" TEXT[] NOT NULL DEFAULT '{}';
+
+---- create above / drop below ----
+
+ALTER TABLE activities
+    DROP COLUMN IF EXISTS "tags";
\ No newline at end of file
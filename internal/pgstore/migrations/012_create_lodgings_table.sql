@@ -0,0 +1,16 @@
+CREATE TABLE IF NOT EXISTS lodgings (
+    "id"            uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "trip_id"       uuid                        NOT NULL,
+    "name"          VARCHAR(255)                NOT NULL,
+    "address"       VARCHAR(255)                NOT NULL,
+    "check_in"      TIMESTAMP                   NOT NULL,
+    "check_out"     TIMESTAMP                   NOT NULL,
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS lodgings;
\ No newline at end of file
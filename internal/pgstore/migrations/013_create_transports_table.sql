@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS transports (
+    "id"            uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "trip_id"       uuid                        NOT NULL,
+    "mode"          VARCHAR(50)                 NOT NULL,
+    "origin"        VARCHAR(255)                NOT NULL,
+    "destination"   VARCHAR(255)                NOT NULL,
+    "departs_at"    TIMESTAMP                   NOT NULL,
+    "arrives_at"    TIMESTAMP                   NOT NULL,
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS transports;
\ No newline at end of file
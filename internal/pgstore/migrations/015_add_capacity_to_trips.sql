@@ -0,0 +1,15 @@
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "max_participants" INTEGER;
+
+ALTER TABLE participants
+    ADD COLUMN IF NOT EXISTS "status"       VARCHAR(20) NOT NULL    DEFAULT 'invited',
+    ADD COLUMN IF NOT EXISTS "invited_at"   TIMESTAMP   NOT NULL    DEFAULT NOW();
+
+---- create above / drop below ----
+
+ALTER TABLE participants
+    DROP COLUMN IF EXISTS "invited_at",
+    DROP COLUMN IF EXISTS "status";
+
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS "max_participants";
\ No newline at end of file
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS companions (
+    "id"                uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "participant_id"    uuid                        NOT NULL,
+    "name"              VARCHAR(255)                NOT NULL,
+    "created_at"        TIMESTAMP                   NOT NULL    DEFAULT NOW(),
+
+    FOREIGN KEY (participant_id) REFERENCES participants(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS companions;
\ No newline at end of file
@@ -0,0 +1,39 @@
+CREATE TABLE IF NOT EXISTS date_poll_options (
+    "id"            uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "trip_id"       uuid                        NOT NULL,
+    "starts_at"     TIMESTAMP                   NOT NULL,
+    "ends_at"       TIMESTAMP                   NOT NULL,
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS date_poll_tokens (
+    "token"             VARCHAR(64)     PRIMARY KEY NOT NULL,
+    "participant_id"    uuid                        NOT NULL    UNIQUE,
+
+    FOREIGN KEY (participant_id) REFERENCES participants(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS date_poll_votes (
+    "option_id"         uuid            NOT NULL,
+    "participant_id"    uuid            NOT NULL,
+    "is_available"      BOOLEAN         NOT NULL,
+
+    PRIMARY KEY (option_id, participant_id),
+    FOREIGN KEY (option_id) REFERENCES date_poll_options(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+    FOREIGN KEY (participant_id) REFERENCES participants(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS date_poll_votes;
+DROP TABLE IF EXISTS date_poll_tokens;
+DROP TABLE IF EXISTS date_poll_options;
\ No newline at end of file
@@ -0,0 +1,23 @@
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "budget_per_person_cents" BIGINT;
+
+ALTER TABLE activities
+    ADD COLUMN IF NOT EXISTS "cost_cents"   BIGINT          NOT NULL    DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS "status"       VARCHAR(20)     NOT NULL    DEFAULT 'approved';
+
+ALTER TABLE lodgings
+    ADD COLUMN IF NOT EXISTS "cost_cents"   BIGINT          NOT NULL    DEFAULT 0,
+    ADD COLUMN IF NOT EXISTS "status"       VARCHAR(20)     NOT NULL    DEFAULT 'approved';
+
+---- create above / drop below ----
+
+ALTER TABLE lodgings
+    DROP COLUMN IF EXISTS "status",
+    DROP COLUMN IF EXISTS "cost_cents";
+
+ALTER TABLE activities
+    DROP COLUMN IF EXISTS "status",
+    DROP COLUMN IF EXISTS "cost_cents";
+
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS "budget_per_person_cents";
\ No newline at end of file
@@ -0,0 +1,9 @@
+ALTER TABLE activities
+    ADD COLUMN IF NOT EXISTS "latitude"     DOUBLE PRECISION,
+    ADD COLUMN IF NOT EXISTS "longitude"    DOUBLE PRECISION;
+
+---- create above / drop below ----
+
+ALTER TABLE activities
+    DROP COLUMN IF EXISTS "longitude",
+    DROP COLUMN IF EXISTS "latitude";
\ No newline at end of file
@@ -0,0 +1,7 @@
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "itinerary_attachment" VARCHAR(16) NOT NULL DEFAULT 'none';
+
+---- create above / drop below ----
+
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS "itinerary_attachment";
\ No newline at end of file
@@ -0,0 +1,14 @@
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "created_at"       TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS "summary_sent_at"  TIMESTAMP;
+
+-- Trips created before this migration have no creation time and are never
+-- sent a summary.
+ALTER TABLE trips
+    ALTER COLUMN "created_at" SET DEFAULT NOW();
+
+---- create above / drop below ----
+
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS "summary_sent_at",
+    DROP COLUMN IF EXISTS "created_at";
\ No newline at end of file
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS ownership_transfers (
+    "token"             VARCHAR(64)     PRIMARY KEY NOT NULL,
+    "trip_id"           uuid                        NOT NULL    UNIQUE,
+    "participant_id"    uuid                        NOT NULL,
+    "expires_at"        TIMESTAMP                   NOT NULL,
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+    FOREIGN KEY (participant_id) REFERENCES participants(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS ownership_transfers;
\ No newline at end of file
@@ -0,0 +1,26 @@
+ALTER TABLE participants
+    ADD COLUMN IF NOT EXISTS "role" VARCHAR(20) NOT NULL DEFAULT 'participant';
+
+-- Owners are participants with the owner role. The owner of each existing
+-- trip becomes one, reusing their participant entry when they have one.
+UPDATE participants p
+SET
+    "role" = 'owner',
+    "is_confirmed" = true,
+    "status" = 'invited'
+FROM trips t
+WHERE
+    p.trip_id = t.id AND LOWER(p.email) = LOWER(t.owner_email);
+
+INSERT INTO participants
+    ( "trip_id", "email", "name", "status", "is_confirmed", "role" )
+SELECT t.id, t.owner_email, t.owner_name, 'invited', true, 'owner'
+FROM trips t
+WHERE NOT EXISTS (
+    SELECT 1 FROM participants p WHERE p.trip_id = t.id AND p.role = 'owner'
+);
+
+---- create above / drop below ----
+
+ALTER TABLE participants
+    DROP COLUMN IF EXISTS "role";
\ No newline at end of file
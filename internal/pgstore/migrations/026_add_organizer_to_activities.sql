@@ -0,0 +1,16 @@
+ALTER TABLE activities
+    ADD COLUMN IF NOT EXISTS "organizer_id" uuid
+        REFERENCES participants(id)
+        ON UPDATE CASCADE
+        ON DELETE SET NULL;
+
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "digest_sent_on" DATE;
+
+---- create above / drop below ----
+
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS "digest_sent_on";
+
+ALTER TABLE activities
+    DROP COLUMN IF EXISTS "organizer_id";
\ No newline at end of file
@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS tasks (
+    "id"                    uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "trip_id"               uuid                        NOT NULL,
+    "title"                 VARCHAR(255)                NOT NULL,
+    "due_on"                DATE                        NOT NULL,
+    "assignee_id"           uuid,
+    "is_done"               BOOLEAN                     NOT NULL    DEFAULT FALSE,
+    "overdue_notified_at"   TIMESTAMP,
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+    FOREIGN KEY (assignee_id) REFERENCES participants(id)
+        ON UPDATE CASCADE
+        ON DELETE SET NULL
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS tasks;
\ No newline at end of file
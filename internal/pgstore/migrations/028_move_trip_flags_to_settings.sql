@@ -0,0 +1,28 @@
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "settings" JSONB NOT NULL
+        DEFAULT '{"reminder_hour": 7, "digest": true, "proposal_mode": "open", "timezone": "UTC", "itinerary_attachment": "none"}';
+
+UPDATE trips
+SET
+    "settings" = "settings" || jsonb_strip_nulls(jsonb_build_object(
+        'currency', "currency",
+        'itinerary_attachment', "itinerary_attachment"
+    ));
+
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS "currency",
+    DROP COLUMN IF EXISTS "itinerary_attachment";
+
+---- create above / drop below ----
+
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "currency" CHAR(3),
+    ADD COLUMN IF NOT EXISTS "itinerary_attachment" VARCHAR(16) NOT NULL DEFAULT 'none';
+
+UPDATE trips
+SET
+    "currency" = "settings"->>'currency',
+    "itinerary_attachment" = COALESCE("settings"->>'itinerary_attachment', 'none');
+
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS "settings";
\ No newline at end of file
@@ -0,0 +1,15 @@
+ALTER TABLE participants
+    ADD COLUMN IF NOT EXISTS "confirmed_at" TIMESTAMP;
+
+-- When participants confirmed before was not recorded, their invite time is
+-- the closest known.
+UPDATE participants
+SET
+    "confirmed_at" = "invited_at"
+WHERE
+    is_confirmed;
+
+---- create above / drop below ----
+
+ALTER TABLE participants
+    DROP COLUMN IF EXISTS "confirmed_at";
\ No newline at end of file
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS owner_email_changes (
+    "trip_id"           uuid            PRIMARY KEY NOT NULL,
+    "new_email"         VARCHAR(255)                NOT NULL,
+    "old_token"         VARCHAR(64)                 NOT NULL    UNIQUE,
+    "new_token"         VARCHAR(64)                 NOT NULL    UNIQUE,
+    "old_confirmed_at"  TIMESTAMP,
+    "new_confirmed_at"  TIMESTAMP,
+    "expires_at"        TIMESTAMP                   NOT NULL,
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS owner_email_changes;
\ No newline at end of file
@@ -0,0 +1,19 @@
+CREATE TABLE IF NOT EXISTS audit_events (
+    "id"            uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "trip_id"       uuid                        NOT NULL,
+    "action"        VARCHAR(64)                 NOT NULL,
+    "details"       JSONB                       NOT NULL    DEFAULT '{}',
+    "remote_addr"   VARCHAR(255)                NOT NULL    DEFAULT '',
+    "created_at"    TIMESTAMP                   NOT NULL    DEFAULT NOW(),
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS audit_events_trip_id_action_created_at_idx
+    ON audit_events (trip_id, action, created_at);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS audit_events;
\ No newline at end of file
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS attachments (
+    "id"            uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "trip_id"       uuid                        NOT NULL,
+    "filename"      VARCHAR(255)                NOT NULL,
+    "content_type"  VARCHAR(100)                NOT NULL,
+    "size_bytes"    BIGINT,
+    "uploaded_at"   TIMESTAMP,
+    "created_at"    TIMESTAMP                   NOT NULL    DEFAULT NOW(),
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS attachments;
\ No newline at end of file
@@ -0,0 +1,13 @@
+ALTER TABLE attachments
+    ADD COLUMN IF NOT EXISTS "uploaded_by" uuid REFERENCES participants(id) ON DELETE SET NULL,
+    ADD COLUMN IF NOT EXISTS "scan_status" VARCHAR(16) NOT NULL DEFAULT 'pending',
+    ADD COLUMN IF NOT EXISTS "scan_signature" VARCHAR(255),
+    ADD COLUMN IF NOT EXISTS "scanned_at" TIMESTAMP;
+
+---- create above / drop below ----
+
+ALTER TABLE attachments
+    DROP COLUMN IF EXISTS "uploaded_by",
+    DROP COLUMN IF EXISTS "scan_status",
+    DROP COLUMN IF EXISTS "scan_signature",
+    DROP COLUMN IF EXISTS "scanned_at";
\ No newline at end of file
@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS trip_sheets (
+    "trip_id"           uuid            PRIMARY KEY NOT NULL,
+    "state"             VARCHAR(64)                             UNIQUE,
+    "refresh_token"     TEXT,
+    "spreadsheet_id"    VARCHAR(255),
+    "spreadsheet_url"   TEXT,
+    "synced_at"         TIMESTAMP,
+    "created_at"        TIMESTAMP                   NOT NULL    DEFAULT NOW(),
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS trip_sheets;
\ No newline at end of file
@@ -0,0 +1,20 @@
+ALTER TABLE participants
+    ADD COLUMN IF NOT EXISTS "created_at" TIMESTAMP NOT NULL DEFAULT NOW();
+
+-- Participants were added when invited, when that was recorded.
+UPDATE participants
+SET
+    "created_at" = "invited_at"
+WHERE
+    invited_at IS NOT NULL;
+
+ALTER TABLE activities
+    ADD COLUMN IF NOT EXISTS "created_at" TIMESTAMP NOT NULL DEFAULT NOW();
+
+---- create above / drop below ----
+
+ALTER TABLE activities
+    DROP COLUMN IF EXISTS "created_at";
+
+ALTER TABLE participants
+    DROP COLUMN IF EXISTS "created_at";
\ No newline at end of file
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS trip_shares (
+    "token"         VARCHAR(64)     PRIMARY KEY NOT NULL,
+    "trip_id"       uuid                        NOT NULL    UNIQUE,
+    "created_at"    TIMESTAMP                   NOT NULL    DEFAULT NOW(),
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS trip_shares;
\ No newline at end of file
@@ -0,0 +1,27 @@
+CREATE TABLE IF NOT EXISTS participant_groups (
+    "id"            uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "trip_id"       uuid                        NOT NULL,
+    "name"          VARCHAR(255)                NOT NULL,
+    "created_at"    TIMESTAMP                   NOT NULL    DEFAULT NOW(),
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+    UNIQUE (trip_id, name)
+);
+
+ALTER TABLE participants
+    ADD COLUMN IF NOT EXISTS "group_id" uuid
+        REFERENCES participant_groups(id)
+        ON UPDATE CASCADE
+        ON DELETE SET NULL;
+
+CREATE INDEX IF NOT EXISTS participants_group_id_idx
+    ON participants (group_id);
+
+---- create above / drop below ----
+
+ALTER TABLE participants
+    DROP COLUMN IF EXISTS "group_id";
+
+DROP TABLE IF EXISTS participant_groups;
\ No newline at end of file
@@ -0,0 +1,38 @@
+CREATE TABLE IF NOT EXISTS lodging_rooms (
+    "id"            uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "lodging_id"    uuid                        NOT NULL,
+    "name"          VARCHAR(255)                NOT NULL,
+    "capacity"      INTEGER                     NOT NULL,
+    "beds"          VARCHAR(255)                NOT NULL    DEFAULT '',
+    "created_at"    TIMESTAMP                   NOT NULL    DEFAULT NOW(),
+
+    FOREIGN KEY (lodging_id) REFERENCES lodgings(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+    UNIQUE (lodging_id, name)
+);
+
+CREATE TABLE IF NOT EXISTS room_assignments (
+    "room_id"           uuid        NOT NULL,
+    "lodging_id"        uuid        NOT NULL,
+    "participant_id"    uuid        NOT NULL,
+
+    PRIMARY KEY (room_id, participant_id),
+    FOREIGN KEY (room_id) REFERENCES lodging_rooms(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+    FOREIGN KEY (lodging_id) REFERENCES lodgings(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+    FOREIGN KEY (participant_id) REFERENCES participants(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+    -- Participants sleep in one room of each lodging.
+    UNIQUE (lodging_id, participant_id)
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS room_assignments;
+
+DROP TABLE IF EXISTS lodging_rooms;
\ No newline at end of file
@@ -0,0 +1,37 @@
+CREATE TABLE IF NOT EXISTS rides (
+    "id"                uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "trip_id"           uuid                        NOT NULL,
+    "driver_id"         uuid                        NOT NULL,
+    "departs_at"        TIMESTAMP                   NOT NULL,
+    "departure_point"   VARCHAR(255)                NOT NULL,
+    "seats"             INTEGER                     NOT NULL,
+    "created_at"        TIMESTAMP                   NOT NULL    DEFAULT NOW(),
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+    FOREIGN KEY (driver_id) REFERENCES participants(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS ride_passengers (
+    "ride_id"           uuid            NOT NULL,
+    "participant_id"    uuid            NOT NULL,
+    "seats"             INTEGER         NOT NULL,
+    "created_at"        TIMESTAMP       NOT NULL    DEFAULT NOW(),
+
+    PRIMARY KEY (ride_id, participant_id),
+    FOREIGN KEY (ride_id) REFERENCES rides(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+    FOREIGN KEY (participant_id) REFERENCES participants(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS ride_passengers;
+
+DROP TABLE IF EXISTS rides;
\ No newline at end of file
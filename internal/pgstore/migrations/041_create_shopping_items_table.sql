@@ -0,0 +1,24 @@
+CREATE TABLE IF NOT EXISTS shopping_items (
+    "id"                uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "trip_id"           uuid                        NOT NULL,
+    "title"             VARCHAR(255)                NOT NULL,
+    "quantity"          VARCHAR(255)                NOT NULL    DEFAULT '',
+    "claimed_by"        uuid,
+    "expense_id"        uuid,
+    "purchased_at"      TIMESTAMP,
+    "created_at"        TIMESTAMP                   NOT NULL    DEFAULT NOW(),
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+    FOREIGN KEY (claimed_by) REFERENCES participants(id)
+        ON UPDATE CASCADE
+        ON DELETE SET NULL,
+    FOREIGN KEY (expense_id) REFERENCES expenses(id)
+        ON UPDATE CASCADE
+        ON DELETE SET NULL
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS shopping_items;
\ No newline at end of file
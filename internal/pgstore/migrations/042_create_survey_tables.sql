@@ -0,0 +1,52 @@
+CREATE TABLE IF NOT EXISTS trip_surveys (
+    "trip_id"       uuid            PRIMARY KEY NOT NULL,
+    "sent_at"       TIMESTAMP                   NOT NULL    DEFAULT NOW(),
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS survey_questions (
+    "id"            uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "trip_id"       uuid                        NOT NULL,
+    "position"      INTEGER                     NOT NULL,
+    "prompt"        VARCHAR(255)                NOT NULL,
+    "kind"          VARCHAR(16)                 NOT NULL,
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS survey_tokens (
+    "token"             VARCHAR(64)     PRIMARY KEY NOT NULL,
+    "participant_id"    uuid                        NOT NULL    UNIQUE,
+
+    FOREIGN KEY (participant_id) REFERENCES participants(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+CREATE TABLE IF NOT EXISTS survey_answers (
+    "question_id"       uuid            NOT NULL,
+    "participant_id"    uuid            NOT NULL,
+    "rating"            INTEGER,
+    "comment"           TEXT,
+    "answered_at"       TIMESTAMP       NOT NULL    DEFAULT NOW(),
+
+    PRIMARY KEY (question_id, participant_id),
+    FOREIGN KEY (question_id) REFERENCES survey_questions(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+    FOREIGN KEY (participant_id) REFERENCES participants(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS survey_answers;
+DROP TABLE IF EXISTS survey_tokens;
+DROP TABLE IF EXISTS survey_questions;
+DROP TABLE IF EXISTS trip_surveys;
\ No newline at end of file
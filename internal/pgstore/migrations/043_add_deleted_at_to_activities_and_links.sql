@@ -0,0 +1,15 @@
+-- Deleted activities and links stay in the trip trash, out of every listing,
+-- until restored or purged.
+ALTER TABLE activities
+    ADD COLUMN IF NOT EXISTS "deleted_at" TIMESTAMP;
+
+ALTER TABLE links
+    ADD COLUMN IF NOT EXISTS "deleted_at" TIMESTAMP;
+
+---- create above / drop below ----
+
+ALTER TABLE links
+    DROP COLUMN IF EXISTS "deleted_at";
+
+ALTER TABLE activities
+    DROP COLUMN IF EXISTS "deleted_at";
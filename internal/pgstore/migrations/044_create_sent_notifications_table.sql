@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS sent_notifications (
+    "participant_id"    uuid                        NOT NULL,
+    "kind"              VARCHAR(32)                 NOT NULL,
+    "trip_id"           uuid                        NOT NULL,
+    "sent_at"           TIMESTAMP                   NOT NULL    DEFAULT NOW(),
+
+    PRIMARY KEY (participant_id, kind),
+
+    FOREIGN KEY (participant_id) REFERENCES participants(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE,
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS sent_notifications;
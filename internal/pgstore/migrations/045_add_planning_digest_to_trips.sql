@@ -0,0 +1,21 @@
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "planning_digest_sent_at"  TIMESTAMP;
+
+ALTER TABLE trips
+    ALTER COLUMN "settings" SET DEFAULT '{"reminder_hour": 7, "digest": true, "planning_digest": true, "proposal_mode": "open", "timezone": "UTC", "itinerary_attachment": "none"}';
+
+UPDATE trips
+SET
+    "settings" = '{"planning_digest": true}' || "settings";
+
+---- create above / drop below ----
+
+UPDATE trips
+SET
+    "settings" = "settings" - 'planning_digest';
+
+ALTER TABLE trips
+    ALTER COLUMN "settings" SET DEFAULT '{"reminder_hour": 7, "digest": true, "proposal_mode": "open", "timezone": "UTC", "itinerary_attachment": "none"}';
+
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS "planning_digest_sent_at";
@@ -0,0 +1,8 @@
+CREATE TABLE IF NOT EXISTS scheduler_runs (
+    "name"      VARCHAR(64)     PRIMARY KEY NOT NULL,
+    "ran_at"    TIMESTAMP                   NOT NULL    DEFAULT NOW()
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS scheduler_runs;
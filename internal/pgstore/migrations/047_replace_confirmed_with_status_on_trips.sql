@@ -0,0 +1,26 @@
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "status"   VARCHAR(20) NOT NULL    DEFAULT 'draft';
+
+UPDATE trips
+SET
+    "status" = CASE
+        WHEN NOT "is_confirmed" THEN 'draft'
+        WHEN "ends_at" < NOW() THEN 'finished'
+        WHEN "starts_at" <= NOW() THEN 'ongoing'
+        ELSE 'confirmed'
+    END;
+
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS "is_confirmed";
+
+---- create above / drop below ----
+
+ALTER TABLE trips
+    ADD COLUMN IF NOT EXISTS "is_confirmed" BOOLEAN NOT NULL    DEFAULT FALSE;
+
+UPDATE trips
+SET
+    "is_confirmed" = "status" IN ('confirmed', 'ongoing', 'finished');
+
+ALTER TABLE trips
+    DROP COLUMN IF EXISTS "status";
@@ -0,0 +1,30 @@
+CREATE TABLE IF NOT EXISTS trip_reminders (
+    "trip_id"           uuid                        NOT NULL,
+    "starts_at"         TIMESTAMP                   NOT NULL,
+    "days_before"       INTEGER                     NOT NULL,
+    "sent_at"           TIMESTAMP                   NOT NULL    DEFAULT NOW(),
+
+    PRIMARY KEY (trip_id, starts_at, days_before),
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+ALTER TABLE trips
+    ALTER COLUMN "settings" SET DEFAULT '{"reminder_hour": 7, "reminder_days": [7, 1], "digest": true, "planning_digest": true, "proposal_mode": "open", "timezone": "UTC", "itinerary_attachment": "none"}';
+
+UPDATE trips
+SET
+    "settings" = '{"reminder_days": [7, 1]}' || "settings";
+
+---- create above / drop below ----
+
+UPDATE trips
+SET
+    "settings" = "settings" - 'reminder_days';
+
+ALTER TABLE trips
+    ALTER COLUMN "settings" SET DEFAULT '{"reminder_hour": 7, "digest": true, "planning_digest": true, "proposal_mode": "open", "timezone": "UTC", "itinerary_attachment": "none"}';
+
+DROP TABLE IF EXISTS trip_reminders;
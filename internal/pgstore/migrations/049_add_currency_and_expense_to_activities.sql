@@ -0,0 +1,9 @@
+ALTER TABLE activities
+    ADD COLUMN IF NOT EXISTS "currency"     CHAR(3),
+    ADD COLUMN IF NOT EXISTS "expense_id"   uuid            REFERENCES expenses(id) ON DELETE SET NULL;
+
+---- create above / drop below ----
+
+ALTER TABLE activities
+    DROP COLUMN IF EXISTS "expense_id",
+    DROP COLUMN IF EXISTS "currency";
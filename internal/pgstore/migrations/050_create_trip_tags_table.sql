@@ -0,0 +1,17 @@
+CREATE TABLE IF NOT EXISTS trip_tags (
+    "trip_id"       uuid                        NOT NULL,
+    "tag"           VARCHAR(30)                 NOT NULL,
+    "created_at"    TIMESTAMP                   NOT NULL    DEFAULT NOW(),
+
+    PRIMARY KEY (trip_id, tag),
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS trip_tags_tag_idx
+    ON trip_tags (tag);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS trip_tags;
@@ -0,0 +1,32 @@
+ALTER TABLE trip_shares
+    ADD COLUMN IF NOT EXISTS "held_at"      TIMESTAMP,
+    ADD COLUMN IF NOT EXISTS "held_reason"  VARCHAR(20),
+    ADD COLUMN IF NOT EXISTS "approved_at"  TIMESTAMP;
+
+CREATE TABLE IF NOT EXISTS content_reports (
+    "id"            uuid            PRIMARY KEY NOT NULL    DEFAULT gen_random_uuid(),
+    "trip_id"       uuid                        NOT NULL,
+    "reason"        VARCHAR(20)                 NOT NULL,
+    "details"       TEXT,
+    "remote_addr"   VARCHAR(255)                NOT NULL    DEFAULT '',
+    "created_at"    TIMESTAMP                   NOT NULL    DEFAULT NOW(),
+    "resolved_at"   TIMESTAMP,
+
+    FOREIGN KEY (trip_id) REFERENCES trips(id)
+        ON UPDATE CASCADE
+        ON DELETE CASCADE
+);
+
+-- A client has one open report a trip, so it takes several to hold it.
+CREATE UNIQUE INDEX IF NOT EXISTS content_reports_open_idx
+    ON content_reports (trip_id, remote_addr)
+    WHERE resolved_at IS NULL;
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS content_reports;
+
+ALTER TABLE trip_shares
+    DROP COLUMN IF EXISTS "approved_at",
+    DROP COLUMN IF EXISTS "held_reason",
+    DROP COLUMN IF EXISTS "held_at";
@@ -0,0 +1,9 @@
+-- The digest of the texts an admin approved, so edits made after the approval
+-- are checked again.
+ALTER TABLE trip_shares
+    ADD COLUMN IF NOT EXISTS "approved_digest" VARCHAR(64);
+
+---- create above / drop below ----
+
+ALTER TABLE trip_shares
+    DROP COLUMN IF EXISTS "approved_digest";
@@ -0,0 +1,9 @@
+-- The packing template an item was generated from, so generating the list
+-- again updates the item instead of adding it twice.
+ALTER TABLE checklist_items
+    ADD COLUMN IF NOT EXISTS "template_key" VARCHAR(50);
+
+---- create above / drop below ----
+
+ALTER TABLE checklist_items
+    DROP COLUMN IF EXISTS "template_key";
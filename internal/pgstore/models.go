@@ -10,32 +10,333 @@ import (
 )
 
 type Activity struct {
+	ID              uuid.UUID        `db:"id" json:"id"`
+	TripID          uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Title           string           `db:"title" json:"title"`
+	OccursAt        pgtype.Timestamp `db:"occurs_at" json:"occurs_at"`
+	Tags            []string         `db:"tags" json:"tags"`
+	DurationMinutes pgtype.Int4      `db:"duration_minutes" json:"duration_minutes"`
+	CostCents       int64            `db:"cost_cents" json:"cost_cents"`
+	Status          string           `db:"status" json:"status"`
+	Latitude        pgtype.Float8    `db:"latitude" json:"latitude"`
+	Longitude       pgtype.Float8    `db:"longitude" json:"longitude"`
+	InviteSequence  pgtype.Int4      `db:"invite_sequence" json:"invite_sequence"`
+	OrganizerID     pgtype.UUID      `db:"organizer_id" json:"organizer_id"`
+	CreatedAt       pgtype.Timestamp `db:"created_at" json:"created_at"`
+	DeletedAt       pgtype.Timestamp `db:"deleted_at" json:"deleted_at"`
+	Currency        pgtype.Text      `db:"currency" json:"currency"`
+	ExpenseID       pgtype.UUID      `db:"expense_id" json:"expense_id"`
+}
+
+type Attachment struct {
+	ID            uuid.UUID        `db:"id" json:"id"`
+	TripID        uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Filename      string           `db:"filename" json:"filename"`
+	ContentType   string           `db:"content_type" json:"content_type"`
+	SizeBytes     pgtype.Int8      `db:"size_bytes" json:"size_bytes"`
+	UploadedAt    pgtype.Timestamp `db:"uploaded_at" json:"uploaded_at"`
+	CreatedAt     pgtype.Timestamp `db:"created_at" json:"created_at"`
+	UploadedBy    pgtype.UUID      `db:"uploaded_by" json:"uploaded_by"`
+	ScanStatus    string           `db:"scan_status" json:"scan_status"`
+	ScanSignature pgtype.Text      `db:"scan_signature" json:"scan_signature"`
+	ScannedAt     pgtype.Timestamp `db:"scanned_at" json:"scanned_at"`
+}
+
+type AuditEvent struct {
+	ID         uuid.UUID        `db:"id" json:"id"`
+	TripID     uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Action     string           `db:"action" json:"action"`
+	Details    []byte           `db:"details" json:"details"`
+	RemoteAddr string           `db:"remote_addr" json:"remote_addr"`
+	CreatedAt  pgtype.Timestamp `db:"created_at" json:"created_at"`
+}
+
+type ChecklistItem struct {
+	ID          uuid.UUID   `db:"id" json:"id"`
+	TripID      uuid.UUID   `db:"trip_id" json:"trip_id"`
+	Title       string      `db:"title" json:"title"`
+	Category    string      `db:"category" json:"category"`
+	IsChecked   bool        `db:"is_checked" json:"is_checked"`
+	TemplateKey pgtype.Text `db:"template_key" json:"template_key"`
+}
+
+type Companion struct {
+	ID            uuid.UUID        `db:"id" json:"id"`
+	ParticipantID uuid.UUID        `db:"participant_id" json:"participant_id"`
+	Name          string           `db:"name" json:"name"`
+	CreatedAt     pgtype.Timestamp `db:"created_at" json:"created_at"`
+}
+
+type ContentReport struct {
+	ID         uuid.UUID        `db:"id" json:"id"`
+	TripID     uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Reason     string           `db:"reason" json:"reason"`
+	Details    pgtype.Text      `db:"details" json:"details"`
+	RemoteAddr string           `db:"remote_addr" json:"remote_addr"`
+	CreatedAt  pgtype.Timestamp `db:"created_at" json:"created_at"`
+	ResolvedAt pgtype.Timestamp `db:"resolved_at" json:"resolved_at"`
+}
+
+type DatePollOption struct {
 	ID       uuid.UUID        `db:"id" json:"id"`
 	TripID   uuid.UUID        `db:"trip_id" json:"trip_id"`
-	Title    string           `db:"title" json:"title"`
-	OccursAt pgtype.Timestamp `db:"occurs_at" json:"occurs_at"`
+	StartsAt pgtype.Timestamp `db:"starts_at" json:"starts_at"`
+	EndsAt   pgtype.Timestamp `db:"ends_at" json:"ends_at"`
+}
+
+type DatePollToken struct {
+	Token         string    `db:"token" json:"token"`
+	ParticipantID uuid.UUID `db:"participant_id" json:"participant_id"`
+}
+
+type DatePollVote struct {
+	OptionID      uuid.UUID `db:"option_id" json:"option_id"`
+	ParticipantID uuid.UUID `db:"participant_id" json:"participant_id"`
+	IsAvailable   bool      `db:"is_available" json:"is_available"`
+}
+
+type Expense struct {
+	ID          uuid.UUID        `db:"id" json:"id"`
+	TripID      uuid.UUID        `db:"trip_id" json:"trip_id"`
+	PaidBy      uuid.UUID        `db:"paid_by" json:"paid_by"`
+	Description string           `db:"description" json:"description"`
+	Category    string           `db:"category" json:"category"`
+	AmountCents int64            `db:"amount_cents" json:"amount_cents"`
+	SpentAt     pgtype.Timestamp `db:"spent_at" json:"spent_at"`
+}
+
+type ExpenseSplit struct {
+	ID            uuid.UUID `db:"id" json:"id"`
+	ExpenseID     uuid.UUID `db:"expense_id" json:"expense_id"`
+	ParticipantID uuid.UUID `db:"participant_id" json:"participant_id"`
+	AmountCents   int64     `db:"amount_cents" json:"amount_cents"`
 }
 
 type Link struct {
-	ID     uuid.UUID `db:"id" json:"id"`
-	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
-	Title  string    `db:"title" json:"title"`
-	Url    string    `db:"url" json:"url"`
+	ID        uuid.UUID        `db:"id" json:"id"`
+	TripID    uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Title     string           `db:"title" json:"title"`
+	Url       string           `db:"url" json:"url"`
+	DeletedAt pgtype.Timestamp `db:"deleted_at" json:"deleted_at"`
+}
+
+type Lodging struct {
+	ID        uuid.UUID        `db:"id" json:"id"`
+	TripID    uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Name      string           `db:"name" json:"name"`
+	Address   string           `db:"address" json:"address"`
+	CheckIn   pgtype.Timestamp `db:"check_in" json:"check_in"`
+	CheckOut  pgtype.Timestamp `db:"check_out" json:"check_out"`
+	CostCents int64            `db:"cost_cents" json:"cost_cents"`
+	Status    string           `db:"status" json:"status"`
+}
+
+type LodgingRoom struct {
+	ID        uuid.UUID        `db:"id" json:"id"`
+	LodgingID uuid.UUID        `db:"lodging_id" json:"lodging_id"`
+	Name      string           `db:"name" json:"name"`
+	Capacity  int32            `db:"capacity" json:"capacity"`
+	Beds      string           `db:"beds" json:"beds"`
+	CreatedAt pgtype.Timestamp `db:"created_at" json:"created_at"`
+}
+
+type OwnerEmailChange struct {
+	TripID         uuid.UUID        `db:"trip_id" json:"trip_id"`
+	NewEmail       string           `db:"new_email" json:"new_email"`
+	OldToken       string           `db:"old_token" json:"old_token"`
+	NewToken       string           `db:"new_token" json:"new_token"`
+	OldConfirmedAt pgtype.Timestamp `db:"old_confirmed_at" json:"old_confirmed_at"`
+	NewConfirmedAt pgtype.Timestamp `db:"new_confirmed_at" json:"new_confirmed_at"`
+	ExpiresAt      pgtype.Timestamp `db:"expires_at" json:"expires_at"`
+}
+
+type OwnershipTransfer struct {
+	Token         string           `db:"token" json:"token"`
+	TripID        uuid.UUID        `db:"trip_id" json:"trip_id"`
+	ParticipantID uuid.UUID        `db:"participant_id" json:"participant_id"`
+	ExpiresAt     pgtype.Timestamp `db:"expires_at" json:"expires_at"`
 }
 
 type Participant struct {
-	ID          uuid.UUID `db:"id" json:"id"`
-	TripID      uuid.UUID `db:"trip_id" json:"trip_id"`
-	Email       string    `db:"email" json:"email"`
-	IsConfirmed bool      `db:"is_confirmed" json:"is_confirmed"`
+	ID          uuid.UUID        `db:"id" json:"id"`
+	TripID      uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Email       string           `db:"email" json:"email"`
+	IsConfirmed bool             `db:"is_confirmed" json:"is_confirmed"`
+	Name        pgtype.Text      `db:"name" json:"name"`
+	Status      string           `db:"status" json:"status"`
+	InvitedAt   pgtype.Timestamp `db:"invited_at" json:"invited_at"`
+	Role        string           `db:"role" json:"role"`
+	ConfirmedAt pgtype.Timestamp `db:"confirmed_at" json:"confirmed_at"`
+	CreatedAt   pgtype.Timestamp `db:"created_at" json:"created_at"`
+	GroupID     pgtype.UUID      `db:"group_id" json:"group_id"`
 }
 
-type Trip struct {
+type ParticipantGroup struct {
+	ID        uuid.UUID        `db:"id" json:"id"`
+	TripID    uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Name      string           `db:"name" json:"name"`
+	CreatedAt pgtype.Timestamp `db:"created_at" json:"created_at"`
+}
+
+type ParticipantNeed struct {
+	ParticipantID uuid.UUID        `db:"participant_id" json:"participant_id"`
+	Dietary       []string         `db:"dietary" json:"dietary"`
+	Accessibility []string         `db:"accessibility" json:"accessibility"`
+	Notes         string           `db:"notes" json:"notes"`
+	UpdatedAt     pgtype.Timestamp `db:"updated_at" json:"updated_at"`
+}
+
+type Receipt struct {
+	ID          uuid.UUID        `db:"id" json:"id"`
+	TripID      uuid.UUID        `db:"trip_id" json:"trip_id"`
+	ExpenseID   pgtype.UUID      `db:"expense_id" json:"expense_id"`
+	ContentType string           `db:"content_type" json:"content_type"`
+	Data        []byte           `db:"data" json:"data"`
+	Merchant    pgtype.Text      `db:"merchant" json:"merchant"`
+	AmountCents pgtype.Int8      `db:"amount_cents" json:"amount_cents"`
+	SpentAt     pgtype.Timestamp `db:"spent_at" json:"spent_at"`
+	CreatedAt   pgtype.Timestamp `db:"created_at" json:"created_at"`
+}
+
+type Ride struct {
+	ID             uuid.UUID        `db:"id" json:"id"`
+	TripID         uuid.UUID        `db:"trip_id" json:"trip_id"`
+	DriverID       uuid.UUID        `db:"driver_id" json:"driver_id"`
+	DepartsAt      pgtype.Timestamp `db:"departs_at" json:"departs_at"`
+	DeparturePoint string           `db:"departure_point" json:"departure_point"`
+	Seats          int32            `db:"seats" json:"seats"`
+	CreatedAt      pgtype.Timestamp `db:"created_at" json:"created_at"`
+}
+
+type RidePassenger struct {
+	RideID        uuid.UUID        `db:"ride_id" json:"ride_id"`
+	ParticipantID uuid.UUID        `db:"participant_id" json:"participant_id"`
+	Seats         int32            `db:"seats" json:"seats"`
+	CreatedAt     pgtype.Timestamp `db:"created_at" json:"created_at"`
+}
+
+type RoomAssignment struct {
+	RoomID        uuid.UUID `db:"room_id" json:"room_id"`
+	LodgingID     uuid.UUID `db:"lodging_id" json:"lodging_id"`
+	ParticipantID uuid.UUID `db:"participant_id" json:"participant_id"`
+}
+
+type SchedulerRun struct {
+	Name  string           `db:"name" json:"name"`
+	RanAt pgtype.Timestamp `db:"ran_at" json:"ran_at"`
+}
+
+type SentNotification struct {
+	ParticipantID uuid.UUID        `db:"participant_id" json:"participant_id"`
+	Kind          string           `db:"kind" json:"kind"`
+	TripID        uuid.UUID        `db:"trip_id" json:"trip_id"`
+	SentAt        pgtype.Timestamp `db:"sent_at" json:"sent_at"`
+}
+
+type ShoppingItem struct {
 	ID          uuid.UUID        `db:"id" json:"id"`
+	TripID      uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Title       string           `db:"title" json:"title"`
+	Quantity    string           `db:"quantity" json:"quantity"`
+	ClaimedBy   pgtype.UUID      `db:"claimed_by" json:"claimed_by"`
+	ExpenseID   pgtype.UUID      `db:"expense_id" json:"expense_id"`
+	PurchasedAt pgtype.Timestamp `db:"purchased_at" json:"purchased_at"`
+	CreatedAt   pgtype.Timestamp `db:"created_at" json:"created_at"`
+}
+
+type SurveyAnswer struct {
+	QuestionID    uuid.UUID        `db:"question_id" json:"question_id"`
+	ParticipantID uuid.UUID        `db:"participant_id" json:"participant_id"`
+	Rating        pgtype.Int4      `db:"rating" json:"rating"`
+	Comment       pgtype.Text      `db:"comment" json:"comment"`
+	AnsweredAt    pgtype.Timestamp `db:"answered_at" json:"answered_at"`
+}
+
+type SurveyQuestion struct {
+	ID       uuid.UUID `db:"id" json:"id"`
+	TripID   uuid.UUID `db:"trip_id" json:"trip_id"`
+	Position int32     `db:"position" json:"position"`
+	Prompt   string    `db:"prompt" json:"prompt"`
+	Kind     string    `db:"kind" json:"kind"`
+}
+
+type SurveyToken struct {
+	Token         string    `db:"token" json:"token"`
+	ParticipantID uuid.UUID `db:"participant_id" json:"participant_id"`
+}
+
+type Task struct {
+	ID                uuid.UUID        `db:"id" json:"id"`
+	TripID            uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Title             string           `db:"title" json:"title"`
+	DueOn             pgtype.Date      `db:"due_on" json:"due_on"`
+	AssigneeID        pgtype.UUID      `db:"assignee_id" json:"assignee_id"`
+	IsDone            bool             `db:"is_done" json:"is_done"`
+	OverdueNotifiedAt pgtype.Timestamp `db:"overdue_notified_at" json:"overdue_notified_at"`
+}
+
+type Transport struct {
+	ID          uuid.UUID        `db:"id" json:"id"`
+	TripID      uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Mode        string           `db:"mode" json:"mode"`
+	Origin      string           `db:"origin" json:"origin"`
 	Destination string           `db:"destination" json:"destination"`
-	OwnerEmail  string           `db:"owner_email" json:"owner_email"`
-	OwnerName   string           `db:"owner_name" json:"owner_name"`
-	IsConfirmed bool             `db:"is_confirmed" json:"is_confirmed"`
-	StartsAt    pgtype.Timestamp `db:"starts_at" json:"starts_at"`
-	EndsAt      pgtype.Timestamp `db:"ends_at" json:"ends_at"`
+	DepartsAt   pgtype.Timestamp `db:"departs_at" json:"departs_at"`
+	ArrivesAt   pgtype.Timestamp `db:"arrives_at" json:"arrives_at"`
+}
+
+type Trip struct {
+	ID                   uuid.UUID        `db:"id" json:"id"`
+	Destination          string           `db:"destination" json:"destination"`
+	OwnerEmail           string           `db:"owner_email" json:"owner_email"`
+	OwnerName            string           `db:"owner_name" json:"owner_name"`
+	StartsAt             pgtype.Timestamp `db:"starts_at" json:"starts_at"`
+	EndsAt               pgtype.Timestamp `db:"ends_at" json:"ends_at"`
+	MaxParticipants      pgtype.Int4      `db:"max_participants" json:"max_participants"`
+	BudgetPerPersonCents pgtype.Int8      `db:"budget_per_person_cents" json:"budget_per_person_cents"`
+	CreatedAt            pgtype.Timestamp `db:"created_at" json:"created_at"`
+	SummarySentAt        pgtype.Timestamp `db:"summary_sent_at" json:"summary_sent_at"`
+	DigestSentOn         pgtype.Date      `db:"digest_sent_on" json:"digest_sent_on"`
+	Settings             TripSettings     `db:"settings" json:"settings"`
+	ArchivedAt           pgtype.Timestamp `db:"archived_at" json:"archived_at"`
+	PlanningDigestSentAt pgtype.Timestamp `db:"planning_digest_sent_at" json:"planning_digest_sent_at"`
+	Status               string           `db:"status" json:"status"`
+}
+
+type TripReminder struct {
+	TripID     uuid.UUID        `db:"trip_id" json:"trip_id"`
+	StartsAt   pgtype.Timestamp `db:"starts_at" json:"starts_at"`
+	DaysBefore int32            `db:"days_before" json:"days_before"`
+	SentAt     pgtype.Timestamp `db:"sent_at" json:"sent_at"`
+}
+
+type TripShare struct {
+	Token          string           `db:"token" json:"token"`
+	TripID         uuid.UUID        `db:"trip_id" json:"trip_id"`
+	CreatedAt      pgtype.Timestamp `db:"created_at" json:"created_at"`
+	HeldAt         pgtype.Timestamp `db:"held_at" json:"held_at"`
+	HeldReason     pgtype.Text      `db:"held_reason" json:"held_reason"`
+	ApprovedAt     pgtype.Timestamp `db:"approved_at" json:"approved_at"`
+	ApprovedDigest pgtype.Text      `db:"approved_digest" json:"approved_digest"`
+}
+
+type TripSheet struct {
+	TripID         uuid.UUID        `db:"trip_id" json:"trip_id"`
+	State          pgtype.Text      `db:"state" json:"state"`
+	RefreshToken   pgtype.Text      `db:"refresh_token" json:"refresh_token"`
+	SpreadsheetID  pgtype.Text      `db:"spreadsheet_id" json:"spreadsheet_id"`
+	SpreadsheetUrl pgtype.Text      `db:"spreadsheet_url" json:"spreadsheet_url"`
+	SyncedAt       pgtype.Timestamp `db:"synced_at" json:"synced_at"`
+	CreatedAt      pgtype.Timestamp `db:"created_at" json:"created_at"`
+}
+
+type TripSurvey struct {
+	TripID uuid.UUID        `db:"trip_id" json:"trip_id"`
+	SentAt pgtype.Timestamp `db:"sent_at" json:"sent_at"`
+}
+
+type TripTag struct {
+	TripID    uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Tag       string           `db:"tag" json:"tag"`
+	CreatedAt pgtype.Timestamp `db:"created_at" json:"created_at"`
 }
@@ -0,0 +1,9 @@
+package pgstore
+
+// Kinds of the emails recorded in sent_notifications. Each participant is
+// sent each of them once, however many times the action sending it is
+// repeated.
+const (
+	NotificationInvitation        = "invitation"
+	NotificationWaitlistPromotion = "waitlist_promotion"
+)
@@ -0,0 +1,32 @@
+package pgstore
+
+import "errors"
+
+// ErrTransferParticipantGone is returned when accepting an ownership
+// transfer to a participant who is no longer confirmed on the trip.
+var ErrTransferParticipantGone = errors.New("pgstore: participant is no longer confirmed on the trip")
+
+// ErrLastOwner is returned when removing the only owner left on a trip.
+var ErrLastOwner = errors.New("pgstore: trip must have at least one owner")
+
+// NewOwnershipTransferToken returns a random, URL safe token that lets the
+// new owner accept a trip handoff from the email sent to them.
+func NewOwnershipTransferToken() (string, error) {
+	return newToken()
+}
+
+// ErrOwnerEmailInUse is returned when changing the owner email to the email
+// of another participant of the trip.
+var ErrOwnerEmailInUse = errors.New("pgstore: email already participates in the trip")
+
+// NewOwnerEmailChangeToken returns a random, URL safe token that confirms an
+// owner email change from the email sent to one of the addresses.
+func NewOwnerEmailChangeToken() (string, error) {
+	return newToken()
+}
+
+// Confirmed reports whether both the old and the new address confirmed the
+// change.
+func (c OwnerEmailChange) Confirmed() bool {
+	return c.OldConfirmedAt.Valid && c.NewConfirmedAt.Valid
+}
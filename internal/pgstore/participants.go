@@ -0,0 +1,34 @@
+package pgstore
+
+import "github.com/jackc/pgx/v5/pgtype"
+
+// Participant statuses. Only invited participants take up a spot on the
+// trip, along with the ones whose invite bounced, who keep it while the owner
+// corrects their email.
+const (
+	ParticipantInvited      = "invited"
+	ParticipantWaitlisted   = "waitlisted"
+	ParticipantDeclined     = "declined"
+	ParticipantEmailInvalid = "email_invalid"
+)
+
+// Participant roles. Owners manage the trip and receive the emails meant for
+// its organizers. A trip always has at least one owner.
+const (
+	RoleParticipant = "participant"
+	RoleOwner       = "owner"
+)
+
+// InviteStatuses returns the status of each of n new invitations to a trip
+// with the given capacity and active participants: the free spots are
+// invited and the rest waitlisted, in order.
+func InviteStatuses(maxParticipants pgtype.Int4, active int64, n int) []string {
+	statuses := make([]string, n)
+	for i := range statuses {
+		statuses[i] = ParticipantInvited
+		if maxParticipants.Valid && active+int64(i) >= int64(maxParticipants.Int32) {
+			statuses[i] = ParticipantWaitlisted
+		}
+	}
+	return statuses
+}
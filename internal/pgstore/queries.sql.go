@@ -12,105 +12,154 @@ import (
 	"github.com/jackc/pgx/v5/pgtype"
 )
 
-const confirmParticipant = `-- name: ConfirmParticipant :exec
-SELECT
-    "id", "trip_id", "email", "is_confirmed"
-FROM participants
+const approveActivity = `-- name: ApproveActivity :exec
+UPDATE activities
+SET
+    "status" = 'approved'
 WHERE
     id = $1
 `
 
-func (q *Queries) ConfirmParticipant(ctx context.Context, id uuid.UUID) error {
-	_, err := q.db.Exec(ctx, confirmParticipant, id)
+func (q *Queries) ApproveActivity(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, approveActivity, id)
 	return err
 }
 
-const createActivity = `-- name: CreateActivity :one
-INSERT INTO activities
-    ( "trip_id", "title", "occurs_at" ) VALUES
-    ( $1, $2, $3 )
-RETURNING "id"
+const approveLodging = `-- name: ApproveLodging :exec
+UPDATE lodgings
+SET
+    "status" = 'approved'
+WHERE
+    id = $1
 `
 
-type CreateActivityParams struct {
-	TripID   uuid.UUID        `db:"trip_id" json:"trip_id"`
-	Title    string           `db:"title" json:"title"`
-	OccursAt pgtype.Timestamp `db:"occurs_at" json:"occurs_at"`
+func (q *Queries) ApproveLodging(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, approveLodging, id)
+	return err
 }
 
-func (q *Queries) CreateActivity(ctx context.Context, arg CreateActivityParams) (uuid.UUID, error) {
-	row := q.db.QueryRow(ctx, createActivity, arg.TripID, arg.Title, arg.OccursAt)
-	var id uuid.UUID
-	err := row.Scan(&id)
-	return id, err
+const approveTripShare = `-- name: ApproveTripShare :execrows
+UPDATE trip_shares
+SET
+    "held_at" = NULL,
+    "held_reason" = NULL,
+    "approved_at" = NOW(),
+    "approved_digest" = $1::TEXT
+WHERE
+    trip_id = $2
+`
+
+type ApproveTripShareParams struct {
+	Digest string    `db:"digest" json:"digest"`
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
 }
 
-const createTripLink = `-- name: CreateTripLink :one
-INSERT INTO links
-    ( "trip_id", "title", "url" ) VALUES
-    ( $1, $2, $3 )
+func (q *Queries) ApproveTripShare(ctx context.Context, arg ApproveTripShareParams) (int64, error) {
+	result, err := q.db.Exec(ctx, approveTripShare, arg.Digest, arg.TripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const archiveEndedTrips = `-- name: ArchiveEndedTrips :many
+UPDATE trips
+SET
+    "archived_at" = NOW()
+WHERE
+    id IN (
+        SELECT t.id
+        FROM trips t
+        WHERE t.archived_at IS NULL AND t.ends_at < $1
+        FOR UPDATE SKIP LOCKED
+    )
 RETURNING "id"
 `
 
-type CreateTripLinkParams struct {
-	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
-	Title  string    `db:"title" json:"title"`
-	Url    string    `db:"url" json:"url"`
+func (q *Queries) ArchiveEndedTrips(ctx context.Context, endsAt pgtype.Timestamp) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, archiveEndedTrips, endsAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-func (q *Queries) CreateTripLink(ctx context.Context, arg CreateTripLinkParams) (uuid.UUID, error) {
-	row := q.db.QueryRow(ctx, createTripLink, arg.TripID, arg.Title, arg.Url)
-	var id uuid.UUID
-	err := row.Scan(&id)
-	return id, err
+const archiveTrip = `-- name: ArchiveTrip :exec
+UPDATE trips
+SET
+    "archived_at" = NOW()
+WHERE
+    id = $1
+`
+
+func (q *Queries) ArchiveTrip(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, archiveTrip, id)
+	return err
 }
 
-const getParticipant = `-- name: GetParticipant :one
-SELECT
-    "id", "trip_id", "email", "is_confirmed"
-FROM participants
+const attachReceiptToExpense = `-- name: AttachReceiptToExpense :exec
+UPDATE receipts
+SET
+    "expense_id" = $1
 WHERE
-    id = $1
+    id = $2
 `
 
-func (q *Queries) GetParticipant(ctx context.Context, id uuid.UUID) (Participant, error) {
-	row := q.db.QueryRow(ctx, getParticipant, id)
-	var i Participant
-	err := row.Scan(
-		&i.ID,
-		&i.TripID,
-		&i.Email,
-		&i.IsConfirmed,
-	)
-	return i, err
+type AttachReceiptToExpenseParams struct {
+	ExpenseID pgtype.UUID `db:"expense_id" json:"expense_id"`
+	ID        uuid.UUID   `db:"id" json:"id"`
 }
 
-const getParticipants = `-- name: GetParticipants :many
-SELECT
-    "id", "trip_id", "email", "is_confirmed"
-FROM participants
+func (q *Queries) AttachReceiptToExpense(ctx context.Context, arg AttachReceiptToExpenseParams) error {
+	_, err := q.db.Exec(ctx, attachReceiptToExpense, arg.ExpenseID, arg.ID)
+	return err
+}
+
+const claimDueTripDigests = `-- name: ClaimDueTripDigests :many
+UPDATE trips
+SET
+    "digest_sent_on" = (NOW() AT TIME ZONE (settings->>'timezone'))::DATE
 WHERE
-    trip_id = $1
+    id IN (
+        SELECT t.id
+        FROM trips t
+        WHERE
+            t.status IN ('confirmed', 'ongoing')
+            AND t.archived_at IS NULL
+            AND (t.settings->>'digest')::BOOLEAN
+            AND EXTRACT(HOUR FROM NOW() AT TIME ZONE (t.settings->>'timezone')) >= (t.settings->>'reminder_hour')::INT
+            AND (t.digest_sent_on IS NULL OR t.digest_sent_on < (NOW() AT TIME ZONE (t.settings->>'timezone'))::DATE)
+            AND t.ends_at::DATE >= (NOW() AT TIME ZONE (t.settings->>'timezone'))::DATE
+        FOR UPDATE SKIP LOCKED
+    )
+RETURNING "id"
 `
 
-func (q *Queries) GetParticipants(ctx context.Context, tripID uuid.UUID) ([]Participant, error) {
-	rows, err := q.db.Query(ctx, getParticipants, tripID)
+func (q *Queries) ClaimDueTripDigests(ctx context.Context) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, claimDueTripDigests)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Participant
+	var items []uuid.UUID
 	for rows.Next() {
-		var i Participant
-		if err := rows.Scan(
-			&i.ID,
-			&i.TripID,
-			&i.Email,
-			&i.IsConfirmed,
-		); err != nil {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
 			return nil, err
 		}
-		items = append(items, i)
+		items = append(items, id)
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
@@ -118,52 +167,77 @@ func (q *Queries) GetParticipants(ctx context.Context, tripID uuid.UUID) ([]Part
 	return items, nil
 }
 
-const getTrip = `-- name: GetTrip :one
-SELECT
-    "id", "destination", "owner_email", "owner_name", "is_confirmed", "starts_at", "ends_at"
-FROM trips
+const claimDueTripPlanningDigests = `-- name: ClaimDueTripPlanningDigests :many
+UPDATE trips
+SET
+    "planning_digest_sent_at" = NOW()
 WHERE
-    id = $1
+    id IN (
+        SELECT t.id
+        FROM trips t
+        WHERE
+            t.status = 'draft'
+            AND t.archived_at IS NULL
+            AND (t.settings->>'planning_digest')::BOOLEAN
+            AND t.starts_at > NOW()
+            AND COALESCE(t.planning_digest_sent_at, t.created_at, $1) <= $1
+        FOR UPDATE SKIP LOCKED
+    )
+RETURNING "id"
 `
 
-func (q *Queries) GetTrip(ctx context.Context, id uuid.UUID) (Trip, error) {
-	row := q.db.QueryRow(ctx, getTrip, id)
-	var i Trip
-	err := row.Scan(
-		&i.ID,
-		&i.Destination,
-		&i.OwnerEmail,
-		&i.OwnerName,
-		&i.IsConfirmed,
-		&i.StartsAt,
-		&i.EndsAt,
-	)
-	return i, err
+func (q *Queries) ClaimDueTripPlanningDigests(ctx context.Context, planningDigestSentAt pgtype.Timestamp) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, claimDueTripPlanningDigests, planningDigestSentAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-const getTripActivities = `-- name: GetTripActivities :many
-SELECT
-    "id", "trip_id", "title", "occurs_at"
-FROM activities
+const claimDueTripSheets = `-- name: ClaimDueTripSheets :many
+UPDATE trip_sheets
+SET
+    "synced_at" = NOW()
 WHERE
-    trip_id = $1
-ORDER BY occurs_at
+    trip_id IN (
+        SELECT s.trip_id
+        FROM trip_sheets s
+        JOIN trips t ON t.id = s.trip_id
+        WHERE s.spreadsheet_id IS NOT NULL AND s.synced_at < $1 AND t.archived_at IS NULL
+        FOR UPDATE OF s SKIP LOCKED
+    )
+RETURNING "trip_id", "state", "refresh_token", "spreadsheet_id", "spreadsheet_url", "synced_at", "created_at"
 `
 
-func (q *Queries) GetTripActivities(ctx context.Context, tripID uuid.UUID) ([]Activity, error) {
-	rows, err := q.db.Query(ctx, getTripActivities, tripID)
+func (q *Queries) ClaimDueTripSheets(ctx context.Context, syncedAt pgtype.Timestamp) ([]TripSheet, error) {
+	rows, err := q.db.Query(ctx, claimDueTripSheets, syncedAt)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Activity
+	var items []TripSheet
 	for rows.Next() {
-		var i Activity
+		var i TripSheet
 		if err := rows.Scan(
-			&i.ID,
 			&i.TripID,
-			&i.Title,
-			&i.OccursAt,
+			&i.State,
+			&i.RefreshToken,
+			&i.SpreadsheetID,
+			&i.SpreadsheetUrl,
+			&i.SyncedAt,
+			&i.CreatedAt,
 		); err != nil {
 			return nil, err
 		}
@@ -175,32 +249,33 @@ func (q *Queries) GetTripActivities(ctx context.Context, tripID uuid.UUID) ([]Ac
 	return items, nil
 }
 
-const getTripLinks = `-- name: GetTripLinks :many
-SELECT
-    "id", "trip_id", "title", "url"
-FROM links
+const claimDueTripSummaries = `-- name: ClaimDueTripSummaries :many
+UPDATE trips
+SET
+    "summary_sent_at" = NOW()
 WHERE
-    trip_id = $1
+    id IN (
+        SELECT t.id
+        FROM trips t
+        WHERE t.summary_sent_at IS NULL AND t.archived_at IS NULL AND t.created_at <= $1
+        FOR UPDATE SKIP LOCKED
+    )
+RETURNING "id"
 `
 
-func (q *Queries) GetTripLinks(ctx context.Context, tripID uuid.UUID) ([]Link, error) {
-	rows, err := q.db.Query(ctx, getTripLinks, tripID)
+func (q *Queries) ClaimDueTripSummaries(ctx context.Context, createdAt pgtype.Timestamp) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, claimDueTripSummaries, createdAt)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []Link
+	var items []uuid.UUID
 	for rows.Next() {
-		var i Link
-		if err := rows.Scan(
-			&i.ID,
-			&i.TripID,
-			&i.Title,
-			&i.Url,
-		); err != nil {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
 			return nil, err
 		}
-		items = append(items, i)
+		items = append(items, id)
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
@@ -208,66 +283,5241 @@ func (q *Queries) GetTripLinks(ctx context.Context, tripID uuid.UUID) ([]Link, e
 	return items, nil
 }
 
-const insertTrip = `-- name: InsertTrip :one
-INSERT
-INTO trips
-    ( "destination", "owner_email", "owner_name", "starts_at", "ends_at") VALUES
-    ( $1, $2, $3, $4, $5 )
+const claimEndedTripSurveys = `-- name: ClaimEndedTripSurveys :many
+INSERT INTO trip_surveys
+    ( "trip_id" )
+SELECT t.id
+FROM trips t
+WHERE
+    t.status = 'finished' AND t.archived_at IS NULL AND t.ends_at >= $1
+ON CONFLICT (trip_id) DO NOTHING
+RETURNING "trip_id"
+`
+
+func (q *Queries) ClaimEndedTripSurveys(ctx context.Context, endsAt pgtype.Timestamp) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, claimEndedTripSurveys, endsAt)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var trip_id uuid.UUID
+		if err := rows.Scan(&trip_id); err != nil {
+			return nil, err
+		}
+		items = append(items, trip_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const claimNotifications = `-- name: ClaimNotifications :many
+INSERT INTO sent_notifications
+    ( "participant_id", "kind", "trip_id" )
+SELECT p.id, $1::VARCHAR, p.trip_id
+FROM participants p
+WHERE
+    p.id = ANY($2::uuid[])
+ON CONFLICT (participant_id, kind) DO NOTHING
+RETURNING "participant_id"
+`
+
+type ClaimNotificationsParams struct {
+	Kind           string      `db:"kind" json:"kind"`
+	ParticipantIds []uuid.UUID `db:"participant_ids" json:"participant_ids"`
+}
+
+func (q *Queries) ClaimNotifications(ctx context.Context, arg ClaimNotificationsParams) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, claimNotifications, arg.Kind, arg.ParticipantIds)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var participant_id uuid.UUID
+		if err := rows.Scan(&participant_id); err != nil {
+			return nil, err
+		}
+		items = append(items, participant_id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const claimOverdueTasks = `-- name: ClaimOverdueTasks :many
+UPDATE tasks
+SET
+    "overdue_notified_at" = NOW()
+WHERE
+    id IN (
+        SELECT k.id
+        FROM tasks k
+        JOIN trips t ON t.id = k.trip_id
+        WHERE
+            NOT k.is_done
+            AND k.overdue_notified_at IS NULL
+            AND t.archived_at IS NULL
+            AND k.due_on < (NOW() AT TIME ZONE (t.settings->>'timezone'))::DATE
+            AND EXTRACT(HOUR FROM NOW() AT TIME ZONE (t.settings->>'timezone')) >= (t.settings->>'reminder_hour')::INT
+        FOR UPDATE OF k SKIP LOCKED
+    )
 RETURNING "id"
 `
 
-type InsertTripParams struct {
-	Destination string           `db:"destination" json:"destination"`
-	OwnerEmail  string           `db:"owner_email" json:"owner_email"`
-	OwnerName   string           `db:"owner_name" json:"owner_name"`
-	StartsAt    pgtype.Timestamp `db:"starts_at" json:"starts_at"`
-	EndsAt      pgtype.Timestamp `db:"ends_at" json:"ends_at"`
+func (q *Queries) ClaimOverdueTasks(ctx context.Context) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, claimOverdueTasks)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
 }
 
-func (q *Queries) InsertTrip(ctx context.Context, arg InsertTripParams) (uuid.UUID, error) {
-	row := q.db.QueryRow(ctx, insertTrip,
-		arg.Destination,
-		arg.OwnerEmail,
-		arg.OwnerName,
-		arg.StartsAt,
-		arg.EndsAt,
-	)
-	var id uuid.UUID
-	err := row.Scan(&id)
-	return id, err
+const claimSchedulerRun = `-- name: ClaimSchedulerRun :execrows
+INSERT INTO scheduler_runs
+    ( "name", "ran_at" ) VALUES
+    ( $1, NOW() )
+ON CONFLICT (name) DO UPDATE
+SET
+    "ran_at" = NOW()
+WHERE
+    scheduler_runs.ran_at <= NOW() - make_interval(secs => $2)
+`
+
+type ClaimSchedulerRunParams struct {
+	Name          string  `db:"name" json:"name"`
+	WindowSeconds float64 `db:"window_seconds" json:"window_seconds"`
 }
 
-type InviteParticipantsToTripParams struct {
-	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
-	Email  string    `db:"email" json:"email"`
+func (q *Queries) ClaimSchedulerRun(ctx context.Context, arg ClaimSchedulerRunParams) (int64, error) {
+	result, err := q.db.Exec(ctx, claimSchedulerRun, arg.Name, arg.WindowSeconds)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
 }
 
-const updateTrip = `-- name: UpdateTrip :exec
-UPDATE trips
-SET 
-    "destination" = $1,
-    "ends_at" = $2,
-    "starts_at" = $3,
-    "is_confirmed" = $4
+const claimShoppingItem = `-- name: ClaimShoppingItem :execrows
+UPDATE shopping_items
+SET
+    "claimed_by" = $1
 WHERE
-    id = $5
+    id = $2 AND purchased_at IS NULL AND (claimed_by IS NULL OR claimed_by = $1)
 `
 
-type UpdateTripParams struct {
-	Destination string           `db:"destination" json:"destination"`
-	EndsAt      pgtype.Timestamp `db:"ends_at" json:"ends_at"`
-	StartsAt    pgtype.Timestamp `db:"starts_at" json:"starts_at"`
-	IsConfirmed bool             `db:"is_confirmed" json:"is_confirmed"`
-	ID          uuid.UUID        `db:"id" json:"id"`
+type ClaimShoppingItemParams struct {
+	ClaimedBy pgtype.UUID `db:"claimed_by" json:"claimed_by"`
+	ID        uuid.UUID   `db:"id" json:"id"`
 }
 
-func (q *Queries) UpdateTrip(ctx context.Context, arg UpdateTripParams) error {
-	_, err := q.db.Exec(ctx, updateTrip,
-		arg.Destination,
-		arg.EndsAt,
-		arg.StartsAt,
-		arg.IsConfirmed,
-		arg.ID,
+func (q *Queries) ClaimShoppingItem(ctx context.Context, arg ClaimShoppingItemParams) (int64, error) {
+	result, err := q.db.Exec(ctx, claimShoppingItem, arg.ClaimedBy, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const claimTripReminder = `-- name: ClaimTripReminder :execrows
+INSERT INTO trip_reminders
+    ( "trip_id", "starts_at", "days_before" ) VALUES
+    ( $1, $2, $3 )
+ON CONFLICT DO NOTHING
+`
+
+type ClaimTripReminderParams struct {
+	TripID     uuid.UUID        `db:"trip_id" json:"trip_id"`
+	StartsAt   pgtype.Timestamp `db:"starts_at" json:"starts_at"`
+	DaysBefore int32            `db:"days_before" json:"days_before"`
+}
+
+func (q *Queries) ClaimTripReminder(ctx context.Context, arg ClaimTripReminderParams) (int64, error) {
+	result, err := q.db.Exec(ctx, claimTripReminder, arg.TripID, arg.StartsAt, arg.DaysBefore)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const clearParticipantGroup = `-- name: ClearParticipantGroup :exec
+UPDATE participants
+SET
+    "group_id" = NULL
+WHERE
+    group_id = $1 AND NOT (id = ANY($2::UUID[]))
+`
+
+type ClearParticipantGroupParams struct {
+	GroupID pgtype.UUID `db:"group_id" json:"group_id"`
+	Keep    []uuid.UUID `db:"keep" json:"keep"`
+}
+
+func (q *Queries) ClearParticipantGroup(ctx context.Context, arg ClearParticipantGroupParams) error {
+	_, err := q.db.Exec(ctx, clearParticipantGroup, arg.GroupID, arg.Keep)
+	return err
+}
+
+const clearRoomAssignments = `-- name: ClearRoomAssignments :exec
+DELETE FROM room_assignments
+WHERE
+    room_id = $1 OR (lodging_id = $2 AND participant_id = ANY($3::UUID[]))
+`
+
+type ClearRoomAssignmentsParams struct {
+	RoomID         uuid.UUID   `db:"room_id" json:"room_id"`
+	LodgingID      uuid.UUID   `db:"lodging_id" json:"lodging_id"`
+	ParticipantIds []uuid.UUID `db:"participant_ids" json:"participant_ids"`
+}
+
+func (q *Queries) ClearRoomAssignments(ctx context.Context, arg ClearRoomAssignmentsParams) error {
+	_, err := q.db.Exec(ctx, clearRoomAssignments, arg.RoomID, arg.LodgingID, arg.ParticipantIds)
+	return err
+}
+
+const completeAttachment = `-- name: CompleteAttachment :one
+UPDATE attachments
+SET
+    "size_bytes" = $1,
+    "uploaded_at" = NOW()
+WHERE
+    id = $2
+RETURNING "id", "trip_id", "filename", "content_type", "size_bytes", "uploaded_at", "created_at", "uploaded_by", "scan_status", "scan_signature", "scanned_at"
+`
+
+type CompleteAttachmentParams struct {
+	SizeBytes pgtype.Int8 `db:"size_bytes" json:"size_bytes"`
+	ID        uuid.UUID   `db:"id" json:"id"`
+}
+
+func (q *Queries) CompleteAttachment(ctx context.Context, arg CompleteAttachmentParams) (Attachment, error) {
+	row := q.db.QueryRow(ctx, completeAttachment, arg.SizeBytes, arg.ID)
+	var i Attachment
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.Filename,
+		&i.ContentType,
+		&i.SizeBytes,
+		&i.UploadedAt,
+		&i.CreatedAt,
+		&i.UploadedBy,
+		&i.ScanStatus,
+		&i.ScanSignature,
+		&i.ScannedAt,
+	)
+	return i, err
+}
+
+const confirmOwnerEmailChange = `-- name: ConfirmOwnerEmailChange :one
+UPDATE owner_email_changes
+SET
+    "old_confirmed_at" = CASE WHEN old_token = $1 THEN COALESCE(old_confirmed_at, NOW()) ELSE old_confirmed_at END,
+    "new_confirmed_at" = CASE WHEN new_token = $1 THEN COALESCE(new_confirmed_at, NOW()) ELSE new_confirmed_at END
+WHERE
+    old_token = $1 OR new_token = $1
+RETURNING "trip_id", "new_email", "old_token", "new_token", "old_confirmed_at", "new_confirmed_at", "expires_at"
+`
+
+func (q *Queries) ConfirmOwnerEmailChange(ctx context.Context, token string) (OwnerEmailChange, error) {
+	row := q.db.QueryRow(ctx, confirmOwnerEmailChange, token)
+	var i OwnerEmailChange
+	err := row.Scan(
+		&i.TripID,
+		&i.NewEmail,
+		&i.OldToken,
+		&i.NewToken,
+		&i.OldConfirmedAt,
+		&i.NewConfirmedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const confirmParticipant = `-- name: ConfirmParticipant :exec
+UPDATE participants
+SET
+    "is_confirmed" = true,
+    "confirmed_at" = COALESCE("confirmed_at", NOW())
+WHERE
+    id = $1
+`
+
+func (q *Queries) ConfirmParticipant(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, confirmParticipant, id)
+	return err
+}
+
+const confirmTripParticipants = `-- name: ConfirmTripParticipants :many
+UPDATE participants
+SET
+    "is_confirmed" = true,
+    "confirmed_at" = COALESCE("confirmed_at", NOW())
+WHERE
+    trip_id = $1 AND id = ANY($2::uuid[]) AND NOT is_confirmed
+RETURNING "id"
+`
+
+type ConfirmTripParticipantsParams struct {
+	TripID uuid.UUID   `db:"trip_id" json:"trip_id"`
+	Ids    []uuid.UUID `db:"ids" json:"ids"`
+}
+
+func (q *Queries) ConfirmTripParticipants(ctx context.Context, arg ConfirmTripParticipantsParams) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, confirmTripParticipants, arg.TripID, arg.Ids)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const connectTripSheet = `-- name: ConnectTripSheet :one
+UPDATE trip_sheets
+SET
+    "state" = NULL,
+    "refresh_token" = $2,
+    "spreadsheet_id" = $3,
+    "spreadsheet_url" = $4,
+    "synced_at" = NOW()
+WHERE
+    trip_id = $1
+RETURNING "trip_id", "state", "refresh_token", "spreadsheet_id", "spreadsheet_url", "synced_at", "created_at"
+`
+
+type ConnectTripSheetParams struct {
+	TripID         uuid.UUID   `db:"trip_id" json:"trip_id"`
+	RefreshToken   pgtype.Text `db:"refresh_token" json:"refresh_token"`
+	SpreadsheetID  pgtype.Text `db:"spreadsheet_id" json:"spreadsheet_id"`
+	SpreadsheetUrl pgtype.Text `db:"spreadsheet_url" json:"spreadsheet_url"`
+}
+
+func (q *Queries) ConnectTripSheet(ctx context.Context, arg ConnectTripSheetParams) (TripSheet, error) {
+	row := q.db.QueryRow(ctx, connectTripSheet,
+		arg.TripID,
+		arg.RefreshToken,
+		arg.SpreadsheetID,
+		arg.SpreadsheetUrl,
+	)
+	var i TripSheet
+	err := row.Scan(
+		&i.TripID,
+		&i.State,
+		&i.RefreshToken,
+		&i.SpreadsheetID,
+		&i.SpreadsheetUrl,
+		&i.SyncedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const correctParticipantEmail = `-- name: CorrectParticipantEmail :exec
+UPDATE participants
+SET
+    "email" = $1,
+    "status" = 'invited'
+WHERE
+    id = $2
+`
+
+type CorrectParticipantEmailParams struct {
+	Email string    `db:"email" json:"email"`
+	ID    uuid.UUID `db:"id" json:"id"`
+}
+
+func (q *Queries) CorrectParticipantEmail(ctx context.Context, arg CorrectParticipantEmailParams) error {
+	_, err := q.db.Exec(ctx, correctParticipantEmail, arg.Email, arg.ID)
+	return err
+}
+
+const countActiveParticipants = `-- name: CountActiveParticipants :one
+SELECT
+    (
+        (SELECT COUNT(*) FROM participants p WHERE p.trip_id = $1 AND p.status IN ('invited', 'email_invalid')) +
+        (SELECT COUNT(*) FROM companions c JOIN participants p ON p.id = c.participant_id WHERE p.trip_id = $1 AND p.status IN ('invited', 'email_invalid'))
+    )::BIGINT AS count
+`
+
+func (q *Queries) CountActiveParticipants(ctx context.Context, tripID uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countActiveParticipants, tripID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countOpenContentReports = `-- name: CountOpenContentReports :one
+SELECT
+    COUNT(*)
+FROM content_reports
+WHERE
+    trip_id = $1 AND resolved_at IS NULL
+`
+
+func (q *Queries) CountOpenContentReports(ctx context.Context, tripID uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countOpenContentReports, tripID)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
+const countRecentAuditEvents = `-- name: CountRecentAuditEvents :one
+SELECT
+    COUNT(*) AS count,
+    MIN(created_at)::TIMESTAMP AS oldest
+FROM audit_events
+WHERE
+    trip_id = $1 AND action = $2 AND created_at > $3
+`
+
+type CountRecentAuditEventsParams struct {
+	TripID uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Action string           `db:"action" json:"action"`
+	Since  pgtype.Timestamp `db:"since" json:"since"`
+}
+
+type CountRecentAuditEventsRow struct {
+	Count  int64            `db:"count" json:"count"`
+	Oldest pgtype.Timestamp `db:"oldest" json:"oldest"`
+}
+
+func (q *Queries) CountRecentAuditEvents(ctx context.Context, arg CountRecentAuditEventsParams) (CountRecentAuditEventsRow, error) {
+	row := q.db.QueryRow(ctx, countRecentAuditEvents, arg.TripID, arg.Action, arg.Since)
+	var i CountRecentAuditEventsRow
+	err := row.Scan(&i.Count, &i.Oldest)
+	return i, err
+}
+
+const countRideSeatsTaken = `-- name: CountRideSeatsTaken :one
+SELECT
+    COALESCE(SUM(seats), 0)::BIGINT AS taken
+FROM ride_passengers
+WHERE
+    ride_id = $1
+`
+
+func (q *Queries) CountRideSeatsTaken(ctx context.Context, rideID uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, countRideSeatsTaken, rideID)
+	var taken int64
+	err := row.Scan(&taken)
+	return taken, err
+}
+
+const countTripsCreatedPerDay = `-- name: CountTripsCreatedPerDay :many
+SELECT
+    created_at::DATE AS day,
+    COUNT(*) AS trips
+FROM trips
+WHERE
+    created_at >= $1
+GROUP BY day
+ORDER BY day
+`
+
+type CountTripsCreatedPerDayRow struct {
+	Day   pgtype.Date `db:"day" json:"day"`
+	Trips int64       `db:"trips" json:"trips"`
+}
+
+func (q *Queries) CountTripsCreatedPerDay(ctx context.Context, since pgtype.Timestamp) ([]CountTripsCreatedPerDayRow, error) {
+	rows, err := q.db.Query(ctx, countTripsCreatedPerDay, since)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []CountTripsCreatedPerDayRow
+	for rows.Next() {
+		var i CountTripsCreatedPerDayRow
+		if err := rows.Scan(&i.Day, &i.Trips); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const createActivity = `-- name: CreateActivity :one
+INSERT INTO activities
+    ( "trip_id", "title", "occurs_at", "tags", "duration_minutes", "cost_cents", "status", "latitude", "longitude", "invite_sequence", "currency" ) VALUES
+    ( $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11 )
+RETURNING "id"
+`
+
+type CreateActivityParams struct {
+	TripID          uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Title           string           `db:"title" json:"title"`
+	OccursAt        pgtype.Timestamp `db:"occurs_at" json:"occurs_at"`
+	Tags            []string         `db:"tags" json:"tags"`
+	DurationMinutes pgtype.Int4      `db:"duration_minutes" json:"duration_minutes"`
+	CostCents       int64            `db:"cost_cents" json:"cost_cents"`
+	Status          string           `db:"status" json:"status"`
+	Latitude        pgtype.Float8    `db:"latitude" json:"latitude"`
+	Longitude       pgtype.Float8    `db:"longitude" json:"longitude"`
+	InviteSequence  pgtype.Int4      `db:"invite_sequence" json:"invite_sequence"`
+	Currency        pgtype.Text      `db:"currency" json:"currency"`
+}
+
+func (q *Queries) CreateActivity(ctx context.Context, arg CreateActivityParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createActivity,
+		arg.TripID,
+		arg.Title,
+		arg.OccursAt,
+		arg.Tags,
+		arg.DurationMinutes,
+		arg.CostCents,
+		arg.Status,
+		arg.Latitude,
+		arg.Longitude,
+		arg.InviteSequence,
+		arg.Currency,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createAttachment = `-- name: CreateAttachment :one
+INSERT INTO attachments
+    ( "trip_id", "filename", "content_type", "uploaded_by" ) VALUES
+    ( $1, $2, $3, $4 )
+RETURNING "id"
+`
+
+type CreateAttachmentParams struct {
+	TripID      uuid.UUID   `db:"trip_id" json:"trip_id"`
+	Filename    string      `db:"filename" json:"filename"`
+	ContentType string      `db:"content_type" json:"content_type"`
+	UploadedBy  pgtype.UUID `db:"uploaded_by" json:"uploaded_by"`
+}
+
+func (q *Queries) CreateAttachment(ctx context.Context, arg CreateAttachmentParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createAttachment,
+		arg.TripID,
+		arg.Filename,
+		arg.ContentType,
+		arg.UploadedBy,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createChecklistItem = `-- name: CreateChecklistItem :one
+INSERT INTO checklist_items
+    ( "trip_id", "title", "category" ) VALUES
+    ( $1, $2, $3 )
+RETURNING "id"
+`
+
+type CreateChecklistItemParams struct {
+	TripID   uuid.UUID `db:"trip_id" json:"trip_id"`
+	Title    string    `db:"title" json:"title"`
+	Category string    `db:"category" json:"category"`
+}
+
+func (q *Queries) CreateChecklistItem(ctx context.Context, arg CreateChecklistItemParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createChecklistItem, arg.TripID, arg.Title, arg.Category)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createCompanion = `-- name: CreateCompanion :one
+INSERT INTO companions
+    ( "participant_id", "name" ) VALUES
+    ( $1, $2 )
+RETURNING "id"
+`
+
+type CreateCompanionParams struct {
+	ParticipantID uuid.UUID `db:"participant_id" json:"participant_id"`
+	Name          string    `db:"name" json:"name"`
+}
+
+func (q *Queries) CreateCompanion(ctx context.Context, arg CreateCompanionParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createCompanion, arg.ParticipantID, arg.Name)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createDatePollToken = `-- name: CreateDatePollToken :exec
+INSERT INTO date_poll_tokens
+    ( "token", "participant_id" ) VALUES
+    ( $1, $2 )
+ON CONFLICT (participant_id) DO NOTHING
+`
+
+type CreateDatePollTokenParams struct {
+	Token         string    `db:"token" json:"token"`
+	ParticipantID uuid.UUID `db:"participant_id" json:"participant_id"`
+}
+
+func (q *Queries) CreateDatePollToken(ctx context.Context, arg CreateDatePollTokenParams) error {
+	_, err := q.db.Exec(ctx, createDatePollToken, arg.Token, arg.ParticipantID)
+	return err
+}
+
+const createLodging = `-- name: CreateLodging :one
+INSERT INTO lodgings
+    ( "trip_id", "name", "address", "check_in", "check_out", "cost_cents", "status" ) VALUES
+    ( $1, $2, $3, $4, $5, $6, $7 )
+RETURNING "id"
+`
+
+type CreateLodgingParams struct {
+	TripID    uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Name      string           `db:"name" json:"name"`
+	Address   string           `db:"address" json:"address"`
+	CheckIn   pgtype.Timestamp `db:"check_in" json:"check_in"`
+	CheckOut  pgtype.Timestamp `db:"check_out" json:"check_out"`
+	CostCents int64            `db:"cost_cents" json:"cost_cents"`
+	Status    string           `db:"status" json:"status"`
+}
+
+func (q *Queries) CreateLodging(ctx context.Context, arg CreateLodgingParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createLodging,
+		arg.TripID,
+		arg.Name,
+		arg.Address,
+		arg.CheckIn,
+		arg.CheckOut,
+		arg.CostCents,
+		arg.Status,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createLodgingRoom = `-- name: CreateLodgingRoom :one
+INSERT INTO lodging_rooms
+    ( "lodging_id", "name", "capacity", "beds" ) VALUES
+    ( $1, $2, $3, $4 )
+RETURNING "id"
+`
+
+type CreateLodgingRoomParams struct {
+	LodgingID uuid.UUID `db:"lodging_id" json:"lodging_id"`
+	Name      string    `db:"name" json:"name"`
+	Capacity  int32     `db:"capacity" json:"capacity"`
+	Beds      string    `db:"beds" json:"beds"`
+}
+
+func (q *Queries) CreateLodgingRoom(ctx context.Context, arg CreateLodgingRoomParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createLodgingRoom,
+		arg.LodgingID,
+		arg.Name,
+		arg.Capacity,
+		arg.Beds,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createOwnerEmailChange = `-- name: CreateOwnerEmailChange :exec
+INSERT INTO owner_email_changes
+    ( "trip_id", "new_email", "old_token", "new_token", "expires_at" ) VALUES
+    ( $1, $2, $3, $4, $5 )
+ON CONFLICT (trip_id) DO UPDATE SET
+    "new_email" = EXCLUDED.new_email,
+    "old_token" = EXCLUDED.old_token,
+    "new_token" = EXCLUDED.new_token,
+    "old_confirmed_at" = NULL,
+    "new_confirmed_at" = NULL,
+    "expires_at" = EXCLUDED.expires_at
+`
+
+type CreateOwnerEmailChangeParams struct {
+	TripID    uuid.UUID        `db:"trip_id" json:"trip_id"`
+	NewEmail  string           `db:"new_email" json:"new_email"`
+	OldToken  string           `db:"old_token" json:"old_token"`
+	NewToken  string           `db:"new_token" json:"new_token"`
+	ExpiresAt pgtype.Timestamp `db:"expires_at" json:"expires_at"`
+}
+
+func (q *Queries) CreateOwnerEmailChange(ctx context.Context, arg CreateOwnerEmailChangeParams) error {
+	_, err := q.db.Exec(ctx, createOwnerEmailChange,
+		arg.TripID,
+		arg.NewEmail,
+		arg.OldToken,
+		arg.NewToken,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
+const createOwnershipTransfer = `-- name: CreateOwnershipTransfer :exec
+INSERT INTO ownership_transfers
+    ( "token", "trip_id", "participant_id", "expires_at" ) VALUES
+    ( $1, $2, $3, $4 )
+ON CONFLICT (trip_id) DO UPDATE SET
+    "token" = EXCLUDED.token,
+    "participant_id" = EXCLUDED.participant_id,
+    "expires_at" = EXCLUDED.expires_at
+`
+
+type CreateOwnershipTransferParams struct {
+	Token         string           `db:"token" json:"token"`
+	TripID        uuid.UUID        `db:"trip_id" json:"trip_id"`
+	ParticipantID uuid.UUID        `db:"participant_id" json:"participant_id"`
+	ExpiresAt     pgtype.Timestamp `db:"expires_at" json:"expires_at"`
+}
+
+func (q *Queries) CreateOwnershipTransfer(ctx context.Context, arg CreateOwnershipTransferParams) error {
+	_, err := q.db.Exec(ctx, createOwnershipTransfer,
+		arg.Token,
+		arg.TripID,
+		arg.ParticipantID,
+		arg.ExpiresAt,
+	)
+	return err
+}
+
+const createReceipt = `-- name: CreateReceipt :one
+INSERT INTO receipts
+    ( "trip_id", "content_type", "data", "merchant", "amount_cents", "spent_at" ) VALUES
+    ( $1, $2, $3, $4, $5, $6 )
+RETURNING "id"
+`
+
+type CreateReceiptParams struct {
+	TripID      uuid.UUID        `db:"trip_id" json:"trip_id"`
+	ContentType string           `db:"content_type" json:"content_type"`
+	Data        []byte           `db:"data" json:"data"`
+	Merchant    pgtype.Text      `db:"merchant" json:"merchant"`
+	AmountCents pgtype.Int8      `db:"amount_cents" json:"amount_cents"`
+	SpentAt     pgtype.Timestamp `db:"spent_at" json:"spent_at"`
+}
+
+func (q *Queries) CreateReceipt(ctx context.Context, arg CreateReceiptParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createReceipt,
+		arg.TripID,
+		arg.ContentType,
+		arg.Data,
+		arg.Merchant,
+		arg.AmountCents,
+		arg.SpentAt,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createRide = `-- name: CreateRide :one
+INSERT INTO rides
+    ( "trip_id", "driver_id", "departs_at", "departure_point", "seats" ) VALUES
+    ( $1, $2, $3, $4, $5 )
+RETURNING "id"
+`
+
+type CreateRideParams struct {
+	TripID         uuid.UUID        `db:"trip_id" json:"trip_id"`
+	DriverID       uuid.UUID        `db:"driver_id" json:"driver_id"`
+	DepartsAt      pgtype.Timestamp `db:"departs_at" json:"departs_at"`
+	DeparturePoint string           `db:"departure_point" json:"departure_point"`
+	Seats          int32            `db:"seats" json:"seats"`
+}
+
+func (q *Queries) CreateRide(ctx context.Context, arg CreateRideParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createRide,
+		arg.TripID,
+		arg.DriverID,
+		arg.DepartsAt,
+		arg.DeparturePoint,
+		arg.Seats,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createShoppingItem = `-- name: CreateShoppingItem :one
+INSERT INTO shopping_items
+    ( "trip_id", "title", "quantity" ) VALUES
+    ( $1, $2, $3 )
+RETURNING "id"
+`
+
+type CreateShoppingItemParams struct {
+	TripID   uuid.UUID `db:"trip_id" json:"trip_id"`
+	Title    string    `db:"title" json:"title"`
+	Quantity string    `db:"quantity" json:"quantity"`
+}
+
+func (q *Queries) CreateShoppingItem(ctx context.Context, arg CreateShoppingItemParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createShoppingItem, arg.TripID, arg.Title, arg.Quantity)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createSurveyToken = `-- name: CreateSurveyToken :exec
+INSERT INTO survey_tokens
+    ( "token", "participant_id" ) VALUES
+    ( $1, $2 )
+ON CONFLICT (participant_id) DO NOTHING
+`
+
+type CreateSurveyTokenParams struct {
+	Token         string    `db:"token" json:"token"`
+	ParticipantID uuid.UUID `db:"participant_id" json:"participant_id"`
+}
+
+func (q *Queries) CreateSurveyToken(ctx context.Context, arg CreateSurveyTokenParams) error {
+	_, err := q.db.Exec(ctx, createSurveyToken, arg.Token, arg.ParticipantID)
+	return err
+}
+
+const createTask = `-- name: CreateTask :one
+INSERT INTO tasks
+    ( "trip_id", "title", "due_on", "assignee_id" ) VALUES
+    ( $1, $2, $3, $4 )
+RETURNING "id"
+`
+
+type CreateTaskParams struct {
+	TripID     uuid.UUID   `db:"trip_id" json:"trip_id"`
+	Title      string      `db:"title" json:"title"`
+	DueOn      pgtype.Date `db:"due_on" json:"due_on"`
+	AssigneeID pgtype.UUID `db:"assignee_id" json:"assignee_id"`
+}
+
+func (q *Queries) CreateTask(ctx context.Context, arg CreateTaskParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createTask,
+		arg.TripID,
+		arg.Title,
+		arg.DueOn,
+		arg.AssigneeID,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createTransport = `-- name: CreateTransport :one
+INSERT INTO transports
+    ( "trip_id", "mode", "origin", "destination", "departs_at", "arrives_at" ) VALUES
+    ( $1, $2, $3, $4, $5, $6 )
+RETURNING "id"
+`
+
+type CreateTransportParams struct {
+	TripID      uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Mode        string           `db:"mode" json:"mode"`
+	Origin      string           `db:"origin" json:"origin"`
+	Destination string           `db:"destination" json:"destination"`
+	DepartsAt   pgtype.Timestamp `db:"departs_at" json:"departs_at"`
+	ArrivesAt   pgtype.Timestamp `db:"arrives_at" json:"arrives_at"`
+}
+
+func (q *Queries) CreateTransport(ctx context.Context, arg CreateTransportParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createTransport,
+		arg.TripID,
+		arg.Mode,
+		arg.Origin,
+		arg.Destination,
+		arg.DepartsAt,
+		arg.ArrivesAt,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const createTripLink = `-- name: CreateTripLink :one
+INSERT INTO links
+    ( "trip_id", "title", "url" ) VALUES
+    ( $1, $2, $3 )
+RETURNING "id"
+`
+
+type CreateTripLinkParams struct {
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+	Title  string    `db:"title" json:"title"`
+	Url    string    `db:"url" json:"url"`
+}
+
+func (q *Queries) CreateTripLink(ctx context.Context, arg CreateTripLinkParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, createTripLink, arg.TripID, arg.Title, arg.Url)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const deleteActivity = `-- name: DeleteActivity :exec
+DELETE FROM activities
+WHERE
+    id = $1
+`
+
+func (q *Queries) DeleteActivity(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteActivity, id)
+	return err
+}
+
+const deleteAttachment = `-- name: DeleteAttachment :execrows
+DELETE FROM attachments
+WHERE
+    id = $1
+`
+
+func (q *Queries) DeleteAttachment(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteAttachment, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteChecklistItem = `-- name: DeleteChecklistItem :exec
+DELETE FROM checklist_items
+WHERE
+    id = $1
+`
+
+func (q *Queries) DeleteChecklistItem(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteChecklistItem, id)
+	return err
+}
+
+const deleteCompanion = `-- name: DeleteCompanion :exec
+DELETE FROM companions
+WHERE
+    id = $1
+`
+
+func (q *Queries) DeleteCompanion(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteCompanion, id)
+	return err
+}
+
+const deleteLodging = `-- name: DeleteLodging :exec
+DELETE FROM lodgings
+WHERE
+    id = $1
+`
+
+func (q *Queries) DeleteLodging(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteLodging, id)
+	return err
+}
+
+const deleteLodgingRoom = `-- name: DeleteLodgingRoom :exec
+DELETE FROM lodging_rooms
+WHERE
+    id = $1
+`
+
+func (q *Queries) DeleteLodgingRoom(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteLodgingRoom, id)
+	return err
+}
+
+const deleteOwnerEmailChange = `-- name: DeleteOwnerEmailChange :exec
+DELETE FROM owner_email_changes
+WHERE
+    trip_id = $1
+`
+
+func (q *Queries) DeleteOwnerEmailChange(ctx context.Context, tripID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteOwnerEmailChange, tripID)
+	return err
+}
+
+const deleteOwnershipTransfer = `-- name: DeleteOwnershipTransfer :exec
+DELETE FROM ownership_transfers
+WHERE
+    token = $1
+`
+
+func (q *Queries) DeleteOwnershipTransfer(ctx context.Context, token string) error {
+	_, err := q.db.Exec(ctx, deleteOwnershipTransfer, token)
+	return err
+}
+
+const deleteParticipantGroup = `-- name: DeleteParticipantGroup :exec
+DELETE FROM participant_groups
+WHERE
+    id = $1
+`
+
+func (q *Queries) DeleteParticipantGroup(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteParticipantGroup, id)
+	return err
+}
+
+const deleteRide = `-- name: DeleteRide :exec
+DELETE FROM rides
+WHERE
+    id = $1
+`
+
+func (q *Queries) DeleteRide(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteRide, id)
+	return err
+}
+
+const deleteRidePassenger = `-- name: DeleteRidePassenger :execrows
+DELETE FROM ride_passengers
+WHERE
+    ride_id = $1 AND participant_id = $2
+`
+
+type DeleteRidePassengerParams struct {
+	RideID        uuid.UUID `db:"ride_id" json:"ride_id"`
+	ParticipantID uuid.UUID `db:"participant_id" json:"participant_id"`
+}
+
+func (q *Queries) DeleteRidePassenger(ctx context.Context, arg DeleteRidePassengerParams) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteRidePassenger, arg.RideID, arg.ParticipantID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteShoppingItem = `-- name: DeleteShoppingItem :exec
+DELETE FROM shopping_items
+WHERE
+    id = $1
+`
+
+func (q *Queries) DeleteShoppingItem(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteShoppingItem, id)
+	return err
+}
+
+const deleteSurveyQuestions = `-- name: DeleteSurveyQuestions :exec
+DELETE FROM survey_questions
+WHERE
+    trip_id = $1
+`
+
+func (q *Queries) DeleteSurveyQuestions(ctx context.Context, tripID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteSurveyQuestions, tripID)
+	return err
+}
+
+const deleteTask = `-- name: DeleteTask :exec
+DELETE FROM tasks
+WHERE
+    id = $1
+`
+
+func (q *Queries) DeleteTask(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteTask, id)
+	return err
+}
+
+const deleteTripDatePollOptions = `-- name: DeleteTripDatePollOptions :exec
+DELETE FROM date_poll_options
+WHERE
+    trip_id = $1
+`
+
+func (q *Queries) DeleteTripDatePollOptions(ctx context.Context, tripID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteTripDatePollOptions, tripID)
+	return err
+}
+
+const deleteTripSheet = `-- name: DeleteTripSheet :execrows
+DELETE FROM trip_sheets
+WHERE
+    trip_id = $1
+`
+
+func (q *Queries) DeleteTripSheet(ctx context.Context, tripID uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, deleteTripSheet, tripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const deleteTripTags = `-- name: DeleteTripTags :exec
+DELETE FROM trip_tags
+WHERE
+    trip_id = $1
+`
+
+func (q *Queries) DeleteTripTags(ctx context.Context, tripID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, deleteTripTags, tripID)
+	return err
+}
+
+const findRecentTrip = `-- name: FindRecentTrip :one
+SELECT
+    "id"
+FROM trips
+WHERE
+    owner_email = $1
+    AND destination = $2
+    AND starts_at = $3
+    AND ends_at = $4
+    -- Submitted twice by mistake, not planning the same trip again.
+    AND created_at > NOW() - INTERVAL '10 minutes'
+ORDER BY created_at DESC
+LIMIT 1
+`
+
+type FindRecentTripParams struct {
+	OwnerEmail  string           `db:"owner_email" json:"owner_email"`
+	Destination string           `db:"destination" json:"destination"`
+	StartsAt    pgtype.Timestamp `db:"starts_at" json:"starts_at"`
+	EndsAt      pgtype.Timestamp `db:"ends_at" json:"ends_at"`
+}
+
+func (q *Queries) FindRecentTrip(ctx context.Context, arg FindRecentTripParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, findRecentTrip,
+		arg.OwnerEmail,
+		arg.Destination,
+		arg.StartsAt,
+		arg.EndsAt,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const getActivity = `-- name: GetActivity :one
+SELECT
+    "id", "trip_id", "title", "occurs_at", "tags", "duration_minutes", "cost_cents", "status", "latitude", "longitude", "invite_sequence", "organizer_id", "created_at", "deleted_at", "currency", "expense_id"
+FROM activities
+WHERE
+    id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) GetActivity(ctx context.Context, id uuid.UUID) (Activity, error) {
+	row := q.db.QueryRow(ctx, getActivity, id)
+	var i Activity
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.Title,
+		&i.OccursAt,
+		&i.Tags,
+		&i.DurationMinutes,
+		&i.CostCents,
+		&i.Status,
+		&i.Latitude,
+		&i.Longitude,
+		&i.InviteSequence,
+		&i.OrganizerID,
+		&i.CreatedAt,
+		&i.DeletedAt,
+		&i.Currency,
+		&i.ExpenseID,
+	)
+	return i, err
+}
+
+const getAttachment = `-- name: GetAttachment :one
+SELECT
+    "id", "trip_id", "filename", "content_type", "size_bytes", "uploaded_at", "created_at", "uploaded_by", "scan_status", "scan_signature", "scanned_at"
+FROM attachments
+WHERE
+    id = $1
+`
+
+func (q *Queries) GetAttachment(ctx context.Context, id uuid.UUID) (Attachment, error) {
+	row := q.db.QueryRow(ctx, getAttachment, id)
+	var i Attachment
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.Filename,
+		&i.ContentType,
+		&i.SizeBytes,
+		&i.UploadedAt,
+		&i.CreatedAt,
+		&i.UploadedBy,
+		&i.ScanStatus,
+		&i.ScanSignature,
+		&i.ScannedAt,
+	)
+	return i, err
+}
+
+const getChecklistItem = `-- name: GetChecklistItem :one
+SELECT
+    "id", "trip_id", "title", "category", "is_checked", "template_key"
+FROM checklist_items
+WHERE
+    id = $1
+`
+
+func (q *Queries) GetChecklistItem(ctx context.Context, id uuid.UUID) (ChecklistItem, error) {
+	row := q.db.QueryRow(ctx, getChecklistItem, id)
+	var i ChecklistItem
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.Title,
+		&i.Category,
+		&i.IsChecked,
+		&i.TemplateKey,
+	)
+	return i, err
+}
+
+const getCompanion = `-- name: GetCompanion :one
+SELECT
+    "id", "participant_id", "name", "created_at"
+FROM companions
+WHERE
+    id = $1
+`
+
+func (q *Queries) GetCompanion(ctx context.Context, id uuid.UUID) (Companion, error) {
+	row := q.db.QueryRow(ctx, getCompanion, id)
+	var i Companion
+	err := row.Scan(
+		&i.ID,
+		&i.ParticipantID,
+		&i.Name,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getDatePollOption = `-- name: GetDatePollOption :one
+SELECT
+    "id", "trip_id", "starts_at", "ends_at"
+FROM date_poll_options
+WHERE
+    id = $1
+`
+
+func (q *Queries) GetDatePollOption(ctx context.Context, id uuid.UUID) (DatePollOption, error) {
+	row := q.db.QueryRow(ctx, getDatePollOption, id)
+	var i DatePollOption
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.StartsAt,
+		&i.EndsAt,
+	)
+	return i, err
+}
+
+const getDatePollResults = `-- name: GetDatePollResults :many
+SELECT
+    o."id",
+    o."starts_at",
+    o."ends_at",
+    COUNT(v.participant_id) FILTER (WHERE v.is_available)::BIGINT AS available_count,
+    COUNT(v.participant_id) FILTER (WHERE NOT v.is_available)::BIGINT AS unavailable_count
+FROM date_poll_options o
+LEFT JOIN date_poll_votes v ON v.option_id = o.id
+WHERE
+    o.trip_id = $1
+GROUP BY o.id, o.starts_at, o.ends_at
+ORDER BY o.starts_at
+`
+
+type GetDatePollResultsRow struct {
+	ID               uuid.UUID        `db:"id" json:"id"`
+	StartsAt         pgtype.Timestamp `db:"starts_at" json:"starts_at"`
+	EndsAt           pgtype.Timestamp `db:"ends_at" json:"ends_at"`
+	AvailableCount   int64            `db:"available_count" json:"available_count"`
+	UnavailableCount int64            `db:"unavailable_count" json:"unavailable_count"`
+}
+
+func (q *Queries) GetDatePollResults(ctx context.Context, tripID uuid.UUID) ([]GetDatePollResultsRow, error) {
+	rows, err := q.db.Query(ctx, getDatePollResults, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetDatePollResultsRow
+	for rows.Next() {
+		var i GetDatePollResultsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.StartsAt,
+			&i.EndsAt,
+			&i.AvailableCount,
+			&i.UnavailableCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getDatePollToken = `-- name: GetDatePollToken :one
+SELECT
+    "token", "participant_id"
+FROM date_poll_tokens
+WHERE
+    token = $1
+`
+
+func (q *Queries) GetDatePollToken(ctx context.Context, token string) (DatePollToken, error) {
+	row := q.db.QueryRow(ctx, getDatePollToken, token)
+	var i DatePollToken
+	err := row.Scan(&i.Token, &i.ParticipantID)
+	return i, err
+}
+
+const getExpenseReceipt = `-- name: GetExpenseReceipt :one
+SELECT
+    "id", "trip_id", "expense_id", "content_type", "data", "merchant", "amount_cents", "spent_at", "created_at"
+FROM receipts
+WHERE
+    expense_id = $1
+`
+
+func (q *Queries) GetExpenseReceipt(ctx context.Context, expenseID pgtype.UUID) (Receipt, error) {
+	row := q.db.QueryRow(ctx, getExpenseReceipt, expenseID)
+	var i Receipt
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.ExpenseID,
+		&i.ContentType,
+		&i.Data,
+		&i.Merchant,
+		&i.AmountCents,
+		&i.SpentAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getFirstWaitlistedParticipant = `-- name: GetFirstWaitlistedParticipant :one
+SELECT
+    "id", "trip_id", "email", "is_confirmed", "name", "status", "invited_at", "role", "confirmed_at", "created_at", "group_id"
+FROM participants
+WHERE
+    trip_id = $1 AND status = 'waitlisted'
+ORDER BY invited_at, id
+LIMIT 1
+FOR UPDATE
+`
+
+func (q *Queries) GetFirstWaitlistedParticipant(ctx context.Context, tripID uuid.UUID) (Participant, error) {
+	row := q.db.QueryRow(ctx, getFirstWaitlistedParticipant, tripID)
+	var i Participant
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.Email,
+		&i.IsConfirmed,
+		&i.Name,
+		&i.Status,
+		&i.InvitedAt,
+		&i.Role,
+		&i.ConfirmedAt,
+		&i.CreatedAt,
+		&i.GroupID,
+	)
+	return i, err
+}
+
+const getInstanceTotals = `-- name: GetInstanceTotals :one
+SELECT
+    (SELECT COUNT(*) FROM trips)::BIGINT AS trips,
+    (SELECT COUNT(*) FROM trips WHERE status IN ('confirmed', 'ongoing') AND archived_at IS NULL AND ends_at >= NOW())::BIGINT AS active_trips,
+    (SELECT COUNT(*) FROM participants)::BIGINT AS participants,
+    pg_database_size(current_database())::BIGINT AS database_bytes
+`
+
+type GetInstanceTotalsRow struct {
+	Trips         int64 `db:"trips" json:"trips"`
+	ActiveTrips   int64 `db:"active_trips" json:"active_trips"`
+	Participants  int64 `db:"participants" json:"participants"`
+	DatabaseBytes int64 `db:"database_bytes" json:"database_bytes"`
+}
+
+func (q *Queries) GetInstanceTotals(ctx context.Context) (GetInstanceTotalsRow, error) {
+	row := q.db.QueryRow(ctx, getInstanceTotals)
+	var i GetInstanceTotalsRow
+	err := row.Scan(
+		&i.Trips,
+		&i.ActiveTrips,
+		&i.Participants,
+		&i.DatabaseBytes,
+	)
+	return i, err
+}
+
+const getLodging = `-- name: GetLodging :one
+SELECT
+    "id", "trip_id", "name", "address", "check_in", "check_out", "cost_cents", "status"
+FROM lodgings
+WHERE
+    id = $1
+`
+
+func (q *Queries) GetLodging(ctx context.Context, id uuid.UUID) (Lodging, error) {
+	row := q.db.QueryRow(ctx, getLodging, id)
+	var i Lodging
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.Name,
+		&i.Address,
+		&i.CheckIn,
+		&i.CheckOut,
+		&i.CostCents,
+		&i.Status,
+	)
+	return i, err
+}
+
+const getLodgingRoom = `-- name: GetLodgingRoom :one
+SELECT
+    "id", "lodging_id", "name", "capacity", "beds", "created_at"
+FROM lodging_rooms
+WHERE
+    id = $1
+`
+
+func (q *Queries) GetLodgingRoom(ctx context.Context, id uuid.UUID) (LodgingRoom, error) {
+	row := q.db.QueryRow(ctx, getLodgingRoom, id)
+	var i LodgingRoom
+	err := row.Scan(
+		&i.ID,
+		&i.LodgingID,
+		&i.Name,
+		&i.Capacity,
+		&i.Beds,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getLodgingRoomAssignments = `-- name: GetLodgingRoomAssignments :many
+SELECT
+    "room_id", "lodging_id", "participant_id"
+FROM room_assignments
+WHERE
+    lodging_id = $1
+`
+
+func (q *Queries) GetLodgingRoomAssignments(ctx context.Context, lodgingID uuid.UUID) ([]RoomAssignment, error) {
+	rows, err := q.db.Query(ctx, getLodgingRoomAssignments, lodgingID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RoomAssignment
+	for rows.Next() {
+		var i RoomAssignment
+		if err := rows.Scan(&i.RoomID, &i.LodgingID, &i.ParticipantID); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getLodgingRooms = `-- name: GetLodgingRooms :many
+SELECT
+    "id", "lodging_id", "name", "capacity", "beds", "created_at"
+FROM lodging_rooms
+WHERE
+    lodging_id = $1
+ORDER BY name
+`
+
+func (q *Queries) GetLodgingRooms(ctx context.Context, lodgingID uuid.UUID) ([]LodgingRoom, error) {
+	rows, err := q.db.Query(ctx, getLodgingRooms, lodgingID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []LodgingRoom
+	for rows.Next() {
+		var i LodgingRoom
+		if err := rows.Scan(
+			&i.ID,
+			&i.LodgingID,
+			&i.Name,
+			&i.Capacity,
+			&i.Beds,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getModerationQueue = `-- name: GetModerationQueue :many
+SELECT
+    s."trip_id", t."destination", s."held_at", s."held_reason"
+FROM trip_shares s
+JOIN trips t ON t.id = s.trip_id
+WHERE
+    s.held_at IS NOT NULL
+    OR EXISTS (SELECT 1 FROM content_reports r WHERE r.trip_id = s.trip_id AND r.resolved_at IS NULL)
+ORDER BY s.held_at IS NULL, s.held_at, s.trip_id
+`
+
+type GetModerationQueueRow struct {
+	TripID      uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Destination string           `db:"destination" json:"destination"`
+	HeldAt      pgtype.Timestamp `db:"held_at" json:"held_at"`
+	HeldReason  pgtype.Text      `db:"held_reason" json:"held_reason"`
+}
+
+func (q *Queries) GetModerationQueue(ctx context.Context) ([]GetModerationQueueRow, error) {
+	rows, err := q.db.Query(ctx, getModerationQueue)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetModerationQueueRow
+	for rows.Next() {
+		var i GetModerationQueueRow
+		if err := rows.Scan(
+			&i.TripID,
+			&i.Destination,
+			&i.HeldAt,
+			&i.HeldReason,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOpenContentReports = `-- name: GetOpenContentReports :many
+SELECT
+    r."id", r."trip_id", r."reason", r."details", r."remote_addr", r."created_at", r."resolved_at"
+FROM content_reports r
+JOIN trip_shares s ON s.trip_id = r.trip_id
+WHERE
+    r.resolved_at IS NULL
+ORDER BY r.created_at
+`
+
+func (q *Queries) GetOpenContentReports(ctx context.Context) ([]ContentReport, error) {
+	rows, err := q.db.Query(ctx, getOpenContentReports)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ContentReport
+	for rows.Next() {
+		var i ContentReport
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Reason,
+			&i.Details,
+			&i.RemoteAddr,
+			&i.CreatedAt,
+			&i.ResolvedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getOwnerEmailChange = `-- name: GetOwnerEmailChange :one
+SELECT
+    "trip_id", "new_email", "old_token", "new_token", "old_confirmed_at", "new_confirmed_at", "expires_at"
+FROM owner_email_changes
+WHERE
+    trip_id = $1
+`
+
+func (q *Queries) GetOwnerEmailChange(ctx context.Context, tripID uuid.UUID) (OwnerEmailChange, error) {
+	row := q.db.QueryRow(ctx, getOwnerEmailChange, tripID)
+	var i OwnerEmailChange
+	err := row.Scan(
+		&i.TripID,
+		&i.NewEmail,
+		&i.OldToken,
+		&i.NewToken,
+		&i.OldConfirmedAt,
+		&i.NewConfirmedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const getOwnerEmailChangeByToken = `-- name: GetOwnerEmailChangeByToken :one
+SELECT
+    "trip_id", "new_email", "old_token", "new_token", "old_confirmed_at", "new_confirmed_at", "expires_at"
+FROM owner_email_changes
+WHERE
+    old_token = $1 OR new_token = $1
+`
+
+func (q *Queries) GetOwnerEmailChangeByToken(ctx context.Context, token string) (OwnerEmailChange, error) {
+	row := q.db.QueryRow(ctx, getOwnerEmailChangeByToken, token)
+	var i OwnerEmailChange
+	err := row.Scan(
+		&i.TripID,
+		&i.NewEmail,
+		&i.OldToken,
+		&i.NewToken,
+		&i.OldConfirmedAt,
+		&i.NewConfirmedAt,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const getOwnershipTransfer = `-- name: GetOwnershipTransfer :one
+SELECT
+    "token", "trip_id", "participant_id", "expires_at"
+FROM ownership_transfers
+WHERE
+    token = $1
+`
+
+func (q *Queries) GetOwnershipTransfer(ctx context.Context, token string) (OwnershipTransfer, error) {
+	row := q.db.QueryRow(ctx, getOwnershipTransfer, token)
+	var i OwnershipTransfer
+	err := row.Scan(
+		&i.Token,
+		&i.TripID,
+		&i.ParticipantID,
+		&i.ExpiresAt,
+	)
+	return i, err
+}
+
+const getParticipant = `-- name: GetParticipant :one
+SELECT
+    "id", "trip_id", "email", "is_confirmed", "name", "status", "invited_at", "role", "confirmed_at", "created_at", "group_id"
+FROM participants
+WHERE
+    id = $1
+`
+
+func (q *Queries) GetParticipant(ctx context.Context, id uuid.UUID) (Participant, error) {
+	row := q.db.QueryRow(ctx, getParticipant, id)
+	var i Participant
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.Email,
+		&i.IsConfirmed,
+		&i.Name,
+		&i.Status,
+		&i.InvitedAt,
+		&i.Role,
+		&i.ConfirmedAt,
+		&i.CreatedAt,
+		&i.GroupID,
+	)
+	return i, err
+}
+
+const getParticipantDatePollVotes = `-- name: GetParticipantDatePollVotes :many
+SELECT
+    "option_id", "participant_id", "is_available"
+FROM date_poll_votes
+WHERE
+    participant_id = $1
+`
+
+func (q *Queries) GetParticipantDatePollVotes(ctx context.Context, participantID uuid.UUID) ([]DatePollVote, error) {
+	rows, err := q.db.Query(ctx, getParticipantDatePollVotes, participantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []DatePollVote
+	for rows.Next() {
+		var i DatePollVote
+		if err := rows.Scan(&i.OptionID, &i.ParticipantID, &i.IsAvailable); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getParticipantGroup = `-- name: GetParticipantGroup :one
+SELECT
+    "id", "trip_id", "name", "created_at"
+FROM participant_groups
+WHERE
+    id = $1
+`
+
+func (q *Queries) GetParticipantGroup(ctx context.Context, id uuid.UUID) (ParticipantGroup, error) {
+	row := q.db.QueryRow(ctx, getParticipantGroup, id)
+	var i ParticipantGroup
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.Name,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getParticipantNeeds = `-- name: GetParticipantNeeds :one
+SELECT
+    "participant_id", "dietary", "accessibility", "notes", "updated_at"
+FROM participant_needs
+WHERE
+    participant_id = $1
+`
+
+func (q *Queries) GetParticipantNeeds(ctx context.Context, participantID uuid.UUID) (ParticipantNeed, error) {
+	row := q.db.QueryRow(ctx, getParticipantNeeds, participantID)
+	var i ParticipantNeed
+	err := row.Scan(
+		&i.ParticipantID,
+		&i.Dietary,
+		&i.Accessibility,
+		&i.Notes,
+		&i.UpdatedAt,
+	)
+	return i, err
+}
+
+const getParticipantSurveyAnswers = `-- name: GetParticipantSurveyAnswers :many
+SELECT
+    "question_id", "participant_id", "rating", "comment", "answered_at"
+FROM survey_answers
+WHERE
+    participant_id = $1
+`
+
+func (q *Queries) GetParticipantSurveyAnswers(ctx context.Context, participantID uuid.UUID) ([]SurveyAnswer, error) {
+	rows, err := q.db.Query(ctx, getParticipantSurveyAnswers, participantID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SurveyAnswer
+	for rows.Next() {
+		var i SurveyAnswer
+		if err := rows.Scan(
+			&i.QuestionID,
+			&i.ParticipantID,
+			&i.Rating,
+			&i.Comment,
+			&i.AnsweredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getParticipants = `-- name: GetParticipants :many
+SELECT
+    "id", "trip_id", "email", "is_confirmed", "name", "status", "invited_at", "role", "confirmed_at", "created_at", "group_id"
+FROM participants
+WHERE
+    trip_id = $1
+`
+
+func (q *Queries) GetParticipants(ctx context.Context, tripID uuid.UUID) ([]Participant, error) {
+	rows, err := q.db.Query(ctx, getParticipants, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Participant
+	for rows.Next() {
+		var i Participant
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Email,
+			&i.IsConfirmed,
+			&i.Name,
+			&i.Status,
+			&i.InvitedAt,
+			&i.Role,
+			&i.ConfirmedAt,
+			&i.CreatedAt,
+			&i.GroupID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getReceipt = `-- name: GetReceipt :one
+SELECT
+    "id", "trip_id", "expense_id", "content_type", "data", "merchant", "amount_cents", "spent_at", "created_at"
+FROM receipts
+WHERE
+    id = $1
+`
+
+func (q *Queries) GetReceipt(ctx context.Context, id uuid.UUID) (Receipt, error) {
+	row := q.db.QueryRow(ctx, getReceipt, id)
+	var i Receipt
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.ExpenseID,
+		&i.ContentType,
+		&i.Data,
+		&i.Merchant,
+		&i.AmountCents,
+		&i.SpentAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getReceiptChunk = `-- name: GetReceiptChunk :one
+SELECT
+    substring("data" FROM $1::INT + 1 FOR $2::INT)::BYTEA AS chunk
+FROM receipts
+WHERE
+    id = $3
+`
+
+type GetReceiptChunkParams struct {
+	OffsetBytes int32     `db:"offset_bytes" json:"offset_bytes"`
+	LengthBytes int32     `db:"length_bytes" json:"length_bytes"`
+	ID          uuid.UUID `db:"id" json:"id"`
+}
+
+func (q *Queries) GetReceiptChunk(ctx context.Context, arg GetReceiptChunkParams) ([]byte, error) {
+	row := q.db.QueryRow(ctx, getReceiptChunk, arg.OffsetBytes, arg.LengthBytes, arg.ID)
+	var chunk []byte
+	err := row.Scan(&chunk)
+	return chunk, err
+}
+
+const getRide = `-- name: GetRide :one
+SELECT
+    "id", "trip_id", "driver_id", "departs_at", "departure_point", "seats", "created_at"
+FROM rides
+WHERE
+    id = $1
+`
+
+func (q *Queries) GetRide(ctx context.Context, id uuid.UUID) (Ride, error) {
+	row := q.db.QueryRow(ctx, getRide, id)
+	var i Ride
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.DriverID,
+		&i.DepartsAt,
+		&i.DeparturePoint,
+		&i.Seats,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getRidePassengers = `-- name: GetRidePassengers :many
+SELECT
+    "ride_id", "participant_id", "seats", "created_at"
+FROM ride_passengers
+WHERE
+    ride_id = $1
+ORDER BY created_at
+`
+
+func (q *Queries) GetRidePassengers(ctx context.Context, rideID uuid.UUID) ([]RidePassenger, error) {
+	rows, err := q.db.Query(ctx, getRidePassengers, rideID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RidePassenger
+	for rows.Next() {
+		var i RidePassenger
+		if err := rows.Scan(
+			&i.RideID,
+			&i.ParticipantID,
+			&i.Seats,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSharedTripID = `-- name: GetSharedTripID :one
+SELECT
+    "trip_id"
+FROM trip_shares
+WHERE
+    token = $1 AND held_at IS NULL
+`
+
+func (q *Queries) GetSharedTripID(ctx context.Context, token string) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, getSharedTripID, token)
+	var trip_id uuid.UUID
+	err := row.Scan(&trip_id)
+	return trip_id, err
+}
+
+const getShoppingItem = `-- name: GetShoppingItem :one
+SELECT
+    "id", "trip_id", "title", "quantity", "claimed_by", "expense_id", "purchased_at", "created_at"
+FROM shopping_items
+WHERE
+    id = $1
+`
+
+func (q *Queries) GetShoppingItem(ctx context.Context, id uuid.UUID) (ShoppingItem, error) {
+	row := q.db.QueryRow(ctx, getShoppingItem, id)
+	var i ShoppingItem
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.Title,
+		&i.Quantity,
+		&i.ClaimedBy,
+		&i.ExpenseID,
+		&i.PurchasedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getSurveyQuestions = `-- name: GetSurveyQuestions :many
+SELECT
+    "id", "trip_id", "position", "prompt", "kind"
+FROM survey_questions
+WHERE
+    trip_id = $1
+ORDER BY position
+`
+
+func (q *Queries) GetSurveyQuestions(ctx context.Context, tripID uuid.UUID) ([]SurveyQuestion, error) {
+	rows, err := q.db.Query(ctx, getSurveyQuestions, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SurveyQuestion
+	for rows.Next() {
+		var i SurveyQuestion
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Position,
+			&i.Prompt,
+			&i.Kind,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSurveyToken = `-- name: GetSurveyToken :one
+SELECT
+    "token", "participant_id"
+FROM survey_tokens
+WHERE
+    token = $1
+`
+
+func (q *Queries) GetSurveyToken(ctx context.Context, token string) (SurveyToken, error) {
+	row := q.db.QueryRow(ctx, getSurveyToken, token)
+	var i SurveyToken
+	err := row.Scan(&i.Token, &i.ParticipantID)
+	return i, err
+}
+
+const getTableSizes = `-- name: GetTableSizes :many
+SELECT
+    c.relname::TEXT AS name,
+    -- Planner estimate, kept up to date by autovacuum, instead of counting.
+    GREATEST(c.reltuples, 0)::BIGINT AS rows_estimate,
+    pg_total_relation_size(c.oid)::BIGINT AS bytes
+FROM pg_catalog.pg_class c
+WHERE
+    c.relkind = 'r' AND c.relnamespace = 'public'::regnamespace
+ORDER BY bytes DESC
+`
+
+type GetTableSizesRow struct {
+	Name         string `db:"name" json:"name"`
+	RowsEstimate int64  `db:"rows_estimate" json:"rows_estimate"`
+	Bytes        int64  `db:"bytes" json:"bytes"`
+}
+
+func (q *Queries) GetTableSizes(ctx context.Context) ([]GetTableSizesRow, error) {
+	rows, err := q.db.Query(ctx, getTableSizes)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTableSizesRow
+	for rows.Next() {
+		var i GetTableSizesRow
+		if err := rows.Scan(&i.Name, &i.RowsEstimate, &i.Bytes); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTask = `-- name: GetTask :one
+SELECT
+    "id", "trip_id", "title", "due_on", "assignee_id", "is_done", "overdue_notified_at"
+FROM tasks
+WHERE
+    id = $1
+`
+
+func (q *Queries) GetTask(ctx context.Context, id uuid.UUID) (Task, error) {
+	row := q.db.QueryRow(ctx, getTask, id)
+	var i Task
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.Title,
+		&i.DueOn,
+		&i.AssigneeID,
+		&i.IsDone,
+		&i.OverdueNotifiedAt,
+	)
+	return i, err
+}
+
+const getTrip = `-- name: GetTrip :one
+SELECT
+    "id", "destination", "owner_email", "owner_name", "starts_at", "ends_at", "max_participants", "budget_per_person_cents", "created_at", "summary_sent_at", "digest_sent_on", "settings", "archived_at", "planning_digest_sent_at", "status"
+FROM trips
+WHERE
+    id = $1
+`
+
+func (q *Queries) GetTrip(ctx context.Context, id uuid.UUID) (Trip, error) {
+	row := q.db.QueryRow(ctx, getTrip, id)
+	var i Trip
+	err := row.Scan(
+		&i.ID,
+		&i.Destination,
+		&i.OwnerEmail,
+		&i.OwnerName,
+		&i.StartsAt,
+		&i.EndsAt,
+		&i.MaxParticipants,
+		&i.BudgetPerPersonCents,
+		&i.CreatedAt,
+		&i.SummarySentAt,
+		&i.DigestSentOn,
+		&i.Settings,
+		&i.ArchivedAt,
+		&i.PlanningDigestSentAt,
+		&i.Status,
+	)
+	return i, err
+}
+
+const getTripActivities = `-- name: GetTripActivities :many
+SELECT
+    "id", "trip_id", "title", "occurs_at", "tags", "duration_minutes", "cost_cents", "status", "latitude", "longitude", "invite_sequence", "organizer_id", "created_at", "deleted_at", "currency", "expense_id"
+FROM activities
+WHERE
+    trip_id = $1 AND deleted_at IS NULL
+ORDER BY occurs_at
+`
+
+func (q *Queries) GetTripActivities(ctx context.Context, tripID uuid.UUID) ([]Activity, error) {
+	rows, err := q.db.Query(ctx, getTripActivities, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Activity
+	for rows.Next() {
+		var i Activity
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Title,
+			&i.OccursAt,
+			&i.Tags,
+			&i.DurationMinutes,
+			&i.CostCents,
+			&i.Status,
+			&i.Latitude,
+			&i.Longitude,
+			&i.InviteSequence,
+			&i.OrganizerID,
+			&i.CreatedAt,
+			&i.DeletedAt,
+			&i.Currency,
+			&i.ExpenseID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripActivityEstimates = `-- name: GetTripActivityEstimates :many
+SELECT
+    COALESCE("currency", '')::TEXT AS currency, SUM("cost_cents")::BIGINT AS estimated_cents
+FROM activities
+WHERE
+    trip_id = $1 AND status = 'approved' AND expense_id IS NULL AND deleted_at IS NULL AND cost_cents > 0
+GROUP BY 1
+ORDER BY 1
+`
+
+type GetTripActivityEstimatesRow struct {
+	Currency       string `db:"currency" json:"currency"`
+	EstimatedCents int64  `db:"estimated_cents" json:"estimated_cents"`
+}
+
+func (q *Queries) GetTripActivityEstimates(ctx context.Context, tripID uuid.UUID) ([]GetTripActivityEstimatesRow, error) {
+	rows, err := q.db.Query(ctx, getTripActivityEstimates, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTripActivityEstimatesRow
+	for rows.Next() {
+		var i GetTripActivityEstimatesRow
+		if err := rows.Scan(&i.Currency, &i.EstimatedCents); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripAttachmentBytes = `-- name: GetTripAttachmentBytes :one
+SELECT
+    COALESCE(SUM(size_bytes), 0)::BIGINT AS bytes
+FROM attachments
+WHERE
+    trip_id = $1 AND uploaded_at IS NOT NULL AND scan_status <> 'infected'
+`
+
+func (q *Queries) GetTripAttachmentBytes(ctx context.Context, tripID uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, getTripAttachmentBytes, tripID)
+	var bytes int64
+	err := row.Scan(&bytes)
+	return bytes, err
+}
+
+const getTripAttachments = `-- name: GetTripAttachments :many
+SELECT
+    "id", "trip_id", "filename", "content_type", "size_bytes", "uploaded_at", "created_at", "uploaded_by", "scan_status", "scan_signature", "scanned_at"
+FROM attachments
+WHERE
+    trip_id = $1
+ORDER BY created_at, id
+`
+
+func (q *Queries) GetTripAttachments(ctx context.Context, tripID uuid.UUID) ([]Attachment, error) {
+	rows, err := q.db.Query(ctx, getTripAttachments, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Attachment
+	for rows.Next() {
+		var i Attachment
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Filename,
+			&i.ContentType,
+			&i.SizeBytes,
+			&i.UploadedAt,
+			&i.CreatedAt,
+			&i.UploadedBy,
+			&i.ScanStatus,
+			&i.ScanSignature,
+			&i.ScannedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripAuditEvents = `-- name: GetTripAuditEvents :many
+SELECT
+    "id", "trip_id", "action", "details", "remote_addr", "created_at"
+FROM audit_events
+WHERE
+    trip_id = $1
+ORDER BY created_at, id
+`
+
+func (q *Queries) GetTripAuditEvents(ctx context.Context, tripID uuid.UUID) ([]AuditEvent, error) {
+	rows, err := q.db.Query(ctx, getTripAuditEvents, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuditEvent
+	for rows.Next() {
+		var i AuditEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Action,
+			&i.Details,
+			&i.RemoteAddr,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripBalances = `-- name: GetTripBalances :many
+SELECT
+    p."id",
+    p."email",
+    COALESCE((SELECT SUM(e."amount_cents") FROM expenses e WHERE e.paid_by = p.id), 0)::BIGINT AS paid_cents,
+    COALESCE((SELECT SUM(s."amount_cents") FROM expense_splits s WHERE s.participant_id = p.id), 0)::BIGINT AS owed_cents
+FROM participants p
+WHERE
+    p.trip_id = $1
+ORDER BY p.email
+`
+
+type GetTripBalancesRow struct {
+	ID        uuid.UUID `db:"id" json:"id"`
+	Email     string    `db:"email" json:"email"`
+	PaidCents int64     `db:"paid_cents" json:"paid_cents"`
+	OwedCents int64     `db:"owed_cents" json:"owed_cents"`
+}
+
+func (q *Queries) GetTripBalances(ctx context.Context, tripID uuid.UUID) ([]GetTripBalancesRow, error) {
+	rows, err := q.db.Query(ctx, getTripBalances, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTripBalancesRow
+	for rows.Next() {
+		var i GetTripBalancesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Email,
+			&i.PaidCents,
+			&i.OwedCents,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripBudgetTotals = `-- name: GetTripBudgetTotals :one
+SELECT
+    (SELECT COALESCE(SUM(l.cost_cents), 0) FROM lodgings l WHERE l.trip_id = $1 AND l.status = 'approved')::BIGINT AS lodging_cents,
+    (SELECT COALESCE(SUM(e.amount_cents), 0) FROM expenses e WHERE e.trip_id = $1)::BIGINT AS spent_cents
+`
+
+type GetTripBudgetTotalsRow struct {
+	LodgingCents int64 `db:"lodging_cents" json:"lodging_cents"`
+	SpentCents   int64 `db:"spent_cents" json:"spent_cents"`
+}
+
+func (q *Queries) GetTripBudgetTotals(ctx context.Context, tripID uuid.UUID) (GetTripBudgetTotalsRow, error) {
+	row := q.db.QueryRow(ctx, getTripBudgetTotals, tripID)
+	var i GetTripBudgetTotalsRow
+	err := row.Scan(&i.LodgingCents, &i.SpentCents)
+	return i, err
+}
+
+const getTripChecklistItems = `-- name: GetTripChecklistItems :many
+SELECT
+    "id", "trip_id", "title", "category", "is_checked", "template_key"
+FROM checklist_items
+WHERE
+    trip_id = $1
+ORDER BY category, title
+`
+
+func (q *Queries) GetTripChecklistItems(ctx context.Context, tripID uuid.UUID) ([]ChecklistItem, error) {
+	rows, err := q.db.Query(ctx, getTripChecklistItems, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ChecklistItem
+	for rows.Next() {
+		var i ChecklistItem
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Title,
+			&i.Category,
+			&i.IsChecked,
+			&i.TemplateKey,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripCommittedCents = `-- name: GetTripCommittedCents :one
+SELECT
+    (
+        (SELECT COALESCE(SUM(a.cost_cents), 0) FROM activities a JOIN trips t ON t.id = a.trip_id WHERE a.trip_id = $1 AND a.status = 'approved' AND a.deleted_at IS NULL AND (a.currency IS NULL OR a.currency = COALESCE(t.settings->>'currency', a.currency))) +
+        (SELECT COALESCE(SUM(l.cost_cents), 0) FROM lodgings l WHERE l.trip_id = $1 AND l.status = 'approved')
+    )::BIGINT AS committed_cents
+`
+
+func (q *Queries) GetTripCommittedCents(ctx context.Context, tripID uuid.UUID) (int64, error) {
+	row := q.db.QueryRow(ctx, getTripCommittedCents, tripID)
+	var committed_cents int64
+	err := row.Scan(&committed_cents)
+	return committed_cents, err
+}
+
+const getTripCompanions = `-- name: GetTripCompanions :many
+SELECT
+    c."id", c."participant_id", c."name", c."created_at"
+FROM companions c
+JOIN participants p ON p.id = c.participant_id
+WHERE
+    p.trip_id = $1
+ORDER BY c.created_at
+`
+
+func (q *Queries) GetTripCompanions(ctx context.Context, tripID uuid.UUID) ([]Companion, error) {
+	rows, err := q.db.Query(ctx, getTripCompanions, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Companion
+	for rows.Next() {
+		var i Companion
+		if err := rows.Scan(
+			&i.ID,
+			&i.ParticipantID,
+			&i.Name,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripConfirmationSummary = `-- name: GetTripConfirmationSummary :one
+SELECT
+    COUNT(p.id) FILTER (WHERE p.is_confirmed)::BIGINT AS confirmed,
+    COUNT(p.id) FILTER (WHERE NOT p.is_confirmed)::BIGINT AS pending,
+    MAX(p.confirmed_at)::TIMESTAMP AS last_confirmed_at
+FROM trips t
+LEFT JOIN participants p ON p.trip_id = t.id AND p.status IN ('invited', 'email_invalid')
+WHERE
+    t.id = $1
+GROUP BY t.id
+`
+
+type GetTripConfirmationSummaryRow struct {
+	Confirmed       int64            `db:"confirmed" json:"confirmed"`
+	Pending         int64            `db:"pending" json:"pending"`
+	LastConfirmedAt pgtype.Timestamp `db:"last_confirmed_at" json:"last_confirmed_at"`
+}
+
+func (q *Queries) GetTripConfirmationSummary(ctx context.Context, tripID uuid.UUID) (GetTripConfirmationSummaryRow, error) {
+	row := q.db.QueryRow(ctx, getTripConfirmationSummary, tripID)
+	var i GetTripConfirmationSummaryRow
+	err := row.Scan(&i.Confirmed, &i.Pending, &i.LastConfirmedAt)
+	return i, err
+}
+
+const getTripDatePollNonVoters = `-- name: GetTripDatePollNonVoters :many
+SELECT
+    p."email", p."name"
+FROM date_poll_tokens t
+JOIN participants p ON p.id = t.participant_id
+WHERE
+    p.trip_id = $1
+    AND p.status = 'invited'
+    AND NOT EXISTS (
+        SELECT 1 FROM date_poll_votes v WHERE v.participant_id = p.id
+    )
+ORDER BY p.email
+`
+
+type GetTripDatePollNonVotersRow struct {
+	Email string      `db:"email" json:"email"`
+	Name  pgtype.Text `db:"name" json:"name"`
+}
+
+func (q *Queries) GetTripDatePollNonVoters(ctx context.Context, tripID uuid.UUID) ([]GetTripDatePollNonVotersRow, error) {
+	rows, err := q.db.Query(ctx, getTripDatePollNonVoters, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTripDatePollNonVotersRow
+	for rows.Next() {
+		var i GetTripDatePollNonVotersRow
+		if err := rows.Scan(&i.Email, &i.Name); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripDatePollOptions = `-- name: GetTripDatePollOptions :many
+SELECT
+    "id", "trip_id", "starts_at", "ends_at"
+FROM date_poll_options
+WHERE
+    trip_id = $1
+ORDER BY starts_at
+`
+
+func (q *Queries) GetTripDatePollOptions(ctx context.Context, tripID uuid.UUID) ([]DatePollOption, error) {
+	rows, err := q.db.Query(ctx, getTripDatePollOptions, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []DatePollOption
+	for rows.Next() {
+		var i DatePollOption
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.StartsAt,
+			&i.EndsAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripDatePollTokens = `-- name: GetTripDatePollTokens :many
+SELECT
+    t."token", p."id", p."email"
+FROM date_poll_tokens t
+JOIN participants p ON p.id = t.participant_id
+WHERE
+    p.trip_id = $1 AND p.status = 'invited'
+`
+
+type GetTripDatePollTokensRow struct {
+	Token string    `db:"token" json:"token"`
+	ID    uuid.UUID `db:"id" json:"id"`
+	Email string    `db:"email" json:"email"`
+}
+
+func (q *Queries) GetTripDatePollTokens(ctx context.Context, tripID uuid.UUID) ([]GetTripDatePollTokensRow, error) {
+	rows, err := q.db.Query(ctx, getTripDatePollTokens, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTripDatePollTokensRow
+	for rows.Next() {
+		var i GetTripDatePollTokensRow
+		if err := rows.Scan(&i.Token, &i.ID, &i.Email); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripExpenseReceipts = `-- name: GetTripExpenseReceipts :many
+SELECT
+    "id", "expense_id", "content_type", length("data")::BIGINT AS size_bytes
+FROM receipts
+WHERE
+    trip_id = $1 AND expense_id IS NOT NULL
+`
+
+type GetTripExpenseReceiptsRow struct {
+	ID          uuid.UUID   `db:"id" json:"id"`
+	ExpenseID   pgtype.UUID `db:"expense_id" json:"expense_id"`
+	ContentType string      `db:"content_type" json:"content_type"`
+	SizeBytes   int64       `db:"size_bytes" json:"size_bytes"`
+}
+
+func (q *Queries) GetTripExpenseReceipts(ctx context.Context, tripID uuid.UUID) ([]GetTripExpenseReceiptsRow, error) {
+	rows, err := q.db.Query(ctx, getTripExpenseReceipts, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTripExpenseReceiptsRow
+	for rows.Next() {
+		var i GetTripExpenseReceiptsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ExpenseID,
+			&i.ContentType,
+			&i.SizeBytes,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripExpenseSplits = `-- name: GetTripExpenseSplits :many
+SELECT
+    s."expense_id", s."participant_id", s."amount_cents"
+FROM expense_splits s
+JOIN expenses e ON e.id = s.expense_id
+WHERE
+    e.trip_id = $1
+`
+
+type GetTripExpenseSplitsRow struct {
+	ExpenseID     uuid.UUID `db:"expense_id" json:"expense_id"`
+	ParticipantID uuid.UUID `db:"participant_id" json:"participant_id"`
+	AmountCents   int64     `db:"amount_cents" json:"amount_cents"`
+}
+
+func (q *Queries) GetTripExpenseSplits(ctx context.Context, tripID uuid.UUID) ([]GetTripExpenseSplitsRow, error) {
+	rows, err := q.db.Query(ctx, getTripExpenseSplits, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTripExpenseSplitsRow
+	for rows.Next() {
+		var i GetTripExpenseSplitsRow
+		if err := rows.Scan(&i.ExpenseID, &i.ParticipantID, &i.AmountCents); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripExpensesByCategory = `-- name: GetTripExpensesByCategory :many
+SELECT
+    "category", SUM("amount_cents")::BIGINT AS total_cents
+FROM expenses
+WHERE
+    trip_id = $1
+GROUP BY category
+ORDER BY total_cents DESC
+`
+
+type GetTripExpensesByCategoryRow struct {
+	Category   string `db:"category" json:"category"`
+	TotalCents int64  `db:"total_cents" json:"total_cents"`
+}
+
+func (q *Queries) GetTripExpensesByCategory(ctx context.Context, tripID uuid.UUID) ([]GetTripExpensesByCategoryRow, error) {
+	rows, err := q.db.Query(ctx, getTripExpensesByCategory, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTripExpensesByCategoryRow
+	for rows.Next() {
+		var i GetTripExpensesByCategoryRow
+		if err := rows.Scan(&i.Category, &i.TotalCents); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripExpensesByDay = `-- name: GetTripExpensesByDay :many
+SELECT
+    "spent_at"::DATE AS day, SUM("amount_cents")::BIGINT AS total_cents
+FROM expenses
+WHERE
+    trip_id = $1
+GROUP BY day
+ORDER BY day
+`
+
+type GetTripExpensesByDayRow struct {
+	Day        pgtype.Date `db:"day" json:"day"`
+	TotalCents int64       `db:"total_cents" json:"total_cents"`
+}
+
+func (q *Queries) GetTripExpensesByDay(ctx context.Context, tripID uuid.UUID) ([]GetTripExpensesByDayRow, error) {
+	rows, err := q.db.Query(ctx, getTripExpensesByDay, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTripExpensesByDayRow
+	for rows.Next() {
+		var i GetTripExpensesByDayRow
+		if err := rows.Scan(&i.Day, &i.TotalCents); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripExpensesByParticipant = `-- name: GetTripExpensesByParticipant :many
+SELECT
+    p."id", p."email", SUM(e."amount_cents")::BIGINT AS total_cents
+FROM expenses e
+JOIN participants p ON p.id = e.paid_by
+WHERE
+    e.trip_id = $1
+GROUP BY p.id, p.email
+ORDER BY total_cents DESC
+`
+
+type GetTripExpensesByParticipantRow struct {
+	ID         uuid.UUID `db:"id" json:"id"`
+	Email      string    `db:"email" json:"email"`
+	TotalCents int64     `db:"total_cents" json:"total_cents"`
+}
+
+func (q *Queries) GetTripExpensesByParticipant(ctx context.Context, tripID uuid.UUID) ([]GetTripExpensesByParticipantRow, error) {
+	rows, err := q.db.Query(ctx, getTripExpensesByParticipant, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTripExpensesByParticipantRow
+	for rows.Next() {
+		var i GetTripExpensesByParticipantRow
+		if err := rows.Scan(&i.ID, &i.Email, &i.TotalCents); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripInviteSummary = `-- name: GetTripInviteSummary :one
+SELECT
+    t.max_participants,
+    COUNT(p.id) FILTER (WHERE p.status IN ('invited', 'email_invalid'))::BIGINT AS invited,
+    COUNT(p.id) FILTER (WHERE p.status IN ('invited', 'email_invalid') AND p.is_confirmed)::BIGINT AS confirmed,
+    COUNT(p.id) FILTER (WHERE p.status = 'declined')::BIGINT AS declined,
+    COUNT(p.id) FILTER (WHERE p.status = 'waitlisted')::BIGINT AS waitlisted,
+    (
+        SELECT COUNT(*)
+        FROM companions c
+        JOIN participants cp ON cp.id = c.participant_id
+        WHERE cp.trip_id = t.id AND cp.status IN ('invited', 'email_invalid')
+    )::BIGINT AS companions,
+    COALESCE(
+        ARRAY_AGG(p.email ORDER BY p.invited_at, p.id) FILTER (WHERE p.status IN ('invited', 'email_invalid') AND NOT p.is_confirmed),
+        '{}'
+    )::TEXT[] AS pending_emails
+FROM trips t
+LEFT JOIN participants p ON p.trip_id = t.id
+WHERE
+    t.id = $1
+GROUP BY t.id
+`
+
+type GetTripInviteSummaryRow struct {
+	MaxParticipants pgtype.Int4 `db:"max_participants" json:"max_participants"`
+	Invited         int64       `db:"invited" json:"invited"`
+	Confirmed       int64       `db:"confirmed" json:"confirmed"`
+	Declined        int64       `db:"declined" json:"declined"`
+	Waitlisted      int64       `db:"waitlisted" json:"waitlisted"`
+	Companions      int64       `db:"companions" json:"companions"`
+	PendingEmails   []string    `db:"pending_emails" json:"pending_emails"`
+}
+
+func (q *Queries) GetTripInviteSummary(ctx context.Context, id uuid.UUID) (GetTripInviteSummaryRow, error) {
+	row := q.db.QueryRow(ctx, getTripInviteSummary, id)
+	var i GetTripInviteSummaryRow
+	err := row.Scan(
+		&i.MaxParticipants,
+		&i.Invited,
+		&i.Confirmed,
+		&i.Declined,
+		&i.Waitlisted,
+		&i.Companions,
+		&i.PendingEmails,
+	)
+	return i, err
+}
+
+const getTripLinks = `-- name: GetTripLinks :many
+SELECT
+    "id", "trip_id", "title", "url", "deleted_at"
+FROM links
+WHERE
+    trip_id = $1 AND deleted_at IS NULL
+`
+
+func (q *Queries) GetTripLinks(ctx context.Context, tripID uuid.UUID) ([]Link, error) {
+	rows, err := q.db.Query(ctx, getTripLinks, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Link
+	for rows.Next() {
+		var i Link
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Title,
+			&i.Url,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripLodgings = `-- name: GetTripLodgings :many
+SELECT
+    "id", "trip_id", "name", "address", "check_in", "check_out", "cost_cents", "status"
+FROM lodgings
+WHERE
+    trip_id = $1
+ORDER BY check_in
+`
+
+func (q *Queries) GetTripLodgings(ctx context.Context, tripID uuid.UUID) ([]Lodging, error) {
+	rows, err := q.db.Query(ctx, getTripLodgings, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Lodging
+	for rows.Next() {
+		var i Lodging
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Name,
+			&i.Address,
+			&i.CheckIn,
+			&i.CheckOut,
+			&i.CostCents,
+			&i.Status,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripOwners = `-- name: GetTripOwners :many
+SELECT
+    "id", "trip_id", "email", "is_confirmed", "name", "status", "invited_at", "role", "confirmed_at", "created_at", "group_id"
+FROM participants
+WHERE
+    trip_id = $1 AND role = 'owner'
+ORDER BY invited_at, id
+`
+
+func (q *Queries) GetTripOwners(ctx context.Context, tripID uuid.UUID) ([]Participant, error) {
+	rows, err := q.db.Query(ctx, getTripOwners, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Participant
+	for rows.Next() {
+		var i Participant
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Email,
+			&i.IsConfirmed,
+			&i.Name,
+			&i.Status,
+			&i.InvitedAt,
+			&i.Role,
+			&i.ConfirmedAt,
+			&i.CreatedAt,
+			&i.GroupID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripParticipantGroups = `-- name: GetTripParticipantGroups :many
+SELECT
+    "id", "trip_id", "name", "created_at"
+FROM participant_groups
+WHERE
+    trip_id = $1
+ORDER BY name
+`
+
+func (q *Queries) GetTripParticipantGroups(ctx context.Context, tripID uuid.UUID) ([]ParticipantGroup, error) {
+	rows, err := q.db.Query(ctx, getTripParticipantGroups, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ParticipantGroup
+	for rows.Next() {
+		var i ParticipantGroup
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Name,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripParticipantNeeds = `-- name: GetTripParticipantNeeds :many
+SELECT
+    p."id", p."email", n."dietary", n."accessibility", n."notes"
+FROM participant_needs n
+JOIN participants p ON p.id = n.participant_id
+WHERE
+    p.trip_id = $1
+ORDER BY p.email
+`
+
+type GetTripParticipantNeedsRow struct {
+	ID            uuid.UUID `db:"id" json:"id"`
+	Email         string    `db:"email" json:"email"`
+	Dietary       []string  `db:"dietary" json:"dietary"`
+	Accessibility []string  `db:"accessibility" json:"accessibility"`
+	Notes         string    `db:"notes" json:"notes"`
+}
+
+func (q *Queries) GetTripParticipantNeeds(ctx context.Context, tripID uuid.UUID) ([]GetTripParticipantNeedsRow, error) {
+	rows, err := q.db.Query(ctx, getTripParticipantNeeds, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTripParticipantNeedsRow
+	for rows.Next() {
+		var i GetTripParticipantNeedsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Email,
+			&i.Dietary,
+			&i.Accessibility,
+			&i.Notes,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripRidePassengers = `-- name: GetTripRidePassengers :many
+SELECT
+    p."ride_id", p."participant_id", p."seats", p."created_at"
+FROM ride_passengers p
+JOIN rides r ON r.id = p.ride_id
+WHERE
+    r.trip_id = $1
+ORDER BY p.created_at
+`
+
+func (q *Queries) GetTripRidePassengers(ctx context.Context, tripID uuid.UUID) ([]RidePassenger, error) {
+	rows, err := q.db.Query(ctx, getTripRidePassengers, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []RidePassenger
+	for rows.Next() {
+		var i RidePassenger
+		if err := rows.Scan(
+			&i.RideID,
+			&i.ParticipantID,
+			&i.Seats,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripRides = `-- name: GetTripRides :many
+SELECT
+    "id", "trip_id", "driver_id", "departs_at", "departure_point", "seats", "created_at"
+FROM rides
+WHERE
+    trip_id = $1
+ORDER BY departs_at, id
+`
+
+func (q *Queries) GetTripRides(ctx context.Context, tripID uuid.UUID) ([]Ride, error) {
+	rows, err := q.db.Query(ctx, getTripRides, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Ride
+	for rows.Next() {
+		var i Ride
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.DriverID,
+			&i.DepartsAt,
+			&i.DeparturePoint,
+			&i.Seats,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripSheet = `-- name: GetTripSheet :one
+SELECT
+    "trip_id", "state", "refresh_token", "spreadsheet_id", "spreadsheet_url", "synced_at", "created_at"
+FROM trip_sheets
+WHERE
+    trip_id = $1
+`
+
+func (q *Queries) GetTripSheet(ctx context.Context, tripID uuid.UUID) (TripSheet, error) {
+	row := q.db.QueryRow(ctx, getTripSheet, tripID)
+	var i TripSheet
+	err := row.Scan(
+		&i.TripID,
+		&i.State,
+		&i.RefreshToken,
+		&i.SpreadsheetID,
+		&i.SpreadsheetUrl,
+		&i.SyncedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getTripSheetByState = `-- name: GetTripSheetByState :one
+SELECT
+    "trip_id", "state", "refresh_token", "spreadsheet_id", "spreadsheet_url", "synced_at", "created_at"
+FROM trip_sheets
+WHERE
+    state = $1
+`
+
+func (q *Queries) GetTripSheetByState(ctx context.Context, state pgtype.Text) (TripSheet, error) {
+	row := q.db.QueryRow(ctx, getTripSheetByState, state)
+	var i TripSheet
+	err := row.Scan(
+		&i.TripID,
+		&i.State,
+		&i.RefreshToken,
+		&i.SpreadsheetID,
+		&i.SpreadsheetUrl,
+		&i.SyncedAt,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const getTripShoppingItems = `-- name: GetTripShoppingItems :many
+SELECT
+    "id", "trip_id", "title", "quantity", "claimed_by", "expense_id", "purchased_at", "created_at"
+FROM shopping_items
+WHERE
+    trip_id = $1
+ORDER BY created_at, id
+`
+
+func (q *Queries) GetTripShoppingItems(ctx context.Context, tripID uuid.UUID) ([]ShoppingItem, error) {
+	rows, err := q.db.Query(ctx, getTripShoppingItems, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ShoppingItem
+	for rows.Next() {
+		var i ShoppingItem
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Title,
+			&i.Quantity,
+			&i.ClaimedBy,
+			&i.ExpenseID,
+			&i.PurchasedAt,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripSurvey = `-- name: GetTripSurvey :one
+SELECT
+    "trip_id", "sent_at"
+FROM trip_surveys
+WHERE
+    trip_id = $1
+`
+
+func (q *Queries) GetTripSurvey(ctx context.Context, tripID uuid.UUID) (TripSurvey, error) {
+	row := q.db.QueryRow(ctx, getTripSurvey, tripID)
+	var i TripSurvey
+	err := row.Scan(&i.TripID, &i.SentAt)
+	return i, err
+}
+
+const getTripSurveyAnswers = `-- name: GetTripSurveyAnswers :many
+SELECT
+    a."question_id", a."participant_id", a."rating", a."comment", a."answered_at"
+FROM survey_answers a
+JOIN survey_questions q ON q.id = a.question_id
+WHERE
+    q.trip_id = $1
+ORDER BY a.answered_at
+`
+
+func (q *Queries) GetTripSurveyAnswers(ctx context.Context, tripID uuid.UUID) ([]SurveyAnswer, error) {
+	rows, err := q.db.Query(ctx, getTripSurveyAnswers, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []SurveyAnswer
+	for rows.Next() {
+		var i SurveyAnswer
+		if err := rows.Scan(
+			&i.QuestionID,
+			&i.ParticipantID,
+			&i.Rating,
+			&i.Comment,
+			&i.AnsweredAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripSurveyTokens = `-- name: GetTripSurveyTokens :many
+SELECT
+    t."token", p."id", p."email"
+FROM survey_tokens t
+JOIN participants p ON p.id = t.participant_id
+WHERE
+    p.trip_id = $1 AND p.status = 'invited'
+`
+
+type GetTripSurveyTokensRow struct {
+	Token string    `db:"token" json:"token"`
+	ID    uuid.UUID `db:"id" json:"id"`
+	Email string    `db:"email" json:"email"`
+}
+
+func (q *Queries) GetTripSurveyTokens(ctx context.Context, tripID uuid.UUID) ([]GetTripSurveyTokensRow, error) {
+	rows, err := q.db.Query(ctx, getTripSurveyTokens, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTripSurveyTokensRow
+	for rows.Next() {
+		var i GetTripSurveyTokensRow
+		if err := rows.Scan(&i.Token, &i.ID, &i.Email); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripTags = `-- name: GetTripTags :many
+SELECT "tag"
+FROM trip_tags
+WHERE
+    trip_id = $1
+ORDER BY tag
+`
+
+func (q *Queries) GetTripTags(ctx context.Context, tripID uuid.UUID) ([]string, error) {
+	rows, err := q.db.Query(ctx, getTripTags, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var tag string
+		if err := rows.Scan(&tag); err != nil {
+			return nil, err
+		}
+		items = append(items, tag)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripTasks = `-- name: GetTripTasks :many
+SELECT
+    "id", "trip_id", "title", "due_on", "assignee_id", "is_done", "overdue_notified_at"
+FROM tasks
+WHERE
+    trip_id = $1
+ORDER BY due_on, title
+`
+
+func (q *Queries) GetTripTasks(ctx context.Context, tripID uuid.UUID) ([]Task, error) {
+	rows, err := q.db.Query(ctx, getTripTasks, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Task
+	for rows.Next() {
+		var i Task
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Title,
+			&i.DueOn,
+			&i.AssigneeID,
+			&i.IsDone,
+			&i.OverdueNotifiedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripTransports = `-- name: GetTripTransports :many
+SELECT
+    "id", "trip_id", "mode", "origin", "destination", "departs_at", "arrives_at"
+FROM transports
+WHERE
+    trip_id = $1
+ORDER BY departs_at
+`
+
+func (q *Queries) GetTripTransports(ctx context.Context, tripID uuid.UUID) ([]Transport, error) {
+	rows, err := q.db.Query(ctx, getTripTransports, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Transport
+	for rows.Next() {
+		var i Transport
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Mode,
+			&i.Origin,
+			&i.Destination,
+			&i.DepartsAt,
+			&i.ArrivesAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripTrashedActivities = `-- name: GetTripTrashedActivities :many
+SELECT
+    "id", "title", "occurs_at", "deleted_at"::TIMESTAMP AS deleted_at
+FROM activities
+WHERE
+    trip_id = $1 AND deleted_at IS NOT NULL
+ORDER BY deleted_at DESC, id
+`
+
+type GetTripTrashedActivitiesRow struct {
+	ID        uuid.UUID        `db:"id" json:"id"`
+	Title     string           `db:"title" json:"title"`
+	OccursAt  pgtype.Timestamp `db:"occurs_at" json:"occurs_at"`
+	DeletedAt pgtype.Timestamp `db:"deleted_at" json:"deleted_at"`
+}
+
+func (q *Queries) GetTripTrashedActivities(ctx context.Context, tripID uuid.UUID) ([]GetTripTrashedActivitiesRow, error) {
+	rows, err := q.db.Query(ctx, getTripTrashedActivities, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTripTrashedActivitiesRow
+	for rows.Next() {
+		var i GetTripTrashedActivitiesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.OccursAt,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripTrashedLinks = `-- name: GetTripTrashedLinks :many
+SELECT
+    "id", "title", "url", "deleted_at"::TIMESTAMP AS deleted_at
+FROM links
+WHERE
+    trip_id = $1 AND deleted_at IS NOT NULL
+ORDER BY deleted_at DESC, id
+`
+
+type GetTripTrashedLinksRow struct {
+	ID        uuid.UUID        `db:"id" json:"id"`
+	Title     string           `db:"title" json:"title"`
+	Url       string           `db:"url" json:"url"`
+	DeletedAt pgtype.Timestamp `db:"deleted_at" json:"deleted_at"`
+}
+
+func (q *Queries) GetTripTrashedLinks(ctx context.Context, tripID uuid.UUID) ([]GetTripTrashedLinksRow, error) {
+	rows, err := q.db.Query(ctx, getTripTrashedLinks, tripID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTripTrashedLinksRow
+	for rows.Next() {
+		var i GetTripTrashedLinksRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.Url,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripsDueForReminders = `-- name: GetTripsDueForReminders :many
+SELECT
+    "id", "starts_at", "settings"
+FROM trips
+WHERE
+    status = 'confirmed'
+    AND archived_at IS NULL
+    AND starts_at > NOW()
+    AND starts_at < NOW() + MAKE_INTERVAL(days => (
+        SELECT COALESCE(MAX(d::INT), 0) + 1
+        FROM JSONB_ARRAY_ELEMENTS_TEXT(COALESCE(settings->'reminder_days', '[]')) d
+    ))
+    AND EXTRACT(HOUR FROM NOW() AT TIME ZONE (settings->>'timezone')) >= (settings->>'reminder_hour')::INT
+`
+
+type GetTripsDueForRemindersRow struct {
+	ID       uuid.UUID        `db:"id" json:"id"`
+	StartsAt pgtype.Timestamp `db:"starts_at" json:"starts_at"`
+	Settings TripSettings     `db:"settings" json:"settings"`
+}
+
+func (q *Queries) GetTripsDueForReminders(ctx context.Context) ([]GetTripsDueForRemindersRow, error) {
+	rows, err := q.db.Query(ctx, getTripsDueForReminders)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTripsDueForRemindersRow
+	for rows.Next() {
+		var i GetTripsDueForRemindersRow
+		if err := rows.Scan(&i.ID, &i.StartsAt, &i.Settings); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTripsDueToAdvance = `-- name: GetTripsDueToAdvance :many
+SELECT
+    "id", "status", "ends_at"
+FROM trips
+WHERE
+    archived_at IS NULL
+    AND (
+        (status = 'confirmed' AND starts_at <= NOW())
+        OR (status = 'ongoing' AND ends_at < NOW())
+    )
+`
+
+type GetTripsDueToAdvanceRow struct {
+	ID     uuid.UUID        `db:"id" json:"id"`
+	Status string           `db:"status" json:"status"`
+	EndsAt pgtype.Timestamp `db:"ends_at" json:"ends_at"`
+}
+
+func (q *Queries) GetTripsDueToAdvance(ctx context.Context) ([]GetTripsDueToAdvanceRow, error) {
+	rows, err := q.db.Query(ctx, getTripsDueToAdvance)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []GetTripsDueToAdvanceRow
+	for rows.Next() {
+		var i GetTripsDueToAdvanceRow
+		if err := rows.Scan(&i.ID, &i.Status, &i.EndsAt); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const holdTripShare = `-- name: HoldTripShare :execrows
+UPDATE trip_shares
+SET
+    "held_at" = NOW(),
+    "held_reason" = $1,
+    "approved_at" = NULL,
+    "approved_digest" = NULL
+WHERE
+    trip_id = $2 AND held_at IS NULL AND ($3::TEXT = '' OR approved_digest IS DISTINCT FROM $3::TEXT)
+`
+
+type HoldTripShareParams struct {
+	Reason pgtype.Text `db:"reason" json:"reason"`
+	TripID uuid.UUID   `db:"trip_id" json:"trip_id"`
+	Digest string      `db:"digest" json:"digest"`
+}
+
+func (q *Queries) HoldTripShare(ctx context.Context, arg HoldTripShareParams) (int64, error) {
+	result, err := q.db.Exec(ctx, holdTripShare, arg.Reason, arg.TripID, arg.Digest)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const insertAuditEvent = `-- name: InsertAuditEvent :exec
+INSERT INTO audit_events
+    ( "trip_id", "action", "details", "remote_addr" ) VALUES
+    ( $1, $2, $3, $4 )
+`
+
+type InsertAuditEventParams struct {
+	TripID     uuid.UUID `db:"trip_id" json:"trip_id"`
+	Action     string    `db:"action" json:"action"`
+	Details    []byte    `db:"details" json:"details"`
+	RemoteAddr string    `db:"remote_addr" json:"remote_addr"`
+}
+
+func (q *Queries) InsertAuditEvent(ctx context.Context, arg InsertAuditEventParams) error {
+	_, err := q.db.Exec(ctx, insertAuditEvent,
+		arg.TripID,
+		arg.Action,
+		arg.Details,
+		arg.RemoteAddr,
+	)
+	return err
+}
+
+type InsertChecklistItemsParams struct {
+	TripID      uuid.UUID   `db:"trip_id" json:"trip_id"`
+	Title       string      `db:"title" json:"title"`
+	Category    string      `db:"category" json:"category"`
+	TemplateKey pgtype.Text `db:"template_key" json:"template_key"`
+}
+
+const insertContentReport = `-- name: InsertContentReport :execrows
+INSERT INTO content_reports
+    ( "trip_id", "reason", "details", "remote_addr" ) VALUES
+    ( $1, $2, $3, $4 )
+ON CONFLICT (trip_id, remote_addr) WHERE resolved_at IS NULL DO NOTHING
+`
+
+type InsertContentReportParams struct {
+	TripID     uuid.UUID   `db:"trip_id" json:"trip_id"`
+	Reason     string      `db:"reason" json:"reason"`
+	Details    pgtype.Text `db:"details" json:"details"`
+	RemoteAddr string      `db:"remote_addr" json:"remote_addr"`
+}
+
+func (q *Queries) InsertContentReport(ctx context.Context, arg InsertContentReportParams) (int64, error) {
+	result, err := q.db.Exec(ctx, insertContentReport,
+		arg.TripID,
+		arg.Reason,
+		arg.Details,
+		arg.RemoteAddr,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+type InsertDatePollOptionsParams struct {
+	TripID   uuid.UUID        `db:"trip_id" json:"trip_id"`
+	StartsAt pgtype.Timestamp `db:"starts_at" json:"starts_at"`
+	EndsAt   pgtype.Timestamp `db:"ends_at" json:"ends_at"`
+}
+
+const insertExpense = `-- name: InsertExpense :one
+INSERT INTO expenses
+    ( "trip_id", "paid_by", "description", "category", "amount_cents", "spent_at" ) VALUES
+    ( $1, $2, $3, $4, $5, $6 )
+RETURNING "id"
+`
+
+type InsertExpenseParams struct {
+	TripID      uuid.UUID        `db:"trip_id" json:"trip_id"`
+	PaidBy      uuid.UUID        `db:"paid_by" json:"paid_by"`
+	Description string           `db:"description" json:"description"`
+	Category    string           `db:"category" json:"category"`
+	AmountCents int64            `db:"amount_cents" json:"amount_cents"`
+	SpentAt     pgtype.Timestamp `db:"spent_at" json:"spent_at"`
+}
+
+func (q *Queries) InsertExpense(ctx context.Context, arg InsertExpenseParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, insertExpense,
+		arg.TripID,
+		arg.PaidBy,
+		arg.Description,
+		arg.Category,
+		arg.AmountCents,
+		arg.SpentAt,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+type InsertExpenseSplitsParams struct {
+	ExpenseID     uuid.UUID `db:"expense_id" json:"expense_id"`
+	ParticipantID uuid.UUID `db:"participant_id" json:"participant_id"`
+	AmountCents   int64     `db:"amount_cents" json:"amount_cents"`
+}
+
+type InsertImportedActivitiesParams struct {
+	ID              uuid.UUID        `db:"id" json:"id"`
+	TripID          uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Title           string           `db:"title" json:"title"`
+	OccursAt        pgtype.Timestamp `db:"occurs_at" json:"occurs_at"`
+	Tags            []string         `db:"tags" json:"tags"`
+	DurationMinutes pgtype.Int4      `db:"duration_minutes" json:"duration_minutes"`
+	CostCents       int64            `db:"cost_cents" json:"cost_cents"`
+	Status          string           `db:"status" json:"status"`
+	Latitude        pgtype.Float8    `db:"latitude" json:"latitude"`
+	Longitude       pgtype.Float8    `db:"longitude" json:"longitude"`
+	InviteSequence  pgtype.Int4      `db:"invite_sequence" json:"invite_sequence"`
+	OrganizerID     pgtype.UUID      `db:"organizer_id" json:"organizer_id"`
+	Currency        pgtype.Text      `db:"currency" json:"currency"`
+	ExpenseID       pgtype.UUID      `db:"expense_id" json:"expense_id"`
+}
+
+type InsertImportedAttachmentsParams struct {
+	ID            uuid.UUID        `db:"id" json:"id"`
+	TripID        uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Filename      string           `db:"filename" json:"filename"`
+	ContentType   string           `db:"content_type" json:"content_type"`
+	SizeBytes     pgtype.Int8      `db:"size_bytes" json:"size_bytes"`
+	UploadedAt    pgtype.Timestamp `db:"uploaded_at" json:"uploaded_at"`
+	CreatedAt     pgtype.Timestamp `db:"created_at" json:"created_at"`
+	UploadedBy    pgtype.UUID      `db:"uploaded_by" json:"uploaded_by"`
+	ScanStatus    string           `db:"scan_status" json:"scan_status"`
+	ScanSignature pgtype.Text      `db:"scan_signature" json:"scan_signature"`
+	ScannedAt     pgtype.Timestamp `db:"scanned_at" json:"scanned_at"`
+}
+
+type InsertImportedAuditEventsParams struct {
+	ID         uuid.UUID        `db:"id" json:"id"`
+	TripID     uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Action     string           `db:"action" json:"action"`
+	Details    []byte           `db:"details" json:"details"`
+	RemoteAddr string           `db:"remote_addr" json:"remote_addr"`
+	CreatedAt  pgtype.Timestamp `db:"created_at" json:"created_at"`
+}
+
+type InsertImportedChecklistItemsParams struct {
+	ID          uuid.UUID   `db:"id" json:"id"`
+	TripID      uuid.UUID   `db:"trip_id" json:"trip_id"`
+	Title       string      `db:"title" json:"title"`
+	Category    string      `db:"category" json:"category"`
+	IsChecked   bool        `db:"is_checked" json:"is_checked"`
+	TemplateKey pgtype.Text `db:"template_key" json:"template_key"`
+}
+
+type InsertImportedExpensesParams struct {
+	ID          uuid.UUID        `db:"id" json:"id"`
+	TripID      uuid.UUID        `db:"trip_id" json:"trip_id"`
+	PaidBy      uuid.UUID        `db:"paid_by" json:"paid_by"`
+	Description string           `db:"description" json:"description"`
+	Category    string           `db:"category" json:"category"`
+	AmountCents int64            `db:"amount_cents" json:"amount_cents"`
+	SpentAt     pgtype.Timestamp `db:"spent_at" json:"spent_at"`
+}
+
+type InsertImportedLinksParams struct {
+	ID     uuid.UUID `db:"id" json:"id"`
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+	Title  string    `db:"title" json:"title"`
+	Url    string    `db:"url" json:"url"`
+}
+
+type InsertImportedLodgingsParams struct {
+	ID        uuid.UUID        `db:"id" json:"id"`
+	TripID    uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Name      string           `db:"name" json:"name"`
+	Address   string           `db:"address" json:"address"`
+	CheckIn   pgtype.Timestamp `db:"check_in" json:"check_in"`
+	CheckOut  pgtype.Timestamp `db:"check_out" json:"check_out"`
+	CostCents int64            `db:"cost_cents" json:"cost_cents"`
+	Status    string           `db:"status" json:"status"`
+}
+
+type InsertImportedParticipantsParams struct {
+	ID          uuid.UUID        `db:"id" json:"id"`
+	TripID      uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Email       string           `db:"email" json:"email"`
+	IsConfirmed bool             `db:"is_confirmed" json:"is_confirmed"`
+	Name        pgtype.Text      `db:"name" json:"name"`
+	Status      string           `db:"status" json:"status"`
+	InvitedAt   pgtype.Timestamp `db:"invited_at" json:"invited_at"`
+	Role        string           `db:"role" json:"role"`
+}
+
+type InsertImportedTasksParams struct {
+	ID                uuid.UUID        `db:"id" json:"id"`
+	TripID            uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Title             string           `db:"title" json:"title"`
+	DueOn             pgtype.Date      `db:"due_on" json:"due_on"`
+	AssigneeID        pgtype.UUID      `db:"assignee_id" json:"assignee_id"`
+	IsDone            bool             `db:"is_done" json:"is_done"`
+	OverdueNotifiedAt pgtype.Timestamp `db:"overdue_notified_at" json:"overdue_notified_at"`
+}
+
+type InsertImportedTransportsParams struct {
+	ID          uuid.UUID        `db:"id" json:"id"`
+	TripID      uuid.UUID        `db:"trip_id" json:"trip_id"`
+	Mode        string           `db:"mode" json:"mode"`
+	Origin      string           `db:"origin" json:"origin"`
+	Destination string           `db:"destination" json:"destination"`
+	DepartsAt   pgtype.Timestamp `db:"departs_at" json:"departs_at"`
+	ArrivesAt   pgtype.Timestamp `db:"arrives_at" json:"arrives_at"`
+}
+
+const insertImportedTrip = `-- name: InsertImportedTrip :exec
+INSERT INTO trips
+    ( "id", "destination", "owner_email", "owner_name", "status", "starts_at", "ends_at", "max_participants", "budget_per_person_cents", "settings", "archived_at" ) VALUES
+    ( $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11 )
+`
+
+type InsertImportedTripParams struct {
+	ID                   uuid.UUID        `db:"id" json:"id"`
+	Destination          string           `db:"destination" json:"destination"`
+	OwnerEmail           string           `db:"owner_email" json:"owner_email"`
+	OwnerName            string           `db:"owner_name" json:"owner_name"`
+	Status               string           `db:"status" json:"status"`
+	StartsAt             pgtype.Timestamp `db:"starts_at" json:"starts_at"`
+	EndsAt               pgtype.Timestamp `db:"ends_at" json:"ends_at"`
+	MaxParticipants      pgtype.Int4      `db:"max_participants" json:"max_participants"`
+	BudgetPerPersonCents pgtype.Int8      `db:"budget_per_person_cents" json:"budget_per_person_cents"`
+	Settings             TripSettings     `db:"settings" json:"settings"`
+	ArchivedAt           pgtype.Timestamp `db:"archived_at" json:"archived_at"`
+}
+
+func (q *Queries) InsertImportedTrip(ctx context.Context, arg InsertImportedTripParams) error {
+	_, err := q.db.Exec(ctx, insertImportedTrip,
+		arg.ID,
+		arg.Destination,
+		arg.OwnerEmail,
+		arg.OwnerName,
+		arg.Status,
+		arg.StartsAt,
+		arg.EndsAt,
+		arg.MaxParticipants,
+		arg.BudgetPerPersonCents,
+		arg.Settings,
+		arg.ArchivedAt,
+	)
+	return err
+}
+
+const insertOwnerParticipant = `-- name: InsertOwnerParticipant :one
+INSERT INTO participants
+    ( "trip_id", "email", "name", "status", "is_confirmed", "confirmed_at", "role" ) VALUES
+    ( $1, $2, $3, 'invited', true, NOW(), 'owner' )
+RETURNING "id"
+`
+
+type InsertOwnerParticipantParams struct {
+	TripID uuid.UUID   `db:"trip_id" json:"trip_id"`
+	Email  string      `db:"email" json:"email"`
+	Name   pgtype.Text `db:"name" json:"name"`
+}
+
+func (q *Queries) InsertOwnerParticipant(ctx context.Context, arg InsertOwnerParticipantParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, insertOwnerParticipant, arg.TripID, arg.Email, arg.Name)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const insertParticipantGroup = `-- name: InsertParticipantGroup :one
+INSERT INTO participant_groups
+    ( "trip_id", "name" ) VALUES
+    ( $1, $2 )
+RETURNING "id"
+`
+
+type InsertParticipantGroupParams struct {
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+	Name   string    `db:"name" json:"name"`
+}
+
+func (q *Queries) InsertParticipantGroup(ctx context.Context, arg InsertParticipantGroupParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, insertParticipantGroup, arg.TripID, arg.Name)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const insertRidePassenger = `-- name: InsertRidePassenger :exec
+INSERT INTO ride_passengers
+    ( "ride_id", "participant_id", "seats" ) VALUES
+    ( $1, $2, $3 )
+`
+
+type InsertRidePassengerParams struct {
+	RideID        uuid.UUID `db:"ride_id" json:"ride_id"`
+	ParticipantID uuid.UUID `db:"participant_id" json:"participant_id"`
+	Seats         int32     `db:"seats" json:"seats"`
+}
+
+func (q *Queries) InsertRidePassenger(ctx context.Context, arg InsertRidePassengerParams) error {
+	_, err := q.db.Exec(ctx, insertRidePassenger, arg.RideID, arg.ParticipantID, arg.Seats)
+	return err
+}
+
+type InsertRoomAssignmentsParams struct {
+	RoomID        uuid.UUID `db:"room_id" json:"room_id"`
+	LodgingID     uuid.UUID `db:"lodging_id" json:"lodging_id"`
+	ParticipantID uuid.UUID `db:"participant_id" json:"participant_id"`
+}
+
+type InsertSurveyQuestionsParams struct {
+	TripID   uuid.UUID `db:"trip_id" json:"trip_id"`
+	Position int32     `db:"position" json:"position"`
+	Prompt   string    `db:"prompt" json:"prompt"`
+	Kind     string    `db:"kind" json:"kind"`
+}
+
+const insertTrip = `-- name: InsertTrip :one
+INSERT
+INTO trips
+    ( "destination", "owner_email", "owner_name", "starts_at", "ends_at", "max_participants", "budget_per_person_cents", "settings") VALUES
+    ( $1, $2, $3, $4, $5, $6, $7, $8 )
+RETURNING "id"
+`
+
+type InsertTripParams struct {
+	Destination          string           `db:"destination" json:"destination"`
+	OwnerEmail           string           `db:"owner_email" json:"owner_email"`
+	OwnerName            string           `db:"owner_name" json:"owner_name"`
+	StartsAt             pgtype.Timestamp `db:"starts_at" json:"starts_at"`
+	EndsAt               pgtype.Timestamp `db:"ends_at" json:"ends_at"`
+	MaxParticipants      pgtype.Int4      `db:"max_participants" json:"max_participants"`
+	BudgetPerPersonCents pgtype.Int8      `db:"budget_per_person_cents" json:"budget_per_person_cents"`
+	Settings             TripSettings     `db:"settings" json:"settings"`
+}
+
+func (q *Queries) InsertTrip(ctx context.Context, arg InsertTripParams) (uuid.UUID, error) {
+	row := q.db.QueryRow(ctx, insertTrip,
+		arg.Destination,
+		arg.OwnerEmail,
+		arg.OwnerName,
+		arg.StartsAt,
+		arg.EndsAt,
+		arg.MaxParticipants,
+		arg.BudgetPerPersonCents,
+		arg.Settings,
+	)
+	var id uuid.UUID
+	err := row.Scan(&id)
+	return id, err
+}
+
+const insertTripTags = `-- name: InsertTripTags :exec
+INSERT INTO trip_tags
+    ( "trip_id", "tag" )
+SELECT $1::uuid, UNNEST($2::TEXT[])
+`
+
+type InsertTripTagsParams struct {
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+	Tags   []string  `db:"tags" json:"tags"`
+}
+
+func (q *Queries) InsertTripTags(ctx context.Context, arg InsertTripTagsParams) error {
+	_, err := q.db.Exec(ctx, insertTripTags, arg.TripID, arg.Tags)
+	return err
+}
+
+type InviteParticipantsToTripParams struct {
+	TripID uuid.UUID   `db:"trip_id" json:"trip_id"`
+	Email  string      `db:"email" json:"email"`
+	Name   pgtype.Text `db:"name" json:"name"`
+	Status string      `db:"status" json:"status"`
+}
+
+const listExpiredAttachments = `-- name: ListExpiredAttachments :many
+SELECT
+    a.id, a.trip_id, a.size_bytes
+FROM attachments a
+JOIN trips t ON t.id = a.trip_id
+WHERE
+    t.archived_at < $1 OR t.ends_at < $1
+ORDER BY a.created_at
+LIMIT $2
+`
+
+type ListExpiredAttachmentsParams struct {
+	Before pgtype.Timestamp `db:"before" json:"before"`
+	Max    int32            `db:"max" json:"max"`
+}
+
+type ListExpiredAttachmentsRow struct {
+	ID        uuid.UUID   `db:"id" json:"id"`
+	TripID    uuid.UUID   `db:"trip_id" json:"trip_id"`
+	SizeBytes pgtype.Int8 `db:"size_bytes" json:"size_bytes"`
+}
+
+func (q *Queries) ListExpiredAttachments(ctx context.Context, arg ListExpiredAttachmentsParams) ([]ListExpiredAttachmentsRow, error) {
+	rows, err := q.db.Query(ctx, listExpiredAttachments, arg.Before, arg.Max)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListExpiredAttachmentsRow
+	for rows.Next() {
+		var i ListExpiredAttachmentsRow
+		if err := rows.Scan(&i.ID, &i.TripID, &i.SizeBytes); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listNewActivities = `-- name: ListNewActivities :many
+SELECT
+    "id", "title", "occurs_at", "status", "created_at"
+FROM activities
+WHERE
+    trip_id = $1 AND deleted_at IS NULL AND ("created_at", "id") > ($2::TIMESTAMP, $3::uuid)
+ORDER BY "created_at", "id"
+LIMIT $4
+`
+
+type ListNewActivitiesParams struct {
+	TripID         uuid.UUID        `db:"trip_id" json:"trip_id"`
+	AfterCreatedAt pgtype.Timestamp `db:"after_created_at" json:"after_created_at"`
+	AfterID        uuid.UUID        `db:"after_id" json:"after_id"`
+	Max            int32            `db:"max" json:"max"`
+}
+
+type ListNewActivitiesRow struct {
+	ID        uuid.UUID        `db:"id" json:"id"`
+	Title     string           `db:"title" json:"title"`
+	OccursAt  pgtype.Timestamp `db:"occurs_at" json:"occurs_at"`
+	Status    string           `db:"status" json:"status"`
+	CreatedAt pgtype.Timestamp `db:"created_at" json:"created_at"`
+}
+
+func (q *Queries) ListNewActivities(ctx context.Context, arg ListNewActivitiesParams) ([]ListNewActivitiesRow, error) {
+	rows, err := q.db.Query(ctx, listNewActivities,
+		arg.TripID,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.Max,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListNewActivitiesRow
+	for rows.Next() {
+		var i ListNewActivitiesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Title,
+			&i.OccursAt,
+			&i.Status,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listNewParticipants = `-- name: ListNewParticipants :many
+SELECT
+    "id", "email", "name", "status", "is_confirmed", "created_at"
+FROM participants
+WHERE
+    trip_id = $1 AND ("created_at", "id") > ($2::TIMESTAMP, $3::uuid)
+ORDER BY "created_at", "id"
+LIMIT $4
+`
+
+type ListNewParticipantsParams struct {
+	TripID         uuid.UUID        `db:"trip_id" json:"trip_id"`
+	AfterCreatedAt pgtype.Timestamp `db:"after_created_at" json:"after_created_at"`
+	AfterID        uuid.UUID        `db:"after_id" json:"after_id"`
+	Max            int32            `db:"max" json:"max"`
+}
+
+type ListNewParticipantsRow struct {
+	ID          uuid.UUID        `db:"id" json:"id"`
+	Email       string           `db:"email" json:"email"`
+	Name        pgtype.Text      `db:"name" json:"name"`
+	Status      string           `db:"status" json:"status"`
+	IsConfirmed bool             `db:"is_confirmed" json:"is_confirmed"`
+	CreatedAt   pgtype.Timestamp `db:"created_at" json:"created_at"`
+}
+
+func (q *Queries) ListNewParticipants(ctx context.Context, arg ListNewParticipantsParams) ([]ListNewParticipantsRow, error) {
+	rows, err := q.db.Query(ctx, listNewParticipants,
+		arg.TripID,
+		arg.AfterCreatedAt,
+		arg.AfterID,
+		arg.Max,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListNewParticipantsRow
+	for rows.Next() {
+		var i ListNewParticipantsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Email,
+			&i.Name,
+			&i.Status,
+			&i.IsConfirmed,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTripActivities = `-- name: ListTripActivities :many
+SELECT
+    "id", "trip_id", "title", "occurs_at", "tags", "duration_minutes", "cost_cents", "status", "latitude", "longitude", "invite_sequence", "organizer_id", "created_at", "deleted_at", "currency", "expense_id"
+FROM activities
+WHERE
+    trip_id = $1 AND deleted_at IS NULL
+ORDER BY
+    CASE WHEN $2::TEXT = 'title' THEN title END,
+    CASE WHEN $2::TEXT = '-title' THEN title END DESC,
+    CASE WHEN $2::TEXT = 'cost_cents' THEN cost_cents END,
+    CASE WHEN $2::TEXT = '-cost_cents' THEN cost_cents END DESC,
+    CASE WHEN $2::TEXT = '-occurs_at' THEN occurs_at END DESC,
+    occurs_at, id
+`
+
+type ListTripActivitiesParams struct {
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+	Sort   string    `db:"sort" json:"sort"`
+}
+
+func (q *Queries) ListTripActivities(ctx context.Context, arg ListTripActivitiesParams) ([]Activity, error) {
+	rows, err := q.db.Query(ctx, listTripActivities, arg.TripID, arg.Sort)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Activity
+	for rows.Next() {
+		var i Activity
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Title,
+			&i.OccursAt,
+			&i.Tags,
+			&i.DurationMinutes,
+			&i.CostCents,
+			&i.Status,
+			&i.Latitude,
+			&i.Longitude,
+			&i.InviteSequence,
+			&i.OrganizerID,
+			&i.CreatedAt,
+			&i.DeletedAt,
+			&i.Currency,
+			&i.ExpenseID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTripExpenses = `-- name: ListTripExpenses :many
+SELECT
+    "id", "trip_id", "paid_by", "description", "category", "amount_cents", "spent_at"
+FROM expenses
+WHERE
+    trip_id = $1
+ORDER BY
+    CASE WHEN $2::TEXT = 'amount_cents' THEN amount_cents END,
+    CASE WHEN $2::TEXT = '-amount_cents' THEN amount_cents END DESC,
+    CASE WHEN $2::TEXT = 'category' THEN category END,
+    CASE WHEN $2::TEXT = '-category' THEN category END DESC,
+    CASE WHEN $2::TEXT = 'description' THEN description END,
+    CASE WHEN $2::TEXT = '-description' THEN description END DESC,
+    CASE WHEN $2::TEXT = '-spent_at' THEN spent_at END DESC,
+    spent_at, id
+`
+
+type ListTripExpensesParams struct {
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+	Sort   string    `db:"sort" json:"sort"`
+}
+
+func (q *Queries) ListTripExpenses(ctx context.Context, arg ListTripExpensesParams) ([]Expense, error) {
+	rows, err := q.db.Query(ctx, listTripExpenses, arg.TripID, arg.Sort)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Expense
+	for rows.Next() {
+		var i Expense
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.PaidBy,
+			&i.Description,
+			&i.Category,
+			&i.AmountCents,
+			&i.SpentAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTripFeedEvents = `-- name: ListTripFeedEvents :many
+SELECT
+    "id", "trip_id", "action", "details", "remote_addr", "created_at"
+FROM audit_events
+WHERE
+    trip_id = $1 AND action = ANY($2::TEXT[])
+ORDER BY created_at DESC, id DESC
+LIMIT $3
+`
+
+type ListTripFeedEventsParams struct {
+	TripID  uuid.UUID `db:"trip_id" json:"trip_id"`
+	Actions []string  `db:"actions" json:"actions"`
+	Max     int32     `db:"max" json:"max"`
+}
+
+func (q *Queries) ListTripFeedEvents(ctx context.Context, arg ListTripFeedEventsParams) ([]AuditEvent, error) {
+	rows, err := q.db.Query(ctx, listTripFeedEvents, arg.TripID, arg.Actions, arg.Max)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []AuditEvent
+	for rows.Next() {
+		var i AuditEvent
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Action,
+			&i.Details,
+			&i.RemoteAddr,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTripLinks = `-- name: ListTripLinks :many
+SELECT
+    "id", "trip_id", "title", "url", "deleted_at"
+FROM links
+WHERE
+    trip_id = $1 AND deleted_at IS NULL
+ORDER BY
+    CASE WHEN $2::TEXT = 'title' THEN title END,
+    CASE WHEN $2::TEXT = '-title' THEN title END DESC,
+    CASE WHEN $2::TEXT = 'url' THEN url END,
+    CASE WHEN $2::TEXT = '-url' THEN url END DESC,
+    id
+`
+
+type ListTripLinksParams struct {
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+	Sort   string    `db:"sort" json:"sort"`
+}
+
+func (q *Queries) ListTripLinks(ctx context.Context, arg ListTripLinksParams) ([]Link, error) {
+	rows, err := q.db.Query(ctx, listTripLinks, arg.TripID, arg.Sort)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Link
+	for rows.Next() {
+		var i Link
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Title,
+			&i.Url,
+			&i.DeletedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listTripParticipants = `-- name: ListTripParticipants :many
+SELECT
+    "id", "trip_id", "email", "is_confirmed", "name", "status", "invited_at", "role", "confirmed_at", "created_at", "group_id"
+FROM participants
+WHERE
+    trip_id = $1
+ORDER BY
+    CASE WHEN $2::TEXT = 'name' THEN COALESCE(name, email) END,
+    CASE WHEN $2::TEXT = '-name' THEN COALESCE(name, email) END DESC,
+    CASE WHEN $2::TEXT = 'email' THEN email END,
+    CASE WHEN $2::TEXT = '-email' THEN email END DESC,
+    CASE WHEN $2::TEXT = 'invited_at' THEN invited_at END,
+    CASE WHEN $2::TEXT = '-invited_at' THEN invited_at END DESC,
+    id
+`
+
+type ListTripParticipantsParams struct {
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+	Sort   string    `db:"sort" json:"sort"`
+}
+
+func (q *Queries) ListTripParticipants(ctx context.Context, arg ListTripParticipantsParams) ([]Participant, error) {
+	rows, err := q.db.Query(ctx, listTripParticipants, arg.TripID, arg.Sort)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Participant
+	for rows.Next() {
+		var i Participant
+		if err := rows.Scan(
+			&i.ID,
+			&i.TripID,
+			&i.Email,
+			&i.IsConfirmed,
+			&i.Name,
+			&i.Status,
+			&i.InvitedAt,
+			&i.Role,
+			&i.ConfirmedAt,
+			&i.CreatedAt,
+			&i.GroupID,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listUploadedAttachments = `-- name: ListUploadedAttachments :many
+SELECT
+    "id", "trip_id", "scan_status"
+FROM attachments
+WHERE
+    uploaded_at IS NOT NULL
+ORDER BY created_at, id
+`
+
+type ListUploadedAttachmentsRow struct {
+	ID         uuid.UUID `db:"id" json:"id"`
+	TripID     uuid.UUID `db:"trip_id" json:"trip_id"`
+	ScanStatus string    `db:"scan_status" json:"scan_status"`
+}
+
+func (q *Queries) ListUploadedAttachments(ctx context.Context) ([]ListUploadedAttachmentsRow, error) {
+	rows, err := q.db.Query(ctx, listUploadedAttachments)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListUploadedAttachmentsRow
+	for rows.Next() {
+		var i ListUploadedAttachmentsRow
+		if err := rows.Scan(&i.ID, &i.TripID, &i.ScanStatus); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockOwnerTrips = `-- name: LockOwnerTrips :exec
+SELECT pg_advisory_xact_lock(hashtext($1::TEXT))
+`
+
+func (q *Queries) LockOwnerTrips(ctx context.Context, ownerEmail string) error {
+	_, err := q.db.Exec(ctx, lockOwnerTrips, ownerEmail)
+	return err
+}
+
+const lockRide = `-- name: LockRide :one
+SELECT
+    "id", "trip_id", "driver_id", "departs_at", "departure_point", "seats", "created_at"
+FROM rides
+WHERE
+    id = $1
+FOR UPDATE
+`
+
+func (q *Queries) LockRide(ctx context.Context, id uuid.UUID) (Ride, error) {
+	row := q.db.QueryRow(ctx, lockRide, id)
+	var i Ride
+	err := row.Scan(
+		&i.ID,
+		&i.TripID,
+		&i.DriverID,
+		&i.DepartsAt,
+		&i.DeparturePoint,
+		&i.Seats,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const lockTrip = `-- name: LockTrip :exec
+SELECT "id"
+FROM trips
+WHERE
+    id = $1
+FOR UPDATE
+`
+
+func (q *Queries) LockTrip(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, lockTrip, id)
+	return err
+}
+
+const markActivityPaid = `-- name: MarkActivityPaid :execrows
+UPDATE activities
+SET
+    "expense_id" = $1
+WHERE
+    id = $2 AND expense_id IS NULL AND deleted_at IS NULL
+`
+
+type MarkActivityPaidParams struct {
+	ExpenseID pgtype.UUID `db:"expense_id" json:"expense_id"`
+	ID        uuid.UUID   `db:"id" json:"id"`
+}
+
+func (q *Queries) MarkActivityPaid(ctx context.Context, arg MarkActivityPaidParams) (int64, error) {
+	result, err := q.db.Exec(ctx, markActivityPaid, arg.ExpenseID, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const markParticipantDeclined = `-- name: MarkParticipantDeclined :exec
+UPDATE participants
+SET
+    "status" = 'declined',
+    "is_confirmed" = false,
+    "confirmed_at" = NULL
+WHERE
+    id = $1
+`
+
+func (q *Queries) MarkParticipantDeclined(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, markParticipantDeclined, id)
+	return err
+}
+
+const markParticipantsEmailInvalid = `-- name: MarkParticipantsEmailInvalid :many
+UPDATE participants
+SET
+    "status" = 'email_invalid'
+WHERE
+    LOWER(email) = LOWER($1) AND status = 'invited' AND NOT is_confirmed
+RETURNING "id"
+`
+
+func (q *Queries) MarkParticipantsEmailInvalid(ctx context.Context, email string) ([]uuid.UUID, error) {
+	rows, err := q.db.Query(ctx, markParticipantsEmailInvalid, email)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []uuid.UUID
+	for rows.Next() {
+		var id uuid.UUID
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		items = append(items, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const markShoppingItemPurchased = `-- name: MarkShoppingItemPurchased :execrows
+UPDATE shopping_items
+SET
+    "expense_id" = $1,
+    "purchased_at" = NOW()
+WHERE
+    id = $2 AND purchased_at IS NULL
+`
+
+type MarkShoppingItemPurchasedParams struct {
+	ExpenseID pgtype.UUID `db:"expense_id" json:"expense_id"`
+	ID        uuid.UUID   `db:"id" json:"id"`
+}
+
+func (q *Queries) MarkShoppingItemPurchased(ctx context.Context, arg MarkShoppingItemPurchasedParams) (int64, error) {
+	result, err := q.db.Exec(ctx, markShoppingItemPurchased, arg.ExpenseID, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const moveTripActivities = `-- name: MoveTripActivities :execrows
+UPDATE activities
+SET
+    "trip_id" = $1,
+    -- Organizers already on the target trip are not moved, their activities
+    -- go to the participant with the same email there.
+    "organizer_id" = COALESCE((
+        SELECT t.id
+        FROM participants s
+        JOIN participants t ON LOWER(t.email) = LOWER(s.email) AND t.trip_id = $1
+        WHERE s.id = activities.organizer_id
+    ), activities.organizer_id)
+WHERE
+    activities.trip_id = $2
+`
+
+type MoveTripActivitiesParams struct {
+	TargetID uuid.UUID `db:"target_id" json:"target_id"`
+	SourceID uuid.UUID `db:"source_id" json:"source_id"`
+}
+
+func (q *Queries) MoveTripActivities(ctx context.Context, arg MoveTripActivitiesParams) (int64, error) {
+	result, err := q.db.Exec(ctx, moveTripActivities, arg.TargetID, arg.SourceID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const moveTripLinks = `-- name: MoveTripLinks :execrows
+UPDATE links
+SET
+    "trip_id" = $1
+WHERE
+    trip_id = $2
+`
+
+type MoveTripLinksParams struct {
+	TargetID uuid.UUID `db:"target_id" json:"target_id"`
+	SourceID uuid.UUID `db:"source_id" json:"source_id"`
+}
+
+func (q *Queries) MoveTripLinks(ctx context.Context, arg MoveTripLinksParams) (int64, error) {
+	result, err := q.db.Exec(ctx, moveTripLinks, arg.TargetID, arg.SourceID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const moveTripParticipants = `-- name: MoveTripParticipants :execrows
+UPDATE participants p
+SET
+    "trip_id" = $1
+WHERE
+    p.trip_id = $2
+    AND NOT EXISTS (
+        SELECT 1
+        FROM participants t
+        WHERE t.trip_id = $1 AND LOWER(t.email) = LOWER(p.email)
+    )
+`
+
+type MoveTripParticipantsParams struct {
+	TargetID uuid.UUID `db:"target_id" json:"target_id"`
+	SourceID uuid.UUID `db:"source_id" json:"source_id"`
+}
+
+func (q *Queries) MoveTripParticipants(ctx context.Context, arg MoveTripParticipantsParams) (int64, error) {
+	result, err := q.db.Exec(ctx, moveTripParticipants, arg.TargetID, arg.SourceID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const nextActivityInviteSequence = `-- name: NextActivityInviteSequence :one
+UPDATE activities
+SET
+    "invite_sequence" = invite_sequence + 1
+WHERE
+    id = $1 AND invite_sequence IS NOT NULL
+RETURNING "invite_sequence"::INTEGER AS invite_sequence
+`
+
+func (q *Queries) NextActivityInviteSequence(ctx context.Context, id uuid.UUID) (int32, error) {
+	row := q.db.QueryRow(ctx, nextActivityInviteSequence, id)
+	var invite_sequence int32
+	err := row.Scan(&invite_sequence)
+	return invite_sequence, err
+}
+
+const promoteParticipant = `-- name: PromoteParticipant :exec
+UPDATE participants
+SET
+    "status" = 'invited'
+WHERE
+    id = $1
+`
+
+func (q *Queries) PromoteParticipant(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, promoteParticipant, id)
+	return err
+}
+
+const purgeTrashedActivities = `-- name: PurgeTrashedActivities :execrows
+DELETE FROM activities
+WHERE
+    deleted_at < $1
+`
+
+func (q *Queries) PurgeTrashedActivities(ctx context.Context, deletedAt pgtype.Timestamp) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeTrashedActivities, deletedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const purgeTrashedLinks = `-- name: PurgeTrashedLinks :execrows
+DELETE FROM links
+WHERE
+    deleted_at < $1
+`
+
+func (q *Queries) PurgeTrashedLinks(ctx context.Context, deletedAt pgtype.Timestamp) (int64, error) {
+	result, err := q.db.Exec(ctx, purgeTrashedLinks, deletedAt)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const releaseNotifications = `-- name: ReleaseNotifications :exec
+DELETE FROM sent_notifications
+WHERE
+    kind = $1 AND participant_id = ANY($2::uuid[])
+`
+
+type ReleaseNotificationsParams struct {
+	Kind           string      `db:"kind" json:"kind"`
+	ParticipantIds []uuid.UUID `db:"participant_ids" json:"participant_ids"`
+}
+
+func (q *Queries) ReleaseNotifications(ctx context.Context, arg ReleaseNotificationsParams) error {
+	_, err := q.db.Exec(ctx, releaseNotifications, arg.Kind, arg.ParticipantIds)
+	return err
+}
+
+const releaseOverdueTask = `-- name: ReleaseOverdueTask :exec
+UPDATE tasks
+SET
+    "overdue_notified_at" = NULL
+WHERE
+    id = $1
+`
+
+func (q *Queries) ReleaseOverdueTask(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, releaseOverdueTask, id)
+	return err
+}
+
+const releaseTripDigest = `-- name: ReleaseTripDigest :exec
+UPDATE trips
+SET
+    "digest_sent_on" = NULL
+WHERE
+    id = $1
+`
+
+func (q *Queries) ReleaseTripDigest(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, releaseTripDigest, id)
+	return err
+}
+
+const releaseTripPlanningDigest = `-- name: ReleaseTripPlanningDigest :exec
+UPDATE trips
+SET
+    "planning_digest_sent_at" = NULL
+WHERE
+    id = $1
+`
+
+func (q *Queries) ReleaseTripPlanningDigest(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, releaseTripPlanningDigest, id)
+	return err
+}
+
+const releaseTripReminder = `-- name: ReleaseTripReminder :exec
+DELETE FROM trip_reminders
+WHERE
+    trip_id = $1 AND starts_at = $2 AND days_before = $3
+`
+
+type ReleaseTripReminderParams struct {
+	TripID     uuid.UUID        `db:"trip_id" json:"trip_id"`
+	StartsAt   pgtype.Timestamp `db:"starts_at" json:"starts_at"`
+	DaysBefore int32            `db:"days_before" json:"days_before"`
+}
+
+func (q *Queries) ReleaseTripReminder(ctx context.Context, arg ReleaseTripReminderParams) error {
+	_, err := q.db.Exec(ctx, releaseTripReminder, arg.TripID, arg.StartsAt, arg.DaysBefore)
+	return err
+}
+
+const releaseTripSummary = `-- name: ReleaseTripSummary :exec
+UPDATE trips
+SET
+    "summary_sent_at" = NULL
+WHERE
+    id = $1
+`
+
+func (q *Queries) ReleaseTripSummary(ctx context.Context, id uuid.UUID) error {
+	_, err := q.db.Exec(ctx, releaseTripSummary, id)
+	return err
+}
+
+const releaseTripSurvey = `-- name: ReleaseTripSurvey :exec
+DELETE FROM trip_surveys
+WHERE
+    trip_id = $1
+`
+
+func (q *Queries) ReleaseTripSurvey(ctx context.Context, tripID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, releaseTripSurvey, tripID)
+	return err
+}
+
+const renameParticipantGroup = `-- name: RenameParticipantGroup :exec
+UPDATE participant_groups
+SET
+    "name" = $1
+WHERE
+    id = $2
+`
+
+type RenameParticipantGroupParams struct {
+	Name string    `db:"name" json:"name"`
+	ID   uuid.UUID `db:"id" json:"id"`
+}
+
+func (q *Queries) RenameParticipantGroup(ctx context.Context, arg RenameParticipantGroupParams) error {
+	_, err := q.db.Exec(ctx, renameParticipantGroup, arg.Name, arg.ID)
+	return err
+}
+
+const resolveContentReports = `-- name: ResolveContentReports :exec
+UPDATE content_reports
+SET
+    "resolved_at" = NOW()
+WHERE
+    trip_id = $1 AND resolved_at IS NULL
+`
+
+func (q *Queries) ResolveContentReports(ctx context.Context, tripID uuid.UUID) error {
+	_, err := q.db.Exec(ctx, resolveContentReports, tripID)
+	return err
+}
+
+const restoreActivity = `-- name: RestoreActivity :one
+UPDATE activities
+SET
+    "deleted_at" = NULL
+WHERE
+    id = $1 AND trip_id = $2 AND deleted_at IS NOT NULL
+RETURNING "title", "occurs_at"
+`
+
+type RestoreActivityParams struct {
+	ID     uuid.UUID `db:"id" json:"id"`
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+}
+
+type RestoreActivityRow struct {
+	Title    string           `db:"title" json:"title"`
+	OccursAt pgtype.Timestamp `db:"occurs_at" json:"occurs_at"`
+}
+
+func (q *Queries) RestoreActivity(ctx context.Context, arg RestoreActivityParams) (RestoreActivityRow, error) {
+	row := q.db.QueryRow(ctx, restoreActivity, arg.ID, arg.TripID)
+	var i RestoreActivityRow
+	err := row.Scan(&i.Title, &i.OccursAt)
+	return i, err
+}
+
+const restoreLink = `-- name: RestoreLink :one
+UPDATE links
+SET
+    "deleted_at" = NULL
+WHERE
+    id = $1 AND trip_id = $2 AND deleted_at IS NOT NULL
+RETURNING "title", "url"
+`
+
+type RestoreLinkParams struct {
+	ID     uuid.UUID `db:"id" json:"id"`
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+}
+
+type RestoreLinkRow struct {
+	Title string `db:"title" json:"title"`
+	Url   string `db:"url" json:"url"`
+}
+
+func (q *Queries) RestoreLink(ctx context.Context, arg RestoreLinkParams) (RestoreLinkRow, error) {
+	row := q.db.QueryRow(ctx, restoreLink, arg.ID, arg.TripID)
+	var i RestoreLinkRow
+	err := row.Scan(&i.Title, &i.Url)
+	return i, err
+}
+
+const setActivityOrganizer = `-- name: SetActivityOrganizer :exec
+UPDATE activities
+SET
+    "organizer_id" = $1
+WHERE
+    id = $2
+`
+
+type SetActivityOrganizerParams struct {
+	OrganizerID pgtype.UUID `db:"organizer_id" json:"organizer_id"`
+	ID          uuid.UUID   `db:"id" json:"id"`
+}
+
+func (q *Queries) SetActivityOrganizer(ctx context.Context, arg SetActivityOrganizerParams) error {
+	_, err := q.db.Exec(ctx, setActivityOrganizer, arg.OrganizerID, arg.ID)
+	return err
+}
+
+const setAttachmentScanResult = `-- name: SetAttachmentScanResult :exec
+UPDATE attachments
+SET
+    "scan_status" = $1,
+    "scan_signature" = $2,
+    "scanned_at" = NOW()
+WHERE
+    id = $3
+`
+
+type SetAttachmentScanResultParams struct {
+	ScanStatus    string      `db:"scan_status" json:"scan_status"`
+	ScanSignature pgtype.Text `db:"scan_signature" json:"scan_signature"`
+	ID            uuid.UUID   `db:"id" json:"id"`
+}
+
+func (q *Queries) SetAttachmentScanResult(ctx context.Context, arg SetAttachmentScanResultParams) error {
+	_, err := q.db.Exec(ctx, setAttachmentScanResult, arg.ScanStatus, arg.ScanSignature, arg.ID)
+	return err
+}
+
+const setParticipantRole = `-- name: SetParticipantRole :exec
+UPDATE participants
+SET
+    "role" = $1
+WHERE
+    id = $2
+`
+
+type SetParticipantRoleParams struct {
+	Role string    `db:"role" json:"role"`
+	ID   uuid.UUID `db:"id" json:"id"`
+}
+
+func (q *Queries) SetParticipantRole(ctx context.Context, arg SetParticipantRoleParams) error {
+	_, err := q.db.Exec(ctx, setParticipantRole, arg.Role, arg.ID)
+	return err
+}
+
+const setParticipantsGroup = `-- name: SetParticipantsGroup :exec
+UPDATE participants
+SET
+    "group_id" = $1
+WHERE
+    trip_id = $2 AND id = ANY($3::UUID[])
+`
+
+type SetParticipantsGroupParams struct {
+	GroupID pgtype.UUID `db:"group_id" json:"group_id"`
+	TripID  uuid.UUID   `db:"trip_id" json:"trip_id"`
+	Ids     []uuid.UUID `db:"ids" json:"ids"`
+}
+
+func (q *Queries) SetParticipantsGroup(ctx context.Context, arg SetParticipantsGroupParams) error {
+	_, err := q.db.Exec(ctx, setParticipantsGroup, arg.GroupID, arg.TripID, arg.Ids)
+	return err
+}
+
+const shareTrip = `-- name: ShareTrip :exec
+INSERT INTO trip_shares
+    ( "trip_id", "token" ) VALUES
+    ( $1, $2 )
+ON CONFLICT (trip_id) DO UPDATE
+SET
+    "token" = EXCLUDED.token,
+    "created_at" = NOW()
+`
+
+type ShareTripParams struct {
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+	Token  string    `db:"token" json:"token"`
+}
+
+func (q *Queries) ShareTrip(ctx context.Context, arg ShareTripParams) error {
+	_, err := q.db.Exec(ctx, shareTrip, arg.TripID, arg.Token)
+	return err
+}
+
+const shiftTripActivities = `-- name: ShiftTripActivities :exec
+UPDATE activities
+SET
+    "occurs_at" = "occurs_at" + make_interval(days => $1)
+WHERE
+    trip_id = $2
+`
+
+type ShiftTripActivitiesParams struct {
+	Days   int32     `db:"days" json:"days"`
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+}
+
+func (q *Queries) ShiftTripActivities(ctx context.Context, arg ShiftTripActivitiesParams) error {
+	_, err := q.db.Exec(ctx, shiftTripActivities, arg.Days, arg.TripID)
+	return err
+}
+
+const startTripSheetConnection = `-- name: StartTripSheetConnection :exec
+INSERT INTO trip_sheets
+    ( "trip_id", "state" ) VALUES
+    ( $1, $2 )
+ON CONFLICT (trip_id) DO UPDATE
+SET
+    "state" = EXCLUDED.state
+`
+
+type StartTripSheetConnectionParams struct {
+	TripID uuid.UUID   `db:"trip_id" json:"trip_id"`
+	State  pgtype.Text `db:"state" json:"state"`
+}
+
+func (q *Queries) StartTripSheetConnection(ctx context.Context, arg StartTripSheetConnectionParams) error {
+	_, err := q.db.Exec(ctx, startTripSheetConnection, arg.TripID, arg.State)
+	return err
+}
+
+const transitionTrip = `-- name: TransitionTrip :execrows
+UPDATE trips
+SET
+    "status" = $1
+WHERE
+    id = $2 AND status = $3
+`
+
+type TransitionTripParams struct {
+	ToStatus   string    `db:"to_status" json:"to_status"`
+	ID         uuid.UUID `db:"id" json:"id"`
+	FromStatus string    `db:"from_status" json:"from_status"`
+}
+
+func (q *Queries) TransitionTrip(ctx context.Context, arg TransitionTripParams) (int64, error) {
+	result, err := q.db.Exec(ctx, transitionTrip, arg.ToStatus, arg.ID, arg.FromStatus)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const trashActivity = `-- name: TrashActivity :one
+UPDATE activities
+SET
+    "deleted_at" = NOW()
+WHERE
+    id = $1 AND trip_id = $2 AND deleted_at IS NULL
+RETURNING "title", "occurs_at"
+`
+
+type TrashActivityParams struct {
+	ID     uuid.UUID `db:"id" json:"id"`
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+}
+
+type TrashActivityRow struct {
+	Title    string           `db:"title" json:"title"`
+	OccursAt pgtype.Timestamp `db:"occurs_at" json:"occurs_at"`
+}
+
+func (q *Queries) TrashActivity(ctx context.Context, arg TrashActivityParams) (TrashActivityRow, error) {
+	row := q.db.QueryRow(ctx, trashActivity, arg.ID, arg.TripID)
+	var i TrashActivityRow
+	err := row.Scan(&i.Title, &i.OccursAt)
+	return i, err
+}
+
+const trashLink = `-- name: TrashLink :one
+UPDATE links
+SET
+    "deleted_at" = NOW()
+WHERE
+    id = $1 AND trip_id = $2 AND deleted_at IS NULL
+RETURNING "title", "url"
+`
+
+type TrashLinkParams struct {
+	ID     uuid.UUID `db:"id" json:"id"`
+	TripID uuid.UUID `db:"trip_id" json:"trip_id"`
+}
+
+type TrashLinkRow struct {
+	Title string `db:"title" json:"title"`
+	Url   string `db:"url" json:"url"`
+}
+
+func (q *Queries) TrashLink(ctx context.Context, arg TrashLinkParams) (TrashLinkRow, error) {
+	row := q.db.QueryRow(ctx, trashLink, arg.ID, arg.TripID)
+	var i TrashLinkRow
+	err := row.Scan(&i.Title, &i.Url)
+	return i, err
+}
+
+const unclaimShoppingItem = `-- name: UnclaimShoppingItem :execrows
+UPDATE shopping_items
+SET
+    "claimed_by" = NULL
+WHERE
+    id = $1 AND purchased_at IS NULL
+`
+
+func (q *Queries) UnclaimShoppingItem(ctx context.Context, id uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, unclaimShoppingItem, id)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const unshareTrip = `-- name: UnshareTrip :execrows
+DELETE FROM trip_shares
+WHERE
+    trip_id = $1
+`
+
+func (q *Queries) UnshareTrip(ctx context.Context, tripID uuid.UUID) (int64, error) {
+	result, err := q.db.Exec(ctx, unshareTrip, tripID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
+
+const updateActivityOccursAt = `-- name: UpdateActivityOccursAt :exec
+UPDATE activities
+SET
+    "occurs_at" = $1
+WHERE
+    id = $2
+`
+
+type UpdateActivityOccursAtParams struct {
+	OccursAt pgtype.Timestamp `db:"occurs_at" json:"occurs_at"`
+	ID       uuid.UUID        `db:"id" json:"id"`
+}
+
+func (q *Queries) UpdateActivityOccursAt(ctx context.Context, arg UpdateActivityOccursAtParams) error {
+	_, err := q.db.Exec(ctx, updateActivityOccursAt, arg.OccursAt, arg.ID)
+	return err
+}
+
+const updateChecklistItem = `-- name: UpdateChecklistItem :exec
+UPDATE checklist_items
+SET
+    "title" = $1,
+    "is_checked" = $2
+WHERE
+    id = $3
+`
+
+type UpdateChecklistItemParams struct {
+	Title     string    `db:"title" json:"title"`
+	IsChecked bool      `db:"is_checked" json:"is_checked"`
+	ID        uuid.UUID `db:"id" json:"id"`
+}
+
+func (q *Queries) UpdateChecklistItem(ctx context.Context, arg UpdateChecklistItemParams) error {
+	_, err := q.db.Exec(ctx, updateChecklistItem, arg.Title, arg.IsChecked, arg.ID)
+	return err
+}
+
+const updateParticipantEmail = `-- name: UpdateParticipantEmail :exec
+UPDATE participants
+SET
+    "email" = $1
+WHERE
+    id = $2
+`
+
+type UpdateParticipantEmailParams struct {
+	Email string    `db:"email" json:"email"`
+	ID    uuid.UUID `db:"id" json:"id"`
+}
+
+func (q *Queries) UpdateParticipantEmail(ctx context.Context, arg UpdateParticipantEmailParams) error {
+	_, err := q.db.Exec(ctx, updateParticipantEmail, arg.Email, arg.ID)
+	return err
+}
+
+const updateTask = `-- name: UpdateTask :exec
+UPDATE tasks
+SET
+    "title" = $1,
+    "due_on" = $2,
+    "assignee_id" = $3,
+    "is_done" = $4,
+    "overdue_notified_at" = CASE WHEN "due_on" = $2 THEN "overdue_notified_at" END
+WHERE
+    id = $5
+`
+
+type UpdateTaskParams struct {
+	Title      string      `db:"title" json:"title"`
+	DueOn      pgtype.Date `db:"due_on" json:"due_on"`
+	AssigneeID pgtype.UUID `db:"assignee_id" json:"assignee_id"`
+	IsDone     bool        `db:"is_done" json:"is_done"`
+	ID         uuid.UUID   `db:"id" json:"id"`
+}
+
+func (q *Queries) UpdateTask(ctx context.Context, arg UpdateTaskParams) error {
+	_, err := q.db.Exec(ctx, updateTask,
+		arg.Title,
+		arg.DueOn,
+		arg.AssigneeID,
+		arg.IsDone,
+		arg.ID,
+	)
+	return err
+}
+
+const updateTrip = `-- name: UpdateTrip :exec
+UPDATE trips
+SET 
+    "destination" = $1,
+    "ends_at" = $2,
+    "starts_at" = $3,
+    "max_participants" = $4,
+    "budget_per_person_cents" = $5
+WHERE
+    id = $6
+`
+
+type UpdateTripParams struct {
+	Destination          string           `db:"destination" json:"destination"`
+	EndsAt               pgtype.Timestamp `db:"ends_at" json:"ends_at"`
+	StartsAt             pgtype.Timestamp `db:"starts_at" json:"starts_at"`
+	MaxParticipants      pgtype.Int4      `db:"max_participants" json:"max_participants"`
+	BudgetPerPersonCents pgtype.Int8      `db:"budget_per_person_cents" json:"budget_per_person_cents"`
+	ID                   uuid.UUID        `db:"id" json:"id"`
+}
+
+func (q *Queries) UpdateTrip(ctx context.Context, arg UpdateTripParams) error {
+	_, err := q.db.Exec(ctx, updateTrip,
+		arg.Destination,
+		arg.EndsAt,
+		arg.StartsAt,
+		arg.MaxParticipants,
+		arg.BudgetPerPersonCents,
+		arg.ID,
+	)
+	return err
+}
+
+const updateTripOwner = `-- name: UpdateTripOwner :exec
+UPDATE trips
+SET
+    "owner_email" = $1,
+    "owner_name" = $2
+WHERE
+    id = $3
+`
+
+type UpdateTripOwnerParams struct {
+	OwnerEmail string    `db:"owner_email" json:"owner_email"`
+	OwnerName  string    `db:"owner_name" json:"owner_name"`
+	ID         uuid.UUID `db:"id" json:"id"`
+}
+
+func (q *Queries) UpdateTripOwner(ctx context.Context, arg UpdateTripOwnerParams) error {
+	_, err := q.db.Exec(ctx, updateTripOwner, arg.OwnerEmail, arg.OwnerName, arg.ID)
+	return err
+}
+
+const updateTripSettings = `-- name: UpdateTripSettings :exec
+UPDATE trips
+SET
+    "settings" = $1
+WHERE
+    id = $2
+`
+
+type UpdateTripSettingsParams struct {
+	Settings TripSettings `db:"settings" json:"settings"`
+	ID       uuid.UUID    `db:"id" json:"id"`
+}
+
+func (q *Queries) UpdateTripSettings(ctx context.Context, arg UpdateTripSettingsParams) error {
+	_, err := q.db.Exec(ctx, updateTripSettings, arg.Settings, arg.ID)
+	return err
+}
+
+const upsertDatePollVote = `-- name: UpsertDatePollVote :exec
+INSERT INTO date_poll_votes
+    ( "option_id", "participant_id", "is_available" ) VALUES
+    ( $1, $2, $3 )
+ON CONFLICT (option_id, participant_id) DO UPDATE
+SET
+    "is_available" = EXCLUDED.is_available
+`
+
+type UpsertDatePollVoteParams struct {
+	OptionID      uuid.UUID `db:"option_id" json:"option_id"`
+	ParticipantID uuid.UUID `db:"participant_id" json:"participant_id"`
+	IsAvailable   bool      `db:"is_available" json:"is_available"`
+}
+
+func (q *Queries) UpsertDatePollVote(ctx context.Context, arg UpsertDatePollVoteParams) error {
+	_, err := q.db.Exec(ctx, upsertDatePollVote, arg.OptionID, arg.ParticipantID, arg.IsAvailable)
+	return err
+}
+
+const upsertParticipantNeeds = `-- name: UpsertParticipantNeeds :exec
+INSERT INTO participant_needs
+    ( "participant_id", "dietary", "accessibility", "notes" ) VALUES
+    ( $1, $2, $3, $4 )
+ON CONFLICT (participant_id) DO UPDATE
+SET
+    "dietary" = EXCLUDED.dietary,
+    "accessibility" = EXCLUDED.accessibility,
+    "notes" = EXCLUDED.notes,
+    "updated_at" = NOW()
+`
+
+type UpsertParticipantNeedsParams struct {
+	ParticipantID uuid.UUID `db:"participant_id" json:"participant_id"`
+	Dietary       []string  `db:"dietary" json:"dietary"`
+	Accessibility []string  `db:"accessibility" json:"accessibility"`
+	Notes         string    `db:"notes" json:"notes"`
+}
+
+func (q *Queries) UpsertParticipantNeeds(ctx context.Context, arg UpsertParticipantNeedsParams) error {
+	_, err := q.db.Exec(ctx, upsertParticipantNeeds,
+		arg.ParticipantID,
+		arg.Dietary,
+		arg.Accessibility,
+		arg.Notes,
+	)
+	return err
+}
+
+const upsertSurveyAnswer = `-- name: UpsertSurveyAnswer :exec
+INSERT INTO survey_answers
+    ( "question_id", "participant_id", "rating", "comment" ) VALUES
+    ( $1, $2, $3, $4 )
+ON CONFLICT (question_id, participant_id) DO UPDATE
+SET
+    "rating" = EXCLUDED.rating,
+    "comment" = EXCLUDED.comment,
+    "answered_at" = NOW()
+`
+
+type UpsertSurveyAnswerParams struct {
+	QuestionID    uuid.UUID   `db:"question_id" json:"question_id"`
+	ParticipantID uuid.UUID   `db:"participant_id" json:"participant_id"`
+	Rating        pgtype.Int4 `db:"rating" json:"rating"`
+	Comment       pgtype.Text `db:"comment" json:"comment"`
+}
+
+func (q *Queries) UpsertSurveyAnswer(ctx context.Context, arg UpsertSurveyAnswerParams) error {
+	_, err := q.db.Exec(ctx, upsertSurveyAnswer,
+		arg.QuestionID,
+		arg.ParticipantID,
+		arg.Rating,
+		arg.Comment,
 	)
 	return err
 }
@@ -1,68 +1,184 @@
 -- name: InsertTrip :one
 INSERT
 INTO trips
-    ( "destination", "owner_email", "owner_name", "starts_at", "ends_at") VALUES
-    ( $1, $2, $3, $4, $5 )
+    ( "destination", "owner_email", "owner_name", "starts_at", "ends_at", "max_participants", "budget_per_person_cents", "settings") VALUES
+    ( $1, $2, $3, $4, $5, $6, $7, $8 )
 RETURNING "id";
 
+-- name: LockOwnerTrips :exec
+SELECT pg_advisory_xact_lock(hashtext(@owner_email::TEXT));
+
+-- name: FindRecentTrip :one
+SELECT
+    "id"
+FROM trips
+WHERE
+    owner_email = @owner_email
+    AND destination = @destination
+    AND starts_at = @starts_at
+    AND ends_at = @ends_at
+    -- Submitted twice by mistake, not planning the same trip again.
+    AND created_at > NOW() - INTERVAL '10 minutes'
+ORDER BY created_at DESC
+LIMIT 1;
+
 -- name: GetTrip :one
 SELECT
-    "id", "destination", "owner_email", "owner_name", "is_confirmed", "starts_at", "ends_at"
+    "id", "destination", "owner_email", "owner_name", "starts_at", "ends_at", "max_participants", "budget_per_person_cents", "created_at", "summary_sent_at", "digest_sent_on", "settings", "archived_at", "planning_digest_sent_at", "status"
 FROM trips
 WHERE
     id = $1;
 
+-- name: ArchiveTrip :exec
+UPDATE trips
+SET
+    "archived_at" = NOW()
+WHERE
+    id = $1;
+
+-- name: MoveTripActivities :execrows
+UPDATE activities
+SET
+    "trip_id" = @target_id,
+    -- Organizers already on the target trip are not moved, their activities
+    -- go to the participant with the same email there.
+    "organizer_id" = COALESCE((
+        SELECT t.id
+        FROM participants s
+        JOIN participants t ON LOWER(t.email) = LOWER(s.email) AND t.trip_id = @target_id
+        WHERE s.id = activities.organizer_id
+    ), activities.organizer_id)
+WHERE
+    activities.trip_id = @source_id;
+
+-- name: MoveTripLinks :execrows
+UPDATE links
+SET
+    "trip_id" = @target_id
+WHERE
+    trip_id = @source_id;
+
+-- name: MoveTripParticipants :execrows
+UPDATE participants p
+SET
+    "trip_id" = @target_id
+WHERE
+    p.trip_id = @source_id
+    AND NOT EXISTS (
+        SELECT 1
+        FROM participants t
+        WHERE t.trip_id = @target_id AND LOWER(t.email) = LOWER(p.email)
+    );
+
 -- name: UpdateTrip :exec
 UPDATE trips
 SET 
     "destination" = $1,
     "ends_at" = $2,
     "starts_at" = $3,
-    "is_confirmed" = $4
+    "max_participants" = $4,
+    "budget_per_person_cents" = $5
 WHERE
-    id = $5;
+    id = $6;
+
+-- name: UpdateTripSettings :exec
+UPDATE trips
+SET
+    "settings" = $1
+WHERE
+    id = $2;
+
+-- name: ClaimDueTripSummaries :many
+UPDATE trips
+SET
+    "summary_sent_at" = NOW()
+WHERE
+    id IN (
+        SELECT t.id
+        FROM trips t
+        WHERE t.summary_sent_at IS NULL AND t.archived_at IS NULL AND t.created_at <= $1
+        FOR UPDATE SKIP LOCKED
+    )
+RETURNING "id";
+
+-- name: ReleaseTripSummary :exec
+UPDATE trips
+SET
+    "summary_sent_at" = NULL
+WHERE
+    id = $1;
 
 -- name: GetParticipant :one
 SELECT
-    "id", "trip_id", "email", "is_confirmed"
+    "id", "trip_id", "email", "is_confirmed", "name", "status", "invited_at", "role", "confirmed_at", "created_at", "group_id"
 FROM participants
 WHERE
     id = $1;
 
 -- name: ConfirmParticipant :exec
-SELECT
-    "id", "trip_id", "email", "is_confirmed"
-FROM participants
+UPDATE participants
+SET
+    "is_confirmed" = true,
+    "confirmed_at" = COALESCE("confirmed_at", NOW())
 WHERE
     id = $1;
 
 
 -- name: GetParticipants :many
 SELECT
-    "id", "trip_id", "email", "is_confirmed"
+    "id", "trip_id", "email", "is_confirmed", "name", "status", "invited_at", "role", "confirmed_at", "created_at", "group_id"
 FROM participants
 WHERE
     trip_id = $1;
 
+-- name: ListTripParticipants :many
+SELECT
+    "id", "trip_id", "email", "is_confirmed", "name", "status", "invited_at", "role", "confirmed_at", "created_at", "group_id"
+FROM participants
+WHERE
+    trip_id = @trip_id
+ORDER BY
+    CASE WHEN @sort::TEXT = 'name' THEN COALESCE(name, email) END,
+    CASE WHEN @sort::TEXT = '-name' THEN COALESCE(name, email) END DESC,
+    CASE WHEN @sort::TEXT = 'email' THEN email END,
+    CASE WHEN @sort::TEXT = '-email' THEN email END DESC,
+    CASE WHEN @sort::TEXT = 'invited_at' THEN invited_at END,
+    CASE WHEN @sort::TEXT = '-invited_at' THEN invited_at END DESC,
+    id;
+
 -- name: InviteParticipantsToTrip :copyfrom
 INSERT INTO participants
-    ( "trip_id", "email" ) VALUES
-    ( $1, $2 );
+    ( "trip_id", "email", "name", "status" ) VALUES
+    ( $1, $2, $3, $4 );
 
 -- name: CreateActivity :one
 INSERT INTO activities
-    ( "trip_id", "title", "occurs_at" ) VALUES
-    ( $1, $2, $3 )
+    ( "trip_id", "title", "occurs_at", "tags", "duration_minutes", "cost_cents", "status", "latitude", "longitude", "invite_sequence", "currency" ) VALUES
+    ( $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11 )
 RETURNING "id";
 
 -- name: GetTripActivities :many
 SELECT
-    "id", "trip_id", "title", "occurs_at"
+    "id", "trip_id", "title", "occurs_at", "tags", "duration_minutes", "cost_cents", "status", "latitude", "longitude", "invite_sequence", "organizer_id", "created_at", "deleted_at", "currency", "expense_id"
 FROM activities
 WHERE
-    trip_id = $1
+    trip_id = $1 AND deleted_at IS NULL
 ORDER BY occurs_at;
 
+-- name: ListTripActivities :many
+SELECT
+    "id", "trip_id", "title", "occurs_at", "tags", "duration_minutes", "cost_cents", "status", "latitude", "longitude", "invite_sequence", "organizer_id", "created_at", "deleted_at", "currency", "expense_id"
+FROM activities
+WHERE
+    trip_id = @trip_id AND deleted_at IS NULL
+ORDER BY
+    CASE WHEN @sort::TEXT = 'title' THEN title END,
+    CASE WHEN @sort::TEXT = '-title' THEN title END DESC,
+    CASE WHEN @sort::TEXT = 'cost_cents' THEN cost_cents END,
+    CASE WHEN @sort::TEXT = '-cost_cents' THEN cost_cents END DESC,
+    CASE WHEN @sort::TEXT = '-occurs_at' THEN occurs_at END DESC,
+    occurs_at, id;
+
 -- name: CreateTripLink :one
 INSERT INTO links
     ( "trip_id", "title", "url" ) VALUES
@@ -71,8 +187,1532 @@ RETURNING "id";
 
 -- name: GetTripLinks :many
 SELECT
-    "id", "trip_id", "title", "url"
+    "id", "trip_id", "title", "url", "deleted_at"
 FROM links
+WHERE
+    trip_id = $1 AND deleted_at IS NULL;
+
+-- name: ListTripLinks :many
+SELECT
+    "id", "trip_id", "title", "url", "deleted_at"
+FROM links
+WHERE
+    trip_id = @trip_id AND deleted_at IS NULL
+ORDER BY
+    CASE WHEN @sort::TEXT = 'title' THEN title END,
+    CASE WHEN @sort::TEXT = '-title' THEN title END DESC,
+    CASE WHEN @sort::TEXT = 'url' THEN url END,
+    CASE WHEN @sort::TEXT = '-url' THEN url END DESC,
+    id;
+
+-- name: CreateChecklistItem :one
+INSERT INTO checklist_items
+    ( "trip_id", "title", "category" ) VALUES
+    ( $1, $2, $3 )
+RETURNING "id";
+
+-- name: InsertChecklistItems :copyfrom
+INSERT INTO checklist_items
+    ( "trip_id", "title", "category", "template_key" ) VALUES
+    ( $1, $2, $3, $4 );
+
+-- name: GetChecklistItem :one
+SELECT
+    "id", "trip_id", "title", "category", "is_checked", "template_key"
+FROM checklist_items
+WHERE
+    id = $1;
+
+-- name: GetTripChecklistItems :many
+SELECT
+    "id", "trip_id", "title", "category", "is_checked", "template_key"
+FROM checklist_items
+WHERE
+    trip_id = $1
+ORDER BY category, title;
+
+-- name: UpdateChecklistItem :exec
+UPDATE checklist_items
+SET
+    "title" = $1,
+    "is_checked" = $2
+WHERE
+    id = $3;
+
+-- name: DeleteChecklistItem :exec
+DELETE FROM checklist_items
+WHERE
+    id = $1;
+
+-- name: InsertExpense :one
+INSERT INTO expenses
+    ( "trip_id", "paid_by", "description", "category", "amount_cents", "spent_at" ) VALUES
+    ( $1, $2, $3, $4, $5, $6 )
+RETURNING "id";
+
+-- name: ListTripExpenses :many
+SELECT
+    "id", "trip_id", "paid_by", "description", "category", "amount_cents", "spent_at"
+FROM expenses
+WHERE
+    trip_id = @trip_id
+ORDER BY
+    CASE WHEN @sort::TEXT = 'amount_cents' THEN amount_cents END,
+    CASE WHEN @sort::TEXT = '-amount_cents' THEN amount_cents END DESC,
+    CASE WHEN @sort::TEXT = 'category' THEN category END,
+    CASE WHEN @sort::TEXT = '-category' THEN category END DESC,
+    CASE WHEN @sort::TEXT = 'description' THEN description END,
+    CASE WHEN @sort::TEXT = '-description' THEN description END DESC,
+    CASE WHEN @sort::TEXT = '-spent_at' THEN spent_at END DESC,
+    spent_at, id;
+
+-- name: GetTripExpensesByCategory :many
+SELECT
+    "category", SUM("amount_cents")::BIGINT AS total_cents
+FROM expenses
+WHERE
+    trip_id = $1
+GROUP BY category
+ORDER BY total_cents DESC;
+
+-- name: GetTripExpensesByDay :many
+SELECT
+    "spent_at"::DATE AS day, SUM("amount_cents")::BIGINT AS total_cents
+FROM expenses
+WHERE
+    trip_id = $1
+GROUP BY day
+ORDER BY day;
+
+-- name: GetTripExpensesByParticipant :many
+SELECT
+    p."id", p."email", SUM(e."amount_cents")::BIGINT AS total_cents
+FROM expenses e
+JOIN participants p ON p.id = e.paid_by
+WHERE
+    e.trip_id = $1
+GROUP BY p.id, p.email
+ORDER BY total_cents DESC;
+
+-- name: CreateReceipt :one
+INSERT INTO receipts
+    ( "trip_id", "content_type", "data", "merchant", "amount_cents", "spent_at" ) VALUES
+    ( $1, $2, $3, $4, $5, $6 )
+RETURNING "id";
+
+-- name: GetReceipt :one
+SELECT
+    "id", "trip_id", "expense_id", "content_type", "data", "merchant", "amount_cents", "spent_at", "created_at"
+FROM receipts
+WHERE
+    id = $1;
+
+-- name: GetExpenseReceipt :one
+SELECT
+    "id", "trip_id", "expense_id", "content_type", "data", "merchant", "amount_cents", "spent_at", "created_at"
+FROM receipts
+WHERE
+    expense_id = $1;
+
+-- name: AttachReceiptToExpense :exec
+UPDATE receipts
+SET
+    "expense_id" = $1
+WHERE
+    id = $2;
+
+
+
+-- name: InsertExpenseSplits :copyfrom
+INSERT INTO expense_splits
+    ( "expense_id", "participant_id", "amount_cents" ) VALUES
+    ( $1, $2, $3 );
+
+-- name: GetTripBalances :many
+SELECT
+    p."id",
+    p."email",
+    COALESCE((SELECT SUM(e."amount_cents") FROM expenses e WHERE e.paid_by = p.id), 0)::BIGINT AS paid_cents,
+    COALESCE((SELECT SUM(s."amount_cents") FROM expense_splits s WHERE s.participant_id = p.id), 0)::BIGINT AS owed_cents
+FROM participants p
+WHERE
+    p.trip_id = $1
+ORDER BY p.email;
+
+-- name: UpsertParticipantNeeds :exec
+INSERT INTO participant_needs
+    ( "participant_id", "dietary", "accessibility", "notes" ) VALUES
+    ( $1, $2, $3, $4 )
+ON CONFLICT (participant_id) DO UPDATE
+SET
+    "dietary" = EXCLUDED.dietary,
+    "accessibility" = EXCLUDED.accessibility,
+    "notes" = EXCLUDED.notes,
+    "updated_at" = NOW();
+
+-- name: GetParticipantNeeds :one
+SELECT
+    "participant_id", "dietary", "accessibility", "notes", "updated_at"
+FROM participant_needs
+WHERE
+    participant_id = $1;
+
+-- name: GetTripParticipantNeeds :many
+SELECT
+    p."id", p."email", n."dietary", n."accessibility", n."notes"
+FROM participant_needs n
+JOIN participants p ON p.id = n.participant_id
+WHERE
+    p.trip_id = $1
+ORDER BY p.email;
+
+-- name: CreateLodging :one
+INSERT INTO lodgings
+    ( "trip_id", "name", "address", "check_in", "check_out", "cost_cents", "status" ) VALUES
+    ( $1, $2, $3, $4, $5, $6, $7 )
+RETURNING "id";
+
+-- name: GetTripLodgings :many
+SELECT
+    "id", "trip_id", "name", "address", "check_in", "check_out", "cost_cents", "status"
+FROM lodgings
+WHERE
+    trip_id = $1
+ORDER BY check_in;
+
+-- name: CreateTransport :one
+INSERT INTO transports
+    ( "trip_id", "mode", "origin", "destination", "departs_at", "arrives_at" ) VALUES
+    ( $1, $2, $3, $4, $5, $6 )
+RETURNING "id";
+
+-- name: GetTripTransports :many
+SELECT
+    "id", "trip_id", "mode", "origin", "destination", "departs_at", "arrives_at"
+FROM transports
+WHERE
+    trip_id = $1
+ORDER BY departs_at;
+
+-- name: CountActiveParticipants :one
+SELECT
+    (
+        (SELECT COUNT(*) FROM participants p WHERE p.trip_id = $1 AND p.status IN ('invited', 'email_invalid')) +
+        (SELECT COUNT(*) FROM companions c JOIN participants p ON p.id = c.participant_id WHERE p.trip_id = $1 AND p.status IN ('invited', 'email_invalid'))
+    )::BIGINT AS count;
+
+-- name: GetTripConfirmationSummary :one
+SELECT
+    COUNT(p.id) FILTER (WHERE p.is_confirmed)::BIGINT AS confirmed,
+    COUNT(p.id) FILTER (WHERE NOT p.is_confirmed)::BIGINT AS pending,
+    MAX(p.confirmed_at)::TIMESTAMP AS last_confirmed_at
+FROM trips t
+LEFT JOIN participants p ON p.trip_id = t.id AND p.status IN ('invited', 'email_invalid')
+WHERE
+    t.id = @trip_id
+GROUP BY t.id;
+
+-- name: GetFirstWaitlistedParticipant :one
+SELECT
+    "id", "trip_id", "email", "is_confirmed", "name", "status", "invited_at", "role", "confirmed_at", "created_at", "group_id"
+FROM participants
+WHERE
+    trip_id = $1 AND status = 'waitlisted'
+ORDER BY invited_at, id
+LIMIT 1
+FOR UPDATE;
+
+-- name: MarkParticipantDeclined :exec
+UPDATE participants
+SET
+    "status" = 'declined',
+    "is_confirmed" = false,
+    "confirmed_at" = NULL
+WHERE
+    id = $1;
+
+-- name: PromoteParticipant :exec
+UPDATE participants
+SET
+    "status" = 'invited'
+WHERE
+    id = $1;
+
+-- name: MarkParticipantsEmailInvalid :many
+UPDATE participants
+SET
+    "status" = 'email_invalid'
+WHERE
+    LOWER(email) = LOWER(sqlc.arg(email)) AND status = 'invited' AND NOT is_confirmed
+RETURNING "id";
+
+-- name: CorrectParticipantEmail :exec
+UPDATE participants
+SET
+    "email" = $1,
+    "status" = 'invited'
+WHERE
+    id = $2;
+
+-- name: CreateCompanion :one
+INSERT INTO companions
+    ( "participant_id", "name" ) VALUES
+    ( $1, $2 )
+RETURNING "id";
+
+-- name: GetCompanion :one
+SELECT
+    "id", "participant_id", "name", "created_at"
+FROM companions
+WHERE
+    id = $1;
+
+-- name: GetTripCompanions :many
+SELECT
+    c."id", c."participant_id", c."name", c."created_at"
+FROM companions c
+JOIN participants p ON p.id = c.participant_id
+WHERE
+    p.trip_id = $1
+ORDER BY c.created_at;
+
+-- name: DeleteCompanion :exec
+DELETE FROM companions
+WHERE
+    id = $1;
+
+-- name: InsertDatePollOptions :copyfrom
+INSERT INTO date_poll_options
+    ( "trip_id", "starts_at", "ends_at" ) VALUES
+    ( $1, $2, $3 );
+
+-- name: GetDatePollOption :one
+SELECT
+    "id", "trip_id", "starts_at", "ends_at"
+FROM date_poll_options
+WHERE
+    id = $1;
+
+-- name: GetTripDatePollOptions :many
+SELECT
+    "id", "trip_id", "starts_at", "ends_at"
+FROM date_poll_options
+WHERE
+    trip_id = $1
+ORDER BY starts_at;
+
+-- name: DeleteTripDatePollOptions :exec
+DELETE FROM date_poll_options
+WHERE
+    trip_id = $1;
+
+-- name: CreateDatePollToken :exec
+INSERT INTO date_poll_tokens
+    ( "token", "participant_id" ) VALUES
+    ( $1, $2 )
+ON CONFLICT (participant_id) DO NOTHING;
+
+-- name: GetDatePollToken :one
+SELECT
+    "token", "participant_id"
+FROM date_poll_tokens
+WHERE
+    token = $1;
+
+-- name: GetTripDatePollTokens :many
+SELECT
+    t."token", p."id", p."email"
+FROM date_poll_tokens t
+JOIN participants p ON p.id = t.participant_id
+WHERE
+    p.trip_id = $1 AND p.status = 'invited';
+
+-- name: UpsertDatePollVote :exec
+INSERT INTO date_poll_votes
+    ( "option_id", "participant_id", "is_available" ) VALUES
+    ( $1, $2, $3 )
+ON CONFLICT (option_id, participant_id) DO UPDATE
+SET
+    "is_available" = EXCLUDED.is_available;
+
+-- name: GetParticipantDatePollVotes :many
+SELECT
+    "option_id", "participant_id", "is_available"
+FROM date_poll_votes
+WHERE
+    participant_id = $1;
+
+-- name: GetDatePollResults :many
+SELECT
+    o."id",
+    o."starts_at",
+    o."ends_at",
+    COUNT(v.participant_id) FILTER (WHERE v.is_available)::BIGINT AS available_count,
+    COUNT(v.participant_id) FILTER (WHERE NOT v.is_available)::BIGINT AS unavailable_count
+FROM date_poll_options o
+LEFT JOIN date_poll_votes v ON v.option_id = o.id
+WHERE
+    o.trip_id = $1
+GROUP BY o.id, o.starts_at, o.ends_at
+ORDER BY o.starts_at;
+
+-- name: LockTrip :exec
+SELECT "id"
+FROM trips
+WHERE
+    id = $1
+FOR UPDATE;
+
+-- name: GetTripCommittedCents :one
+SELECT
+    (
+        (SELECT COALESCE(SUM(a.cost_cents), 0) FROM activities a JOIN trips t ON t.id = a.trip_id WHERE a.trip_id = $1 AND a.status = 'approved' AND a.deleted_at IS NULL AND (a.currency IS NULL OR a.currency = COALESCE(t.settings->>'currency', a.currency))) +
+        (SELECT COALESCE(SUM(l.cost_cents), 0) FROM lodgings l WHERE l.trip_id = $1 AND l.status = 'approved')
+    )::BIGINT AS committed_cents;
+
+-- name: GetActivity :one
+SELECT
+    "id", "trip_id", "title", "occurs_at", "tags", "duration_minutes", "cost_cents", "status", "latitude", "longitude", "invite_sequence", "organizer_id", "created_at", "deleted_at", "currency", "expense_id"
+FROM activities
+WHERE
+    id = $1 AND deleted_at IS NULL;
+
+-- name: ApproveActivity :exec
+UPDATE activities
+SET
+    "status" = 'approved'
+WHERE
+    id = $1;
+
+-- name: DeleteActivity :exec
+DELETE FROM activities
+WHERE
+    id = $1;
+
+-- name: GetLodging :one
+SELECT
+    "id", "trip_id", "name", "address", "check_in", "check_out", "cost_cents", "status"
+FROM lodgings
+WHERE
+    id = $1;
+
+-- name: ApproveLodging :exec
+UPDATE lodgings
+SET
+    "status" = 'approved'
+WHERE
+    id = $1;
+
+-- name: DeleteLodging :exec
+DELETE FROM lodgings
+WHERE
+    id = $1;
+
+-- name: UpdateActivityOccursAt :exec
+UPDATE activities
+SET
+    "occurs_at" = $1
+WHERE
+    id = $2;
+
+-- name: NextActivityInviteSequence :one
+UPDATE activities
+SET
+    "invite_sequence" = invite_sequence + 1
+WHERE
+    id = $1 AND invite_sequence IS NOT NULL
+RETURNING "invite_sequence"::INTEGER AS invite_sequence;
+-- name: CreateOwnershipTransfer :exec
+INSERT INTO ownership_transfers
+    ( "token", "trip_id", "participant_id", "expires_at" ) VALUES
+    ( $1, $2, $3, $4 )
+ON CONFLICT (trip_id) DO UPDATE SET
+    "token" = EXCLUDED.token,
+    "participant_id" = EXCLUDED.participant_id,
+    "expires_at" = EXCLUDED.expires_at;
+
+-- name: GetOwnershipTransfer :one
+SELECT
+    "token", "trip_id", "participant_id", "expires_at"
+FROM ownership_transfers
+WHERE
+    token = $1;
+
+-- name: DeleteOwnershipTransfer :exec
+DELETE FROM ownership_transfers
+WHERE
+    token = $1;
+
+-- name: CreateOwnerEmailChange :exec
+INSERT INTO owner_email_changes
+    ( "trip_id", "new_email", "old_token", "new_token", "expires_at" ) VALUES
+    ( $1, $2, $3, $4, $5 )
+ON CONFLICT (trip_id) DO UPDATE SET
+    "new_email" = EXCLUDED.new_email,
+    "old_token" = EXCLUDED.old_token,
+    "new_token" = EXCLUDED.new_token,
+    "old_confirmed_at" = NULL,
+    "new_confirmed_at" = NULL,
+    "expires_at" = EXCLUDED.expires_at;
+
+-- name: GetOwnerEmailChange :one
+SELECT
+    "trip_id", "new_email", "old_token", "new_token", "old_confirmed_at", "new_confirmed_at", "expires_at"
+FROM owner_email_changes
+WHERE
+    trip_id = $1;
+
+-- name: GetOwnerEmailChangeByToken :one
+SELECT
+    "trip_id", "new_email", "old_token", "new_token", "old_confirmed_at", "new_confirmed_at", "expires_at"
+FROM owner_email_changes
+WHERE
+    old_token = @token OR new_token = @token;
+
+-- name: ConfirmOwnerEmailChange :one
+UPDATE owner_email_changes
+SET
+    "old_confirmed_at" = CASE WHEN old_token = @token THEN COALESCE(old_confirmed_at, NOW()) ELSE old_confirmed_at END,
+    "new_confirmed_at" = CASE WHEN new_token = @token THEN COALESCE(new_confirmed_at, NOW()) ELSE new_confirmed_at END
+WHERE
+    old_token = @token OR new_token = @token
+RETURNING "trip_id", "new_email", "old_token", "new_token", "old_confirmed_at", "new_confirmed_at", "expires_at";
+
+-- name: DeleteOwnerEmailChange :exec
+DELETE FROM owner_email_changes
 WHERE
     trip_id = $1;
 
+-- name: UpdateParticipantEmail :exec
+UPDATE participants
+SET
+    "email" = $1
+WHERE
+    id = $2;
+
+-- name: UpdateTripOwner :exec
+UPDATE trips
+SET
+    "owner_email" = $1,
+    "owner_name" = $2
+WHERE
+    id = $3;
+
+-- name: InsertOwnerParticipant :one
+INSERT INTO participants
+    ( "trip_id", "email", "name", "status", "is_confirmed", "confirmed_at", "role" ) VALUES
+    ( $1, $2, $3, 'invited', true, NOW(), 'owner' )
+RETURNING "id";
+
+-- name: SetParticipantRole :exec
+UPDATE participants
+SET
+    "role" = $1
+WHERE
+    id = $2;
+
+-- name: GetTripOwners :many
+SELECT
+    "id", "trip_id", "email", "is_confirmed", "name", "status", "invited_at", "role", "confirmed_at", "created_at", "group_id"
+FROM participants
+WHERE
+    trip_id = $1 AND role = 'owner'
+ORDER BY invited_at, id;
+
+-- name: SetActivityOrganizer :exec
+UPDATE activities
+SET
+    "organizer_id" = $1
+WHERE
+    id = $2;
+
+-- name: ClaimDueTripDigests :many
+UPDATE trips
+SET
+    "digest_sent_on" = (NOW() AT TIME ZONE (settings->>'timezone'))::DATE
+WHERE
+    id IN (
+        SELECT t.id
+        FROM trips t
+        WHERE
+            t.status IN ('confirmed', 'ongoing')
+            AND t.archived_at IS NULL
+            AND (t.settings->>'digest')::BOOLEAN
+            AND EXTRACT(HOUR FROM NOW() AT TIME ZONE (t.settings->>'timezone')) >= (t.settings->>'reminder_hour')::INT
+            AND (t.digest_sent_on IS NULL OR t.digest_sent_on < (NOW() AT TIME ZONE (t.settings->>'timezone'))::DATE)
+            AND t.ends_at::DATE >= (NOW() AT TIME ZONE (t.settings->>'timezone'))::DATE
+        FOR UPDATE SKIP LOCKED
+    )
+RETURNING "id";
+
+-- name: ReleaseTripDigest :exec
+UPDATE trips
+SET
+    "digest_sent_on" = NULL
+WHERE
+    id = $1;
+
+-- name: CreateTask :one
+INSERT INTO tasks
+    ( "trip_id", "title", "due_on", "assignee_id" ) VALUES
+    ( $1, $2, $3, $4 )
+RETURNING "id";
+
+-- name: GetTask :one
+SELECT
+    "id", "trip_id", "title", "due_on", "assignee_id", "is_done", "overdue_notified_at"
+FROM tasks
+WHERE
+    id = $1;
+
+-- name: GetTripTasks :many
+SELECT
+    "id", "trip_id", "title", "due_on", "assignee_id", "is_done", "overdue_notified_at"
+FROM tasks
+WHERE
+    trip_id = $1
+ORDER BY due_on, title;
+
+-- name: UpdateTask :exec
+UPDATE tasks
+SET
+    "title" = $1,
+    "due_on" = $2,
+    "assignee_id" = $3,
+    "is_done" = $4,
+    "overdue_notified_at" = CASE WHEN "due_on" = $2 THEN "overdue_notified_at" END
+WHERE
+    id = $5;
+
+-- name: DeleteTask :exec
+DELETE FROM tasks
+WHERE
+    id = $1;
+
+-- name: ClaimOverdueTasks :many
+UPDATE tasks
+SET
+    "overdue_notified_at" = NOW()
+WHERE
+    id IN (
+        SELECT k.id
+        FROM tasks k
+        JOIN trips t ON t.id = k.trip_id
+        WHERE
+            NOT k.is_done
+            AND k.overdue_notified_at IS NULL
+            AND t.archived_at IS NULL
+            AND k.due_on < (NOW() AT TIME ZONE (t.settings->>'timezone'))::DATE
+            AND EXTRACT(HOUR FROM NOW() AT TIME ZONE (t.settings->>'timezone')) >= (t.settings->>'reminder_hour')::INT
+        FOR UPDATE OF k SKIP LOCKED
+    )
+RETURNING "id";
+
+-- name: ReleaseOverdueTask :exec
+UPDATE tasks
+SET
+    "overdue_notified_at" = NULL
+WHERE
+    id = $1;
+
+-- name: ConfirmTripParticipants :many
+UPDATE participants
+SET
+    "is_confirmed" = true,
+    "confirmed_at" = COALESCE("confirmed_at", NOW())
+WHERE
+    trip_id = @trip_id AND id = ANY(@ids::uuid[]) AND NOT is_confirmed
+RETURNING "id";
+
+-- name: InsertAuditEvent :exec
+INSERT INTO audit_events
+    ( "trip_id", "action", "details", "remote_addr" ) VALUES
+    ( $1, $2, $3, $4 );
+
+-- name: CountRecentAuditEvents :one
+SELECT
+    COUNT(*) AS count,
+    MIN(created_at)::TIMESTAMP AS oldest
+FROM audit_events
+WHERE
+    trip_id = @trip_id AND action = @action AND created_at > @since;
+
+-- name: GetInstanceTotals :one
+SELECT
+    (SELECT COUNT(*) FROM trips)::BIGINT AS trips,
+    (SELECT COUNT(*) FROM trips WHERE status IN ('confirmed', 'ongoing') AND archived_at IS NULL AND ends_at >= NOW())::BIGINT AS active_trips,
+    (SELECT COUNT(*) FROM participants)::BIGINT AS participants,
+    pg_database_size(current_database())::BIGINT AS database_bytes;
+
+-- name: CountTripsCreatedPerDay :many
+SELECT
+    created_at::DATE AS day,
+    COUNT(*) AS trips
+FROM trips
+WHERE
+    created_at >= @since
+GROUP BY day
+ORDER BY day;
+
+-- name: GetTableSizes :many
+SELECT
+    c.relname::TEXT AS name,
+    -- Planner estimate, kept up to date by autovacuum, instead of counting.
+    GREATEST(c.reltuples, 0)::BIGINT AS rows_estimate,
+    pg_total_relation_size(c.oid)::BIGINT AS bytes
+FROM pg_catalog.pg_class c
+WHERE
+    c.relkind = 'r' AND c.relnamespace = 'public'::regnamespace
+ORDER BY bytes DESC;
+
+-- name: CreateAttachment :one
+INSERT INTO attachments
+    ( "trip_id", "filename", "content_type", "uploaded_by" ) VALUES
+    ( $1, $2, $3, $4 )
+RETURNING "id";
+
+-- name: GetAttachment :one
+SELECT
+    "id", "trip_id", "filename", "content_type", "size_bytes", "uploaded_at", "created_at", "uploaded_by", "scan_status", "scan_signature", "scanned_at"
+FROM attachments
+WHERE
+    id = $1;
+
+-- name: CompleteAttachment :one
+UPDATE attachments
+SET
+    "size_bytes" = $1,
+    "uploaded_at" = NOW()
+WHERE
+    id = $2
+RETURNING "id", "trip_id", "filename", "content_type", "size_bytes", "uploaded_at", "created_at", "uploaded_by", "scan_status", "scan_signature", "scanned_at";
+
+-- name: SetAttachmentScanResult :exec
+UPDATE attachments
+SET
+    "scan_status" = $1,
+    "scan_signature" = $2,
+    "scanned_at" = NOW()
+WHERE
+    id = $3;
+
+-- name: GetTripAttachmentBytes :one
+SELECT
+    COALESCE(SUM(size_bytes), 0)::BIGINT AS bytes
+FROM attachments
+WHERE
+    trip_id = $1 AND uploaded_at IS NOT NULL AND scan_status <> 'infected';
+
+-- name: ListExpiredAttachments :many
+SELECT
+    a.id, a.trip_id, a.size_bytes
+FROM attachments a
+JOIN trips t ON t.id = a.trip_id
+WHERE
+    t.archived_at < @before OR t.ends_at < @before
+ORDER BY a.created_at
+LIMIT @max;
+
+-- name: DeleteAttachment :execrows
+DELETE FROM attachments
+WHERE
+    id = $1;
+
+-- name: ArchiveEndedTrips :many
+UPDATE trips
+SET
+    "archived_at" = NOW()
+WHERE
+    id IN (
+        SELECT t.id
+        FROM trips t
+        WHERE t.archived_at IS NULL AND t.ends_at < $1
+        FOR UPDATE SKIP LOCKED
+    )
+RETURNING "id";
+
+-- name: StartTripSheetConnection :exec
+INSERT INTO trip_sheets
+    ( "trip_id", "state" ) VALUES
+    ( $1, $2 )
+ON CONFLICT (trip_id) DO UPDATE
+SET
+    "state" = EXCLUDED.state;
+
+-- name: GetTripSheet :one
+SELECT
+    "trip_id", "state", "refresh_token", "spreadsheet_id", "spreadsheet_url", "synced_at", "created_at"
+FROM trip_sheets
+WHERE
+    trip_id = $1;
+
+-- name: GetTripSheetByState :one
+SELECT
+    "trip_id", "state", "refresh_token", "spreadsheet_id", "spreadsheet_url", "synced_at", "created_at"
+FROM trip_sheets
+WHERE
+    state = $1;
+
+-- name: ConnectTripSheet :one
+UPDATE trip_sheets
+SET
+    "state" = NULL,
+    "refresh_token" = $2,
+    "spreadsheet_id" = $3,
+    "spreadsheet_url" = $4,
+    "synced_at" = NOW()
+WHERE
+    trip_id = $1
+RETURNING "trip_id", "state", "refresh_token", "spreadsheet_id", "spreadsheet_url", "synced_at", "created_at";
+
+-- name: DeleteTripSheet :execrows
+DELETE FROM trip_sheets
+WHERE
+    trip_id = $1;
+
+-- name: ClaimDueTripSheets :many
+UPDATE trip_sheets
+SET
+    "synced_at" = NOW()
+WHERE
+    trip_id IN (
+        SELECT s.trip_id
+        FROM trip_sheets s
+        JOIN trips t ON t.id = s.trip_id
+        WHERE s.spreadsheet_id IS NOT NULL AND s.synced_at < $1 AND t.archived_at IS NULL
+        FOR UPDATE OF s SKIP LOCKED
+    )
+RETURNING "trip_id", "state", "refresh_token", "spreadsheet_id", "spreadsheet_url", "synced_at", "created_at";
+
+-- name: ListNewParticipants :many
+SELECT
+    "id", "email", "name", "status", "is_confirmed", "created_at"
+FROM participants
+WHERE
+    trip_id = @trip_id AND ("created_at", "id") > (@after_created_at::TIMESTAMP, @after_id::uuid)
+ORDER BY "created_at", "id"
+LIMIT @max;
+
+-- name: ListNewActivities :many
+SELECT
+    "id", "title", "occurs_at", "status", "created_at"
+FROM activities
+WHERE
+    trip_id = @trip_id AND deleted_at IS NULL AND ("created_at", "id") > (@after_created_at::TIMESTAMP, @after_id::uuid)
+ORDER BY "created_at", "id"
+LIMIT @max;
+
+-- name: ShareTrip :exec
+INSERT INTO trip_shares
+    ( "trip_id", "token" ) VALUES
+    ( $1, $2 )
+ON CONFLICT (trip_id) DO UPDATE
+SET
+    "token" = EXCLUDED.token,
+    "created_at" = NOW();
+
+-- name: GetSharedTripID :one
+SELECT
+    "trip_id"
+FROM trip_shares
+WHERE
+    token = $1 AND held_at IS NULL;
+
+-- name: UnshareTrip :execrows
+DELETE FROM trip_shares
+WHERE
+    trip_id = $1;
+
+-- name: ListTripFeedEvents :many
+SELECT
+    "id", "trip_id", "action", "details", "remote_addr", "created_at"
+FROM audit_events
+WHERE
+    trip_id = @trip_id AND action = ANY(@actions::TEXT[])
+ORDER BY created_at DESC, id DESC
+LIMIT @max;
+
+-- name: InsertParticipantGroup :one
+INSERT INTO participant_groups
+    ( "trip_id", "name" ) VALUES
+    ( $1, $2 )
+RETURNING "id";
+
+-- name: GetParticipantGroup :one
+SELECT
+    "id", "trip_id", "name", "created_at"
+FROM participant_groups
+WHERE
+    id = $1;
+
+-- name: GetTripParticipantGroups :many
+SELECT
+    "id", "trip_id", "name", "created_at"
+FROM participant_groups
+WHERE
+    trip_id = $1
+ORDER BY name;
+
+-- name: RenameParticipantGroup :exec
+UPDATE participant_groups
+SET
+    "name" = $1
+WHERE
+    id = $2;
+
+-- name: DeleteParticipantGroup :exec
+DELETE FROM participant_groups
+WHERE
+    id = $1;
+
+-- name: ClearParticipantGroup :exec
+UPDATE participants
+SET
+    "group_id" = NULL
+WHERE
+    group_id = @group_id AND NOT (id = ANY(@keep::UUID[]));
+
+-- name: SetParticipantsGroup :exec
+UPDATE participants
+SET
+    "group_id" = @group_id
+WHERE
+    trip_id = @trip_id AND id = ANY(@ids::UUID[]);
+
+-- name: CreateLodgingRoom :one
+INSERT INTO lodging_rooms
+    ( "lodging_id", "name", "capacity", "beds" ) VALUES
+    ( $1, $2, $3, $4 )
+RETURNING "id";
+
+-- name: GetLodgingRoom :one
+SELECT
+    "id", "lodging_id", "name", "capacity", "beds", "created_at"
+FROM lodging_rooms
+WHERE
+    id = $1;
+
+-- name: GetLodgingRooms :many
+SELECT
+    "id", "lodging_id", "name", "capacity", "beds", "created_at"
+FROM lodging_rooms
+WHERE
+    lodging_id = $1
+ORDER BY name;
+
+-- name: DeleteLodgingRoom :exec
+DELETE FROM lodging_rooms
+WHERE
+    id = $1;
+
+-- name: GetLodgingRoomAssignments :many
+SELECT
+    "room_id", "lodging_id", "participant_id"
+FROM room_assignments
+WHERE
+    lodging_id = $1;
+
+-- name: ClearRoomAssignments :exec
+DELETE FROM room_assignments
+WHERE
+    room_id = @room_id OR (lodging_id = @lodging_id AND participant_id = ANY(@participant_ids::UUID[]));
+
+-- name: InsertRoomAssignments :copyfrom
+INSERT INTO room_assignments
+    ( "room_id", "lodging_id", "participant_id" ) VALUES
+    ( $1, $2, $3 );
+
+-- name: CreateRide :one
+INSERT INTO rides
+    ( "trip_id", "driver_id", "departs_at", "departure_point", "seats" ) VALUES
+    ( $1, $2, $3, $4, $5 )
+RETURNING "id";
+
+-- name: GetRide :one
+SELECT
+    "id", "trip_id", "driver_id", "departs_at", "departure_point", "seats", "created_at"
+FROM rides
+WHERE
+    id = $1;
+
+-- name: LockRide :one
+SELECT
+    "id", "trip_id", "driver_id", "departs_at", "departure_point", "seats", "created_at"
+FROM rides
+WHERE
+    id = $1
+FOR UPDATE;
+
+-- name: GetTripRides :many
+SELECT
+    "id", "trip_id", "driver_id", "departs_at", "departure_point", "seats", "created_at"
+FROM rides
+WHERE
+    trip_id = $1
+ORDER BY departs_at, id;
+
+-- name: DeleteRide :exec
+DELETE FROM rides
+WHERE
+    id = $1;
+
+-- name: GetRidePassengers :many
+SELECT
+    "ride_id", "participant_id", "seats", "created_at"
+FROM ride_passengers
+WHERE
+    ride_id = $1
+ORDER BY created_at;
+
+-- name: GetTripRidePassengers :many
+SELECT
+    p."ride_id", p."participant_id", p."seats", p."created_at"
+FROM ride_passengers p
+JOIN rides r ON r.id = p.ride_id
+WHERE
+    r.trip_id = $1
+ORDER BY p.created_at;
+
+-- name: CountRideSeatsTaken :one
+SELECT
+    COALESCE(SUM(seats), 0)::BIGINT AS taken
+FROM ride_passengers
+WHERE
+    ride_id = $1;
+
+-- name: InsertRidePassenger :exec
+INSERT INTO ride_passengers
+    ( "ride_id", "participant_id", "seats" ) VALUES
+    ( $1, $2, $3 );
+
+-- name: DeleteRidePassenger :execrows
+DELETE FROM ride_passengers
+WHERE
+    ride_id = $1 AND participant_id = $2;
+
+-- name: CreateShoppingItem :one
+INSERT INTO shopping_items
+    ( "trip_id", "title", "quantity" ) VALUES
+    ( $1, $2, $3 )
+RETURNING "id";
+
+-- name: GetShoppingItem :one
+SELECT
+    "id", "trip_id", "title", "quantity", "claimed_by", "expense_id", "purchased_at", "created_at"
+FROM shopping_items
+WHERE
+    id = $1;
+
+-- name: GetTripShoppingItems :many
+SELECT
+    "id", "trip_id", "title", "quantity", "claimed_by", "expense_id", "purchased_at", "created_at"
+FROM shopping_items
+WHERE
+    trip_id = $1
+ORDER BY created_at, id;
+
+-- name: DeleteShoppingItem :exec
+DELETE FROM shopping_items
+WHERE
+    id = $1;
+
+-- name: ClaimShoppingItem :execrows
+UPDATE shopping_items
+SET
+    "claimed_by" = $1
+WHERE
+    id = $2 AND purchased_at IS NULL AND (claimed_by IS NULL OR claimed_by = $1);
+
+-- name: UnclaimShoppingItem :execrows
+UPDATE shopping_items
+SET
+    "claimed_by" = NULL
+WHERE
+    id = $1 AND purchased_at IS NULL;
+
+-- name: MarkShoppingItemPurchased :execrows
+UPDATE shopping_items
+SET
+    "expense_id" = $1,
+    "purchased_at" = NOW()
+WHERE
+    id = $2 AND purchased_at IS NULL;
+
+-- name: ClaimEndedTripSurveys :many
+INSERT INTO trip_surveys
+    ( "trip_id" )
+SELECT t.id
+FROM trips t
+WHERE
+    t.status = 'finished' AND t.archived_at IS NULL AND t.ends_at >= $1
+ON CONFLICT (trip_id) DO NOTHING
+RETURNING "trip_id";
+
+-- name: ReleaseTripSurvey :exec
+DELETE FROM trip_surveys
+WHERE
+    trip_id = $1;
+
+-- name: GetTripSurvey :one
+SELECT
+    "trip_id", "sent_at"
+FROM trip_surveys
+WHERE
+    trip_id = $1;
+
+-- name: GetSurveyQuestions :many
+SELECT
+    "id", "trip_id", "position", "prompt", "kind"
+FROM survey_questions
+WHERE
+    trip_id = $1
+ORDER BY position;
+
+-- name: DeleteSurveyQuestions :exec
+DELETE FROM survey_questions
+WHERE
+    trip_id = $1;
+
+-- name: InsertSurveyQuestions :copyfrom
+INSERT INTO survey_questions
+    ( "trip_id", "position", "prompt", "kind" ) VALUES
+    ( $1, $2, $3, $4 );
+
+-- name: CreateSurveyToken :exec
+INSERT INTO survey_tokens
+    ( "token", "participant_id" ) VALUES
+    ( $1, $2 )
+ON CONFLICT (participant_id) DO NOTHING;
+
+-- name: GetSurveyToken :one
+SELECT
+    "token", "participant_id"
+FROM survey_tokens
+WHERE
+    token = $1;
+
+-- name: GetTripSurveyTokens :many
+SELECT
+    t."token", p."id", p."email"
+FROM survey_tokens t
+JOIN participants p ON p.id = t.participant_id
+WHERE
+    p.trip_id = $1 AND p.status = 'invited';
+
+-- name: UpsertSurveyAnswer :exec
+INSERT INTO survey_answers
+    ( "question_id", "participant_id", "rating", "comment" ) VALUES
+    ( $1, $2, $3, $4 )
+ON CONFLICT (question_id, participant_id) DO UPDATE
+SET
+    "rating" = EXCLUDED.rating,
+    "comment" = EXCLUDED.comment,
+    "answered_at" = NOW();
+
+-- name: GetParticipantSurveyAnswers :many
+SELECT
+    "question_id", "participant_id", "rating", "comment", "answered_at"
+FROM survey_answers
+WHERE
+    participant_id = $1;
+
+-- name: GetTripSurveyAnswers :many
+SELECT
+    a."question_id", a."participant_id", a."rating", a."comment", a."answered_at"
+FROM survey_answers a
+JOIN survey_questions q ON q.id = a.question_id
+WHERE
+    q.trip_id = $1
+ORDER BY a.answered_at;
+
+-- name: GetTripExpenseSplits :many
+SELECT
+    s."expense_id", s."participant_id", s."amount_cents"
+FROM expense_splits s
+JOIN expenses e ON e.id = s.expense_id
+WHERE
+    e.trip_id = $1;
+
+-- name: GetTripAuditEvents :many
+SELECT
+    "id", "trip_id", "action", "details", "remote_addr", "created_at"
+FROM audit_events
+WHERE
+    trip_id = $1
+ORDER BY created_at, id;
+
+-- name: GetTripAttachments :many
+SELECT
+    "id", "trip_id", "filename", "content_type", "size_bytes", "uploaded_at", "created_at", "uploaded_by", "scan_status", "scan_signature", "scanned_at"
+FROM attachments
+WHERE
+    trip_id = $1
+ORDER BY created_at, id;
+
+-- name: InsertImportedTrip :exec
+INSERT INTO trips
+    ( "id", "destination", "owner_email", "owner_name", "status", "starts_at", "ends_at", "max_participants", "budget_per_person_cents", "settings", "archived_at" ) VALUES
+    ( $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11 );
+
+-- name: InsertImportedParticipants :copyfrom
+INSERT INTO participants
+    ( "id", "trip_id", "email", "is_confirmed", "name", "status", "invited_at", "role" ) VALUES
+    ( $1, $2, $3, $4, $5, $6, $7, $8 );
+
+-- name: InsertImportedActivities :copyfrom
+INSERT INTO activities
+    ( "id", "trip_id", "title", "occurs_at", "tags", "duration_minutes", "cost_cents", "status", "latitude", "longitude", "invite_sequence", "organizer_id", "currency", "expense_id" ) VALUES
+    ( $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14 );
+
+-- name: InsertImportedLinks :copyfrom
+INSERT INTO links
+    ( "id", "trip_id", "title", "url" ) VALUES
+    ( $1, $2, $3, $4 );
+
+-- name: InsertImportedChecklistItems :copyfrom
+INSERT INTO checklist_items
+    ( "id", "trip_id", "title", "category", "is_checked", "template_key" ) VALUES
+    ( $1, $2, $3, $4, $5, $6 );
+
+-- name: InsertImportedLodgings :copyfrom
+INSERT INTO lodgings
+    ( "id", "trip_id", "name", "address", "check_in", "check_out", "cost_cents", "status" ) VALUES
+    ( $1, $2, $3, $4, $5, $6, $7, $8 );
+
+-- name: InsertImportedTransports :copyfrom
+INSERT INTO transports
+    ( "id", "trip_id", "mode", "origin", "destination", "departs_at", "arrives_at" ) VALUES
+    ( $1, $2, $3, $4, $5, $6, $7 );
+
+-- name: InsertImportedExpenses :copyfrom
+INSERT INTO expenses
+    ( "id", "trip_id", "paid_by", "description", "category", "amount_cents", "spent_at" ) VALUES
+    ( $1, $2, $3, $4, $5, $6, $7 );
+
+-- name: InsertImportedTasks :copyfrom
+INSERT INTO tasks
+    ( "id", "trip_id", "title", "due_on", "assignee_id", "is_done", "overdue_notified_at" ) VALUES
+    ( $1, $2, $3, $4, $5, $6, $7 );
+
+-- name: InsertImportedAuditEvents :copyfrom
+INSERT INTO audit_events
+    ( "id", "trip_id", "action", "details", "remote_addr", "created_at" ) VALUES
+    ( $1, $2, $3, $4, $5, $6 );
+
+-- name: InsertImportedAttachments :copyfrom
+INSERT INTO attachments
+    ( "id", "trip_id", "filename", "content_type", "size_bytes", "uploaded_at", "created_at", "uploaded_by", "scan_status", "scan_signature", "scanned_at" ) VALUES
+    ( $1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11 );
+
+-- name: ListUploadedAttachments :many
+SELECT
+    "id", "trip_id", "scan_status"
+FROM attachments
+WHERE
+    uploaded_at IS NOT NULL
+ORDER BY created_at, id;
+
+-- name: ShiftTripActivities :exec
+UPDATE activities
+SET
+    "occurs_at" = "occurs_at" + make_interval(days => $1)
+WHERE
+    trip_id = $2;
+
+-- name: TrashActivity :one
+UPDATE activities
+SET
+    "deleted_at" = NOW()
+WHERE
+    id = $1 AND trip_id = $2 AND deleted_at IS NULL
+RETURNING "title", "occurs_at";
+
+-- name: TrashLink :one
+UPDATE links
+SET
+    "deleted_at" = NOW()
+WHERE
+    id = $1 AND trip_id = $2 AND deleted_at IS NULL
+RETURNING "title", "url";
+
+-- name: RestoreActivity :one
+UPDATE activities
+SET
+    "deleted_at" = NULL
+WHERE
+    id = $1 AND trip_id = $2 AND deleted_at IS NOT NULL
+RETURNING "title", "occurs_at";
+
+-- name: RestoreLink :one
+UPDATE links
+SET
+    "deleted_at" = NULL
+WHERE
+    id = $1 AND trip_id = $2 AND deleted_at IS NOT NULL
+RETURNING "title", "url";
+
+-- name: GetTripTrashedActivities :many
+SELECT
+    "id", "title", "occurs_at", "deleted_at"::TIMESTAMP AS deleted_at
+FROM activities
+WHERE
+    trip_id = $1 AND deleted_at IS NOT NULL
+ORDER BY deleted_at DESC, id;
+
+-- name: GetTripTrashedLinks :many
+SELECT
+    "id", "title", "url", "deleted_at"::TIMESTAMP AS deleted_at
+FROM links
+WHERE
+    trip_id = $1 AND deleted_at IS NOT NULL
+ORDER BY deleted_at DESC, id;
+
+-- name: PurgeTrashedActivities :execrows
+DELETE FROM activities
+WHERE
+    deleted_at < $1;
+
+-- name: PurgeTrashedLinks :execrows
+DELETE FROM links
+WHERE
+    deleted_at < $1;
+
+-- name: ClaimNotifications :many
+INSERT INTO sent_notifications
+    ( "participant_id", "kind", "trip_id" )
+SELECT p.id, @kind::VARCHAR, p.trip_id
+FROM participants p
+WHERE
+    p.id = ANY(@participant_ids::uuid[])
+ON CONFLICT (participant_id, kind) DO NOTHING
+RETURNING "participant_id";
+
+-- name: ReleaseNotifications :exec
+DELETE FROM sent_notifications
+WHERE
+    kind = @kind AND participant_id = ANY(@participant_ids::uuid[]);
+
+-- name: GetTripInviteSummary :one
+SELECT
+    t.max_participants,
+    COUNT(p.id) FILTER (WHERE p.status IN ('invited', 'email_invalid'))::BIGINT AS invited,
+    COUNT(p.id) FILTER (WHERE p.status IN ('invited', 'email_invalid') AND p.is_confirmed)::BIGINT AS confirmed,
+    COUNT(p.id) FILTER (WHERE p.status = 'declined')::BIGINT AS declined,
+    COUNT(p.id) FILTER (WHERE p.status = 'waitlisted')::BIGINT AS waitlisted,
+    (
+        SELECT COUNT(*)
+        FROM companions c
+        JOIN participants cp ON cp.id = c.participant_id
+        WHERE cp.trip_id = t.id AND cp.status IN ('invited', 'email_invalid')
+    )::BIGINT AS companions,
+    COALESCE(
+        ARRAY_AGG(p.email ORDER BY p.invited_at, p.id) FILTER (WHERE p.status IN ('invited', 'email_invalid') AND NOT p.is_confirmed),
+        '{}'
+    )::TEXT[] AS pending_emails
+FROM trips t
+LEFT JOIN participants p ON p.trip_id = t.id
+WHERE
+    t.id = $1
+GROUP BY t.id;
+
+-- name: ClaimDueTripPlanningDigests :many
+UPDATE trips
+SET
+    "planning_digest_sent_at" = NOW()
+WHERE
+    id IN (
+        SELECT t.id
+        FROM trips t
+        WHERE
+            t.status = 'draft'
+            AND t.archived_at IS NULL
+            AND (t.settings->>'planning_digest')::BOOLEAN
+            AND t.starts_at > NOW()
+            AND COALESCE(t.planning_digest_sent_at, t.created_at, $1) <= $1
+        FOR UPDATE SKIP LOCKED
+    )
+RETURNING "id";
+
+-- name: ReleaseTripPlanningDigest :exec
+UPDATE trips
+SET
+    "planning_digest_sent_at" = NULL
+WHERE
+    id = $1;
+
+-- name: GetTripDatePollNonVoters :many
+SELECT
+    p."email", p."name"
+FROM date_poll_tokens t
+JOIN participants p ON p.id = t.participant_id
+WHERE
+    p.trip_id = $1
+    AND p.status = 'invited'
+    AND NOT EXISTS (
+        SELECT 1 FROM date_poll_votes v WHERE v.participant_id = p.id
+    )
+ORDER BY p.email;
+
+-- name: GetTripExpenseReceipts :many
+SELECT
+    "id", "expense_id", "content_type", length("data")::BIGINT AS size_bytes
+FROM receipts
+WHERE
+    trip_id = $1 AND expense_id IS NOT NULL;
+
+-- name: GetReceiptChunk :one
+SELECT
+    substring("data" FROM sqlc.arg(offset_bytes)::INT + 1 FOR sqlc.arg(length_bytes)::INT)::BYTEA AS chunk
+FROM receipts
+WHERE
+    id = @id;
+
+-- name: ClaimSchedulerRun :execrows
+INSERT INTO scheduler_runs
+    ( "name", "ran_at" ) VALUES
+    ( @name, NOW() )
+ON CONFLICT (name) DO UPDATE
+SET
+    "ran_at" = NOW()
+WHERE
+    scheduler_runs.ran_at <= NOW() - make_interval(secs => sqlc.arg(window_seconds));
+
+-- name: TransitionTrip :execrows
+UPDATE trips
+SET
+    "status" = @to_status
+WHERE
+    id = @id AND status = @from_status;
+
+-- name: GetTripsDueToAdvance :many
+SELECT
+    "id", "status", "ends_at"
+FROM trips
+WHERE
+    archived_at IS NULL
+    AND (
+        (status = 'confirmed' AND starts_at <= NOW())
+        OR (status = 'ongoing' AND ends_at < NOW())
+    );
+
+-- name: GetTripsDueForReminders :many
+SELECT
+    "id", "starts_at", "settings"
+FROM trips
+WHERE
+    status = 'confirmed'
+    AND archived_at IS NULL
+    AND starts_at > NOW()
+    AND starts_at < NOW() + MAKE_INTERVAL(days => (
+        SELECT COALESCE(MAX(d::INT), 0) + 1
+        FROM JSONB_ARRAY_ELEMENTS_TEXT(COALESCE(settings->'reminder_days', '[]')) d
+    ))
+    AND EXTRACT(HOUR FROM NOW() AT TIME ZONE (settings->>'timezone')) >= (settings->>'reminder_hour')::INT;
+
+-- name: ClaimTripReminder :execrows
+INSERT INTO trip_reminders
+    ( "trip_id", "starts_at", "days_before" ) VALUES
+    ( $1, $2, $3 )
+ON CONFLICT DO NOTHING;
+
+-- name: ReleaseTripReminder :exec
+DELETE FROM trip_reminders
+WHERE
+    trip_id = $1 AND starts_at = $2 AND days_before = $3;
+
+-- name: MarkActivityPaid :execrows
+UPDATE activities
+SET
+    "expense_id" = $1
+WHERE
+    id = $2 AND expense_id IS NULL AND deleted_at IS NULL;
+
+-- name: GetTripActivityEstimates :many
+SELECT
+    COALESCE("currency", '')::TEXT AS currency, SUM("cost_cents")::BIGINT AS estimated_cents
+FROM activities
+WHERE
+    trip_id = $1 AND status = 'approved' AND expense_id IS NULL AND deleted_at IS NULL AND cost_cents > 0
+GROUP BY 1
+ORDER BY 1;
+
+-- name: GetTripBudgetTotals :one
+SELECT
+    (SELECT COALESCE(SUM(l.cost_cents), 0) FROM lodgings l WHERE l.trip_id = $1 AND l.status = 'approved')::BIGINT AS lodging_cents,
+    (SELECT COALESCE(SUM(e.amount_cents), 0) FROM expenses e WHERE e.trip_id = $1)::BIGINT AS spent_cents;
+
+-- name: GetTripTags :many
+SELECT "tag"
+FROM trip_tags
+WHERE
+    trip_id = $1
+ORDER BY tag;
+
+-- name: DeleteTripTags :exec
+DELETE FROM trip_tags
+WHERE
+    trip_id = $1;
+
+-- name: InsertTripTags :exec
+INSERT INTO trip_tags
+    ( "trip_id", "tag" )
+SELECT @trip_id::uuid, UNNEST(@tags::TEXT[]);
+
+-- name: HoldTripShare :execrows
+UPDATE trip_shares
+SET
+    "held_at" = NOW(),
+    "held_reason" = @reason,
+    "approved_at" = NULL,
+    "approved_digest" = NULL
+WHERE
+    trip_id = @trip_id AND held_at IS NULL AND (@digest::TEXT = '' OR approved_digest IS DISTINCT FROM @digest::TEXT);
+
+-- name: ApproveTripShare :execrows
+UPDATE trip_shares
+SET
+    "held_at" = NULL,
+    "held_reason" = NULL,
+    "approved_at" = NOW(),
+    "approved_digest" = @digest::TEXT
+WHERE
+    trip_id = @trip_id;
+
+-- name: InsertContentReport :execrows
+INSERT INTO content_reports
+    ( "trip_id", "reason", "details", "remote_addr" ) VALUES
+    ( $1, $2, $3, $4 )
+ON CONFLICT (trip_id, remote_addr) WHERE resolved_at IS NULL DO NOTHING;
+
+-- name: CountOpenContentReports :one
+SELECT
+    COUNT(*)
+FROM content_reports
+WHERE
+    trip_id = $1 AND resolved_at IS NULL;
+
+-- name: ResolveContentReports :exec
+UPDATE content_reports
+SET
+    "resolved_at" = NOW()
+WHERE
+    trip_id = $1 AND resolved_at IS NULL;
+
+-- name: GetModerationQueue :many
+SELECT
+    s."trip_id", t."destination", s."held_at", s."held_reason"
+FROM trip_shares s
+JOIN trips t ON t.id = s.trip_id
+WHERE
+    s.held_at IS NOT NULL
+    OR EXISTS (SELECT 1 FROM content_reports r WHERE r.trip_id = s.trip_id AND r.resolved_at IS NULL)
+ORDER BY s.held_at IS NULL, s.held_at, s.trip_id;
+
+-- name: GetOpenContentReports :many
+SELECT
+    r."id", r."trip_id", r."reason", r."details", r."remote_addr", r."created_at", r."resolved_at"
+FROM content_reports r
+JOIN trip_shares s ON s.trip_id = r.trip_id
+WHERE
+    r.resolved_at IS NULL
+ORDER BY r.created_at;
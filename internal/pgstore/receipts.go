@@ -0,0 +1,57 @@
+package pgstore
+
+import (
+	"context"
+	"io"
+
+	"github.com/google/uuid"
+)
+
+// receiptChunkSize is how much of a receipt image is read from the database
+// at once.
+const receiptChunkSize = 256 << 10
+
+type receiptChunkSource interface {
+	GetReceiptChunk(ctx context.Context, arg GetReceiptChunkParams) ([]byte, error)
+}
+
+// ReceiptReader reads the image of a receipt of the given size a chunk at a
+// time, so it is never held in memory whole.
+func ReceiptReader(ctx context.Context, src receiptChunkSource, id uuid.UUID, size int64) io.Reader {
+	return &receiptReader{ctx: ctx, src: src, id: id, size: size}
+}
+
+type receiptReader struct {
+	ctx    context.Context
+	src    receiptChunkSource
+	id     uuid.UUID
+	size   int64
+	offset int64
+	chunk  []byte
+}
+
+func (r *receiptReader) Read(p []byte) (int, error) {
+	if len(r.chunk) == 0 {
+		if r.offset >= r.size {
+			return 0, io.EOF
+		}
+
+		chunk, err := r.src.GetReceiptChunk(r.ctx, GetReceiptChunkParams{
+			OffsetBytes: int32(r.offset),
+			LengthBytes: receiptChunkSize,
+			ID:          r.id,
+		})
+		if err != nil {
+			return 0, err
+		}
+		if len(chunk) == 0 {
+			return 0, io.ErrUnexpectedEOF
+		}
+		r.chunk = chunk
+		r.offset += int64(len(chunk))
+	}
+
+	n := copy(p, r.chunk)
+	r.chunk = r.chunk[n:]
+	return n, nil
+}
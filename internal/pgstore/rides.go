@@ -0,0 +1,6 @@
+package pgstore
+
+import "errors"
+
+// ErrRideFull is returned when claiming more seats than are left in a ride.
+var ErrRideFull = errors.New("pgstore: not enough seats left in the ride")
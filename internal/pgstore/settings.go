@@ -0,0 +1,68 @@
+package pgstore
+
+import (
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+// How activities and lodgings added to a trip are taken in: straight into the
+// plans, or waiting for an owner approval like the ones over budget.
+const (
+	ProposalOpen     = "open"
+	ProposalApproval = "approval"
+)
+
+// TripSettings are the behaviors owners can tune for their trip, stored
+// together as JSON in the trip settings column.
+type TripSettings struct {
+	// ReminderHour is the hour of the day, in the trip timezone, from which
+	// daily digests, trip and overdue task reminders go out.
+	ReminderHour int `json:"reminder_hour"`
+	// ReminderDays are how many days before the trip starts its participants
+	// are reminded of it. Empty turns trip reminders off.
+	ReminderDays []int `json:"reminder_days"`
+
+	Digest              bool   `json:"digest"`
+	PlanningDigest      bool   `json:"planning_digest"`
+	ProposalMode        string `json:"proposal_mode"`
+	Currency            string `json:"currency,omitempty"`
+	Timezone            string `json:"timezone"`
+	ItineraryAttachment string `json:"itinerary_attachment"`
+
+	// KeepPhotoLocation keeps the GPS position in the EXIF data of photos
+	// uploaded to the trip, which is removed otherwise.
+	KeepPhotoLocation bool `json:"keep_photo_location"`
+}
+
+// DefaultTripSettings are the settings trips are created with. They match the
+// settings column default.
+func DefaultTripSettings() TripSettings {
+	return TripSettings{
+		ReminderHour:        7,
+		ReminderDays:        []int{7, 1},
+		Digest:              true,
+		PlanningDigest:      true,
+		ProposalMode:        ProposalOpen,
+		Timezone:            "UTC",
+		ItineraryAttachment: "none",
+	}
+}
+
+// Location is the trip timezone, falling back to UTC when it is not known to
+// this system.
+func (s TripSettings) Location() *time.Location {
+	loc, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// ForeignCurrency tells whether a cost in currency, none meaning the trip
+// currency, is in another currency than the trip. Without exchange rates such
+// costs are not counted in the trip budget.
+func (s TripSettings) ForeignCurrency(currency pgtype.Text) bool {
+	return currency.Valid && s.Currency != "" && !strings.EqualFold(currency.String, s.Currency)
+}
@@ -0,0 +1,7 @@
+package pgstore
+
+// NewTripSheetState returns a random, URL safe token that ties the account
+// connected with OAuth to the trip the connection was started for.
+func NewTripSheetState() (string, error) {
+	return newToken()
+}
@@ -0,0 +1,6 @@
+package pgstore
+
+import "errors"
+
+// ErrItemPurchased is returned when a shopping item was already bought.
+var ErrItemPurchased = errors.New("pgstore: shopping item already purchased")
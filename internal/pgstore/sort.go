@@ -0,0 +1,25 @@
+package pgstore
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// Fields each list can be sorted by, with the ORDER BY for each one written
+// out in its query. A leading "-" sorts descending; an empty sort keeps the
+// default order of the list.
+var (
+	ActivitySortFields    = []string{"occurs_at", "title", "cost_cents"}
+	LinkSortFields        = []string{"title", "url"}
+	ParticipantSortFields = []string{"name", "email", "invited_at"}
+	ExpenseSortFields     = []string{"spent_at", "amount_cents", "category", "description"}
+)
+
+// CheckSort tells whether sort is one of fields, optionally descending.
+func CheckSort(sort string, fields []string) error {
+	if sort == "" || slices.Contains(fields, strings.TrimPrefix(sort, "-")) {
+		return nil
+	}
+	return fmt.Errorf("cannot sort by %q, use one of %s", sort, strings.Join(fields, ", "))
+}
@@ -0,0 +1,88 @@
+package pgstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// Survey question kinds. Rating questions are answered from 1 to 5, text
+// questions with a comment.
+const (
+	SurveyRating = "rating"
+	SurveyText   = "text"
+)
+
+// ErrSurveySent is returned when changing the questions of a survey that was
+// already sent.
+var ErrSurveySent = errors.New("pgstore: survey already sent")
+
+// DefaultSurveyQuestions are asked when the owners did not define their own.
+var DefaultSurveyQuestions = []struct {
+	Prompt string
+	Kind   string
+}{
+	{"Como você avalia a viagem?", SurveyRating},
+	{"Como você avalia o roteiro?", SurveyRating},
+	{"Como você avalia a hospedagem?", SurveyRating},
+	{"O que foi o melhor da viagem?", SurveyText},
+	{"O que poderia ser melhor na próxima?", SurveyText},
+}
+
+// NewSurveyToken returns a random, URL safe token that lets a participant
+// answer the survey of their trip without any other credential.
+func NewSurveyToken() (string, error) {
+	return newToken()
+}
+
+// PrepareTripSurvey gets the survey of the trip ready to be sent: the default
+// questions are stored if the owners did not define any, and every participant
+// going gets a token. It can be run again after failing halfway.
+func (q *Queries) PrepareTripSurvey(ctx context.Context, tripID uuid.UUID) error {
+	questions, err := q.GetSurveyQuestions(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to get questions for PrepareTripSurvey: %w", err)
+	}
+
+	if len(questions) == 0 {
+		defaults := make([]InsertSurveyQuestionsParams, len(DefaultSurveyQuestions))
+		for i, question := range DefaultSurveyQuestions {
+			defaults[i] = InsertSurveyQuestionsParams{
+				TripID:   tripID,
+				Position: int32(i),
+				Prompt:   question.Prompt,
+				Kind:     question.Kind,
+			}
+		}
+		if _, err := q.InsertSurveyQuestions(ctx, defaults); err != nil {
+			return fmt.Errorf("pgstore: failed to insert default questions for PrepareTripSurvey: %w", err)
+		}
+	}
+
+	participants, err := q.GetParticipants(ctx, tripID)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to get participants for PrepareTripSurvey: %w", err)
+	}
+
+	for _, p := range participants {
+		if p.Status != ParticipantInvited {
+			continue
+		}
+
+		token, err := NewSurveyToken()
+		if err != nil {
+			return fmt.Errorf("pgstore: failed to generate token for PrepareTripSurvey: %w", err)
+		}
+
+		if err := q.CreateSurveyToken(ctx, CreateSurveyTokenParams{
+			Token:         token,
+			ParticipantID: p.ID,
+		}); err != nil {
+			return fmt.Errorf("pgstore: failed to create token for PrepareTripSurvey: %w", err)
+		}
+	}
+
+	return nil
+}
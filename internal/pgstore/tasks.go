@@ -0,0 +1,10 @@
+package pgstore
+
+import "time"
+
+// IsTaskOverdue tells whether the task deadline passed, as of now, without it
+// being done. Tasks are due until the end of their deadline day.
+func IsTaskOverdue(task Task, now time.Time) bool {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	return !task.IsDone && task.DueOn.Time.Before(today)
+}
@@ -1,39 +1,101 @@
 package pgstore
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/xtuser777/nlw-journey-trilha-go/internal/api/spec"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/domain"
 )
 
-func (q *Queries) CreateTrip(ctx context.Context, pool *pgxpool.Pool, params spec.CreateTripRequest) (uuid.UUID, error) {
+// CreateTrip creates a trip along with its owner and invited participants.
+// Unless force is set, it refuses to create a trip identical to one the same
+// owner has just created, returning a *DuplicateTripError instead.
+func (q *Queries) CreateTrip(ctx context.Context, pool *pgxpool.Pool, params spec.CreateTripRequest, force bool) (uuid.UUID, error) {
 	tx, err := pool.Begin(ctx)
 	if err != nil {
 		return uuid.UUID{}, fmt.Errorf("pgstore: failed to begin tx for CreateTrip: %w", err)
 	}
 	defer func() { _ = tx.Rollback(ctx) }()
 
-	qtx := q.WithTx(tx)
+	var maxParticipants pgtype.Int4
+	if params.MaxParticipants != nil {
+		maxParticipants = pgtype.Int4{Valid: true, Int32: int32(*params.MaxParticipants)}
+	}
+
+	var budget pgtype.Int8
+	if params.BudgetPerPersonCents != nil {
+		budget = pgtype.Int8{Valid: true, Int64: *params.BudgetPerPersonCents}
+	}
+
+	settings := DefaultTripSettings()
+	if params.Currency != nil {
+		settings.Currency = *params.Currency
+	}
+
+	startsAt := pgtype.Timestamp{Valid: true, Time: params.StartsAt}
+	endsAt := pgtype.Timestamp{Valid: true, Time: params.EndsAt}
+
+	qtx := q.Tx(tx)
+	if !force {
+		// Serializes the owner trip creations so a double submit can not
+		// slip both requests past the check.
+		if err := qtx.LockOwnerTrips(ctx, string(params.OwnerEmail)); err != nil {
+			return uuid.UUID{}, fmt.Errorf("pgstore: failed to lock owner trips for CreateTrip: %w", err)
+		}
+
+		existingID, err := qtx.FindRecentTrip(ctx, FindRecentTripParams{
+			OwnerEmail:  string(params.OwnerEmail),
+			Destination: params.Destination,
+			StartsAt:    startsAt,
+			EndsAt:      endsAt,
+		})
+		if err == nil {
+			return uuid.UUID{}, &DuplicateTripError{TripID: existingID}
+		}
+		if !errors.Is(err, ErrNotFound) {
+			return uuid.UUID{}, fmt.Errorf("pgstore: failed to find recent trip for CreateTrip: %w", err)
+		}
+	}
+
 	tripID, err := qtx.InsertTrip(ctx, InsertTripParams{
-		Destination: params.Destination,
-		OwnerEmail:  string(params.OwnerEmail),
-		OwnerName:   params.OwnerName,
-		StartsAt:    pgtype.Timestamp{Valid: true, Time: params.StartsAt},
-		EndsAt:      pgtype.Timestamp{Valid: true, Time: params.EndsAt},
+		Destination:          params.Destination,
+		OwnerEmail:           string(params.OwnerEmail),
+		OwnerName:            params.OwnerName,
+		StartsAt:             startsAt,
+		EndsAt:               endsAt,
+		MaxParticipants:      maxParticipants,
+		BudgetPerPersonCents: budget,
+		Settings:             settings,
 	})
 	if err != nil {
 		return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert trip for CreateTrip: %w", err)
 	}
 
+	if _, err := qtx.InsertOwnerParticipant(ctx, InsertOwnerParticipantParams{
+		TripID: tripID,
+		Email:  string(params.OwnerEmail),
+		Name:   pgtype.Text{Valid: true, String: params.OwnerName},
+	}); err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert owner for CreateTrip: %w", err)
+	}
+
+	// The owner takes the first spot.
+	statuses := InviteStatuses(maxParticipants, 1, len(params.EmailsToInvite))
 	participants := make([]InviteParticipantsToTripParams, len(params.EmailsToInvite))
 	for i, eti := range params.EmailsToInvite {
 		participants[i] = InviteParticipantsToTripParams{
 			TripID: tripID,
 			Email:  string(eti),
+			Status: statuses[i],
 		}
 	}
 
@@ -47,3 +109,1179 @@ func (q *Queries) CreateTrip(ctx context.Context, pool *pgxpool.Pool, params spe
 
 	return tripID, nil
 }
+
+func (q *Queries) CreateExpense(ctx context.Context, pool *pgxpool.Pool, params InsertExpenseParams, splits []InsertExpenseSplitsParams) (uuid.UUID, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to begin tx for CreateExpense: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.Tx(tx)
+	expenseID, err := qtx.InsertExpense(ctx, params)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert expense for CreateExpense: %w", err)
+	}
+
+	for i := range splits {
+		splits[i].ExpenseID = expenseID
+	}
+
+	if _, err := qtx.InsertExpenseSplits(ctx, splits); err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert splits for CreateExpense: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to commit tx for CreateExpense: %w", err)
+	}
+
+	return expenseID, nil
+}
+
+func (q *Queries) CreateExpenseFromReceipt(ctx context.Context, pool *pgxpool.Pool, receiptID uuid.UUID, params InsertExpenseParams, splits []InsertExpenseSplitsParams) (uuid.UUID, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to begin tx for CreateExpenseFromReceipt: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.Tx(tx)
+	expenseID, err := qtx.InsertExpense(ctx, params)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert expense for CreateExpenseFromReceipt: %w", err)
+	}
+
+	for i := range splits {
+		splits[i].ExpenseID = expenseID
+	}
+
+	if _, err := qtx.InsertExpenseSplits(ctx, splits); err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert splits for CreateExpenseFromReceipt: %w", err)
+	}
+
+	if err := qtx.AttachReceiptToExpense(ctx, AttachReceiptToExpenseParams{
+		ExpenseID: pgtype.UUID{Valid: true, Bytes: expenseID},
+		ID:        receiptID,
+	}); err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to attach receipt for CreateExpenseFromReceipt: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to commit tx for CreateExpenseFromReceipt: %w", err)
+	}
+
+	return expenseID, nil
+}
+
+// DeclineParticipant frees the spots the participant and their companions
+// took on the trip and hands them to whoever is first on the waitlist,
+// returning who was promoted.
+func (q *Queries) DeclineParticipant(ctx context.Context, pool *pgxpool.Pool, participant Participant, trip Trip) ([]uuid.UUID, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("pgstore: failed to begin tx for DeclineParticipant: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.Tx(tx)
+	if err := qtx.MarkParticipantDeclined(ctx, participant.ID); err != nil {
+		return nil, fmt.Errorf("pgstore: failed to decline participant for DeclineParticipant: %w", err)
+	}
+
+	promoted, err := qtx.promoteWaitlisted(ctx, trip)
+	if err != nil {
+		return nil, fmt.Errorf("pgstore: failed to promote waitlist for DeclineParticipant: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("pgstore: failed to commit tx for DeclineParticipant: %w", err)
+	}
+
+	return promoted, nil
+}
+
+// RemoveCompanion frees the spot the companion took on the trip and hands it
+// to whoever is first on the waitlist, returning who was promoted.
+func (q *Queries) RemoveCompanion(ctx context.Context, pool *pgxpool.Pool, companion Companion, trip Trip) ([]uuid.UUID, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("pgstore: failed to begin tx for RemoveCompanion: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.Tx(tx)
+	if err := qtx.DeleteCompanion(ctx, companion.ID); err != nil {
+		return nil, fmt.Errorf("pgstore: failed to delete companion for RemoveCompanion: %w", err)
+	}
+
+	promoted, err := qtx.promoteWaitlisted(ctx, trip)
+	if err != nil {
+		return nil, fmt.Errorf("pgstore: failed to promote waitlist for RemoveCompanion: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("pgstore: failed to commit tx for RemoveCompanion: %w", err)
+	}
+
+	return promoted, nil
+}
+
+// promoteWaitlisted invites waitlisted participants, first come first
+// served, while the trip has free spots. It must run inside a transaction.
+func (q *Queries) promoteWaitlisted(ctx context.Context, trip Trip) ([]uuid.UUID, error) {
+	if !trip.MaxParticipants.Valid {
+		return nil, nil
+	}
+
+	active, err := q.CountActiveParticipants(ctx, trip.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	var promoted []uuid.UUID
+	for ; active < int64(trip.MaxParticipants.Int32); active++ {
+		next, err := q.GetFirstWaitlistedParticipant(ctx, trip.ID)
+		if errors.Is(err, ErrNotFound) {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if err := q.PromoteParticipant(ctx, next.ID); err != nil {
+			return nil, err
+		}
+		promoted = append(promoted, next.ID)
+	}
+
+	return promoted, nil
+}
+
+// CreateDatePoll adds the proposed date ranges to the trip poll and makes sure
+// every given participant has a personal token to answer it with.
+func (q *Queries) CreateDatePoll(ctx context.Context, pool *pgxpool.Pool, options []InsertDatePollOptionsParams, participants []uuid.UUID) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for CreateDatePoll: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.Tx(tx)
+	if _, err := qtx.InsertDatePollOptions(ctx, options); err != nil {
+		return fmt.Errorf("pgstore: failed to insert options for CreateDatePoll: %w", err)
+	}
+
+	for _, participantID := range participants {
+		token, err := NewDatePollToken()
+		if err != nil {
+			return fmt.Errorf("pgstore: failed to generate token for CreateDatePoll: %w", err)
+		}
+
+		if err := qtx.CreateDatePollToken(ctx, CreateDatePollTokenParams{
+			Token:         token,
+			ParticipantID: participantID,
+		}); err != nil {
+			return fmt.Errorf("pgstore: failed to insert token for CreateDatePoll: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit tx for CreateDatePoll: %w", err)
+	}
+
+	return nil
+}
+
+// PickDatePollOption moves the trip to the chosen date range and closes the
+// poll, discarding every option and vote.
+func (q *Queries) PickDatePollOption(ctx context.Context, pool *pgxpool.Pool, trip Trip, option DatePollOption) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for PickDatePollOption: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.Tx(tx)
+	if err := qtx.UpdateTrip(ctx, UpdateTripParams{
+		Destination:          trip.Destination,
+		StartsAt:             option.StartsAt,
+		EndsAt:               option.EndsAt,
+		MaxParticipants:      trip.MaxParticipants,
+		BudgetPerPersonCents: trip.BudgetPerPersonCents,
+		ID:                   trip.ID,
+	}); err != nil {
+		return fmt.Errorf("pgstore: failed to update trip for PickDatePollOption: %w", err)
+	}
+
+	if err := qtx.DeleteTripDatePollOptions(ctx, trip.ID); err != nil {
+		return fmt.Errorf("pgstore: failed to delete options for PickDatePollOption: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit tx for PickDatePollOption: %w", err)
+	}
+
+	return nil
+}
+
+// AddActivity creates the activity, leaving it pending the owner approval
+// when its cost goes over what is left of the trip budget.
+func (q *Queries) AddActivity(ctx context.Context, pool *pgxpool.Pool, trip Trip, params CreateActivityParams) (uuid.UUID, string, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return uuid.UUID{}, "", fmt.Errorf("pgstore: failed to begin tx for AddActivity: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.Tx(tx)
+	params.Status, err = qtx.planStatus(ctx, trip, params.CostCents, params.Currency)
+	if err != nil {
+		return uuid.UUID{}, "", fmt.Errorf("pgstore: failed to check budget for AddActivity: %w", err)
+	}
+
+	activityID, err := qtx.CreateActivity(ctx, params)
+	if err != nil {
+		return uuid.UUID{}, "", fmt.Errorf("pgstore: failed to insert activity for AddActivity: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return uuid.UUID{}, "", fmt.Errorf("pgstore: failed to commit tx for AddActivity: %w", err)
+	}
+
+	return activityID, params.Status, nil
+}
+
+// AddLodging creates the lodging, leaving it pending the owner approval when
+// its cost goes over what is left of the trip budget.
+func (q *Queries) AddLodging(ctx context.Context, pool *pgxpool.Pool, trip Trip, params CreateLodgingParams) (uuid.UUID, string, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return uuid.UUID{}, "", fmt.Errorf("pgstore: failed to begin tx for AddLodging: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.Tx(tx)
+	params.Status, err = qtx.planStatus(ctx, trip, params.CostCents, pgtype.Text{})
+	if err != nil {
+		return uuid.UUID{}, "", fmt.Errorf("pgstore: failed to check budget for AddLodging: %w", err)
+	}
+
+	lodgingID, err := qtx.CreateLodging(ctx, params)
+	if err != nil {
+		return uuid.UUID{}, "", fmt.Errorf("pgstore: failed to insert lodging for AddLodging: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return uuid.UUID{}, "", fmt.Errorf("pgstore: failed to commit tx for AddLodging: %w", err)
+	}
+
+	return lodgingID, params.Status, nil
+}
+
+// planStatus locks the trip so concurrent additions see each other's costs
+// and decides whether a plan of costCents in currency fits its budget. Costs
+// in another currency than the trip can not be compared, so they wait for the
+// owner. It must run inside a transaction.
+func (q *Queries) planStatus(ctx context.Context, trip Trip, costCents int64, currency pgtype.Text) (string, error) {
+	if trip.Settings.ProposalMode == ProposalApproval {
+		return PlanPending, nil
+	}
+
+	if !trip.BudgetPerPersonCents.Valid {
+		return PlanApproved, nil
+	}
+
+	if costCents > 0 && trip.Settings.ForeignCurrency(currency) {
+		return PlanPending, nil
+	}
+
+	if err := q.LockTrip(ctx, trip.ID); err != nil {
+		return "", err
+	}
+
+	people, err := q.CountActiveParticipants(ctx, trip.ID)
+	if err != nil {
+		return "", err
+	}
+
+	committed, err := q.GetTripCommittedCents(ctx, trip.ID)
+	if err != nil {
+		return "", err
+	}
+
+	return PlanStatus(trip.BudgetPerPersonCents, people, committed, costCents), nil
+}
+
+// RescheduleActivities moves every given activity at once, so a day is never
+// left half reordered.
+func (q *Queries) RescheduleActivities(ctx context.Context, pool *pgxpool.Pool, params []UpdateActivityOccursAtParams) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for RescheduleActivities: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.Tx(tx)
+	for _, p := range params {
+		if err := qtx.UpdateActivityOccursAt(ctx, p); err != nil {
+			return fmt.Errorf("pgstore: failed to update activity for RescheduleActivities: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit tx for RescheduleActivities: %w", err)
+	}
+
+	return nil
+}
+
+// TransferOwnership makes the participant of the transfer the trip owner in
+// place of the owner who asked for it, who stays on the trip as a regular
+// participant. The transfer is spent at the same time, so the trip is never
+// left without an owner.
+func (q *Queries) TransferOwnership(ctx context.Context, pool *pgxpool.Pool, transfer OwnershipTransfer) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for TransferOwnership: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.Tx(tx)
+	if err := qtx.LockTrip(ctx, transfer.TripID); err != nil {
+		return fmt.Errorf("pgstore: failed to lock trip for TransferOwnership: %w", err)
+	}
+
+	trip, err := qtx.GetTrip(ctx, transfer.TripID)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to get trip for TransferOwnership: %w", err)
+	}
+
+	participant, err := qtx.GetParticipant(ctx, transfer.ParticipantID)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to get participant for TransferOwnership: %w", err)
+	}
+	if !participant.IsConfirmed || participant.Status != ParticipantInvited {
+		return ErrTransferParticipantGone
+	}
+
+	owners, err := qtx.GetTripOwners(ctx, trip.ID)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to get owners for TransferOwnership: %w", err)
+	}
+	for _, owner := range owners {
+		if owner.Email != trip.OwnerEmail || owner.ID == participant.ID {
+			continue
+		}
+		if err := qtx.SetParticipantRole(ctx, SetParticipantRoleParams{Role: RoleParticipant, ID: owner.ID}); err != nil {
+			return fmt.Errorf("pgstore: failed to demote former owner for TransferOwnership: %w", err)
+		}
+	}
+
+	if err := qtx.SetParticipantRole(ctx, SetParticipantRoleParams{Role: RoleOwner, ID: participant.ID}); err != nil {
+		return fmt.Errorf("pgstore: failed to promote owner for TransferOwnership: %w", err)
+	}
+
+	if err := qtx.setPrimaryOwner(ctx, trip.ID, participant); err != nil {
+		return fmt.Errorf("pgstore: failed to update owner for TransferOwnership: %w", err)
+	}
+
+	if err := qtx.DeleteOwnershipTransfer(ctx, transfer.Token); err != nil {
+		return fmt.Errorf("pgstore: failed to delete transfer for TransferOwnership: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit tx for TransferOwnership: %w", err)
+	}
+
+	return nil
+}
+
+// ConfirmOwnerEmail records the confirmation of the address the token was
+// sent to. Once both addresses confirmed, the trip and its owner participant
+// move to the new email.
+func (q *Queries) ConfirmOwnerEmail(ctx context.Context, pool *pgxpool.Pool, token string) (OwnerEmailChange, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return OwnerEmailChange{}, fmt.Errorf("pgstore: failed to begin tx for ConfirmOwnerEmail: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.Tx(tx)
+	change, err := qtx.GetOwnerEmailChangeByToken(ctx, token)
+	if err != nil {
+		return OwnerEmailChange{}, fmt.Errorf("pgstore: failed to get change for ConfirmOwnerEmail: %w", err)
+	}
+
+	if err := qtx.LockTrip(ctx, change.TripID); err != nil {
+		return OwnerEmailChange{}, fmt.Errorf("pgstore: failed to lock trip for ConfirmOwnerEmail: %w", err)
+	}
+
+	change, err = qtx.ConfirmOwnerEmailChange(ctx, token)
+	if err != nil {
+		return OwnerEmailChange{}, fmt.Errorf("pgstore: failed to confirm change for ConfirmOwnerEmail: %w", err)
+	}
+
+	if change.Confirmed() {
+		trip, err := qtx.GetTrip(ctx, change.TripID)
+		if err != nil {
+			return OwnerEmailChange{}, fmt.Errorf("pgstore: failed to get trip for ConfirmOwnerEmail: %w", err)
+		}
+
+		participants, err := qtx.GetParticipants(ctx, trip.ID)
+		if err != nil {
+			return OwnerEmailChange{}, fmt.Errorf("pgstore: failed to get participants for ConfirmOwnerEmail: %w", err)
+		}
+
+		for _, participant := range participants {
+			if strings.EqualFold(participant.Email, change.NewEmail) {
+				return OwnerEmailChange{}, ErrOwnerEmailInUse
+			}
+		}
+
+		for _, participant := range participants {
+			if participant.Email != trip.OwnerEmail || participant.Role != RoleOwner {
+				continue
+			}
+			if err := qtx.UpdateParticipantEmail(ctx, UpdateParticipantEmailParams{Email: change.NewEmail, ID: participant.ID}); err != nil {
+				return OwnerEmailChange{}, fmt.Errorf("pgstore: failed to update owner participant for ConfirmOwnerEmail: %w", err)
+			}
+		}
+
+		if err := qtx.UpdateTripOwner(ctx, UpdateTripOwnerParams{
+			OwnerEmail: change.NewEmail,
+			OwnerName:  trip.OwnerName,
+			ID:         trip.ID,
+		}); err != nil {
+			return OwnerEmailChange{}, fmt.Errorf("pgstore: failed to update owner for ConfirmOwnerEmail: %w", err)
+		}
+
+		if err := qtx.DeleteOwnerEmailChange(ctx, trip.ID); err != nil {
+			return OwnerEmailChange{}, fmt.Errorf("pgstore: failed to delete change for ConfirmOwnerEmail: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return OwnerEmailChange{}, fmt.Errorf("pgstore: failed to commit tx for ConfirmOwnerEmail: %w", err)
+	}
+
+	return change, nil
+}
+
+// MergeTrips moves the activities, links and participants of the source trip
+// to the target one and archives the source. Participants whose email is
+// already on the target trip are left behind.
+func (q *Queries) MergeTrips(ctx context.Context, pool *pgxpool.Pool, targetID, sourceID uuid.UUID) (TripMerge, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return TripMerge{}, fmt.Errorf("pgstore: failed to begin tx for MergeTrips: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.Tx(tx)
+
+	// Always locked in the same order, so merging two trips into each other
+	// at once can not deadlock.
+	ids := []uuid.UUID{targetID, sourceID}
+	slices.SortFunc(ids, func(a, b uuid.UUID) int { return bytes.Compare(a[:], b[:]) })
+	for _, id := range ids {
+		if err := qtx.LockTrip(ctx, id); err != nil {
+			return TripMerge{}, fmt.Errorf("pgstore: failed to lock trip for MergeTrips: %w", err)
+		}
+	}
+
+	params := MoveTripActivitiesParams{TargetID: targetID, SourceID: sourceID}
+
+	var merge TripMerge
+	if merge.Activities, err = qtx.MoveTripActivities(ctx, params); err != nil {
+		return TripMerge{}, fmt.Errorf("pgstore: failed to move activities for MergeTrips: %w", err)
+	}
+
+	if merge.Links, err = qtx.MoveTripLinks(ctx, MoveTripLinksParams(params)); err != nil {
+		return TripMerge{}, fmt.Errorf("pgstore: failed to move links for MergeTrips: %w", err)
+	}
+
+	if merge.Participants, err = qtx.MoveTripParticipants(ctx, MoveTripParticipantsParams(params)); err != nil {
+		return TripMerge{}, fmt.Errorf("pgstore: failed to move participants for MergeTrips: %w", err)
+	}
+
+	if err := qtx.ArchiveTrip(ctx, sourceID); err != nil {
+		return TripMerge{}, fmt.Errorf("pgstore: failed to archive source trip for MergeTrips: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return TripMerge{}, fmt.Errorf("pgstore: failed to commit tx for MergeTrips: %w", err)
+	}
+
+	return merge, nil
+}
+
+// ConfirmParticipantsByOwner confirms the trip participants on their behalf,
+// recording who was confirmed in the audit events. Participants already
+// confirmed are left as they are and not returned.
+func (q *Queries) ConfirmParticipantsByOwner(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID, ids []uuid.UUID, remoteAddr string) ([]uuid.UUID, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("pgstore: failed to begin tx for ConfirmParticipantsByOwner: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.Tx(tx)
+	confirmed, err := qtx.ConfirmTripParticipants(ctx, ConfirmTripParticipantsParams{TripID: tripID, Ids: ids})
+	if err != nil {
+		return nil, fmt.Errorf("pgstore: failed to confirm participants for ConfirmParticipantsByOwner: %w", err)
+	}
+
+	details, err := json.Marshal(map[string]any{"participant_ids": confirmed})
+	if err != nil {
+		return nil, fmt.Errorf("pgstore: failed to encode audit details for ConfirmParticipantsByOwner: %w", err)
+	}
+
+	if err := qtx.InsertAuditEvent(ctx, InsertAuditEventParams{
+		TripID:     tripID,
+		Action:     AuditParticipantsConfirmed,
+		Details:    details,
+		RemoteAddr: remoteAddr,
+	}); err != nil {
+		return nil, fmt.Errorf("pgstore: failed to insert audit event for ConfirmParticipantsByOwner: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("pgstore: failed to commit tx for ConfirmParticipantsByOwner: %w", err)
+	}
+
+	return confirmed, nil
+}
+
+// RemoveOwner takes the owner role away from the participant, unless they
+// are the last owner of the trip. When they were the owner the trip is
+// listed under, the next owner takes their place.
+func (q *Queries) RemoveOwner(ctx context.Context, pool *pgxpool.Pool, trip Trip, participant Participant) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for RemoveOwner: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.Tx(tx)
+	if err := qtx.LockTrip(ctx, trip.ID); err != nil {
+		return fmt.Errorf("pgstore: failed to lock trip for RemoveOwner: %w", err)
+	}
+
+	owners, err := qtx.GetTripOwners(ctx, trip.ID)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to get owners for RemoveOwner: %w", err)
+	}
+
+	var next *Participant
+	for i, owner := range owners {
+		if owner.ID != participant.ID {
+			next = &owners[i]
+			break
+		}
+	}
+	if next == nil {
+		return ErrLastOwner
+	}
+
+	if err := qtx.SetParticipantRole(ctx, SetParticipantRoleParams{Role: RoleParticipant, ID: participant.ID}); err != nil {
+		return fmt.Errorf("pgstore: failed to demote owner for RemoveOwner: %w", err)
+	}
+
+	if participant.Email == trip.OwnerEmail {
+		if err := qtx.setPrimaryOwner(ctx, trip.ID, *next); err != nil {
+			return fmt.Errorf("pgstore: failed to update owner for RemoveOwner: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit tx for RemoveOwner: %w", err)
+	}
+
+	return nil
+}
+
+// setPrimaryOwner lists the trip under the given owner, the one trip
+// confirmation emails go to.
+func (q *Queries) setPrimaryOwner(ctx context.Context, tripID uuid.UUID, owner Participant) error {
+	name := owner.Name.String
+	if !owner.Name.Valid {
+		name = owner.Email
+	}
+	return q.UpdateTripOwner(ctx, UpdateTripOwnerParams{
+		OwnerEmail: owner.Email,
+		OwnerName:  name,
+		ID:         tripID,
+	})
+}
+
+// CreateParticipantGroup creates the group with the given participants of
+// the trip in it, moving them out of the groups they were in.
+func (q *Queries) CreateParticipantGroup(ctx context.Context, pool *pgxpool.Pool, params InsertParticipantGroupParams, members []uuid.UUID) (uuid.UUID, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to begin tx for CreateParticipantGroup: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.Tx(tx)
+	groupID, err := qtx.InsertParticipantGroup(ctx, params)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert group for CreateParticipantGroup: %w", err)
+	}
+
+	if err := qtx.SetParticipantsGroup(ctx, SetParticipantsGroupParams{
+		GroupID: pgtype.UUID{Valid: true, Bytes: groupID},
+		TripID:  params.TripID,
+		Ids:     members,
+	}); err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to set members for CreateParticipantGroup: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to commit tx for CreateParticipantGroup: %w", err)
+	}
+
+	return groupID, nil
+}
+
+// UpdateParticipantGroup renames the group and, unless members is nil,
+// makes them the only participants in it.
+func (q *Queries) UpdateParticipantGroup(ctx context.Context, pool *pgxpool.Pool, group ParticipantGroup, name string, members []uuid.UUID) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for UpdateParticipantGroup: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.Tx(tx)
+	if err := qtx.RenameParticipantGroup(ctx, RenameParticipantGroupParams{Name: name, ID: group.ID}); err != nil {
+		return fmt.Errorf("pgstore: failed to rename group for UpdateParticipantGroup: %w", err)
+	}
+
+	if members != nil {
+		groupID := pgtype.UUID{Valid: true, Bytes: group.ID}
+		if err := qtx.ClearParticipantGroup(ctx, ClearParticipantGroupParams{GroupID: groupID, Keep: members}); err != nil {
+			return fmt.Errorf("pgstore: failed to clear members for UpdateParticipantGroup: %w", err)
+		}
+		if err := qtx.SetParticipantsGroup(ctx, SetParticipantsGroupParams{GroupID: groupID, TripID: group.TripID, Ids: members}); err != nil {
+			return fmt.Errorf("pgstore: failed to set members for UpdateParticipantGroup: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit tx for UpdateParticipantGroup: %w", err)
+	}
+
+	return nil
+}
+
+// AssignRoom makes the participants the only ones in the room, moving them
+// out of the other rooms of the lodging they were in.
+func (q *Queries) AssignRoom(ctx context.Context, pool *pgxpool.Pool, room LodgingRoom, participantIDs []uuid.UUID) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for AssignRoom: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.Tx(tx)
+	if err := qtx.ClearRoomAssignments(ctx, ClearRoomAssignmentsParams{
+		RoomID:         room.ID,
+		LodgingID:      room.LodgingID,
+		ParticipantIds: participantIDs,
+	}); err != nil {
+		return fmt.Errorf("pgstore: failed to clear assignments for AssignRoom: %w", err)
+	}
+
+	assignments := make([]InsertRoomAssignmentsParams, len(participantIDs))
+	for i, participantID := range participantIDs {
+		assignments[i] = InsertRoomAssignmentsParams{
+			RoomID:        room.ID,
+			LodgingID:     room.LodgingID,
+			ParticipantID: participantID,
+		}
+	}
+
+	if _, err := qtx.InsertRoomAssignments(ctx, assignments); err != nil {
+		return fmt.Errorf("pgstore: failed to insert assignments for AssignRoom: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit tx for AssignRoom: %w", err)
+	}
+
+	return nil
+}
+
+// ClaimRideSeats gives the participant seats in the ride, telling whether it
+// is now full. The ride is locked so two claims cannot take the last seats.
+func (q *Queries) ClaimRideSeats(ctx context.Context, pool *pgxpool.Pool, rideID, participantID uuid.UUID, seats int32) (bool, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("pgstore: failed to begin tx for ClaimRideSeats: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.Tx(tx)
+	ride, err := qtx.LockRide(ctx, rideID)
+	if err != nil {
+		return false, fmt.Errorf("pgstore: failed to lock ride for ClaimRideSeats: %w", err)
+	}
+
+	taken, err := qtx.CountRideSeatsTaken(ctx, rideID)
+	if err != nil {
+		return false, fmt.Errorf("pgstore: failed to count seats for ClaimRideSeats: %w", err)
+	}
+
+	if taken+int64(seats) > int64(ride.Seats) {
+		return false, ErrRideFull
+	}
+
+	if err := qtx.InsertRidePassenger(ctx, InsertRidePassengerParams{
+		RideID:        rideID,
+		ParticipantID: participantID,
+		Seats:         seats,
+	}); err != nil {
+		return false, fmt.Errorf("pgstore: failed to insert passenger for ClaimRideSeats: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("pgstore: failed to commit tx for ClaimRideSeats: %w", err)
+	}
+
+	return taken+int64(seats) == int64(ride.Seats), nil
+}
+
+// PurchaseShoppingItem records what was paid for the shopping item as a trip
+// expense and marks the item purchased.
+func (q *Queries) PurchaseShoppingItem(ctx context.Context, pool *pgxpool.Pool, itemID uuid.UUID, params InsertExpenseParams, splits []InsertExpenseSplitsParams) (uuid.UUID, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to begin tx for PurchaseShoppingItem: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.Tx(tx)
+	expenseID, err := qtx.InsertExpense(ctx, params)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert expense for PurchaseShoppingItem: %w", err)
+	}
+
+	for i := range splits {
+		splits[i].ExpenseID = expenseID
+	}
+
+	if _, err := qtx.InsertExpenseSplits(ctx, splits); err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert splits for PurchaseShoppingItem: %w", err)
+	}
+
+	rows, err := qtx.MarkShoppingItemPurchased(ctx, MarkShoppingItemPurchasedParams{
+		ExpenseID: pgtype.UUID{Valid: true, Bytes: expenseID},
+		ID:        itemID,
+	})
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to mark item for PurchaseShoppingItem: %w", err)
+	}
+	if rows == 0 {
+		return uuid.UUID{}, ErrItemPurchased
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to commit tx for PurchaseShoppingItem: %w", err)
+	}
+
+	return expenseID, nil
+}
+
+// ReplaceSurveyQuestions sets the questions the trip survey will ask, as long
+// as it was not sent yet.
+func (q *Queries) ReplaceSurveyQuestions(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID, questions []InsertSurveyQuestionsParams) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for ReplaceSurveyQuestions: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.Tx(tx)
+	if _, err := qtx.GetTripSurvey(ctx, tripID); err == nil {
+		return ErrSurveySent
+	} else if !errors.Is(err, ErrNotFound) {
+		return fmt.Errorf("pgstore: failed to get survey for ReplaceSurveyQuestions: %w", err)
+	}
+
+	if err := qtx.DeleteSurveyQuestions(ctx, tripID); err != nil {
+		return fmt.Errorf("pgstore: failed to delete questions for ReplaceSurveyQuestions: %w", err)
+	}
+
+	if _, err := qtx.InsertSurveyQuestions(ctx, questions); err != nil {
+		return fmt.Errorf("pgstore: failed to insert questions for ReplaceSurveyQuestions: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit tx for ReplaceSurveyQuestions: %w", err)
+	}
+
+	return nil
+}
+
+// AddDraftActivities creates the activities of an itinerary draft, all of
+// them pending the owner review, so none is added without the others.
+func (q *Queries) AddDraftActivities(ctx context.Context, pool *pgxpool.Pool, activities []CreateActivityParams) ([]uuid.UUID, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("pgstore: failed to begin tx for AddDraftActivities: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.Tx(tx)
+	ids := make([]uuid.UUID, 0, len(activities))
+	for _, params := range activities {
+		params.Status = PlanPending
+		id, err := qtx.CreateActivity(ctx, params)
+		if err != nil {
+			return nil, fmt.Errorf("pgstore: failed to insert activity for AddDraftActivities: %w", err)
+		}
+		ids = append(ids, id)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("pgstore: failed to commit tx for AddDraftActivities: %w", err)
+	}
+
+	return ids, nil
+}
+
+// ImportTrip creates a trip, and everything it holds, from a snapshot taken
+// on another instance, recording where it came from in the audit events. The
+// snapshot ids are used as they are, so they must have been replaced by new
+// ones first.
+func (q *Queries) ImportTrip(ctx context.Context, pool *pgxpool.Pool, snapshot TripSnapshot, instance string, sourceID uuid.UUID, remoteAddr string) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for ImportTrip: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.Tx(tx)
+
+	trip := snapshot.Trip
+
+	// Snapshots from instances older than the trip statuses carry none.
+	status := trip.Status
+	if _, err := domain.ParseTripStatus(status); err != nil {
+		status = string(domain.TripDraft)
+	}
+
+	if err := qtx.InsertImportedTrip(ctx, InsertImportedTripParams{
+		ID:                   trip.ID,
+		Destination:          trip.Destination,
+		OwnerEmail:           trip.OwnerEmail,
+		OwnerName:            trip.OwnerName,
+		Status:               status,
+		StartsAt:             trip.StartsAt,
+		EndsAt:               trip.EndsAt,
+		MaxParticipants:      trip.MaxParticipants,
+		BudgetPerPersonCents: trip.BudgetPerPersonCents,
+		Settings:             trip.Settings,
+		ArchivedAt:           trip.ArchivedAt,
+	}); err != nil {
+		return fmt.Errorf("pgstore: failed to insert trip for ImportTrip: %w", err)
+	}
+
+	participants := make([]InsertImportedParticipantsParams, len(snapshot.Participants))
+	for i, p := range snapshot.Participants {
+		participants[i] = InsertImportedParticipantsParams{
+			ID:          p.ID,
+			TripID:      p.TripID,
+			Email:       p.Email,
+			IsConfirmed: p.IsConfirmed,
+			Name:        p.Name,
+			Status:      p.Status,
+			InvitedAt:   p.InvitedAt,
+			Role:        p.Role,
+		}
+	}
+	if _, err := qtx.InsertImportedParticipants(ctx, participants); err != nil {
+		return fmt.Errorf("pgstore: failed to insert participants for ImportTrip: %w", err)
+	}
+
+	links := make([]InsertImportedLinksParams, len(snapshot.Links))
+	for i, l := range snapshot.Links {
+		links[i] = InsertImportedLinksParams{
+			ID:     l.ID,
+			TripID: l.TripID,
+			Title:  l.Title,
+			Url:    l.Url,
+		}
+	}
+	if _, err := qtx.InsertImportedLinks(ctx, links); err != nil {
+		return fmt.Errorf("pgstore: failed to insert links for ImportTrip: %w", err)
+	}
+
+	checklistItems := make([]InsertImportedChecklistItemsParams, len(snapshot.ChecklistItems))
+	for i, c := range snapshot.ChecklistItems {
+		checklistItems[i] = InsertImportedChecklistItemsParams(c)
+	}
+	if _, err := qtx.InsertImportedChecklistItems(ctx, checklistItems); err != nil {
+		return fmt.Errorf("pgstore: failed to insert checklist items for ImportTrip: %w", err)
+	}
+
+	lodgings := make([]InsertImportedLodgingsParams, len(snapshot.Lodgings))
+	for i, l := range snapshot.Lodgings {
+		lodgings[i] = InsertImportedLodgingsParams(l)
+	}
+	if _, err := qtx.InsertImportedLodgings(ctx, lodgings); err != nil {
+		return fmt.Errorf("pgstore: failed to insert lodgings for ImportTrip: %w", err)
+	}
+
+	transports := make([]InsertImportedTransportsParams, len(snapshot.Transports))
+	for i, t := range snapshot.Transports {
+		transports[i] = InsertImportedTransportsParams(t)
+	}
+	if _, err := qtx.InsertImportedTransports(ctx, transports); err != nil {
+		return fmt.Errorf("pgstore: failed to insert transports for ImportTrip: %w", err)
+	}
+
+	expenses := make([]InsertImportedExpensesParams, len(snapshot.Expenses))
+	for i, e := range snapshot.Expenses {
+		expenses[i] = InsertImportedExpensesParams(e)
+	}
+	if _, err := qtx.InsertImportedExpenses(ctx, expenses); err != nil {
+		return fmt.Errorf("pgstore: failed to insert expenses for ImportTrip: %w", err)
+	}
+
+	splits := make([]InsertExpenseSplitsParams, len(snapshot.ExpenseSplits))
+	for i, s := range snapshot.ExpenseSplits {
+		splits[i] = InsertExpenseSplitsParams(s)
+	}
+	if _, err := qtx.InsertExpenseSplits(ctx, splits); err != nil {
+		return fmt.Errorf("pgstore: failed to insert expense splits for ImportTrip: %w", err)
+	}
+
+	// Activities go after the expenses, as the paid ones refer to theirs.
+	activities := make([]InsertImportedActivitiesParams, len(snapshot.Activities))
+	for i, a := range snapshot.Activities {
+		activities[i] = InsertImportedActivitiesParams{
+			ID:              a.ID,
+			TripID:          a.TripID,
+			Title:           a.Title,
+			OccursAt:        a.OccursAt,
+			Tags:            a.Tags,
+			DurationMinutes: a.DurationMinutes,
+			CostCents:       a.CostCents,
+			Status:          a.Status,
+			Latitude:        a.Latitude,
+			Longitude:       a.Longitude,
+			InviteSequence:  a.InviteSequence,
+			OrganizerID:     a.OrganizerID,
+			Currency:        a.Currency,
+			ExpenseID:       a.ExpenseID,
+		}
+	}
+	if _, err := qtx.InsertImportedActivities(ctx, activities); err != nil {
+		return fmt.Errorf("pgstore: failed to insert activities for ImportTrip: %w", err)
+	}
+
+	tasks := make([]InsertImportedTasksParams, len(snapshot.Tasks))
+	for i, t := range snapshot.Tasks {
+		tasks[i] = InsertImportedTasksParams(t)
+	}
+	if _, err := qtx.InsertImportedTasks(ctx, tasks); err != nil {
+		return fmt.Errorf("pgstore: failed to insert tasks for ImportTrip: %w", err)
+	}
+
+	events := make([]InsertImportedAuditEventsParams, len(snapshot.AuditEvents))
+	for i, e := range snapshot.AuditEvents {
+		events[i] = InsertImportedAuditEventsParams(e)
+	}
+	if _, err := qtx.InsertImportedAuditEvents(ctx, events); err != nil {
+		return fmt.Errorf("pgstore: failed to insert audit events for ImportTrip: %w", err)
+	}
+
+	attachments := make([]InsertImportedAttachmentsParams, len(snapshot.Attachments))
+	for i, a := range snapshot.Attachments {
+		attachments[i] = InsertImportedAttachmentsParams(a)
+	}
+	if _, err := qtx.InsertImportedAttachments(ctx, attachments); err != nil {
+		return fmt.Errorf("pgstore: failed to insert attachments for ImportTrip: %w", err)
+	}
+
+	details, err := json.Marshal(map[string]any{"instance": instance, "source_trip_id": sourceID})
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to encode audit details for ImportTrip: %w", err)
+	}
+
+	if err := qtx.InsertAuditEvent(ctx, InsertAuditEventParams{
+		TripID:     trip.ID,
+		Action:     AuditTripImported,
+		Details:    details,
+		RemoteAddr: remoteAddr,
+	}); err != nil {
+		return fmt.Errorf("pgstore: failed to insert audit event for ImportTrip: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit tx for ImportTrip: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateTripShiftingActivities updates the trip and moves every activity of it
+// by days, together, so the activities keep their place in the trip.
+func (q *Queries) UpdateTripShiftingActivities(ctx context.Context, pool *pgxpool.Pool, params UpdateTripParams, days int32) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for UpdateTripShiftingActivities: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.Tx(tx)
+	if err := qtx.UpdateTrip(ctx, params); err != nil {
+		return fmt.Errorf("pgstore: failed to update trip for UpdateTripShiftingActivities: %w", err)
+	}
+
+	if err := qtx.ShiftTripActivities(ctx, ShiftTripActivitiesParams{Days: days, TripID: params.ID}); err != nil {
+		return fmt.Errorf("pgstore: failed to shift activities for UpdateTripShiftingActivities: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit tx for UpdateTripShiftingActivities: %w", err)
+	}
+
+	return nil
+}
+
+// CreateTripLinks adds the links to a trip all at once, returning their ids in
+// the order given. When one fails none are added.
+func (q *Queries) CreateTripLinks(ctx context.Context, pool *pgxpool.Pool, links []CreateTripLinkParams) ([]uuid.UUID, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("pgstore: failed to begin tx for CreateTripLinks: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.Tx(tx)
+	ids := make([]uuid.UUID, len(links))
+	for i, link := range links {
+		if ids[i], err = qtx.CreateTripLink(ctx, link); err != nil {
+			return nil, fmt.Errorf("pgstore: failed to insert link for CreateTripLinks: %w", err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("pgstore: failed to commit tx for CreateTripLinks: %w", err)
+	}
+
+	return ids, nil
+}
+
+// PayActivity records what was paid for the activity as an expense, keeping
+// the activity out of the estimates from then on.
+func (q *Queries) PayActivity(ctx context.Context, pool *pgxpool.Pool, activityID uuid.UUID, params InsertExpenseParams, splits []InsertExpenseSplitsParams) (uuid.UUID, error) {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to begin tx for PayActivity: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.Tx(tx)
+	expenseID, err := qtx.InsertExpense(ctx, params)
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert expense for PayActivity: %w", err)
+	}
+
+	for i := range splits {
+		splits[i].ExpenseID = expenseID
+	}
+
+	if _, err := qtx.InsertExpenseSplits(ctx, splits); err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to insert splits for PayActivity: %w", err)
+	}
+
+	rows, err := qtx.MarkActivityPaid(ctx, MarkActivityPaidParams{
+		ExpenseID: pgtype.UUID{Valid: true, Bytes: expenseID},
+		ID:        activityID,
+	})
+	if err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to mark activity for PayActivity: %w", err)
+	}
+	if rows == 0 {
+		return uuid.UUID{}, ErrActivityPaid
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return uuid.UUID{}, fmt.Errorf("pgstore: failed to commit tx for PayActivity: %w", err)
+	}
+
+	return expenseID, nil
+}
+
+// ReplaceTripTags sets the tags of the trip to the given ones.
+func (q *Queries) ReplaceTripTags(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID, tags []string) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for ReplaceTripTags: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.Tx(tx)
+	if err := qtx.DeleteTripTags(ctx, tripID); err != nil {
+		return fmt.Errorf("pgstore: failed to delete tags for ReplaceTripTags: %w", err)
+	}
+
+	if err := qtx.InsertTripTags(ctx, InsertTripTagsParams{TripID: tripID, Tags: tags}); err != nil {
+		return fmt.Errorf("pgstore: failed to insert tags for ReplaceTripTags: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit tx for ReplaceTripTags: %w", err)
+	}
+
+	return nil
+}
+
+// ApproveSharedTrip lists the shared trip again once reviewed, closing its
+// reports. The digest is that of the texts approved: the heuristics leave
+// those alone, but hold the trip again once edited. It returns ErrNotFound
+// when the trip is not shared.
+func (q *Queries) ApproveSharedTrip(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID, digest string) error {
+	return q.reviewSharedTrip(ctx, pool, tripID, func(qtx *Queries) (int64, error) {
+		return qtx.ApproveTripShare(ctx, ApproveTripShareParams{Digest: digest, TripID: tripID})
+	})
+}
+
+// RejectSharedTrip stops sharing the trip once reviewed, closing its reports.
+// It returns ErrNotFound when the trip is not shared.
+func (q *Queries) RejectSharedTrip(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID) error {
+	return q.reviewSharedTrip(ctx, pool, tripID, func(qtx *Queries) (int64, error) {
+		return qtx.UnshareTrip(ctx, tripID)
+	})
+}
+
+func (q *Queries) reviewSharedTrip(ctx context.Context, pool *pgxpool.Pool, tripID uuid.UUID, review func(*Queries) (int64, error)) error {
+	tx, err := pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to begin tx for reviewSharedTrip: %w", err)
+	}
+	defer func() { _ = tx.Rollback(ctx) }()
+
+	qtx := q.Tx(tx)
+	rows, err := review(qtx)
+	if err != nil {
+		return fmt.Errorf("pgstore: failed to update share for reviewSharedTrip: %w", err)
+	}
+	if rows == 0 {
+		return ErrNotFound
+	}
+
+	if err := qtx.ResolveContentReports(ctx, tripID); err != nil {
+		return fmt.Errorf("pgstore: failed to resolve reports for reviewSharedTrip: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("pgstore: failed to commit tx for reviewSharedTrip: %w", err)
+	}
+
+	return nil
+}
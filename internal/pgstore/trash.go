@@ -0,0 +1,7 @@
+package pgstore
+
+import "time"
+
+// TrashRetention is how long deleted activities and links stay in the trip
+// trash, where they can be restored, before being purged.
+const TrashRetention = 30 * 24 * time.Hour
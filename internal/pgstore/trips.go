@@ -0,0 +1,50 @@
+package pgstore
+
+import (
+	"fmt"
+
+	"github.com/google/uuid"
+)
+
+// DuplicateTripError is returned when creating a trip the same owner created
+// minutes ago, to the same destination and dates, which is most likely the
+// same form submitted twice.
+type DuplicateTripError struct {
+	TripID uuid.UUID
+}
+
+func (e *DuplicateTripError) Error() string {
+	return fmt.Sprintf("pgstore: trip %s was just created with the same details", e.TripID)
+}
+
+// TripMerge counts what MergeTrips moved to the target trip.
+type TripMerge struct {
+	Activities   int64
+	Links        int64
+	Participants int64
+}
+
+// NewTripShareToken returns a random, URL safe token that lets anyone with
+// it see the trip itinerary, and nothing else.
+func NewTripShareToken() (string, error) {
+	return newToken()
+}
+
+// TripSnapshot is everything a trip holds that is carried to other
+// instances. Date polls, groups, rooms, rides, shopping lists, surveys,
+// receipts and shares stay behind, as do the files of attachments, of which
+// only the records are carried.
+type TripSnapshot struct {
+	Trip           Trip                      `json:"trip"`
+	Participants   []Participant             `json:"participants"`
+	Activities     []Activity                `json:"activities"`
+	Links          []Link                    `json:"links"`
+	ChecklistItems []ChecklistItem           `json:"checklist_items"`
+	Lodgings       []Lodging                 `json:"lodgings"`
+	Transports     []Transport               `json:"transports"`
+	Expenses       []Expense                 `json:"expenses"`
+	ExpenseSplits  []GetTripExpenseSplitsRow `json:"expense_splits"`
+	Tasks          []Task                    `json:"tasks"`
+	AuditEvents    []AuditEvent              `json:"audit_events"`
+	Attachments    []Attachment              `json:"attachments"`
+}
@@ -0,0 +1,128 @@
+package planning
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/domain"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/schedule"
+)
+
+// Impact is what moving a trip to new dates leaves out of its plans.
+type Impact struct {
+	// Activities happen on days out of the new dates.
+	Activities []domain.Activity
+	// UncoveredNights are the nights of the new dates no lodging covers,
+	// leaving out those already uncovered before.
+	UncoveredNights []time.Time
+	// Lodgings have nights out of the new dates.
+	Lodgings []domain.Lodging
+	// Transports depart or arrive on days out of the new dates.
+	Transports []domain.Transport
+}
+
+// Empty reports whether the new dates leave nothing out.
+func (i Impact) Empty() bool {
+	return len(i.Activities) == 0 && len(i.UncoveredNights) == 0 && len(i.Lodgings) == 0 && len(i.Transports) == 0
+}
+
+// LoadImpact reads the trip plans and computes the impact of moving the trip
+// to startsAt and endsAt, with its activities moved by shiftDays first.
+func LoadImpact(ctx context.Context, src Source, trip domain.Trip, startsAt, endsAt time.Time, shiftDays int) (Impact, error) {
+	acts, err := src.TripActivities(ctx, trip.ID)
+	if err != nil {
+		return Impact{}, fmt.Errorf("planning: failed to get activities for LoadImpact: %w", err)
+	}
+	if shiftDays != 0 {
+		shifts := ShiftActivities(acts, shiftDays)
+		acts = make([]domain.Activity, len(shifts))
+		for i, shift := range shifts {
+			acts[i] = shift.Activity
+			acts[i].OccursAt = shift.To
+		}
+	}
+
+	lodgings, err := src.TripLodgings(ctx, trip.ID)
+	if err != nil {
+		return Impact{}, fmt.Errorf("planning: failed to get lodgings for LoadImpact: %w", err)
+	}
+
+	transports, err := src.TripTransports(ctx, trip.ID)
+	if err != nil {
+		return Impact{}, fmt.Errorf("planning: failed to get transports for LoadImpact: %w", err)
+	}
+
+	return ComputeImpact(trip, acts, lodgings, transports, startsAt, endsAt), nil
+}
+
+// ComputeImpact compares the plans of a trip with its new dates, day by day:
+// plans on the first and last days are still within the trip.
+func ComputeImpact(trip domain.Trip, acts []domain.Activity, lodgings []domain.Lodging, transports []domain.Transport, startsAt, endsAt time.Time) Impact {
+	// Days are compared by their date, as the new dates may not be in the
+	// location of the plans.
+	first, last := startsAt.Format(time.DateOnly), endsAt.Format(time.DateOnly)
+	outside := func(t time.Time) bool {
+		d := t.Format(time.DateOnly)
+		return d < first || d > last
+	}
+
+	var i Impact
+	for _, act := range acts {
+		if outside(act.OccursAt) {
+			i.Activities = append(i.Activities, act)
+		}
+	}
+	for _, lodging := range lodgings {
+		if outside(lodging.CheckIn) || outside(lodging.CheckOut) {
+			i.Lodgings = append(i.Lodgings, lodging)
+		}
+	}
+	for _, transport := range transports {
+		if outside(transport.DepartsAt) || outside(transport.ArrivesAt) {
+			i.Transports = append(i.Transports, transport)
+		}
+	}
+
+	stays := make([]schedule.Stay, len(lodgings))
+	for j, lodging := range lodgings {
+		stays[j] = schedule.Stay{Place: lodging.Address, CheckIn: lodging.CheckIn, CheckOut: lodging.CheckOut}
+	}
+
+	before := make(map[string]bool)
+	for _, night := range schedule.UncoveredNights(stays, trip.StartsAt, trip.EndsAt) {
+		before[night.Format(time.DateOnly)] = true
+	}
+	for _, night := range schedule.UncoveredNights(stays, startsAt, endsAt) {
+		if !before[night.Format(time.DateOnly)] {
+			i.UncoveredNights = append(i.UncoveredNights, night)
+		}
+	}
+
+	return i
+}
+
+// Shift is an activity moved along with its trip.
+type Shift struct {
+	Activity domain.Activity
+	From     time.Time
+	To       time.Time
+}
+
+// ShiftDays is how many days a trip starting at from moves when it starts at
+// to instead, counted between their dates.
+func ShiftDays(from, to time.Time) int {
+	fromDate := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, time.UTC)
+	toDate := time.Date(to.Year(), to.Month(), to.Day(), 0, 0, 0, 0, time.UTC)
+	return int(toDate.Sub(fromDate).Hours() / 24)
+}
+
+// ShiftActivities moves every activity by days, keeping the time of day they
+// happen at.
+func ShiftActivities(acts []domain.Activity, days int) []Shift {
+	shifts := make([]Shift, len(acts))
+	for i, act := range acts {
+		shifts[i] = Shift{Activity: act, From: act.OccursAt, To: act.OccursAt.AddDate(0, 0, days)}
+	}
+	return shifts
+}
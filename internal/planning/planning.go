@@ -0,0 +1,143 @@
+package planning
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/domain"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/schedule"
+)
+
+// Status is how far along the planning of a trip is, from the dates being
+// confirmed to every day, night and change of place being covered.
+type Status struct {
+	DatesConfirmed   bool
+	Participants     int
+	Confirmed        int
+	ConfirmedPercent int
+	// Pending are the names, or emails, of the invited participants who did
+	// not confirm yet.
+	Pending         []string
+	EmptyDays       []time.Time
+	UncoveredNights []time.Time
+	UncoveredMoves  []schedule.Move
+	// Progress is the average of every check, from 0 to 100.
+	Progress int
+}
+
+// Source is where the plans of a trip are read from.
+type Source interface {
+	TripParticipants(ctx context.Context, tripID uuid.UUID) ([]domain.Participant, error)
+	TripActivities(ctx context.Context, tripID uuid.UUID) ([]domain.Activity, error)
+	TripLodgings(ctx context.Context, tripID uuid.UUID) ([]domain.Lodging, error)
+	TripTransports(ctx context.Context, tripID uuid.UUID) ([]domain.Transport, error)
+}
+
+// Load reads the trip plans and computes its planning status.
+func Load(ctx context.Context, src Source, trip domain.Trip) (Status, error) {
+	participants, err := src.TripParticipants(ctx, trip.ID)
+	if err != nil {
+		return Status{}, fmt.Errorf("planning: failed to get participants for Load: %w", err)
+	}
+
+	acts, err := src.TripActivities(ctx, trip.ID)
+	if err != nil {
+		return Status{}, fmt.Errorf("planning: failed to get activities for Load: %w", err)
+	}
+
+	lodgings, err := src.TripLodgings(ctx, trip.ID)
+	if err != nil {
+		return Status{}, fmt.Errorf("planning: failed to get lodgings for Load: %w", err)
+	}
+
+	transports, err := src.TripTransports(ctx, trip.ID)
+	if err != nil {
+		return Status{}, fmt.Errorf("planning: failed to get transports for Load: %w", err)
+	}
+
+	return Compute(trip, participants, acts, lodgings, transports), nil
+}
+
+// Compute computes the planning status of a trip from its plans.
+func Compute(trip domain.Trip, participants []domain.Participant, acts []domain.Activity, lodgings []domain.Lodging, transports []domain.Transport) Status {
+	s := Status{
+		DatesConfirmed: trip.Status.Confirmed(),
+		Participants:   len(participants),
+	}
+
+	for _, p := range participants {
+		if p.Confirmed {
+			s.Confirmed++
+			continue
+		}
+		if p.Status == domain.ParticipantInvited {
+			s.Pending = append(s.Pending, p.DisplayName())
+		}
+	}
+	s.ConfirmedPercent = percent(s.Confirmed, len(participants))
+
+	scheduled := make([]schedule.Activity, len(acts))
+	for i, act := range acts {
+		scheduled[i] = schedule.Activity{
+			ID:       act.ID,
+			Title:    act.Title,
+			StartsAt: act.OccursAt,
+			Duration: act.Duration,
+		}
+	}
+
+	stays := make([]schedule.Stay, len(lodgings))
+	for i, lodging := range lodgings {
+		stays[i] = schedule.Stay{Place: lodging.Address, CheckIn: lodging.CheckIn, CheckOut: lodging.CheckOut}
+	}
+
+	legs := make([]schedule.Leg, len(transports))
+	for i, transport := range transports {
+		legs[i] = schedule.Leg{DepartsAt: transport.DepartsAt, ArrivesAt: transport.ArrivesAt}
+	}
+
+	first, last := trip.StartsAt, trip.EndsAt
+	s.EmptyDays = schedule.EmptyDays(scheduled, first, last)
+	s.UncoveredNights = schedule.UncoveredNights(stays, first, last)
+	s.UncoveredMoves = schedule.UncoveredMoves(stays, legs)
+
+	days := tripDays(first, last)
+	checks := []int{
+		100 * boolToInt(trip.Status.Confirmed()),
+		s.ConfirmedPercent,
+		percent(days-len(s.EmptyDays), days),
+		percent(days-1-len(s.UncoveredNights), days-1),
+		100 * boolToInt(len(s.UncoveredMoves) == 0),
+	}
+	for _, check := range checks {
+		s.Progress += check
+	}
+	s.Progress /= len(checks)
+
+	return s
+}
+
+// tripDays counts the calendar days a trip spans, including the first and
+// the last.
+func tripDays(first, last time.Time) int {
+	first = time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, time.UTC)
+	last = time.Date(last.Year(), last.Month(), last.Day(), 0, 0, 0, 0, time.UTC)
+	return int(last.Sub(first).Hours()/24) + 1
+}
+
+// percent is part of total from 0 to 100, where nothing to do counts as done.
+func percent(part, total int) int {
+	if total <= 0 {
+		return 100
+	}
+	return part * 100 / total
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,211 @@
+package quickadd
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	ErrNoTitle     = errors.New("quickadd: no title given")
+	ErrNoDay       = errors.New("quickadd: no day given")
+	ErrNoTime      = errors.New("quickadd: no time given")
+	ErrOutsideTrip = errors.New("quickadd: day is not during the trip")
+)
+
+// Activity is what was understood from the text.
+type Activity struct {
+	Title    string
+	OccursAt time.Time
+}
+
+var (
+	timeRE    = regexp.MustCompile(`^(\d{1,2})(?:(?::|h)(\d{2})?)?(am|pm)?$`)
+	dayRE     = regexp.MustCompile(`^(\d{1,2})/(\d{1,2})(?:/(\d{4}))?$`)
+	isoDateRE = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+)
+
+// weekdays are the names of the days, in English and Portuguese without
+// accents. Short names are left out as they are also common words.
+var weekdays = map[string]time.Weekday{
+	"sunday": time.Sunday, "domingo": time.Sunday,
+	"monday": time.Monday, "segunda": time.Monday, "segunda-feira": time.Monday,
+	"tuesday": time.Tuesday, "terca": time.Tuesday, "terca-feira": time.Tuesday,
+	"wednesday": time.Wednesday, "quarta": time.Wednesday, "quarta-feira": time.Wednesday,
+	"thursday": time.Thursday, "quinta": time.Thursday, "quinta-feira": time.Thursday,
+	"friday": time.Friday, "sexta": time.Friday, "sexta-feira": time.Friday,
+	"saturday": time.Saturday, "sabado": time.Saturday,
+}
+
+// connectors are dropped from the title when right before a day or a time,
+// as in "on friday" or "às 20h".
+var connectors = map[string]bool{
+	"on": true, "at": true, "na": true, "no": true, "as": true, "em": true,
+}
+
+// Parse understands texts like "dinner at Coco Bambu friday 20:00" or
+// "jantar no Coco Bambu sexta às 20h" as an activity of the trip from startsAt
+// to endsAt. Days are given by weekday, the first one of the trip, by date
+// (02/01 or 2006-01-02), by trip day ("day 2", "dia 2") or as today or
+// tomorrow from now. The day can be left out of trips of a single day.
+func Parse(text string, startsAt, endsAt, now time.Time) (Activity, error) {
+	words := strings.Fields(text)
+	used := make([]bool, len(words))
+
+	firstDay, lastDay := dateOf(startsAt), dateOf(endsAt)
+
+	var (
+		day           time.Time
+		hasDay        bool
+		hour, minute  int
+		hasTime       bool
+		outsideOfTrip bool
+	)
+	for i, word := range words {
+		key := fold(strings.Trim(word, ",.;!?"))
+
+		switch {
+		case !hasTime && timeRE.MatchString(key) && isTime(key, words, i):
+			m := timeRE.FindStringSubmatch(key)
+			meridiem := m[3]
+			if meridiem == "" && i+1 < len(words) {
+				if next := fold(words[i+1]); next == "am" || next == "pm" {
+					meridiem = next
+					used[i+1] = true
+				}
+			}
+
+			h, _ := strconv.Atoi(m[1])
+			mi, _ := strconv.Atoi(m[2])
+			switch meridiem {
+			case "am":
+				h %= 12
+			case "pm":
+				h = h%12 + 12
+			}
+			if h > 23 || mi > 59 {
+				continue
+			}
+			hour, minute, hasTime = h, mi, true
+
+		case !hasDay && dayRE.MatchString(key):
+			m := dayRE.FindStringSubmatch(key)
+			d, _ := strconv.Atoi(m[1])
+			mo, _ := strconv.Atoi(m[2])
+			year := firstDay.Year()
+			if m[3] != "" {
+				year, _ = strconv.Atoi(m[3])
+			}
+			day = time.Date(year, time.Month(mo), d, 0, 0, 0, 0, time.UTC)
+			if day.Day() != d {
+				continue
+			}
+			if m[3] == "" && day.Before(firstDay) {
+				day = day.AddDate(1, 0, 0)
+			}
+			hasDay = true
+
+		case !hasDay && isoDateRE.MatchString(key):
+			t, err := time.Parse(time.DateOnly, key)
+			if err != nil {
+				continue
+			}
+			day, hasDay = t, true
+
+		case !hasDay && (key == "today" || key == "hoje"):
+			day, hasDay = dateOf(now), true
+
+		case !hasDay && (key == "tomorrow" || key == "amanha"):
+			day, hasDay = dateOf(now).AddDate(0, 0, 1), true
+
+		case !hasDay && (key == "day" || key == "dia") && i+1 < len(words):
+			n, err := strconv.Atoi(strings.Trim(words[i+1], ",.;!?"))
+			if err != nil || n < 1 {
+				continue
+			}
+			day, hasDay = firstDay.AddDate(0, 0, n-1), true
+			used[i+1] = true
+
+		default:
+			weekday, ok := weekdays[key]
+			if !ok || hasDay {
+				continue
+			}
+			for d := firstDay; !d.After(lastDay); d = d.AddDate(0, 0, 1) {
+				if d.Weekday() == weekday {
+					day, hasDay = d, true
+					break
+				}
+			}
+			if !hasDay {
+				outsideOfTrip = true
+			}
+		}
+
+		used[i] = true
+		if i > 0 && connectors[fold(words[i-1])] {
+			used[i-1] = true
+		}
+	}
+
+	title := make([]string, 0, len(words))
+	for i, word := range words {
+		if !used[i] {
+			title = append(title, word)
+		}
+	}
+
+	activity := Activity{Title: strings.Trim(strings.Join(title, " "), " ,.;-")}
+	if activity.Title == "" {
+		return Activity{}, ErrNoTitle
+	}
+
+	if !hasDay && firstDay.Equal(lastDay) && !outsideOfTrip {
+		day, hasDay = firstDay, true
+	}
+	switch {
+	case outsideOfTrip:
+		return Activity{}, ErrOutsideTrip
+	case !hasDay:
+		return Activity{}, ErrNoDay
+	case !hasTime:
+		return Activity{}, ErrNoTime
+	case day.Before(firstDay) || day.After(lastDay):
+		return Activity{}, ErrOutsideTrip
+	}
+
+	activity.OccursAt = day.Add(time.Duration(hour)*time.Hour + time.Duration(minute)*time.Minute)
+	return activity, nil
+}
+
+// isTime tells whether the number at i is a time rather than part of the
+// title: it must have minutes, an "h" or am/pm, after it or on its own.
+func isTime(key string, words []string, i int) bool {
+	if strings.ContainsAny(key, ":h") || strings.HasSuffix(key, "m") {
+		return true
+	}
+	if i+1 < len(words) {
+		next := fold(words[i+1])
+		return next == "am" || next == "pm"
+	}
+	return false
+}
+
+var accents = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a",
+	"é", "e", "ê", "e", "í", "i",
+	"ó", "o", "ô", "o", "õ", "o", "ú", "u", "ç", "c",
+)
+
+// fold lowercases the word and drops its accents, so "Sábado" and "sabado"
+// are the same.
+func fold(word string) string {
+	return accents.Replace(strings.ToLower(word))
+}
+
+func dateOf(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,132 @@
+package routing
+
+import (
+	"context"
+	"math"
+	"slices"
+	"time"
+)
+
+type Point struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// Leg is how far apart two places are and how long it takes to go from one
+// to the other.
+type Leg struct {
+	DistanceMeters float64
+	Duration       time.Duration
+}
+
+// Provider estimates the way between two places.
+type Provider interface {
+	Route(ctx context.Context, from, to Point) (Leg, error)
+}
+
+const earthRadiusMeters = 6_371_000
+
+// Distance is the great-circle distance between two points, in meters.
+func Distance(a, b Point) float64 {
+	lat1, lat2 := radians(a.Latitude), radians(b.Latitude)
+	dLat := lat2 - lat1
+	dLon := radians(b.Longitude - a.Longitude)
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusMeters * math.Asin(math.Sqrt(h))
+}
+
+func radians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
+
+const (
+	// detourFactor accounts for streets not going in a straight line.
+	detourFactor = 1.3
+
+	// Up to walkingDistance people are assumed to walk, further than that to
+	// take some urban transport.
+	walkingDistance   = 1500
+	walkingSpeedKmh   = 5
+	transportSpeedKmh = 25
+)
+
+// Haversine is the provider used when no routing service is configured. It
+// estimates from the straight line distance, good enough to tell whether a
+// day is feasible.
+type Haversine struct{}
+
+func (Haversine) Route(_ context.Context, from, to Point) (Leg, error) {
+	meters := Distance(from, to) * detourFactor
+
+	speed := float64(transportSpeedKmh)
+	if meters <= walkingDistance {
+		speed = walkingSpeedKmh
+	}
+	hours := meters / 1000 / speed
+
+	return Leg{
+		DistanceMeters: meters,
+		Duration:       time.Duration(hours * float64(time.Hour)).Round(time.Minute),
+	}, nil
+}
+
+// Shortest returns the order in which to visit the points, always starting
+// from the first one, that roughly minimizes the distance travelled: a
+// nearest neighbor tour improved with 2-opt.
+func Shortest(points []Point) []int {
+	order := make([]int, 0, len(points))
+	if len(points) == 0 {
+		return order
+	}
+
+	visited := make([]bool, len(points))
+	order = append(order, 0)
+	visited[0] = true
+	for len(order) < len(points) {
+		last, next := points[order[len(order)-1]], -1
+		for i, p := range points {
+			if !visited[i] && (next == -1 || Distance(last, p) < Distance(last, points[next])) {
+				next = i
+			}
+		}
+		order = append(order, next)
+		visited[next] = true
+	}
+
+	for improved := true; improved; {
+		improved = false
+		for i := 1; i < len(order)-1; i++ {
+			for j := i + 1; j < len(order); j++ {
+				if reversalGain(points, order, i, j) > 1e-9 {
+					slices.Reverse(order[i : j+1])
+					improved = true
+				}
+			}
+		}
+	}
+
+	return order
+}
+
+// reversalGain is how much shorter the path gets by reversing order[i:j+1].
+// The path is open, so reversing up to the last point only changes one edge.
+func reversalGain(points []Point, order []int, i, j int) float64 {
+	a, b := points[order[i-1]], points[order[i]]
+	c := points[order[j]]
+	if j == len(order)-1 {
+		return Distance(a, b) - Distance(a, c)
+	}
+	d := points[order[j+1]]
+	return Distance(a, b) + Distance(c, d) - Distance(a, c) - Distance(b, d)
+}
+
+// PathDistance is the straight line distance, in meters, of visiting the
+// points in the given order.
+func PathDistance(points []Point, order []int) float64 {
+	var total float64
+	for i := 1; i < len(order); i++ {
+		total += Distance(points[order[i-1]], points[order[i]])
+	}
+	return total
+}
@@ -0,0 +1,100 @@
+package clamav
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/scanner"
+)
+
+// chunkSize is how much of the file is sent to clamd at a time.
+const chunkSize = 64 << 10
+
+// ClamAV scans files with a clamd daemon, streaming them with its INSTREAM
+// command.
+type ClamAV struct {
+	network string
+	addr    string
+}
+
+// NewClamAV returns a scanner for the clamd listening on addr, a host:port or
+// the path to its unix socket.
+func NewClamAV(addr string) ClamAV {
+	if strings.HasPrefix(addr, "/") {
+		return ClamAV{"unix", addr}
+	}
+	return ClamAV{"tcp", addr}
+}
+
+func (c ClamAV) Scan(ctx context.Context, r io.Reader) (scanner.Result, error) {
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, c.network, c.addr)
+	if err != nil {
+		return scanner.Result{}, fmt.Errorf("clamav: failed to connect for Scan: %w", err)
+	}
+	defer conn.Close()
+
+	if deadline, ok := ctx.Deadline(); ok {
+		if err := conn.SetDeadline(deadline); err != nil {
+			return scanner.Result{}, fmt.Errorf("clamav: failed to set deadline for Scan: %w", err)
+		}
+	}
+
+	if err := stream(conn, r); err != nil {
+		return scanner.Result{}, fmt.Errorf("clamav: failed to send file for Scan: %w", err)
+	}
+
+	reply, err := bufio.NewReader(conn).ReadString(0)
+	if err != nil {
+		return scanner.Result{}, fmt.Errorf("clamav: failed to read reply for Scan: %w", err)
+	}
+
+	return parseReply(strings.TrimSuffix(reply, "\x00"))
+}
+
+// stream sends the file as INSTREAM expects: chunks prefixed by their length,
+// ended by an empty one.
+func stream(w io.Writer, r io.Reader) error {
+	if _, err := io.WriteString(w, "zINSTREAM\x00"); err != nil {
+		return err
+	}
+
+	buf := make([]byte, 4+chunkSize)
+	for {
+		n, err := io.ReadFull(r, buf[4:])
+		if n > 0 {
+			binary.BigEndian.PutUint32(buf, uint32(n))
+			if _, err := w.Write(buf[:4+n]); err != nil {
+				return err
+			}
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := w.Write([]byte{0, 0, 0, 0})
+	return err
+}
+
+// parseReply reads replies like "stream: OK" and
+// "stream: Eicar-Signature FOUND".
+func parseReply(reply string) (scanner.Result, error) {
+	_, status, _ := strings.Cut(reply, ": ")
+	switch {
+	case status == "OK":
+		return scanner.Result{}, nil
+	case strings.HasSuffix(status, " FOUND"):
+		return scanner.Result{Infected: true, Signature: strings.TrimSuffix(status, " FOUND")}, nil
+	default:
+		return scanner.Result{}, fmt.Errorf("clamav: unexpected reply %q for Scan", reply)
+	}
+}
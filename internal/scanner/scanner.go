@@ -0,0 +1,28 @@
+package scanner
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+var ErrDisabled = errors.New("scanner: no scanner configured")
+
+// Result is what a scan found. Signature names the malware found in infected
+// files.
+type Result struct {
+	Infected  bool
+	Signature string
+}
+
+// Scanner checks files for viruses and other malware.
+type Scanner interface {
+	Scan(ctx context.Context, r io.Reader) (Result, error)
+}
+
+// None is the scanner used when none is configured. Files are left unscanned.
+type None struct{}
+
+func (None) Scan(context.Context, io.Reader) (Result, error) {
+	return Result{}, ErrDisabled
+}
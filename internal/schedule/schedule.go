@@ -0,0 +1,330 @@
+package schedule
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	ConflictOverlap            = "overlap"
+	ConflictOutsideLodging     = "outside_lodging"
+	ConflictAfterLastTransport = "after_last_transport"
+)
+
+// Activity is a scheduled activity. Activities without a duration take a
+// single instant of time.
+type Activity struct {
+	ID       uuid.UUID
+	Title    string
+	StartsAt time.Time
+	Duration time.Duration
+}
+
+func (a Activity) EndsAt() time.Time {
+	return a.StartsAt.Add(a.Duration)
+}
+
+// Stay is the time a trip has a place to sleep.
+type Stay struct {
+	Place    string
+	CheckIn  time.Time
+	CheckOut time.Time
+}
+
+// Leg is a transport between two places.
+type Leg struct {
+	DepartsAt time.Time
+	ArrivesAt time.Time
+}
+
+type Conflict struct {
+	Kind        string
+	ActivityIDs []uuid.UUID
+	Message     string
+}
+
+// Conflicts lists the activities that can't happen as scheduled: activities
+// overlapping each other, activities before the first check-in or after the
+// last check-out, and, when the trip has a way back, activities that end
+// after the last transport leaves.
+func Conflicts(acts []Activity, stays []Stay, legs []Leg) []Conflict {
+	acts = slices.Clone(acts)
+	slices.SortStableFunc(acts, func(a, b Activity) int {
+		return a.StartsAt.Compare(b.StartsAt)
+	})
+
+	var conflicts []Conflict
+	for i, a := range acts {
+		for _, b := range acts[i+1:] {
+			if !b.StartsAt.Equal(a.StartsAt) && !b.StartsAt.Before(a.EndsAt()) {
+				break
+			}
+			conflicts = append(conflicts, Conflict{
+				Kind:        ConflictOverlap,
+				ActivityIDs: []uuid.UUID{a.ID, b.ID},
+				Message:     fmt.Sprintf("%q overlaps with %q", a.Title, b.Title),
+			})
+		}
+	}
+
+	if len(stays) > 0 {
+		firstCheckIn, lastCheckOut := stays[0].CheckIn, stays[0].CheckOut
+		for _, s := range stays[1:] {
+			if s.CheckIn.Before(firstCheckIn) {
+				firstCheckIn = s.CheckIn
+			}
+			if s.CheckOut.After(lastCheckOut) {
+				lastCheckOut = s.CheckOut
+			}
+		}
+
+		for _, a := range acts {
+			switch {
+			case a.StartsAt.Before(firstCheckIn):
+				conflicts = append(conflicts, Conflict{
+					Kind:        ConflictOutsideLodging,
+					ActivityIDs: []uuid.UUID{a.ID},
+					Message:     fmt.Sprintf("%q is scheduled before the first check-in", a.Title),
+				})
+			case a.EndsAt().After(lastCheckOut):
+				conflicts = append(conflicts, Conflict{
+					Kind:        ConflictOutsideLodging,
+					ActivityIDs: []uuid.UUID{a.ID},
+					Message:     fmt.Sprintf("%q is scheduled after the last check-out", a.Title),
+				})
+			}
+		}
+	}
+
+	// A single leg is only the way there, so nothing can be after it.
+	if len(legs) > 1 {
+		last := legs[0]
+		for _, l := range legs[1:] {
+			if l.DepartsAt.After(last.DepartsAt) {
+				last = l
+			}
+		}
+
+		for _, a := range acts {
+			if a.EndsAt().After(last.DepartsAt) {
+				conflicts = append(conflicts, Conflict{
+					Kind:        ConflictAfterLastTransport,
+					ActivityIDs: []uuid.UUID{a.ID},
+					Message:     fmt.Sprintf("%q is scheduled after the last transport leaves", a.Title),
+				})
+			}
+		}
+	}
+
+	return conflicts
+}
+
+// Gap is a stretch of free time in a day.
+type Gap struct {
+	StartsAt time.Time
+	EndsAt   time.Time
+}
+
+func (g Gap) Duration() time.Duration {
+	return g.EndsAt.Sub(g.StartsAt)
+}
+
+const (
+	// DayStart and DayEnd bound the part of a day worth planning, as an
+	// offset from midnight.
+	DayStart = 8 * time.Hour
+	DayEnd   = 22 * time.Hour
+
+	// assumedDuration is how long an activity without a duration is expected
+	// to take when looking for free time around it.
+	assumedDuration = time.Hour
+)
+
+// Gaps lists the free stretches of at least minGap between DayStart and
+// DayEnd of every day from first to last, around the given activities.
+func Gaps(acts []Activity, first, last time.Time, minGap time.Duration) []Gap {
+	acts = slices.Clone(acts)
+	for i := range acts {
+		if acts[i].Duration == 0 {
+			acts[i].Duration = assumedDuration
+		}
+	}
+	slices.SortStableFunc(acts, func(a, b Activity) int {
+		return a.StartsAt.Compare(b.StartsAt)
+	})
+
+	var gaps []Gap
+	for day := truncateDay(first); !day.After(truncateDay(last)); day = day.AddDate(0, 0, 1) {
+		free, end := day.Add(DayStart), day.Add(DayEnd)
+		for _, a := range acts {
+			if !a.EndsAt().After(free) || !a.StartsAt.Before(end) {
+				continue
+			}
+			if a.StartsAt.Sub(free) >= minGap {
+				gaps = append(gaps, Gap{StartsAt: free, EndsAt: a.StartsAt})
+			}
+			if a.EndsAt().After(free) {
+				free = a.EndsAt()
+			}
+		}
+		if end.Sub(free) >= minGap {
+			gaps = append(gaps, Gap{StartsAt: free, EndsAt: end})
+		}
+	}
+
+	return gaps
+}
+
+// Slot is a time an activity could be scheduled at.
+type Slot struct {
+	StartsAt time.Time
+	EndsAt   time.Time
+}
+
+const (
+	// slotStep is how far apart suggested slots start.
+	slotStep = 30 * time.Minute
+
+	// slotBuffer is the free time slots are preferred to leave before and
+	// after the activities around them, to get from one to the other.
+	slotBuffer = 30 * time.Minute
+)
+
+// TypicalDuration is the median duration of the activities with one, or
+// assumedDuration when none has.
+func TypicalDuration(acts []Activity) time.Duration {
+	var durations []time.Duration
+	for _, a := range acts {
+		if a.Duration > 0 {
+			durations = append(durations, a.Duration)
+		}
+	}
+	if len(durations) == 0 {
+		return assumedDuration
+	}
+	slices.Sort(durations)
+	return durations[len(durations)/2]
+}
+
+// Suggest lists, best first, up to limit slots of the given duration in the
+// free time of day. Slots leaving slotBuffer to the activities around them
+// come first, then those leaving the longest free stretch in the rest of
+// their gap, so the day keeps room for more, then the earliest.
+func Suggest(acts []Activity, day time.Time, duration time.Duration, limit int) []Slot {
+	day = truncateDay(day)
+	dayStart, dayEnd := day.Add(DayStart), day.Add(DayEnd)
+
+	type candidate struct {
+		slot     Slot
+		tight    bool
+		leftover time.Duration
+	}
+
+	var candidates []candidate
+	for _, gap := range Gaps(acts, day, day, duration) {
+		start := gap.StartsAt.Truncate(slotStep)
+		if start.Before(gap.StartsAt) {
+			start = start.Add(slotStep)
+		}
+
+		for ; !start.Add(duration).After(gap.EndsAt); start = start.Add(slotStep) {
+			end := start.Add(duration)
+			before, after := start.Sub(gap.StartsAt), gap.EndsAt.Sub(end)
+			tight := (before < slotBuffer && !gap.StartsAt.Equal(dayStart)) ||
+				(after < slotBuffer && !gap.EndsAt.Equal(dayEnd))
+
+			candidates = append(candidates, candidate{
+				slot:     Slot{StartsAt: start, EndsAt: end},
+				tight:    tight,
+				leftover: max(before, after),
+			})
+		}
+	}
+
+	slices.SortStableFunc(candidates, func(a, b candidate) int {
+		if a.tight != b.tight {
+			if a.tight {
+				return 1
+			}
+			return -1
+		}
+		return cmp.Or(cmp.Compare(b.leftover, a.leftover), a.slot.StartsAt.Compare(b.slot.StartsAt))
+	})
+
+	slots := make([]Slot, 0, min(limit, len(candidates)))
+	for _, c := range candidates[:min(limit, len(candidates))] {
+		slots = append(slots, c.slot)
+	}
+	return slots
+}
+
+func truncateDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// EmptyDays lists the days from first to last with no activity starting.
+func EmptyDays(acts []Activity, first, last time.Time) []time.Time {
+	busy := make(map[time.Time]bool, len(acts))
+	for _, a := range acts {
+		busy[truncateDay(a.StartsAt)] = true
+	}
+
+	var days []time.Time
+	for day := truncateDay(first); !day.After(truncateDay(last)); day = day.AddDate(0, 0, 1) {
+		if !busy[day] {
+			days = append(days, day)
+		}
+	}
+	return days
+}
+
+// UncoveredNights lists the nights from first to last, named by the day they
+// start, that no stay covers.
+func UncoveredNights(stays []Stay, first, last time.Time) []time.Time {
+	var nights []time.Time
+	for night := truncateDay(first); night.Before(truncateDay(last)); night = night.AddDate(0, 0, 1) {
+		covered := slices.ContainsFunc(stays, func(s Stay) bool {
+			return !truncateDay(s.CheckIn).After(night) && truncateDay(s.CheckOut).After(night)
+		})
+		if !covered {
+			nights = append(nights, night)
+		}
+	}
+	return nights
+}
+
+// Move is a change of place between two consecutive stays.
+type Move struct {
+	From string
+	To   string
+}
+
+// UncoveredMoves lists the changes of place between consecutive stays that
+// have no transport leaving after the first stay begins and arriving before
+// the next one ends.
+func UncoveredMoves(stays []Stay, legs []Leg) []Move {
+	stays = slices.Clone(stays)
+	slices.SortStableFunc(stays, func(a, b Stay) int {
+		return a.CheckIn.Compare(b.CheckIn)
+	})
+
+	var moves []Move
+	for i := 1; i < len(stays); i++ {
+		from, to := stays[i-1], stays[i]
+		if from.Place == to.Place {
+			continue
+		}
+
+		covered := slices.ContainsFunc(legs, func(l Leg) bool {
+			return !l.DepartsAt.Before(from.CheckIn) && !l.ArrivesAt.After(to.CheckOut)
+		})
+		if !covered {
+			moves = append(moves, Move{From: from.Place, To: to.Place})
+		}
+	}
+	return moves
+}
@@ -0,0 +1,48 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// DefaultArchiveAfter is how long after a trip ends it is archived, unless
+// configured otherwise.
+const DefaultArchiveAfter = 30 * 24 * time.Hour
+
+type archiveStore interface {
+	ArchiveEndedTrips(ctx context.Context, endsAt pgtype.Timestamp) ([]uuid.UUID, error)
+}
+
+type archiveMailer interface {
+	SendTripArchived(tripID uuid.UUID) error
+}
+
+// ArchivedTrips archives the trips that ended more than after ago and tells
+// their owners where to export them. Trips are archived before sending, so
+// each owner is told once; a failed email is not retried.
+func ArchivedTrips(store archiveStore, mailer archiveMailer, after time.Duration, logger *zap.Logger) Job {
+	return Job{
+		Name:     "archived trips",
+		Interval: time.Hour,
+		Run: func(ctx context.Context) error {
+			endsAt := pgtype.Timestamp{Valid: true, Time: time.Now().Add(-after)}
+			ids, err := store.ArchiveEndedTrips(ctx, endsAt)
+			if err != nil {
+				return fmt.Errorf("scheduler: failed to archive trips for ArchivedTrips: %w", err)
+			}
+
+			for _, id := range ids {
+				if err := mailer.SendTripArchived(id); err != nil {
+					logger.Error("failed to send email on ArchivedTrips", zap.Error(err), zap.String("trip_id", id.String()))
+				}
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,95 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"expvar"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/storage"
+	"go.uber.org/zap"
+)
+
+const (
+	// attachmentRetention is how long attachments are kept once their trip
+	// is archived or over.
+	attachmentRetention = 90 * 24 * time.Hour
+
+	// attachmentCleanupBatch is how many attachments are deleted per run.
+	attachmentCleanupBatch = 500
+)
+
+// attachmentMetrics are published under "attachments" in /debug/vars.
+var (
+	attachmentMetrics = expvar.NewMap("attachments")
+	deletedTotal      = new(expvar.Int)
+	reclaimedBytes    = new(expvar.Int)
+)
+
+func init() {
+	attachmentMetrics.Set("deleted", deletedTotal)
+	attachmentMetrics.Set("reclaimed_bytes", reclaimedBytes)
+}
+
+type attachmentStore interface {
+	ListExpiredAttachments(ctx context.Context, arg pgstore.ListExpiredAttachmentsParams) ([]pgstore.ListExpiredAttachmentsRow, error)
+	DeleteAttachment(ctx context.Context, id uuid.UUID) (int64, error)
+}
+
+type attachmentFiles interface {
+	Delete(ctx context.Context, key string) error
+}
+
+// ExpiredAttachments deletes the attachments of trips archived or over for
+// longer than the retention, files first so none is left without its row.
+// Instances running it at once may delete the same attachments, only the one
+// removing the row counts them.
+func ExpiredAttachments(store attachmentStore, files attachmentFiles, logger *zap.Logger) Job {
+	return Job{
+		Name:     "expired attachments",
+		Interval: time.Hour,
+		Run: func(ctx context.Context) error {
+			before := pgtype.Timestamp{Valid: true, Time: time.Now().Add(-attachmentRetention)}
+			attachments, err := store.ListExpiredAttachments(ctx, pgstore.ListExpiredAttachmentsParams{
+				Before: before,
+				Max:    attachmentCleanupBatch,
+			})
+			if err != nil {
+				return fmt.Errorf("scheduler: failed to list attachments for ExpiredAttachments: %w", err)
+			}
+
+			for _, attachment := range attachments {
+				logger := logger.With(zap.String("attachment_id", attachment.ID.String()))
+
+				err := files.Delete(ctx, pgstore.AttachmentKey(attachment.TripID, attachment.ID))
+				if errors.Is(err, storage.ErrDisabled) {
+					return nil
+				}
+				if err != nil {
+					logger.Error("failed to delete expired attachment file", zap.Error(err))
+					continue
+				}
+				if err := files.Delete(ctx, pgstore.QuarantineKey(attachment.TripID, attachment.ID)); err != nil {
+					logger.Error("failed to delete quarantined attachment file", zap.Error(err))
+					continue
+				}
+
+				rows, err := store.DeleteAttachment(ctx, attachment.ID)
+				if err != nil {
+					logger.Error("failed to delete expired attachment", zap.Error(err))
+					continue
+				}
+				if rows == 1 {
+					deletedTotal.Add(1)
+					reclaimedBytes.Add(attachment.SizeBytes.Int64)
+				}
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,47 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type digestStore interface {
+	ClaimDueTripDigests(ctx context.Context) ([]uuid.UUID, error)
+	ReleaseTripDigest(ctx context.Context, id uuid.UUID) error
+}
+
+type digestMailer interface {
+	SendDailyDigest(tripID uuid.UUID) error
+}
+
+// DailyDigests sends the participants of every confirmed trip that has not
+// ended yet a digest of what is coming, once a day from the reminder hour of
+// the trip, unless its owners turned digests off. Like OwnerSummaries, trips
+// are claimed for the day before sending and released on failure.
+func DailyDigests(store digestStore, mailer digestMailer, logger *zap.Logger) Job {
+	return Job{
+		Name:     "daily digests",
+		Interval: 15 * time.Minute,
+		Run: func(ctx context.Context) error {
+			ids, err := store.ClaimDueTripDigests(ctx)
+			if err != nil {
+				return fmt.Errorf("scheduler: failed to claim trips for DailyDigests: %w", err)
+			}
+
+			for _, id := range ids {
+				if err := mailer.SendDailyDigest(id); err != nil {
+					logger.Error("failed to send email on DailyDigests", zap.Error(err), zap.String("trip_id", id.String()))
+					if err := store.ReleaseTripDigest(ctx, id); err != nil {
+						logger.Error("failed to release trip digest", zap.Error(err), zap.String("trip_id", id.String()))
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
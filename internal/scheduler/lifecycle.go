@@ -0,0 +1,56 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/domain"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+type tripLifecycleStore interface {
+	GetTripsDueToAdvance(ctx context.Context) ([]pgstore.GetTripsDueToAdvanceRow, error)
+}
+
+type transitioner interface {
+	Transition(ctx context.Context, tripID uuid.UUID, from, to domain.TripStatus, remoteAddr string) error
+}
+
+// TripLifecycle starts the confirmed trips whose first day came and finishes
+// the ongoing ones that ended. A trip found after it already ended is started
+// and finished in the same run, so it goes through every status.
+func TripLifecycle(store tripLifecycleStore, lifecycle transitioner, logger *zap.Logger) Job {
+	return Job{
+		Name:     "trip lifecycle",
+		Interval: time.Hour,
+		Run: func(ctx context.Context) error {
+			trips, err := store.GetTripsDueToAdvance(ctx)
+			if err != nil {
+				return fmt.Errorf("scheduler: failed to get trips for TripLifecycle: %w", err)
+			}
+
+			now := time.Now()
+			for _, trip := range trips {
+				status := domain.TripStatus(trip.Status)
+				if status == domain.TripConfirmed {
+					if err := lifecycle.Transition(ctx, trip.ID, status, domain.TripOngoing, ""); err != nil {
+						logger.Error("failed to start trip", zap.Error(err), zap.String("trip_id", trip.ID.String()))
+						continue
+					}
+					status = domain.TripOngoing
+				}
+
+				if status == domain.TripOngoing && trip.EndsAt.Time.Before(now) {
+					if err := lifecycle.Transition(ctx, trip.ID, status, domain.TripFinished, ""); err != nil {
+						logger.Error("failed to finish trip", zap.Error(err), zap.String("trip_id", trip.ID.String()))
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,53 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// planningDigestEvery is how long owners wait between two planning digests,
+// and after creating the trip for the first one.
+const planningDigestEvery = 7 * 24 * time.Hour
+
+type planningDigestStore interface {
+	ClaimDueTripPlanningDigests(ctx context.Context, sentBefore pgtype.Timestamp) ([]uuid.UUID, error)
+	ReleaseTripPlanningDigest(ctx context.Context, id uuid.UUID) error
+}
+
+type planningDigestMailer interface {
+	SendPlanningDigest(tripID uuid.UUID) error
+}
+
+// PlanningDigests sends the owners of every upcoming trip not confirmed yet a
+// weekly digest of what is pending, unless they turned it off. Like
+// OwnerSummaries, trips are claimed for the week before sending and released
+// on failure.
+func PlanningDigests(store planningDigestStore, mailer planningDigestMailer, logger *zap.Logger) Job {
+	return Job{
+		Name:     "planning digests",
+		Interval: time.Hour,
+		Run: func(ctx context.Context) error {
+			due := pgtype.Timestamp{Valid: true, Time: time.Now().Add(-planningDigestEvery)}
+			ids, err := store.ClaimDueTripPlanningDigests(ctx, due)
+			if err != nil {
+				return fmt.Errorf("scheduler: failed to claim trips for PlanningDigests: %w", err)
+			}
+
+			for _, id := range ids {
+				if err := mailer.SendPlanningDigest(id); err != nil {
+					logger.Error("failed to send email on PlanningDigests", zap.Error(err), zap.String("trip_id", id.String()))
+					if err := store.ReleaseTripPlanningDigest(ctx, id); err != nil {
+						logger.Error("failed to release trip planning digest", zap.Error(err), zap.String("trip_id", id.String()))
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,94 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+type reminderStore interface {
+	GetTripsDueForReminders(ctx context.Context) ([]pgstore.GetTripsDueForRemindersRow, error)
+	ClaimTripReminder(ctx context.Context, arg pgstore.ClaimTripReminderParams) (int64, error)
+	ReleaseTripReminder(ctx context.Context, arg pgstore.ReleaseTripReminderParams) error
+}
+
+type reminderMailer interface {
+	SendTripReminder(tripID uuid.UUID, daysLeft int) error
+}
+
+// TripReminders reminds the participants of every confirmed trip that it is
+// coming, the days before it starts its owners set in the trip settings, from
+// its reminder hour. When several reminders are due at once, as for a trip
+// confirmed late, only the closest to the start is sent. Each reminder is
+// claimed before sending and released on failure, and moving the trip to
+// other dates makes its reminders due again.
+func TripReminders(store reminderStore, mailer reminderMailer, logger *zap.Logger) Job {
+	return Job{
+		Name:     "trip reminders",
+		Interval: 15 * time.Minute,
+		Run: func(ctx context.Context) error {
+			trips, err := store.GetTripsDueForReminders(ctx)
+			if err != nil {
+				return fmt.Errorf("scheduler: failed to get trips for TripReminders: %w", err)
+			}
+
+			for _, trip := range trips {
+				daysLeft := daysUntil(trip.StartsAt.Time, time.Now().In(trip.Settings.Location()))
+				daysBefore, ok := dueReminder(trip.Settings.ReminderDays, daysLeft)
+				if !ok {
+					continue
+				}
+
+				n, err := store.ClaimTripReminder(ctx, pgstore.ClaimTripReminderParams{
+					TripID:     trip.ID,
+					StartsAt:   trip.StartsAt,
+					DaysBefore: int32(daysBefore),
+				})
+				if err != nil {
+					logger.Error("failed to claim trip reminder", zap.Error(err), zap.String("trip_id", trip.ID.String()))
+					continue
+				}
+				if n == 0 {
+					continue
+				}
+
+				if err := mailer.SendTripReminder(trip.ID, daysLeft); err != nil {
+					logger.Error("failed to send email on TripReminders", zap.Error(err), zap.String("trip_id", trip.ID.String()))
+					if err := store.ReleaseTripReminder(ctx, pgstore.ReleaseTripReminderParams{
+						TripID:     trip.ID,
+						StartsAt:   trip.StartsAt,
+						DaysBefore: int32(daysBefore),
+					}); err != nil {
+						logger.Error("failed to release trip reminder", zap.Error(err), zap.String("trip_id", trip.ID.String()))
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+// daysUntil counts the days from now to startsAt by the calendar, as plans
+// are stored in the destination local time.
+func daysUntil(startsAt, now time.Time) int {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	day := time.Date(startsAt.Year(), startsAt.Month(), startsAt.Day(), 0, 0, 0, 0, time.UTC)
+	return int(day.Sub(today) / (24 * time.Hour))
+}
+
+// dueReminder returns the reminder due daysLeft before the trip: the closest
+// of reminderDays that is not still ahead.
+func dueReminder(reminderDays []int, daysLeft int) (int, bool) {
+	due, ok := 0, false
+	for _, days := range reminderDays {
+		if days >= daysLeft && (!ok || days < due) {
+			due, ok = days, true
+		}
+	}
+	return due, ok
+}
@@ -0,0 +1,76 @@
+package scheduler
+
+import (
+	"context"
+	"time"
+
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"go.uber.org/zap"
+)
+
+// Job is work run periodically in the background, such as sending the emails
+// that are due.
+type Job struct {
+	Name     string
+	Interval time.Duration
+	Run      func(context.Context) error
+}
+
+type runStore interface {
+	ClaimSchedulerRun(ctx context.Context, arg pgstore.ClaimSchedulerRunParams) (int64, error)
+}
+
+type Scheduler struct {
+	logger *zap.Logger
+	runs   runStore
+	jobs   []Job
+}
+
+func New(logger *zap.Logger, runs runStore, jobs ...Job) *Scheduler {
+	return &Scheduler{logger: logger, runs: runs, jobs: jobs}
+}
+
+// Start runs every job once and then on its interval until ctx is done.
+// Every server runs its own scheduler, so each run is claimed in the database
+// first: whichever instance claims the window of a job runs it, and the
+// others skip it until the next one.
+func (s *Scheduler) Start(ctx context.Context) {
+	for _, job := range s.jobs {
+		go s.loop(ctx, job)
+	}
+}
+
+func (s *Scheduler) loop(ctx context.Context, job Job) {
+	ticker := time.NewTicker(job.Interval)
+	defer ticker.Stop()
+
+	for {
+		if s.claim(ctx, job) {
+			if err := job.Run(ctx); err != nil {
+				s.logger.Error("failed to run scheduled job", zap.Error(err), zap.String("job", job.Name))
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// claim reports whether this instance got the current window of the job. A
+// job can be claimed again a little before its interval has passed, so the
+// ticks of an instance running early do not miss their window.
+func (s *Scheduler) claim(ctx context.Context, job Job) bool {
+	window := job.Interval * 9 / 10
+	claimed, err := s.runs.ClaimSchedulerRun(ctx, pgstore.ClaimSchedulerRunParams{
+		Name:          job.Name,
+		WindowSeconds: window.Seconds(),
+	})
+	if err != nil {
+		s.logger.Error("failed to claim scheduled job", zap.Error(err), zap.String("job", job.Name))
+		return false
+	}
+	return claimed > 0
+}
@@ -0,0 +1,72 @@
+package scheduler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/sheets"
+	"go.uber.org/zap"
+)
+
+// sheetSyncInterval is how often the spreadsheet of each connected trip is
+// written again.
+const sheetSyncInterval = 15 * time.Minute
+
+type sheetStore interface {
+	sheets.Source
+	ClaimDueTripSheets(ctx context.Context, syncedAt pgtype.Timestamp) ([]pgstore.TripSheet, error)
+	GetTrip(ctx context.Context, id uuid.UUID) (pgstore.Trip, error)
+}
+
+type sheetWriter interface {
+	Update(ctx context.Context, refreshToken, spreadsheetID string, tabs []sheets.Sheet) error
+}
+
+// TripSheets keeps the spreadsheets of the trips connected to one up to date,
+// rewriting their participants and expenses. Trips are claimed before
+// writing, so each is written by one instance; a failed write waits for the
+// next interval. Archived trips are no longer written.
+func TripSheets(store sheetStore, writer sheetWriter, logger *zap.Logger) Job {
+	return Job{
+		Name:     "trip sheets",
+		Interval: 5 * time.Minute,
+		Run: func(ctx context.Context) error {
+			due := pgtype.Timestamp{Valid: true, Time: time.Now().Add(-sheetSyncInterval)}
+			claimed, err := store.ClaimDueTripSheets(ctx, due)
+			if err != nil {
+				return fmt.Errorf("scheduler: failed to claim trip sheets for TripSheets: %w", err)
+			}
+
+			for _, sheet := range claimed {
+				logger := logger.With(zap.String("trip_id", sheet.TripID.String()))
+
+				trip, err := store.GetTrip(ctx, sheet.TripID)
+				if err != nil {
+					logger.Error("failed to get trip", zap.Error(err))
+					continue
+				}
+
+				tabs, err := sheets.Trip(ctx, store, trip)
+				if err != nil {
+					logger.Error("failed to build trip sheets", zap.Error(err))
+					continue
+				}
+
+				err = writer.Update(ctx, sheet.RefreshToken.String, sheet.SpreadsheetID.String, tabs)
+				if errors.Is(err, sheets.ErrDisabled) {
+					return nil
+				}
+				if err != nil {
+					logger.Error("failed to update trip spreadsheet", zap.Error(err))
+				}
+			}
+
+			return nil
+		},
+	}
+}
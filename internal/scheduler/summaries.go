@@ -0,0 +1,53 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// summaryDelay is how long after a trip is created its owner is sent the
+// planning summary.
+const summaryDelay = 24 * time.Hour
+
+type summaryStore interface {
+	ClaimDueTripSummaries(ctx context.Context, createdAt pgtype.Timestamp) ([]uuid.UUID, error)
+	ReleaseTripSummary(ctx context.Context, id uuid.UUID) error
+}
+
+type summaryMailer interface {
+	SendOwnerSummary(tripID uuid.UUID) error
+}
+
+// OwnerSummaries sends the owner of each trip created more than a day ago a
+// summary of its planning. Trips are claimed before sending, so each summary
+// goes out once, and released when sending fails to be retried on the next
+// run.
+func OwnerSummaries(store summaryStore, mailer summaryMailer, logger *zap.Logger) Job {
+	return Job{
+		Name:     "owner summaries",
+		Interval: 10 * time.Minute,
+		Run: func(ctx context.Context) error {
+			due := pgtype.Timestamp{Valid: true, Time: time.Now().Add(-summaryDelay)}
+			ids, err := store.ClaimDueTripSummaries(ctx, due)
+			if err != nil {
+				return fmt.Errorf("scheduler: failed to claim trips for OwnerSummaries: %w", err)
+			}
+
+			for _, id := range ids {
+				if err := mailer.SendOwnerSummary(id); err != nil {
+					logger.Error("failed to send email on OwnerSummaries", zap.Error(err), zap.String("trip_id", id.String()))
+					if err := store.ReleaseTripSummary(ctx, id); err != nil {
+						logger.Error("failed to release trip summary", zap.Error(err), zap.String("trip_id", id.String()))
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,59 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/jackc/pgx/v5/pgtype"
+	"go.uber.org/zap"
+)
+
+// surveyWindow is how long after a trip ends its survey can still be sent,
+// so trips that were over long before surveys existed are left alone.
+const surveyWindow = 7 * 24 * time.Hour
+
+type surveyStore interface {
+	ClaimEndedTripSurveys(ctx context.Context, endsAt pgtype.Timestamp) ([]uuid.UUID, error)
+	ReleaseTripSurvey(ctx context.Context, tripID uuid.UUID) error
+	PrepareTripSurvey(ctx context.Context, tripID uuid.UUID) error
+}
+
+type surveyMailer interface {
+	SendTripSurvey(tripID uuid.UUID) error
+}
+
+// TripSurveys emails the participants of every confirmed trip that just
+// ended a link to its retrospective survey. Like DailyDigests, trips are
+// claimed before sending and released on failure.
+func TripSurveys(store surveyStore, mailer surveyMailer, logger *zap.Logger) Job {
+	return Job{
+		Name:     "trip surveys",
+		Interval: 15 * time.Minute,
+		Run: func(ctx context.Context) error {
+			endsAt := pgtype.Timestamp{Valid: true, Time: time.Now().Add(-surveyWindow)}
+			ids, err := store.ClaimEndedTripSurveys(ctx, endsAt)
+			if err != nil {
+				return fmt.Errorf("scheduler: failed to claim trips for TripSurveys: %w", err)
+			}
+
+			for _, id := range ids {
+				err := store.PrepareTripSurvey(ctx, id)
+				if err != nil {
+					logger.Error("failed to prepare survey on TripSurveys", zap.Error(err), zap.String("trip_id", id.String()))
+				} else if err = mailer.SendTripSurvey(id); err != nil {
+					logger.Error("failed to send email on TripSurveys", zap.Error(err), zap.String("trip_id", id.String()))
+				}
+
+				if err != nil {
+					if err := store.ReleaseTripSurvey(ctx, id); err != nil {
+						logger.Error("failed to release trip survey", zap.Error(err), zap.String("trip_id", id.String()))
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,46 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+type taskStore interface {
+	ClaimOverdueTasks(ctx context.Context) ([]uuid.UUID, error)
+	ReleaseOverdueTask(ctx context.Context, id uuid.UUID) error
+}
+
+type taskMailer interface {
+	SendOverdueTaskReminder(taskID uuid.UUID) error
+}
+
+// OverdueTasks reminds whoever is responsible for a task once its deadline
+// passed without it being done, from the reminder hour of the trip. Each task
+// is reminded once per deadline; moving the deadline makes it eligible again.
+func OverdueTasks(store taskStore, mailer taskMailer, logger *zap.Logger) Job {
+	return Job{
+		Name:     "overdue tasks",
+		Interval: 15 * time.Minute,
+		Run: func(ctx context.Context) error {
+			ids, err := store.ClaimOverdueTasks(ctx)
+			if err != nil {
+				return fmt.Errorf("scheduler: failed to claim tasks for OverdueTasks: %w", err)
+			}
+
+			for _, id := range ids {
+				if err := mailer.SendOverdueTaskReminder(id); err != nil {
+					logger.Error("failed to send email on OverdueTasks", zap.Error(err), zap.String("task_id", id.String()))
+					if err := store.ReleaseOverdueTask(ctx, id); err != nil {
+						logger.Error("failed to release overdue task", zap.Error(err), zap.String("task_id", id.String()))
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
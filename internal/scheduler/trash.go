@@ -0,0 +1,37 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgtype"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+)
+
+type trashStore interface {
+	PurgeTrashedActivities(ctx context.Context, deletedAt pgtype.Timestamp) (int64, error)
+	PurgeTrashedLinks(ctx context.Context, deletedAt pgtype.Timestamp) (int64, error)
+}
+
+// TrashPurge deletes for good the activities and links in trip trashes for
+// longer than pgstore.TrashRetention.
+func TrashPurge(store trashStore) Job {
+	return Job{
+		Name:     "trash purge",
+		Interval: time.Hour,
+		Run: func(ctx context.Context) error {
+			before := pgtype.Timestamp{Valid: true, Time: time.Now().Add(-pgstore.TrashRetention)}
+
+			if _, err := store.PurgeTrashedActivities(ctx, before); err != nil {
+				return fmt.Errorf("scheduler: failed to purge activities for TrashPurge: %w", err)
+			}
+
+			if _, err := store.PurgeTrashedLinks(ctx, before); err != nil {
+				return fmt.Errorf("scheduler: failed to purge links for TrashPurge: %w", err)
+			}
+
+			return nil
+		},
+	}
+}
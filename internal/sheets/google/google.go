@@ -0,0 +1,228 @@
+package google
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/sheets"
+)
+
+const (
+	authURL   = "https://accounts.google.com/o/oauth2/v2/auth"
+	tokenURL  = "https://oauth2.googleapis.com/token"
+	sheetsURL = "https://sheets.googleapis.com/v4/spreadsheets"
+
+	// scope only gives access to the files the app creates, not to the rest
+	// of the account.
+	scope = "https://www.googleapis.com/auth/drive.file"
+)
+
+// Config is the OAuth client registered in the Google Cloud console.
+// RedirectURL must be one of its authorized redirect URIs, pointing to
+// GET /sheets/callback.
+type Config struct {
+	ClientID     string
+	ClientSecret string
+	RedirectURL  string
+}
+
+type Google struct {
+	client *http.Client
+	cfg    Config
+}
+
+func NewGoogle(client *http.Client, cfg Config) (Google, error) {
+	if cfg.ClientID == "" || cfg.ClientSecret == "" || cfg.RedirectURL == "" {
+		return Google{}, fmt.Errorf("google: client id, secret and redirect url are required")
+	}
+	return Google{client, cfg}, nil
+}
+
+func (g Google) AuthURL(state string) (string, error) {
+	q := url.Values{}
+	q.Set("client_id", g.cfg.ClientID)
+	q.Set("redirect_uri", g.cfg.RedirectURL)
+	q.Set("response_type", "code")
+	q.Set("scope", scope)
+	q.Set("state", state)
+	// A refresh token is only given offline, and again on later consents
+	// only when prompted.
+	q.Set("access_type", "offline")
+	q.Set("prompt", "consent")
+	return authURL + "?" + q.Encode(), nil
+}
+
+type tokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+// Connect exchanges the code people come back with for a refresh token.
+func (g Google) Connect(ctx context.Context, code string) (string, error) {
+	form := url.Values{}
+	form.Set("code", code)
+	form.Set("redirect_uri", g.cfg.RedirectURL)
+	form.Set("grant_type", "authorization_code")
+
+	token, err := g.token(ctx, form)
+	if err != nil {
+		return "", fmt.Errorf("google: failed to exchange code for Connect: %w", err)
+	}
+	if token.RefreshToken == "" {
+		return "", fmt.Errorf("google: no refresh token given for Connect")
+	}
+	return token.RefreshToken, nil
+}
+
+type spreadsheetRequest struct {
+	Properties struct {
+		Title string `json:"title"`
+	} `json:"properties"`
+	Sheets []sheetRequest `json:"sheets"`
+}
+
+type sheetRequest struct {
+	Properties struct {
+		Title string `json:"title"`
+	} `json:"properties"`
+}
+
+type spreadsheetResponse struct {
+	SpreadsheetID  string `json:"spreadsheetId"`
+	SpreadsheetURL string `json:"spreadsheetUrl"`
+}
+
+func (g Google) Create(ctx context.Context, refreshToken, title string, tabs []sheets.Sheet) (sheets.Spreadsheet, error) {
+	accessToken, err := g.accessToken(ctx, refreshToken)
+	if err != nil {
+		return sheets.Spreadsheet{}, fmt.Errorf("google: failed to get access token for Create: %w", err)
+	}
+
+	var req spreadsheetRequest
+	req.Properties.Title = title
+	for _, tab := range tabs {
+		var sheet sheetRequest
+		sheet.Properties.Title = tab.Title
+		req.Sheets = append(req.Sheets, sheet)
+	}
+
+	var res spreadsheetResponse
+	if err := g.post(ctx, accessToken, sheetsURL, req, &res); err != nil {
+		return sheets.Spreadsheet{}, fmt.Errorf("google: failed to create spreadsheet for Create: %w", err)
+	}
+
+	if err := g.write(ctx, accessToken, res.SpreadsheetID, tabs); err != nil {
+		return sheets.Spreadsheet{}, fmt.Errorf("google: failed to write sheets for Create: %w", err)
+	}
+
+	return sheets.Spreadsheet{ID: res.SpreadsheetID, URL: res.SpreadsheetURL}, nil
+}
+
+func (g Google) Update(ctx context.Context, refreshToken, spreadsheetID string, tabs []sheets.Sheet) error {
+	accessToken, err := g.accessToken(ctx, refreshToken)
+	if err != nil {
+		return fmt.Errorf("google: failed to get access token for Update: %w", err)
+	}
+
+	if err := g.write(ctx, accessToken, spreadsheetID, tabs); err != nil {
+		return fmt.Errorf("google: failed to write sheets for Update: %w", err)
+	}
+	return nil
+}
+
+type valueRange struct {
+	Range  string  `json:"range"`
+	Values [][]any `json:"values"`
+}
+
+// write clears the sheets and writes their rows. Values are written raw, so
+// nothing typed by people is ever taken for a formula.
+func (g Google) write(ctx context.Context, accessToken, spreadsheetID string, tabs []sheets.Sheet) error {
+	base := sheetsURL + "/" + url.PathEscape(spreadsheetID) + "/values"
+
+	ranges := make([]string, 0, len(tabs))
+	data := make([]valueRange, 0, len(tabs))
+	for _, tab := range tabs {
+		name := "'" + strings.ReplaceAll(tab.Title, "'", "''") + "'"
+		ranges = append(ranges, name)
+		data = append(data, valueRange{Range: name + "!A1", Values: tab.Rows})
+	}
+
+	if err := g.post(ctx, accessToken, base+":batchClear", map[string]any{"ranges": ranges}, nil); err != nil {
+		return err
+	}
+
+	return g.post(ctx, accessToken, base+":batchUpdate", map[string]any{
+		"valueInputOption": "RAW",
+		"data":             data,
+	}, nil)
+}
+
+func (g Google) accessToken(ctx context.Context, refreshToken string) (string, error) {
+	form := url.Values{}
+	form.Set("refresh_token", refreshToken)
+	form.Set("grant_type", "refresh_token")
+
+	token, err := g.token(ctx, form)
+	if err != nil {
+		return "", err
+	}
+	return token.AccessToken, nil
+}
+
+func (g Google) token(ctx context.Context, form url.Values) (tokenResponse, error) {
+	form.Set("client_id", g.cfg.ClientID)
+	form.Set("client_secret", g.cfg.ClientSecret)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return tokenResponse{}, err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	var token tokenResponse
+	if err := g.do(req, &token); err != nil {
+		return tokenResponse{}, err
+	}
+	return token, nil
+}
+
+func (g Google) post(ctx context.Context, accessToken, u string, body, v any) error {
+	b, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, u, bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	return g.do(req, v)
+}
+
+func (g Google) do(req *http.Request, v any) error {
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("unexpected status %d: %s", resp.StatusCode, bytes.TrimSpace(body))
+	}
+
+	if v == nil {
+		return nil
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
@@ -0,0 +1,54 @@
+package sheets
+
+import (
+	"context"
+	"errors"
+)
+
+var ErrDisabled = errors.New("sheets: no spreadsheet provider configured")
+
+// Sheet is a tab of a spreadsheet: its title and every row, header first.
+// Values are strings or numbers, written as they are.
+type Sheet struct {
+	Title string
+	Rows  [][]any
+}
+
+// Spreadsheet is a spreadsheet created in the account of whoever connected a
+// trip.
+type Spreadsheet struct {
+	ID  string
+	URL string
+}
+
+// Provider writes trips to spreadsheets in accounts connected with OAuth.
+// Connecting an account gives a refresh token, used for every later call.
+type Provider interface {
+	// AuthURL is where people are sent to connect their account. They come
+	// back to the redirect URL with a code and the given state.
+	AuthURL(state string) (string, error)
+	Connect(ctx context.Context, code string) (string, error)
+	Create(ctx context.Context, refreshToken, title string, sheets []Sheet) (Spreadsheet, error)
+	// Update replaces the content of the sheets with the same titles.
+	Update(ctx context.Context, refreshToken, spreadsheetID string, sheets []Sheet) error
+}
+
+// None is the provider used when no spreadsheet provider is configured.
+// Every operation fails with ErrDisabled.
+type None struct{}
+
+func (None) AuthURL(string) (string, error) {
+	return "", ErrDisabled
+}
+
+func (None) Connect(context.Context, string) (string, error) {
+	return "", ErrDisabled
+}
+
+func (None) Create(context.Context, string, string, []Sheet) (Spreadsheet, error) {
+	return Spreadsheet{}, ErrDisabled
+}
+
+func (None) Update(context.Context, string, string, []Sheet) error {
+	return ErrDisabled
+}
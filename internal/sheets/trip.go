@@ -0,0 +1,69 @@
+package sheets
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/pgstore"
+)
+
+var participantStatuses = map[string]string{
+	pgstore.ParticipantInvited:    "Convidado",
+	pgstore.ParticipantWaitlisted: "Lista de espera",
+	pgstore.ParticipantDeclined:   "Recusou",
+}
+
+// Source is where the participants and expenses of a trip are read from.
+type Source interface {
+	GetParticipants(ctx context.Context, tripID uuid.UUID) ([]pgstore.Participant, error)
+	ListTripExpenses(ctx context.Context, arg pgstore.ListTripExpensesParams) ([]pgstore.Expense, error)
+}
+
+// Trip returns the sheets the trip is exported to: its participants and its
+// expenses, oldest first.
+func Trip(ctx context.Context, src Source, trip pgstore.Trip) ([]Sheet, error) {
+	participants, err := src.GetParticipants(ctx, trip.ID)
+	if err != nil {
+		return nil, fmt.Errorf("sheets: failed to get participants for Trip: %w", err)
+	}
+
+	names := make(map[uuid.UUID]string, len(participants))
+	people := Sheet{Title: "Participantes", Rows: [][]any{{"Nome", "E-mail", "Situação", "Confirmado"}}}
+	for _, participant := range participants {
+		name := participant.Name.String
+		if name == "" {
+			name = participant.Email
+		}
+		names[participant.ID] = name
+
+		status, ok := participantStatuses[participant.Status]
+		if !ok {
+			status = participant.Status
+		}
+		confirmed := "Não"
+		if participant.IsConfirmed {
+			confirmed = "Sim"
+		}
+		people.Rows = append(people.Rows, []any{participant.Name.String, participant.Email, status, confirmed})
+	}
+
+	expenses, err := src.ListTripExpenses(ctx, pgstore.ListTripExpensesParams{TripID: trip.ID})
+	if err != nil {
+		return nil, fmt.Errorf("sheets: failed to get expenses for Trip: %w", err)
+	}
+
+	spent := Sheet{Title: "Despesas", Rows: [][]any{{"Data", "Descrição", "Categoria", "Pago por", "Valor", "Moeda"}}}
+	for _, expense := range expenses {
+		spent.Rows = append(spent.Rows, []any{
+			expense.SpentAt.Time.Format("2006-01-02"),
+			expense.Description,
+			expense.Category,
+			names[expense.PaidBy],
+			float64(expense.AmountCents) / 100,
+			trip.Settings.Currency,
+		})
+	}
+
+	return []Sheet{people, spent}, nil
+}
@@ -0,0 +1,169 @@
+package split
+
+import (
+	"cmp"
+	"errors"
+	"math"
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+const (
+	MethodEqual      = "equal"
+	MethodExact      = "exact"
+	MethodPercentage = "percentage"
+	MethodShares     = "shares"
+)
+
+var (
+	ErrUnknownMethod     = errors.New("unknown method")
+	ErrNoParticipants    = errors.New("no participants to split between")
+	ErrNotWholeCents     = errors.New("exact amounts must be whole cents")
+	ErrExactMismatch     = errors.New("exact amounts must add up to the expense amount")
+	ErrPercentMismatch   = errors.New("percentages must add up to 100")
+	ErrSharesNotPositive = errors.New("shares must add up to more than zero")
+)
+
+// Amounts divides totalCents between participants according to method.
+// values holds each participant's amount in cents, percentage or weight,
+// depending on the method, and is ignored for MethodEqual. The returned
+// amounts always add up to totalCents.
+func Amounts(method string, totalCents int64, values []float64) ([]int64, error) {
+	if len(values) == 0 {
+		return nil, ErrNoParticipants
+	}
+
+	weights := make([]int64, len(values))
+	switch method {
+	case MethodEqual:
+		for i := range weights {
+			weights[i] = 1
+		}
+	case MethodExact:
+		var sum int64
+		amounts := make([]int64, len(values))
+		for i, v := range values {
+			if v != math.Trunc(v) {
+				return nil, ErrNotWholeCents
+			}
+			amounts[i] = int64(v)
+			sum += amounts[i]
+		}
+		if sum != totalCents {
+			return nil, ErrExactMismatch
+		}
+		return amounts, nil
+	case MethodPercentage:
+		// Work in basis points so two decimal places survive the rounding.
+		var sum int64
+		for i, v := range values {
+			weights[i] = int64(math.Round(v * 100))
+			sum += weights[i]
+		}
+		if sum != 100*100 {
+			return nil, ErrPercentMismatch
+		}
+	case MethodShares:
+		var sum int64
+		for i, v := range values {
+			weights[i] = int64(math.Round(v * 100))
+			sum += weights[i]
+		}
+		if sum <= 0 {
+			return nil, ErrSharesNotPositive
+		}
+	default:
+		return nil, ErrUnknownMethod
+	}
+
+	return distribute(totalCents, weights), nil
+}
+
+// distribute divides total proportionally to weights. Cents lost to integer
+// division go one by one to the largest remainders, earlier participants
+// first on ties, so results are stable for the same input.
+func distribute(total int64, weights []int64) []int64 {
+	var sum int64
+	for _, w := range weights {
+		sum += w
+	}
+
+	amounts := make([]int64, len(weights))
+	remainders := make([]int64, len(weights))
+	left := total
+	for i, w := range weights {
+		amounts[i] = total * w / sum
+		remainders[i] = total * w % sum
+		left -= amounts[i]
+	}
+
+	order := make([]int, len(weights))
+	for i := range order {
+		order[i] = i
+	}
+	slices.SortStableFunc(order, func(a, b int) int {
+		return cmp.Compare(remainders[b], remainders[a])
+	})
+
+	for i := 0; left > 0; i++ {
+		amounts[order[i%len(order)]]++
+		left--
+	}
+
+	return amounts
+}
+
+// Balance is how much a participant is owed, when positive, or owes, when
+// negative, after everything they paid and their share of every expense.
+type Balance struct {
+	ParticipantID uuid.UUID
+	BalanceCents  int64
+}
+
+type Transfer struct {
+	From        uuid.UUID
+	To          uuid.UUID
+	AmountCents int64
+}
+
+// Settle returns transfers that bring every balance to zero, repeatedly
+// matching whoever owes the most with whoever is owed the most.
+func Settle(balances []Balance) []Transfer {
+	var debtors, creditors []Balance
+	for _, b := range balances {
+		switch {
+		case b.BalanceCents < 0:
+			debtors = append(debtors, Balance{b.ParticipantID, -b.BalanceCents})
+		case b.BalanceCents > 0:
+			creditors = append(creditors, b)
+		}
+	}
+
+	byAmount := func(a, b Balance) int {
+		return cmp.Compare(b.BalanceCents, a.BalanceCents)
+	}
+	slices.SortStableFunc(debtors, byAmount)
+	slices.SortStableFunc(creditors, byAmount)
+
+	transfers := make([]Transfer, 0, max(len(debtors), len(creditors)))
+	for d, c := 0, 0; d < len(debtors) && c < len(creditors); {
+		amount := min(debtors[d].BalanceCents, creditors[c].BalanceCents)
+		transfers = append(transfers, Transfer{
+			From:        debtors[d].ParticipantID,
+			To:          creditors[c].ParticipantID,
+			AmountCents: amount,
+		})
+
+		debtors[d].BalanceCents -= amount
+		creditors[c].BalanceCents -= amount
+		if debtors[d].BalanceCents == 0 {
+			d++
+		}
+		if creditors[c].BalanceCents == 0 {
+			c++
+		}
+	}
+
+	return transfers
+}
@@ -0,0 +1,292 @@
+package s3
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/storage"
+)
+
+// requestExpiry is how long the requests the API makes itself are valid for.
+const requestExpiry = time.Minute
+
+// Config tells where the bucket is. Endpoint is the base URL of any service
+// speaking the S3 API, like https://s3.us-east-1.amazonaws.com or a MinIO
+// server; buckets are always addressed by path.
+type Config struct {
+	Endpoint        string
+	Region          string
+	Bucket          string
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+type S3 struct {
+	client *http.Client
+	cfg    Config
+}
+
+func NewS3(client *http.Client, cfg Config) (S3, error) {
+	if cfg.Endpoint == "" || cfg.Region == "" || cfg.Bucket == "" || cfg.AccessKeyID == "" || cfg.SecretAccessKey == "" {
+		return S3{}, fmt.Errorf("s3: endpoint, region, bucket and credentials are required")
+	}
+	if _, err := url.Parse(cfg.Endpoint); err != nil {
+		return S3{}, fmt.Errorf("s3: invalid endpoint: %w", err)
+	}
+	cfg.Endpoint = strings.TrimSuffix(cfg.Endpoint, "/")
+	return S3{client, cfg}, nil
+}
+
+// PresignUpload returns a PUT request for the object that is only accepted
+// with the given content type.
+func (s S3) PresignUpload(ctx context.Context, key, contentType string, expires time.Duration) (storage.Upload, error) {
+	header := http.Header{"Content-Type": {contentType}}
+	now := time.Now().UTC()
+
+	u, err := s.presign(http.MethodPut, key, header, now, expires)
+	if err != nil {
+		return storage.Upload{}, fmt.Errorf("s3: failed to presign PresignUpload: %w", err)
+	}
+
+	return storage.Upload{
+		Method:    http.MethodPut,
+		URL:       u,
+		Header:    header,
+		ExpiresAt: now.Add(expires),
+	}, nil
+}
+
+func (s S3) Stat(ctx context.Context, key string) (storage.Object, error) {
+	resp, err := s.do(ctx, http.MethodHead, key, nil)
+	if err != nil {
+		return storage.Object{}, fmt.Errorf("s3: failed to get object for Stat: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return storage.Object{}, storage.ErrNotFound
+	default:
+		return storage.Object{}, fmt.Errorf("s3: unexpected status %d for Stat", resp.StatusCode)
+	}
+
+	size, err := strconv.ParseInt(resp.Header.Get("Content-Length"), 10, 64)
+	if err != nil {
+		return storage.Object{}, fmt.Errorf("s3: invalid content length for Stat: %w", err)
+	}
+
+	return storage.Object{Size: size, ContentType: resp.Header.Get("Content-Type")}, nil
+}
+
+// Open returns the content of the object, which must be closed when done.
+func (s S3) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	resp, err := s.do(ctx, http.MethodGet, key, nil)
+	if err != nil {
+		return nil, fmt.Errorf("s3: failed to get object for Open: %w", err)
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp.Body, nil
+	case http.StatusNotFound:
+		resp.Body.Close()
+		return nil, storage.ErrNotFound
+	default:
+		resp.Body.Close()
+		return nil, fmt.Errorf("s3: unexpected status %d for Open", resp.StatusCode)
+	}
+}
+
+// Put replaces the content of the object.
+func (s S3) Put(ctx context.Context, key, contentType string, data []byte) error {
+	header := http.Header{"Content-Type": {contentType}}
+	u, err := s.presign(http.MethodPut, key, header, time.Now().UTC(), requestExpiry)
+	if err != nil {
+		return fmt.Errorf("s3: failed to presign Put: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, u, bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("s3: failed to build request for Put: %w", err)
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("s3: failed to put object for Put: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("s3: unexpected status %d for Put", resp.StatusCode)
+	}
+	return nil
+}
+
+// Copy copies the object at src to dst, within the bucket.
+func (s S3) Copy(ctx context.Context, src, dst string) error {
+	header := http.Header{"X-Amz-Copy-Source": {uriEncode("/"+s.cfg.Bucket+"/"+src, false)}}
+	resp, err := s.do(ctx, http.MethodPut, dst, header)
+	if err != nil {
+		return fmt.Errorf("s3: failed to copy object for Copy: %w", err)
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return nil
+	case http.StatusNotFound:
+		return storage.ErrNotFound
+	default:
+		return fmt.Errorf("s3: unexpected status %d for Copy", resp.StatusCode)
+	}
+}
+
+func (s S3) Delete(ctx context.Context, key string) error {
+	resp, err := s.do(ctx, http.MethodDelete, key, nil)
+	if err != nil {
+		return fmt.Errorf("s3: failed to delete object for Delete: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("s3: unexpected status %d for Delete", resp.StatusCode)
+	}
+	return nil
+}
+
+// do makes a request without a body for the object, signed the same way as
+// the uploads handed to clients.
+func (s S3) do(ctx context.Context, method, key string, header http.Header) (*http.Response, error) {
+	u, err := s.presign(method, key, header, time.Now().UTC(), requestExpiry)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u, nil)
+	if err != nil {
+		return nil, err
+	}
+	for name := range header {
+		req.Header.Set(name, header.Get(name))
+	}
+	return s.client.Do(req)
+}
+
+// presign builds the URL of a request for the object in the bucket.
+func (s S3) presign(method, key string, header http.Header, now time.Time, expires time.Duration) (string, error) {
+	u, err := url.Parse(s.cfg.Endpoint + "/" + s.cfg.Bucket + "/" + key)
+	if err != nil {
+		return "", err
+	}
+	return s.sign(method, u, header, now, expires), nil
+}
+
+// sign returns u authenticated by its query string, following AWS Signature
+// Version 4. Every header given must be sent with the request as is.
+func (s S3) sign(method string, u *url.URL, header http.Header, now time.Time, expires time.Duration) string {
+	date := now.Format("20060102")
+	scope := date + "/" + s.cfg.Region + "/s3/aws4_request"
+
+	signed := map[string]string{"host": u.Host}
+	for name := range header {
+		signed[strings.ToLower(name)] = strings.TrimSpace(header.Get(name))
+	}
+	names := make([]string, 0, len(signed))
+	for name := range signed {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + signed[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	query := url.Values{}
+	query.Set("X-Amz-Algorithm", "AWS4-HMAC-SHA256")
+	query.Set("X-Amz-Credential", s.cfg.AccessKeyID+"/"+scope)
+	query.Set("X-Amz-Date", now.Format("20060102T150405Z"))
+	query.Set("X-Amz-Expires", strconv.Itoa(int(expires.Seconds())))
+	query.Set("X-Amz-SignedHeaders", signedHeaders)
+	canonicalQuery := encodeQuery(query)
+
+	path := uriEncode(u.Path, false)
+	canonicalRequest := strings.Join([]string{
+		method,
+		path,
+		canonicalQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		"UNSIGNED-PAYLOAD",
+	}, "\n")
+
+	hash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{
+		"AWS4-HMAC-SHA256",
+		now.Format("20060102T150405Z"),
+		scope,
+		hex.EncodeToString(hash[:]),
+	}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+s.cfg.SecretAccessKey), date)
+	signingKey = hmacSHA256(signingKey, s.cfg.Region)
+	signingKey = hmacSHA256(signingKey, "s3")
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	return u.Scheme + "://" + u.Host + path + "?" + canonicalQuery + "&X-Amz-Signature=" + signature
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// encodeQuery encodes the query sorted by key, as signatures expect.
+func encodeQuery(query url.Values) string {
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		parts = append(parts, uriEncode(k, true)+"="+uriEncode(query.Get(k), true))
+	}
+	return strings.Join(parts, "&")
+}
+
+// uriEncode percent-encodes everything but the unreserved characters of RFC
+// 3986, and slashes unless encodeSlash is set.
+func uriEncode(s string, encodeSlash bool) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case 'A' <= c && c <= 'Z', 'a' <= c && c <= 'z', '0' <= c && c <= '9',
+			c == '-', c == '.', c == '_', c == '~':
+			b.WriteByte(c)
+		case c == '/' && !encodeSlash:
+			b.WriteByte(c)
+		default:
+			fmt.Fprintf(&b, "%%%02X", c)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,68 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"time"
+)
+
+var (
+	ErrDisabled = errors.New("storage: no storage configured")
+	ErrNotFound = errors.New("storage: object not found")
+)
+
+// Upload is how a client sends a file straight to the storage, without going
+// through the API: a request with the given method and headers to URL, with
+// the file as the body, before ExpiresAt.
+type Upload struct {
+	Method    string
+	URL       string
+	Header    http.Header
+	ExpiresAt time.Time
+}
+
+// Object describes a file kept in the storage.
+type Object struct {
+	Size        int64
+	ContentType string
+}
+
+// Provider keeps files uploaded by clients.
+type Provider interface {
+	PresignUpload(ctx context.Context, key, contentType string, expires time.Duration) (Upload, error)
+	Stat(ctx context.Context, key string) (Object, error)
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	Put(ctx context.Context, key, contentType string, data []byte) error
+	Copy(ctx context.Context, src, dst string) error
+	Delete(ctx context.Context, key string) error
+}
+
+// None is the provider used when no storage is configured. Every operation
+// fails with ErrDisabled.
+type None struct{}
+
+func (None) PresignUpload(context.Context, string, string, time.Duration) (Upload, error) {
+	return Upload{}, ErrDisabled
+}
+
+func (None) Stat(context.Context, string) (Object, error) {
+	return Object{}, ErrDisabled
+}
+
+func (None) Open(context.Context, string) (io.ReadCloser, error) {
+	return nil, ErrDisabled
+}
+
+func (None) Put(context.Context, string, string, []byte) error {
+	return ErrDisabled
+}
+
+func (None) Copy(context.Context, string, string) error {
+	return ErrDisabled
+}
+
+func (None) Delete(context.Context, string) error {
+	return ErrDisabled
+}
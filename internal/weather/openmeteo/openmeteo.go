@@ -0,0 +1,157 @@
+package openmeteo
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/xtuser777/nlw-journey-trilha-go/internal/weather"
+)
+
+const (
+	geocodingURL = "https://geocoding-api.open-meteo.com/v1/search"
+	forecastURL  = "https://api.open-meteo.com/v1/forecast"
+	archiveURL   = "https://archive-api.open-meteo.com/v1/archive"
+
+	// forecastHorizon is how far ahead the forecast API has data. Trips
+	// further away use last year's observations for the same dates.
+	forecastHorizon = 16 * 24 * time.Hour
+)
+
+var ErrDestinationNotFound = errors.New("openmeteo: destination not found")
+
+type OpenMeteo struct {
+	client *http.Client
+}
+
+func NewOpenMeteo(client *http.Client) OpenMeteo {
+	return OpenMeteo{client}
+}
+
+type geocodingResponse struct {
+	Results []geocodingResult `json:"results"`
+}
+
+type geocodingResult struct {
+	Latitude    float64 `json:"latitude"`
+	Longitude   float64 `json:"longitude"`
+	CountryCode string  `json:"country_code"`
+}
+
+type dailyResponse struct {
+	Daily struct {
+		Time                        []string   `json:"time"`
+		Temperature2mMax            []*float64 `json:"temperature_2m_max"`
+		Temperature2mMin            []*float64 `json:"temperature_2m_min"`
+		PrecipitationSum            []*float64 `json:"precipitation_sum"`
+		PrecipitationProbabilityMax []*int     `json:"precipitation_probability_max"`
+	} `json:"daily"`
+}
+
+func (om OpenMeteo) Forecast(ctx context.Context, destination string, from, to time.Time) ([]weather.Day, error) {
+	place, err := om.geocode(ctx, destination)
+	if err != nil {
+		return nil, err
+	}
+
+	base, shift := forecastURL, 0
+	daily := "temperature_2m_max,temperature_2m_min,precipitation_sum,precipitation_probability_max"
+	if time.Until(to) > forecastHorizon {
+		base, shift = archiveURL, -1
+		daily = "temperature_2m_max,temperature_2m_min,precipitation_sum"
+	}
+
+	q := url.Values{}
+	q.Set("latitude", fmt.Sprint(place.Latitude))
+	q.Set("longitude", fmt.Sprint(place.Longitude))
+	q.Set("daily", daily)
+	q.Set("timezone", "auto")
+	q.Set("start_date", from.AddDate(shift, 0, 0).Format(time.DateOnly))
+	q.Set("end_date", to.AddDate(shift, 0, 0).Format(time.DateOnly))
+
+	var res dailyResponse
+	if err := om.get(ctx, base, q, &res); err != nil {
+		return nil, fmt.Errorf("openmeteo: failed to get daily weather for Forecast: %w", err)
+	}
+
+	days := make([]weather.Day, 0, len(res.Daily.Time))
+	for i, t := range res.Daily.Time {
+		date, err := time.Parse(time.DateOnly, t)
+		if err != nil {
+			return nil, fmt.Errorf("openmeteo: invalid date %q for Forecast: %w", t, err)
+		}
+
+		day := weather.Day{
+			Date:            date.AddDate(-shift, 0, 0),
+			TempMinC:        valueAt(res.Daily.Temperature2mMin, i),
+			TempMaxC:        valueAt(res.Daily.Temperature2mMax, i),
+			PrecipitationMM: valueAt(res.Daily.PrecipitationSum, i),
+		}
+		if i < len(res.Daily.PrecipitationProbabilityMax) && res.Daily.PrecipitationProbabilityMax[i] != nil {
+			day.PrecipitationProbability = *res.Daily.PrecipitationProbabilityMax[i]
+		} else if day.PrecipitationMM >= 1 {
+			// Historical data has no probability, only what actually fell.
+			day.PrecipitationProbability = 100
+		}
+		days = append(days, day)
+	}
+
+	return days, nil
+}
+
+// CountryCode returns the ISO 3166-1 alpha-2 code of the country the
+// destination is in.
+func (om OpenMeteo) CountryCode(ctx context.Context, destination string) (string, error) {
+	place, err := om.geocode(ctx, destination)
+	if err != nil {
+		return "", err
+	}
+	return place.CountryCode, nil
+}
+
+func (om OpenMeteo) geocode(ctx context.Context, destination string) (geocodingResult, error) {
+	q := url.Values{}
+	q.Set("name", destination)
+	q.Set("count", "1")
+	q.Set("format", "json")
+
+	var res geocodingResponse
+	if err := om.get(ctx, geocodingURL, q, &res); err != nil {
+		return geocodingResult{}, fmt.Errorf("openmeteo: failed to geocode destination: %w", err)
+	}
+	if len(res.Results) == 0 {
+		return geocodingResult{}, ErrDestinationNotFound
+	}
+
+	return res.Results[0], nil
+}
+
+func (om OpenMeteo) get(ctx context.Context, base string, q url.Values, v any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, base+"?"+q.Encode(), nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := om.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+
+	return json.NewDecoder(resp.Body).Decode(v)
+}
+
+func valueAt(values []*float64, i int) float64 {
+	if i >= len(values) || values[i] == nil {
+		return 0
+	}
+	return *values[i]
+}
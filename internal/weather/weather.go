@@ -0,0 +1,57 @@
+package weather
+
+import "time"
+
+// Day is the weather summary for a single day at a destination.
+type Day struct {
+	Date                     time.Time
+	TempMinC                 float64
+	TempMaxC                 float64
+	PrecipitationMM          float64
+	PrecipitationProbability int
+}
+
+// Climate is an aggregate of the days of a trip, used to pick what to pack.
+type Climate struct {
+	TempMinC  float64
+	TempMaxC  float64
+	RainyDays int
+	Days      int
+}
+
+// rainyProbability is the precipitation probability, in percent, from which a
+// day is considered rainy.
+const rainyProbability = 50
+
+func (d Day) IsRainy() bool {
+	return d.PrecipitationProbability >= rainyProbability
+}
+
+func Summarize(days []Day) Climate {
+	var c Climate
+	for i, d := range days {
+		if i == 0 || d.TempMinC < c.TempMinC {
+			c.TempMinC = d.TempMinC
+		}
+		if i == 0 || d.TempMaxC > c.TempMaxC {
+			c.TempMaxC = d.TempMaxC
+		}
+		if d.IsRainy() {
+			c.RainyDays++
+		}
+	}
+	c.Days = len(days)
+	return c
+}
+
+func (c Climate) IsCold() bool {
+	return c.Days > 0 && c.TempMinC < 12
+}
+
+func (c Climate) IsHot() bool {
+	return c.Days > 0 && c.TempMaxC >= 28
+}
+
+func (c Climate) IsRainy() bool {
+	return c.RainyDays > 0
+}